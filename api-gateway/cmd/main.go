@@ -5,10 +5,21 @@ import (
 	_ "api-gateway/docs"
 	"api-gateway/grpc"
 	"api-gateway/internal/handlers"
+	"api-gateway/internal/logging"
+	"api-gateway/internal/metrics"
 	"api-gateway/internal/routes"
+	"api-gateway/internal/tracing"
+	"context"
+	"errors"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 // @title Sphere Swagger
@@ -19,11 +30,19 @@ import (
 // @in header
 // @name Authorization
 func main() {
-	router := gin.Default()
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		log.Fatalf("Failed to load config %v", err)
 	}
+	if err = logging.Init("api-gateway", cfg.Log.Level); err != nil {
+		log.Fatalf("Failed to set up logging: %v", err)
+	}
+	slog.Info("loaded config", "config", cfg.String())
+
+	router := gin.New()
+	if err = router.SetTrustedProxies(cfg.Proxies()); err != nil {
+		log.Fatalf("Invalid trusted proxies %q: %v", cfg.Server.TrustedProxies, err)
+	}
 
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowOrigins = []string{"*"}
@@ -32,14 +51,35 @@ func main() {
 	corsConfig.ExposeHeaders = []string{"Content-Length"}
 	corsConfig.AllowCredentials = true
 	router.Use(cors.New(corsConfig))
+	router.Use(metrics.Middleware, tracing.Middleware("api-gateway"), tracing.TraceHeader)
+	router.Use(logging.Middleware, logging.Recovery())
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	flushTraces, err := tracing.Init(ctx, "api-gateway", cfg.Tracing.Exporter, cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+	if err != nil {
+		logging.Fatal("failed to set up tracing", err)
+	}
+	defer flushTraces()
 
 	grpcClients := grpc.InitializeGrpcClients(cfg)
 	handlers.InitClients(grpcClients)
-	routes.SetUpRoutes(router, grpcClients.UserClient)
+	routes.SetUpRoutes(router, grpcClients.UserClient, grpcClients.EducationClient)
+
+	server := &http.Server{Addr: ":" + cfg.Server.Port, Handler: router}
+	go func() {
+		slog.Info("starting api gateway", "port", cfg.Server.Port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logging.Fatal("failed to start server", err)
+		}
+	}()
 
-	port := cfg.Server.Port
-	log.Printf("Starting api gateway on port %s", port)
-	if err = router.Run(":" + port); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	<-ctx.Done()
+	slog.Info("shutting down, waiting for running requests")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err = server.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("running requests did not finish in time", "error", err)
 	}
+	slog.Info("api gateway stopped")
 }
@@ -1,50 +1,75 @@
 package config
 
-import (
-	"gopkg.in/yaml.v3"
-	"os"
-)
+import "strings"
 
 type Config struct {
 	Server struct {
-		Port string `yaml:"port"`
+		Port string `yaml:"port" env:"SERVER_PORT,required"`
+		// TrustedProxies is a comma separated list of the addresses or CIDRs
+		// of the proxies in front of the gateway, whose X-Forwarded-For is
+		// believed. Empty trusts none and takes the client IP from the
+		// connection.
+		TrustedProxies string `yaml:"trustedProxies" env:"TRUSTED_PROXIES"`
 	} `yaml:"server"`
 
+	// Tracing selects where spans are sent: otlp ships them to the collector
+	// at Endpoint, stdout prints them for local runs, none disables it.
+	Tracing struct {
+		Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER"`
+		Endpoint    string  `yaml:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+		SampleRatio float64 `yaml:"sampleRatio" env:"TRACING_SAMPLE_RATIO"`
+	} `yaml:"tracing"`
+	// Log sets the lowest level logged: debug, info, warn or error.
+	Log struct {
+		Level string `yaml:"level" env:"LOG_LEVEL"`
+	} `yaml:"log"`
+
 	Grpc struct {
 		AuditingService struct {
-			Address string `yaml:"address"`
+			Address string `yaml:"address" env:"AUDITING_SERVICE_ADDRESS"`
 		} `yaml:"auditing_service"`
 
 		UserService struct {
-			Address string `yaml:"address"`
+			Address string `yaml:"address" env:"USER_SERVICE_ADDRESS,required"`
 		} `yaml:"user_service"`
 
 		EducationService struct {
-			Address string `yaml:"address"`
+			Address string `yaml:"address" env:"EDUCATION_SERVICE_ADDRESS,required"`
 		} `yaml:"education_service"`
 
 		LidService struct {
-			Address string `yaml:"address"`
+			Address string `yaml:"address" env:"LEAD_SERVICE_ADDRESS,required"`
 		} `yaml:"lid_service"`
 		FinanceService struct {
-			Address string `yaml:"address"`
+			Address string `yaml:"address" env:"FINANCE_SERVICE_ADDRESS,required"`
 		} `yaml:"finance_service"`
 	} `yaml:"grpc"`
 }
 
-func LoadConfig() (*Config, error) {
-	file, err := os.Open("config/config.yaml")
-	if err != nil {
-		return nil, err
+// Proxies lists the trusted proxies, nil when there are none.
+func (c Config) Proxies() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(c.Server.TrustedProxies, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
 	}
-	defer file.Close()
+	return proxies
+}
 
+// String masks the secrets, the config is safe to log.
+func (c Config) String() string {
+	return redact(c)
+}
+
+func LoadConfig() (*Config, error) {
 	var config Config
-	decoder := yaml.NewDecoder(file)
-	err = decoder.Decode(&config)
-	if err != nil {
+	config.Server.Port = "8080"
+	config.Tracing.Exporter = "none"
+	config.Tracing.SampleRatio = 1
+	config.Log.Level = "info"
+	if err := load(&config); err != nil {
 		return nil, err
 	}
-
 	return &config, nil
 }
@@ -19,6 +19,193 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/api/analytics/history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Daily snapshots of the platform metrics, for charts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "SUPER_CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "YYYY-MM-DD, 90 days back by default",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "YYYY-MM-DD, today by default",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.PlatformHistory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/analytics/metrics": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "MRR and ARR of the periods paid for today, companies by state, and per month the renewal and churn rates and the retention of the companies that first paid in it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "SUPER_CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First month reported, YYYY-MM-DD (twelve months back by default)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last month reported, YYYY-MM-DD (this month by default)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.PlatformMetrics"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/analytics/tenants": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Usage and health score of every company, least healthy first. Students, groups, staff, leads and payments are gathered from all services",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "SUPER_CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "HEALTHY, AT_RISK or CRITICAL",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.TenantHealthList"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/analytics/tenants/{companyId}/history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Daily snapshots of the usage and health of a company",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "SUPER_CEO",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Company id",
+                        "name": "companyId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "YYYY-MM-DD, 90 days back by default",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "YYYY-MM-DD, today by default",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.TenantHealthList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/api/attendance/get-attendance": {
             "post": {
                 "security": [
@@ -135,9 +322,7 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "integer"
-                            }
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -395,40 +580,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/create": {
-            "post": {
+        "/api/company/billing/invoices": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new company",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Invoices of a company",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "billing"
                 ],
                 "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Company creation request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateCompanyRequest"
-                        }
+                        "type": "string",
+                        "description": "Company id",
+                        "name": "companyId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OPEN, PAID or VOID",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.InvoiceList"
                         }
                     },
                     "400": {
@@ -438,16 +636,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/company/finance/create": {
+            },
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new company finance record",
+                "description": "Issue an invoice to a company. Payments recorded with its invoiceId, or of exactly its open amount, settle it",
                 "consumes": [
                     "application/json"
                 ],
@@ -455,29 +651,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "billing"
                 ],
-                "summary": "Create a finance record",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Company finance details",
+                        "description": "companyId, tariffId, months and an optional promoCode",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinance"
+                            "$ref": "#/definitions/pb.QuoteRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "created",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.Invoice"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -485,42 +681,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/delete": {
+        "/api/company/billing/invoices/{id}": {
             "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a company finance record by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Void an open invoice nothing was paid on yet",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "billing"
                 ],
-                "summary": "Delete a finance record",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID of the company finance record to delete",
+                        "description": "Invoice id",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "deleted",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "403": {
+                        "description": "Invoice is paid or void",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -528,14 +721,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/get-all": {
-            "post": {
+        "/api/company/billing/override": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of all company finance records",
+                "description": "Give a company its own monthly price and/or a percent off, optionally until a date",
                 "consumes": [
                     "application/json"
                 ],
@@ -543,29 +736,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "billing"
                 ],
-                "summary": "Get all finance records",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Pagination details",
+                        "description": "Price override",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.PageRequest"
+                            "$ref": "#/definitions/pb.PriceOverride"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "list of finance records",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinanceList"
+                            "$ref": "#/definitions/pb.PriceOverride"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -573,44 +766,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/get-by-company": {
-            "post": {
+        "/api/company/billing/override/{companyId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve finance records filtered by company",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Price override of a company",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "billing"
                 ],
-                "summary": "Get finance records by company",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Pagination and filtering details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.PageRequest"
-                        }
+                        "type": "string",
+                        "description": "Company id",
+                        "name": "companyId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "list of finance records for a specific company",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinanceSelfList"
+                            "$ref": "#/definitions/pb.PriceOverride"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -618,14 +806,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/update": {
-            "put": {
+        "/api/company/billing/promo-codes": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update finance details for a specific company",
+                "description": "List promo codes with how often they were used",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "billing"
+                ],
+                "summary": "SUPER_CEO",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.PromoCodeList"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a promo code taking either a percent or a fixed amount off, optionally for one tariff, a date range and a number of uses",
                 "consumes": [
                     "application/json"
                 ],
@@ -633,29 +844,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "billing"
                 ],
-                "summary": "Update finance records by company",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Finance details to be updated",
+                        "description": "Promo code",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinance"
+                            "$ref": "#/definitions/pb.PromoCode"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated finance details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinance"
+                            "$ref": "#/definitions/pb.PromoCode"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Code exists",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -663,53 +880,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/get-all": {
-            "get": {
+        "/api/company/billing/promo-codes/{code}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a paginated list of all companies",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Stop a promo code from being used, invoices already issued with it keep their price",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "billing"
                 ],
                 "summary": "SUPER_CEO",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "size",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "filter only accept (active , no_active , demo)",
-                        "name": "filter",
-                        "in": "query"
+                        "description": "Promo code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -717,13 +920,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/get-statistic": {
+        "/api/company/billing/quote": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Price of renewing a company for some months on a tariff, with prepay, company and promo code discounts",
                 "consumes": [
                     "application/json"
                 ],
@@ -731,29 +935,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "billing"
                 ],
                 "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Параметры запроса статистики компании",
+                        "description": "companyId, tariffId, months and an optional promoCode",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetStatisticRequest"
+                            "$ref": "#/definitions/pb.QuoteRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Успешный ответ со статистикой",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetStatisticResponse"
+                            "$ref": "#/definitions/pb.TariffQuote"
                         }
                     },
                     "400": {
-                        "description": "Неверный запрос",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -761,9 +965,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/subdomain/{domain}": {
-            "get": {
-                "description": "Retrieve the data company by domain",
+        "/api/company/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new company",
                 "consumes": [
                     "application/json"
                 ],
@@ -773,41 +982,42 @@ const docTemplate = `{
                 "tags": [
                     "company"
                 ],
-                "summary": "ALL",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Start date of the period",
-                        "name": "domain",
-                        "in": "path",
-                        "required": true
+                        "description": "Company creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateCompanyRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetCompanyResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/company/tariff/create": {
+        "/api/company/finance/create": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new tariff with the provided details",
+                "description": "Create a new company finance record",
                 "consumes": [
                     "application/json"
                 ],
@@ -815,17 +1025,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tariff"
+                    "companyFinance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "Create a finance record",
                 "parameters": [
                     {
-                        "description": "Tariff data",
-                        "name": "tariff",
+                        "description": "Company finance details",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.Tariff"
+                            "$ref": "#/definitions/pb.CompanyFinance"
                         }
                     }
                 ],
@@ -845,14 +1055,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/tariff/delete/{id}": {
+        "/api/company/finance/delete": {
             "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a tariff by its ID",
+                "description": "Delete a company finance record by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -860,15 +1070,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tariff"
+                    "companyFinance"
                 ],
-                "summary": "Delete a tariff",
+                "summary": "Delete a finance record",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID of the tariff to delete",
+                        "type": "string",
+                        "description": "ID of the company finance record to delete",
                         "name": "id",
-                        "in": "path",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -884,24 +1094,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Tariff not found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
                     }
                 }
             }
         },
-        "/api/company/tariff/get-all": {
-            "get": {
+        "/api/company/finance/get-all": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of all tariffs",
+                "description": "Retrieve a list of all company finance records",
                 "consumes": [
                     "application/json"
                 ],
@@ -909,18 +1113,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tariff"
+                    "companyFinance"
+                ],
+                "summary": "Get all finance records",
+                "parameters": [
+                    {
+                        "description": "Pagination details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.PageRequest"
+                        }
+                    }
                 ],
-                "summary": "SUPER_CEO",
                 "responses": {
                     "200": {
-                        "description": "List of tariffs",
+                        "description": "list of finance records",
                         "schema": {
-                            "$ref": "#/definitions/pb.TariffList"
+                            "$ref": "#/definitions/pb.CompanyFinanceList"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -928,14 +1143,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/tariff/update": {
-            "put": {
+        "/api/company/finance/get-by-company": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the details of an existing tariff",
+                "description": "Retrieve finance records filtered by company",
                 "consumes": [
                     "application/json"
                 ],
@@ -943,25 +1158,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tariff"
+                    "companyFinance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "Get finance records by company",
                 "parameters": [
                     {
-                        "description": "Updated tariff data",
-                        "name": "tariff",
+                        "description": "Pagination and filtering details",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.Tariff"
+                            "$ref": "#/definitions/pb.PageRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "updated",
+                        "description": "list of finance records for a specific company",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.CompanyFinanceSelfList"
                         }
                     },
                     "400": {
@@ -973,14 +1188,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/update": {
+        "/api/company/finance/update": {
             "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing company by ID",
+                "description": "Update finance details for a specific company",
                 "consumes": [
                     "application/json"
                 ],
@@ -988,36 +1203,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "companyFinance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "Update finance records by company",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Company ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Company update request",
-                        "name": "request",
+                        "description": "Finance details to be updated",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.UpdateCompanyRequest"
+                            "$ref": "#/definitions/pb.CompanyFinance"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Updated finance details",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.CompanyFinance"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1025,14 +1233,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/course/create": {
-            "post": {
+        "/api/company/get-all": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new course based on the provided request data",
+                "description": "Retrieve a paginated list of all companies",
                 "consumes": [
                     "application/json"
                 ],
@@ -1040,35 +1248,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "company"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Request to create a course",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateCourseRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter only accept (active , no_active , demo)",
+                        "name": "filter",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1076,26 +1287,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/course/delete/{id}": {
-            "delete": {
+        "/api/company/get-statistic": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a course by its ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "company"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "SUPER_CEO",
+                "parameters": [
+                    {
+                        "description": "Параметры запроса статистики компании",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetStatisticRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ со статистикой",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetStatisticResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/company/subdomain/{domain}": {
+            "get": {
+                "description": "Retrieve the data company by domain",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "ALL",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Course ID",
-                        "name": "id",
+                        "description": "Start date of the period",
+                        "name": "domain",
                         "in": "path",
                         "required": true
                     }
@@ -1104,42 +1357,43 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetCompanyResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     }
                 }
             }
         },
-        "/api/course/get-all": {
+        "/api/company/subscription": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve all courses",
+                "description": "Subscription of the caller's company: ACTIVE, GRACE (read-only after the paid period) or LOCKED, and how many active students its tariff allows",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "company"
                 ],
                 "summary": "ALL",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetUpdateCourseAbs"
+                            "$ref": "#/definitions/pb.CompanySubscription"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1147,54 +1401,57 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/course/get-by-id/{id}": {
+        "/api/company/subscription/invoices": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves a course by its ID for admin users.",
+                "description": "Invoices of the caller's company",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "subscription"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Course ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "OPEN, PAID or VOID",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response with course details",
-                        "schema": {
-                            "$ref": "#/definitions/pb.AbsCourse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.InvoiceList"
                         }
                     }
                 }
-            }
-        },
-        "/api/course/update": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the details of an existing course based on the provided request data",
+                "description": "Issue an invoice for renewing the caller's company, priced like the quote. Paying it extends the valid date",
                 "consumes": [
                     "application/json"
                 ],
@@ -1202,17 +1459,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "subscription"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Request to update course",
-                        "name": "request",
+                        "description": "tariffId, months and an optional promoCode",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsCourse"
+                            "$ref": "#/definitions/pb.QuoteRequest"
                         }
                     }
                 ],
@@ -1220,7 +1477,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.Invoice"
                         }
                     },
                     "400": {
@@ -1228,24 +1485,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
                     }
                 }
             }
         },
-        "/api/expectation/create": {
+        "/api/company/subscription/quote": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new expectation.",
+                "description": "Price of renewing the caller's company for some months on a tariff, with prepay, company and promo code discounts, and the valid date it would get",
                 "consumes": [
                     "application/json"
                 ],
@@ -1253,33 +1504,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expectations"
+                    "subscription"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Title of the expectation",
-                        "name": "title",
-                        "in": "query",
-                        "required": true
+                        "description": "tariffId, months and an optional promoCode",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.QuoteRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Expectation created successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.TariffQuote"
                         }
                     },
-                    "409": {
-                        "description": "Conflict occurred",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Promo code cannot be used",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1287,14 +1540,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/expectation/delete/{id}": {
-            "delete": {
+        "/api/company/tariff/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete an expectation by ID.",
+                "description": "Create a new tariff with the provided details",
                 "consumes": [
                     "application/json"
                 ],
@@ -1302,33 +1555,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expectations"
+                    "tariff"
                 ],
-                "summary": "ADMIN",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Expectation ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Tariff data",
+                        "name": "tariff",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.Tariff"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Expectation deleted successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict occurred",
+                        "description": "created",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1336,14 +1585,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/expectation/update/{id}": {
-            "put": {
+        "/api/company/tariff/delete/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing expectation by ID.",
+                "description": "Delete a tariff by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1351,40 +1600,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expectations"
+                    "tariff"
                 ],
-                "summary": "ADMIN",
+                "summary": "Delete a tariff",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Expectation ID",
+                        "type": "integer",
+                        "description": "ID of the tariff to delete",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Title of the expectation",
-                        "name": "title",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Expectation updated successfully",
+                        "description": "deleted",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict occurred",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Tariff not found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1392,14 +1634,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/category/create": {
-            "post": {
+        "/api/company/tariff/get-all": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Creates a new category with the provided name and description",
+                "description": "Retrieve a list of all tariffs",
                 "consumes": [
                     "application/json"
                 ],
@@ -1407,35 +1649,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "category"
-                ],
-                "summary": "ADMIN , CEO",
-                "parameters": [
-                    {
-                        "description": "Category Data",
-                        "name": "category",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateCategoryRequest"
-                        }
-                    }
+                    "tariff"
                 ],
+                "summary": "SUPER_CEO",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "List of tariffs",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.TariffList"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1443,45 +1668,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/category/delete/{categoryId}": {
-            "delete": {
+        "/api/company/tariff/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deletes a category by its ID",
+                "description": "Update the details of an existing tariff",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "category"
+                    "tariff"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Category ID",
-                        "name": "categoryId",
-                        "in": "path",
-                        "required": true
+                        "description": "Updated tariff data",
+                        "name": "tariff",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.Tariff"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "updated",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1489,30 +1713,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/category/get-all": {
-            "get": {
+        "/api/company/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves all categories",
+                "description": "Update an existing company by ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "category"
+                    "company"
+                ],
+                "summary": "SUPER_CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Company update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateCompanyRequest"
+                        }
+                    }
                 ],
-                "summary": "ADMIN , CEO",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllCategoryRequest"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1520,14 +1765,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/discount/create": {
+        "/api/course/create": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Creates a new discount for a specified group and student",
+                "description": "Create a new course based on the provided request data",
                 "consumes": [
                     "application/json"
                 ],
@@ -1535,23 +1780,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discount"
+                    "courses"
                 ],
-                "summary": "CEO , ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Discount Request",
+                        "description": "Request to create a course",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsDiscountRequest"
+                            "$ref": "#/definitions/pb.CreateCourseRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response with status and message",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1571,43 +1816,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/discount/delete": {
+        "/api/course/delete/{id}": {
             "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deletes a discount for a specific group and student",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete a course by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "discount"
+                    "courses"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "query",
+                        "description": "Course ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response with status and message",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1621,42 +1856,70 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/discount/get-all-by-group/{groupId}": {
+        "/api/course/get-all": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves all discount information for a specific group ID",
-                "consumes": [
+                "description": "Retrieve all courses",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "courses"
+                ],
+                "summary": "ALL",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetUpdateCourseAbs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/course/get-by-id/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves a course by its ID for admin users.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "discount"
+                    "courses"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupId",
+                        "description": "Course ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response",
+                        "description": "Successful response with course details",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetInformationDiscountResponse"
+                            "$ref": "#/definitions/pb.AbsCourse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1664,14 +1927,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/discount/history/{userId}": {
-            "get": {
+        "/api/course/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves the discount history for a user by their ID",
+                "description": "Update the details of an existing course based on the provided request data",
                 "consumes": [
                     "application/json"
                 ],
@@ -1679,23 +1942,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discount"
+                    "courses"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "description": "Request to update course",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsCourse"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetHistoryDiscountResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
@@ -1713,40 +1978,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/expense/create": {
-            "post": {
+        "/api/debt-reminder/list": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Creates a new expense entry with details provided in the request body.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List debt reminders with the number sent, the delivery status of the last one and the recorded reply",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "expense"
+                    "debt-reminder"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Expense details",
-                        "name": "expense",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateExpenseRequest"
-                        }
+                        "type": "string",
+                        "description": "ACTIVE, CLEARED or STOPPED",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetDebtRemindersResponse"
                         }
                     },
                     "500": {
@@ -1758,28 +2029,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/expense/delete/{id}": {
-            "delete": {
+        "/api/debt-reminder/reply": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deletes an expense entry by ID.",
+                "description": "Record the debtor's reply (NONE, PROMISED, REFUSED, NO_ANSWER, WRONG_NUMBER). A promised date pauses reminders until it passes; stop closes the reminder",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "expense"
+                    "debt-reminder"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Expense ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Reply",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.SetDebtReminderReplyRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1789,8 +2065,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1798,74 +2074,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/expense/get-all-information/{from}/{to}": {
+        "/api/debt-reminder/settings": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves expenses with optional filters, pagination, and date range.",
+                "description": "Get the debt reminder campaign settings of the company",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "expense"
-                ],
-                "summary": "ADMIN , CEO",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Start date",
-                        "name": "from",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date",
-                        "name": "to",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "size",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ID to filter by user or creator",
-                        "name": "id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter type (USER or CATEGORY)",
-                        "name": "type",
-                        "in": "query"
-                    }
+                    "debt-reminder"
                 ],
+                "summary": "ADMIN , CEO , FINANCIST",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllExpenseResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request parameter",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.DebtReminderSettings"
                         }
                     },
                     "500": {
@@ -1875,16 +2103,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/finance/expense/get-chart-diagram/{from}/{to}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Queries the finance service for expense data between 'from' and 'to' dates, returning it as a chart diagram.",
+                "description": "Update debt reminders. Students whose balance drops below balanceBelow get the first reminder after overdueDays, then every cadenceDays, at most maxReminders times. The DEBT_REMINDER template uses {{.student}}, {{.debt}}, {{.balance}} and {{.days}}",
                 "consumes": [
                     "application/json"
                 ],
@@ -1892,34 +2118,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expense"
+                    "debt-reminder"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Start date for the chart (YYYY-MM-DD)",
-                        "name": "from",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date for the chart (YYYY-MM-DD)",
-                        "name": "to",
-                        "in": "path",
-                        "required": true
+                        "description": "Debt reminder settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.DebtReminderSettings"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Chart data successfully retrieved",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllExpenseDiagramResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict or error retrieving chart data",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1927,41 +2148,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/all-student-payments": {
+        "/api/expectation/create": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves a list of student payments between the provided 'from' and 'to' date parameters.",
+                "description": "Create a new expectation.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "expectations"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN",
                 "parameters": [
                     {
-                        "description": "get all student payment request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentPaymentsRequest"
-                        }
+                        "type": "string",
+                        "description": "Title of the expectation",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of payments",
+                        "description": "Expectation created successfully",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentPaymentsResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict error with an explanation",
+                        "description": "Conflict occurred",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1975,41 +2197,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/all-student-payments/chart": {
-            "post": {
+        "/api/expectation/delete/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Fetches payment data for students between the given 'from' and 'to' dates to be used in chart visualizations.",
+                "description": "Delete an expectation by ID.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "expectations"
                 ],
-                "summary": "ADMIN ,CEO",
+                "summary": "ADMIN",
                 "parameters": [
                     {
-                        "description": "get all student payment request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentPaymentsRequest"
-                        }
+                        "type": "string",
+                        "description": "Expectation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Chart data of payments",
+                        "description": "Expectation deleted successfully",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentPaymentsChartResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict error with an explanation",
+                        "description": "Conflict occurred",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2023,14 +2246,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/get-all-debts/{page}/{size}": {
-            "get": {
+        "/api/expectation/update/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves information about debts within the specified date range.",
+                "description": "Update an existing expectation by ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2038,62 +2261,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "expectations"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "page",
-                        "name": "page",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "size",
-                        "name": "size",
+                        "description": "Expectation ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "from",
-                        "name": "from",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "to",
-                        "name": "to",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "amountTo",
-                        "name": "amountTo",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "amountFrom",
-                        "name": "amountFrom",
+                        "description": "Title of the expectation",
+                        "name": "title",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response containing debt information",
+                        "description": "Expectation updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllDebtsInformationResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred while fetching debt information",
+                        "description": "Conflict occurred",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2107,46 +2302,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/get-all-payments/{studentId}/{month}": {
+        "/api/finance/cash/accounts": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all payment records for a student within a specified month.",
+                "description": "Cash desks, bank accounts and card terminals of the company with what is on each in its currency and its open shift",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "cash"
                 ],
-                "summary": "ADMIN , CEO",
-                "parameters": [
+                "summary": "ADMIN , CEO , FINANCIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.CashAccountList"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "path",
-                        "required": true
-                    },
+                        "Bearer": []
+                    }
+                ],
+                "description": "Adds a cash account. kind is CASH_DESK, BANK, CARD_TERMINAL or ONLINE, currency UZS or USD, method the payment method it takes. A default account receives the payments of its method that name no account. requiresShift refuses payments while no shift is open",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cash"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
                     {
-                        "type": "string",
-                        "description": "Month (format: YYYY-MM)",
-                        "name": "month",
-                        "in": "path",
-                        "required": true
+                        "description": "Cash account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CashAccount"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllPaymentsByMonthResponse"
+                            "$ref": "#/definitions/pb.CashAccount"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters or failed retrieval",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2154,14 +2370,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/payment-take-off/chart/{from}/{to}": {
-            "get": {
+        "/api/finance/cash/accounts/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a chart representation of take-off payments within a specified date range.",
+                "description": "Changes a cash account, isActive false closes it. The currency cannot change once money went through the account",
                 "consumes": [
                     "application/json"
                 ],
@@ -2169,40 +2385,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "cash"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date (format: YYYY-MM-DD)",
-                        "name": "from",
+                        "description": "Cash account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "End date (format: YYYY-MM-DD)",
-                        "name": "to",
-                        "in": "path",
-                        "required": true
+                        "description": "Cash account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CashAccount"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Chart data of take-off payments",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllPaymentTakeOffChartResponse"
+                            "$ref": "#/definitions/pb.CashAccount"
                         }
                     },
-                    "409": {
-                        "description": "Error message",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2210,14 +2428,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/payment-take-off/{from}/{to}": {
-            "get": {
+        "/api/finance/cash/accounts/{id}/shift/close": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve all take-off payments within a specified date range.",
+                "description": "Closes the open shift of a cash account with the money counted in it. The shift keeps what was expected, the opening count plus payments in less money out, and the difference to the count",
                 "consumes": [
                     "application/json"
                 ],
@@ -2225,40 +2443,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "cash"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date (format: YYYY-MM-DD)",
-                        "name": "from",
+                        "description": "Cash account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "End date (format: YYYY-MM-DD)",
-                        "name": "to",
-                        "in": "path",
-                        "required": true
+                        "description": "Counted money",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CashShiftRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of take-off payments",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllPaymentTakeOffResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Error message",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.CashShift"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "403": {
+                        "description": "No shift is open",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2266,14 +2480,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/student/add": {
+        "/api/finance/cash/accounts/{id}/shift/open": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Add a payment for a student",
+                "description": "Opens a shift on a cash account with the money counted in it",
                 "consumes": [
                     "application/json"
                 ],
@@ -2281,17 +2495,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "cash"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Payment Add Request",
+                        "type": "string",
+                        "description": "Cash account ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Counted money",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.PaymentAddRequest"
+                            "$ref": "#/definitions/pb.CashShiftRequest"
                         }
                     }
                 ],
@@ -2299,17 +2520,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.CashShift"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict",
+                        "description": "A shift is already open",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2317,54 +2538,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/student/get-monthly-status/{studentId}": {
+        "/api/finance/cash/rates": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get the monthly payment status for a student.",
+                "description": "Recorded exchange rates, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "cash"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "path",
-                        "required": true
+                        "description": "Currency",
+                        "name": "currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetMonthlyStatusResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request parameters or failed retrieval",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.ExchangeRateList"
                         }
                     }
                 }
-            }
-        },
-        "/api/finance/payment/student/return": {
+            },
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Return a payment for a student",
+                "description": "Records how many UZS one unit of a currency is from a day on, today by default. Payments and expenses of USD accounts are converted at the rate of their date",
                 "consumes": [
                     "application/json"
                 ],
@@ -2372,17 +2584,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "cash"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Payment Return Request",
+                        "description": "Exchange rate",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.PaymentReturnRequest"
+                            "$ref": "#/definitions/pb.ExchangeRate"
                         }
                     }
                 ],
@@ -2390,7 +2602,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.ExchangeRate"
                         }
                     },
                     "400": {
@@ -2398,24 +2610,65 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/finance/cash/shifts": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Shifts newest first, of one cash account or all of them. An open shift shows what is expected so far",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cash"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cash account ID",
+                        "name": "accountId",
+                        "in": "query"
                     },
-                    "409": {
-                        "description": "Conflict",
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.CashShiftList"
                         }
                     }
                 }
             }
         },
-        "/api/finance/payment/student/update": {
-            "patch": {
+        "/api/finance/category/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update a payment for a student",
+                "description": "Creates a new category with the provided name and description",
                 "consumes": [
                     "application/json"
                 ],
@@ -2423,17 +2676,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "category"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Payment Update Request",
-                        "name": "request",
+                        "description": "Category Data",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.PaymentUpdateRequest"
+                            "$ref": "#/definitions/pb.CreateCategoryRequest"
                         }
                     }
                 ],
@@ -2450,8 +2703,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2459,55 +2712,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/salary/calculate/{from}/{to}": {
-            "get": {
+        "/api/finance/category/delete/{categoryId}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Calculates the salary for a specified teacher within a given date range.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deletes a category by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "salary"
+                    "category"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date for the salary calculation period in YYYY-MM-DD format",
-                        "name": "from",
+                        "description": "Category ID",
+                        "name": "categoryId",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "End date for the salary calculation period in YYYY-MM-DD format",
-                        "name": "to",
-                        "in": "path",
-                        "required": true
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/category/get-all": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Teacher ID for whom the salary is calculated",
-                        "name": "teacherId",
-                        "in": "query"
+                        "Bearer": []
                     }
                 ],
+                "description": "Retrieves all categories",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category"
+                ],
+                "summary": "ADMIN , CEO",
                 "responses": {
                     "200": {
-                        "description": "Calculated salary information",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.CalculateTeacherSalaryResponse"
+                            "$ref": "#/definitions/pb.GetAllCategoryRequest"
                         }
                     },
-                    "409": {
-                        "description": "Conflict or calculation error",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2515,39 +2789,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/salary/delete/{teacherID}": {
-            "delete": {
+        "/api/finance/discount/applications": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deletes a salary entry for a specific teacher by ID",
+                "description": "History of discount rules applied by the monthly charge",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "salary"
+                    "discount"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Teacher ID",
-                        "name": "teacherID",
-                        "in": "path",
-                        "required": true
+                        "description": "Rule ID",
+                        "name": "ruleId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month, YYYY-MM",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Salary deleted successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.RuleApplicationList"
                         }
                     },
-                    "409": {
-                        "description": "Conflict error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2555,14 +2854,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/salary/teacher-add": {
+        "/api/finance/discount/create": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Adds a new salary entry for a specific teacher",
+                "description": "Creates a new discount for a specified group and student",
                 "consumes": [
                     "application/json"
                 ],
@@ -2570,35 +2869,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "salary"
+                    "discount"
                 ],
-                "summary": "CEO",
+                "summary": "CEO , ADMIN",
                 "parameters": [
                     {
-                        "description": "Salary details",
+                        "description": "Discount Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateTeacherSalaryRequest"
+                            "$ref": "#/definitions/pb.AbsDiscountRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Salary added successfully",
+                        "description": "Success response with status and message",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict error",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2606,30 +2905,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/salary/teacher-all": {
-            "get": {
+        "/api/finance/discount/delete": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves the salary information for all teachers",
+                "description": "Deletes a discount for a specific group and student",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "salary"
+                    "discount"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Discount ID, all discounts of the student in the group when empty",
+                        "name": "id",
+                        "in": "query"
+                    }
                 ],
-                "summary": "CEO",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success response with status and message",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetTeachersSalaryRequest"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2637,46 +2961,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/get-chart-income": {
+        "/api/finance/discount/get-all-by-group/{groupId}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get information about a income",
+                "description": "Retrieves all discount information for a specific group ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "discount"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "from",
-                        "name": "from",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "to",
-                        "name": "to",
-                        "in": "query",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success response",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetCommonInformationResponse"
+                            "$ref": "#/definitions/pb.GetInformationDiscountResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2684,36 +3004,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/get-table-groups": {
+        "/api/finance/discount/history/{userId}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get common information about company",
+                "description": "Retrieves the discount history for a user by their ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "discount"
                 ],
-                "summary": "ADMIN , CEO, TEACHER",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "dateType",
-                        "name": "dateType",
-                        "in": "query",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/pb.GetHistoryDiscountResponse"
                         }
                     },
                     "400": {
@@ -2721,18 +3043,47 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/group/create": {
-            "post": {
+        "/api/finance/discount/policy": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new group with provided details.",
+                "description": "How the discounts of a student are combined: STACK adds them up to maxPercent of the price, BEST takes the largest",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "discount"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.DiscountPolicy"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Sets how the discounts of a student are combined",
                 "consumes": [
                     "application/json"
                 ],
@@ -2740,35 +3091,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "discount"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Group Data",
-                        "name": "group",
+                        "description": "Discount policy",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateGroupRequest"
+                            "$ref": "#/definitions/pb.DiscountPolicy"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group successfully created",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.DiscountPolicy"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2776,80 +3121,63 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/delete/{id}": {
-            "delete": {
+        "/api/finance/discount/rules": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a group by its ID.",
+                "description": "Lists the discount rules of the company, active ones first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
-                ],
-                "summary": "ADMIN , CEO",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "discount"
                 ],
+                "summary": "ADMIN , CEO , FINANCIST",
                 "responses": {
                     "200": {
-                        "description": "Group successfully deleted",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.DiscountRuleList"
                         }
                     }
                 }
-            }
-        },
-        "/api/group/get-all": {
+            },
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of all groups.",
+                "description": "Creates a company discount rule. ruleType is SIBLING (minCount students sharing a parent contact), MULTI_GROUP (minCount active groups) or EARLY_PAYMENT (the month is covered by the balance when it is charged); discountType is FIXED or PERCENT",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "discount"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Group Data",
-                        "name": "group",
+                        "description": "Discount rule",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetGroupsRequest"
+                            "$ref": "#/definitions/pb.DiscountRule"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of groups",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/pb.GetGroupsResponse"
-                            }
+                            "$ref": "#/definitions/pb.DiscountRule"
                         }
                     },
                     "400": {
@@ -2857,75 +3185,85 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
                     }
                 }
             }
         },
-        "/api/group/get-by-course/{courseId}": {
-            "get": {
+        "/api/finance/discount/rules/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve groups associated with a specific course ID.",
+                "description": "Updates a company discount rule, isActive switches it on and off",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "discount"
                 ],
-                "summary": "ADMIN, TEACHER",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Course ID",
-                        "name": "courseId",
+                        "description": "Rule ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Discount rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.DiscountRule"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetGroupsByCourseResponse"
+                            "$ref": "#/definitions/pb.DiscountRule"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/group/get-by-id/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve details of a group by its ID.",
+                "description": "Deactivates a company discount rule, its applications stay in the history",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "discount"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
+                        "description": "Rule ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2933,13 +3271,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetGroupAbsResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2947,34 +3285,72 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/get-by-teacher/{teacherId}": {
-            "get": {
+        "/api/finance/expense/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get information about a specific teacher by their ID, with an option to filter archived data.",
-                "produces": [
+                "description": "Creates a new expense entry with details provided in the request body.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "expense"
                 ],
-                "summary": "ADMIN , TEACHER , CEO",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Teacher ID",
-                        "name": "teacherId",
-                        "in": "path",
-                        "required": true
+                        "description": "Expense details",
+                        "name": "expense",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateExpenseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/expense/delete/{id}": {
+            "delete": {
+                "security": [
                     {
-                        "type": "boolean",
-                        "description": "Whether to include archived information",
-                        "name": "isArchived",
-                        "in": "query",
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deletes an expense entry by ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -2982,11 +3358,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetGroupsByTeacherResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2994,51 +3370,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/left-after-trial/{from}/{to}": {
+        "/api/finance/expense/get-all-information/{from}/{to}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve the data left after the trial period based on the provided from date, to date, page, and page size",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves expenses with optional filters, pagination, and date range.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "expense"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date of the period",
+                        "description": "Start date",
                         "name": "from",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "End date of the period",
+                        "description": "End date",
                         "name": "to",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "default": "1",
+                        "type": "integer",
                         "description": "Page number",
                         "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID to filter by user or creator",
+                        "name": "id",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "10",
-                        "description": "Page size",
-                        "name": "page_size",
+                        "description": "Filter type (USER or CATEGORY)",
+                        "name": "type",
                         "in": "query"
                     }
                 ],
@@ -3046,22 +3431,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetLeftAfterTrialPeriodResponse"
+                            "$ref": "#/definitions/pb.GetAllExpenseResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid request parameter",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/group/transfer-date": {
-            "post": {
-                "description": "Transfers the lesson date for a course",
+        "/api/finance/expense/get-chart-diagram/{from}/{to}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Queries the finance service for expense data between 'from' and 'to' dates, returning it as a chart diagram.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3069,35 +3464,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "lesson"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Transfer Lesson Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.TransferLessonRequest"
-                        }
+                        "type": "string",
+                        "description": "Start date for the chart (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date for the chart (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Status and message",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
+                        "description": "Chart data successfully retrieved",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllExpenseDiagramResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict or error retrieving chart data",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3105,14 +3499,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/update": {
-            "put": {
+        "/api/finance/ledger/accounts": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update details of an existing group.",
+                "description": "The chart of accounts of the company with the balance of each, debit less credit. The system accounts are created on first use",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ledger"
+                ],
+                "summary": "CEO , FINANCIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.LedgerAccountList"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Adds an account to the chart. code is 3 to 10 digits, type ASSET, LIABILITY, EQUITY, INCOME or EXPENSE. Only an ASSET account can be isCash",
                 "consumes": [
                     "application/json"
                 ],
@@ -3120,35 +3537,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "ledger"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Group Data",
-                        "name": "group",
+                        "description": "Ledger account",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetUpdateGroupAbs"
+                            "$ref": "#/definitions/pb.LedgerAccount"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group successfully updated",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.LedgerAccount"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "The code is taken",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3156,42 +3573,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/history/group/{groupId}": {
+        "/api/finance/ledger/cash-flow": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get the history of a specific group by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Opening, money in, money out and closing of every cash account over a period, this month by default, and what the money came from or went to",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "ledger"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupId",
-                        "in": "path",
-                        "required": true
+                        "description": "From, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetHistoryGroupResponse"
+                            "$ref": "#/definitions/pb.CashFlow"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3199,129 +3618,119 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/history/student/{studentId}": {
+        "/api/finance/ledger/entries": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get the history of a specific student by their ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Journal entries newest first with their lines. Payments, charges, refunds and expenses post theirs on their own",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "ledger"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "STUDENT_PAYMENT, SPONSOR_PAYMENT, EXPENSE or MANUAL",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Account code",
+                        "name": "accountCode",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Student ID",
                         "name": "studentId",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetHistoryStudentResponse"
-                        }
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/image/get-image": {
-            "get": {
-                "description": "Retrieve an uploaded image by filename",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "image/jpeg",
-                    "image/png",
-                    "image/gif"
-                ],
-                "tags": [
-                    "image"
-                ],
-                "summary": "Get an uploaded image",
-                "parameters": [
                     {
-                        "type": "string",
-                        "description": "Filename of the image to retrieve",
-                        "name": "filename",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Image file"
-                    },
-                    "400": {
-                        "description": "Bad request, filename missing",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.JournalEntryList"
                         }
                     },
-                    "404": {
-                        "description": "Image not found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/image/upload": {
+            },
             "post": {
-                "description": "Upload an image file and save it to the server",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Posts an entry by hand, such as money the owners put in. It needs at least two lines, each with a debit or a credit, and debits must equal credits. The balances of students and sponsors are moved by their payments only",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "image"
+                    "ledger"
                 ],
-                "summary": "Upload an image",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Image file to upload",
-                        "name": "image",
-                        "in": "formData",
-                        "required": true
+                        "description": "Journal entry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.JournalEntry"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response with file URL",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.JournalEntry"
                         }
                     },
                     "400": {
-                        "description": "Bad request or file error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3329,14 +3738,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/create": {
+        "/api/finance/ledger/entries/{id}/reverse": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new lead with the given title.",
+                "description": "Reverses an entry posted by hand with one that swaps its debits and credits. Entries of payments and expenses are reversed by returning or deleting those",
                 "consumes": [
                     "application/json"
                 ],
@@ -3344,33 +3753,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "ledger"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Title of the lead",
-                        "name": "title",
-                        "in": "query",
+                        "description": "Journal entry ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Reason and date of the reversal",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ReverseJournalEntryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead successfully created",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.JournalEntry"
                         }
                     },
-                    "409": {
-                        "description": "Lead creation conflict",
+                    "403": {
+                        "description": "Not an entry made by hand, or already reversed",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3378,48 +3796,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/delete/{id}": {
-            "delete": {
+        "/api/finance/ledger/profit-and-loss": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a lead by ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Income and expenses by account over a period, this month by default, and the profit. The income chart and the expense diagram show the same figures",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "ledger"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Lead ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "From, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead deleted successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict occurred",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.ProfitAndLoss"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3427,29 +3841,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/get-all": {
+        "/api/finance/ledger/trial-balance": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the data associated with a lead",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Debits and credits of every account up to a day, today by default. The totals are equal",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "ledger"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Date, YYYY-MM-DD",
+                        "name": "date",
+                        "in": "query"
+                    }
                 ],
-                "summary": "ALL",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetLeadListResponse"
+                            "$ref": "#/definitions/pb.TrialBalance"
                         }
                     },
                     "400": {
@@ -3457,30 +3876,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
                     }
                 }
             }
         },
-        "/api/lead/get-lead-common": {
+        "/api/finance/payment-plan": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get a common lead by ID and type.",
+                "description": "Splits what a student owes for a group into installments: an explicit schedule, or total split evenly over installments intervalMonths apart from firstDueDate. Payments of the student are matched to the installments, the group's plan first. lateFeeType FIXED or PERCENT charges a fee once per installment overdue past graceDays",
                 "consumes": [
                     "application/json"
                 ],
@@ -3488,25 +3895,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "payment-plan"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Lead ID",
-                        "name": "req",
+                        "description": "Payment plan",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetLeadCommonRequest"
+                            "$ref": "#/definitions/pb.CreatePaymentPlanRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead details retrieved",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetLeadCommonResponse"
+                            "$ref": "#/definitions/pb.PaymentPlan"
                         }
                     },
                     "400": {
@@ -3515,8 +3922,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "The student already has a running plan in the group",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3524,64 +3931,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/get-lead-reports": {
+        "/api/finance/payment-plan/overdue": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves lead reports for a given date range.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Unpaid installments of running plans past their due date and grace days, longest overdue first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "payment-plan"
                 ],
-                "summary": "CEO , ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Start date in YYYY-MM-DD format",
-                        "name": "from",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "End date in YYYY-MM-DD format",
-                        "name": "till",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Size",
+                        "name": "size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead reports response",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict error with details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.OverdueInstallmentList"
                         }
                     }
                 }
             }
         },
-        "/api/lead/update/{id}": {
-            "put": {
+        "/api/finance/payment-plan/preview": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing lead by ID.",
+                "description": "Shows the installments a payment plan would have without creating it",
                 "consumes": [
                     "application/json"
                 ],
@@ -3589,40 +3987,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "payment-plan"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Lead ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Title of the lead",
-                        "name": "title",
-                        "in": "query",
-                        "required": true
+                        "description": "Payment plan",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreatePaymentPlanRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead updated successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict occurred",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.PaymentPlan"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3630,107 +4017,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/leadData/change-lead-data": {
-            "patch": {
+        "/api/finance/payment-plan/student/{studentId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the data associated with a lead",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
+                "description": "Where each payment plan of a student stands: ON_TRACK, OVERDUE, COMPLETED or CANCELLED, what is paid, overdue and due next",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leadData"
+                    "payment-plan"
                 ],
-                "summary": "Change lead data",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Lead change request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.ChangeLeadPlaceRequest"
-                        }
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.PaymentPlanStatusList"
                         }
                     }
                 }
             }
         },
-        "/api/leadData/create": {
-            "post": {
+        "/api/finance/payment-plan/{id}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create lead data.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "A payment plan with its installments and the payments matched to each of them",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leadData"
+                    "payment-plan"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Lead data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateLeadDataRequest"
-                        }
+                        "type": "string",
+                        "description": "Payment plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead data created successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict occurred",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.PaymentPlan"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3738,48 +4091,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/leadData/delete/{id}": {
-            "delete": {
+        "/api/finance/payment-plan/{id}/cancel": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete lead data by ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Cancels a running payment plan, payments already matched to it stay matched",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leadData"
+                    "payment-plan"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Lead data ID",
+                        "description": "Payment plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason",
+                        "name": "reason",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead data deleted successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.PaymentPlan"
                         }
                     },
-                    "409": {
-                        "description": "Conflict occurred",
+                    "403": {
+                        "description": "The plan is not running",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3787,44 +4143,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/leadData/update": {
-            "put": {
+        "/api/finance/payment/all-student-payments": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update lead data by ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves a list of student payments between the provided 'from' and 'to' date parameters.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leadData"
+                    "payments"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Lead data",
-                        "name": "data",
+                        "description": "get all student payment request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.UpdateLeadDataRequest"
+                            "$ref": "#/definitions/pb.GetAllStudentPaymentsRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead data updated successfully",
+                        "description": "List of payments",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllStudentPaymentsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
+                        "description": "Conflict error with an explanation",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3838,50 +4191,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/room/create": {
+        "/api/finance/payment/all-student-payments/chart": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new room based on the provided request data",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Fetches payment data for students between the given 'from' and 'to' dates to be used in chart visualizations.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "rooms"
+                    "payments"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN ,CEO",
                 "parameters": [
                     {
-                        "description": "Request to create a room",
+                        "description": "get all student payment request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateRoomRequest"
+                            "$ref": "#/definitions/pb.GetAllStudentPaymentsRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Chart data of payments",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllStudentPaymentsChartResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict error with an explanation",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3889,70 +4239,69 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/room/delete/{id}": {
-            "delete": {
+        "/api/finance/payment/balance-events/failed": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a room by its ID",
+                "description": "Balance changes education-service rejected. Until they are retried the students' balances do not include them",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "rooms"
-                ],
-                "summary": "ADMIN , CEO",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Room ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "payments"
                 ],
+                "summary": "CEO , FINANCIST",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.BalanceEventList"
                         }
                     }
                 }
             }
         },
-        "/api/room/get-all": {
-            "get": {
+        "/api/finance/payment/balance-events/retry": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve all rooms",
+                "description": "Sends the given failed balance changes again, all of them when ids is empty",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "rooms"
+                    "payments"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Event ids",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.RetryBalanceEventsRequest"
+                        }
+                    }
                 ],
-                "summary": "ADMIN , CEO",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetUpdateRoomAbs"
+                            "$ref": "#/definitions/pb.RetryBalanceEventsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3960,14 +4309,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/room/update": {
-            "put": {
+        "/api/finance/payment/get-all-debts/{page}/{size}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the details of an existing room based on the provided request data",
+                "description": "Retrieves information about debts within the specified date range. debts are owed by the students' families, sponsorDebts by sponsors.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3975,41 +4324,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rooms"
+                    "payments"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "capacity",
-                        "in": "query"
+                        "type": "string",
+                        "description": "page",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "name": "id",
-                        "in": "query"
+                        "description": "size",
+                        "name": "size",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "name": "name",
-                        "in": "query"
+                        "description": "from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "to",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "amountTo",
+                        "name": "amountTo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "amountFrom",
+                        "name": "amountFrom",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success response containing debt information",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllDebtsInformationResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "409": {
+                        "description": "Conflict occurred while fetching debt information",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4017,50 +4393,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/change-to-group": {
-            "patch": {
+        "/api/finance/payment/get-all-payments/{studentId}/{month}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Change the lead set to a group based on the provided request data",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get all payment records for a student within a specified month.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "payments"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Request to change set to group",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.ChangeToSetRequest"
-                        }
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month (format: YYYY-MM)",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllPaymentsByMonthResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
+                        "description": "Invalid request parameters or failed retrieval",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4068,14 +4440,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/create": {
-            "post": {
+        "/api/finance/payment/payment-take-off/chart/{from}/{to}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new set.",
+                "description": "Retrieve a chart representation of take-off payments within a specified date range.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4083,84 +4455,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "payments"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Set data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateSetRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Set created successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict occurred",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
+                        "type": "string",
+                        "description": "Start date (format: YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/set/delete/{id}": {
-            "delete": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Delete a set by ID.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "sets"
-                ],
-                "summary": "ADMIN",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "Set ID",
-                        "name": "id",
+                        "description": "End date (format: YYYY-MM-DD)",
+                        "name": "to",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Set deleted successfully",
+                        "description": "Chart data of take-off payments",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllPaymentTakeOffChartResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
+                        "description": "Error message",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4168,14 +4496,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/get-by-id/{id}": {
+        "/api/finance/payment/payment-take-off/{from}/{to}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves lead details based on the provided ID.",
+                "description": "Retrieve all take-off payments within a specified date range.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4183,33 +4511,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "payments"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Lead ID",
-                        "name": "id",
+                        "description": "Start date (format: YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (format: YYYY-MM-DD)",
+                        "name": "to",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response with lead details",
+                        "description": "List of take-off payments",
                         "schema": {
-                            "$ref": "#/definitions/pb.SetDataResponse"
+                            "$ref": "#/definitions/pb.GetAllPaymentTakeOffResponse"
                         }
                     },
-                    "404": {
-                        "description": "Lead not found",
+                    "409": {
+                        "description": "Error message",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict error with details",
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4217,14 +4552,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/update": {
-            "put": {
+        "/api/finance/payment/student/add": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing set by ID.",
+                "description": "Add a payment for a student",
                 "consumes": [
                     "application/json"
                 ],
@@ -4232,41 +4567,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "payments"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Set data",
-                        "name": "data",
+                        "description": "Payment Add Request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.UpdateSetRequest"
+                            "$ref": "#/definitions/pb.PaymentAddRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Set updated successfully",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4274,46 +4603,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/add-to-group": {
-            "post": {
+        "/api/finance/payment/student/get-monthly-status/{studentId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Get the monthly payment status for a student.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "payments"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Add student to group details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.AddToGroupRequest"
-                        }
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetMonthlyStatusResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Invalid request parameters or failed retrieval",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4321,14 +4643,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/change-condition": {
-            "put": {
+        "/api/finance/payment/student/return": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Changes the condition of a student based on provided details",
+                "description": "Return a payment for a student. The payment stays in the ledger and a linked reversal entry with the reason is added",
                 "consumes": [
                     "application/json"
                 ],
@@ -4336,35 +4658,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "payments"
                 ],
-                "summary": "ADMIN, CEO",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Change Condition Student Request",
+                        "description": "Payment Return Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.ChangeConditionStudentRequest"
+                            "$ref": "#/definitions/pb.PaymentReturnRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Status and message",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4372,46 +4694,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/create": {
-            "post": {
+        "/api/finance/payment/student/update": {
+            "patch": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Update a payment for a student. The original payment is reversed and a new entry with the edited values is added",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "payments"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Student details",
+                        "description": "Payment Update Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateStudentRequest"
+                            "$ref": "#/definitions/pb.PaymentUpdateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Created student details",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4419,92 +4745,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/delete/{id}": {
-            "delete": {
+        "/api/finance/salary/calculate/{from}/{to}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Calculates the salary for a specified teacher within a given date range.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "salary"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Start date for the salary calculation period in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Flag to determine if money should be returned",
-                        "name": "returnMoney",
-                        "in": "query",
+                        "type": "string",
+                        "description": "End date for the salary calculation period in YYYY-MM-DD format",
+                        "name": "to",
+                        "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Delete success message",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/student/get-all": {
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "students"
-                ],
-                "summary": "ADMIN",
-                "parameters": [
                     {
-                        "description": "filter students",
-                        "name": "filter",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentRequest"
-                        }
+                        "type": "string",
+                        "description": "Teacher ID for whom the salary is calculated",
+                        "name": "teacherId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of students",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid condition",
+                        "description": "Calculated salary information",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.CalculateTeacherSalaryResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict or calculation error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4512,44 +4801,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/get-student-by-id/{studentId}": {
-            "get": {
+        "/api/finance/salary/delete/{teacherID}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Deletes a salary entry for a specific teacher by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "salary"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
+                        "description": "Teacher ID",
+                        "name": "teacherID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of students",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid condition",
+                        "description": "Salary deleted successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4557,14 +4841,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/note/create": {
+        "/api/finance/salary/teacher-add": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new note associated with a specific student",
+                "description": "Adds a new salary entry for a specific teacher",
                 "consumes": [
                     "application/json"
                 ],
@@ -4572,35 +4856,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "salary"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO",
                 "parameters": [
                     {
-                        "description": "Note details",
+                        "description": "Salary details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateNoteRequest"
+                            "$ref": "#/definitions/pb.CreateTeacherSalaryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Salary added successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid request body",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4608,42 +4892,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/note/delete/{noteId}": {
-            "delete": {
+        "/api/finance/salary/teacher-all": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a specific note associated with a student",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the salary information for all teachers",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
-                ],
-                "summary": "ADMIN",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Note ID",
-                        "name": "noteId",
-                        "in": "path",
-                        "required": true
-                    }
+                    "salary"
                 ],
+                "summary": "CEO",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetTeachersSalaryRequest"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4651,57 +4923,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/note/get-notes/{studentId}": {
+        "/api/finance/sponsor": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all notes associated with a specific student",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the sponsors of the company with their balances and the number of students they cover today",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
-                ],
-                "summary": "ADMIN",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "path",
-                        "required": true
-                    }
+                    "sponsor"
                 ],
+                "summary": "ADMIN , CEO , FINANCIST",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetNotesByStudent"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.SponsorList"
                         }
                     }
                 }
-            }
-        },
-        "/api/student/search-student/{value}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Search for students by phone number or name",
+                "description": "Creates a sponsor, a payer account covering part of some students' tuition",
                 "consumes": [
                     "application/json"
                 ],
@@ -4709,27 +4961,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "sponsor"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search value (phone number or name)",
-                        "name": "value",
-                        "in": "path",
-                        "required": true
+                        "description": "Sponsor",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.Sponsor"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.SearchStudentResponse"
+                            "$ref": "#/definitions/pb.Sponsor"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4737,46 +4991,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/update": {
-            "put": {
+        "/api/finance/sponsor/payments": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Records money a sponsor paid in",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "sponsor"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Updated student details",
+                        "description": "Sponsor payment",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.UpdateStudentRequest"
+                            "$ref": "#/definitions/pb.SponsorPaymentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Update success message",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4784,14 +5036,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/create": {
+        "/api/finance/sponsor/payments/return": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new user",
+                "description": "Returns a sponsor's payment. A linked reversal entry is added, covered charges go back with the refund of the student's month",
                 "consumes": [
                     "application/json"
                 ],
@@ -4799,35 +5051,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "sponsor"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "user",
+                        "description": "Sponsor payment return",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateUserRequest"
+                            "$ref": "#/definitions/pb.SponsorPaymentReturnRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created user",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "403": {
+                        "description": "Already returned or not a payment",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4835,51 +5087,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/delete/{userId}": {
-            "delete": {
+        "/api/finance/sponsor/sponsorships": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a user from the system using their ID",
+                "description": "Lists sponsorships, of a sponsor or of a student",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "user"
+                    "sponsor"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "description": "Sponsor ID",
+                        "name": "sponsorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.SponsorshipList"
                         }
                     }
                 }
-            }
-        },
-        "/api/user/get-all-employee/{isArchived}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves a list of all employees based on archive status. Restricted to ADMIN and CEO roles.",
+                "description": "Makes a sponsor cover part of a student's monthly charges, in one group or in all of them when groupId is empty. coverType PERCENT takes a percent of each charge, FIXED up to an amount of it",
                 "consumes": [
                     "application/json"
                 ],
@@ -4887,39 +5139,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "sponsor"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Filter by archive status (true=archived, false=active)",
-                        "name": "isArchived",
-                        "in": "path",
-                        "required": true
+                        "description": "Sponsorship",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.Sponsorship"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllEmployeeResponse"
+                            "$ref": "#/definitions/pb.Sponsorship"
                         }
                     },
                     "400": {
-                        "description": "Invalid input or processing error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized access",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "408": {
-                        "description": "Request timeout",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4927,51 +5175,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/get-all-staff/{isArchived}": {
-            "get": {
+        "/api/finance/sponsor/sponsorships/{id}/end": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of all staff members, filtered by their archived status.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Ends a sponsorship after a day, today when endAt is not given",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "sponsor"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Boolean to filter archived or active staff; true for archived, false for active",
-                        "name": "isArchived",
+                        "type": "string",
+                        "description": "Sponsorship ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day covered, YYYY-MM-DD",
+                        "name": "endAt",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of staff members based on archived status",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/pb.GetAllStuffResponse"
-                            }
+                            "$ref": "#/definitions/pb.Sponsorship"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid 'isArchived' parameter",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error during data retrieval",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4979,14 +5227,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/get-my-profile": {
-            "get": {
+        "/api/finance/sponsor/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve the information of the authenticated user. Admin and CEO roles receive basic user info, while Teachers get additional group information.",
+                "description": "Updates a sponsor, isActive switches the split of its students' charges on and off",
                 "consumes": [
                     "application/json"
                 ],
@@ -4994,74 +5242,80 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "sponsor"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sponsor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Sponsor",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.Sponsor"
+                        }
+                    }
                 ],
-                "summary": "ADMIN , CEO , TEACHER",
                 "responses": {
                     "200": {
-                        "description": "User information with optional groups for Teachers",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/pb.Sponsor"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized - Invalid or missing authentication",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error while retrieving teacher's group information",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/user/get-teachers/{isDeleted}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Fetches a list of teachers based on the deletion status",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deactivates a sponsor, its ledger stays",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "sponsor"
                 ],
-                "summary": "ADMIN,CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Deletion status (true/false)",
-                        "name": "isDeleted",
+                        "type": "string",
+                        "description": "Sponsor ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of teachers",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetTeachersResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5069,30 +5323,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/get-user/{userId}": {
+        "/api/finance/sponsor/{id}/statement": {
             "get": {
-                "description": "Retrieve user details by their unique user ID.",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Statement of a sponsor over a period, this month by default: the opening and closing balance, what it paid, the charges it covered and the students they were for",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "sponsor"
                 ],
-                "summary": "CEO , ADMIN , TEACHER",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Sponsor ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetUserByIdResponse"
+                            "$ref": "#/definitions/pb.SponsorStatement"
                         }
                     },
                     "400": {
@@ -5100,28 +5371,44 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/user/history/{userId}": {
+        "/api/get-chart-income": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Fetches the history data for a given user ID.",
+                "description": "Get information about a income",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "user"
+                    "education"
                 ],
-                "summary": "ADMIN, CEO, TEACHER",
+                "summary": "CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
+                        "description": "from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "to",
+                        "name": "to",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -5129,7 +5416,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetHistoryByUserIdResponse"
+                            "$ref": "#/definitions/pb.GetCommonInformationResponse"
                         }
                     },
                     "400": {
@@ -5141,39 +5428,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/login": {
-            "post": {
-                "description": "Authenticate a user and return a token upon successful login.",
-                "consumes": [
-                    "application/json"
+        "/api/get-table-groups": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
+                "description": "Get common information about company",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "education"
                 ],
-                "summary": "ALL",
+                "summary": "ADMIN , CEO, TEACHER",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "LoginRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "dateType",
+                        "name": "dateType",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful login",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.LoginResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid JSON or login failure",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5181,9 +5469,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/update": {
-            "patch": {
-                "description": "Update user details using their ID",
+        "/api/group/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new group with provided details.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5191,29 +5484,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "groups"
                 ],
-                "summary": "ADMIN , CEO , TEACHER",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "User Details",
-                        "name": "user",
+                        "description": "Group Data",
+                        "name": "group",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.UpdateUserRequest"
+                            "$ref": "#/definitions/pb.CreateGroupRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Group successfully created",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5221,84 +5520,5066 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/update-password/{userId}/{password}": {
-            "put": {
+        "/api/group/delete/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Updates the password of a user specified by the userId.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete a group by its ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "groups"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "New Password",
-                        "name": "password",
+                        "description": "Group ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Password updated successfully",
+                        "description": "Group successfully deleted",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/group/get-all": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve a list of all groups.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Group Data",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of groups",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pb.GetGroupsResponse"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "pb.AbsCalculateSalary": {
+        },
+        "/api/group/get-by-course/{courseId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve groups associated with a specific course ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN, TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Course ID",
+                        "name": "courseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group details",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupsByCourseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/get-by-id/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve details of a group by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group details",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupAbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/get-by-teacher/{teacherId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get information about a specific teacher by their ID, with an option to filter archived data.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , TEACHER , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether to include archived information",
+                        "name": "isArchived",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupsByTeacherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/left-after-trial/{from}/{to}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve the data left after the trial period based on the provided from date, to date, page, and page size",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "education"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date of the period",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date of the period",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "1",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLeftAfterTrialPeriodResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/transfer-date": {
+            "post": {
+                "description": "Transfers the lesson date for a course",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lesson"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Transfer Lesson Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.TransferLessonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status and message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update details of an existing group.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Group Data",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetUpdateGroupAbs"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group successfully updated",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/history/group/{groupId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the history of a specific group by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetHistoryGroupResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/history/student/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the history of a specific student by their ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetHistoryStudentResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/image/get-image": {
+            "get": {
+                "description": "Retrieve an uploaded image by filename",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif"
+                ],
+                "tags": [
+                    "image"
+                ],
+                "summary": "Get an uploaded image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filename of the image to retrieve",
+                        "name": "filename",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image file"
+                    },
+                    "400": {
+                        "description": "Bad request, filename missing",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/image/upload": {
+            "post": {
+                "description": "Upload an image file and save it to the server",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "image"
+                ],
+                "summary": "Upload an image",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Image file to upload",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success response with file URL",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or file error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new lead with the given title.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Title of the lead",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Lead creation conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a lead by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update the data associated with a lead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ALL",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLeadListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/get-lead-common": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a common lead by ID and type.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Lead ID",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLeadCommonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead details retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLeadCommonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/get-lead-reports": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves lead reports for a given date range.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "CEO , ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date in YYYY-MM-DD format",
+                        "name": "till",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead reports response",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict error with details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/update/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update an existing lead by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Title of the lead",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/change-lead-data": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update the data associated with a lead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "Change lead data",
+                "parameters": [
+                    {
+                        "description": "Lead change request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ChangeLeadPlaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create lead data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Lead data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateLeadDataRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead data created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete lead data by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead data ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead data deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/merge": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Merge a duplicated lead into another one, the source lead is removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Lead merge request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.MergeLeadDataRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update lead data by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Lead data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateLeadDataRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead data updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadForm/get": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the public lead form configuration of the company.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadForm"
+                ],
+                "summary": "ADMIN , CEO",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.LeadForm"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict error with details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadForm/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create or update the public lead form: fields, target lead section, spam limits.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadForm"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Lead form",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.LeadForm"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification/outbox": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List queued and delivered notifications of the company",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PENDING, SENDING, SENT or FAILED",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetNotificationOutboxResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification/settings": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get notification language, enabled channels and the template of every event",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "ADMIN , CEO",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.NotificationSettings"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update notification settings. Templates use {{.student}}, {{.amount}}, {{.balance}}, {{.group}}, {{.from}}, {{.to}} and {{.date}}; an empty body restores the default text",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "description": "Notification settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.NotificationSettings"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/public/lead-form/{subdomain}": {
+            "get": {
+                "description": "Get the lead form of the company resolved by subdomain, used by landing pages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadForm"
+                ],
+                "summary": "ALL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company subdomain",
+                        "name": "subdomain",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.LeadForm"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Submit a lead from a marketing site. utm_* query parameters are used when the body has none.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadForm"
+                ],
+                "summary": "ALL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company subdomain",
+                        "name": "subdomain",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lead",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.PublicLeadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.PublicLeadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/public/lead-form/{subdomain}/embed": {
+            "get": {
+                "description": "Html page with the lead form of the company, meant to be embedded with an iframe.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "leadForm"
+                ],
+                "summary": "ALL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company subdomain",
+                        "name": "subdomain",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "html",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/public/signup": {
+            "post": {
+                "description": "Sign a learning center up: creates a demo company on the subdomain with its CEO, who can log in right away",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "signup"
+                ],
+                "summary": "ALL",
+                "parameters": [
+                    {
+                        "description": "Company and CEO",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.SignupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.SignupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid subdomain, phone or password",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Subdomain or phone already taken",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "More than 5 signups from this address in an hour",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/public/signup/check-subdomain/{subdomain}": {
+            "get": {
+                "description": "Tell whether a subdomain can be taken at signup, and why not",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "signup"
+                ],
+                "summary": "ALL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subdomain",
+                        "name": "subdomain",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.CheckSubdomainResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reconciliation/reports": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List balance reconciliation reports, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetReconciliationReportsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reconciliation/reports/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get one reconciliation report with the drifted students",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.ReconciliationReport"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reconciliation/run": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Compare every student's balance with the finance payment ledger and store the drift report. With autoCorrect=true drifted balances are set to the ledger value and recorded in the student history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Overwrite drifted balances with the ledger value",
+                        "name": "autoCorrect",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.ReconciliationReport"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/room/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new room based on the provided request data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Request to create a room",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/room/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a room by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/room/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve all rooms",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "ADMIN , CEO",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetUpdateRoomAbs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/room/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update the details of an existing room based on the provided request data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "capacity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/change-to-group": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Change the lead set to a group based on the provided request data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Request to change set to group",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ChangeToSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Set data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a set by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/get-by-id/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves lead details based on the provided ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response with lead details",
+                        "schema": {
+                            "$ref": "#/definitions/pb.SetDataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Lead not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict error with details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update an existing set by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Set data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/add-to-group": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Add student to group details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AddToGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tariff student limit exceeded or subscription expired",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/change-condition": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Changes the condition of a student based on provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN, CEO",
+                "parameters": [
+                    {
+                        "description": "Change Condition Student Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ChangeConditionStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status and message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Student details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Created student details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Tariff student limit reached or subscription expired",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Flag to determine if money should be returned",
+                        "name": "returnMoney",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete success message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/find-by-phone": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Find students registered with the same phone number, the number is normalized before lookup",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Phone number",
+                        "name": "phone",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.SearchStudentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/get-all": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "filter students",
+                        "name": "filter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of students",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid condition",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/get-student-by-id/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of students",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid condition",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/merge": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Merge the source student into the target: groups, attendance, notes, balance, payments and discounts are moved and the source is archived",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN, CEO",
+                "parameters": [
+                    {
+                        "description": "Merge Students Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.MergeStudentsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/note/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new note associated with a specific student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Note details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateNoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/note/delete/{noteId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a specific note associated with a student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Note ID",
+                        "name": "noteId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/note/get-notes/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all notes associated with a specific student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetNotesByStudent"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/search-student/{value}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Search for students by phone number or name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search value (phone number or name)",
+                        "name": "value",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.SearchStudentResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Updated student details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update success message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created user",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/delete/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a user from the system using their ID",
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-all-employee/{isArchived}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves a list of all employees based on archive status. Restricted to ADMIN and CEO roles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter by archive status (true=archived, false=active)",
+                        "name": "isArchived",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllEmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or processing error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-all-staff/{isArchived}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve a list of all staff members, filtered by their archived status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Boolean to filter archived or active staff; true for archived, false for active",
+                        "name": "isArchived",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of staff members based on archived status",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pb.GetAllStuffResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid 'isArchived' parameter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error during data retrieval",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-my-profile": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve the information of the authenticated user. Admin and CEO roles receive basic user info, while Teachers get additional group information.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO , TEACHER",
+                "responses": {
+                    "200": {
+                        "description": "User information with optional groups for Teachers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing authentication",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error while retrieving teacher's group information",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-teachers/{isDeleted}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Fetches a list of teachers based on the deletion status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN,CEO",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Deletion status (true/false)",
+                        "name": "isDeleted",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of teachers",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetTeachersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-user/{userId}": {
+            "get": {
+                "description": "Retrieve user details by their unique user ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "CEO , ADMIN , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetUserByIdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/history/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Fetches the history data for a given user ID.",
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN, CEO, TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetHistoryByUserIdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/login": {
+            "post": {
+                "description": "Authenticate a user and return a token upon successful login.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ALL",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "LoginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful login",
+                        "schema": {
+                            "$ref": "#/definitions/pb.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid JSON or login failure",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Subscription of the company expired and it is locked",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/update": {
+            "patch": {
+                "description": "Update user details using their ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO , TEACHER",
+                "parameters": [
+                    {
+                        "description": "User Details",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/update-password/{userId}/{password}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Updates the password of a user specified by the userId.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "New Password",
+                        "name": "password",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "Answers as long as the gateway process is running",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Checks the health service of every downstream service, 503 when one of them is not serving",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handlers.ReadinessResponse": {
+            "type": "object",
+            "properties": {
+                "services": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsCalculateSalary": {
+            "type": "object",
+            "properties": {
+                "commonLessonCountInPeriod": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "salaries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.StudentSalary"
+                    }
+                }
+            }
+        },
+        "pb.AbsCategory": {
+            "type": "object",
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsCourse": {
+            "type": "object",
+            "properties": {
+                "courseDuration": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lessonDuration": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsDebtsInformation": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.DebtorComment"
+                    }
+                },
+                "debtType": {
+                    "description": "FAMILY or SPONSOR",
+                    "type": "string"
+                },
+                "debtorId": {
+                    "type": "string"
+                },
+                "debtorName": {
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.DebtorGroup"
+                    }
+                },
+                "nextDueDate": {
+                    "type": "string"
+                },
+                "overdueAmount": {
+                    "description": "installment plans of the student",
+                    "type": "string"
+                },
+                "overdueInstallments": {
+                    "type": "integer"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "totalOnPeriod": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsDiscountRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "discountPrice": {
+                    "description": "the amount, or the percent for PERCENT discounts",
+                    "type": "string"
+                },
+                "discountType": {
+                    "description": "FIXED (default) or PERCENT",
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "description": "deletes just this discount, otherwise all of the student in the group",
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AbsGetAllPaymentsByMonthResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "accountName": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by_id": {
+                    "type": "string"
+                },
+                "created_by_name": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "exchangeRate": {
+                    "type": "number"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "isReversed": {
+                    "type": "boolean"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "originalAmount": {
+                    "description": "in the account's currency, amount is in UZS",
+                    "type": "string"
+                },
+                "paymentId": {
+                    "type": "string"
+                },
+                "payment_type": {
+                    "type": "string"
+                },
+                "reversalOf": {
+                    "type": "string"
+                },
+                "reversalReason": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGetHistoryByUserIdResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "currentValue": {
+                    "type": "string"
+                },
+                "oldValue": {
+                    "type": "string"
+                },
+                "updatedField": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGetLeftAfter": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "returnMoney": {
+                    "type": "boolean"
+                },
+                "specificDate": {
+                    "type": "string"
+                },
+                "studentBalance": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "studentPhone": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGetMonthlyStatusResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGetTeachersSalary": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGroup": {
+            "type": "object",
+            "properties": {
+                "course": {
+                    "$ref": "#/definitions/pb.AbsCourse"
+                },
+                "currentGroupStatus": {
+                    "type": "string"
+                },
+                "dateType": {
+                    "type": "string"
+                },
+                "groupEndDate": {
+                    "type": "string"
+                },
+                "groupStartDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isArchived": {
+                    "type": "boolean"
+                },
+                "lessonStartTime": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsHistory": {
+            "type": "object",
+            "properties": {
+                "currentValue": {
+                    "type": "string"
+                },
+                "editedField": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "oldValue": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsHistoryDiscount": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "discountId": {
+                    "type": "string"
+                },
+                "discountPrice": {
+                    "type": "string"
+                },
+                "discountType": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AbsNote": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsPaymentTakeOff": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "creatorId": {
+                    "type": "string"
+                },
+                "creatorName": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "paymentId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsRoom": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsStudent": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsStudentDiscount": {
+            "type": "object",
+            "properties": {
+                "cause": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "string"
+                },
+                "discountType": {
+                    "type": "string"
+                },
+                "endAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "startAt": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "studentPhoneNumber": {
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AbsStudentHistory": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currentCondition": {
+                    "type": "string"
+                },
+                "group": {
+                    "$ref": "#/definitions/pb.AbsGroup"
+                },
+                "oldCondition": {
+                    "type": "string"
+                },
+                "specificDate": {
+                    "type": "string"
+                },
+                "student": {
+                    "$ref": "#/definitions/pb.AbsStudent"
+                }
+            }
+        },
+        "pb.AbsStudentPayments": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "creatorId": {
+                    "type": "string"
+                },
+                "creatorName": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "isReversed": {
+                    "type": "boolean"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "originalAmount": {
+                    "type": "string"
+                },
+                "paymentId": {
+                    "type": "string"
+                },
+                "reversalOf": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsTakeOfChartResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "yearMonth": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsTeacher": {
+            "type": "object",
+            "properties": {
+                "activeGroups": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AddToGroupRequest": {
+            "type": "object",
+            "properties": {
+                "createdBy": {
+                    "type": "string"
+                },
+                "createdDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "student_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pb.AppliedDiscount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "discountType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source": {
+                    "description": "DISCOUNT for a student's own discount, otherwise the rule type",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.Attendance": {
+            "type": "object",
+            "properties": {
+                "attend_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isCome": {
+                    "type": "boolean"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.BalanceDrift": {
+            "type": "object",
+            "properties": {
+                "actualBalance": {
+                    "type": "string"
+                },
+                "corrected": {
+                    "type": "boolean"
+                },
+                "difference": {
+                    "type": "string"
+                },
+                "ledgerBalance": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.BalanceEvent": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "eventType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.BalanceEventList": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.BalanceEvent"
+                    }
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CalculateTeacherSalaryResponse": {
+            "type": "object",
+            "properties": {
+                "salaries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsCalculateSalary"
+                    }
+                }
+            }
+        },
+        "pb.CashAccount": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "in the account's currency",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "UZS or USD",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isDefault": {
+                    "description": "where payments of its method go when no account is given",
+                    "type": "boolean"
+                },
+                "kind": {
+                    "description": "CASH_DESK, BANK, CARD_TERMINAL or ONLINE",
+                    "type": "string"
+                },
+                "method": {
+                    "description": "CASH, CLICK, PAYME, CARD or TRANSFER",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "openShiftId": {
+                    "type": "string"
+                },
+                "requiresShift": {
+                    "description": "payments and expenses need an open shift",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.CashAccountList": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CashAccount"
+                    }
+                }
+            }
+        },
+        "pb.CashFlow": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CashFlowAccount"
+                    }
+                },
+                "activities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CashFlowActivity"
+                    }
+                },
+                "closing": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "inflow": {
+                    "type": "string"
+                },
+                "opening": {
+                    "type": "string"
+                },
+                "outflow": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CashFlowAccount": {
+            "type": "object",
+            "properties": {
+                "cashAccountId": {
+                    "type": "string"
+                },
+                "closing": {
+                    "type": "string"
+                },
+                "inflow": {
+                    "type": "string"
+                },
+                "ledgerCode": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening": {
+                    "type": "string"
+                },
+                "outflow": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CashFlowActivity": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "inflow": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "net": {
+                    "type": "string"
+                },
+                "outflow": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CashShift": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "accountName": {
+                    "type": "string"
+                },
+                "closedAt": {
+                    "type": "string"
+                },
+                "closedByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "counted": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "difference": {
+                    "description": "counted - expected, negative when money is missing",
+                    "type": "string"
+                },
+                "expected": {
+                    "description": "openingCounted + inflow - outflow",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inflow": {
+                    "type": "string"
+                },
+                "openedAt": {
+                    "type": "string"
+                },
+                "openedByName": {
+                    "type": "string"
+                },
+                "openingCounted": {
+                    "type": "string"
+                },
+                "outflow": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "OPEN or CLOSED",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CashShiftList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CashShift"
+                    }
+                }
+            }
+        },
+        "pb.CashShiftRequest": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "counted": {
+                    "description": "money counted in the drawer, in the account's currency",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ChangeConditionStudentRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "returnTheMoney": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "tillDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ChangeLeadDataRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "sectionType": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ChangeLeadPlaceRequest": {
+            "type": "object",
+            "properties": {
+                "changedSet": {
+                    "$ref": "#/definitions/pb.ChangeLeadDataRequest"
+                },
+                "leadDataId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ChangeToSetRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "courseId": {
+                    "type": "string"
+                },
+                "dateType": {
+                    "type": "string"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "setId": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CheckSubdomainResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "description": "why the subdomain can not be taken, empty when available",
+                    "type": "string"
+                },
+                "subdomain": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CohortRetention": {
+            "type": "object",
+            "properties": {
+                "companies": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "retention": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "pb.CompanyCommonDetails": {
+            "type": "object",
+            "properties": {
+                "activeCompanies": {
+                    "type": "integer"
+                },
+                "activeStudents": {
+                    "type": "integer"
+                },
+                "debtorCompanies": {
+                    "type": "integer"
+                },
+                "demoCompanies": {
+                    "type": "integer"
+                },
+                "wholeCompanies": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CompanyFinance": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "company_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "edited_valid_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "invoice_id": {
+                    "description": "the invoice paid; when 0 an open invoice of the same total is matched",
+                    "type": "integer"
+                },
+                "sum": {
+                    "type": "string"
+                },
+                "tariff_id": {
+                    "type": "integer"
+                },
+                "tariff_sum": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CompanyFinanceForList": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "integer"
+                },
+                "company_name": {
+                    "type": "string"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "finished_to": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "start_from": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "string"
+                },
+                "tariff_id": {
+                    "type": "string"
+                },
+                "tariff_name": {
+                    "type": "string"
+                },
+                "tariff_sum": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CompanyFinanceList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CompanyFinanceForList"
+                    }
+                }
+            }
+        },
+        "pb.CompanyFinanceSelf": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "edit_valid_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "sum": {
+                    "type": "string"
+                },
+                "tariff_id": {
+                    "type": "integer"
+                },
+                "tariff_name": {
+                    "type": "string"
+                },
+                "tariff_sum": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CompanyFinanceSelfList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CompanyFinanceSelf"
+                    }
+                },
+                "required_sum": {
+                    "type": "string"
+                },
+                "sum_amount_period": {
+                    "type": "string"
+                },
+                "tariff_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CompanySubscription": {
+            "type": "object",
+            "properties": {
+                "activeStudents": {
+                    "type": "integer"
+                },
+                "companyId": {
+                    "type": "string"
+                },
+                "daysLeft": {
+                    "type": "integer"
+                },
+                "graceUntil": {
+                    "type": "string"
+                },
+                "isDemo": {
+                    "type": "boolean"
+                },
+                "state": {
+                    "description": "ACTIVE, GRACE (read-only after valid_date) or LOCKED",
+                    "type": "string"
+                },
+                "studentLimit": {
+                    "type": "integer"
+                },
+                "tariffId": {
+                    "type": "integer"
+                },
+                "tariffName": {
+                    "type": "string"
+                },
+                "validDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateCompanyRequest": {
+            "type": "object",
+            "properties": {
+                "avatarUrl": {
+                    "type": "string"
+                },
+                "companyPhone": {
+                    "type": "string"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "is_demo": {
+                    "type": "boolean"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "subdomain": {
+                    "type": "string"
+                },
+                "tariff_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "valid_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateCourseRequest": {
+            "type": "object",
+            "properties": {
+                "courseDuration": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "lessonDuration": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateExpenseRequest": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "description": "cash account the money is paid from, the company's default one for\npaymentMethod when empty; sum is in the account's currency",
+                    "type": "string"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "createdById": {
+                    "type": "string"
+                },
+                "expenseType": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateGroupRequest": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "type": "integer"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "groupEndDate": {
+                    "type": "string"
+                },
+                "groupStartDate": {
+                    "type": "string"
+                },
+                "lessonStartTime": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "requestKey": {
+                    "description": "a retried request with the same key gets the group created the first time",
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "integer"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateLeadDataRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "leadId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateNoteRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreatePaymentPlanRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "firstDueDate": {
+                    "type": "string"
+                },
+                "graceDays": {
+                    "description": "days after the due date before an installment is overdue",
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "installments": {
+                    "description": "split evenly when schedule is empty",
+                    "type": "integer"
+                },
+                "intervalMonths": {
+                    "description": "months between due dates, 1 when 0",
+                    "type": "integer"
+                },
+                "lateFeeType": {
+                    "description": "FIXED or PERCENT of the installment, none when empty",
+                    "type": "string"
+                },
+                "lateFeeValue": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "description": "an explicit schedule instead, adding up to total",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ScheduledInstallment"
+                    }
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateRoomRequest": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateSetRequest": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dateType": {
+                    "type": "string"
+                },
+                "lessonStartTime": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateStudentRequest": {
+            "type": "object",
+            "properties": {
+                "additionalContact": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "dateFrom": {
+                    "type": "string"
+                },
+                "dateOfBirth": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "boolean"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "passportId": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "telegramUsername": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateTeacherSalaryRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "a sum for FIXED, a percent for PERCENT",
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateUserRequest": {
+            "type": "object",
+            "properties": {
+                "birthDate": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "description": "optional, generated when empty; lets a caller retry or undo the create",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.Day": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "transferDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.DebtReminderItem": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "debtSince": {
+                    "type": "string"
+                },
+                "deliveryStatus": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastSentAt": {
+                    "type": "string"
+                },
+                "nextSendAt": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "promisedDate": {
+                    "type": "string"
+                },
+                "remindersSent": {
+                    "type": "integer"
+                },
+                "replyComment": {
+                    "type": "string"
+                },
+                "replyStatus": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.DebtReminderSettings": {
+            "type": "object",
+            "properties": {
+                "balanceBelow": {
+                    "type": "string"
+                },
+                "cadenceDays": {
+                    "type": "integer"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "maxReminders": {
+                    "type": "integer"
+                },
+                "overdueDays": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.DebtorComment": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "commentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.DebtorGroup": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.DiscountPolicy": {
+            "type": "object",
+            "properties": {
+                "maxPercent": {
+                    "type": "number"
+                },
+                "stacking": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.DiscountRule": {
             "type": "object",
             "properties": {
-                "commonLessonCountInPeriod": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "discountType": {
+                    "description": "FIXED or PERCENT",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "minCount": {
                     "type": "integer"
                 },
-                "groupId": {
+                "name": {
                     "type": "string"
                 },
-                "groupName": {
+                "ruleType": {
                     "type": "string"
                 },
-                "salaries": {
+                "value": {
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.DiscountRuleList": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.StudentSalary"
+                        "$ref": "#/definitions/pb.DiscountRule"
                     }
                 }
             }
         },
-        "pb.AbsCategory": {
+        "pb.DynamicSection": {
             "type": "object",
             "properties": {
-                "desc": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "string"
                 },
@@ -5307,882 +10588,973 @@ const docTemplate = `{
                 }
             }
         },
-        "pb.AbsCourse": {
+        "pb.ExchangeRate": {
             "type": "object",
             "properties": {
-                "courseDuration": {
-                    "type": "integer"
+                "actionById": {
+                    "type": "string"
                 },
-                "description": {
+                "actionByName": {
                     "type": "string"
                 },
-                "id": {
+                "createdAt": {
                     "type": "string"
                 },
-                "lessonDuration": {
-                    "type": "integer"
+                "createdByName": {
+                    "type": "string"
                 },
-                "name": {
+                "currency": {
                     "type": "string"
                 },
-                "price": {
+                "date": {
+                    "description": "the rate holds from this day until the next one recorded",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "UZS for one unit of currency",
                     "type": "number"
                 }
             }
         },
-        "pb.AbsDebtsInformation": {
+        "pb.ExchangeRateList": {
             "type": "object",
             "properties": {
-                "balance": {
-                    "type": "string"
-                },
-                "comments": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.DebtorComment"
+                        "$ref": "#/definitions/pb.ExchangeRate"
                     }
+                }
+            }
+        },
+        "pb.Filters": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
                 },
-                "debtorId": {
+                "type": {
                     "type": "string"
                 },
-                "debtorName": {
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.FreezeDetail": {
+            "type": "object",
+            "properties": {
+                "reason": {
                     "type": "string"
                 },
-                "groups": {
+                "till_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.GetAllCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "categories": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.DebtorGroup"
+                        "$ref": "#/definitions/pb.AbsCategory"
+                    }
+                }
+            }
+        },
+        "pb.GetAllDebtsInformationResponse": {
+            "type": "object",
+            "properties": {
+                "debts": {
+                    "description": "owed by the students' families",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsDebtsInformation"
                     }
                 },
-                "phoneNumber": {
-                    "type": "string"
+                "sponsorDebts": {
+                    "description": "owed by sponsors, all of them on every page",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsDebtsInformation"
+                    }
                 },
-                "totalOnPeriod": {
-                    "type": "string"
+                "totalPageCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.AbsDiscountRequest": {
+        "pb.GetAllEmployeeResponse": {
             "type": "object",
             "properties": {
-                "comment": {
+                "employees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetUserByIdResponse"
+                    }
+                }
+            }
+        },
+        "pb.GetAllExpenseAbs": {
+            "type": "object",
+            "properties": {
+                "accountId": {
                     "type": "string"
                 },
-                "discountPrice": {
+                "accountName": {
                     "type": "string"
                 },
-                "endDate": {
+                "category": {
+                    "$ref": "#/definitions/pb.AbsCategory"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "groupId": {
+                "creator": {
+                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                },
+                "currency": {
                     "type": "string"
                 },
-                "startDate": {
+                "exchangeRate": {
+                    "type": "number"
+                },
+                "expenseType": {
                     "type": "string"
                 },
-                "studentId": {
+                "givenDate": {
                     "type": "string"
                 },
-                "withTeacher": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
+                },
+                "originalSum": {
+                    "description": "in the account's currency, sum is in UZS",
+                    "type": "string"
+                },
+                "paymentType": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/pb.GetUserByIdResponse"
                 }
             }
         },
-        "pb.AbsGetAllPaymentsByMonthResponse": {
+        "pb.GetAllExpenseDiagramResponse": {
+            "type": "object",
+            "properties": {
+                "amountCommonExpense": {
+                    "type": "string"
+                },
+                "monthAmount": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userOrCategories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userOrCategoriesAmount": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pb.GetAllExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetAllExpenseAbs"
+                    }
+                },
+                "totalPageCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.GetAllPaymentTakeOffChartResponse": {
+            "type": "object",
+            "properties": {
+                "chartResponse": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsTakeOfChartResponse"
+                    }
+                }
+            }
+        },
+        "pb.GetAllPaymentTakeOffResponse": {
+            "type": "object",
+            "properties": {
+                "pennies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsPaymentTakeOff"
+                    }
+                }
+            }
+        },
+        "pb.GetAllPaymentsByMonthResponse": {
+            "type": "object",
+            "properties": {
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetAllPaymentsByMonthResponse"
+                    }
+                }
+            }
+        },
+        "pb.GetAllResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetCompanyResponse"
+                    }
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.GetAllStudentPaymentsChartResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "string"
-                },
-                "comment": {
-                    "type": "string"
-                },
-                "created_at": {
+                "cash": {
                     "type": "string"
                 },
-                "created_by_id": {
+                "click": {
                     "type": "string"
                 },
-                "created_by_name": {
+                "payme": {
                     "type": "string"
                 },
-                "givenDate": {
-                    "type": "string"
+                "paymentsChart": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsTakeOfChartResponse"
+                    }
                 },
-                "groupId": {
+                "totalRevenue": {
                     "type": "string"
+                }
+            }
+        },
+        "pb.GetAllStudentPaymentsRequest": {
+            "type": "object",
+            "properties": {
+                "filters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Filters"
+                    }
                 },
-                "groupName": {
+                "from": {
                     "type": "string"
                 },
-                "method": {
-                    "type": "string"
+                "page": {
+                    "$ref": "#/definitions/pb.PageRequest"
                 },
-                "paymentId": {
-                    "type": "string"
+                "sorts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.SortBy"
+                    }
                 },
-                "payment_type": {
+                "to": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsGetHistoryByUserIdResponse": {
+        "pb.GetAllStudentPaymentsResponse": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "gross": {
                     "type": "string"
                 },
-                "currentValue": {
+                "net": {
                     "type": "string"
                 },
-                "oldValue": {
-                    "type": "string"
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudentPayments"
+                    }
                 },
-                "updatedField": {
+                "reversed": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsGetLeftAfter": {
+        "pb.GetAllStudentRequest": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "groupId": {
+                "condition": {
                     "type": "string"
                 },
-                "groupName": {
+                "courseId": {
                     "type": "string"
                 },
-                "returnMoney": {
-                    "type": "boolean"
-                },
-                "specificDate": {
+                "nameOrSurname": {
                     "type": "string"
                 },
-                "studentBalance": {
+                "page": {
                     "type": "string"
                 },
-                "studentId": {
+                "size": {
                     "type": "string"
                 },
-                "studentName": {
+                "trainingDatesEnd": {
                     "type": "string"
                 },
-                "studentPhone": {
+                "trainingDatesStart": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsGetMonthlyStatusResponse": {
+        "pb.GetAllStudentResponse": {
             "type": "object",
             "properties": {
-                "balance": {
-                    "type": "string"
+                "response": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetGroupsAbsForStudent"
+                    }
                 },
-                "month": {
-                    "type": "string"
+                "totalCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.AbsGetTeachersSalary": {
+        "pb.GetAllStuffResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "integer"
-                },
-                "teacherId": {
-                    "type": "string"
-                },
-                "teacherName": {
-                    "type": "string"
-                },
-                "type": {
-                    "type": "string"
+                "stuff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetUserByIdResponse"
+                    }
                 }
             }
         },
-        "pb.AbsGroup": {
+        "pb.GetAttendanceRequest": {
             "type": "object",
             "properties": {
-                "course": {
-                    "$ref": "#/definitions/pb.AbsCourse"
-                },
-                "currentGroupStatus": {
+                "actionId": {
                     "type": "string"
                 },
-                "dateType": {
+                "actionRole": {
                     "type": "string"
                 },
-                "groupEndDate": {
+                "from": {
                     "type": "string"
                 },
-                "groupStartDate": {
+                "groupId": {
                     "type": "string"
                 },
-                "id": {
+                "till": {
                     "type": "string"
                 },
-                "isArchived": {
+                "withOutdated": {
                     "type": "boolean"
+                }
+            }
+        },
+        "pb.GetAttendanceResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Day"
+                    }
                 },
-                "lessonStartTime": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "teacherName": {
-                    "type": "string"
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Student"
+                    }
                 }
             }
         },
-        "pb.AbsHistory": {
+        "pb.GetCommonInformationResponse": {
             "type": "object",
             "properties": {
-                "currentValue": {
-                    "type": "string"
+                "debtorsCount": {
+                    "type": "integer"
                 },
-                "editedField": {
+                "expenseInCurrentMonth": {
                     "type": "string"
                 },
-                "id": {
+                "incomeInCurrentMonth": {
+                    "description": "from the ledger, as on the profit and loss report of this month",
                     "type": "string"
                 },
-                "oldValue": {
+                "payInCurrentMonth": {
+                    "type": "integer"
+                },
+                "profitInCurrentMonth": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsHistoryDiscount": {
+        "pb.GetCompanyResponse": {
             "type": "object",
             "properties": {
-                "action": {
+                "active_student_count": {
+                    "type": "integer"
+                },
+                "avatarUrl": {
                     "type": "string"
                 },
-                "comment": {
+                "ceo_id": {
                     "type": "string"
                 },
-                "createdAt": {
+                "companyPhone": {
                     "type": "string"
                 },
-                "discountPrice": {
+                "created_at": {
                     "type": "string"
                 },
-                "endDate": {
+                "discount_id": {
                     "type": "string"
                 },
-                "groupId": {
+                "endTime": {
                     "type": "string"
                 },
-                "groupName": {
+                "id": {
                     "type": "string"
                 },
-                "startDate": {
+                "is_demo": {
+                    "type": "boolean"
+                },
+                "startTime": {
                     "type": "string"
                 },
-                "studentId": {
+                "subdomain": {
                     "type": "string"
                 },
-                "studentName": {
+                "tariff": {
+                    "$ref": "#/definitions/pb.Tariff"
+                },
+                "title": {
                     "type": "string"
                 },
-                "withTeacher": {
-                    "type": "boolean"
+                "valid_date": {
+                    "type": "string"
                 }
             }
         },
-        "pb.AbsNote": {
+        "pb.GetDebtRemindersResponse": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.DebtReminderItem"
+                    }
                 },
-                "id": {
-                    "type": "string"
+                "totalCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.AbsPaymentTakeOff": {
+        "pb.GetGroupAbsResponse": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
+                "course": {
+                    "$ref": "#/definitions/pb.AbsCourse"
                 },
-                "creatorId": {
+                "createdAt": {
                     "type": "string"
                 },
-                "creatorName": {
+                "dateType": {
                     "type": "string"
                 },
-                "givenDate": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "endDate": {
                     "type": "string"
                 },
-                "paymentId": {
+                "id": {
                     "type": "string"
                 },
-                "studentId": {
+                "isArchived": {
+                    "type": "boolean"
+                },
+                "lessonStartTime": {
                     "type": "string"
                 },
-                "studentName": {
+                "name": {
                     "type": "string"
                 },
-                "sum": {
+                "room": {
+                    "$ref": "#/definitions/pb.AbsRoom"
+                },
+                "startDate": {
                     "type": "string"
-                }
-            }
-        },
-        "pb.AbsRoom": {
-            "type": "object",
-            "properties": {
-                "capacity": {
+                },
+                "studentCount": {
                     "type": "integer"
                 },
-                "id": {
+                "teacherId": {
                     "type": "string"
                 },
-                "name": {
+                "teacherName": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsStudent": {
+        "pb.GetGroupByCourseAbsResponse": {
             "type": "object",
             "properties": {
+                "dateType": {
+                    "type": "string"
+                },
+                "groupEndDate": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "groupStartDate": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "lessonStartTime": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "teacherName": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsStudentDiscount": {
+        "pb.GetGroupByTeacherAbs": {
             "type": "object",
             "properties": {
-                "cause": {
+                "activeStudentCount": {
+                    "type": "integer"
+                },
+                "courseName": {
                     "type": "string"
                 },
-                "createdAt": {
+                "dayType": {
                     "type": "string"
                 },
-                "discount": {
+                "groupEndAt": {
                     "type": "string"
                 },
-                "endAt": {
+                "groupStartAt": {
                     "type": "string"
                 },
-                "startAt": {
+                "id": {
                     "type": "string"
                 },
-                "studentId": {
+                "lessonStartTime": {
                     "type": "string"
                 },
-                "studentName": {
+                "name": {
                     "type": "string"
                 },
-                "studentPhoneNumber": {
+                "roomName": {
                     "type": "string"
                 },
-                "withTeacher": {
-                    "type": "boolean"
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudent"
+                    }
                 }
             }
         },
-        "pb.AbsStudentHistory": {
+        "pb.GetGroupsAbsForStudent": {
             "type": "object",
             "properties": {
-                "comment": {
+                "additionalContact": {
                     "type": "string"
                 },
-                "createdAt": {
+                "address": {
                     "type": "string"
                 },
-                "currentCondition": {
+                "balance": {
                     "type": "string"
                 },
-                "group": {
-                    "$ref": "#/definitions/pb.AbsGroup"
-                },
-                "oldCondition": {
+                "condition": {
                     "type": "string"
                 },
-                "specificDate": {
+                "createdAt": {
                     "type": "string"
                 },
-                "student": {
-                    "$ref": "#/definitions/pb.AbsStudent"
-                }
-            }
-        },
-        "pb.AbsStudentPayments": {
-            "type": "object",
-            "properties": {
-                "amount": {
+                "dateOfBirth": {
                     "type": "string"
                 },
-                "comment": {
-                    "type": "string"
+                "gender": {
+                    "type": "boolean"
                 },
-                "creatorId": {
-                    "type": "string"
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GroupGetAllStudentAbs"
+                    }
                 },
-                "creatorName": {
+                "id": {
                     "type": "string"
                 },
-                "givenDate": {
+                "name": {
                     "type": "string"
                 },
-                "method": {
+                "passportId": {
                     "type": "string"
                 },
-                "studentId": {
+                "phone": {
                     "type": "string"
                 },
-                "studentName": {
+                "telegramUsername": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsTakeOfChartResponse": {
+        "pb.GetGroupsByCourseResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "string"
-                },
-                "yearMonth": {
-                    "type": "string"
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetGroupByCourseAbsResponse"
+                    }
                 }
             }
         },
-        "pb.AbsTeacher": {
+        "pb.GetGroupsByTeacherResponse": {
             "type": "object",
             "properties": {
-                "activeGroups": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetGroupByTeacherAbs"
+                    }
+                }
+            }
+        },
+        "pb.GetGroupsRequest": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "type": "integer"
+                },
+                "dateType": {
                     "type": "string"
                 },
-                "fullName": {
+                "endDate": {
                     "type": "string"
                 },
-                "id": {
+                "isArchived": {
+                    "type": "boolean"
+                },
+                "orderBy": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "orderDirection": {
+                    "type": "string"
+                },
+                "page": {
+                    "$ref": "#/definitions/pb.PageRequest"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "teacherId": {
                     "type": "string"
                 }
             }
         },
-        "pb.AddToGroupRequest": {
+        "pb.GetGroupsResponse": {
             "type": "object",
             "properties": {
-                "createdBy": {
-                    "type": "string"
-                },
-                "createdDate": {
-                    "type": "string"
-                },
-                "groupId": {
-                    "type": "string"
-                },
-                "student_ids": {
+                "groups": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.GetGroupAbsResponse"
                     }
+                },
+                "totalPageCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.Attendance": {
+        "pb.GetHistoryByUserIdResponse": {
             "type": "object",
             "properties": {
-                "attend_date": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "isCome": {
-                    "type": "boolean"
-                },
-                "studentId": {
-                    "type": "string"
-                },
-                "teacherId": {
-                    "type": "string"
+                "histories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetHistoryByUserIdResponse"
+                    }
                 }
             }
         },
-        "pb.CalculateTeacherSalaryResponse": {
+        "pb.GetHistoryDiscountResponse": {
             "type": "object",
             "properties": {
-                "salaries": {
+                "discounts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsCalculateSalary"
+                        "$ref": "#/definitions/pb.AbsHistoryDiscount"
                     }
                 }
             }
         },
-        "pb.ChangeConditionStudentRequest": {
+        "pb.GetHistoryGroupResponse": {
             "type": "object",
             "properties": {
-                "actionById": {
-                    "type": "string"
-                },
-                "actionByName": {
-                    "type": "string"
-                },
-                "comment": {
-                    "type": "string"
-                },
-                "groupId": {
-                    "type": "string"
-                },
-                "returnTheMoney": {
-                    "type": "boolean"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "studentId": {
-                    "type": "string"
+                "groupHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsHistory"
+                    }
                 },
-                "tillDate": {
-                    "type": "string"
+                "studentsHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudentHistory"
+                    }
                 }
             }
         },
-        "pb.ChangeLeadDataRequest": {
+        "pb.GetHistoryStudentResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "conditionsHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudentHistory"
+                    }
                 },
-                "sectionType": {
-                    "type": "string"
+                "studentHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsHistory"
+                    }
                 }
             }
         },
-        "pb.ChangeLeadPlaceRequest": {
+        "pb.GetInformationDiscountResponse": {
             "type": "object",
             "properties": {
-                "changedSet": {
-                    "$ref": "#/definitions/pb.ChangeLeadDataRequest"
-                },
-                "leadDataId": {
-                    "type": "string"
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudentDiscount"
+                    }
+                }
+            }
+        },
+        "pb.GetLeadCommonRequest": {
+            "type": "object",
+            "properties": {
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.LeadCommonRequest"
+                    }
                 }
             }
         },
-        "pb.ChangeToSetRequest": {
+        "pb.GetLeadCommonResponse": {
             "type": "object",
             "properties": {
-                "courseId": {
-                    "type": "string"
-                },
-                "dateType": {
-                    "type": "string"
-                },
-                "days": {
+                "expectations": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.Section"
                     }
                 },
-                "end_date": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "roomId": {
-                    "type": "string"
-                },
-                "setId": {
-                    "type": "string"
-                },
-                "startDate": {
-                    "type": "string"
-                },
-                "startTime": {
-                    "type": "string"
+                "leads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Section"
+                    }
                 },
-                "teacherId": {
-                    "type": "string"
+                "sets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Section"
+                    }
                 }
             }
         },
-        "pb.CompanyCommonDetails": {
+        "pb.GetLeadListResponse": {
             "type": "object",
             "properties": {
-                "activeCompanies": {
-                    "type": "integer"
-                },
-                "activeStudents": {
-                    "type": "integer"
-                },
-                "debtorCompanies": {
-                    "type": "integer"
-                },
-                "demoCompanies": {
-                    "type": "integer"
-                },
-                "wholeCompanies": {
-                    "type": "integer"
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.DynamicSection"
+                    }
                 }
             }
         },
-        "pb.CompanyFinance": {
+        "pb.GetLeftAfterTrialPeriodResponse": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
-                },
-                "company_id": {
-                    "type": "integer"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "discount_id": {
-                    "type": "string"
-                },
-                "discount_name": {
-                    "type": "string"
-                },
-                "edited_valid_date": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "sum": {
-                    "type": "number"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetLeftAfter"
+                    }
                 },
-                "tariff_id": {
+                "totalItemCount": {
                     "type": "integer"
-                },
-                "tariff_sum": {
-                    "type": "number"
                 }
             }
         },
-        "pb.CompanyFinanceForList": {
+        "pb.GetMonthlyStatusResponse": {
             "type": "object",
             "properties": {
-                "company_id": {
-                    "type": "integer"
-                },
-                "company_name": {
-                    "type": "string"
-                },
-                "discount_id": {
-                    "type": "string"
-                },
-                "discount_name": {
-                    "type": "string"
-                },
-                "finished_to": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "start_from": {
-                    "type": "string"
-                },
-                "sum": {
-                    "type": "number"
-                },
-                "tariff_id": {
-                    "type": "string"
-                },
-                "tariff_name": {
-                    "type": "string"
-                },
-                "tariff_sum": {
-                    "type": "number"
+                "monthStatus": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetMonthlyStatusResponse"
+                    }
                 }
             }
         },
-        "pb.CompanyFinanceList": {
+        "pb.GetNotesByStudent": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "items": {
+                "notes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.CompanyFinanceForList"
+                        "$ref": "#/definitions/pb.AbsNote"
                     }
                 }
             }
         },
-        "pb.CompanyFinanceSelf": {
+        "pb.GetNotificationOutboxResponse": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "discount_id": {
-                    "type": "string"
-                },
-                "discount_name": {
-                    "type": "string"
-                },
-                "edit_valid_date": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "sum": {
-                    "type": "number"
-                },
-                "tariff_id": {
-                    "type": "integer"
-                },
-                "tariff_name": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.NotificationOutboxItem"
+                    }
                 },
-                "tariff_sum": {
+                "totalCount": {
                     "type": "integer"
                 }
             }
         },
-        "pb.CompanyFinanceSelfList": {
+        "pb.GetReconciliationReportsResponse": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "discount_name": {
-                    "type": "string"
-                },
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.CompanyFinanceSelf"
+                        "$ref": "#/definitions/pb.ReconciliationReport"
                     }
                 },
-                "required_sum": {
-                    "type": "number"
-                },
-                "sum_amount_period": {
-                    "type": "number"
-                },
-                "tariff_name": {
-                    "type": "string"
+                "totalCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.CreateCategoryRequest": {
+        "pb.GetStatisticRequest": {
             "type": "object",
             "properties": {
-                "desc": {
+                "from": {
                     "type": "string"
                 },
-                "name": {
+                "to": {
                     "type": "string"
                 }
             }
         },
-        "pb.CreateCompanyRequest": {
+        "pb.GetStatisticResponse": {
             "type": "object",
             "properties": {
-                "avatarUrl": {
-                    "type": "string"
-                },
-                "companyPhone": {
-                    "type": "string"
-                },
-                "discount_id": {
-                    "type": "string"
-                },
-                "endTime": {
-                    "type": "string"
-                },
-                "is_demo": {
-                    "type": "boolean"
-                },
-                "startTime": {
-                    "type": "string"
-                },
-                "subdomain": {
-                    "type": "string"
-                },
-                "tariff_id": {
-                    "type": "integer"
+                "details": {
+                    "$ref": "#/definitions/pb.CompanyCommonDetails"
                 },
-                "title": {
-                    "type": "string"
+                "paymentDetails": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.OtherDetails"
+                    }
                 },
-                "valid_date": {
-                    "type": "string"
+                "registerDetails": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.OtherDetails"
+                    }
+                }
+            }
+        },
+        "pb.GetTeachersResponse": {
+            "type": "object",
+            "properties": {
+                "teachers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsTeacher"
+                    }
                 }
             }
         },
-        "pb.CreateCourseRequest": {
+        "pb.GetTeachersSalaryRequest": {
             "type": "object",
             "properties": {
-                "courseDuration": {
-                    "type": "integer"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "lessonDuration": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "price": {
-                    "type": "number"
+                "salaries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetTeachersSalary"
+                    }
                 }
             }
         },
-        "pb.CreateExpenseRequest": {
+        "pb.GetUpdateCourseAbs": {
             "type": "object",
             "properties": {
-                "categoryId": {
-                    "type": "string"
-                },
-                "createdById": {
-                    "type": "string"
-                },
-                "expenseType": {
-                    "type": "string"
-                },
-                "givenDate": {
-                    "type": "string"
-                },
-                "paymentMethod": {
-                    "type": "string"
-                },
-                "sum": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "userId": {
-                    "type": "string"
+                "courses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsCourse"
+                    }
                 }
             }
         },
-        "pb.CreateGroupRequest": {
+        "pb.GetUpdateGroupAbs": {
             "type": "object",
             "properties": {
                 "courseId": {
@@ -6200,6 +11572,9 @@ const docTemplate = `{
                 "groupStartDate": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
                 "lessonStartTime": {
                     "type": "string"
                 },
@@ -6217,116 +11592,89 @@ const docTemplate = `{
                 }
             }
         },
-        "pb.CreateLeadDataRequest": {
+        "pb.GetUpdateRoomAbs": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
-                },
-                "leadId": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "phoneNumber": {
-                    "type": "string"
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsRoom"
+                    }
                 }
             }
         },
-        "pb.CreateNoteRequest": {
+        "pb.GetUserByIdResponse": {
             "type": "object",
             "properties": {
-                "note": {
+                "birthDate": {
                     "type": "string"
                 },
-                "studentId": {
-                    "type": "string"
-                }
-            }
-        },
-        "pb.CreateRoomRequest": {
-            "type": "object",
-            "properties": {
-                "capacity": {
+                "companyId": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
-                }
-            }
-        },
-        "pb.CreateSetRequest": {
-            "type": "object",
-            "properties": {
-                "courseId": {
+                "createdAt": {
                     "type": "string"
                 },
-                "date": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "gender": {
+                    "type": "boolean"
                 },
-                "dateType": {
+                "has_access_finance": {
+                    "type": "boolean"
+                },
+                "id": {
                     "type": "string"
                 },
-                "lessonStartTime": {
+                "is_deleted": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
-                "teacherId": {
+                "phoneNumber": {
                     "type": "string"
                 },
-                "title": {
+                "role": {
                     "type": "string"
                 }
             }
         },
-        "pb.CreateStudentRequest": {
+        "pb.GroupGetAllStudentAbs": {
             "type": "object",
             "properties": {
-                "additionalContact": {
-                    "type": "string"
+                "course": {
+                    "$ref": "#/definitions/pb.AbsCourse"
                 },
-                "address": {
-                    "type": "string"
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "createdBy": {
+                "groupEndDate": {
                     "type": "string"
                 },
-                "dateFrom": {
+                "groupStartDate": {
                     "type": "string"
                 },
-                "dateOfBirth": {
+                "id": {
                     "type": "string"
                 },
-                "gender": {
-                    "type": "boolean"
-                },
-                "groupId": {
+                "lessonStartTime": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "passportId": {
-                    "type": "string"
+                "roomId": {
+                    "type": "integer"
                 },
-                "phoneNumber": {
+                "studentActivatedAt": {
                     "type": "string"
                 },
-                "telegramUsername": {
+                "studentCondition": {
                     "type": "string"
-                }
-            }
-        },
-        "pb.CreateTeacherSalaryRequest": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "integer"
                 },
-                "teacherId": {
+                "teacherName": {
                     "type": "string"
                 },
                 "type": {
@@ -6334,1064 +11682,1303 @@ const docTemplate = `{
                 }
             }
         },
-        "pb.CreateUserRequest": {
+        "pb.Installment": {
             "type": "object",
             "properties": {
-                "birthDate": {
+                "amount": {
                     "type": "string"
                 },
-                "fullName": {
+                "dueDate": {
                     "type": "string"
                 },
-                "gender": {
-                    "type": "boolean"
-                },
-                "password": {
+                "id": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "lateFee": {
                     "type": "string"
                 },
-                "role": {
-                    "type": "string"
-                }
-            }
-        },
-        "pb.Day": {
-            "type": "object",
-            "properties": {
-                "date": {
-                    "type": "string"
+                "number": {
+                    "type": "integer"
                 },
-                "transferDate": {
+                "paid": {
                     "type": "string"
-                }
-            }
-        },
-        "pb.DebtorComment": {
-            "type": "object",
-            "properties": {
-                "comment": {
+                },
+                "paidAt": {
                     "type": "string"
                 },
-                "commentId": {
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.InstallmentPayment"
+                    }
+                },
+                "status": {
+                    "description": "PENDING, PARTIAL, PAID or OVERDUE",
                     "type": "string"
                 }
             }
         },
-        "pb.DebtorGroup": {
+        "pb.InstallmentPayment": {
             "type": "object",
             "properties": {
-                "groupId": {
+                "amount": {
                     "type": "string"
                 },
-                "groupName": {
-                    "type": "string"
-                }
-            }
-        },
-        "pb.DynamicSection": {
-            "type": "object",
-            "properties": {
-                "id": {
+                "date": {
                     "type": "string"
                 },
-                "name": {
+                "paymentId": {
                     "type": "string"
                 }
             }
         },
-        "pb.Filters": {
+        "pb.Invoice": {
             "type": "object",
             "properties": {
-                "field": {
+                "createdAt": {
                     "type": "string"
                 },
-                "type": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "value": {
+                "paidAt": {
                     "type": "string"
-                }
-            }
-        },
-        "pb.FreezeDetail": {
-            "type": "object",
-            "properties": {
-                "reason": {
+                },
+                "paidSum": {
                     "type": "string"
                 },
-                "till_date": {
+                "quote": {
+                    "$ref": "#/definitions/pb.TariffQuote"
+                },
+                "status": {
+                    "description": "OPEN, PAID or VOID",
                     "type": "string"
                 }
             }
         },
-        "pb.GetAllCategoryRequest": {
+        "pb.InvoiceList": {
             "type": "object",
             "properties": {
-                "categories": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsCategory"
+                        "$ref": "#/definitions/pb.Invoice"
                     }
                 }
             }
         },
-        "pb.GetAllDebtsInformationResponse": {
+        "pb.JournalEntry": {
             "type": "object",
             "properties": {
-                "debts": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lines": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsDebtsInformation"
+                        "$ref": "#/definitions/pb.JournalLine"
                     }
                 },
-                "totalPageCount": {
-                    "type": "integer"
+                "reversalOf": {
+                    "type": "string"
+                },
+                "reversedBy": {
+                    "type": "string"
+                },
+                "source": {
+                    "description": "STUDENT_PAYMENT, SPONSOR_PAYMENT, EXPENSE or MANUAL",
+                    "type": "string"
+                },
+                "sourceId": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAllEmployeeResponse": {
+        "pb.JournalEntryList": {
             "type": "object",
             "properties": {
-                "employees": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetUserByIdResponse"
+                        "$ref": "#/definitions/pb.JournalEntry"
                     }
                 }
             }
         },
-        "pb.GetAllExpenseAbs": {
+        "pb.JournalLine": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/pb.AbsCategory"
-                },
-                "createdAt": {
+                "accountCode": {
                     "type": "string"
                 },
-                "creator": {
-                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                "accountName": {
+                    "type": "string"
                 },
-                "expenseType": {
+                "cashAccountId": {
                     "type": "string"
                 },
-                "givenDate": {
+                "categoryId": {
                     "type": "string"
                 },
-                "id": {
+                "credit": {
                     "type": "string"
                 },
-                "paymentType": {
+                "debit": {
+                    "description": "one of debit and credit is above 0, the other is 0",
                     "type": "string"
                 },
-                "sum": {
+                "sponsorId": {
                     "type": "string"
                 },
-                "title": {
+                "studentId": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                "userId": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAllExpenseDiagramResponse": {
+        "pb.Lead": {
             "type": "object",
             "properties": {
-                "amountCommonExpense": {
+                "comment": {
                     "type": "string"
                 },
-                "monthAmount": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "createdAt": {
+                    "type": "string"
                 },
-                "months": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "userOrCategories": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "name": {
+                    "type": "string"
                 },
-                "userOrCategoriesAmount": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "phoneNumber": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAllExpenseResponse": {
+        "pb.LeadCommonRequest": {
             "type": "object",
             "properties": {
-                "expenses": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.GetAllExpenseAbs"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "totalPageCount": {
-                    "type": "integer"
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAllPaymentTakeOffChartResponse": {
+        "pb.LeadForm": {
             "type": "object",
             "properties": {
-                "chartResponse": {
+                "defaultLeadId": {
+                    "type": "string"
+                },
+                "fields": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsTakeOfChartResponse"
+                        "$ref": "#/definitions/pb.LeadFormField"
                     }
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "rateLimitPerHour": {
+                    "type": "integer"
+                },
+                "successMessage": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAllPaymentTakeOffResponse": {
+        "pb.LeadFormField": {
             "type": "object",
             "properties": {
-                "pennies": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsPaymentTakeOff"
-                    }
+                "label": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
                 }
             }
         },
-        "pb.GetAllPaymentsByMonthResponse": {
+        "pb.LedgerAccount": {
             "type": "object",
             "properties": {
-                "payments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsGetAllPaymentsByMonthResponse"
-                    }
+                "balance": {
+                    "description": "debit less credit of everything posted to it",
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "isCash": {
+                    "description": "money on hand, only an ASSET can be",
+                    "type": "boolean"
+                },
+                "isSystem": {
+                    "description": "part of the chart every company starts with",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "ASSET, LIABILITY, EQUITY, INCOME or EXPENSE",
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAllResponse": {
+        "pb.LedgerAccountList": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetCompanyResponse"
+                        "$ref": "#/definitions/pb.LedgerAccount"
                     }
-                },
-                "totalCount": {
-                    "type": "integer"
                 }
             }
         },
-        "pb.GetAllStudentPaymentsChartResponse": {
+        "pb.LoginRequest": {
             "type": "object",
             "properties": {
-                "cash": {
-                    "type": "string"
-                },
-                "click": {
+                "companyId": {
                     "type": "string"
                 },
-                "payme": {
+                "password": {
                     "type": "string"
                 },
-                "paymentsChart": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsTakeOfChartResponse"
-                    }
-                },
-                "totalRevenue": {
+                "phoneNumber": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetAllStudentPaymentsRequest": {
+        "pb.LoginResponse": {
             "type": "object",
             "properties": {
-                "filters": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.Filters"
-                    }
+                "isOk": {
+                    "type": "boolean"
                 },
-                "from": {
+                "token": {
                     "type": "string"
                 },
-                "page": {
-                    "$ref": "#/definitions/pb.PageRequest"
-                },
-                "sorts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.SortBy"
-                    }
+                "user": {
+                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                }
+            }
+        },
+        "pb.MergeLeadDataRequest": {
+            "type": "object",
+            "properties": {
+                "sourceId": {
+                    "type": "string"
                 },
-                "to": {
+                "targetId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetAllStudentPaymentsResponse": {
+        "pb.MergeStudentsRequest": {
             "type": "object",
             "properties": {
-                "payments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsStudentPayments"
-                    }
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "sourceStudentId": {
+                    "type": "string"
+                },
+                "targetStudentId": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAllStudentRequest": {
+        "pb.NotificationOutboxItem": {
             "type": "object",
             "properties": {
-                "condition": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "body": {
                     "type": "string"
                 },
-                "courseId": {
+                "channel": {
                     "type": "string"
                 },
-                "nameOrSurname": {
+                "createdAt": {
                     "type": "string"
                 },
-                "page": {
+                "event": {
                     "type": "string"
                 },
-                "size": {
+                "id": {
                     "type": "string"
                 },
-                "trainingDatesEnd": {
+                "lastError": {
                     "type": "string"
                 },
-                "trainingDatesStart": {
+                "recipient": {
+                    "type": "string"
+                },
+                "sentAt": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetAllStudentResponse": {
+        "pb.NotificationSettings": {
             "type": "object",
             "properties": {
-                "response": {
+                "lang": {
+                    "type": "string"
+                },
+                "smsEnabled": {
+                    "type": "boolean"
+                },
+                "telegramEnabled": {
+                    "type": "boolean"
+                },
+                "templates": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetGroupsAbsForStudent"
+                        "$ref": "#/definitions/pb.NotificationTemplate"
                     }
+                }
+            }
+        },
+        "pb.NotificationTemplate": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "event": {
+                    "type": "string"
+                },
+                "isDefault": {
+                    "type": "boolean"
+                },
+                "lang": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAllStuffResponse": {
+        "pb.OtherDetails": {
             "type": "object",
             "properties": {
-                "stuff": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.GetUserByIdResponse"
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
                 }
             }
         },
-        "pb.GetAttendanceRequest": {
+        "pb.OverdueInstallment": {
             "type": "object",
-            "properties": {
-                "actionId": {
-                    "type": "string"
+            "properties": {
+                "daysOverdue": {
+                    "type": "integer"
                 },
-                "actionRole": {
+                "due": {
                     "type": "string"
                 },
-                "from": {
+                "dueDate": {
                     "type": "string"
                 },
                 "groupId": {
                     "type": "string"
                 },
-                "till": {
+                "installmentId": {
                     "type": "string"
                 },
-                "withOutdated": {
-                    "type": "boolean"
+                "lateFee": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "planId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetAttendanceResponse": {
+        "pb.OverdueInstallmentList": {
             "type": "object",
             "properties": {
-                "days": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.Day"
-                    }
+                "count": {
+                    "type": "integer"
                 },
-                "students": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.Student"
+                        "$ref": "#/definitions/pb.OverdueInstallment"
                     }
                 }
             }
         },
-        "pb.GetCommonInformationResponse": {
+        "pb.PageRequest": {
             "type": "object",
             "properties": {
-                "debtorsCount": {
+                "companyId": {
+                    "type": "string"
+                },
+                "filter": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "page": {
                     "type": "integer"
                 },
-                "payInCurrentMonth": {
+                "size": {
                     "type": "integer"
+                },
+                "to": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetCompanyResponse": {
+        "pb.PaymentAddRequest": {
             "type": "object",
             "properties": {
-                "active_student_count": {
-                    "type": "integer"
-                },
-                "avatarUrl": {
+                "accountId": {
+                    "description": "cash account an ADD is paid into, the company's default one for method\nwhen empty; sum is in the account's currency",
                     "type": "string"
                 },
-                "ceo_id": {
+                "actionById": {
                     "type": "string"
                 },
-                "companyPhone": {
+                "actionByName": {
                     "type": "string"
                 },
-                "created_at": {
+                "comment": {
                     "type": "string"
                 },
-                "discount_id": {
+                "date": {
                     "type": "string"
                 },
-                "endTime": {
-                    "type": "string"
+                "discounts": {
+                    "description": "discounts a TAKE_OFF was priced with, its rules are recorded as applied\nto the month of date together with the charge",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AppliedDiscount"
+                    }
                 },
-                "id": {
+                "groupId": {
                     "type": "string"
                 },
-                "is_demo": {
-                    "type": "boolean"
-                },
-                "startTime": {
+                "method": {
                     "type": "string"
                 },
-                "subdomain": {
+                "sum": {
                     "type": "string"
                 },
-                "tariff": {
-                    "$ref": "#/definitions/pb.Tariff"
-                },
-                "title": {
+                "type": {
                     "type": "string"
                 },
-                "valid_date": {
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetGroupAbsResponse": {
+        "pb.PaymentPlan": {
             "type": "object",
             "properties": {
-                "course": {
-                    "$ref": "#/definitions/pb.AbsCourse"
+                "cancelReason": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
                 },
                 "createdAt": {
                     "type": "string"
                 },
-                "dateType": {
+                "createdBy": {
                     "type": "string"
                 },
-                "days": {
+                "graceDays": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "installments": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.Installment"
                     }
                 },
-                "endDate": {
+                "lateFeeType": {
                     "type": "string"
                 },
-                "id": {
+                "lateFeeValue": {
                     "type": "string"
                 },
-                "isArchived": {
-                    "type": "boolean"
+                "studentId": {
+                    "type": "string"
                 },
-                "lessonStartTime": {
+                "summary": {
+                    "$ref": "#/definitions/pb.PaymentPlanStatus"
+                }
+            }
+        },
+        "pb.PaymentPlanStatus": {
+            "type": "object",
+            "properties": {
+                "groupId": {
                     "type": "string"
                 },
-                "name": {
+                "nextDueAmount": {
                     "type": "string"
                 },
-                "room": {
-                    "$ref": "#/definitions/pb.AbsRoom"
+                "nextDueDate": {
+                    "type": "string"
                 },
-                "startDate": {
+                "outstanding": {
                     "type": "string"
                 },
-                "studentCount": {
+                "overdue": {
+                    "description": "due and unpaid past the grace days, late fees included",
+                    "type": "string"
+                },
+                "overdueInstallments": {
                     "type": "integer"
                 },
-                "teacherId": {
+                "paid": {
                     "type": "string"
                 },
-                "teacherName": {
+                "planId": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "ON_TRACK, OVERDUE, COMPLETED or CANCELLED",
+                    "type": "string"
+                },
+                "total": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetGroupByCourseAbsResponse": {
+        "pb.PaymentPlanStatusList": {
             "type": "object",
             "properties": {
-                "dateType": {
-                    "type": "string"
-                },
-                "groupEndDate": {
-                    "type": "string"
-                },
-                "groupName": {
-                    "type": "string"
-                },
-                "groupStartDate": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.PaymentPlanStatus"
+                    }
+                }
+            }
+        },
+        "pb.PaymentReturnRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
                     "type": "string"
                 },
-                "id": {
+                "actionByName": {
                     "type": "string"
                 },
-                "lessonStartTime": {
+                "paymentId": {
                     "type": "string"
                 },
-                "teacherName": {
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetGroupByTeacherAbs": {
+        "pb.PaymentUpdateRequest": {
             "type": "object",
             "properties": {
-                "activeStudentCount": {
-                    "type": "integer"
+                "accountId": {
+                    "type": "string"
                 },
-                "courseName": {
+                "actionById": {
                     "type": "string"
                 },
-                "dayType": {
+                "actionByName": {
                     "type": "string"
                 },
-                "groupEndAt": {
+                "comment": {
                     "type": "string"
                 },
-                "groupStartAt": {
+                "date": {
                     "type": "string"
                 },
-                "id": {
+                "debit": {
                     "type": "string"
                 },
-                "lessonStartTime": {
+                "groupId": {
                     "type": "string"
                 },
-                "name": {
+                "method": {
                     "type": "string"
                 },
-                "roomName": {
+                "paymentId": {
                     "type": "string"
                 },
-                "students": {
+                "reason": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.PlatformHistory": {
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsStudent"
+                        "$ref": "#/definitions/pb.PlatformSnapshot"
                     }
                 }
             }
         },
-        "pb.GetGroupsAbsForStudent": {
+        "pb.PlatformMetrics": {
             "type": "object",
             "properties": {
-                "additionalContact": {
-                    "type": "string"
+                "activeStudents": {
+                    "type": "integer"
                 },
-                "address": {
+                "arr": {
                     "type": "string"
                 },
-                "balance": {
-                    "type": "number"
+                "cohorts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CohortRetention"
+                    }
                 },
-                "condition": {
-                    "type": "string"
+                "demoCompanies": {
+                    "type": "integer"
                 },
-                "createdAt": {
-                    "type": "string"
+                "graceCompanies": {
+                    "type": "integer"
                 },
-                "dateOfBirth": {
+                "lockedCompanies": {
+                    "type": "integer"
+                },
+                "mrr": {
+                    "description": "monthly recurring revenue of the periods paid for today",
                     "type": "string"
                 },
-                "gender": {
-                    "type": "boolean"
+                "payingCompanies": {
+                    "type": "integer"
                 },
-                "groups": {
+                "renewals": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GroupGetAllStudentAbs"
+                        "$ref": "#/definitions/pb.RenewalStat"
                     }
                 },
-                "id": {
-                    "type": "string"
+                "totalCompanies": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.PlatformSnapshot": {
+            "type": "object",
+            "properties": {
+                "activeStudents": {
+                    "type": "integer"
                 },
-                "name": {
+                "arr": {
                     "type": "string"
                 },
-                "passportId": {
-                    "type": "string"
+                "averageHealth": {
+                    "type": "number"
                 },
-                "phone": {
+                "day": {
                     "type": "string"
                 },
-                "telegramUsername": {
+                "demoCompanies": {
+                    "type": "integer"
+                },
+                "graceCompanies": {
+                    "type": "integer"
+                },
+                "lockedCompanies": {
+                    "type": "integer"
+                },
+                "mrr": {
                     "type": "string"
+                },
+                "payingCompanies": {
+                    "type": "integer"
+                },
+                "totalCompanies": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.GetGroupsByCourseResponse": {
-            "type": "object",
-            "properties": {
-                "groups": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.GetGroupByCourseAbsResponse"
-                    }
-                }
-            }
-        },
-        "pb.GetGroupsByTeacherResponse": {
+        "pb.PrepayDiscount": {
             "type": "object",
             "properties": {
-                "groups": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.GetGroupByTeacherAbs"
-                    }
+                "months": {
+                    "type": "integer"
+                },
+                "percent": {
+                    "type": "number"
                 }
             }
         },
-        "pb.GetGroupsRequest": {
+        "pb.PriceOverride": {
             "type": "object",
             "properties": {
-                "courseId": {
-                    "type": "integer"
-                },
-                "dateType": {
-                    "type": "string"
-                },
-                "endDate": {
+                "comment": {
                     "type": "string"
                 },
-                "isArchived": {
-                    "type": "boolean"
-                },
-                "orderBy": {
+                "companyId": {
                     "type": "string"
                 },
-                "orderDirection": {
+                "monthlyPrice": {
+                    "description": "replaces the tariff's monthly price when set",
                     "type": "string"
                 },
-                "page": {
-                    "$ref": "#/definitions/pb.PageRequest"
-                },
-                "startDate": {
-                    "type": "string"
+                "percent": {
+                    "type": "number"
                 },
-                "teacherId": {
+                "validTo": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetGroupsResponse": {
+        "pb.ProfitAndLoss": {
             "type": "object",
             "properties": {
-                "groups": {
+                "expenses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetGroupAbsResponse"
+                        "$ref": "#/definitions/pb.ProfitAndLossRow"
                     }
                 },
-                "totalPageCount": {
-                    "type": "integer"
-                }
-            }
-        },
-        "pb.GetHistoryByUserIdResponse": {
-            "type": "object",
-            "properties": {
-                "histories": {
+                "from": {
+                    "type": "string"
+                },
+                "income": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsGetHistoryByUserIdResponse"
+                        "$ref": "#/definitions/pb.ProfitAndLossRow"
                     }
+                },
+                "netProfit": {
+                    "description": "totalIncome - totalExpense",
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "totalExpense": {
+                    "type": "string"
+                },
+                "totalIncome": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetHistoryDiscountResponse": {
+        "pb.ProfitAndLossRow": {
             "type": "object",
             "properties": {
-                "discounts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsHistoryDiscount"
-                    }
+                "amount": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetHistoryGroupResponse": {
+        "pb.PromoCode": {
             "type": "object",
             "properties": {
-                "groupHistory": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsHistory"
-                    }
+                "amount": {
+                    "type": "string"
                 },
-                "studentsHistory": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsStudentHistory"
-                    }
+                "code": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "maxUses": {
+                    "type": "integer"
+                },
+                "percent": {
+                    "description": "either percent or amount",
+                    "type": "number"
+                },
+                "tariffId": {
+                    "description": "any tariff when 0",
+                    "type": "integer"
+                },
+                "usedCount": {
+                    "type": "integer"
+                },
+                "validFrom": {
+                    "type": "string"
+                },
+                "validTo": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetHistoryStudentResponse": {
+        "pb.PromoCodeList": {
             "type": "object",
             "properties": {
-                "conditionsHistory": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsStudentHistory"
-                    }
-                },
-                "studentHistory": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsHistory"
+                        "$ref": "#/definitions/pb.PromoCode"
                     }
                 }
             }
         },
-        "pb.GetInformationDiscountResponse": {
+        "pb.PublicLeadRequest": {
             "type": "object",
             "properties": {
-                "discounts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsStudentDiscount"
+                "clientIp": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "extra": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "utmCampaign": {
+                    "type": "string"
+                },
+                "utmMedium": {
+                    "type": "string"
+                },
+                "utmSource": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetLeadCommonRequest": {
+        "pb.PublicLeadResponse": {
             "type": "object",
             "properties": {
-                "requests": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.LeadCommonRequest"
-                    }
+                "duplicate": {
+                    "description": "Deprecated: Marked as deprecated in lead.proto.",
+                    "type": "boolean"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.GetLeadCommonResponse": {
+        "pb.QuoteRequest": {
             "type": "object",
             "properties": {
-                "expectations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.Section"
-                    }
+                "companyId": {
+                    "type": "string"
                 },
-                "leads": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.Section"
-                    }
+                "months": {
+                    "type": "integer"
                 },
-                "sets": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.Section"
-                    }
+                "promoCode": {
+                    "type": "string"
+                },
+                "tariffId": {
+                    "description": "the company's current tariff when 0",
+                    "type": "integer"
                 }
             }
         },
-        "pb.GetLeadListResponse": {
+        "pb.ReconciliationReport": {
             "type": "object",
             "properties": {
-                "sections": {
+                "autoCorrected": {
+                    "type": "boolean"
+                },
+                "checkedCount": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "driftCount": {
+                    "type": "integer"
+                },
+                "drifts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.DynamicSection"
+                        "$ref": "#/definitions/pb.BalanceDrift"
                     }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "skippedCount": {
+                    "type": "integer"
+                },
+                "totalDrift": {
+                    "type": "string"
+                },
+                "triggeredBy": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetLeftAfterTrialPeriodResponse": {
+        "pb.RenewalStat": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsGetLeftAfter"
-                    }
+                "churnRate": {
+                    "type": "number"
                 },
-                "totalItemCount": {
+                "churned": {
+                    "type": "integer"
+                },
+                "due": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "renewalRate": {
+                    "type": "number"
+                },
+                "renewed": {
                     "type": "integer"
                 }
             }
         },
-        "pb.GetMonthlyStatusResponse": {
+        "pb.RetryBalanceEventsRequest": {
             "type": "object",
             "properties": {
-                "monthStatus": {
+                "ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsGetMonthlyStatusResponse"
+                        "type": "string"
                     }
                 }
             }
         },
-        "pb.GetNotesByStudent": {
+        "pb.RetryBalanceEventsResponse": {
             "type": "object",
             "properties": {
-                "notes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsNote"
-                    }
+                "retried": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.GetStatisticRequest": {
+        "pb.ReverseJournalEntryRequest": {
             "type": "object",
             "properties": {
-                "from": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "date": {
+                    "description": "YYYY-MM-DD, today by default",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.RuleApplication": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
                 },
-                "to": {
+                "month": {
                     "type": "string"
-                }
-            }
-        },
-        "pb.GetStatisticResponse": {
-            "type": "object",
-            "properties": {
-                "details": {
-                    "$ref": "#/definitions/pb.CompanyCommonDetails"
                 },
-                "paymentDetails": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.OtherDetails"
-                    }
+                "ruleId": {
+                    "type": "string"
                 },
-                "registerDetails": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.OtherDetails"
-                    }
+                "ruleName": {
+                    "type": "string"
+                },
+                "ruleType": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetTeachersResponse": {
+        "pb.RuleApplicationList": {
             "type": "object",
             "properties": {
-                "teachers": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsTeacher"
+                        "$ref": "#/definitions/pb.RuleApplication"
                     }
                 }
             }
         },
-        "pb.GetTeachersSalaryRequest": {
+        "pb.ScheduledInstallment": {
             "type": "object",
             "properties": {
-                "salaries": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsGetTeachersSalary"
-                    }
+                "amount": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetUpdateCourseAbs": {
+        "pb.SearchStudentResponse": {
             "type": "object",
             "properties": {
-                "courses": {
+                "students": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsCourse"
+                        "$ref": "#/definitions/pb.AbsStudent"
                     }
                 }
             }
         },
-        "pb.GetUpdateGroupAbs": {
+        "pb.Section": {
             "type": "object",
             "properties": {
-                "courseId": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "days": {
+                "leads": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.Lead"
                     }
                 },
-                "groupEndDate": {
+                "leadsCount": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "groupStartDate": {
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.SetAttendanceRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
                     "type": "string"
                 },
-                "id": {
+                "actionByRole": {
                     "type": "string"
                 },
-                "lessonStartTime": {
+                "attendDate": {
                     "type": "string"
                 },
-                "name": {
+                "groupId": {
                     "type": "string"
                 },
-                "roomId": {
+                "status": {
                     "type": "integer"
                 },
-                "teacherId": {
+                "studentId": {
                     "type": "string"
                 },
-                "type": {
+                "teacherId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetUpdateRoomAbs": {
-            "type": "object",
-            "properties": {
-                "rooms": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsRoom"
-                    }
-                }
-            }
-        },
-        "pb.GetUserByIdResponse": {
+        "pb.SetDataResponse": {
             "type": "object",
             "properties": {
-                "birthDate": {
+                "courseId": {
                     "type": "string"
                 },
-                "companyId": {
-                    "type": "integer"
-                },
-                "createdAt": {
+                "courseName": {
                     "type": "string"
                 },
-                "gender": {
-                    "type": "boolean"
+                "dateType": {
+                    "type": "string"
                 },
-                "has_access_finance": {
-                    "type": "boolean"
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "id": {
+                "lessonStartTime": {
                     "type": "string"
                 },
-                "is_deleted": {
-                    "type": "boolean"
-                },
-                "name": {
+                "teacherId": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "teacherName": {
                     "type": "string"
                 },
-                "role": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "pb.GroupGetAllStudentAbs": {
+        "pb.SetDebtReminderReplyRequest": {
             "type": "object",
             "properties": {
-                "course": {
-                    "$ref": "#/definitions/pb.AbsCourse"
+                "comment": {
+                    "type": "string"
                 },
-                "days": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "groupEndDate": {
+                "promisedDate": {
                     "type": "string"
                 },
-                "groupStartDate": {
+                "replyStatus": {
                     "type": "string"
                 },
-                "id": {
+                "stop": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.SignupRequest": {
+            "type": "object",
+            "properties": {
+                "ceoName": {
                     "type": "string"
                 },
-                "lessonStartTime": {
+                "ceoPhone": {
                     "type": "string"
                 },
-                "name": {
+                "companyPhone": {
                     "type": "string"
                 },
-                "roomId": {
-                    "type": "integer"
+                "endTime": {
+                    "type": "string"
                 },
-                "studentActivatedAt": {
+                "password": {
                     "type": "string"
                 },
-                "studentCondition": {
+                "startTime": {
                     "type": "string"
                 },
-                "teacherName": {
+                "subdomain": {
                     "type": "string"
                 },
-                "type": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "pb.Lead": {
+        "pb.SignupResponse": {
             "type": "object",
             "properties": {
-                "comment": {
+                "ceoId": {
                     "type": "string"
                 },
-                "createdAt": {
+                "companyId": {
                     "type": "string"
                 },
-                "id": {
+                "subdomain": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "tariffId": {
+                    "type": "integer"
                 },
-                "phoneNumber": {
+                "validDate": {
+                    "description": "last day of the demo",
                     "type": "string"
                 }
             }
         },
-        "pb.LeadCommonRequest": {
+        "pb.SortBy": {
             "type": "object",
             "properties": {
-                "id": {
+                "field": {
                     "type": "string"
                 },
                 "type": {
@@ -7399,69 +12986,81 @@ const docTemplate = `{
                 }
             }
         },
-        "pb.LoginRequest": {
+        "pb.Sponsor": {
             "type": "object",
             "properties": {
-                "companyId": {
+                "balance": {
+                    "description": "paid in less charged, negative when the sponsor owes",
                     "type": "string"
                 },
-                "password": {
+                "comment": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "contactPerson": {
                     "type": "string"
-                }
-            }
-        },
-        "pb.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "isOk": {
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
                     "type": "boolean"
                 },
-                "token": {
+                "name": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "studentCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.OtherDetails": {
+        "pb.SponsorList": {
             "type": "object",
             "properties": {
-                "details": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Sponsor"
                     }
                 }
             }
         },
-        "pb.PageRequest": {
+        "pb.SponsorPaymentRequest": {
             "type": "object",
             "properties": {
-                "companyId": {
+                "accountId": {
                     "type": "string"
                 },
-                "filter": {
+                "actionById": {
                     "type": "string"
                 },
-                "from": {
+                "actionByName": {
                     "type": "string"
                 },
-                "page": {
-                    "type": "integer"
+                "amount": {
+                    "type": "string"
                 },
-                "size": {
-                    "type": "integer"
+                "comment": {
+                    "type": "string"
                 },
-                "to": {
+                "date": {
+                    "type": "string"
+                },
+                "method": {
+                    "description": "CASH, CLICK, PAYME, CARD or TRANSFER, or the method of accountId\nwhen given",
+                    "type": "string"
+                },
+                "sponsorId": {
                     "type": "string"
                 }
             }
         },
-        "pb.PaymentAddRequest": {
+        "pb.SponsorPaymentReturnRequest": {
             "type": "object",
             "properties": {
                 "actionById": {
@@ -7470,50 +13069,57 @@ const docTemplate = `{
                 "actionByName": {
                     "type": "string"
                 },
-                "comment": {
+                "id": {
                     "type": "string"
                 },
-                "date": {
+                "reason": {
                     "type": "string"
-                },
-                "groupId": {
+                }
+            }
+        },
+        "pb.SponsorStatement": {
+            "type": "object",
+            "properties": {
+                "closingBalance": {
                     "type": "string"
                 },
-                "method": {
+                "covered": {
                     "type": "string"
                 },
-                "sum": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.SponsorStatementEntry"
+                    }
+                },
+                "from": {
                     "type": "string"
                 },
-                "type": {
+                "openingBalance": {
                     "type": "string"
                 },
-                "userId": {
-                    "type": "string"
-                }
-            }
-        },
-        "pb.PaymentReturnRequest": {
-            "type": "object",
-            "properties": {
-                "actionById": {
+                "paid": {
                     "type": "string"
                 },
-                "actionByName": {
-                    "type": "string"
+                "sponsor": {
+                    "$ref": "#/definitions/pb.Sponsor"
                 },
-                "paymentId": {
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.SponsorStatementStudent"
+                    }
+                },
+                "to": {
                     "type": "string"
                 }
             }
         },
-        "pb.PaymentUpdateRequest": {
+        "pb.SponsorStatementEntry": {
             "type": "object",
             "properties": {
-                "actionById": {
-                    "type": "string"
-                },
-                "actionByName": {
+                "amount": {
+                    "description": "signed by its effect on the balance",
                     "type": "string"
                 },
                 "comment": {
@@ -7522,123 +13128,95 @@ const docTemplate = `{
                 "date": {
                     "type": "string"
                 },
-                "debit": {
+                "groupId": {
                     "type": "string"
                 },
-                "groupId": {
+                "id": {
                     "type": "string"
                 },
                 "method": {
                     "type": "string"
                 },
-                "paymentId": {
+                "reversalOf": {
                     "type": "string"
                 },
-                "userId": {
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "ADD for a payment, CHARGE for a covered charge, REFUND for a covered refund",
                     "type": "string"
                 }
             }
         },
-        "pb.SearchStudentResponse": {
-            "type": "object",
-            "properties": {
-                "students": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsStudent"
-                    }
-                }
-            }
-        },
-        "pb.Section": {
+        "pb.SponsorStatementStudent": {
             "type": "object",
             "properties": {
-                "id": {
+                "covered": {
                     "type": "string"
                 },
-                "leads": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.Lead"
-                    }
-                },
-                "leadsCount": {
-                    "type": "integer"
-                },
-                "name": {
+                "studentId": {
                     "type": "string"
                 },
-                "type": {
+                "studentName": {
                     "type": "string"
                 }
             }
         },
-        "pb.SetAttendanceRequest": {
+        "pb.Sponsorship": {
             "type": "object",
             "properties": {
-                "actionById": {
+                "coverType": {
+                    "description": "FIXED or PERCENT",
                     "type": "string"
                 },
-                "actionByRole": {
+                "createdAt": {
                     "type": "string"
                 },
-                "attendDate": {
+                "endAt": {
+                    "description": "open ended when empty",
                     "type": "string"
                 },
                 "groupId": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "integer"
-                },
-                "studentId": {
+                "id": {
                     "type": "string"
                 },
-                "teacherId": {
-                    "type": "string"
-                }
-            }
-        },
-        "pb.SetDataResponse": {
-            "type": "object",
-            "properties": {
-                "courseId": {
-                    "type": "string"
+                "isActive": {
+                    "type": "boolean"
                 },
-                "courseName": {
+                "sponsorId": {
                     "type": "string"
                 },
-                "dateType": {
+                "sponsorName": {
                     "type": "string"
                 },
-                "dates": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "lessonStartTime": {
+                "startAt": {
                     "type": "string"
                 },
-                "teacherId": {
+                "studentId": {
                     "type": "string"
                 },
-                "teacherName": {
+                "studentName": {
                     "type": "string"
                 },
-                "title": {
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "pb.SortBy": {
+        "pb.SponsorshipList": {
             "type": "object",
             "properties": {
-                "field": {
-                    "type": "string"
-                },
-                "type": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Sponsorship"
+                    }
                 }
             }
         },
@@ -7658,7 +13236,7 @@ const docTemplate = `{
                     }
                 },
                 "balance": {
-                    "type": "number"
+                    "type": "string"
                 },
                 "condition": {
                     "type": "string"
@@ -7689,11 +13267,11 @@ const docTemplate = `{
         "pb.StudentSalary": {
             "type": "object",
             "properties": {
-                "calculatedSalaryInPeriod": {
-                    "type": "integer"
+                "calculatedSalary": {
+                    "type": "string"
                 },
                 "coursePrice": {
-                    "type": "number"
+                    "type": "string"
                 },
                 "passedLessonCount": {
                     "type": "integer"
@@ -7708,7 +13286,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "totalCount": {
-                    "type": "number"
+                    "type": "string"
                 }
             }
         },
@@ -7719,6 +13297,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "discounts": {
+                    "description": "legacy free-form discounts, prepay_discounts are what quotes use",
                     "type": "string"
                 },
                 "id": {
@@ -7730,11 +13309,17 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "prepay_discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.PrepayDiscount"
+                    }
+                },
                 "student_count": {
                     "type": "integer"
                 },
                 "sum": {
-                    "type": "number"
+                    "type": "string"
                 }
             }
         },
@@ -7752,6 +13337,131 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.TariffQuote": {
+            "type": "object",
+            "properties": {
+                "baseSum": {
+                    "type": "string"
+                },
+                "companyId": {
+                    "type": "string"
+                },
+                "discountSum": {
+                    "type": "string"
+                },
+                "monthlyPrice": {
+                    "type": "string"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "overridePercent": {
+                    "type": "number"
+                },
+                "periodFrom": {
+                    "type": "string"
+                },
+                "prepayPercent": {
+                    "type": "number"
+                },
+                "promoCode": {
+                    "type": "string"
+                },
+                "tariffId": {
+                    "type": "integer"
+                },
+                "tariffName": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "string"
+                },
+                "validDate": {
+                    "description": "the company's valid_date once paid",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.TenantHealth": {
+            "type": "object",
+            "properties": {
+                "companyId": {
+                    "type": "integer"
+                },
+                "day": {
+                    "description": "set on snapshots",
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "integer"
+                },
+                "isDemo": {
+                    "type": "boolean"
+                },
+                "leads": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "payments": {
+                    "type": "integer"
+                },
+                "paymentsSum": {
+                    "type": "string"
+                },
+                "recentLeads": {
+                    "description": "recentLeads, payments and paymentsSum cover the last 30 days",
+                    "type": "integer"
+                },
+                "score": {
+                    "description": "0 to 100",
+                    "type": "integer"
+                },
+                "staff": {
+                    "type": "integer"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "studentLimit": {
+                    "type": "integer"
+                },
+                "students": {
+                    "type": "integer"
+                },
+                "subdomain": {
+                    "type": "string"
+                },
+                "tariffName": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "validDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.TenantHealthList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "incomplete": {
+                    "description": "a service could not be asked, its part of the score is left out",
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.TenantHealth"
+                    }
+                }
+            }
+        },
         "pb.TransferLessonRequest": {
             "type": "object",
             "properties": {
@@ -7766,6 +13476,51 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.TrialBalance": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.TrialBalanceRow"
+                    }
+                },
+                "totalCredit": {
+                    "type": "string"
+                },
+                "totalDebit": {
+                    "description": "equal when the ledger is balanced",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.TrialBalanceRow": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "debit less credit",
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "credit": {
+                    "type": "string"
+                },
+                "debit": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
         "pb.UpdateCompanyRequest": {
             "type": "object",
             "properties": {
@@ -7922,6 +13677,9 @@ const docTemplate = `{
                 },
                 "statusCode": {
                     "type": "integer"
+                },
+                "traceId": {
+                    "type": "string"
                 }
             }
         }
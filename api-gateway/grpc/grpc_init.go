@@ -3,7 +3,7 @@ package grpc
 import (
 	"api-gateway/config"
 	client "api-gateway/internal/clients"
-	"log"
+	"api-gateway/internal/logging"
 )
 
 type Clients struct {
@@ -16,19 +16,19 @@ type Clients struct {
 func InitializeGrpcClients(cfg *config.Config) *Clients {
 	educationClient, err := client.NewEducationClient(cfg.Grpc.EducationService.Address)
 	if err != nil {
-		log.Fatalf("%v", err)
+		logging.Fatal("failed to create education client", err)
 	}
 	userClient, err := client.NewUserClient(cfg.Grpc.UserService.Address)
 	if err != nil {
-		log.Fatalf("%v", err)
+		logging.Fatal("failed to create user client", err)
 	}
 	lidClient, err := client.NewLidClient(cfg.Grpc.LidService.Address)
 	if err != nil {
-		log.Fatalf("%v", err)
+		logging.Fatal("failed to create lead client", err)
 	}
 	financeClient, err := client.NewFinanceClient(cfg.Grpc.FinanceService.Address)
 	if err != nil {
-		log.Fatalf("%v", err)
+		logging.Fatal("failed to create finance client", err)
 	}
 
 	return &Clients{
@@ -23,8 +23,8 @@ const (
 
 type AbsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,7 +75,7 @@ func (x *AbsResponse) GetMessage() string {
 
 type DeleteAbsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -119,12 +119,12 @@ func (x *DeleteAbsRequest) GetId() string {
 
 type PageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size"`
-	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter"`
-	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to"`
-	CompanyId     string                 `protobuf:"bytes,6,opt,name=companyId,proto3" json:"companyId"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,6,opt,name=companyId,proto3" json:"companyId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -201,6 +201,169 @@ func (x *PageRequest) GetCompanyId() string {
 	return ""
 }
 
+// PaymentPlanStatus is where a student's installment plan stands.
+type PaymentPlanStatus struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	PlanId  string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId,omitempty"`
+	GroupId string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// ON_TRACK, OVERDUE, COMPLETED or CANCELLED
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Total       string `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Paid        string `protobuf:"bytes,5,opt,name=paid,proto3" json:"paid,omitempty"`
+	Outstanding string `protobuf:"bytes,6,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	// due and unpaid past the grace days, late fees included
+	Overdue             string `protobuf:"bytes,7,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	OverdueInstallments int32  `protobuf:"varint,8,opt,name=overdueInstallments,proto3" json:"overdueInstallments,omitempty"`
+	NextDueDate         string `protobuf:"bytes,9,opt,name=nextDueDate,proto3" json:"nextDueDate,omitempty"`
+	NextDueAmount       string `protobuf:"bytes,10,opt,name=nextDueAmount,proto3" json:"nextDueAmount,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PaymentPlanStatus) Reset() {
+	*x = PaymentPlanStatus{}
+	mi := &file_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentPlanStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentPlanStatus) ProtoMessage() {}
+
+func (x *PaymentPlanStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentPlanStatus.ProtoReflect.Descriptor instead.
+func (*PaymentPlanStatus) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PaymentPlanStatus) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetOutstanding() string {
+	if x != nil {
+		return x.Outstanding
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetOverdue() string {
+	if x != nil {
+		return x.Overdue
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetOverdueInstallments() int32 {
+	if x != nil {
+		return x.OverdueInstallments
+	}
+	return 0
+}
+
+func (x *PaymentPlanStatus) GetNextDueDate() string {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetNextDueAmount() string {
+	if x != nil {
+		return x.NextDueAmount
+	}
+	return ""
+}
+
+type PaymentPlanStatusList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PaymentPlanStatus   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentPlanStatusList) Reset() {
+	*x = PaymentPlanStatusList{}
+	mi := &file_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentPlanStatusList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentPlanStatusList) ProtoMessage() {}
+
+func (x *PaymentPlanStatusList) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentPlanStatusList.ProtoReflect.Descriptor instead.
+func (*PaymentPlanStatusList) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PaymentPlanStatusList) GetItems() []*PaymentPlanStatus {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
@@ -217,7 +380,21 @@ const file_common_proto_rawDesc = "" +
 	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x12\n" +
 	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x05 \x01(\tR\x02to\x12\x1c\n" +
-	"\tcompanyId\x18\x06 \x01(\tR\tcompanyIdB\x0fZ\rgrpc/proto/pbb\x06proto3"
+	"\tcompanyId\x18\x06 \x01(\tR\tcompanyId\"\xbd\x02\n" +
+	"\x11PaymentPlanStatus\x12\x16\n" +
+	"\x06planId\x18\x01 \x01(\tR\x06planId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\tR\x05total\x12\x12\n" +
+	"\x04paid\x18\x05 \x01(\tR\x04paid\x12 \n" +
+	"\voutstanding\x18\x06 \x01(\tR\voutstanding\x12\x18\n" +
+	"\aoverdue\x18\a \x01(\tR\aoverdue\x120\n" +
+	"\x13overdueInstallments\x18\b \x01(\x05R\x13overdueInstallments\x12 \n" +
+	"\vnextDueDate\x18\t \x01(\tR\vnextDueDate\x12$\n" +
+	"\rnextDueAmount\x18\n" +
+	" \x01(\tR\rnextDueAmount\"H\n" +
+	"\x15PaymentPlanStatusList\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.common.PaymentPlanStatusR\x05itemsB\x0fZ\rgrpc/proto/pbb\x06proto3"
 
 var (
 	file_common_proto_rawDescOnce sync.Once
@@ -231,18 +408,21 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_common_proto_goTypes = []any{
-	(*AbsResponse)(nil),      // 0: common.AbsResponse
-	(*DeleteAbsRequest)(nil), // 1: common.DeleteAbsRequest
-	(*PageRequest)(nil),      // 2: common.PageRequest
+	(*AbsResponse)(nil),           // 0: common.AbsResponse
+	(*DeleteAbsRequest)(nil),      // 1: common.DeleteAbsRequest
+	(*PageRequest)(nil),           // 2: common.PageRequest
+	(*PaymentPlanStatus)(nil),     // 3: common.PaymentPlanStatus
+	(*PaymentPlanStatusList)(nil), // 4: common.PaymentPlanStatusList
 }
 var file_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: common.PaymentPlanStatusList.items:type_name -> common.PaymentPlanStatus
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -256,7 +436,7 @@ func file_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,29 +22,32 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetStatisticResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Details         *CompanyCommonDetails  `protobuf:"bytes,1,opt,name=details,proto3" json:"details"`
-	RegisterDetails []*OtherDetails        `protobuf:"bytes,2,rep,name=registerDetails,proto3" json:"registerDetails"`
-	PaymentDetails  []*OtherDetails        `protobuf:"bytes,3,rep,name=paymentDetails,proto3" json:"paymentDetails"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// companyId may be left empty by a company asking for itself
+type QuoteRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// the company's current tariff when 0
+	TariffId      int32  `protobuf:"varint,2,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	Months        int32  `protobuf:"varint,3,opt,name=months,proto3" json:"months,omitempty"`
+	PromoCode     string `protobuf:"bytes,4,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatisticResponse) Reset() {
-	*x = GetStatisticResponse{}
+func (x *QuoteRequest) Reset() {
+	*x = QuoteRequest{}
 	mi := &file_education_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatisticResponse) String() string {
+func (x *QuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatisticResponse) ProtoMessage() {}
+func (*QuoteRequest) ProtoMessage() {}
 
-func (x *GetStatisticResponse) ProtoReflect() protoreflect.Message {
+func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -56,53 +59,73 @@ func (x *GetStatisticResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatisticResponse.ProtoReflect.Descriptor instead.
-func (*GetStatisticResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuoteRequest.ProtoReflect.Descriptor instead.
+func (*QuoteRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *GetStatisticResponse) GetDetails() *CompanyCommonDetails {
+func (x *QuoteRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Details
+		return x.CompanyId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStatisticResponse) GetRegisterDetails() []*OtherDetails {
+func (x *QuoteRequest) GetTariffId() int32 {
 	if x != nil {
-		return x.RegisterDetails
+		return x.TariffId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetStatisticResponse) GetPaymentDetails() []*OtherDetails {
+func (x *QuoteRequest) GetMonths() int32 {
 	if x != nil {
-		return x.PaymentDetails
+		return x.Months
 	}
-	return nil
+	return 0
 }
 
-type OtherDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Details       map[string]string      `protobuf:"bytes,1,rep,name=details,proto3" json:"details" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *QuoteRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type TariffQuote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId       string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	TariffId        int32                  `protobuf:"varint,2,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	TariffName      string                 `protobuf:"bytes,3,opt,name=tariffName,proto3" json:"tariffName,omitempty"`
+	Months          int32                  `protobuf:"varint,4,opt,name=months,proto3" json:"months,omitempty"`
+	MonthlyPrice    string                 `protobuf:"bytes,5,opt,name=monthlyPrice,proto3" json:"monthlyPrice,omitempty"`
+	BaseSum         string                 `protobuf:"bytes,6,opt,name=baseSum,proto3" json:"baseSum,omitempty"`
+	PrepayPercent   float64                `protobuf:"fixed64,7,opt,name=prepayPercent,proto3" json:"prepayPercent,omitempty"`
+	OverridePercent float64                `protobuf:"fixed64,8,opt,name=overridePercent,proto3" json:"overridePercent,omitempty"`
+	PromoCode       string                 `protobuf:"bytes,9,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	DiscountSum     string                 `protobuf:"bytes,10,opt,name=discountSum,proto3" json:"discountSum,omitempty"`
+	Total           string                 `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
+	PeriodFrom      string                 `protobuf:"bytes,12,opt,name=periodFrom,proto3" json:"periodFrom,omitempty"`
+	// the company's valid_date once paid
+	ValidDate     string `protobuf:"bytes,13,opt,name=validDate,proto3" json:"validDate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OtherDetails) Reset() {
-	*x = OtherDetails{}
+func (x *TariffQuote) Reset() {
+	*x = TariffQuote{}
 	mi := &file_education_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OtherDetails) String() string {
+func (x *TariffQuote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OtherDetails) ProtoMessage() {}
+func (*TariffQuote) ProtoMessage() {}
 
-func (x *OtherDetails) ProtoReflect() protoreflect.Message {
+func (x *TariffQuote) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -114,43 +137,129 @@ func (x *OtherDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OtherDetails.ProtoReflect.Descriptor instead.
-func (*OtherDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use TariffQuote.ProtoReflect.Descriptor instead.
+func (*TariffQuote) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *OtherDetails) GetDetails() map[string]string {
+func (x *TariffQuote) GetCompanyId() string {
 	if x != nil {
-		return x.Details
+		return x.CompanyId
 	}
-	return nil
+	return ""
 }
 
-type CompanyCommonDetails struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ActiveStudents  int32                  `protobuf:"varint,1,opt,name=activeStudents,proto3" json:"activeStudents"`
-	WholeCompanies  int32                  `protobuf:"varint,2,opt,name=wholeCompanies,proto3" json:"wholeCompanies"`
-	DebtorCompanies int32                  `protobuf:"varint,3,opt,name=debtorCompanies,proto3" json:"debtorCompanies"`
-	ActiveCompanies int32                  `protobuf:"varint,4,opt,name=activeCompanies,proto3" json:"activeCompanies"`
-	DemoCompanies   int32                  `protobuf:"varint,5,opt,name=demoCompanies,proto3" json:"demoCompanies"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *TariffQuote) GetTariffId() int32 {
+	if x != nil {
+		return x.TariffId
+	}
+	return 0
 }
 
-func (x *CompanyCommonDetails) Reset() {
-	*x = CompanyCommonDetails{}
+func (x *TariffQuote) GetTariffName() string {
+	if x != nil {
+		return x.TariffName
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetMonthlyPrice() string {
+	if x != nil {
+		return x.MonthlyPrice
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetBaseSum() string {
+	if x != nil {
+		return x.BaseSum
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetPrepayPercent() float64 {
+	if x != nil {
+		return x.PrepayPercent
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetOverridePercent() float64 {
+	if x != nil {
+		return x.OverridePercent
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetDiscountSum() string {
+	if x != nil {
+		return x.DiscountSum
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetPeriodFrom() string {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetValidDate() string {
+	if x != nil {
+		return x.ValidDate
+	}
+	return ""
+}
+
+type Invoice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quote *TariffQuote           `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// OPEN, PAID or VOID
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PaidSum       string `protobuf:"bytes,4,opt,name=paidSum,proto3" json:"paidSum,omitempty"`
+	CreatedAt     string `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	PaidAt        string `protobuf:"bytes,6,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
 	mi := &file_education_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyCommonDetails) String() string {
+func (x *Invoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanyCommonDetails) ProtoMessage() {}
+func (*Invoice) ProtoMessage() {}
 
-func (x *CompanyCommonDetails) ProtoReflect() protoreflect.Message {
+func (x *Invoice) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -162,68 +271,77 @@ func (x *CompanyCommonDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanyCommonDetails.ProtoReflect.Descriptor instead.
-func (*CompanyCommonDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CompanyCommonDetails) GetActiveStudents() int32 {
+func (x *Invoice) GetId() int32 {
 	if x != nil {
-		return x.ActiveStudents
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CompanyCommonDetails) GetWholeCompanies() int32 {
+func (x *Invoice) GetQuote() *TariffQuote {
 	if x != nil {
-		return x.WholeCompanies
+		return x.Quote
 	}
-	return 0
+	return nil
 }
 
-func (x *CompanyCommonDetails) GetDebtorCompanies() int32 {
+func (x *Invoice) GetStatus() string {
 	if x != nil {
-		return x.DebtorCompanies
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyCommonDetails) GetActiveCompanies() int32 {
+func (x *Invoice) GetPaidSum() string {
 	if x != nil {
-		return x.ActiveCompanies
+		return x.PaidSum
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyCommonDetails) GetDemoCompanies() int32 {
+func (x *Invoice) GetCreatedAt() string {
 	if x != nil {
-		return x.DemoCompanies
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-type GetStatisticRequest struct {
+func (x *Invoice) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+type GetInvoicesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatisticRequest) Reset() {
-	*x = GetStatisticRequest{}
+func (x *GetInvoicesRequest) Reset() {
+	*x = GetInvoicesRequest{}
 	mi := &file_education_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatisticRequest) String() string {
+func (x *GetInvoicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatisticRequest) ProtoMessage() {}
+func (*GetInvoicesRequest) ProtoMessage() {}
 
-func (x *GetStatisticRequest) ProtoReflect() protoreflect.Message {
+func (x *GetInvoicesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -235,56 +353,61 @@ func (x *GetStatisticRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatisticRequest.ProtoReflect.Descriptor instead.
-func (*GetStatisticRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoicesRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetStatisticRequest) GetFrom() string {
+func (x *GetInvoicesRequest) GetCompanyId() string {
 	if x != nil {
-		return x.From
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *GetStatisticRequest) GetTo() string {
+func (x *GetInvoicesRequest) GetStatus() string {
 	if x != nil {
-		return x.To
+		return x.Status
 	}
 	return ""
 }
 
-type UpdateCompanyRequest struct {
+func (x *GetInvoicesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetInvoicesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type InvoiceList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
-	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl"`
-	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime"`
-	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime"`
-	CompanyPhone  string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone"`
-	Subdomain     string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain"`
-	ValidDate     string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date"`
-	TariffId      int64                  `protobuf:"varint,9,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id"`
-	DiscountId    string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id"`
-	IsDemo        bool                   `protobuf:"varint,11,opt,name=is_demo,json=isDemo,proto3" json:"is_demo"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*Invoice             `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyRequest) Reset() {
-	*x = UpdateCompanyRequest{}
+func (x *InvoiceList) Reset() {
+	*x = InvoiceList{}
 	mi := &file_education_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyRequest) String() string {
+func (x *InvoiceList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyRequest) ProtoMessage() {}
+func (*InvoiceList) ProtoMessage() {}
 
-func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+func (x *InvoiceList) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,111 +419,157 @@ func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvoiceList.ProtoReflect.Descriptor instead.
+func (*InvoiceList) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateCompanyRequest) GetId() string {
+func (x *InvoiceList) GetCount() int32 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCompanyRequest) GetTitle() string {
+func (x *InvoiceList) GetItems() []*Invoice {
 	if x != nil {
-		return x.Title
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateCompanyRequest) GetAvatarUrl() string {
+type PromoCode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// either percent or amount
+	Percent float64 `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount  string  `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// any tariff when 0
+	TariffId      int32  `protobuf:"varint,4,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	ValidFrom     string `protobuf:"bytes,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidTo       string `protobuf:"bytes,6,opt,name=validTo,proto3" json:"validTo,omitempty"`
+	MaxUses       int32  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	UsedCount     int32  `protobuf:"varint,8,opt,name=usedCount,proto3" json:"usedCount,omitempty"`
+	IsActive      bool   `protobuf:"varint,9,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_education_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[5]
 	if x != nil {
-		return x.AvatarUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateCompanyRequest) GetStartTime() string {
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PromoCode) GetCode() string {
 	if x != nil {
-		return x.StartTime
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetEndTime() string {
+func (x *PromoCode) GetPercent() float64 {
 	if x != nil {
-		return x.EndTime
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCompanyRequest) GetCompanyPhone() string {
+func (x *PromoCode) GetAmount() string {
 	if x != nil {
-		return x.CompanyPhone
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetSubdomain() string {
+func (x *PromoCode) GetTariffId() int32 {
 	if x != nil {
-		return x.Subdomain
+		return x.TariffId
+	}
+	return 0
+}
+
+func (x *PromoCode) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetValidDate() string {
+func (x *PromoCode) GetValidTo() string {
 	if x != nil {
-		return x.ValidDate
+		return x.ValidTo
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetTariffId() int64 {
+func (x *PromoCode) GetMaxUses() int32 {
 	if x != nil {
-		return x.TariffId
+		return x.MaxUses
 	}
 	return 0
 }
 
-func (x *UpdateCompanyRequest) GetDiscountId() string {
+func (x *PromoCode) GetUsedCount() int32 {
 	if x != nil {
-		return x.DiscountId
+		return x.UsedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCompanyRequest) GetIsDemo() bool {
+func (x *PromoCode) GetIsActive() bool {
 	if x != nil {
-		return x.IsDemo
+		return x.IsActive
 	}
 	return false
 }
 
-type GetAllResponse struct {
+type PromoCodeList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*GetCompanyResponse  `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount"`
+	Items         []*PromoCode           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllResponse) Reset() {
-	*x = GetAllResponse{}
-	mi := &file_education_proto_msgTypes[5]
+func (x *PromoCodeList) Reset() {
+	*x = PromoCodeList{}
+	mi := &file_education_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllResponse) String() string {
+func (x *PromoCodeList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllResponse) ProtoMessage() {}
+func (*PromoCodeList) ProtoMessage() {}
 
-func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[5]
+func (x *PromoCodeList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,56 +580,45 @@ func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllResponse.ProtoReflect.Descriptor instead.
-func (*GetAllResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PromoCodeList.ProtoReflect.Descriptor instead.
+func (*PromoCodeList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetAllResponse) GetItems() []*GetCompanyResponse {
+func (x *PromoCodeList) GetItems() []*PromoCode {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *GetAllResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-type CreateCompanyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
-	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl"`
-	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime"`
-	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime"`
-	CompanyPhone  string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone"`
-	Subdomain     string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain"`
-	ValidDate     string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date"`
-	TariffId      int64                  `protobuf:"varint,9,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id"`
-	DiscountId    string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id"`
-	IsDemo        bool                   `protobuf:"varint,1,opt,name=is_demo,json=isDemo,proto3" json:"is_demo"`
+type PriceOverride struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// replaces the tariff's monthly price when set
+	MonthlyPrice  string  `protobuf:"bytes,2,opt,name=monthlyPrice,proto3" json:"monthlyPrice,omitempty"`
+	Percent       float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	ValidTo       string  `protobuf:"bytes,4,opt,name=validTo,proto3" json:"validTo,omitempty"`
+	Comment       string  `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyRequest) Reset() {
-	*x = CreateCompanyRequest{}
-	mi := &file_education_proto_msgTypes[6]
+func (x *PriceOverride) Reset() {
+	*x = PriceOverride{}
+	mi := &file_education_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyRequest) String() string {
+func (x *PriceOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompanyRequest) ProtoMessage() {}
+func (*PriceOverride) ProtoMessage() {}
 
-func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[6]
+func (x *PriceOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,103 +629,112 @@ func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceOverride.ProtoReflect.Descriptor instead.
+func (*PriceOverride) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateCompanyRequest) GetTitle() string {
+func (x *PriceOverride) GetCompanyId() string {
 	if x != nil {
-		return x.Title
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetAvatarUrl() string {
+func (x *PriceOverride) GetMonthlyPrice() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.MonthlyPrice
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetStartTime() string {
+func (x *PriceOverride) GetPercent() float64 {
 	if x != nil {
-		return x.StartTime
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCompanyRequest) GetEndTime() string {
+func (x *PriceOverride) GetValidTo() string {
 	if x != nil {
-		return x.EndTime
+		return x.ValidTo
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetCompanyPhone() string {
+func (x *PriceOverride) GetComment() string {
 	if x != nil {
-		return x.CompanyPhone
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetSubdomain() string {
-	if x != nil {
-		return x.Subdomain
-	}
-	return ""
+type GetPriceOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyRequest) GetValidDate() string {
-	if x != nil {
-		return x.ValidDate
-	}
-	return ""
+func (x *GetPriceOverrideRequest) Reset() {
+	*x = GetPriceOverrideRequest{}
+	mi := &file_education_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyRequest) GetTariffId() int64 {
-	if x != nil {
-		return x.TariffId
-	}
-	return 0
+func (x *GetPriceOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateCompanyRequest) GetDiscountId() string {
+func (*GetPriceOverrideRequest) ProtoMessage() {}
+
+func (x *GetPriceOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[8]
 	if x != nil {
-		return x.DiscountId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCompanyRequest) GetIsDemo() bool {
+// Deprecated: Use GetPriceOverrideRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPriceOverrideRequest) GetCompanyId() string {
 	if x != nil {
-		return x.IsDemo
+		return x.CompanyId
 	}
-	return false
+	return ""
 }
 
-type GetCompanyRequest struct {
+type CheckSubdomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	Subdomain     string                 `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyRequest) Reset() {
-	*x = GetCompanyRequest{}
-	mi := &file_education_proto_msgTypes[7]
+func (x *CheckSubdomainRequest) Reset() {
+	*x = CheckSubdomainRequest{}
+	mi := &file_education_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyRequest) String() string {
+func (x *CheckSubdomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyRequest) ProtoMessage() {}
+func (*CheckSubdomainRequest) ProtoMessage() {}
 
-func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[7]
+func (x *CheckSubdomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,53 +745,43 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CheckSubdomainRequest.ProtoReflect.Descriptor instead.
+func (*CheckSubdomainRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetCompanyRequest) GetDomain() string {
+func (x *CheckSubdomainRequest) GetSubdomain() string {
 	if x != nil {
-		return x.Domain
+		return x.Subdomain
 	}
 	return ""
 }
 
-type GetCompanyResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
-	AvatarUrl          string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl"`
-	StartTime          string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime"`
-	EndTime            string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime"`
-	CompanyPhone       string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone"`
-	Subdomain          string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain"`
-	ValidDate          string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date"`
-	Tariff             *Tariff                `protobuf:"bytes,9,opt,name=tariff,proto3" json:"tariff"`
-	DiscountId         string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id"`
-	CreatedAt          string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	IsDemo             bool                   `protobuf:"varint,12,opt,name=is_demo,json=isDemo,proto3" json:"is_demo"`
-	ActiveStudentCount int32                  `protobuf:"varint,13,opt,name=active_student_count,json=activeStudentCount,proto3" json:"active_student_count"`
-	CeoId              string                 `protobuf:"bytes,14,opt,name=ceo_id,json=ceoId,proto3" json:"ceo_id"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CheckSubdomainResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Subdomain string                 `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	Available bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// why the subdomain can not be taken, empty when available
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyResponse) Reset() {
-	*x = GetCompanyResponse{}
-	mi := &file_education_proto_msgTypes[8]
+func (x *CheckSubdomainResponse) Reset() {
+	*x = CheckSubdomainResponse{}
+	mi := &file_education_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyResponse) String() string {
+func (x *CheckSubdomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyResponse) ProtoMessage() {}
+func (*CheckSubdomainResponse) ProtoMessage() {}
 
-func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[8]
+func (x *CheckSubdomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,137 +792,159 @@ func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CheckSubdomainResponse.ProtoReflect.Descriptor instead.
+func (*CheckSubdomainResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetCompanyResponse) GetId() string {
+func (x *CheckSubdomainResponse) GetSubdomain() string {
 	if x != nil {
-		return x.Id
+		return x.Subdomain
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetTitle() string {
+func (x *CheckSubdomainResponse) GetAvailable() bool {
 	if x != nil {
-		return x.Title
+		return x.Available
 	}
-	return ""
+	return false
 }
 
-func (x *GetCompanyResponse) GetAvatarUrl() string {
+func (x *CheckSubdomainResponse) GetReason() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
-	}
-	return ""
+type SignupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Subdomain     string                 `protobuf:"bytes,2,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	CompanyPhone  string                 `protobuf:"bytes,3,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	CeoName       string                 `protobuf:"bytes,6,opt,name=ceoName,proto3" json:"ceoName,omitempty"`
+	CeoPhone      string                 `protobuf:"bytes,7,opt,name=ceoPhone,proto3" json:"ceoPhone,omitempty"`
+	Password      string                 `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyResponse) GetEndTime() string {
-	if x != nil {
-		return x.EndTime
-	}
-	return ""
+func (x *SignupRequest) Reset() {
+	*x = SignupRequest{}
+	mi := &file_education_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyResponse) GetCompanyPhone() string {
+func (x *SignupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignupRequest) ProtoMessage() {}
+
+func (x *SignupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[11]
 	if x != nil {
-		return x.CompanyPhone
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCompanyResponse) GetSubdomain() string {
+// Deprecated: Use SignupRequest.ProtoReflect.Descriptor instead.
+func (*SignupRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SignupRequest) GetTitle() string {
 	if x != nil {
-		return x.Subdomain
+		return x.Title
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetValidDate() string {
+func (x *SignupRequest) GetSubdomain() string {
 	if x != nil {
-		return x.ValidDate
+		return x.Subdomain
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetTariff() *Tariff {
+func (x *SignupRequest) GetCompanyPhone() string {
 	if x != nil {
-		return x.Tariff
+		return x.CompanyPhone
 	}
-	return nil
+	return ""
 }
 
-func (x *GetCompanyResponse) GetDiscountId() string {
+func (x *SignupRequest) GetStartTime() string {
 	if x != nil {
-		return x.DiscountId
+		return x.StartTime
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetCreatedAt() string {
+func (x *SignupRequest) GetEndTime() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.EndTime
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetIsDemo() bool {
+func (x *SignupRequest) GetCeoName() string {
 	if x != nil {
-		return x.IsDemo
+		return x.CeoName
 	}
-	return false
+	return ""
 }
 
-func (x *GetCompanyResponse) GetActiveStudentCount() int32 {
+func (x *SignupRequest) GetCeoPhone() string {
 	if x != nil {
-		return x.ActiveStudentCount
+		return x.CeoPhone
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCompanyResponse) GetCeoId() string {
+func (x *SignupRequest) GetPassword() string {
 	if x != nil {
-		return x.CeoId
+		return x.Password
 	}
 	return ""
 }
 
-type Tariff struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	StudentCount  int32                  `protobuf:"varint,3,opt,name=student_count,json=studentCount,proto3" json:"student_count"`
-	Sum           float32                `protobuf:"fixed32,4,opt,name=sum,proto3" json:"sum"`
-	Discounts     string                 `protobuf:"bytes,5,opt,name=discounts,proto3" json:"discounts"`
-	IsDeleted     bool                   `protobuf:"varint,6,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+type SignupResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	Subdomain string                 `protobuf:"bytes,2,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	CeoId     string                 `protobuf:"bytes,3,opt,name=ceoId,proto3" json:"ceoId,omitempty"`
+	// last day of the demo
+	ValidDate     string `protobuf:"bytes,4,opt,name=validDate,proto3" json:"validDate,omitempty"`
+	TariffId      int32  `protobuf:"varint,5,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tariff) Reset() {
-	*x = Tariff{}
-	mi := &file_education_proto_msgTypes[9]
+func (x *SignupResponse) Reset() {
+	*x = SignupResponse{}
+	mi := &file_education_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tariff) String() string {
+func (x *SignupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tariff) ProtoMessage() {}
+func (*SignupResponse) ProtoMessage() {}
 
-func (x *Tariff) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[9]
+func (x *SignupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,83 +955,67 @@ func (x *Tariff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
-func (*Tariff) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Tariff) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use SignupResponse.ProtoReflect.Descriptor instead.
+func (*SignupResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Tariff) GetName() string {
+func (x *SignupResponse) GetCompanyId() string {
 	if x != nil {
-		return x.Name
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *Tariff) GetStudentCount() int32 {
-	if x != nil {
-		return x.StudentCount
-	}
-	return 0
-}
-
-func (x *Tariff) GetSum() float32 {
+func (x *SignupResponse) GetSubdomain() string {
 	if x != nil {
-		return x.Sum
+		return x.Subdomain
 	}
-	return 0
+	return ""
 }
 
-func (x *Tariff) GetDiscounts() string {
+func (x *SignupResponse) GetCeoId() string {
 	if x != nil {
-		return x.Discounts
+		return x.CeoId
 	}
 	return ""
 }
 
-func (x *Tariff) GetIsDeleted() bool {
+func (x *SignupResponse) GetValidDate() string {
 	if x != nil {
-		return x.IsDeleted
+		return x.ValidDate
 	}
-	return false
+	return ""
 }
 
-func (x *Tariff) GetCreatedAt() string {
+func (x *SignupResponse) GetTariffId() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TariffId
 	}
-	return ""
+	return 0
 }
 
-type TariffList struct {
+type GetSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	Items         []*Tariff              `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TariffList) Reset() {
-	*x = TariffList{}
-	mi := &file_education_proto_msgTypes[10]
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_education_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TariffList) String() string {
+func (x *GetSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TariffList) ProtoMessage() {}
+func (*GetSubscriptionRequest) ProtoMessage() {}
 
-func (x *TariffList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[10]
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,56 +1026,43 @@ func (x *TariffList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TariffList.ProtoReflect.Descriptor instead.
-func (*TariffList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TariffList) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *TariffList) GetItems() []*Tariff {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-type CompanyFinance struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	CompanyId       int32                  `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id"`
-	TariffId        int32                  `protobuf:"varint,3,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id"`
-	TariffSum       float32                `protobuf:"fixed32,10,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum"`
-	Comment         string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
-	Sum             float32                `protobuf:"fixed32,5,opt,name=sum,proto3" json:"sum"`
-	EditedValidDate string                 `protobuf:"bytes,6,opt,name=edited_valid_date,json=editedValidDate,proto3" json:"edited_valid_date"`
-	CreatedAt       string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	DiscountId      string                 `protobuf:"bytes,8,opt,name=discount_id,json=discountId,proto3" json:"discount_id"`
-	DiscountName    string                 `protobuf:"bytes,9,opt,name=discount_name,json=discountName,proto3" json:"discount_name"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CompanySubscription struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// ACTIVE, GRACE (read-only after valid_date) or LOCKED
+	State          string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ValidDate      string `protobuf:"bytes,3,opt,name=validDate,proto3" json:"validDate,omitempty"`
+	GraceUntil     string `protobuf:"bytes,4,opt,name=graceUntil,proto3" json:"graceUntil,omitempty"`
+	DaysLeft       int32  `protobuf:"varint,5,opt,name=daysLeft,proto3" json:"daysLeft,omitempty"`
+	IsDemo         bool   `protobuf:"varint,6,opt,name=isDemo,proto3" json:"isDemo,omitempty"`
+	TariffId       int32  `protobuf:"varint,7,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	TariffName     string `protobuf:"bytes,8,opt,name=tariffName,proto3" json:"tariffName,omitempty"`
+	StudentLimit   int32  `protobuf:"varint,9,opt,name=studentLimit,proto3" json:"studentLimit,omitempty"`
+	ActiveStudents int32  `protobuf:"varint,10,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CompanyFinance) Reset() {
-	*x = CompanyFinance{}
-	mi := &file_education_proto_msgTypes[11]
+func (x *CompanySubscription) Reset() {
+	*x = CompanySubscription{}
+	mi := &file_education_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyFinance) String() string {
+func (x *CompanySubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanyFinance) ProtoMessage() {}
+func (*CompanySubscription) ProtoMessage() {}
 
-func (x *CompanyFinance) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[11]
+func (x *CompanySubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,112 +1073,105 @@ func (x *CompanyFinance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanyFinance.ProtoReflect.Descriptor instead.
-func (*CompanyFinance) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CompanySubscription.ProtoReflect.Descriptor instead.
+func (*CompanySubscription) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CompanyFinance) GetId() int32 {
+func (x *CompanySubscription) GetCompanyId() string {
 	if x != nil {
-		return x.Id
+		return x.CompanyId
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyFinance) GetCompanyId() int32 {
+func (x *CompanySubscription) GetState() string {
 	if x != nil {
-		return x.CompanyId
+		return x.State
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyFinance) GetTariffId() int32 {
+func (x *CompanySubscription) GetValidDate() string {
 	if x != nil {
-		return x.TariffId
+		return x.ValidDate
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyFinance) GetTariffSum() float32 {
+func (x *CompanySubscription) GetGraceUntil() string {
 	if x != nil {
-		return x.TariffSum
+		return x.GraceUntil
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyFinance) GetComment() string {
+func (x *CompanySubscription) GetDaysLeft() int32 {
 	if x != nil {
-		return x.Comment
+		return x.DaysLeft
 	}
-	return ""
+	return 0
 }
 
-func (x *CompanyFinance) GetSum() float32 {
+func (x *CompanySubscription) GetIsDemo() bool {
 	if x != nil {
-		return x.Sum
+		return x.IsDemo
 	}
-	return 0
+	return false
 }
 
-func (x *CompanyFinance) GetEditedValidDate() string {
+func (x *CompanySubscription) GetTariffId() int32 {
 	if x != nil {
-		return x.EditedValidDate
+		return x.TariffId
 	}
-	return ""
+	return 0
 }
 
-func (x *CompanyFinance) GetCreatedAt() string {
+func (x *CompanySubscription) GetTariffName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.TariffName
 	}
 	return ""
 }
 
-func (x *CompanyFinance) GetDiscountId() string {
+func (x *CompanySubscription) GetStudentLimit() int32 {
 	if x != nil {
-		return x.DiscountId
+		return x.StudentLimit
 	}
-	return ""
+	return 0
 }
 
-func (x *CompanyFinance) GetDiscountName() string {
+func (x *CompanySubscription) GetActiveStudents() int32 {
 	if x != nil {
-		return x.DiscountName
+		return x.ActiveStudents
 	}
-	return ""
+	return 0
 }
 
-type CompanyFinanceSelf struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	TariffId      int32                  `protobuf:"varint,2,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id"`
-	TariffSum     int32                  `protobuf:"varint,3,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
-	Sum           float32                `protobuf:"fixed32,5,opt,name=sum,proto3" json:"sum"`
-	EditValidDate string                 `protobuf:"bytes,6,opt,name=edit_valid_date,json=editValidDate,proto3" json:"edit_valid_date"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	DiscountId    string                 `protobuf:"bytes,8,opt,name=discount_id,json=discountId,proto3" json:"discount_id"`
-	DiscountName  string                 `protobuf:"bytes,9,opt,name=discount_name,json=discountName,proto3" json:"discount_name"`
-	TariffName    string                 `protobuf:"bytes,10,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetStatisticResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Details         *CompanyCommonDetails  `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	RegisterDetails []*OtherDetails        `protobuf:"bytes,2,rep,name=registerDetails,proto3" json:"registerDetails,omitempty"`
+	PaymentDetails  []*OtherDetails        `protobuf:"bytes,3,rep,name=paymentDetails,proto3" json:"paymentDetails,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CompanyFinanceSelf) Reset() {
-	*x = CompanyFinanceSelf{}
-	mi := &file_education_proto_msgTypes[12]
+func (x *GetStatisticResponse) Reset() {
+	*x = GetStatisticResponse{}
+	mi := &file_education_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyFinanceSelf) String() string {
+func (x *GetStatisticResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanyFinanceSelf) ProtoMessage() {}
+func (*GetStatisticResponse) ProtoMessage() {}
 
-func (x *CompanyFinanceSelf) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[12]
+func (x *GetStatisticResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,108 +1182,102 @@ func (x *CompanyFinanceSelf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanyFinanceSelf.ProtoReflect.Descriptor instead.
-func (*CompanyFinanceSelf) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetStatisticResponse.ProtoReflect.Descriptor instead.
+func (*GetStatisticResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CompanyFinanceSelf) GetId() int32 {
+func (x *GetStatisticResponse) GetDetails() *CompanyCommonDetails {
 	if x != nil {
-		return x.Id
+		return x.Details
 	}
-	return 0
+	return nil
 }
 
-func (x *CompanyFinanceSelf) GetTariffId() int32 {
+func (x *GetStatisticResponse) GetRegisterDetails() []*OtherDetails {
 	if x != nil {
-		return x.TariffId
+		return x.RegisterDetails
 	}
-	return 0
+	return nil
 }
 
-func (x *CompanyFinanceSelf) GetTariffSum() int32 {
+func (x *GetStatisticResponse) GetPaymentDetails() []*OtherDetails {
 	if x != nil {
-		return x.TariffSum
+		return x.PaymentDetails
 	}
-	return 0
+	return nil
 }
 
-func (x *CompanyFinanceSelf) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
+type OtherDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Details       map[string]string      `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompanyFinanceSelf) GetSum() float32 {
-	if x != nil {
-		return x.Sum
-	}
-	return 0
+func (x *OtherDetails) Reset() {
+	*x = OtherDetails{}
+	mi := &file_education_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyFinanceSelf) GetEditValidDate() string {
-	if x != nil {
-		return x.EditValidDate
-	}
-	return ""
+func (x *OtherDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CompanyFinanceSelf) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
+func (*OtherDetails) ProtoMessage() {}
 
-func (x *CompanyFinanceSelf) GetDiscountId() string {
+func (x *OtherDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[16]
 	if x != nil {
-		return x.DiscountId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CompanyFinanceSelf) GetDiscountName() string {
-	if x != nil {
-		return x.DiscountName
-	}
-	return ""
+// Deprecated: Use OtherDetails.ProtoReflect.Descriptor instead.
+func (*OtherDetails) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CompanyFinanceSelf) GetTariffName() string {
+func (x *OtherDetails) GetDetails() map[string]string {
 	if x != nil {
-		return x.TariffName
+		return x.Details
 	}
-	return ""
+	return nil
 }
 
-type CompanyFinanceSelfList struct {
+type CompanyCommonDetails struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Count           int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	SumAmountPeriod float32                `protobuf:"fixed32,2,opt,name=sum_amount_period,json=sumAmountPeriod,proto3" json:"sum_amount_period"`
-	TariffName      string                 `protobuf:"bytes,3,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name"`
-	DiscountName    string                 `protobuf:"bytes,4,opt,name=discount_name,json=discountName,proto3" json:"discount_name"`
-	RequiredSum     float32                `protobuf:"fixed32,5,opt,name=required_sum,json=requiredSum,proto3" json:"required_sum"`
-	Items           []*CompanyFinanceSelf  `protobuf:"bytes,6,rep,name=items,proto3" json:"items"`
+	ActiveStudents  int32                  `protobuf:"varint,1,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	WholeCompanies  int32                  `protobuf:"varint,2,opt,name=wholeCompanies,proto3" json:"wholeCompanies,omitempty"`
+	DebtorCompanies int32                  `protobuf:"varint,3,opt,name=debtorCompanies,proto3" json:"debtorCompanies,omitempty"`
+	ActiveCompanies int32                  `protobuf:"varint,4,opt,name=activeCompanies,proto3" json:"activeCompanies,omitempty"`
+	DemoCompanies   int32                  `protobuf:"varint,5,opt,name=demoCompanies,proto3" json:"demoCompanies,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CompanyFinanceSelfList) Reset() {
-	*x = CompanyFinanceSelfList{}
-	mi := &file_education_proto_msgTypes[13]
+func (x *CompanyCommonDetails) Reset() {
+	*x = CompanyCommonDetails{}
+	mi := &file_education_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyFinanceSelfList) String() string {
+func (x *CompanyCommonDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanyFinanceSelfList) ProtoMessage() {}
+func (*CompanyCommonDetails) ProtoMessage() {}
 
-func (x *CompanyFinanceSelfList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[13]
+func (x *CompanyCommonDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,76 +1288,69 @@ func (x *CompanyFinanceSelfList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanyFinanceSelfList.ProtoReflect.Descriptor instead.
-func (*CompanyFinanceSelfList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CompanyCommonDetails.ProtoReflect.Descriptor instead.
+func (*CompanyCommonDetails) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CompanyFinanceSelfList) GetCount() int32 {
+func (x *CompanyCommonDetails) GetActiveStudents() int32 {
 	if x != nil {
-		return x.Count
+		return x.ActiveStudents
 	}
 	return 0
 }
 
-func (x *CompanyFinanceSelfList) GetSumAmountPeriod() float32 {
+func (x *CompanyCommonDetails) GetWholeCompanies() int32 {
 	if x != nil {
-		return x.SumAmountPeriod
+		return x.WholeCompanies
 	}
 	return 0
 }
 
-func (x *CompanyFinanceSelfList) GetTariffName() string {
-	if x != nil {
-		return x.TariffName
-	}
-	return ""
-}
-
-func (x *CompanyFinanceSelfList) GetDiscountName() string {
+func (x *CompanyCommonDetails) GetDebtorCompanies() int32 {
 	if x != nil {
-		return x.DiscountName
+		return x.DebtorCompanies
 	}
-	return ""
+	return 0
 }
 
-func (x *CompanyFinanceSelfList) GetRequiredSum() float32 {
+func (x *CompanyCommonDetails) GetActiveCompanies() int32 {
 	if x != nil {
-		return x.RequiredSum
+		return x.ActiveCompanies
 	}
 	return 0
 }
 
-func (x *CompanyFinanceSelfList) GetItems() []*CompanyFinanceSelf {
+func (x *CompanyCommonDetails) GetDemoCompanies() int32 {
 	if x != nil {
-		return x.Items
+		return x.DemoCompanies
 	}
-	return nil
+	return 0
 }
 
-type CompanyFinanceList struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Count         int32                    `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	Items         []*CompanyFinanceForList `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+type GetStatisticRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompanyFinanceList) Reset() {
-	*x = CompanyFinanceList{}
-	mi := &file_education_proto_msgTypes[14]
+func (x *GetStatisticRequest) Reset() {
+	*x = GetStatisticRequest{}
+	mi := &file_education_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyFinanceList) String() string {
+func (x *GetStatisticRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanyFinanceList) ProtoMessage() {}
+func (*GetStatisticRequest) ProtoMessage() {}
 
-func (x *CompanyFinanceList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[14]
+func (x *GetStatisticRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,57 +1361,57 @@ func (x *CompanyFinanceList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanyFinanceList.ProtoReflect.Descriptor instead.
-func (*CompanyFinanceList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetStatisticRequest.ProtoReflect.Descriptor instead.
+func (*GetStatisticRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CompanyFinanceList) GetCount() int32 {
+func (x *GetStatisticRequest) GetFrom() string {
 	if x != nil {
-		return x.Count
+		return x.From
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyFinanceList) GetItems() []*CompanyFinanceForList {
+func (x *GetStatisticRequest) GetTo() string {
 	if x != nil {
-		return x.Items
+		return x.To
 	}
-	return nil
+	return ""
 }
 
-type CompanyFinanceForList struct {
+type UpdateCompanyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	CompanyName   string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name"`
-	CompanyId     int32                  `protobuf:"varint,3,opt,name=company_id,json=companyId,proto3" json:"company_id"`
-	StartFrom     string                 `protobuf:"bytes,4,opt,name=start_from,json=startFrom,proto3" json:"start_from"`
-	FinishedTo    string                 `protobuf:"bytes,5,opt,name=finished_to,json=finishedTo,proto3" json:"finished_to"`
-	TariffId      string                 `protobuf:"bytes,6,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id"`
-	TariffName    string                 `protobuf:"bytes,7,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name"`
-	Sum           float32                `protobuf:"fixed32,8,opt,name=sum,proto3" json:"sum"`
-	DiscountId    string                 `protobuf:"bytes,9,opt,name=discount_id,json=discountId,proto3" json:"discount_id"`
-	DiscountName  string                 `protobuf:"bytes,10,opt,name=discount_name,json=discountName,proto3" json:"discount_name"`
-	TariffSum     float32                `protobuf:"fixed32,11,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	CompanyPhone  string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
+	Subdomain     string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	ValidDate     string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
+	TariffId      int64                  `protobuf:"varint,9,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	DiscountId    string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	IsDemo        bool                   `protobuf:"varint,11,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompanyFinanceForList) Reset() {
-	*x = CompanyFinanceForList{}
-	mi := &file_education_proto_msgTypes[15]
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	mi := &file_education_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyFinanceForList) String() string {
+func (x *UpdateCompanyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanyFinanceForList) ProtoMessage() {}
+func (*UpdateCompanyRequest) ProtoMessage() {}
 
-func (x *CompanyFinanceForList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[15]
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,111 +1422,111 @@ func (x *CompanyFinanceForList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanyFinanceForList.ProtoReflect.Descriptor instead.
-func (*CompanyFinanceForList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CompanyFinanceForList) GetId() int32 {
+func (x *UpdateCompanyRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyFinanceForList) GetCompanyName() string {
+func (x *UpdateCompanyRequest) GetTitle() string {
 	if x != nil {
-		return x.CompanyName
+		return x.Title
 	}
 	return ""
 }
 
-func (x *CompanyFinanceForList) GetCompanyId() int32 {
+func (x *UpdateCompanyRequest) GetAvatarUrl() string {
 	if x != nil {
-		return x.CompanyId
+		return x.AvatarUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyFinanceForList) GetStartFrom() string {
+func (x *UpdateCompanyRequest) GetStartTime() string {
 	if x != nil {
-		return x.StartFrom
+		return x.StartTime
 	}
 	return ""
 }
 
-func (x *CompanyFinanceForList) GetFinishedTo() string {
+func (x *UpdateCompanyRequest) GetEndTime() string {
 	if x != nil {
-		return x.FinishedTo
+		return x.EndTime
 	}
 	return ""
 }
 
-func (x *CompanyFinanceForList) GetTariffId() string {
+func (x *UpdateCompanyRequest) GetCompanyPhone() string {
 	if x != nil {
-		return x.TariffId
+		return x.CompanyPhone
 	}
 	return ""
 }
 
-func (x *CompanyFinanceForList) GetTariffName() string {
+func (x *UpdateCompanyRequest) GetSubdomain() string {
 	if x != nil {
-		return x.TariffName
+		return x.Subdomain
 	}
 	return ""
 }
 
-func (x *CompanyFinanceForList) GetSum() float32 {
+func (x *UpdateCompanyRequest) GetValidDate() string {
 	if x != nil {
-		return x.Sum
+		return x.ValidDate
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyFinanceForList) GetDiscountId() string {
+func (x *UpdateCompanyRequest) GetTariffId() int64 {
 	if x != nil {
-		return x.DiscountId
+		return x.TariffId
 	}
-	return ""
+	return 0
 }
 
-func (x *CompanyFinanceForList) GetDiscountName() string {
+func (x *UpdateCompanyRequest) GetDiscountId() string {
 	if x != nil {
-		return x.DiscountName
+		return x.DiscountId
 	}
 	return ""
 }
 
-func (x *CompanyFinanceForList) GetTariffSum() float32 {
+func (x *UpdateCompanyRequest) GetIsDemo() bool {
 	if x != nil {
-		return x.TariffSum
+		return x.IsDemo
 	}
-	return 0
+	return false
 }
 
-type CreateRoomRequest struct {
+type GetAllResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity"`
+	Items         []*GetCompanyResponse  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoomRequest) Reset() {
-	*x = CreateRoomRequest{}
-	mi := &file_education_proto_msgTypes[16]
+func (x *GetAllResponse) Reset() {
+	*x = GetAllResponse{}
+	mi := &file_education_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoomRequest) String() string {
+func (x *GetAllResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomRequest) ProtoMessage() {}
+func (*GetAllResponse) ProtoMessage() {}
 
-func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[16]
+func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,47 +1537,56 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetAllResponse.ProtoReflect.Descriptor instead.
+func (*GetAllResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateRoomRequest) GetName() string {
+func (x *GetAllResponse) GetItems() []*GetCompanyResponse {
 	if x != nil {
-		return x.Name
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateRoomRequest) GetCapacity() int32 {
+func (x *GetAllResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Capacity
+		return x.TotalCount
 	}
 	return 0
 }
 
-type GetUpdateRoomAbs struct {
+type CreateCompanyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rooms         []*AbsRoom             `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	CompanyPhone  string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
+	Subdomain     string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	ValidDate     string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
+	TariffId      int64                  `protobuf:"varint,9,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	DiscountId    string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	IsDemo        bool                   `protobuf:"varint,1,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateRoomAbs) Reset() {
-	*x = GetUpdateRoomAbs{}
-	mi := &file_education_proto_msgTypes[17]
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_education_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateRoomAbs) String() string {
+func (x *CreateCompanyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateRoomAbs) ProtoMessage() {}
+func (*CreateCompanyRequest) ProtoMessage() {}
 
-func (x *GetUpdateRoomAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[17]
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,104 +1597,103 @@ func (x *GetUpdateRoomAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateRoomAbs.ProtoReflect.Descriptor instead.
-func (*GetUpdateRoomAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetUpdateRoomAbs) GetRooms() []*AbsRoom {
+func (x *CreateCompanyRequest) GetTitle() string {
 	if x != nil {
-		return x.Rooms
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-type AbsRoom struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateCompanyRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
 }
 
-func (x *AbsRoom) Reset() {
-	*x = AbsRoom{}
-	mi := &file_education_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateCompanyRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
 }
 
-func (x *AbsRoom) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateCompanyRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
 }
 
-func (*AbsRoom) ProtoMessage() {}
-
-func (x *AbsRoom) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[18]
+func (x *CreateCompanyRequest) GetCompanyPhone() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CompanyPhone
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AbsRoom.ProtoReflect.Descriptor instead.
-func (*AbsRoom) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{18}
+func (x *CreateCompanyRequest) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
 }
 
-func (x *AbsRoom) GetId() string {
+func (x *CreateCompanyRequest) GetValidDate() string {
 	if x != nil {
-		return x.Id
+		return x.ValidDate
 	}
 	return ""
 }
 
-func (x *AbsRoom) GetCapacity() int32 {
+func (x *CreateCompanyRequest) GetTariffId() int64 {
 	if x != nil {
-		return x.Capacity
+		return x.TariffId
 	}
 	return 0
 }
 
-func (x *AbsRoom) GetName() string {
+func (x *CreateCompanyRequest) GetDiscountId() string {
 	if x != nil {
-		return x.Name
+		return x.DiscountId
 	}
 	return ""
 }
 
-type CreateCourseRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration"`
-	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
-	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CreateCompanyRequest) GetIsDemo() bool {
+	if x != nil {
+		return x.IsDemo
+	}
+	return false
 }
 
-func (x *CreateCourseRequest) Reset() {
-	*x = CreateCourseRequest{}
-	mi := &file_education_proto_msgTypes[19]
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_education_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCourseRequest) String() string {
+func (x *GetCompanyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCourseRequest) ProtoMessage() {}
+func (*GetCompanyRequest) ProtoMessage() {}
 
-func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[19]
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1566,68 +1704,53 @@ func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
-func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateCourseRequest) GetName() string {
+func (x *GetCompanyRequest) GetDomain() string {
 	if x != nil {
-		return x.Name
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *CreateCourseRequest) GetLessonDuration() int32 {
-	if x != nil {
-		return x.LessonDuration
-	}
-	return 0
+type GetCompanyResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AvatarUrl          string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	StartTime          string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime            string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	CompanyPhone       string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
+	Subdomain          string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	ValidDate          string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
+	Tariff             *Tariff                `protobuf:"bytes,9,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	DiscountId         string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsDemo             bool                   `protobuf:"varint,12,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
+	ActiveStudentCount int32                  `protobuf:"varint,13,opt,name=active_student_count,json=activeStudentCount,proto3" json:"active_student_count,omitempty"`
+	CeoId              string                 `protobuf:"bytes,14,opt,name=ceo_id,json=ceoId,proto3" json:"ceo_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CreateCourseRequest) GetCourseDuration() int32 {
-	if x != nil {
-		return x.CourseDuration
-	}
-	return 0
+func (x *GetCompanyResponse) Reset() {
+	*x = GetCompanyResponse{}
+	mi := &file_education_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCourseRequest) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *CreateCourseRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-type GetUpdateCourseAbs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Courses       []*AbsCourse           `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUpdateCourseAbs) Reset() {
-	*x = GetUpdateCourseAbs{}
-	mi := &file_education_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetUpdateCourseAbs) String() string {
+func (x *GetCompanyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateCourseAbs) ProtoMessage() {}
+func (*GetCompanyResponse) ProtoMessage() {}
 
-func (x *GetUpdateCourseAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[20]
+func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,130 +1761,139 @@ func (x *GetUpdateCourseAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateCourseAbs.ProtoReflect.Descriptor instead.
-func (*GetUpdateCourseAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetUpdateCourseAbs) GetCourses() []*AbsCourse {
+func (x *GetCompanyResponse) GetId() string {
 	if x != nil {
-		return x.Courses
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type AbsCourse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration"`
-	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
-	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCompanyResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *AbsCourse) Reset() {
-	*x = AbsCourse{}
-	mi := &file_education_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetCompanyResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
 }
 
-func (x *AbsCourse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetCompanyResponse) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
 }
 
-func (*AbsCourse) ProtoMessage() {}
+func (x *GetCompanyResponse) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
 
-func (x *AbsCourse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[21]
+func (x *GetCompanyResponse) GetCompanyPhone() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CompanyPhone
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AbsCourse.ProtoReflect.Descriptor instead.
-func (*AbsCourse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{21}
+func (x *GetCompanyResponse) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
 }
 
-func (x *AbsCourse) GetId() string {
+func (x *GetCompanyResponse) GetValidDate() string {
 	if x != nil {
-		return x.Id
+		return x.ValidDate
 	}
 	return ""
 }
 
-func (x *AbsCourse) GetName() string {
+func (x *GetCompanyResponse) GetTariff() *Tariff {
 	if x != nil {
-		return x.Name
+		return x.Tariff
+	}
+	return nil
+}
+
+func (x *GetCompanyResponse) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
 	}
 	return ""
 }
 
-func (x *AbsCourse) GetLessonDuration() int32 {
+func (x *GetCompanyResponse) GetCreatedAt() string {
 	if x != nil {
-		return x.LessonDuration
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *AbsCourse) GetCourseDuration() int32 {
+func (x *GetCompanyResponse) GetIsDemo() bool {
 	if x != nil {
-		return x.CourseDuration
+		return x.IsDemo
 	}
-	return 0
+	return false
 }
 
-func (x *AbsCourse) GetPrice() float64 {
+func (x *GetCompanyResponse) GetActiveStudentCount() int32 {
 	if x != nil {
-		return x.Price
+		return x.ActiveStudentCount
 	}
 	return 0
 }
 
-func (x *AbsCourse) GetDescription() string {
+func (x *GetCompanyResponse) GetCeoId() string {
 	if x != nil {
-		return x.Description
+		return x.CeoId
 	}
 	return ""
 }
 
-type GetCourseByIdResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration"`
-	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
-	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`
-	StudentCount   int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type Tariff struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StudentCount int32                  `protobuf:"varint,3,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+	Sum          string                 `protobuf:"bytes,4,opt,name=sum,proto3" json:"sum,omitempty"`
+	// legacy free-form discounts, prepay_discounts are what quotes use
+	Discounts       string            `protobuf:"bytes,5,opt,name=discounts,proto3" json:"discounts,omitempty"`
+	IsDeleted       bool              `protobuf:"varint,6,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	CreatedAt       string            `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PrepayDiscounts []*PrepayDiscount `protobuf:"bytes,8,rep,name=prepay_discounts,json=prepayDiscounts,proto3" json:"prepay_discounts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetCourseByIdResponse) Reset() {
-	*x = GetCourseByIdResponse{}
-	mi := &file_education_proto_msgTypes[22]
+func (x *Tariff) Reset() {
+	*x = Tariff{}
+	mi := &file_education_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseByIdResponse) String() string {
+func (x *Tariff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseByIdResponse) ProtoMessage() {}
+func (*Tariff) ProtoMessage() {}
 
-func (x *GetCourseByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[22]
+func (x *Tariff) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1772,82 +1904,91 @@ func (x *GetCourseByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseByIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
+func (*Tariff) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetCourseByIdResponse) GetId() string {
+func (x *Tariff) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCourseByIdResponse) GetName() string {
+func (x *Tariff) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetCourseByIdResponse) GetLessonDuration() int32 {
+func (x *Tariff) GetStudentCount() int32 {
 	if x != nil {
-		return x.LessonDuration
+		return x.StudentCount
 	}
 	return 0
 }
 
-func (x *GetCourseByIdResponse) GetCourseDuration() int32 {
+func (x *Tariff) GetSum() string {
 	if x != nil {
-		return x.CourseDuration
+		return x.Sum
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCourseByIdResponse) GetPrice() float64 {
+func (x *Tariff) GetDiscounts() string {
 	if x != nil {
-		return x.Price
+		return x.Discounts
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCourseByIdResponse) GetDescription() string {
+func (x *Tariff) GetIsDeleted() bool {
 	if x != nil {
-		return x.Description
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *Tariff) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetCourseByIdResponse) GetStudentCount() int32 {
+func (x *Tariff) GetPrepayDiscounts() []*PrepayDiscount {
 	if x != nil {
-		return x.StudentCount
+		return x.PrepayDiscounts
 	}
-	return 0
+	return nil
 }
 
-type GetCourseByIdRequest struct {
+// percent off when paying for at least months at once
+type PrepayDiscount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Months        int32                  `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	Percent       float64                `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseByIdRequest) Reset() {
-	*x = GetCourseByIdRequest{}
-	mi := &file_education_proto_msgTypes[23]
+func (x *PrepayDiscount) Reset() {
+	*x = PrepayDiscount{}
+	mi := &file_education_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseByIdRequest) String() string {
+func (x *PrepayDiscount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseByIdRequest) ProtoMessage() {}
+func (*PrepayDiscount) ProtoMessage() {}
 
-func (x *GetCourseByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[23]
+func (x *PrepayDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,43 +1999,48 @@ func (x *GetCourseByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseByIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PrepayDiscount.ProtoReflect.Descriptor instead.
+func (*PrepayDiscount) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetCourseByIdRequest) GetId() string {
+func (x *PrepayDiscount) GetMonths() int32 {
 	if x != nil {
-		return x.Id
+		return x.Months
 	}
-	return ""
+	return 0
 }
 
-type GetLeftAfterTrialPeriodRequest struct {
+func (x *PrepayDiscount) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type TariffList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	Page          string                 `protobuf:"bytes,3,opt,name=page,proto3" json:"page"`
-	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*Tariff              `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) Reset() {
-	*x = GetLeftAfterTrialPeriodRequest{}
-	mi := &file_education_proto_msgTypes[24]
+func (x *TariffList) Reset() {
+	*x = TariffList{}
+	mi := &file_education_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) String() string {
+func (x *TariffList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeftAfterTrialPeriodRequest) ProtoMessage() {}
+func (*TariffList) ProtoMessage() {}
 
-func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[24]
+func (x *TariffList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,62 +2051,58 @@ func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeftAfterTrialPeriodRequest.ProtoReflect.Descriptor instead.
-func (*GetLeftAfterTrialPeriodRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use TariffList.ProtoReflect.Descriptor instead.
+func (*TariffList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetFrom() string {
+func (x *TariffList) GetCount() int32 {
 	if x != nil {
-		return x.From
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetTo() string {
+func (x *TariffList) GetItems() []*Tariff {
 	if x != nil {
-		return x.To
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetPage() string {
-	if x != nil {
-		return x.Page
-	}
-	return ""
+type CompanyFinance struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId       int32                  `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	TariffId        int32                  `protobuf:"varint,3,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	TariffSum       string                 `protobuf:"bytes,10,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
+	Comment         string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Sum             string                 `protobuf:"bytes,5,opt,name=sum,proto3" json:"sum,omitempty"`
+	EditedValidDate string                 `protobuf:"bytes,6,opt,name=edited_valid_date,json=editedValidDate,proto3" json:"edited_valid_date,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DiscountId      string                 `protobuf:"bytes,8,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	DiscountName    string                 `protobuf:"bytes,9,opt,name=discount_name,json=discountName,proto3" json:"discount_name,omitempty"`
+	// the invoice paid; when 0 an open invoice of the same total is matched
+	InvoiceId     int32 `protobuf:"varint,11,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetSize() string {
-	if x != nil {
-		return x.Size
-	}
-	return ""
-}
-
-type GetLeftAfterTrialPeriodResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Items          []*AbsGetLeftAfter     `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
-	TotalItemCount int32                  `protobuf:"varint,2,opt,name=totalItemCount,proto3" json:"totalItemCount"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetLeftAfterTrialPeriodResponse) Reset() {
-	*x = GetLeftAfterTrialPeriodResponse{}
-	mi := &file_education_proto_msgTypes[25]
+func (x *CompanyFinance) Reset() {
+	*x = CompanyFinance{}
+	mi := &file_education_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) String() string {
+func (x *CompanyFinance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeftAfterTrialPeriodResponse) ProtoMessage() {}
+func (*CompanyFinance) ProtoMessage() {}
 
-func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[25]
+func (x *CompanyFinance) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,159 +2113,119 @@ func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeftAfterTrialPeriodResponse.ProtoReflect.Descriptor instead.
-func (*GetLeftAfterTrialPeriodResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CompanyFinance.ProtoReflect.Descriptor instead.
+func (*CompanyFinance) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) GetItems() []*AbsGetLeftAfter {
+func (x *CompanyFinance) GetId() int32 {
 	if x != nil {
-		return x.Items
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) GetTotalItemCount() int32 {
+func (x *CompanyFinance) GetCompanyId() int32 {
 	if x != nil {
-		return x.TotalItemCount
+		return x.CompanyId
 	}
 	return 0
 }
 
-type AbsGetLeftAfter struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
-	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
-	StudentPhone   string                 `protobuf:"bytes,3,opt,name=studentPhone,proto3" json:"studentPhone"`
-	StudentBalance string                 `protobuf:"bytes,4,opt,name=studentBalance,proto3" json:"studentBalance"`
-	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId"`
-	GroupName      string                 `protobuf:"bytes,6,opt,name=groupName,proto3" json:"groupName"`
-	ReturnMoney    bool                   `protobuf:"varint,7,opt,name=returnMoney,proto3" json:"returnMoney"`
-	CreatedAt      string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt"`
-	SpecificDate   string                 `protobuf:"bytes,9,opt,name=specificDate,proto3" json:"specificDate"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *AbsGetLeftAfter) Reset() {
-	*x = AbsGetLeftAfter{}
-	mi := &file_education_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AbsGetLeftAfter) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AbsGetLeftAfter) ProtoMessage() {}
-
-func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[26]
+func (x *CompanyFinance) GetTariffId() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TariffId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AbsGetLeftAfter.ProtoReflect.Descriptor instead.
-func (*AbsGetLeftAfter) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{26}
+	return 0
 }
 
-func (x *AbsGetLeftAfter) GetStudentId() string {
+func (x *CompanyFinance) GetTariffSum() string {
 	if x != nil {
-		return x.StudentId
+		return x.TariffSum
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetStudentName() string {
+func (x *CompanyFinance) GetComment() string {
 	if x != nil {
-		return x.StudentName
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetStudentPhone() string {
+func (x *CompanyFinance) GetSum() string {
 	if x != nil {
-		return x.StudentPhone
+		return x.Sum
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetStudentBalance() string {
+func (x *CompanyFinance) GetEditedValidDate() string {
 	if x != nil {
-		return x.StudentBalance
+		return x.EditedValidDate
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetGroupId() string {
+func (x *CompanyFinance) GetCreatedAt() string {
 	if x != nil {
-		return x.GroupId
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetGroupName() string {
+func (x *CompanyFinance) GetDiscountId() string {
 	if x != nil {
-		return x.GroupName
+		return x.DiscountId
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetReturnMoney() bool {
-	if x != nil {
-		return x.ReturnMoney
-	}
-	return false
-}
-
-func (x *AbsGetLeftAfter) GetCreatedAt() string {
+func (x *CompanyFinance) GetDiscountName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.DiscountName
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetSpecificDate() string {
+func (x *CompanyFinance) GetInvoiceId() int32 {
 	if x != nil {
-		return x.SpecificDate
+		return x.InvoiceId
 	}
-	return ""
+	return 0
 }
 
-type GetCommonInformationEducationResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ActiveStudentCount int32                  `protobuf:"varint,1,opt,name=activeStudentCount,proto3" json:"activeStudentCount"`
-	ActiveGroupCount   int32                  `protobuf:"varint,2,opt,name=activeGroupCount,proto3" json:"activeGroupCount"`
-	LeaveGroupCount    int32                  `protobuf:"varint,3,opt,name=leaveGroupCount,proto3" json:"leaveGroupCount"`
-	DebtorsCount       int32                  `protobuf:"varint,4,opt,name=debtorsCount,proto3" json:"debtorsCount"`
-	EleminatedInTrial  int32                  `protobuf:"varint,5,opt,name=eleminatedInTrial,proto3" json:"eleminatedInTrial"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CompanyFinanceSelf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TariffId      int32                  `protobuf:"varint,2,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	TariffSum     string                 `protobuf:"bytes,3,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Sum           string                 `protobuf:"bytes,5,opt,name=sum,proto3" json:"sum,omitempty"`
+	EditValidDate string                 `protobuf:"bytes,6,opt,name=edit_valid_date,json=editValidDate,proto3" json:"edit_valid_date,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DiscountId    string                 `protobuf:"bytes,8,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	DiscountName  string                 `protobuf:"bytes,9,opt,name=discount_name,json=discountName,proto3" json:"discount_name,omitempty"`
+	TariffName    string                 `protobuf:"bytes,10,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommonInformationEducationResponse) Reset() {
-	*x = GetCommonInformationEducationResponse{}
-	mi := &file_education_proto_msgTypes[27]
+func (x *CompanyFinanceSelf) Reset() {
+	*x = CompanyFinanceSelf{}
+	mi := &file_education_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommonInformationEducationResponse) String() string {
+func (x *CompanyFinanceSelf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommonInformationEducationResponse) ProtoMessage() {}
+func (*CompanyFinanceSelf) ProtoMessage() {}
 
-func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[27]
+func (x *CompanyFinanceSelf) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,119 +2236,107 @@ func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommonInformationEducationResponse.ProtoReflect.Descriptor instead.
-func (*GetCommonInformationEducationResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CompanyFinanceSelf.ProtoReflect.Descriptor instead.
+func (*CompanyFinanceSelf) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetCommonInformationEducationResponse) GetActiveStudentCount() int32 {
+func (x *CompanyFinanceSelf) GetId() int32 {
 	if x != nil {
-		return x.ActiveStudentCount
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetCommonInformationEducationResponse) GetActiveGroupCount() int32 {
+func (x *CompanyFinanceSelf) GetTariffId() int32 {
 	if x != nil {
-		return x.ActiveGroupCount
+		return x.TariffId
 	}
 	return 0
 }
 
-func (x *GetCommonInformationEducationResponse) GetLeaveGroupCount() int32 {
+func (x *CompanyFinanceSelf) GetTariffSum() string {
 	if x != nil {
-		return x.LeaveGroupCount
+		return x.TariffSum
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCommonInformationEducationResponse) GetDebtorsCount() int32 {
+func (x *CompanyFinanceSelf) GetComment() string {
 	if x != nil {
-		return x.DebtorsCount
+		return x.Comment
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCommonInformationEducationResponse) GetEleminatedInTrial() int32 {
+func (x *CompanyFinanceSelf) GetSum() string {
 	if x != nil {
-		return x.EleminatedInTrial
+		return x.Sum
 	}
-	return 0
-}
-
-type GetGroupsByTeacherIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
-	IsArchived    bool                   `protobuf:"varint,2,opt,name=isArchived,proto3" json:"isArchived"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetGroupsByTeacherIdRequest) Reset() {
-	*x = GetGroupsByTeacherIdRequest{}
-	mi := &file_education_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *GetGroupsByTeacherIdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CompanyFinanceSelf) GetEditValidDate() string {
+	if x != nil {
+		return x.EditValidDate
+	}
+	return ""
 }
 
-func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
-
-func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[28]
+func (x *CompanyFinanceSelf) GetCreatedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{28}
+func (x *CompanyFinanceSelf) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
 }
 
-func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
+func (x *CompanyFinanceSelf) GetDiscountName() string {
 	if x != nil {
-		return x.TeacherId
+		return x.DiscountName
 	}
 	return ""
 }
 
-func (x *GetGroupsByTeacherIdRequest) GetIsArchived() bool {
+func (x *CompanyFinanceSelf) GetTariffName() string {
 	if x != nil {
-		return x.IsArchived
+		return x.TariffName
 	}
-	return false
+	return ""
 }
 
-type GetGroupsByTeacherResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Groups        []*GetGroupByTeacherAbs `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CompanyFinanceSelfList struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Count           int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	SumAmountPeriod string                 `protobuf:"bytes,2,opt,name=sum_amount_period,json=sumAmountPeriod,proto3" json:"sum_amount_period,omitempty"`
+	TariffName      string                 `protobuf:"bytes,3,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name,omitempty"`
+	DiscountName    string                 `protobuf:"bytes,4,opt,name=discount_name,json=discountName,proto3" json:"discount_name,omitempty"`
+	RequiredSum     string                 `protobuf:"bytes,5,opt,name=required_sum,json=requiredSum,proto3" json:"required_sum,omitempty"`
+	Items           []*CompanyFinanceSelf  `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetGroupsByTeacherResponse) Reset() {
-	*x = GetGroupsByTeacherResponse{}
+func (x *CompanyFinanceSelfList) Reset() {
+	*x = CompanyFinanceSelfList{}
 	mi := &file_education_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByTeacherResponse) String() string {
+func (x *CompanyFinanceSelfList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByTeacherResponse) ProtoMessage() {}
+func (*CompanyFinanceSelfList) ProtoMessage() {}
 
-func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
+func (x *CompanyFinanceSelfList) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2258,163 +2348,136 @@ func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompanyFinanceSelfList.ProtoReflect.Descriptor instead.
+func (*CompanyFinanceSelfList) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
+func (x *CompanyFinanceSelfList) GetCount() int32 {
 	if x != nil {
-		return x.Groups
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type GetGroupByTeacherAbs struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	CourseName         string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName"`
-	RoomName           string                 `protobuf:"bytes,3,opt,name=roomName,proto3" json:"roomName"`
-	LessonStartTime    string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	DayType            string                 `protobuf:"bytes,5,opt,name=dayType,proto3" json:"dayType"`
-	GroupStartAt       string                 `protobuf:"bytes,6,opt,name=groupStartAt,proto3" json:"groupStartAt"`
-	GroupEndAt         string                 `protobuf:"bytes,7,opt,name=groupEndAt,proto3" json:"groupEndAt"`
-	ActiveStudentCount int32                  `protobuf:"varint,8,opt,name=activeStudentCount,proto3" json:"activeStudentCount"`
-	Students           []*AbsStudent          `protobuf:"bytes,9,rep,name=students,proto3" json:"students"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *CompanyFinanceSelfList) GetSumAmountPeriod() string {
+	if x != nil {
+		return x.SumAmountPeriod
+	}
+	return ""
 }
 
-func (x *GetGroupByTeacherAbs) Reset() {
-	*x = GetGroupByTeacherAbs{}
-	mi := &file_education_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetGroupByTeacherAbs) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetGroupByTeacherAbs) ProtoMessage() {}
-
-func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[30]
+func (x *CompanyFinanceSelfList) GetTariffName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TariffName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
-func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{30}
+	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetId() string {
+func (x *CompanyFinanceSelfList) GetDiscountName() string {
 	if x != nil {
-		return x.Id
+		return x.DiscountName
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetName() string {
+func (x *CompanyFinanceSelfList) GetRequiredSum() string {
 	if x != nil {
-		return x.Name
+		return x.RequiredSum
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetCourseName() string {
+func (x *CompanyFinanceSelfList) GetItems() []*CompanyFinanceSelf {
 	if x != nil {
-		return x.CourseName
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *GetGroupByTeacherAbs) GetRoomName() string {
-	if x != nil {
-		return x.RoomName
-	}
-	return ""
+type CompanyFinanceList struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Count         int32                    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*CompanyFinanceForList `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByTeacherAbs) GetLessonStartTime() string {
-	if x != nil {
-		return x.LessonStartTime
-	}
-	return ""
+func (x *CompanyFinanceList) Reset() {
+	*x = CompanyFinanceList{}
+	mi := &file_education_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByTeacherAbs) GetDayType() string {
-	if x != nil {
-		return x.DayType
-	}
-	return ""
+func (x *CompanyFinanceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetGroupByTeacherAbs) GetGroupStartAt() string {
+func (*CompanyFinanceList) ProtoMessage() {}
+
+func (x *CompanyFinanceList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[30]
 	if x != nil {
-		return x.GroupStartAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupByTeacherAbs) GetGroupEndAt() string {
-	if x != nil {
-		return x.GroupEndAt
-	}
-	return ""
+// Deprecated: Use CompanyFinanceList.ProtoReflect.Descriptor instead.
+func (*CompanyFinanceList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetGroupByTeacherAbs) GetActiveStudentCount() int32 {
+func (x *CompanyFinanceList) GetCount() int32 {
 	if x != nil {
-		return x.ActiveStudentCount
+		return x.Count
 	}
 	return 0
 }
 
-func (x *GetGroupByTeacherAbs) GetStudents() []*AbsStudent {
+func (x *CompanyFinanceList) GetItems() []*CompanyFinanceForList {
 	if x != nil {
-		return x.Students
+		return x.Items
 	}
 	return nil
 }
 
-type CreateGroupRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
-	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days"`
-	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId"`
-	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CompanyFinanceForList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyName   string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	CompanyId     int32                  `protobuf:"varint,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	StartFrom     string                 `protobuf:"bytes,4,opt,name=start_from,json=startFrom,proto3" json:"start_from,omitempty"`
+	FinishedTo    string                 `protobuf:"bytes,5,opt,name=finished_to,json=finishedTo,proto3" json:"finished_to,omitempty"`
+	TariffId      string                 `protobuf:"bytes,6,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	TariffName    string                 `protobuf:"bytes,7,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name,omitempty"`
+	Sum           string                 `protobuf:"bytes,8,opt,name=sum,proto3" json:"sum,omitempty"`
+	DiscountId    string                 `protobuf:"bytes,9,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	DiscountName  string                 `protobuf:"bytes,10,opt,name=discount_name,json=discountName,proto3" json:"discount_name,omitempty"`
+	TariffSum     string                 `protobuf:"bytes,11,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
+func (x *CompanyFinanceForList) Reset() {
+	*x = CompanyFinanceForList{}
 	mi := &file_education_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *CompanyFinanceForList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*CompanyFinanceForList) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *CompanyFinanceForList) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2426,97 +2489,110 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompanyFinanceForList.ProtoReflect.Descriptor instead.
+func (*CompanyFinanceForList) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreateGroupRequest) GetName() string {
+func (x *CompanyFinanceForList) GetId() int32 {
 	if x != nil {
-		return x.Name
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompanyFinanceForList) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetCourseId() int32 {
+func (x *CompanyFinanceForList) GetCompanyId() int32 {
 	if x != nil {
-		return x.CourseId
+		return x.CompanyId
 	}
 	return 0
 }
 
-func (x *CreateGroupRequest) GetTeacherId() string {
+func (x *CompanyFinanceForList) GetStartFrom() string {
 	if x != nil {
-		return x.TeacherId
+		return x.StartFrom
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetType() string {
+func (x *CompanyFinanceForList) GetFinishedTo() string {
 	if x != nil {
-		return x.Type
+		return x.FinishedTo
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetDays() []string {
+func (x *CompanyFinanceForList) GetTariffId() string {
 	if x != nil {
-		return x.Days
+		return x.TariffId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateGroupRequest) GetRoomId() int32 {
+func (x *CompanyFinanceForList) GetTariffName() string {
 	if x != nil {
-		return x.RoomId
+		return x.TariffName
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateGroupRequest) GetLessonStartTime() string {
+func (x *CompanyFinanceForList) GetSum() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Sum
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetGroupStartDate() string {
+func (x *CompanyFinanceForList) GetDiscountId() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.DiscountId
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetGroupEndDate() string {
+func (x *CompanyFinanceForList) GetDiscountName() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.DiscountName
 	}
 	return ""
 }
 
-type GetGroupByIdRequest struct {
+func (x *CompanyFinanceForList) GetTariffSum() string {
+	if x != nil {
+		return x.TariffSum
+	}
+	return ""
+}
+
+type CreateRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	ActionRole    string                 `protobuf:"bytes,2,opt,name=actionRole,proto3" json:"actionRole"`
-	ActionId      string                 `protobuf:"bytes,3,opt,name=actionId,proto3" json:"actionId"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByIdRequest) Reset() {
-	*x = GetGroupByIdRequest{}
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
 	mi := &file_education_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByIdRequest) String() string {
+func (x *CreateRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByIdRequest) ProtoMessage() {}
+func (*CreateRoomRequest) ProtoMessage() {}
 
-func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2528,62 +2604,46 @@ func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetGroupByIdRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GetGroupByIdRequest) GetActionRole() string {
+func (x *CreateRoomRequest) GetName() string {
 	if x != nil {
-		return x.ActionRole
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetGroupByIdRequest) GetActionId() string {
+func (x *CreateRoomRequest) GetCapacity() int32 {
 	if x != nil {
-		return x.ActionId
+		return x.Capacity
 	}
-	return ""
+	return 0
 }
 
-type GetUpdateGroupAbs struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
-	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days"`
-	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId"`
-	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetUpdateRoomAbs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*AbsRoom             `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateGroupAbs) Reset() {
-	*x = GetUpdateGroupAbs{}
+func (x *GetUpdateRoomAbs) Reset() {
+	*x = GetUpdateRoomAbs{}
 	mi := &file_education_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateGroupAbs) String() string {
+func (x *GetUpdateRoomAbs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateGroupAbs) ProtoMessage() {}
+func (*GetUpdateRoomAbs) ProtoMessage() {}
 
-func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
+func (x *GetUpdateRoomAbs) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2595,103 +2655,176 @@ func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateGroupAbs.ProtoReflect.Descriptor instead.
-func (*GetUpdateGroupAbs) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUpdateRoomAbs.ProtoReflect.Descriptor instead.
+func (*GetUpdateRoomAbs) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetUpdateGroupAbs) GetId() string {
+func (x *GetUpdateRoomAbs) GetRooms() []*AbsRoom {
 	if x != nil {
-		return x.Id
+		return x.Rooms
 	}
-	return ""
+	return nil
 }
 
-func (x *GetUpdateGroupAbs) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type AbsRoom struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateGroupAbs) GetCourseId() int32 {
-	if x != nil {
-		return x.CourseId
-	}
-	return 0
+func (x *AbsRoom) Reset() {
+	*x = AbsRoom{}
+	mi := &file_education_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateGroupAbs) GetTeacherId() string {
-	if x != nil {
-		return x.TeacherId
-	}
-	return ""
+func (x *AbsRoom) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetUpdateGroupAbs) GetType() string {
+func (*AbsRoom) ProtoMessage() {}
+
+func (x *AbsRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[34]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetUpdateGroupAbs) GetDays() []string {
+// Deprecated: Use AbsRoom.ProtoReflect.Descriptor instead.
+func (*AbsRoom) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AbsRoom) GetId() string {
 	if x != nil {
-		return x.Days
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetRoomId() int32 {
+func (x *AbsRoom) GetCapacity() int32 {
 	if x != nil {
-		return x.RoomId
+		return x.Capacity
 	}
 	return 0
 }
 
-func (x *GetUpdateGroupAbs) GetLessonStartTime() string {
+func (x *AbsRoom) GetName() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetGroupStartDate() string {
+type CreateCourseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
+	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCourseRequest) Reset() {
+	*x = CreateCourseRequest{}
+	mi := &file_education_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCourseRequest) ProtoMessage() {}
+
+func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[35]
 	if x != nil {
-		return x.GroupStartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateCourseRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetGroupEndDate() string {
+func (x *CreateCourseRequest) GetLessonDuration() int32 {
 	if x != nil {
-		return x.GroupEndDate
+		return x.LessonDuration
+	}
+	return 0
+}
+
+func (x *CreateCourseRequest) GetCourseDuration() int32 {
+	if x != nil {
+		return x.CourseDuration
+	}
+	return 0
+}
+
+func (x *CreateCourseRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
 	}
 	return ""
 }
 
-type GetGroupsByCourseResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Groups        []*GetGroupByCourseAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
+func (x *CreateCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetUpdateCourseAbs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*AbsCourse           `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByCourseResponse) Reset() {
-	*x = GetGroupsByCourseResponse{}
-	mi := &file_education_proto_msgTypes[34]
+func (x *GetUpdateCourseAbs) Reset() {
+	*x = GetUpdateCourseAbs{}
+	mi := &file_education_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByCourseResponse) String() string {
+func (x *GetUpdateCourseAbs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByCourseResponse) ProtoMessage() {}
+func (*GetUpdateCourseAbs) ProtoMessage() {}
 
-func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[34]
+func (x *GetUpdateCourseAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2702,46 +2835,45 @@ func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByCourseResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByCourseResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetUpdateCourseAbs.ProtoReflect.Descriptor instead.
+func (*GetUpdateCourseAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetGroupsByCourseResponse) GetGroups() []*GetGroupByCourseAbsResponse {
+func (x *GetUpdateCourseAbs) GetCourses() []*AbsCourse {
 	if x != nil {
-		return x.Groups
+		return x.Courses
 	}
 	return nil
 }
 
-type GetGroupByCourseAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	TeacherName     string                 `protobuf:"bytes,2,opt,name=teacherName,proto3" json:"teacherName"`
-	GroupStartDate  string                 `protobuf:"bytes,3,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate    string                 `protobuf:"bytes,4,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	DateType        string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType"`
-	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	GroupName       string                 `protobuf:"bytes,7,opt,name=groupName,proto3" json:"groupName"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AbsCourse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
+	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupByCourseAbsResponse) Reset() {
-	*x = GetGroupByCourseAbsResponse{}
-	mi := &file_education_proto_msgTypes[35]
+func (x *AbsCourse) Reset() {
+	*x = AbsCourse{}
+	mi := &file_education_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByCourseAbsResponse) String() string {
+func (x *AbsCourse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByCourseAbsResponse) ProtoMessage() {}
+func (*AbsCourse) ProtoMessage() {}
 
-func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[35]
+func (x *AbsCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,95 +2884,81 @@ func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByCourseAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupByCourseAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use AbsCourse.ProtoReflect.Descriptor instead.
+func (*AbsCourse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetGroupByCourseAbsResponse) GetId() string {
+func (x *AbsCourse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetTeacherName() string {
-	if x != nil {
-		return x.TeacherName
-	}
-	return ""
-}
-
-func (x *GetGroupByCourseAbsResponse) GetGroupStartDate() string {
+func (x *AbsCourse) GetName() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupEndDate() string {
+func (x *AbsCourse) GetLessonDuration() int32 {
 	if x != nil {
-		return x.GroupEndDate
+		return x.LessonDuration
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByCourseAbsResponse) GetDateType() string {
+func (x *AbsCourse) GetCourseDuration() int32 {
 	if x != nil {
-		return x.DateType
+		return x.CourseDuration
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByCourseAbsResponse) GetLessonStartTime() string {
+func (x *AbsCourse) GetPrice() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Price
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupName() string {
+func (x *AbsCourse) GetDescription() string {
 	if x != nil {
-		return x.GroupName
+		return x.Description
 	}
 	return ""
 }
 
-type GetGroupAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Course          *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course"`
-	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName"`
-	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId"`
-	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	Room            *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room"`
-	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount"`
-	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name"`
-	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived"`
-	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
-	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate"`
-	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate"`
-	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days"`
-	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetCourseByIdResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
+	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	StudentCount   int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) Reset() {
-	*x = GetGroupAbsResponse{}
-	mi := &file_education_proto_msgTypes[36]
+func (x *GetCourseByIdResponse) Reset() {
+	*x = GetCourseByIdResponse{}
+	mi := &file_education_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) String() string {
+func (x *GetCourseByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupAbsResponse) ProtoMessage() {}
+func (*GetCourseByIdResponse) ProtoMessage() {}
 
-func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[36]
+func (x *GetCourseByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,132 +2969,195 @@ func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetCourseByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseByIdResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetGroupAbsResponse) GetId() string {
+func (x *GetCourseByIdResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetCourse() *AbsCourse {
+func (x *GetCourseByIdResponse) GetName() string {
 	if x != nil {
-		return x.Course
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupAbsResponse) GetTeacherName() string {
+func (x *GetCourseByIdResponse) GetLessonDuration() int32 {
 	if x != nil {
-		return x.TeacherName
+		return x.LessonDuration
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupAbsResponse) GetTeacherId() string {
+func (x *GetCourseByIdResponse) GetCourseDuration() int32 {
 	if x != nil {
-		return x.TeacherId
+		return x.CourseDuration
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupAbsResponse) GetLessonStartTime() string {
+func (x *GetCourseByIdResponse) GetPrice() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Price
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetRoom() *AbsRoom {
+func (x *GetCourseByIdResponse) GetDescription() string {
 	if x != nil {
-		return x.Room
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupAbsResponse) GetStudentCount() int32 {
+func (x *GetCourseByIdResponse) GetStudentCount() int32 {
 	if x != nil {
 		return x.StudentCount
 	}
 	return 0
 }
 
-func (x *GetGroupAbsResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type GetCourseByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) GetIsArchived() bool {
-	if x != nil {
-		return x.IsArchived
-	}
-	return false
+func (x *GetCourseByIdRequest) Reset() {
+	*x = GetCourseByIdRequest{}
+	mi := &file_education_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) GetCreatedAt() string {
+func (x *GetCourseByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseByIdRequest) ProtoMessage() {}
+
+func (x *GetCourseByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[39]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseByIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetCourseByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetStartDate() string {
+type GetLeftAfterTrialPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Page          string                 `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) Reset() {
+	*x = GetLeftAfterTrialPeriodRequest{}
+	mi := &file_education_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeftAfterTrialPeriodRequest) ProtoMessage() {}
+
+func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[40]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeftAfterTrialPeriodRequest.ProtoReflect.Descriptor instead.
+func (*GetLeftAfterTrialPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetFrom() string {
+	if x != nil {
+		return x.From
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetEndDate() string {
+func (x *GetLeftAfterTrialPeriodRequest) GetTo() string {
 	if x != nil {
-		return x.EndDate
+		return x.To
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetDays() []string {
+func (x *GetLeftAfterTrialPeriodRequest) GetPage() string {
 	if x != nil {
-		return x.Days
+		return x.Page
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupAbsResponse) GetDateType() string {
+func (x *GetLeftAfterTrialPeriodRequest) GetSize() string {
 	if x != nil {
-		return x.DateType
+		return x.Size
 	}
 	return ""
 }
 
-type GetGroupsResponse struct {
+type GetLeftAfterTrialPeriodResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Groups         []*GetGroupAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
-	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount"`
+	Items          []*AbsGetLeftAfter     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalItemCount int32                  `protobuf:"varint,2,opt,name=totalItemCount,proto3" json:"totalItemCount,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupsResponse) Reset() {
-	*x = GetGroupsResponse{}
-	mi := &file_education_proto_msgTypes[37]
+func (x *GetLeftAfterTrialPeriodResponse) Reset() {
+	*x = GetLeftAfterTrialPeriodResponse{}
+	mi := &file_education_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsResponse) String() string {
+func (x *GetLeftAfterTrialPeriodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsResponse) ProtoMessage() {}
+func (*GetLeftAfterTrialPeriodResponse) ProtoMessage() {}
 
-func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[37]
+func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2987,55 +3168,55 @@ func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetLeftAfterTrialPeriodResponse.ProtoReflect.Descriptor instead.
+func (*GetLeftAfterTrialPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetGroupsResponse) GetGroups() []*GetGroupAbsResponse {
+func (x *GetLeftAfterTrialPeriodResponse) GetItems() []*AbsGetLeftAfter {
 	if x != nil {
-		return x.Groups
+		return x.Items
 	}
 	return nil
 }
 
-func (x *GetGroupsResponse) GetTotalPageCount() int32 {
+func (x *GetLeftAfterTrialPeriodResponse) GetTotalItemCount() int32 {
 	if x != nil {
-		return x.TotalPageCount
+		return x.TotalItemCount
 	}
 	return 0
 }
 
-type GetGroupsRequest struct {
+type AbsGetLeftAfter struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	IsArchived     bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived"`
-	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacherId,proto3,oneof" json:"teacherId"`
-	CourseId       *int32                 `protobuf:"varint,3,opt,name=courseId,proto3,oneof" json:"courseId"`
-	DateType       *string                `protobuf:"bytes,4,opt,name=dateType,proto3,oneof" json:"dateType"`
-	StartDate      *string                `protobuf:"bytes,5,opt,name=startDate,proto3,oneof" json:"startDate"`
-	EndDate        *string                `protobuf:"bytes,6,opt,name=endDate,proto3,oneof" json:"endDate"`
-	Page           *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page"`
-	OrderBy        string                 `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy"`
-	OrderDirection string                 `protobuf:"bytes,9,opt,name=orderDirection,proto3" json:"orderDirection"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	StudentPhone   string                 `protobuf:"bytes,3,opt,name=studentPhone,proto3" json:"studentPhone,omitempty"`
+	StudentBalance string                 `protobuf:"bytes,4,opt,name=studentBalance,proto3" json:"studentBalance,omitempty"`
+	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName      string                 `protobuf:"bytes,6,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	ReturnMoney    bool                   `protobuf:"varint,7,opt,name=returnMoney,proto3" json:"returnMoney,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	SpecificDate   string                 `protobuf:"bytes,9,opt,name=specificDate,proto3" json:"specificDate,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupsRequest) Reset() {
-	*x = GetGroupsRequest{}
-	mi := &file_education_proto_msgTypes[38]
+func (x *AbsGetLeftAfter) Reset() {
+	*x = AbsGetLeftAfter{}
+	mi := &file_education_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsRequest) String() string {
+func (x *AbsGetLeftAfter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsRequest) ProtoMessage() {}
+func (*AbsGetLeftAfter) ProtoMessage() {}
 
-func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[38]
+func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3046,98 +3227,100 @@ func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use AbsGetLeftAfter.ProtoReflect.Descriptor instead.
+func (*AbsGetLeftAfter) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetGroupsRequest) GetIsArchived() bool {
+func (x *AbsGetLeftAfter) GetStudentId() string {
 	if x != nil {
-		return x.IsArchived
+		return x.StudentId
 	}
-	return false
+	return ""
 }
 
-func (x *GetGroupsRequest) GetTeacherId() string {
-	if x != nil && x.TeacherId != nil {
-		return *x.TeacherId
+func (x *AbsGetLeftAfter) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetCourseId() int32 {
-	if x != nil && x.CourseId != nil {
-		return *x.CourseId
+func (x *AbsGetLeftAfter) GetStudentPhone() string {
+	if x != nil {
+		return x.StudentPhone
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGroupsRequest) GetDateType() string {
-	if x != nil && x.DateType != nil {
-		return *x.DateType
+func (x *AbsGetLeftAfter) GetStudentBalance() string {
+	if x != nil {
+		return x.StudentBalance
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+func (x *AbsGetLeftAfter) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *AbsGetLeftAfter) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetPage() *PageRequest {
+func (x *AbsGetLeftAfter) GetReturnMoney() bool {
 	if x != nil {
-		return x.Page
+		return x.ReturnMoney
 	}
-	return nil
+	return false
 }
 
-func (x *GetGroupsRequest) GetOrderBy() string {
+func (x *AbsGetLeftAfter) GetCreatedAt() string {
 	if x != nil {
-		return x.OrderBy
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetOrderDirection() string {
+func (x *AbsGetLeftAfter) GetSpecificDate() string {
 	if x != nil {
-		return x.OrderDirection
+		return x.SpecificDate
 	}
 	return ""
 }
 
-type CalculateTeacherSalaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCommonInformationEducationResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ActiveStudentCount int32                  `protobuf:"varint,1,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
+	ActiveGroupCount   int32                  `protobuf:"varint,2,opt,name=activeGroupCount,proto3" json:"activeGroupCount,omitempty"`
+	LeaveGroupCount    int32                  `protobuf:"varint,3,opt,name=leaveGroupCount,proto3" json:"leaveGroupCount,omitempty"`
+	DebtorsCount       int32                  `protobuf:"varint,4,opt,name=debtorsCount,proto3" json:"debtorsCount,omitempty"`
+	EleminatedInTrial  int32                  `protobuf:"varint,5,opt,name=eleminatedInTrial,proto3" json:"eleminatedInTrial,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CalculateTeacherSalaryRequest) Reset() {
-	*x = CalculateTeacherSalaryRequest{}
-	mi := &file_education_proto_msgTypes[39]
+func (x *GetCommonInformationEducationResponse) Reset() {
+	*x = GetCommonInformationEducationResponse{}
+	mi := &file_education_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTeacherSalaryRequest) String() string {
+func (x *GetCommonInformationEducationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
+func (*GetCommonInformationEducationResponse) ProtoMessage() {}
 
-func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[39]
+func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3148,54 +3331,69 @@ func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetCommonInformationEducationResponse.ProtoReflect.Descriptor instead.
+func (*GetCommonInformationEducationResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CalculateTeacherSalaryRequest) GetFrom() string {
+func (x *GetCommonInformationEducationResponse) GetActiveStudentCount() int32 {
 	if x != nil {
-		return x.From
+		return x.ActiveStudentCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CalculateTeacherSalaryRequest) GetTo() string {
+func (x *GetCommonInformationEducationResponse) GetActiveGroupCount() int32 {
 	if x != nil {
-		return x.To
+		return x.ActiveGroupCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
+func (x *GetCommonInformationEducationResponse) GetLeaveGroupCount() int32 {
 	if x != nil {
-		return x.TeacherId
+		return x.LeaveGroupCount
 	}
-	return ""
+	return 0
 }
 
-type CalculateTeacherSalaryResponse struct {
+func (x *GetCommonInformationEducationResponse) GetDebtorsCount() int32 {
+	if x != nil {
+		return x.DebtorsCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetEleminatedInTrial() int32 {
+	if x != nil {
+		return x.EleminatedInTrial
+	}
+	return 0
+}
+
+type GetGroupsByTeacherIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	IsArchived    bool                   `protobuf:"varint,2,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateTeacherSalaryResponse) Reset() {
-	*x = CalculateTeacherSalaryResponse{}
-	mi := &file_education_proto_msgTypes[40]
+func (x *GetGroupsByTeacherIdRequest) Reset() {
+	*x = GetGroupsByTeacherIdRequest{}
+	mi := &file_education_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTeacherSalaryResponse) String() string {
+func (x *GetGroupsByTeacherIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
+func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
 
-func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[40]
+func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,43 +3404,47 @@ func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
-func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
+func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
 	if x != nil {
-		return x.Salaries
+		return x.TeacherId
 	}
-	return nil
+	return ""
 }
 
-type AbsCalculateSalary struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
-	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
-	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod"`
-	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+func (x *GetGroupsByTeacherIdRequest) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
 }
 
-func (x *AbsCalculateSalary) Reset() {
-	*x = AbsCalculateSalary{}
-	mi := &file_education_proto_msgTypes[41]
+type GetGroupsByTeacherResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Groups        []*GetGroupByTeacherAbs `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByTeacherResponse) Reset() {
+	*x = GetGroupsByTeacherResponse{}
+	mi := &file_education_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsCalculateSalary) String() string {
+func (x *GetGroupsByTeacherResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsCalculateSalary) ProtoMessage() {}
+func (*GetGroupsByTeacherResponse) ProtoMessage() {}
 
-func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[41]
+func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3253,67 +3455,49 @@ func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
-func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *AbsCalculateSalary) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *AbsCalculateSalary) GetGroupName() string {
-	if x != nil {
-		return x.GroupName
-	}
-	return ""
-}
-
-func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
-	if x != nil {
-		return x.CommonLessonCountInPeriod
-	}
-	return 0
+// Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
+func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
 	if x != nil {
-		return x.Salaries
+		return x.Groups
 	}
 	return nil
 }
 
-type StudentSalary struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	StudentId                string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
-	StudentName              string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
-	PassedLessonCount        int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount"`
-	CalculatedSalaryInPeriod int32                  `protobuf:"varint,4,opt,name=calculatedSalaryInPeriod,proto3" json:"calculatedSalaryInPeriod"`
-	PriceType                string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType"`
-	TotalCount               float64                `protobuf:"fixed64,6,opt,name=totalCount,proto3" json:"totalCount"`
-	CoursePrice              float64                `protobuf:"fixed64,7,opt,name=coursePrice,proto3" json:"coursePrice"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type GetGroupByTeacherAbs struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseName         string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	RoomName           string                 `protobuf:"bytes,3,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	DayType            string                 `protobuf:"bytes,5,opt,name=dayType,proto3" json:"dayType,omitempty"`
+	GroupStartAt       string                 `protobuf:"bytes,6,opt,name=groupStartAt,proto3" json:"groupStartAt,omitempty"`
+	GroupEndAt         string                 `protobuf:"bytes,7,opt,name=groupEndAt,proto3" json:"groupEndAt,omitempty"`
+	ActiveStudentCount int32                  `protobuf:"varint,8,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
+	Students           []*AbsStudent          `protobuf:"bytes,9,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *StudentSalary) Reset() {
-	*x = StudentSalary{}
-	mi := &file_education_proto_msgTypes[42]
+func (x *GetGroupByTeacherAbs) Reset() {
+	*x = GetGroupByTeacherAbs{}
+	mi := &file_education_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentSalary) String() string {
+func (x *GetGroupByTeacherAbs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentSalary) ProtoMessage() {}
+func (*GetGroupByTeacherAbs) ProtoMessage() {}
 
-func (x *StudentSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[42]
+func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3324,87 +3508,113 @@ func (x *StudentSalary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
-func (*StudentSalary) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
+func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *StudentSalary) GetStudentId() string {
+func (x *GetGroupByTeacherAbs) GetId() string {
 	if x != nil {
-		return x.StudentId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetStudentName() string {
+func (x *GetGroupByTeacherAbs) GetName() string {
 	if x != nil {
-		return x.StudentName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetPassedLessonCount() int32 {
+func (x *GetGroupByTeacherAbs) GetCourseName() string {
 	if x != nil {
-		return x.PassedLessonCount
+		return x.CourseName
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentSalary) GetCalculatedSalaryInPeriod() int32 {
+func (x *GetGroupByTeacherAbs) GetRoomName() string {
 	if x != nil {
-		return x.CalculatedSalaryInPeriod
+		return x.RoomName
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentSalary) GetPriceType() string {
+func (x *GetGroupByTeacherAbs) GetLessonStartTime() string {
 	if x != nil {
-		return x.PriceType
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetTotalCount() float64 {
+func (x *GetGroupByTeacherAbs) GetDayType() string {
 	if x != nil {
-		return x.TotalCount
+		return x.DayType
 	}
-	return 0
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetGroupStartAt() string {
+	if x != nil {
+		return x.GroupStartAt
+	}
+	return ""
 }
 
-func (x *StudentSalary) GetCoursePrice() float64 {
+func (x *GetGroupByTeacherAbs) GetGroupEndAt() string {
 	if x != nil {
-		return x.CoursePrice
+		return x.GroupEndAt
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetActiveStudentCount() int32 {
+	if x != nil {
+		return x.ActiveStudentCount
 	}
 	return 0
 }
 
-type GetAttendanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
-	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till"`
-	WithOutdated  bool                   `protobuf:"varint,4,opt,name=withOutdated,proto3" json:"withOutdated"`
-	ActionId      string                 `protobuf:"bytes,5,opt,name=actionId,proto3" json:"actionId"`
-	ActionRole    string                 `protobuf:"bytes,6,opt,name=actionRole,proto3" json:"actionRole"`
+func (x *GetGroupByTeacherAbs) GetStudents() []*AbsStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type CreateGroupRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	// a retried request with the same key gets the group created the first time
+	RequestKey    string `protobuf:"bytes,10,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAttendanceRequest) Reset() {
-	*x = GetAttendanceRequest{}
-	mi := &file_education_proto_msgTypes[43]
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_education_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttendanceRequest) String() string {
+func (x *CreateGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttendanceRequest) ProtoMessage() {}
+func (*CreateGroupRequest) ProtoMessage() {}
 
-func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[43]
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3415,76 +3625,105 @@ func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetAttendanceRequest) GetGroupId() string {
+func (x *CreateGroupRequest) GetName() string {
 	if x != nil {
-		return x.GroupId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetFrom() string {
+func (x *CreateGroupRequest) GetCourseId() int32 {
 	if x != nil {
-		return x.From
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetTill() string {
+func (x *CreateGroupRequest) GetType() string {
 	if x != nil {
-		return x.Till
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetWithOutdated() bool {
+func (x *CreateGroupRequest) GetDays() []string {
 	if x != nil {
-		return x.WithOutdated
+		return x.Days
 	}
-	return false
+	return nil
 }
 
-func (x *GetAttendanceRequest) GetActionId() string {
+func (x *CreateGroupRequest) GetRoomId() int32 {
 	if x != nil {
-		return x.ActionId
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetActionRole() string {
+func (x *CreateGroupRequest) GetGroupStartDate() string {
 	if x != nil {
-		return x.ActionRole
+		return x.GroupStartDate
 	}
 	return ""
 }
 
-type GetAttendanceResponse struct {
+func (x *CreateGroupRequest) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetRequestKey() string {
+	if x != nil {
+		return x.RequestKey
+	}
+	return ""
+}
+
+type GetGroupByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          []*Day                 `protobuf:"bytes,1,rep,name=days,proto3" json:"days"`
-	Students      []*Student             `protobuf:"bytes,2,rep,name=students,proto3" json:"students"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionRole    string                 `protobuf:"bytes,2,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
+	ActionId      string                 `protobuf:"bytes,3,opt,name=actionId,proto3" json:"actionId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAttendanceResponse) Reset() {
-	*x = GetAttendanceResponse{}
-	mi := &file_education_proto_msgTypes[44]
+func (x *GetGroupByIdRequest) Reset() {
+	*x = GetGroupByIdRequest{}
+	mi := &file_education_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttendanceResponse) String() string {
+func (x *GetGroupByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttendanceResponse) ProtoMessage() {}
+func (*GetGroupByIdRequest) ProtoMessage() {}
 
-func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[44]
+func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3495,110 +3734,63 @@ func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttendanceResponse.ProtoReflect.Descriptor instead.
-func (*GetAttendanceResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *GetAttendanceResponse) GetDays() []*Day {
-	if x != nil {
-		return x.Days
-	}
-	return nil
-}
-
-func (x *GetAttendanceResponse) GetStudents() []*Student {
-	if x != nil {
-		return x.Students
-	}
-	return nil
-}
-
-type Day struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`
-	TransferDate  string                 `protobuf:"bytes,2,opt,name=transferDate,proto3" json:"transferDate"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Day) Reset() {
-	*x = Day{}
-	mi := &file_education_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Day) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{48}
 }
 
-func (*Day) ProtoMessage() {}
-
-func (x *Day) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[45]
+func (x *GetGroupByIdRequest) GetId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Id
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Day.ProtoReflect.Descriptor instead.
-func (*Day) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{45}
+	return ""
 }
 
-func (x *Day) GetDate() string {
+func (x *GetGroupByIdRequest) GetActionRole() string {
 	if x != nil {
-		return x.Date
+		return x.ActionRole
 	}
 	return ""
 }
 
-func (x *Day) GetTransferDate() string {
+func (x *GetGroupByIdRequest) GetActionId() string {
 	if x != nil {
-		return x.TransferDate
+		return x.ActionId
 	}
 	return ""
 }
 
-type Student struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id"`
-	ActivatedAt   string                 `protobuf:"bytes,1,opt,name=activatedAt,proto3" json:"activatedAt"`
-	AddedAt       string                 `protobuf:"bytes,2,opt,name=addedAt,proto3" json:"addedAt"`
-	Attendance    []*Attendance          `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance"`
-	Balance       float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt"`
-	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=dateOfBirth,proto3" json:"dateOfBirth"`
-	FreezeDetail  *FreezeDetail          `protobuf:"bytes,7,opt,name=freezeDetail,proto3" json:"freezeDetail"`
-	Gender        bool                   `protobuf:"varint,8,opt,name=gender,proto3" json:"gender"`
-	Condition     string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition"`
-	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name"`
-	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetUpdateGroupAbs struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Student) Reset() {
-	*x = Student{}
-	mi := &file_education_proto_msgTypes[46]
+func (x *GetUpdateGroupAbs) Reset() {
+	*x = GetUpdateGroupAbs{}
+	mi := &file_education_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Student) String() string {
+func (x *GetUpdateGroupAbs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Student) ProtoMessage() {}
+func (*GetUpdateGroupAbs) ProtoMessage() {}
 
-func (x *Student) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[46]
+func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3609,121 +3801,103 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Student.ProtoReflect.Descriptor instead.
-func (*Student) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetUpdateGroupAbs.ProtoReflect.Descriptor instead.
+func (*GetUpdateGroupAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Student) GetId() string {
+func (x *GetUpdateGroupAbs) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Student) GetActivatedAt() string {
-	if x != nil {
-		return x.ActivatedAt
-	}
-	return ""
-}
-
-func (x *Student) GetAddedAt() string {
+func (x *GetUpdateGroupAbs) GetName() string {
 	if x != nil {
-		return x.AddedAt
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Student) GetAttendance() []*Attendance {
-	if x != nil {
-		return x.Attendance
-	}
-	return nil
-}
-
-func (x *Student) GetBalance() float64 {
+func (x *GetUpdateGroupAbs) GetCourseId() int32 {
 	if x != nil {
-		return x.Balance
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *Student) GetCreatedAt() string {
+func (x *GetUpdateGroupAbs) GetTeacherId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *Student) GetDateOfBirth() string {
+func (x *GetUpdateGroupAbs) GetType() string {
 	if x != nil {
-		return x.DateOfBirth
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Student) GetFreezeDetail() *FreezeDetail {
+func (x *GetUpdateGroupAbs) GetDays() []string {
 	if x != nil {
-		return x.FreezeDetail
+		return x.Days
 	}
 	return nil
 }
 
-func (x *Student) GetGender() bool {
+func (x *GetUpdateGroupAbs) GetRoomId() int32 {
 	if x != nil {
-		return x.Gender
+		return x.RoomId
 	}
-	return false
+	return 0
 }
 
-func (x *Student) GetCondition() string {
+func (x *GetUpdateGroupAbs) GetLessonStartTime() string {
 	if x != nil {
-		return x.Condition
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *Student) GetName() string {
+func (x *GetUpdateGroupAbs) GetGroupStartDate() string {
 	if x != nil {
-		return x.Name
+		return x.GroupStartDate
 	}
 	return ""
 }
 
-func (x *Student) GetPhone() string {
+func (x *GetUpdateGroupAbs) GetGroupEndDate() string {
 	if x != nil {
-		return x.Phone
+		return x.GroupEndDate
 	}
 	return ""
 }
 
-type Attendance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	AttendDate    string                 `protobuf:"bytes,2,opt,name=attend_date,json=attendDate,proto3" json:"attend_date"`
-	IsCome        bool                   `protobuf:"varint,3,opt,name=isCome,proto3" json:"isCome"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
-	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+type GetGroupsByCourseResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Groups        []*GetGroupByCourseAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Attendance) Reset() {
-	*x = Attendance{}
-	mi := &file_education_proto_msgTypes[47]
+func (x *GetGroupsByCourseResponse) Reset() {
+	*x = GetGroupsByCourseResponse{}
+	mi := &file_education_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Attendance) String() string {
+func (x *GetGroupsByCourseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attendance) ProtoMessage() {}
+func (*GetGroupsByCourseResponse) ProtoMessage() {}
 
-func (x *Attendance) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[47]
+func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3734,69 +3908,46 @@ func (x *Attendance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
-func (*Attendance) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *Attendance) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Attendance) GetAttendDate() string {
-	if x != nil {
-		return x.AttendDate
-	}
-	return ""
-}
-
-func (x *Attendance) GetIsCome() bool {
-	if x != nil {
-		return x.IsCome
-	}
-	return false
-}
-
-func (x *Attendance) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
+// Deprecated: Use GetGroupsByCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByCourseResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Attendance) GetTeacherId() string {
+func (x *GetGroupsByCourseResponse) GetGroups() []*GetGroupByCourseAbsResponse {
 	if x != nil {
-		return x.TeacherId
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type FreezeDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason"`
-	TillDate      string                 `protobuf:"bytes,2,opt,name=till_date,json=tillDate,proto3" json:"till_date"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetGroupByCourseAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherName     string                 `protobuf:"bytes,2,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,3,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,4,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	DateType        string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupName       string                 `protobuf:"bytes,7,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *FreezeDetail) Reset() {
-	*x = FreezeDetail{}
-	mi := &file_education_proto_msgTypes[48]
+func (x *GetGroupByCourseAbsResponse) Reset() {
+	*x = GetGroupByCourseAbsResponse{}
+	mi := &file_education_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FreezeDetail) String() string {
+func (x *GetGroupByCourseAbsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreezeDetail) ProtoMessage() {}
+func (*GetGroupByCourseAbsResponse) ProtoMessage() {}
 
-func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[48]
+func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3807,146 +3958,95 @@ func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreezeDetail.ProtoReflect.Descriptor instead.
-func (*FreezeDetail) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use GetGroupByCourseAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupByCourseAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *FreezeDetail) GetReason() string {
+func (x *GetGroupByCourseAbsResponse) GetId() string {
 	if x != nil {
-		return x.Reason
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FreezeDetail) GetTillDate() string {
+func (x *GetGroupByCourseAbsResponse) GetTeacherName() string {
 	if x != nil {
-		return x.TillDate
+		return x.TeacherName
 	}
 	return ""
 }
 
-type SetAttendanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttendDate    string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
-	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
-	ActionById    string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
-	ActionByRole  string                 `protobuf:"bytes,7,opt,name=actionByRole,proto3" json:"actionByRole"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetAttendanceRequest) Reset() {
-	*x = SetAttendanceRequest{}
-	mi := &file_education_proto_msgTypes[49]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetGroupByCourseAbsResponse) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
 }
 
-func (x *SetAttendanceRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetGroupByCourseAbsResponse) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
 }
 
-func (*SetAttendanceRequest) ProtoMessage() {}
-
-func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[49]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SetAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*SetAttendanceRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *SetAttendanceRequest) GetAttendDate() string {
-	if x != nil {
-		return x.AttendDate
-	}
-	return ""
-}
-
-func (x *SetAttendanceRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *SetAttendanceRequest) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *SetAttendanceRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
-}
-
-func (x *SetAttendanceRequest) GetTeacherId() string {
+func (x *GetGroupByCourseAbsResponse) GetDateType() string {
 	if x != nil {
-		return x.TeacherId
+		return x.DateType
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetActionById() string {
+func (x *GetGroupByCourseAbsResponse) GetLessonStartTime() string {
 	if x != nil {
-		return x.ActionById
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetActionByRole() string {
+func (x *GetGroupByCourseAbsResponse) GetGroupName() string {
 	if x != nil {
-		return x.ActionByRole
+		return x.GroupName
 	}
 	return ""
 }
 
-type ChangeUserBalanceHistoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
-	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
-	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
-	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
-	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy"`
-	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName"`
-	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetGroupAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Course          *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	Room            *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days,omitempty"`
+	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ChangeUserBalanceHistoryRequest) Reset() {
-	*x = ChangeUserBalanceHistoryRequest{}
-	mi := &file_education_proto_msgTypes[50]
+func (x *GetGroupAbsResponse) Reset() {
+	*x = GetGroupAbsResponse{}
+	mi := &file_education_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeUserBalanceHistoryRequest) String() string {
+func (x *GetGroupAbsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
+func (*GetGroupAbsResponse) ProtoMessage() {}
 
-func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[50]
+func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3957,157 +4057,132 @@ func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
+func (x *GetGroupAbsResponse) GetId() string {
 	if x != nil {
-		return x.StudentId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetAmount() string {
+func (x *GetGroupAbsResponse) GetCourse() *AbsCourse {
 	if x != nil {
-		return x.Amount
+		return x.Course
 	}
-	return ""
+	return nil
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetGivenDate() string {
+func (x *GetGroupAbsResponse) GetTeacherName() string {
 	if x != nil {
-		return x.GivenDate
+		return x.TeacherName
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetComment() string {
+func (x *GetGroupAbsResponse) GetTeacherId() string {
 	if x != nil {
-		return x.Comment
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetPaymentType() string {
+func (x *GetGroupAbsResponse) GetLessonStartTime() string {
 	if x != nil {
-		return x.PaymentType
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetCreatedBy() string {
+func (x *GetGroupAbsResponse) GetRoom() *AbsRoom {
 	if x != nil {
-		return x.CreatedBy
+		return x.Room
 	}
-	return ""
+	return nil
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetCreatedByName() string {
+func (x *GetGroupAbsResponse) GetStudentCount() int32 {
 	if x != nil {
-		return x.CreatedByName
+		return x.StudentCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetGroupId() string {
+func (x *GetGroupAbsResponse) GetName() string {
 	if x != nil {
-		return x.GroupId
+		return x.Name
 	}
 	return ""
 }
 
-type DeleteStudentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
-	ReturnMoney   bool                   `protobuf:"varint,2,opt,name=returnMoney,proto3" json:"returnMoney"`
-	ActionById    string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById"`
-	ActionByName  string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteStudentRequest) Reset() {
-	*x = DeleteStudentRequest{}
-	mi := &file_education_proto_msgTypes[51]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteStudentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteStudentRequest) ProtoMessage() {}
-
-func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[51]
+func (x *GetGroupAbsResponse) GetIsArchived() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IsArchived
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use DeleteStudentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{51}
+func (x *GetGroupAbsResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-func (x *DeleteStudentRequest) GetStudentId() string {
+func (x *GetGroupAbsResponse) GetStartDate() string {
 	if x != nil {
-		return x.StudentId
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *DeleteStudentRequest) GetReturnMoney() bool {
+func (x *GetGroupAbsResponse) GetEndDate() string {
 	if x != nil {
-		return x.ReturnMoney
+		return x.EndDate
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteStudentRequest) GetActionById() string {
+func (x *GetGroupAbsResponse) GetDays() []string {
 	if x != nil {
-		return x.ActionById
+		return x.Days
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteStudentRequest) GetActionByName() string {
+func (x *GetGroupAbsResponse) GetDateType() string {
 	if x != nil {
-		return x.ActionByName
+		return x.DateType
 	}
 	return ""
 }
 
-type GetStudentsByGroupIdResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetGroupsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Groups         []*GetGroupAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetStudentsByGroupIdResponse) Reset() {
-	*x = GetStudentsByGroupIdResponse{}
-	mi := &file_education_proto_msgTypes[52]
+func (x *GetGroupsResponse) Reset() {
+	*x = GetGroupsResponse{}
+	mi := &file_education_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentsByGroupIdResponse) String() string {
+func (x *GetGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
+func (*GetGroupsResponse) ProtoMessage() {}
 
-func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[52]
+func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4118,41 +4193,55 @@ func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
+func (x *GetGroupsResponse) GetGroups() []*GetGroupAbsResponse {
 	if x != nil {
-		return x.Students
+		return x.Groups
 	}
 	return nil
 }
 
-type GetStudentsByGroupIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
-	WithOutdated  bool                   `protobuf:"varint,2,opt,name=withOutdated,proto3" json:"withOutdated"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetGroupsResponse) GetTotalPageCount() int32 {
+	if x != nil {
+		return x.TotalPageCount
+	}
+	return 0
 }
 
-func (x *GetStudentsByGroupIdRequest) Reset() {
-	*x = GetStudentsByGroupIdRequest{}
-	mi := &file_education_proto_msgTypes[53]
+type GetGroupsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IsArchived     bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacherId,proto3,oneof" json:"teacherId,omitempty"`
+	CourseId       *int32                 `protobuf:"varint,3,opt,name=courseId,proto3,oneof" json:"courseId,omitempty"`
+	DateType       *string                `protobuf:"bytes,4,opt,name=dateType,proto3,oneof" json:"dateType,omitempty"`
+	StartDate      *string                `protobuf:"bytes,5,opt,name=startDate,proto3,oneof" json:"startDate,omitempty"`
+	EndDate        *string                `protobuf:"bytes,6,opt,name=endDate,proto3,oneof" json:"endDate,omitempty"`
+	Page           *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`
+	OrderBy        string                 `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	OrderDirection string                 `protobuf:"bytes,9,opt,name=orderDirection,proto3" json:"orderDirection,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupsRequest) Reset() {
+	*x = GetGroupsRequest{}
+	mi := &file_education_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentsByGroupIdRequest) String() string {
+func (x *GetGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
+func (*GetGroupsRequest) ProtoMessage() {}
 
-func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[53]
+func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4163,148 +4252,97 @@ func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
-func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
+func (x *GetGroupsRequest) GetIsArchived() bool {
 	if x != nil {
-		return x.GroupId
+		return x.IsArchived
 	}
-	return ""
+	return false
 }
 
-func (x *GetStudentsByGroupIdRequest) GetWithOutdated() bool {
-	if x != nil {
-		return x.WithOutdated
-	}
-	return false
-}
-
-type ChangeConditionStudentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StudentId      string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
-	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId"`
-	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
-	ReturnTheMoney bool                   `protobuf:"varint,2,opt,name=returnTheMoney,proto3" json:"returnTheMoney"`
-	TillDate       string                 `protobuf:"bytes,3,opt,name=tillDate,proto3" json:"tillDate"`
-	ActionById     string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
-	ActionByName   string                 `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName"`
-	Comment        string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *ChangeConditionStudentRequest) Reset() {
-	*x = ChangeConditionStudentRequest{}
-	mi := &file_education_proto_msgTypes[54]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ChangeConditionStudentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ChangeConditionStudentRequest) ProtoMessage() {}
-
-func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[54]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ChangeConditionStudentRequest.ProtoReflect.Descriptor instead.
-func (*ChangeConditionStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *ChangeConditionStudentRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
+func (x *GetGroupsRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
 	}
 	return ""
 }
 
-func (x *ChangeConditionStudentRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
+func (x *GetGroupsRequest) GetCourseId() int32 {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeConditionStudentRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *GetGroupsRequest) GetDateType() string {
+	if x != nil && x.DateType != nil {
+		return *x.DateType
 	}
 	return ""
 }
 
-func (x *ChangeConditionStudentRequest) GetReturnTheMoney() bool {
-	if x != nil {
-		return x.ReturnTheMoney
+func (x *GetGroupsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
-	return false
+	return ""
 }
 
-func (x *ChangeConditionStudentRequest) GetTillDate() string {
-	if x != nil {
-		return x.TillDate
+func (x *GetGroupsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
 	return ""
 }
 
-func (x *ChangeConditionStudentRequest) GetActionById() string {
+func (x *GetGroupsRequest) GetPage() *PageRequest {
 	if x != nil {
-		return x.ActionById
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-func (x *ChangeConditionStudentRequest) GetActionByName() string {
+func (x *GetGroupsRequest) GetOrderBy() string {
 	if x != nil {
-		return x.ActionByName
+		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ChangeConditionStudentRequest) GetComment() string {
+func (x *GetGroupsRequest) GetOrderDirection() string {
 	if x != nil {
-		return x.Comment
+		return x.OrderDirection
 	}
 	return ""
 }
 
-type TransferLessonRequest struct {
+type CalculateTeacherSalaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferLessonRequest) Reset() {
-	*x = TransferLessonRequest{}
+func (x *CalculateTeacherSalaryRequest) Reset() {
+	*x = CalculateTeacherSalaryRequest{}
 	mi := &file_education_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferLessonRequest) String() string {
+func (x *CalculateTeacherSalaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferLessonRequest) ProtoMessage() {}
+func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
 
-func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
+func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4316,54 +4354,53 @@ func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferLessonRequest.ProtoReflect.Descriptor instead.
-func (*TransferLessonRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *TransferLessonRequest) GetFrom() string {
+func (x *CalculateTeacherSalaryRequest) GetFrom() string {
 	if x != nil {
 		return x.From
 	}
 	return ""
 }
 
-func (x *TransferLessonRequest) GetTo() string {
+func (x *CalculateTeacherSalaryRequest) GetTo() string {
 	if x != nil {
 		return x.To
 	}
 	return ""
 }
 
-func (x *TransferLessonRequest) GetGroupId() string {
+func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
 	if x != nil {
-		return x.GroupId
+		return x.TeacherId
 	}
 	return ""
 }
 
-type GetHistoryGroupResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	GroupHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=groupHistory,proto3" json:"groupHistory"`
-	StudentsHistory []*AbsStudentHistory   `protobuf:"bytes,2,rep,name=studentsHistory,proto3" json:"studentsHistory"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CalculateTeacherSalaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryGroupResponse) Reset() {
-	*x = GetHistoryGroupResponse{}
+func (x *CalculateTeacherSalaryResponse) Reset() {
+	*x = CalculateTeacherSalaryResponse{}
 	mi := &file_education_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryGroupResponse) String() string {
+func (x *CalculateTeacherSalaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryGroupResponse) ProtoMessage() {}
+func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
 
-func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
+func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4375,47 +4412,42 @@ func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryGroupResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoryGroupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetHistoryGroupResponse) GetGroupHistory() []*AbsHistory {
-	if x != nil {
-		return x.GroupHistory
-	}
-	return nil
-}
-
-func (x *GetHistoryGroupResponse) GetStudentsHistory() []*AbsStudentHistory {
+func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
 	if x != nil {
-		return x.StudentsHistory
+		return x.Salaries
 	}
 	return nil
 }
 
-type GetHistoryStudentResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	StudentHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=studentHistory,proto3" json:"studentHistory"`
-	ConditionsHistory []*AbsStudentHistory   `protobuf:"bytes,2,rep,name=conditionsHistory,proto3" json:"conditionsHistory"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type AbsCalculateSalary struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod,omitempty"`
+	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *GetHistoryStudentResponse) Reset() {
-	*x = GetHistoryStudentResponse{}
+func (x *AbsCalculateSalary) Reset() {
+	*x = AbsCalculateSalary{}
 	mi := &file_education_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryStudentResponse) String() string {
+func (x *AbsCalculateSalary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryStudentResponse) ProtoMessage() {}
+func (*AbsCalculateSalary) ProtoMessage() {}
 
-func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
+func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4427,52 +4459,66 @@ func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryStudentResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoryStudentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
+func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetHistoryStudentResponse) GetStudentHistory() []*AbsHistory {
+func (x *AbsCalculateSalary) GetGroupId() string {
 	if x != nil {
-		return x.StudentHistory
+		return x.GroupId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetHistoryStudentResponse) GetConditionsHistory() []*AbsStudentHistory {
+func (x *AbsCalculateSalary) GetGroupName() string {
 	if x != nil {
-		return x.ConditionsHistory
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
+	if x != nil {
+		return x.CommonLessonCountInPeriod
+	}
+	return 0
+}
+
+func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
+	if x != nil {
+		return x.Salaries
 	}
 	return nil
 }
 
-type AbsStudentHistory struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Student          *AbsStudent            `protobuf:"bytes,1,opt,name=student,proto3" json:"student"`
-	Group            *AbsGroup              `protobuf:"bytes,2,opt,name=group,proto3" json:"group"`
-	OldCondition     string                 `protobuf:"bytes,3,opt,name=oldCondition,proto3" json:"oldCondition"`
-	CurrentCondition string                 `protobuf:"bytes,6,opt,name=currentCondition,proto3" json:"currentCondition"`
-	SpecificDate     string                 `protobuf:"bytes,4,opt,name=specificDate,proto3" json:"specificDate"`
-	CreatedAt        string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt"`
-	Comment          string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type StudentSalary struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentId         string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName       string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	PassedLessonCount int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount,omitempty"`
+	PriceType         string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType,omitempty"`
+	TotalCount        string                 `protobuf:"bytes,6,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	CoursePrice       string                 `protobuf:"bytes,7,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
+	CalculatedSalary  string                 `protobuf:"bytes,8,opt,name=calculatedSalary,proto3" json:"calculatedSalary,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *AbsStudentHistory) Reset() {
-	*x = AbsStudentHistory{}
+func (x *StudentSalary) Reset() {
+	*x = StudentSalary{}
 	mi := &file_education_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsStudentHistory) String() string {
+func (x *StudentSalary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsStudentHistory) ProtoMessage() {}
+func (*StudentSalary) ProtoMessage() {}
 
-func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
+func (x *StudentSalary) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4484,90 +4530,86 @@ func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsStudentHistory.ProtoReflect.Descriptor instead.
-func (*AbsStudentHistory) Descriptor() ([]byte, []int) {
+// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
+func (*StudentSalary) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *AbsStudentHistory) GetStudent() *AbsStudent {
+func (x *StudentSalary) GetStudentId() string {
 	if x != nil {
-		return x.Student
+		return x.StudentId
 	}
-	return nil
+	return ""
 }
 
-func (x *AbsStudentHistory) GetGroup() *AbsGroup {
+func (x *StudentSalary) GetStudentName() string {
 	if x != nil {
-		return x.Group
+		return x.StudentName
 	}
-	return nil
+	return ""
 }
 
-func (x *AbsStudentHistory) GetOldCondition() string {
+func (x *StudentSalary) GetPassedLessonCount() int32 {
 	if x != nil {
-		return x.OldCondition
+		return x.PassedLessonCount
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsStudentHistory) GetCurrentCondition() string {
+func (x *StudentSalary) GetPriceType() string {
 	if x != nil {
-		return x.CurrentCondition
+		return x.PriceType
 	}
 	return ""
 }
 
-func (x *AbsStudentHistory) GetSpecificDate() string {
+func (x *StudentSalary) GetTotalCount() string {
 	if x != nil {
-		return x.SpecificDate
+		return x.TotalCount
 	}
 	return ""
 }
 
-func (x *AbsStudentHistory) GetCreatedAt() string {
+func (x *StudentSalary) GetCoursePrice() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.CoursePrice
 	}
 	return ""
 }
 
-func (x *AbsStudentHistory) GetComment() string {
+func (x *StudentSalary) GetCalculatedSalary() string {
 	if x != nil {
-		return x.Comment
+		return x.CalculatedSalary
 	}
 	return ""
 }
 
-type AbsGroup struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	TeacherName        string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName"`
-	CurrentGroupStatus string                 `protobuf:"bytes,4,opt,name=currentGroupStatus,proto3" json:"currentGroupStatus"`
-	GroupStartDate     string                 `protobuf:"bytes,5,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate       string                 `protobuf:"bytes,6,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	DateType           string                 `protobuf:"bytes,8,opt,name=dateType,proto3" json:"dateType"`
-	Course             *AbsCourse             `protobuf:"bytes,9,opt,name=course,proto3" json:"course"`
-	IsArchived         bool                   `protobuf:"varint,10,opt,name=isArchived,proto3" json:"isArchived"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till,omitempty"`
+	WithOutdated  bool                   `protobuf:"varint,4,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
+	ActionId      string                 `protobuf:"bytes,5,opt,name=actionId,proto3" json:"actionId,omitempty"`
+	ActionRole    string                 `protobuf:"bytes,6,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGroup) Reset() {
-	*x = AbsGroup{}
+func (x *GetAttendanceRequest) Reset() {
+	*x = GetAttendanceRequest{}
 	mi := &file_education_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGroup) String() string {
+func (x *GetAttendanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGroup) ProtoMessage() {}
+func (*GetAttendanceRequest) ProtoMessage() {}
 
-func (x *AbsGroup) ProtoReflect() protoreflect.Message {
+func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4579,106 +4621,2846 @@ func (x *AbsGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGroup.ProtoReflect.Descriptor instead.
-func (*AbsGroup) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AbsGroup) GetId() string {
+func (x *GetAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetWithOutdated() bool {
+	if x != nil {
+		return x.WithOutdated
+	}
+	return false
+}
+
+func (x *GetAttendanceRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetActionRole() string {
+	if x != nil {
+		return x.ActionRole
+	}
+	return ""
+}
+
+type GetAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*Day                 `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Students      []*Student             `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceResponse) Reset() {
+	*x = GetAttendanceResponse{}
+	mi := &file_education_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceResponse) ProtoMessage() {}
+
+func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetAttendanceResponse) GetDays() []*Day {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetAttendanceResponse) GetStudents() []*Student {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type Day struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TransferDate  string                 `protobuf:"bytes,2,opt,name=transferDate,proto3" json:"transferDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Day) Reset() {
+	*x = Day{}
+	mi := &file_education_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Day) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Day) ProtoMessage() {}
+
+func (x *Day) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Day.ProtoReflect.Descriptor instead.
+func (*Day) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Day) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Day) GetTransferDate() string {
+	if x != nil {
+		return x.TransferDate
+	}
+	return ""
+}
+
+type Student struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ActivatedAt   string                 `protobuf:"bytes,1,opt,name=activatedAt,proto3" json:"activatedAt,omitempty"`
+	AddedAt       string                 `protobuf:"bytes,2,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
+	Attendance    []*Attendance          `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	Balance       string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	FreezeDetail  *FreezeDetail          `protobuf:"bytes,7,opt,name=freezeDetail,proto3" json:"freezeDetail,omitempty"`
+	Gender        bool                   `protobuf:"varint,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	Condition     string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`
+	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Student) Reset() {
+	*x = Student{}
+	mi := &file_education_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Student) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Student) ProtoMessage() {}
+
+func (x *Student) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Student.ProtoReflect.Descriptor instead.
+func (*Student) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Student) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetName() string {
+func (x *Student) GetActivatedAt() string {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return ""
+}
+
+func (x *Student) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+func (x *Student) GetAttendance() []*Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *Student) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *Student) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Student) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *Student) GetFreezeDetail() *FreezeDetail {
+	if x != nil {
+		return x.FreezeDetail
+	}
+	return nil
+}
+
+func (x *Student) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *Student) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Student) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Student) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type Attendance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttendDate    string                 `protobuf:"bytes,2,opt,name=attend_date,json=attendDate,proto3" json:"attend_date,omitempty"`
+	IsCome        bool                   `protobuf:"varint,3,opt,name=isCome,proto3" json:"isCome,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	mi := &file_education_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Attendance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attendance) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *Attendance) GetIsCome() bool {
+	if x != nil {
+		return x.IsCome
+	}
+	return false
+}
+
+func (x *Attendance) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Attendance) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type FreezeDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	TillDate      string                 `protobuf:"bytes,2,opt,name=till_date,json=tillDate,proto3" json:"till_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeDetail) Reset() {
+	*x = FreezeDetail{}
+	mi := &file_education_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeDetail) ProtoMessage() {}
+
+func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeDetail.ProtoReflect.Descriptor instead.
+func (*FreezeDetail) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FreezeDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeDetail) GetTillDate() string {
+	if x != nil {
+		return x.TillDate
+	}
+	return ""
+}
+
+type SetAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttendDate    string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	ActionById    string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string                 `protobuf:"bytes,7,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttendanceRequest) Reset() {
+	*x = SetAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttendanceRequest) ProtoMessage() {}
+
+func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*SetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetAttendanceRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SetAttendanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type FindStudentsByPhoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindStudentsByPhoneRequest) Reset() {
+	*x = FindStudentsByPhoneRequest{}
+	mi := &file_education_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindStudentsByPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindStudentsByPhoneRequest) ProtoMessage() {}
+
+func (x *FindStudentsByPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindStudentsByPhoneRequest.ProtoReflect.Descriptor instead.
+func (*FindStudentsByPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *FindStudentsByPhoneRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+type MergeStudentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceStudentId string                 `protobuf:"bytes,1,opt,name=sourceStudentId,proto3" json:"sourceStudentId,omitempty"`
+	TargetStudentId string                 `protobuf:"bytes,2,opt,name=targetStudentId,proto3" json:"targetStudentId,omitempty"`
+	ActionById      string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName    string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeStudentsRequest) Reset() {
+	*x = MergeStudentsRequest{}
+	mi := &file_education_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeStudentsRequest) ProtoMessage() {}
+
+func (x *MergeStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeStudentsRequest.ProtoReflect.Descriptor instead.
+func (*MergeStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MergeStudentsRequest) GetSourceStudentId() string {
+	if x != nil {
+		return x.SourceStudentId
+	}
+	return ""
+}
+
+func (x *MergeStudentsRequest) GetTargetStudentId() string {
+	if x != nil {
+		return x.TargetStudentId
+	}
+	return ""
+}
+
+func (x *MergeStudentsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *MergeStudentsRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type ChangeUserBalanceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserBalanceHistoryRequest) Reset() {
+	*x = ChangeUserBalanceHistoryRequest{}
+	mi := &file_education_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserBalanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
+
+func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type DeleteStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	ReturnMoney   bool                   `protobuf:"varint,2,opt,name=returnMoney,proto3" json:"returnMoney,omitempty"`
+	ActionById    string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStudentRequest) Reset() {
+	*x = DeleteStudentRequest{}
+	mi := &file_education_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStudentRequest) ProtoMessage() {}
+
+func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStudentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *DeleteStudentRequest) GetReturnMoney() bool {
+	if x != nil {
+		return x.ReturnMoney
+	}
+	return false
+}
+
+func (x *DeleteStudentRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *DeleteStudentRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetStudentsByGroupIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentsByGroupIdResponse) Reset() {
+	*x = GetStudentsByGroupIdResponse{}
+	mi := &file_education_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentsByGroupIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
+
+func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type GetStudentsByGroupIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	WithOutdated  bool                   `protobuf:"varint,2,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentsByGroupIdRequest) Reset() {
+	*x = GetStudentsByGroupIdRequest{}
+	mi := &file_education_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentsByGroupIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
+
+func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetStudentsByGroupIdRequest) GetWithOutdated() bool {
+	if x != nil {
+		return x.WithOutdated
+	}
+	return false
+}
+
+type ChangeConditionStudentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ReturnTheMoney bool                   `protobuf:"varint,2,opt,name=returnTheMoney,proto3" json:"returnTheMoney,omitempty"`
+	TillDate       string                 `protobuf:"bytes,3,opt,name=tillDate,proto3" json:"tillDate,omitempty"`
+	ActionById     string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName   string                 `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	Comment        string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangeConditionStudentRequest) Reset() {
+	*x = ChangeConditionStudentRequest{}
+	mi := &file_education_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeConditionStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeConditionStudentRequest) ProtoMessage() {}
+
+func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeConditionStudentRequest.ProtoReflect.Descriptor instead.
+func (*ChangeConditionStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ChangeConditionStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetReturnTheMoney() bool {
+	if x != nil {
+		return x.ReturnTheMoney
+	}
+	return false
+}
+
+func (x *ChangeConditionStudentRequest) GetTillDate() string {
+	if x != nil {
+		return x.TillDate
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type TransferLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLessonRequest) Reset() {
+	*x = TransferLessonRequest{}
+	mi := &file_education_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLessonRequest) ProtoMessage() {}
+
+func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLessonRequest.ProtoReflect.Descriptor instead.
+func (*TransferLessonRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TransferLessonRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TransferLessonRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TransferLessonRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetHistoryGroupResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GroupHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=groupHistory,proto3" json:"groupHistory,omitempty"`
+	StudentsHistory []*AbsStudentHistory   `protobuf:"bytes,2,rep,name=studentsHistory,proto3" json:"studentsHistory,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetHistoryGroupResponse) Reset() {
+	*x = GetHistoryGroupResponse{}
+	mi := &file_education_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryGroupResponse) ProtoMessage() {}
+
+func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryGroupResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetHistoryGroupResponse) GetGroupHistory() []*AbsHistory {
+	if x != nil {
+		return x.GroupHistory
+	}
+	return nil
+}
+
+func (x *GetHistoryGroupResponse) GetStudentsHistory() []*AbsStudentHistory {
+	if x != nil {
+		return x.StudentsHistory
+	}
+	return nil
+}
+
+type GetHistoryStudentResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=studentHistory,proto3" json:"studentHistory,omitempty"`
+	ConditionsHistory []*AbsStudentHistory   `protobuf:"bytes,2,rep,name=conditionsHistory,proto3" json:"conditionsHistory,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetHistoryStudentResponse) Reset() {
+	*x = GetHistoryStudentResponse{}
+	mi := &file_education_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryStudentResponse) ProtoMessage() {}
+
+func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryStudentResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetHistoryStudentResponse) GetStudentHistory() []*AbsHistory {
+	if x != nil {
+		return x.StudentHistory
+	}
+	return nil
+}
+
+func (x *GetHistoryStudentResponse) GetConditionsHistory() []*AbsStudentHistory {
+	if x != nil {
+		return x.ConditionsHistory
+	}
+	return nil
+}
+
+type AbsStudentHistory struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Student          *AbsStudent            `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
+	Group            *AbsGroup              `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	OldCondition     string                 `protobuf:"bytes,3,opt,name=oldCondition,proto3" json:"oldCondition,omitempty"`
+	CurrentCondition string                 `protobuf:"bytes,6,opt,name=currentCondition,proto3" json:"currentCondition,omitempty"`
+	SpecificDate     string                 `protobuf:"bytes,4,opt,name=specificDate,proto3" json:"specificDate,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Comment          string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AbsStudentHistory) Reset() {
+	*x = AbsStudentHistory{}
+	mi := &file_education_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudentHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudentHistory) ProtoMessage() {}
+
+func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudentHistory.ProtoReflect.Descriptor instead.
+func (*AbsStudentHistory) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AbsStudentHistory) GetStudent() *AbsStudent {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *AbsStudentHistory) GetGroup() *AbsGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *AbsStudentHistory) GetOldCondition() string {
+	if x != nil {
+		return x.OldCondition
+	}
+	return ""
+}
+
+func (x *AbsStudentHistory) GetCurrentCondition() string {
+	if x != nil {
+		return x.CurrentCondition
+	}
+	return ""
+}
+
+func (x *AbsStudentHistory) GetSpecificDate() string {
+	if x != nil {
+		return x.SpecificDate
+	}
+	return ""
+}
+
+func (x *AbsStudentHistory) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsStudentHistory) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type AbsGroup struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TeacherName        string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	CurrentGroupStatus string                 `protobuf:"bytes,4,opt,name=currentGroupStatus,proto3" json:"currentGroupStatus,omitempty"`
+	GroupStartDate     string                 `protobuf:"bytes,5,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate       string                 `protobuf:"bytes,6,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	DateType           string                 `protobuf:"bytes,8,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Course             *AbsCourse             `protobuf:"bytes,9,opt,name=course,proto3" json:"course,omitempty"`
+	IsArchived         bool                   `protobuf:"varint,10,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AbsGroup) Reset() {
+	*x = AbsGroup{}
+	mi := &file_education_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGroup) ProtoMessage() {}
+
+func (x *AbsGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGroup.ProtoReflect.Descriptor instead.
+func (*AbsGroup) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AbsGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetCurrentGroupStatus() string {
+	if x != nil {
+		return x.CurrentGroupStatus
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *AbsGroup) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+type AbsHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EditedField   string                 `protobuf:"bytes,2,opt,name=editedField,proto3" json:"editedField,omitempty"`
+	OldValue      string                 `protobuf:"bytes,3,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	CurrentValue  string                 `protobuf:"bytes,4,opt,name=currentValue,proto3" json:"currentValue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsHistory) Reset() {
+	*x = AbsHistory{}
+	mi := &file_education_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsHistory) ProtoMessage() {}
+
+func (x *AbsHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsHistory.ProtoReflect.Descriptor instead.
+func (*AbsHistory) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AbsHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsHistory) GetEditedField() string {
+	if x != nil {
+		return x.EditedField
+	}
+	return ""
+}
+
+func (x *AbsHistory) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *AbsHistory) GetCurrentValue() string {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return ""
+}
+
+type SearchStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStudentRequest) Reset() {
+	*x = SearchStudentRequest{}
+	mi := &file_education_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStudentRequest) ProtoMessage() {}
+
+func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStudentRequest.ProtoReflect.Descriptor instead.
+func (*SearchStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SearchStudentRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SearchStudentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStudentResponse) Reset() {
+	*x = SearchStudentResponse{}
+	mi := &file_education_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStudentResponse) ProtoMessage() {}
+
+func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStudentResponse.ProtoReflect.Descriptor instead.
+func (*SearchStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SearchStudentResponse) GetStudents() []*AbsStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type AbsStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsStudent) Reset() {
+	*x = AbsStudent{}
+	mi := &file_education_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudent) ProtoMessage() {}
+
+func (x *AbsStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
+func (*AbsStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AbsStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsStudent) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+type GetAllStudentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Condition          string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Page               string                 `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size               string                 `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
+	NameOrSurname      string                 `protobuf:"bytes,4,opt,name=nameOrSurname,proto3" json:"nameOrSurname,omitempty"`
+	CourseId           string                 `protobuf:"bytes,5,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TrainingDatesStart string                 `protobuf:"bytes,6,opt,name=trainingDatesStart,proto3" json:"trainingDatesStart,omitempty"`
+	TrainingDatesEnd   string                 `protobuf:"bytes,7,opt,name=trainingDatesEnd,proto3" json:"trainingDatesEnd,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetAllStudentRequest) Reset() {
+	*x = GetAllStudentRequest{}
+	mi := &file_education_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentRequest) ProtoMessage() {}
+
+func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentRequest.ProtoReflect.Descriptor instead.
+func (*GetAllStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetAllStudentRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetPage() string {
+	if x != nil {
+		return x.Page
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetNameOrSurname() string {
+	if x != nil {
+		return x.NameOrSurname
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetTrainingDatesStart() string {
+	if x != nil {
+		return x.TrainingDatesStart
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetTrainingDatesEnd() string {
+	if x != nil {
+		return x.TrainingDatesEnd
+	}
+	return ""
+}
+
+type GetAllStudentResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Response      []*GetGroupsAbsForStudent `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
+	TotalCount    int32                     `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStudentResponse) Reset() {
+	*x = GetAllStudentResponse{}
+	mi := &file_education_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentResponse) ProtoMessage() {}
+
+func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentResponse.ProtoReflect.Descriptor instead.
+func (*GetAllStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetAllStudentResponse) GetResponse() []*GetGroupsAbsForStudent {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetAllStudentResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetGroupsAbsForStudent struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Id                string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gender            bool                     `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	DateOfBirth       string                   `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Phone             string                   `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address           string                   `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	PassportId        string                   `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
+	AdditionalContact string                   `protobuf:"bytes,8,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
+	Balance           string                   `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance,omitempty"`
+	Condition         string                   `protobuf:"bytes,11,opt,name=condition,proto3" json:"condition,omitempty"`
+	TelegramUsername  string                   `protobuf:"bytes,12,opt,name=telegramUsername,proto3" json:"telegramUsername,omitempty"`
+	CreatedAt         string                   `protobuf:"bytes,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Groups            []*GroupGetAllStudentAbs `protobuf:"bytes,9,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetGroupsAbsForStudent) Reset() {
+	*x = GetGroupsAbsForStudent{}
+	mi := &file_education_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsAbsForStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsAbsForStudent) ProtoMessage() {}
+
+func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsAbsForStudent.ProtoReflect.Descriptor instead.
+func (*GetGroupsAbsForStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetGroupsAbsForStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *GetGroupsAbsForStudent) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetAdditionalContact() string {
+	if x != nil {
+		return x.AdditionalContact
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetTelegramUsername() string {
+	if x != nil {
+		return x.TelegramUsername
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetGroups() []*GroupGetAllStudentAbs {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GroupGetAllStudentAbs struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Course             *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	TeacherName        string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Days               []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	RoomId             int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition,omitempty"`
+	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GroupGetAllStudentAbs) Reset() {
+	*x = GroupGetAllStudentAbs{}
+	mi := &file_education_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupGetAllStudentAbs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupGetAllStudentAbs) ProtoMessage() {}
+
+func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupGetAllStudentAbs.ProtoReflect.Descriptor instead.
+func (*GroupGetAllStudentAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GroupGetAllStudentAbs) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GroupGetAllStudentAbs) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GroupGetAllStudentAbs) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *GroupGetAllStudentAbs) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetStudentCondition() string {
+	if x != nil {
+		return x.StudentCondition
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetStudentActivatedAt() string {
+	if x != nil {
+		return x.StudentActivatedAt
+	}
+	return ""
+}
+
+type CreateStudentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DateOfBirth       string                 `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Gender            bool                   `protobuf:"varint,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	AdditionalContact string                 `protobuf:"bytes,5,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
+	Address           string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	PassportId        string                 `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
+	TelegramUsername  string                 `protobuf:"bytes,10,opt,name=telegramUsername,proto3" json:"telegramUsername,omitempty"`
+	GroupId           string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	DateFrom          string                 `protobuf:"bytes,9,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	CreatedBy         string                 `protobuf:"bytes,11,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateStudentRequest) Reset() {
+	*x = CreateStudentRequest{}
+	mi := &file_education_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStudentRequest) ProtoMessage() {}
+
+func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStudentRequest.ProtoReflect.Descriptor instead.
+func (*CreateStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateStudentRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *CreateStudentRequest) GetAdditionalContact() string {
+	if x != nil {
+		return x.AdditionalContact
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetTelegramUsername() string {
+	if x != nil {
+		return x.TelegramUsername
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type UpdateStudentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentId         string                 `protobuf:"bytes,8,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DateOfBirth       string                 `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Gender            bool                   `protobuf:"varint,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	AdditionalContact string                 `protobuf:"bytes,5,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
+	Address           string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	PassportId        string                 `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateStudentRequest) Reset() {
+	*x = UpdateStudentRequest{}
+	mi := &file_education_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStudentRequest) ProtoMessage() {}
+
+func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStudentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *UpdateStudentRequest) GetAdditionalContact() string {
+	if x != nil {
+		return x.AdditionalContact
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+type AddToGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedDate   string                 `protobuf:"bytes,1,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	StudentIds    []string               `protobuf:"bytes,3,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToGroupRequest) Reset() {
+	*x = AddToGroupRequest{}
+	mi := &file_education_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToGroupRequest) ProtoMessage() {}
+
+func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToGroupRequest.ProtoReflect.Descriptor instead.
+func (*AddToGroupRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AddToGroupRequest) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+func (x *AddToGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddToGroupRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *AddToGroupRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type GetStudentByIdResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gender            bool                   `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	DateOfBirth       string                 `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Phone             string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Balance           string                 `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Groups            []*GetGroupStudent     `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
+	Condition         string                 `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
+	AdditionalContact string                 `protobuf:"bytes,10,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
+	PaymentPlans      []*PaymentPlanStatus   `protobuf:"bytes,11,rep,name=paymentPlans,proto3" json:"paymentPlans,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetStudentByIdResponse) Reset() {
+	*x = GetStudentByIdResponse{}
+	mi := &file_education_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentByIdResponse) ProtoMessage() {}
+
+func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetStudentByIdResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *GetStudentByIdResponse) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetGroups() []*GetGroupStudent {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetStudentByIdResponse) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetAdditionalContact() string {
+	if x != nil {
+		return x.AdditionalContact
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetPaymentPlans() []*PaymentPlanStatus {
+	if x != nil {
+		return x.PaymentPlans
+	}
+	return nil
+}
+
+type NoteStudentByAbsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteStudentByAbsRequest) Reset() {
+	*x = NoteStudentByAbsRequest{}
+	mi := &file_education_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteStudentByAbsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteStudentByAbsRequest) ProtoMessage() {}
+
+func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
+func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *NoteStudentByAbsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGroupStudent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DateType           string                 `protobuf:"bytes,3,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Days               []string               `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	Room               *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	Course             *AbsCourse             `protobuf:"bytes,6,opt,name=course,proto3" json:"course,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition,omitempty"`
+	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
+	StudentAddedAt     string                 `protobuf:"bytes,14,opt,name=studentAddedAt,proto3" json:"studentAddedAt,omitempty"`
+	TeacherName        string                 `protobuf:"bytes,15,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	PriceForStudent    string                 `protobuf:"bytes,13,opt,name=priceForStudent,proto3" json:"priceForStudent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetGroupStudent) Reset() {
+	*x = GetGroupStudent{}
+	mi := &file_education_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupStudent) ProtoMessage() {}
+
+func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
+func (*GetGroupStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetGroupStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetRoom() *AbsRoom {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentCondition() string {
+	if x != nil {
+		return x.StudentCondition
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentActivatedAt() string {
+	if x != nil {
+		return x.StudentActivatedAt
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentAddedAt() string {
+	if x != nil {
+		return x.StudentAddedAt
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetPriceForStudent() string {
+	if x != nil {
+		return x.PriceForStudent
+	}
+	return ""
+}
+
+type GetNotesByStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*AbsNote             `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotesByStudent) Reset() {
+	*x = GetNotesByStudent{}
+	mi := &file_education_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotesByStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotesByStudent) ProtoMessage() {}
+
+func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotesByStudent.ProtoReflect.Descriptor instead.
+func (*GetNotesByStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetNotesByStudent) GetNotes() []*AbsNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type AbsNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsNote) Reset() {
+	*x = AbsNote{}
+	mi := &file_education_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsNote) ProtoMessage() {}
+
+func (x *AbsNote) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsNote.ProtoReflect.Descriptor instead.
+func (*AbsNote) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AbsNote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsNote) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_education_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateNoteRequest) GetNote() string {
 	if x != nil {
-		return x.Name
+		return x.Note
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetTeacherName() string {
+func (x *CreateNoteRequest) GetStudentId() string {
 	if x != nil {
-		return x.TeacherName
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetCurrentGroupStatus() string {
-	if x != nil {
-		return x.CurrentGroupStatus
-	}
-	return ""
+type NotificationSettings struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Lang            string                  `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	SmsEnabled      bool                    `protobuf:"varint,2,opt,name=smsEnabled,proto3" json:"smsEnabled,omitempty"`
+	TelegramEnabled bool                    `protobuf:"varint,3,opt,name=telegramEnabled,proto3" json:"telegramEnabled,omitempty"`
+	Templates       []*NotificationTemplate `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AbsGroup) GetGroupStartDate() string {
-	if x != nil {
-		return x.GroupStartDate
-	}
-	return ""
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	mi := &file_education_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGroup) GetGroupEndDate() string {
+func (x *NotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettings) ProtoMessage() {}
+
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[95]
 	if x != nil {
-		return x.GroupEndDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AbsGroup) GetLessonStartTime() string {
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *NotificationSettings) GetLang() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Lang
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetDateType() string {
+func (x *NotificationSettings) GetSmsEnabled() bool {
 	if x != nil {
-		return x.DateType
+		return x.SmsEnabled
 	}
-	return ""
+	return false
 }
 
-func (x *AbsGroup) GetCourse() *AbsCourse {
+func (x *NotificationSettings) GetTelegramEnabled() bool {
 	if x != nil {
-		return x.Course
+		return x.TelegramEnabled
 	}
-	return nil
+	return false
 }
 
-func (x *AbsGroup) GetIsArchived() bool {
+func (x *NotificationSettings) GetTemplates() []*NotificationTemplate {
 	if x != nil {
-		return x.IsArchived
+		return x.Templates
 	}
-	return false
+	return nil
 }
 
-type AbsHistory struct {
+type NotificationTemplate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	EditedField   string                 `protobuf:"bytes,2,opt,name=editedField,proto3" json:"editedField"`
-	OldValue      string                 `protobuf:"bytes,3,opt,name=oldValue,proto3" json:"oldValue"`
-	CurrentValue  string                 `protobuf:"bytes,4,opt,name=currentValue,proto3" json:"currentValue"`
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,4,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsHistory) Reset() {
-	*x = AbsHistory{}
-	mi := &file_education_proto_msgTypes[60]
+func (x *NotificationTemplate) Reset() {
+	*x = NotificationTemplate{}
+	mi := &file_education_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsHistory) String() string {
+func (x *NotificationTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsHistory) ProtoMessage() {}
+func (*NotificationTemplate) ProtoMessage() {}
 
-func (x *AbsHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[60]
+func (x *NotificationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4689,61 +7471,63 @@ func (x *AbsHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsHistory.ProtoReflect.Descriptor instead.
-func (*AbsHistory) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use NotificationTemplate.ProtoReflect.Descriptor instead.
+func (*NotificationTemplate) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *AbsHistory) GetId() string {
+func (x *NotificationTemplate) GetEvent() string {
 	if x != nil {
-		return x.Id
+		return x.Event
 	}
 	return ""
 }
 
-func (x *AbsHistory) GetEditedField() string {
+func (x *NotificationTemplate) GetLang() string {
 	if x != nil {
-		return x.EditedField
+		return x.Lang
 	}
 	return ""
 }
 
-func (x *AbsHistory) GetOldValue() string {
+func (x *NotificationTemplate) GetBody() string {
 	if x != nil {
-		return x.OldValue
+		return x.Body
 	}
 	return ""
 }
 
-func (x *AbsHistory) GetCurrentValue() string {
+func (x *NotificationTemplate) GetIsDefault() bool {
 	if x != nil {
-		return x.CurrentValue
+		return x.IsDefault
 	}
-	return ""
+	return false
 }
 
-type SearchStudentRequest struct {
+type GetNotificationOutboxRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchStudentRequest) Reset() {
-	*x = SearchStudentRequest{}
-	mi := &file_education_proto_msgTypes[61]
+func (x *GetNotificationOutboxRequest) Reset() {
+	*x = GetNotificationOutboxRequest{}
+	mi := &file_education_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchStudentRequest) String() string {
+func (x *GetNotificationOutboxRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchStudentRequest) ProtoMessage() {}
+func (*GetNotificationOutboxRequest) ProtoMessage() {}
 
-func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[61]
+func (x *GetNotificationOutboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4754,40 +7538,55 @@ func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchStudentRequest.ProtoReflect.Descriptor instead.
-func (*SearchStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use GetNotificationOutboxRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationOutboxRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *SearchStudentRequest) GetValue() string {
+func (x *GetNotificationOutboxRequest) GetStatus() string {
 	if x != nil {
-		return x.Value
+		return x.Status
 	}
 	return ""
 }
 
-type SearchStudentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students"`
+func (x *GetNotificationOutboxRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetNotificationOutboxRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetNotificationOutboxResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*NotificationOutboxItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                     `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchStudentResponse) Reset() {
-	*x = SearchStudentResponse{}
-	mi := &file_education_proto_msgTypes[62]
+func (x *GetNotificationOutboxResponse) Reset() {
+	*x = GetNotificationOutboxResponse{}
+	mi := &file_education_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchStudentResponse) String() string {
+func (x *GetNotificationOutboxResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchStudentResponse) ProtoMessage() {}
+func (*GetNotificationOutboxResponse) ProtoMessage() {}
 
-func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[62]
+func (x *GetNotificationOutboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4798,42 +7597,56 @@ func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchStudentResponse.ProtoReflect.Descriptor instead.
-func (*SearchStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetNotificationOutboxResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationOutboxResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *SearchStudentResponse) GetStudents() []*AbsStudent {
+func (x *GetNotificationOutboxResponse) GetItems() []*NotificationOutboxItem {
 	if x != nil {
-		return x.Students
+		return x.Items
 	}
 	return nil
 }
 
-type AbsStudent struct {
+func (x *GetNotificationOutboxResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type NotificationOutboxItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Recipient     string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	SentAt        string                 `protobuf:"bytes,10,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsStudent) Reset() {
-	*x = AbsStudent{}
-	mi := &file_education_proto_msgTypes[63]
+func (x *NotificationOutboxItem) Reset() {
+	*x = NotificationOutboxItem{}
+	mi := &file_education_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsStudent) String() string {
+func (x *NotificationOutboxItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsStudent) ProtoMessage() {}
+func (*NotificationOutboxItem) ProtoMessage() {}
 
-func (x *AbsStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[63]
+func (x *NotificationOutboxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4844,60 +7657,107 @@ func (x *AbsStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
-func (*AbsStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use NotificationOutboxItem.ProtoReflect.Descriptor instead.
+func (*NotificationOutboxItem) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *AbsStudent) GetId() string {
+func (x *NotificationOutboxItem) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsStudent) GetName() string {
+func (x *NotificationOutboxItem) GetEvent() string {
 	if x != nil {
-		return x.Name
+		return x.Event
 	}
 	return ""
 }
 
-func (x *AbsStudent) GetPhoneNumber() string {
+func (x *NotificationOutboxItem) GetChannel() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Channel
 	}
 	return ""
 }
 
-type GetAllStudentRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Condition          string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition"`
-	Page               string                 `protobuf:"bytes,2,opt,name=page,proto3" json:"page"`
-	Size               string                 `protobuf:"bytes,3,opt,name=size,proto3" json:"size"`
-	NameOrSurname      string                 `protobuf:"bytes,4,opt,name=nameOrSurname,proto3" json:"nameOrSurname"`
-	CourseId           string                 `protobuf:"bytes,5,opt,name=courseId,proto3" json:"courseId"`
-	TrainingDatesStart string                 `protobuf:"bytes,6,opt,name=trainingDatesStart,proto3" json:"trainingDatesStart"`
-	TrainingDatesEnd   string                 `protobuf:"bytes,7,opt,name=trainingDatesEnd,proto3" json:"trainingDatesEnd"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *NotificationOutboxItem) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
 }
 
-func (x *GetAllStudentRequest) Reset() {
-	*x = GetAllStudentRequest{}
-	mi := &file_education_proto_msgTypes[64]
+func (x *NotificationOutboxItem) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NotificationOutboxItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationOutboxItem) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *NotificationOutboxItem) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *NotificationOutboxItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *NotificationOutboxItem) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+type DebtReminderSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsActive      bool                   `protobuf:"varint,1,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	BalanceBelow  string                 `protobuf:"bytes,2,opt,name=balanceBelow,proto3" json:"balanceBelow,omitempty"`
+	OverdueDays   int32                  `protobuf:"varint,3,opt,name=overdueDays,proto3" json:"overdueDays,omitempty"`
+	CadenceDays   int32                  `protobuf:"varint,4,opt,name=cadenceDays,proto3" json:"cadenceDays,omitempty"`
+	MaxReminders  int32                  `protobuf:"varint,5,opt,name=maxReminders,proto3" json:"maxReminders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtReminderSettings) Reset() {
+	*x = DebtReminderSettings{}
+	mi := &file_education_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentRequest) String() string {
+func (x *DebtReminderSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentRequest) ProtoMessage() {}
+func (*DebtReminderSettings) ProtoMessage() {}
 
-func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[64]
+func (x *DebtReminderSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4908,83 +7768,129 @@ func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentRequest.ProtoReflect.Descriptor instead.
-func (*GetAllStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use DebtReminderSettings.ProtoReflect.Descriptor instead.
+func (*DebtReminderSettings) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetAllStudentRequest) GetCondition() string {
+func (x *DebtReminderSettings) GetIsActive() bool {
 	if x != nil {
-		return x.Condition
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *GetAllStudentRequest) GetPage() string {
+func (x *DebtReminderSettings) GetBalanceBelow() string {
 	if x != nil {
-		return x.Page
+		return x.BalanceBelow
 	}
 	return ""
 }
 
-func (x *GetAllStudentRequest) GetSize() string {
+func (x *DebtReminderSettings) GetOverdueDays() int32 {
 	if x != nil {
-		return x.Size
+		return x.OverdueDays
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAllStudentRequest) GetNameOrSurname() string {
+func (x *DebtReminderSettings) GetCadenceDays() int32 {
 	if x != nil {
-		return x.NameOrSurname
+		return x.CadenceDays
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAllStudentRequest) GetCourseId() string {
+func (x *DebtReminderSettings) GetMaxReminders() int32 {
 	if x != nil {
-		return x.CourseId
+		return x.MaxReminders
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAllStudentRequest) GetTrainingDatesStart() string {
+type GetDebtRemindersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDebtRemindersRequest) Reset() {
+	*x = GetDebtRemindersRequest{}
+	mi := &file_education_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebtRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebtRemindersRequest) ProtoMessage() {}
+
+func (x *GetDebtRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[101]
 	if x != nil {
-		return x.TrainingDatesStart
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetAllStudentRequest) GetTrainingDatesEnd() string {
+// Deprecated: Use GetDebtRemindersRequest.ProtoReflect.Descriptor instead.
+func (*GetDebtRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetDebtRemindersRequest) GetStatus() string {
 	if x != nil {
-		return x.TrainingDatesEnd
+		return x.Status
 	}
 	return ""
 }
 
-type GetAllStudentResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Response      []*GetGroupsAbsForStudent `protobuf:"bytes,1,rep,name=response,proto3" json:"response"`
-	TotalCount    int32                     `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount"`
+func (x *GetDebtRemindersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDebtRemindersRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetDebtRemindersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DebtReminderItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllStudentResponse) Reset() {
-	*x = GetAllStudentResponse{}
-	mi := &file_education_proto_msgTypes[65]
+func (x *GetDebtRemindersResponse) Reset() {
+	*x = GetDebtRemindersResponse{}
+	mi := &file_education_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentResponse) String() string {
+func (x *GetDebtRemindersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentResponse) ProtoMessage() {}
+func (*GetDebtRemindersResponse) ProtoMessage() {}
 
-func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[65]
+func (x *GetDebtRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4995,59 +7901,60 @@ func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentResponse.ProtoReflect.Descriptor instead.
-func (*GetAllStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetDebtRemindersResponse.ProtoReflect.Descriptor instead.
+func (*GetDebtRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetAllStudentResponse) GetResponse() []*GetGroupsAbsForStudent {
+func (x *GetDebtRemindersResponse) GetItems() []*DebtReminderItem {
 	if x != nil {
-		return x.Response
+		return x.Items
 	}
 	return nil
 }
 
-func (x *GetAllStudentResponse) GetTotalCount() int32 {
+func (x *GetDebtRemindersResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-type GetGroupsAbsForStudent struct {
-	state             protoimpl.MessageState   `protogen:"open.v1"`
-	Id                string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name              string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	Gender            bool                     `protobuf:"varint,3,opt,name=gender,proto3" json:"gender"`
-	DateOfBirth       string                   `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth"`
-	Phone             string                   `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone"`
-	Address           string                   `protobuf:"bytes,6,opt,name=address,proto3" json:"address"`
-	PassportId        string                   `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId"`
-	AdditionalContact string                   `protobuf:"bytes,8,opt,name=additionalContact,proto3" json:"additionalContact"`
-	Balance           float64                  `protobuf:"fixed64,10,opt,name=balance,proto3" json:"balance"`
-	Condition         string                   `protobuf:"bytes,11,opt,name=condition,proto3" json:"condition"`
-	TelegramUsername  string                   `protobuf:"bytes,12,opt,name=telegramUsername,proto3" json:"telegramUsername"`
-	CreatedAt         string                   `protobuf:"bytes,13,opt,name=createdAt,proto3" json:"createdAt"`
-	Groups            []*GroupGetAllStudentAbs `protobuf:"bytes,9,rep,name=groups,proto3" json:"groups"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DebtReminderItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId      string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName    string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	PhoneNumber    string                 `protobuf:"bytes,4,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Balance        string                 `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	DebtSince      string                 `protobuf:"bytes,6,opt,name=debtSince,proto3" json:"debtSince,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	RemindersSent  int32                  `protobuf:"varint,8,opt,name=remindersSent,proto3" json:"remindersSent,omitempty"`
+	LastSentAt     string                 `protobuf:"bytes,9,opt,name=lastSentAt,proto3" json:"lastSentAt,omitempty"`
+	NextSendAt     string                 `protobuf:"bytes,10,opt,name=nextSendAt,proto3" json:"nextSendAt,omitempty"`
+	ReplyStatus    string                 `protobuf:"bytes,11,opt,name=replyStatus,proto3" json:"replyStatus,omitempty"`
+	ReplyComment   string                 `protobuf:"bytes,12,opt,name=replyComment,proto3" json:"replyComment,omitempty"`
+	PromisedDate   string                 `protobuf:"bytes,13,opt,name=promisedDate,proto3" json:"promisedDate,omitempty"`
+	DeliveryStatus string                 `protobuf:"bytes,14,opt,name=deliveryStatus,proto3" json:"deliveryStatus,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupsAbsForStudent) Reset() {
-	*x = GetGroupsAbsForStudent{}
-	mi := &file_education_proto_msgTypes[66]
+func (x *DebtReminderItem) Reset() {
+	*x = DebtReminderItem{}
+	mi := &file_education_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsAbsForStudent) String() string {
+func (x *DebtReminderItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsAbsForStudent) ProtoMessage() {}
+func (*DebtReminderItem) ProtoMessage() {}
 
-func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[66]
+func (x *DebtReminderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5058,135 +7965,135 @@ func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsAbsForStudent.ProtoReflect.Descriptor instead.
-func (*GetGroupsAbsForStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use DebtReminderItem.ProtoReflect.Descriptor instead.
+func (*DebtReminderItem) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetGroupsAbsForStudent) GetId() string {
+func (x *DebtReminderItem) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetName() string {
+func (x *DebtReminderItem) GetStudentId() string {
 	if x != nil {
-		return x.Name
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetGender() bool {
+func (x *DebtReminderItem) GetStudentName() string {
 	if x != nil {
-		return x.Gender
+		return x.StudentName
 	}
-	return false
+	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetDateOfBirth() string {
+func (x *DebtReminderItem) GetPhoneNumber() string {
 	if x != nil {
-		return x.DateOfBirth
+		return x.PhoneNumber
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetPhone() string {
+func (x *DebtReminderItem) GetBalance() string {
 	if x != nil {
-		return x.Phone
+		return x.Balance
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetAddress() string {
+func (x *DebtReminderItem) GetDebtSince() string {
 	if x != nil {
-		return x.Address
+		return x.DebtSince
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetPassportId() string {
+func (x *DebtReminderItem) GetStatus() string {
 	if x != nil {
-		return x.PassportId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetAdditionalContact() string {
+func (x *DebtReminderItem) GetRemindersSent() int32 {
 	if x != nil {
-		return x.AdditionalContact
+		return x.RemindersSent
+	}
+	return 0
+}
+
+func (x *DebtReminderItem) GetLastSentAt() string {
+	if x != nil {
+		return x.LastSentAt
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetBalance() float64 {
+func (x *DebtReminderItem) GetNextSendAt() string {
 	if x != nil {
-		return x.Balance
+		return x.NextSendAt
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetCondition() string {
+func (x *DebtReminderItem) GetReplyStatus() string {
 	if x != nil {
-		return x.Condition
+		return x.ReplyStatus
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetTelegramUsername() string {
+func (x *DebtReminderItem) GetReplyComment() string {
 	if x != nil {
-		return x.TelegramUsername
+		return x.ReplyComment
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetCreatedAt() string {
+func (x *DebtReminderItem) GetPromisedDate() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.PromisedDate
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetGroups() []*GroupGetAllStudentAbs {
+func (x *DebtReminderItem) GetDeliveryStatus() string {
 	if x != nil {
-		return x.Groups
+		return x.DeliveryStatus
 	}
-	return nil
+	return ""
 }
 
-type GroupGetAllStudentAbs struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	Course             *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course"`
-	TeacherName        string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName"`
-	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
-	Days               []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days"`
-	RoomId             int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId"`
-	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition"`
-	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type SetDebtReminderReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReplyStatus   string                 `protobuf:"bytes,2,opt,name=replyStatus,proto3" json:"replyStatus,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	PromisedDate  string                 `protobuf:"bytes,4,opt,name=promisedDate,proto3" json:"promisedDate,omitempty"`
+	Stop          bool                   `protobuf:"varint,5,opt,name=stop,proto3" json:"stop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupGetAllStudentAbs) Reset() {
-	*x = GroupGetAllStudentAbs{}
-	mi := &file_education_proto_msgTypes[67]
+func (x *SetDebtReminderReplyRequest) Reset() {
+	*x = SetDebtReminderReplyRequest{}
+	mi := &file_education_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupGetAllStudentAbs) String() string {
+func (x *SetDebtReminderReplyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupGetAllStudentAbs) ProtoMessage() {}
+func (*SetDebtReminderReplyRequest) ProtoMessage() {}
 
-func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[67]
+func (x *SetDebtReminderReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5197,127 +8104,181 @@ func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupGetAllStudentAbs.ProtoReflect.Descriptor instead.
-func (*GroupGetAllStudentAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use SetDebtReminderReplyRequest.ProtoReflect.Descriptor instead.
+func (*SetDebtReminderReplyRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GroupGetAllStudentAbs) GetId() string {
+func (x *SetDebtReminderReplyRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetName() string {
+func (x *SetDebtReminderReplyRequest) GetReplyStatus() string {
 	if x != nil {
-		return x.Name
+		return x.ReplyStatus
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetCourse() *AbsCourse {
+func (x *SetDebtReminderReplyRequest) GetComment() string {
 	if x != nil {
-		return x.Course
+		return x.Comment
 	}
-	return nil
+	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetTeacherName() string {
+func (x *SetDebtReminderReplyRequest) GetPromisedDate() string {
 	if x != nil {
-		return x.TeacherName
+		return x.PromisedDate
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetType() string {
+func (x *SetDebtReminderReplyRequest) GetStop() bool {
 	if x != nil {
-		return x.Type
+		return x.Stop
 	}
-	return ""
+	return false
 }
 
-func (x *GroupGetAllStudentAbs) GetDays() []string {
+type RunBalanceReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AutoCorrect   bool                   `protobuf:"varint,1,opt,name=autoCorrect,proto3" json:"autoCorrect,omitempty"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunBalanceReconciliationRequest) Reset() {
+	*x = RunBalanceReconciliationRequest{}
+	mi := &file_education_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunBalanceReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunBalanceReconciliationRequest) ProtoMessage() {}
+
+func (x *RunBalanceReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[105]
 	if x != nil {
-		return x.Days
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GroupGetAllStudentAbs) GetRoomId() int32 {
+// Deprecated: Use RunBalanceReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*RunBalanceReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RunBalanceReconciliationRequest) GetAutoCorrect() bool {
 	if x != nil {
-		return x.RoomId
+		return x.AutoCorrect
 	}
-	return 0
+	return false
 }
 
-func (x *GroupGetAllStudentAbs) GetLessonStartTime() string {
+func (x *RunBalanceReconciliationRequest) GetActionById() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.ActionById
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetGroupStartDate() string {
+func (x *RunBalanceReconciliationRequest) GetActionByName() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.ActionByName
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetGroupEndDate() string {
+type GetReconciliationReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportsRequest) Reset() {
+	*x = GetReconciliationReportsRequest{}
+	mi := &file_education_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportsRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[106]
 	if x != nil {
-		return x.GroupEndDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GroupGetAllStudentAbs) GetStudentCondition() string {
+// Deprecated: Use GetReconciliationReportsRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetReconciliationReportsRequest) GetPage() int32 {
 	if x != nil {
-		return x.StudentCondition
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *GroupGetAllStudentAbs) GetStudentActivatedAt() string {
+func (x *GetReconciliationReportsRequest) GetSize() int32 {
 	if x != nil {
-		return x.StudentActivatedAt
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-type CreateStudentRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	DateOfBirth       string                 `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth"`
-	Gender            bool                   `protobuf:"varint,4,opt,name=gender,proto3" json:"gender"`
-	AdditionalContact string                 `protobuf:"bytes,5,opt,name=additionalContact,proto3" json:"additionalContact"`
-	Address           string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address"`
-	PassportId        string                 `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId"`
-	TelegramUsername  string                 `protobuf:"bytes,10,opt,name=telegramUsername,proto3" json:"telegramUsername"`
-	GroupId           string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId"`
-	DateFrom          string                 `protobuf:"bytes,9,opt,name=dateFrom,proto3" json:"dateFrom"`
-	CreatedBy         string                 `protobuf:"bytes,11,opt,name=createdBy,proto3" json:"createdBy"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetReconciliationReportsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*ReconciliationReport `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateStudentRequest) Reset() {
-	*x = CreateStudentRequest{}
-	mi := &file_education_proto_msgTypes[68]
+func (x *GetReconciliationReportsResponse) Reset() {
+	*x = GetReconciliationReportsResponse{}
+	mi := &file_education_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateStudentRequest) String() string {
+func (x *GetReconciliationReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStudentRequest) ProtoMessage() {}
+func (*GetReconciliationReportsResponse) ProtoMessage() {}
 
-func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[68]
+func (x *GetReconciliationReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5328,117 +8289,204 @@ func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStudentRequest.ProtoReflect.Descriptor instead.
-func (*CreateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use GetReconciliationReportsResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *CreateStudentRequest) GetPhoneNumber() string {
+func (x *GetReconciliationReportsResponse) GetItems() []*ReconciliationReport {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateStudentRequest) GetName() string {
+func (x *GetReconciliationReportsResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Name
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetReconciliationReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_education_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetReconciliationReportRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetDateOfBirth() string {
+type ReconciliationReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CheckedCount  int32                  `protobuf:"varint,3,opt,name=checkedCount,proto3" json:"checkedCount,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,4,opt,name=skippedCount,proto3" json:"skippedCount,omitempty"`
+	DriftCount    int32                  `protobuf:"varint,5,opt,name=driftCount,proto3" json:"driftCount,omitempty"`
+	TotalDrift    string                 `protobuf:"bytes,6,opt,name=totalDrift,proto3" json:"totalDrift,omitempty"`
+	AutoCorrected bool                   `protobuf:"varint,7,opt,name=autoCorrected,proto3" json:"autoCorrected,omitempty"`
+	TriggeredBy   string                 `protobuf:"bytes,8,opt,name=triggeredBy,proto3" json:"triggeredBy,omitempty"`
+	Drifts        []*BalanceDrift        `protobuf:"bytes,9,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationReport) Reset() {
+	*x = ReconciliationReport{}
+	mi := &file_education_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationReport) ProtoMessage() {}
+
+func (x *ReconciliationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[109]
 	if x != nil {
-		return x.DateOfBirth
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationReport.ProtoReflect.Descriptor instead.
+func (*ReconciliationReport) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReconciliationReport) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetGender() bool {
+func (x *ReconciliationReport) GetCreatedAt() string {
 	if x != nil {
-		return x.Gender
+		return x.CreatedAt
 	}
-	return false
+	return ""
 }
 
-func (x *CreateStudentRequest) GetAdditionalContact() string {
+func (x *ReconciliationReport) GetCheckedCount() int32 {
 	if x != nil {
-		return x.AdditionalContact
+		return x.CheckedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateStudentRequest) GetAddress() string {
+func (x *ReconciliationReport) GetSkippedCount() int32 {
 	if x != nil {
-		return x.Address
+		return x.SkippedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateStudentRequest) GetPassportId() string {
+func (x *ReconciliationReport) GetDriftCount() int32 {
 	if x != nil {
-		return x.PassportId
+		return x.DriftCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateStudentRequest) GetTelegramUsername() string {
+func (x *ReconciliationReport) GetTotalDrift() string {
 	if x != nil {
-		return x.TelegramUsername
+		return x.TotalDrift
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetGroupId() string {
+func (x *ReconciliationReport) GetAutoCorrected() bool {
 	if x != nil {
-		return x.GroupId
+		return x.AutoCorrected
 	}
-	return ""
+	return false
 }
 
-func (x *CreateStudentRequest) GetDateFrom() string {
+func (x *ReconciliationReport) GetTriggeredBy() string {
 	if x != nil {
-		return x.DateFrom
+		return x.TriggeredBy
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetCreatedBy() string {
+func (x *ReconciliationReport) GetDrifts() []*BalanceDrift {
 	if x != nil {
-		return x.CreatedBy
+		return x.Drifts
 	}
-	return ""
+	return nil
 }
 
-type UpdateStudentRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	StudentId         string                 `protobuf:"bytes,8,opt,name=studentId,proto3" json:"studentId"`
-	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	DateOfBirth       string                 `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth"`
-	Gender            bool                   `protobuf:"varint,4,opt,name=gender,proto3" json:"gender"`
-	AdditionalContact string                 `protobuf:"bytes,5,opt,name=additionalContact,proto3" json:"additionalContact"`
-	Address           string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address"`
-	PassportId        string                 `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type BalanceDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	LedgerBalance string                 `protobuf:"bytes,3,opt,name=ledgerBalance,proto3" json:"ledgerBalance,omitempty"`
+	ActualBalance string                 `protobuf:"bytes,4,opt,name=actualBalance,proto3" json:"actualBalance,omitempty"`
+	Difference    string                 `protobuf:"bytes,5,opt,name=difference,proto3" json:"difference,omitempty"`
+	Corrected     bool                   `protobuf:"varint,6,opt,name=corrected,proto3" json:"corrected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateStudentRequest) Reset() {
-	*x = UpdateStudentRequest{}
-	mi := &file_education_proto_msgTypes[69]
+func (x *BalanceDrift) Reset() {
+	*x = BalanceDrift{}
+	mi := &file_education_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStudentRequest) String() string {
+func (x *BalanceDrift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStudentRequest) ProtoMessage() {}
+func (*BalanceDrift) ProtoMessage() {}
 
-func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[69]
+func (x *BalanceDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5449,92 +8497,138 @@ func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStudentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use BalanceDrift.ProtoReflect.Descriptor instead.
+func (*BalanceDrift) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *UpdateStudentRequest) GetStudentId() string {
+func (x *BalanceDrift) GetStudentId() string {
 	if x != nil {
 		return x.StudentId
 	}
 	return ""
 }
 
-func (x *UpdateStudentRequest) GetPhoneNumber() string {
+func (x *BalanceDrift) GetStudentName() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *UpdateStudentRequest) GetName() string {
+func (x *BalanceDrift) GetLedgerBalance() string {
 	if x != nil {
-		return x.Name
+		return x.LedgerBalance
 	}
 	return ""
 }
 
-func (x *UpdateStudentRequest) GetDateOfBirth() string {
+func (x *BalanceDrift) GetActualBalance() string {
 	if x != nil {
-		return x.DateOfBirth
+		return x.ActualBalance
 	}
 	return ""
 }
 
-func (x *UpdateStudentRequest) GetGender() bool {
+func (x *BalanceDrift) GetDifference() string {
 	if x != nil {
-		return x.Gender
+		return x.Difference
+	}
+	return ""
+}
+
+func (x *BalanceDrift) GetCorrected() bool {
+	if x != nil {
+		return x.Corrected
 	}
 	return false
 }
 
-func (x *UpdateStudentRequest) GetAdditionalContact() string {
+type PlatformMetricsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// months whose renewals and cohorts are reported, YYYY-MM-DD
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformMetricsRequest) Reset() {
+	*x = PlatformMetricsRequest{}
+	mi := &file_education_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformMetricsRequest) ProtoMessage() {}
+
+func (x *PlatformMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[111]
 	if x != nil {
-		return x.AdditionalContact
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateStudentRequest) GetAddress() string {
+// Deprecated: Use PlatformMetricsRequest.ProtoReflect.Descriptor instead.
+func (*PlatformMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *PlatformMetricsRequest) GetFrom() string {
 	if x != nil {
-		return x.Address
+		return x.From
 	}
 	return ""
 }
 
-func (x *UpdateStudentRequest) GetPassportId() string {
+func (x *PlatformMetricsRequest) GetTo() string {
 	if x != nil {
-		return x.PassportId
+		return x.To
 	}
 	return ""
 }
 
-type AddToGroupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatedDate   string                 `protobuf:"bytes,1,opt,name=createdDate,proto3" json:"createdDate"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
-	StudentIds    []string               `protobuf:"bytes,3,rep,name=student_ids,json=studentIds,proto3" json:"student_ids"`
-	CreatedBy     string                 `protobuf:"bytes,4,opt,name=createdBy,proto3" json:"createdBy"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PlatformMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// monthly recurring revenue of the periods paid for today
+	Mrr             string             `protobuf:"bytes,1,opt,name=mrr,proto3" json:"mrr,omitempty"`
+	Arr             string             `protobuf:"bytes,2,opt,name=arr,proto3" json:"arr,omitempty"`
+	PayingCompanies int32              `protobuf:"varint,3,opt,name=payingCompanies,proto3" json:"payingCompanies,omitempty"`
+	DemoCompanies   int32              `protobuf:"varint,4,opt,name=demoCompanies,proto3" json:"demoCompanies,omitempty"`
+	GraceCompanies  int32              `protobuf:"varint,5,opt,name=graceCompanies,proto3" json:"graceCompanies,omitempty"`
+	LockedCompanies int32              `protobuf:"varint,6,opt,name=lockedCompanies,proto3" json:"lockedCompanies,omitempty"`
+	TotalCompanies  int32              `protobuf:"varint,7,opt,name=totalCompanies,proto3" json:"totalCompanies,omitempty"`
+	ActiveStudents  int32              `protobuf:"varint,8,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	Renewals        []*RenewalStat     `protobuf:"bytes,9,rep,name=renewals,proto3" json:"renewals,omitempty"`
+	Cohorts         []*CohortRetention `protobuf:"bytes,10,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AddToGroupRequest) Reset() {
-	*x = AddToGroupRequest{}
-	mi := &file_education_proto_msgTypes[70]
+func (x *PlatformMetrics) Reset() {
+	*x = PlatformMetrics{}
+	mi := &file_education_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddToGroupRequest) String() string {
+func (x *PlatformMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToGroupRequest) ProtoMessage() {}
+func (*PlatformMetrics) ProtoMessage() {}
 
-func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[70]
+func (x *PlatformMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5545,70 +8639,111 @@ func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToGroupRequest.ProtoReflect.Descriptor instead.
-func (*AddToGroupRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use PlatformMetrics.ProtoReflect.Descriptor instead.
+func (*PlatformMetrics) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *AddToGroupRequest) GetCreatedDate() string {
+func (x *PlatformMetrics) GetMrr() string {
 	if x != nil {
-		return x.CreatedDate
+		return x.Mrr
 	}
 	return ""
 }
 
-func (x *AddToGroupRequest) GetGroupId() string {
+func (x *PlatformMetrics) GetArr() string {
 	if x != nil {
-		return x.GroupId
+		return x.Arr
 	}
 	return ""
 }
 
-func (x *AddToGroupRequest) GetStudentIds() []string {
+func (x *PlatformMetrics) GetPayingCompanies() int32 {
 	if x != nil {
-		return x.StudentIds
+		return x.PayingCompanies
+	}
+	return 0
+}
+
+func (x *PlatformMetrics) GetDemoCompanies() int32 {
+	if x != nil {
+		return x.DemoCompanies
+	}
+	return 0
+}
+
+func (x *PlatformMetrics) GetGraceCompanies() int32 {
+	if x != nil {
+		return x.GraceCompanies
+	}
+	return 0
+}
+
+func (x *PlatformMetrics) GetLockedCompanies() int32 {
+	if x != nil {
+		return x.LockedCompanies
+	}
+	return 0
+}
+
+func (x *PlatformMetrics) GetTotalCompanies() int32 {
+	if x != nil {
+		return x.TotalCompanies
+	}
+	return 0
+}
+
+func (x *PlatformMetrics) GetActiveStudents() int32 {
+	if x != nil {
+		return x.ActiveStudents
+	}
+	return 0
+}
+
+func (x *PlatformMetrics) GetRenewals() []*RenewalStat {
+	if x != nil {
+		return x.Renewals
 	}
 	return nil
 }
 
-func (x *AddToGroupRequest) GetCreatedBy() string {
+func (x *PlatformMetrics) GetCohorts() []*CohortRetention {
 	if x != nil {
-		return x.CreatedBy
+		return x.Cohorts
 	}
-	return ""
+	return nil
 }
 
-type GetStudentByIdResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	Gender            bool                   `protobuf:"varint,3,opt,name=gender,proto3" json:"gender"`
-	DateOfBirth       string                 `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth"`
-	Phone             string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone"`
-	Balance           float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance"`
-	CreatedAt         string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt"`
-	Groups            []*GetGroupStudent     `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups"`
-	Condition         string                 `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition"`
-	AdditionalContact string                 `protobuf:"bytes,10,opt,name=additionalContact,proto3" json:"additionalContact"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// RenewalStat covers the paid periods ending in a month: renewed within the
+// grace period, churned, or still within it.
+type RenewalStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Due           int32                  `protobuf:"varint,2,opt,name=due,proto3" json:"due,omitempty"`
+	Renewed       int32                  `protobuf:"varint,3,opt,name=renewed,proto3" json:"renewed,omitempty"`
+	Churned       int32                  `protobuf:"varint,4,opt,name=churned,proto3" json:"churned,omitempty"`
+	Pending       int32                  `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	RenewalRate   float64                `protobuf:"fixed64,6,opt,name=renewalRate,proto3" json:"renewalRate,omitempty"`
+	ChurnRate     float64                `protobuf:"fixed64,7,opt,name=churnRate,proto3" json:"churnRate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentByIdResponse) Reset() {
-	*x = GetStudentByIdResponse{}
-	mi := &file_education_proto_msgTypes[71]
+func (x *RenewalStat) Reset() {
+	*x = RenewalStat{}
+	mi := &file_education_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentByIdResponse) String() string {
+func (x *RenewalStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentByIdResponse) ProtoMessage() {}
+func (*RenewalStat) ProtoMessage() {}
 
-func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[71]
+func (x *RenewalStat) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5619,103 +8754,147 @@ func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use RenewalStat.ProtoReflect.Descriptor instead.
+func (*RenewalStat) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetStudentByIdResponse) GetId() string {
+func (x *RenewalStat) GetMonth() string {
 	if x != nil {
-		return x.Id
+		return x.Month
 	}
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetName() string {
+func (x *RenewalStat) GetDue() int32 {
 	if x != nil {
-		return x.Name
+		return x.Due
 	}
-	return ""
+	return 0
 }
 
-func (x *GetStudentByIdResponse) GetGender() bool {
+func (x *RenewalStat) GetRenewed() int32 {
 	if x != nil {
-		return x.Gender
+		return x.Renewed
 	}
-	return false
+	return 0
 }
 
-func (x *GetStudentByIdResponse) GetDateOfBirth() string {
+func (x *RenewalStat) GetChurned() int32 {
 	if x != nil {
-		return x.DateOfBirth
+		return x.Churned
 	}
-	return ""
+	return 0
 }
 
-func (x *GetStudentByIdResponse) GetPhone() string {
+func (x *RenewalStat) GetPending() int32 {
 	if x != nil {
-		return x.Phone
+		return x.Pending
 	}
-	return ""
+	return 0
 }
 
-func (x *GetStudentByIdResponse) GetBalance() float64 {
+func (x *RenewalStat) GetRenewalRate() float64 {
 	if x != nil {
-		return x.Balance
+		return x.RenewalRate
 	}
 	return 0
 }
 
-func (x *GetStudentByIdResponse) GetCreatedAt() string {
+func (x *RenewalStat) GetChurnRate() float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.ChurnRate
 	}
-	return ""
+	return 0
 }
 
-func (x *GetStudentByIdResponse) GetGroups() []*GetGroupStudent {
+// CohortRetention follows the companies that first paid in a month: the share
+// of them paying at the end of that month and each month after.
+type CohortRetention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Companies     int32                  `protobuf:"varint,2,opt,name=companies,proto3" json:"companies,omitempty"`
+	Retention     []float64              `protobuf:"fixed64,3,rep,packed,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CohortRetention) Reset() {
+	*x = CohortRetention{}
+	mi := &file_education_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortRetention) ProtoMessage() {}
+
+func (x *CohortRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[114]
 	if x != nil {
-		return x.Groups
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetStudentByIdResponse) GetCondition() string {
+// Deprecated: Use CohortRetention.ProtoReflect.Descriptor instead.
+func (*CohortRetention) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CohortRetention) GetMonth() string {
 	if x != nil {
-		return x.Condition
+		return x.Month
 	}
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetAdditionalContact() string {
+func (x *CohortRetention) GetCompanies() int32 {
 	if x != nil {
-		return x.AdditionalContact
+		return x.Companies
 	}
-	return ""
+	return 0
 }
 
-type NoteStudentByAbsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+func (x *CohortRetention) GetRetention() []float64 {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+type TenantHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HEALTHY, AT_RISK or CRITICAL
+	Level         string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NoteStudentByAbsRequest) Reset() {
-	*x = NoteStudentByAbsRequest{}
-	mi := &file_education_proto_msgTypes[72]
+func (x *TenantHealthRequest) Reset() {
+	*x = TenantHealthRequest{}
+	mi := &file_education_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NoteStudentByAbsRequest) String() string {
+func (x *TenantHealthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NoteStudentByAbsRequest) ProtoMessage() {}
+func (*TenantHealthRequest) ProtoMessage() {}
 
-func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[72]
+func (x *TenantHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5726,53 +8905,74 @@ func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
-func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use TenantHealthRequest.ProtoReflect.Descriptor instead.
+func (*TenantHealthRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *NoteStudentByAbsRequest) GetId() string {
+func (x *TenantHealthRequest) GetLevel() string {
 	if x != nil {
-		return x.Id
+		return x.Level
 	}
 	return ""
 }
 
-type GetGroupStudent struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	DateType           string                 `protobuf:"bytes,3,opt,name=dateType,proto3" json:"dateType"`
-	Days               []string               `protobuf:"bytes,4,rep,name=days,proto3" json:"days"`
-	Room               *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room"`
-	Course             *AbsCourse             `protobuf:"bytes,6,opt,name=course,proto3" json:"course"`
-	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition"`
-	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt"`
-	StudentAddedAt     string                 `protobuf:"bytes,14,opt,name=studentAddedAt,proto3" json:"studentAddedAt"`
-	TeacherName        string                 `protobuf:"bytes,15,opt,name=teacherName,proto3" json:"teacherName"`
-	PriceForStudent    float64                `protobuf:"fixed64,13,opt,name=priceForStudent,proto3" json:"priceForStudent"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *TenantHealthRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
 }
 
-func (x *GetGroupStudent) Reset() {
-	*x = GetGroupStudent{}
-	mi := &file_education_proto_msgTypes[73]
+func (x *TenantHealthRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type TenantHealth struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId    int32                  `protobuf:"varint,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Subdomain    string                 `protobuf:"bytes,3,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	State        string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	IsDemo       bool                   `protobuf:"varint,5,opt,name=isDemo,proto3" json:"isDemo,omitempty"`
+	TariffName   string                 `protobuf:"bytes,6,opt,name=tariffName,proto3" json:"tariffName,omitempty"`
+	ValidDate    string                 `protobuf:"bytes,7,opt,name=validDate,proto3" json:"validDate,omitempty"`
+	Students     int32                  `protobuf:"varint,8,opt,name=students,proto3" json:"students,omitempty"`
+	StudentLimit int32                  `protobuf:"varint,9,opt,name=studentLimit,proto3" json:"studentLimit,omitempty"`
+	Groups       int32                  `protobuf:"varint,10,opt,name=groups,proto3" json:"groups,omitempty"`
+	Staff        int32                  `protobuf:"varint,11,opt,name=staff,proto3" json:"staff,omitempty"`
+	Leads        int32                  `protobuf:"varint,12,opt,name=leads,proto3" json:"leads,omitempty"`
+	// recentLeads, payments and paymentsSum cover the last 30 days
+	RecentLeads int32  `protobuf:"varint,13,opt,name=recentLeads,proto3" json:"recentLeads,omitempty"`
+	Payments    int32  `protobuf:"varint,14,opt,name=payments,proto3" json:"payments,omitempty"`
+	PaymentsSum string `protobuf:"bytes,15,opt,name=paymentsSum,proto3" json:"paymentsSum,omitempty"`
+	// 0 to 100
+	Score int32  `protobuf:"varint,16,opt,name=score,proto3" json:"score,omitempty"`
+	Level string `protobuf:"bytes,17,opt,name=level,proto3" json:"level,omitempty"`
+	// set on snapshots
+	Day           string `protobuf:"bytes,18,opt,name=day,proto3" json:"day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantHealth) Reset() {
+	*x = TenantHealth{}
+	mi := &file_education_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupStudent) String() string {
+func (x *TenantHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupStudent) ProtoMessage() {}
-
-func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[73]
+func (*TenantHealth) ProtoMessage() {}
+
+func (x *TenantHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5783,131 +8983,162 @@ func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
-func (*GetGroupStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use TenantHealth.ProtoReflect.Descriptor instead.
+func (*TenantHealth) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetGroupStudent) GetId() string {
+func (x *TenantHealth) GetCompanyId() int32 {
 	if x != nil {
-		return x.Id
+		return x.CompanyId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetName() string {
+func (x *TenantHealth) GetTitle() string {
 	if x != nil {
-		return x.Name
+		return x.Title
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetDateType() string {
+func (x *TenantHealth) GetSubdomain() string {
 	if x != nil {
-		return x.DateType
+		return x.Subdomain
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetDays() []string {
+func (x *TenantHealth) GetState() string {
 	if x != nil {
-		return x.Days
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupStudent) GetRoom() *AbsRoom {
+func (x *TenantHealth) GetIsDemo() bool {
 	if x != nil {
-		return x.Room
+		return x.IsDemo
 	}
-	return nil
+	return false
 }
 
-func (x *GetGroupStudent) GetCourse() *AbsCourse {
+func (x *TenantHealth) GetTariffName() string {
 	if x != nil {
-		return x.Course
+		return x.TariffName
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupStudent) GetLessonStartTime() string {
+func (x *TenantHealth) GetValidDate() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.ValidDate
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetGroupStartDate() string {
+func (x *TenantHealth) GetStudents() int32 {
 	if x != nil {
-		return x.GroupStartDate
+		return x.Students
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetGroupEndDate() string {
+func (x *TenantHealth) GetStudentLimit() int32 {
 	if x != nil {
-		return x.GroupEndDate
+		return x.StudentLimit
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetStudentCondition() string {
+func (x *TenantHealth) GetGroups() int32 {
 	if x != nil {
-		return x.StudentCondition
+		return x.Groups
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetStudentActivatedAt() string {
+func (x *TenantHealth) GetStaff() int32 {
 	if x != nil {
-		return x.StudentActivatedAt
+		return x.Staff
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetStudentAddedAt() string {
+func (x *TenantHealth) GetLeads() int32 {
 	if x != nil {
-		return x.StudentAddedAt
+		return x.Leads
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetTeacherName() string {
+func (x *TenantHealth) GetRecentLeads() int32 {
 	if x != nil {
-		return x.TeacherName
+		return x.RecentLeads
+	}
+	return 0
+}
+
+func (x *TenantHealth) GetPayments() int32 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *TenantHealth) GetPaymentsSum() string {
+	if x != nil {
+		return x.PaymentsSum
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetPriceForStudent() float64 {
+func (x *TenantHealth) GetScore() int32 {
 	if x != nil {
-		return x.PriceForStudent
+		return x.Score
 	}
 	return 0
 }
 
-type GetNotesByStudent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notes         []*AbsNote             `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes"`
+func (x *TenantHealth) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *TenantHealth) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+type TenantHealthList struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Count int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items []*TenantHealth        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// a service could not be asked, its part of the score is left out
+	Incomplete    bool `protobuf:"varint,3,opt,name=incomplete,proto3" json:"incomplete,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNotesByStudent) Reset() {
-	*x = GetNotesByStudent{}
-	mi := &file_education_proto_msgTypes[74]
+func (x *TenantHealthList) Reset() {
+	*x = TenantHealthList{}
+	mi := &file_education_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotesByStudent) String() string {
+func (x *TenantHealthList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotesByStudent) ProtoMessage() {}
+func (*TenantHealthList) ProtoMessage() {}
 
-func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[74]
+func (x *TenantHealthList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5918,42 +9149,57 @@ func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotesByStudent.ProtoReflect.Descriptor instead.
-func (*GetNotesByStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use TenantHealthList.ProtoReflect.Descriptor instead.
+func (*TenantHealthList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetNotesByStudent) GetNotes() []*AbsNote {
+func (x *TenantHealthList) GetCount() int32 {
 	if x != nil {
-		return x.Notes
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TenantHealthList) GetItems() []*TenantHealth {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-type AbsNote struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
-	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt"`
+func (x *TenantHealthList) GetIncomplete() bool {
+	if x != nil {
+		return x.Incomplete
+	}
+	return false
+}
+
+type HistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// for GetTenantHistory
+	CompanyId     int32 `protobuf:"varint,3,opt,name=companyId,proto3" json:"companyId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsNote) Reset() {
-	*x = AbsNote{}
-	mi := &file_education_proto_msgTypes[75]
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	mi := &file_education_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsNote) String() string {
+func (x *HistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsNote) ProtoMessage() {}
+func (*HistoryRequest) ProtoMessage() {}
 
-func (x *AbsNote) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[75]
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5964,55 +9210,63 @@ func (x *AbsNote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsNote.ProtoReflect.Descriptor instead.
-func (*AbsNote) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *AbsNote) GetId() string {
+func (x *HistoryRequest) GetFrom() string {
 	if x != nil {
-		return x.Id
+		return x.From
 	}
 	return ""
 }
 
-func (x *AbsNote) GetComment() string {
+func (x *HistoryRequest) GetTo() string {
 	if x != nil {
-		return x.Comment
+		return x.To
 	}
 	return ""
 }
 
-func (x *AbsNote) GetCreatedAt() string {
+func (x *HistoryRequest) GetCompanyId() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.CompanyId
 	}
-	return ""
+	return 0
 }
 
-type CreateNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PlatformSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Day             string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Mrr             string                 `protobuf:"bytes,2,opt,name=mrr,proto3" json:"mrr,omitempty"`
+	Arr             string                 `protobuf:"bytes,3,opt,name=arr,proto3" json:"arr,omitempty"`
+	PayingCompanies int32                  `protobuf:"varint,4,opt,name=payingCompanies,proto3" json:"payingCompanies,omitempty"`
+	DemoCompanies   int32                  `protobuf:"varint,5,opt,name=demoCompanies,proto3" json:"demoCompanies,omitempty"`
+	GraceCompanies  int32                  `protobuf:"varint,6,opt,name=graceCompanies,proto3" json:"graceCompanies,omitempty"`
+	LockedCompanies int32                  `protobuf:"varint,7,opt,name=lockedCompanies,proto3" json:"lockedCompanies,omitempty"`
+	TotalCompanies  int32                  `protobuf:"varint,8,opt,name=totalCompanies,proto3" json:"totalCompanies,omitempty"`
+	ActiveStudents  int32                  `protobuf:"varint,9,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	AverageHealth   float64                `protobuf:"fixed64,10,opt,name=averageHealth,proto3" json:"averageHealth,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateNoteRequest) Reset() {
-	*x = CreateNoteRequest{}
-	mi := &file_education_proto_msgTypes[76]
+func (x *PlatformSnapshot) Reset() {
+	*x = PlatformSnapshot{}
+	mi := &file_education_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNoteRequest) String() string {
+func (x *PlatformSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNoteRequest) ProtoMessage() {}
+func (*PlatformSnapshot) ProtoMessage() {}
 
-func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[76]
+func (x *PlatformSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6023,30 +9277,227 @@ func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
-func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use PlatformSnapshot.ProtoReflect.Descriptor instead.
+func (*PlatformSnapshot) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *CreateNoteRequest) GetNote() string {
+func (x *PlatformSnapshot) GetDay() string {
 	if x != nil {
-		return x.Note
+		return x.Day
 	}
 	return ""
 }
 
-func (x *CreateNoteRequest) GetStudentId() string {
+func (x *PlatformSnapshot) GetMrr() string {
 	if x != nil {
-		return x.StudentId
+		return x.Mrr
+	}
+	return ""
+}
+
+func (x *PlatformSnapshot) GetArr() string {
+	if x != nil {
+		return x.Arr
 	}
 	return ""
 }
 
+func (x *PlatformSnapshot) GetPayingCompanies() int32 {
+	if x != nil {
+		return x.PayingCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetDemoCompanies() int32 {
+	if x != nil {
+		return x.DemoCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetGraceCompanies() int32 {
+	if x != nil {
+		return x.GraceCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetLockedCompanies() int32 {
+	if x != nil {
+		return x.LockedCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetTotalCompanies() int32 {
+	if x != nil {
+		return x.TotalCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetActiveStudents() int32 {
+	if x != nil {
+		return x.ActiveStudents
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetAverageHealth() float64 {
+	if x != nil {
+		return x.AverageHealth
+	}
+	return 0
+}
+
+type PlatformHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PlatformSnapshot    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformHistory) Reset() {
+	*x = PlatformHistory{}
+	mi := &file_education_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformHistory) ProtoMessage() {}
+
+func (x *PlatformHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformHistory.ProtoReflect.Descriptor instead.
+func (*PlatformHistory) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PlatformHistory) GetItems() []*PlatformSnapshot {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_education_proto protoreflect.FileDescriptor
 
 const file_education_proto_rawDesc = "" +
 	"\n" +
-	"\x0feducation.proto\x12\teducation\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd5\x01\n" +
+	"\x0feducation.proto\x12\teducation\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\"~\n" +
+	"\fQuoteRequest\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x1a\n" +
+	"\btariffId\x18\x02 \x01(\x05R\btariffId\x12\x16\n" +
+	"\x06months\x18\x03 \x01(\x05R\x06months\x12\x1c\n" +
+	"\tpromoCode\x18\x04 \x01(\tR\tpromoCode\"\xa1\x03\n" +
+	"\vTariffQuote\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x1a\n" +
+	"\btariffId\x18\x02 \x01(\x05R\btariffId\x12\x1e\n" +
+	"\n" +
+	"tariffName\x18\x03 \x01(\tR\n" +
+	"tariffName\x12\x16\n" +
+	"\x06months\x18\x04 \x01(\x05R\x06months\x12\"\n" +
+	"\fmonthlyPrice\x18\x05 \x01(\tR\fmonthlyPrice\x12\x18\n" +
+	"\abaseSum\x18\x06 \x01(\tR\abaseSum\x12$\n" +
+	"\rprepayPercent\x18\a \x01(\x01R\rprepayPercent\x12(\n" +
+	"\x0foverridePercent\x18\b \x01(\x01R\x0foverridePercent\x12\x1c\n" +
+	"\tpromoCode\x18\t \x01(\tR\tpromoCode\x12 \n" +
+	"\vdiscountSum\x18\n" +
+	" \x01(\tR\vdiscountSum\x12\x14\n" +
+	"\x05total\x18\v \x01(\tR\x05total\x12\x1e\n" +
+	"\n" +
+	"periodFrom\x18\f \x01(\tR\n" +
+	"periodFrom\x12\x1c\n" +
+	"\tvalidDate\x18\r \x01(\tR\tvalidDate\"\xaf\x01\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12,\n" +
+	"\x05quote\x18\x02 \x01(\v2\x16.education.TariffQuoteR\x05quote\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\apaidSum\x18\x04 \x01(\tR\apaidSum\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06paidAt\x18\x06 \x01(\tR\x06paidAt\"r\n" +
+	"\x12GetInvoicesRequest\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"M\n" +
+	"\vInvoiceList\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.education.InvoiceR\x05items\"\xf9\x01\n" +
+	"\tPromoCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\apercent\x18\x02 \x01(\x01R\apercent\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1a\n" +
+	"\btariffId\x18\x04 \x01(\x05R\btariffId\x12\x1c\n" +
+	"\tvalidFrom\x18\x05 \x01(\tR\tvalidFrom\x12\x18\n" +
+	"\avalidTo\x18\x06 \x01(\tR\avalidTo\x12\x18\n" +
+	"\amaxUses\x18\a \x01(\x05R\amaxUses\x12\x1c\n" +
+	"\tusedCount\x18\b \x01(\x05R\tusedCount\x12\x1a\n" +
+	"\bisActive\x18\t \x01(\bR\bisActive\";\n" +
+	"\rPromoCodeList\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.education.PromoCodeR\x05items\"\x9f\x01\n" +
+	"\rPriceOverride\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\"\n" +
+	"\fmonthlyPrice\x18\x02 \x01(\tR\fmonthlyPrice\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\x12\x18\n" +
+	"\avalidTo\x18\x04 \x01(\tR\avalidTo\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"7\n" +
+	"\x17GetPriceOverrideRequest\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\"5\n" +
+	"\x15CheckSubdomainRequest\x12\x1c\n" +
+	"\tsubdomain\x18\x01 \x01(\tR\tsubdomain\"l\n" +
+	"\x16CheckSubdomainResponse\x12\x1c\n" +
+	"\tsubdomain\x18\x01 \x01(\tR\tsubdomain\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xf1\x01\n" +
+	"\rSignupRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
+	"\tsubdomain\x18\x02 \x01(\tR\tsubdomain\x12\"\n" +
+	"\fcompanyPhone\x18\x03 \x01(\tR\fcompanyPhone\x12\x1c\n" +
+	"\tstartTime\x18\x04 \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x05 \x01(\tR\aendTime\x12\x18\n" +
+	"\aceoName\x18\x06 \x01(\tR\aceoName\x12\x1a\n" +
+	"\bceoPhone\x18\a \x01(\tR\bceoPhone\x12\x1a\n" +
+	"\bpassword\x18\b \x01(\tR\bpassword\"\x9c\x01\n" +
+	"\x0eSignupResponse\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x1c\n" +
+	"\tsubdomain\x18\x02 \x01(\tR\tsubdomain\x12\x14\n" +
+	"\x05ceoId\x18\x03 \x01(\tR\x05ceoId\x12\x1c\n" +
+	"\tvalidDate\x18\x04 \x01(\tR\tvalidDate\x12\x1a\n" +
+	"\btariffId\x18\x05 \x01(\x05R\btariffId\"\x18\n" +
+	"\x16GetSubscriptionRequest\"\xc3\x02\n" +
+	"\x13CompanySubscription\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1c\n" +
+	"\tvalidDate\x18\x03 \x01(\tR\tvalidDate\x12\x1e\n" +
+	"\n" +
+	"graceUntil\x18\x04 \x01(\tR\n" +
+	"graceUntil\x12\x1a\n" +
+	"\bdaysLeft\x18\x05 \x01(\x05R\bdaysLeft\x12\x16\n" +
+	"\x06isDemo\x18\x06 \x01(\bR\x06isDemo\x12\x1a\n" +
+	"\btariffId\x18\a \x01(\x05R\btariffId\x12\x1e\n" +
+	"\n" +
+	"tariffName\x18\b \x01(\tR\n" +
+	"tariffName\x12\"\n" +
+	"\fstudentLimit\x18\t \x01(\x05R\fstudentLimit\x12&\n" +
+	"\x0eactiveStudents\x18\n" +
+	" \x01(\x05R\x0eactiveStudents\"\xd5\x01\n" +
 	"\x14GetStatisticResponse\x129\n" +
 	"\adetails\x18\x01 \x01(\v2\x1f.education.CompanyCommonDetailsR\adetails\x12A\n" +
 	"\x0fregisterDetails\x18\x02 \x03(\v2\x17.education.OtherDetailsR\x0fregisterDetails\x12?\n" +
@@ -6119,21 +9570,25 @@ const file_education_proto_rawDesc = "" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x17\n" +
 	"\ais_demo\x18\f \x01(\bR\x06isDemo\x120\n" +
 	"\x14active_student_count\x18\r \x01(\x05R\x12activeStudentCount\x12\x15\n" +
-	"\x06ceo_id\x18\x0e \x01(\tR\x05ceoId\"\xbf\x01\n" +
+	"\x06ceo_id\x18\x0e \x01(\tR\x05ceoId\"\x85\x02\n" +
 	"\x06Tariff\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
 	"\rstudent_count\x18\x03 \x01(\x05R\fstudentCount\x12\x10\n" +
-	"\x03sum\x18\x04 \x01(\x02R\x03sum\x12\x1c\n" +
+	"\x03sum\x18\x04 \x01(\tR\x03sum\x12\x1c\n" +
 	"\tdiscounts\x18\x05 \x01(\tR\tdiscounts\x12\x1d\n" +
 	"\n" +
 	"is_deleted\x18\x06 \x01(\bR\tisDeleted\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\"K\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12D\n" +
+	"\x10prepay_discounts\x18\b \x03(\v2\x19.education.PrepayDiscountR\x0fprepayDiscounts\"B\n" +
+	"\x0ePrepayDiscount\x12\x16\n" +
+	"\x06months\x18\x01 \x01(\x05R\x06months\x12\x18\n" +
+	"\apercent\x18\x02 \x01(\x01R\apercent\"K\n" +
 	"\n" +
 	"TariffList\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\x05R\x05count\x12'\n" +
-	"\x05items\x18\x02 \x03(\v2\x11.education.TariffR\x05items\"\xb8\x02\n" +
+	"\x05items\x18\x02 \x03(\v2\x11.education.TariffR\x05items\"\xd7\x02\n" +
 	"\x0eCompanyFinance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -6141,22 +9596,24 @@ const file_education_proto_rawDesc = "" +
 	"\ttariff_id\x18\x03 \x01(\x05R\btariffId\x12\x1d\n" +
 	"\n" +
 	"tariff_sum\x18\n" +
-	" \x01(\x02R\ttariffSum\x12\x18\n" +
+	" \x01(\tR\ttariffSum\x12\x18\n" +
 	"\acomment\x18\x04 \x01(\tR\acomment\x12\x10\n" +
-	"\x03sum\x18\x05 \x01(\x02R\x03sum\x12*\n" +
+	"\x03sum\x18\x05 \x01(\tR\x03sum\x12*\n" +
 	"\x11edited_valid_date\x18\x06 \x01(\tR\x0feditedValidDate\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1f\n" +
 	"\vdiscount_id\x18\b \x01(\tR\n" +
 	"discountId\x12#\n" +
-	"\rdiscount_name\x18\t \x01(\tR\fdiscountName\"\xba\x02\n" +
+	"\rdiscount_name\x18\t \x01(\tR\fdiscountName\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\v \x01(\x05R\tinvoiceId\"\xba\x02\n" +
 	"\x12CompanyFinanceSelf\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
 	"\ttariff_id\x18\x02 \x01(\x05R\btariffId\x12\x1d\n" +
 	"\n" +
-	"tariff_sum\x18\x03 \x01(\x05R\ttariffSum\x12\x18\n" +
+	"tariff_sum\x18\x03 \x01(\tR\ttariffSum\x12\x18\n" +
 	"\acomment\x18\x04 \x01(\tR\acomment\x12\x10\n" +
-	"\x03sum\x18\x05 \x01(\x02R\x03sum\x12&\n" +
+	"\x03sum\x18\x05 \x01(\tR\x03sum\x12&\n" +
 	"\x0fedit_valid_date\x18\x06 \x01(\tR\reditValidDate\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1f\n" +
@@ -6168,11 +9625,11 @@ const file_education_proto_rawDesc = "" +
 	"tariffName\"\xf8\x01\n" +
 	"\x16CompanyFinanceSelfList\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\x05R\x05count\x12*\n" +
-	"\x11sum_amount_period\x18\x02 \x01(\x02R\x0fsumAmountPeriod\x12\x1f\n" +
+	"\x11sum_amount_period\x18\x02 \x01(\tR\x0fsumAmountPeriod\x12\x1f\n" +
 	"\vtariff_name\x18\x03 \x01(\tR\n" +
 	"tariffName\x12#\n" +
 	"\rdiscount_name\x18\x04 \x01(\tR\fdiscountName\x12!\n" +
-	"\frequired_sum\x18\x05 \x01(\x02R\vrequiredSum\x123\n" +
+	"\frequired_sum\x18\x05 \x01(\tR\vrequiredSum\x123\n" +
 	"\x05items\x18\x06 \x03(\v2\x1d.education.CompanyFinanceSelfR\x05items\"b\n" +
 	"\x12CompanyFinanceList\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\x05R\x05count\x126\n" +
@@ -6189,13 +9646,13 @@ const file_education_proto_rawDesc = "" +
 	"\ttariff_id\x18\x06 \x01(\tR\btariffId\x12\x1f\n" +
 	"\vtariff_name\x18\a \x01(\tR\n" +
 	"tariffName\x12\x10\n" +
-	"\x03sum\x18\b \x01(\x02R\x03sum\x12\x1f\n" +
+	"\x03sum\x18\b \x01(\tR\x03sum\x12\x1f\n" +
 	"\vdiscount_id\x18\t \x01(\tR\n" +
 	"discountId\x12#\n" +
 	"\rdiscount_name\x18\n" +
 	" \x01(\tR\fdiscountName\x12\x1d\n" +
 	"\n" +
-	"tariff_sum\x18\v \x01(\x02R\ttariffSum\"C\n" +
+	"tariff_sum\x18\v \x01(\tR\ttariffSum\"C\n" +
 	"\x11CreateRoomRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\"<\n" +
@@ -6209,7 +9666,7 @@ const file_education_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
 	"\x0elessonDuration\x18\x02 \x01(\x05R\x0elessonDuration\x12&\n" +
 	"\x0ecourseDuration\x18\x03 \x01(\x05R\x0ecourseDuration\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12 \n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\"D\n" +
 	"\x12GetUpdateCourseAbs\x12.\n" +
 	"\acourses\x18\x01 \x03(\v2\x14.education.AbsCourseR\acourses\"\xb7\x01\n" +
@@ -6218,14 +9675,14 @@ const file_education_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
 	"\x0elessonDuration\x18\x02 \x01(\x05R\x0elessonDuration\x12&\n" +
 	"\x0ecourseDuration\x18\x03 \x01(\x05R\x0ecourseDuration\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12 \n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xe7\x01\n" +
 	"\x15GetCourseByIdResponse\x12\x0e\n" +
 	"\x02id\x18\a \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
 	"\x0elessonDuration\x18\x02 \x01(\x05R\x0elessonDuration\x12&\n" +
 	"\x0ecourseDuration\x18\x03 \x01(\x05R\x0ecourseDuration\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12 \n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\"\n" +
 	"\fstudentCount\x18\x06 \x01(\x05R\fstudentCount\"&\n" +
 	"\x14GetCourseByIdRequest\x12\x0e\n" +
@@ -6276,7 +9733,7 @@ const file_education_proto_rawDesc = "" +
 	"groupEndAt\x18\a \x01(\tR\n" +
 	"groupEndAt\x12.\n" +
 	"\x12activeStudentCount\x18\b \x01(\x05R\x12activeStudentCount\x121\n" +
-	"\bstudents\x18\t \x03(\v2\x15.education.AbsStudentR\bstudents\"\x98\x02\n" +
+	"\bstudents\x18\t \x03(\v2\x15.education.AbsStudentR\bstudents\"\xb8\x02\n" +
 	"\x12CreateGroupRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcourseId\x18\x02 \x01(\x05R\bcourseId\x12\x1c\n" +
@@ -6286,7 +9743,11 @@ const file_education_proto_rawDesc = "" +
 	"\x06roomId\x18\x06 \x01(\x05R\x06roomId\x12(\n" +
 	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
 	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
-	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"a\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\x12\x1e\n" +
+	"\n" +
+	"requestKey\x18\n" +
+	" \x01(\tR\n" +
+	"requestKey\"a\n" +
 	"\x13GetGroupByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
 	"\n" +
@@ -6366,17 +9827,17 @@ const file_education_proto_rawDesc = "" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12<\n" +
 	"\x19commonLessonCountInPeriod\x18\x03 \x01(\x05R\x19commonLessonCountInPeriod\x124\n" +
-	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\"\x99\x02\n" +
+	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\"\xa9\x02\n" +
 	"\rStudentSalary\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
 	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12,\n" +
-	"\x11passedLessonCount\x18\x03 \x01(\x05R\x11passedLessonCount\x12:\n" +
-	"\x18calculatedSalaryInPeriod\x18\x04 \x01(\x05R\x18calculatedSalaryInPeriod\x12\x1c\n" +
+	"\x11passedLessonCount\x18\x03 \x01(\x05R\x11passedLessonCount\x12\x1c\n" +
 	"\tpriceType\x18\x05 \x01(\tR\tpriceType\x12\x1e\n" +
 	"\n" +
-	"totalCount\x18\x06 \x01(\x01R\n" +
+	"totalCount\x18\x06 \x01(\tR\n" +
 	"totalCount\x12 \n" +
-	"\vcoursePrice\x18\a \x01(\x01R\vcoursePrice\"\xb8\x01\n" +
+	"\vcoursePrice\x18\a \x01(\tR\vcoursePrice\x12*\n" +
+	"\x10calculatedSalary\x18\b \x01(\tR\x10calculatedSalaryJ\x04\b\x04\x10\x05R\x18calculatedSalaryInPeriod\"\xb8\x01\n" +
 	"\x14GetAttendanceRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
 	"\x04from\x18\x02 \x01(\tR\x04from\x12\x12\n" +
@@ -6399,7 +9860,7 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"attendance\x18\x03 \x03(\v2\x15.education.AttendanceR\n" +
 	"attendance\x12\x18\n" +
-	"\abalance\x18\x04 \x01(\x01R\abalance\x12\x1c\n" +
+	"\abalance\x18\x04 \x01(\tR\abalance\x12\x1c\n" +
 	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\x12 \n" +
 	"\vdateOfBirth\x18\x06 \x01(\tR\vdateOfBirth\x12;\n" +
 	"\ffreezeDetail\x18\a \x01(\v2\x17.education.FreezeDetailR\ffreezeDetail\x12\x16\n" +
@@ -6430,7 +9891,16 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"actionById\x18\x06 \x01(\tR\n" +
 	"actionById\x12\"\n" +
-	"\factionByRole\x18\a \x01(\tR\factionByRole\"\x90\x02\n" +
+	"\factionByRole\x18\a \x01(\tR\factionByRole\">\n" +
+	"\x1aFindStudentsByPhoneRequest\x12 \n" +
+	"\vphoneNumber\x18\x01 \x01(\tR\vphoneNumber\"\xae\x01\n" +
+	"\x14MergeStudentsRequest\x12(\n" +
+	"\x0fsourceStudentId\x18\x01 \x01(\tR\x0fsourceStudentId\x12(\n" +
+	"\x0ftargetStudentId\x18\x02 \x01(\tR\x0ftargetStudentId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x04 \x01(\tR\factionByName\"\x90\x02\n" +
 	"\x1fChangeUserBalanceHistoryRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
@@ -6535,7 +10005,7 @@ const file_education_proto_rawDesc = "" +
 	"passportId\x12,\n" +
 	"\x11additionalContact\x18\b \x01(\tR\x11additionalContact\x12\x18\n" +
 	"\abalance\x18\n" +
-	" \x01(\x01R\abalance\x12\x1c\n" +
+	" \x01(\tR\abalance\x12\x1c\n" +
 	"\tcondition\x18\v \x01(\tR\tcondition\x12*\n" +
 	"\x10telegramUsername\x18\f \x01(\tR\x10telegramUsername\x12\x1c\n" +
 	"\tcreatedAt\x18\r \x01(\tR\tcreatedAt\x128\n" +
@@ -6585,19 +10055,20 @@ const file_education_proto_rawDesc = "" +
 	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1f\n" +
 	"\vstudent_ids\x18\x03 \x03(\tR\n" +
 	"studentIds\x12\x1c\n" +
-	"\tcreatedBy\x18\x04 \x01(\tR\tcreatedBy\"\xc4\x02\n" +
+	"\tcreatedBy\x18\x04 \x01(\tR\tcreatedBy\"\x83\x03\n" +
 	"\x16GetStudentByIdResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
 	"\x06gender\x18\x03 \x01(\bR\x06gender\x12 \n" +
 	"\vdateOfBirth\x18\x04 \x01(\tR\vdateOfBirth\x12\x14\n" +
 	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x18\n" +
-	"\abalance\x18\x06 \x01(\x01R\abalance\x12\x1c\n" +
+	"\abalance\x18\x06 \x01(\tR\abalance\x12\x1c\n" +
 	"\tcreatedAt\x18\a \x01(\tR\tcreatedAt\x122\n" +
 	"\x06groups\x18\b \x03(\v2\x1a.education.GetGroupStudentR\x06groups\x12\x1c\n" +
 	"\tcondition\x18\t \x01(\tR\tcondition\x12,\n" +
 	"\x11additionalContact\x18\n" +
-	" \x01(\tR\x11additionalContact\")\n" +
+	" \x01(\tR\x11additionalContact\x12=\n" +
+	"\fpaymentPlans\x18\v \x03(\v2\x19.common.PaymentPlanStatusR\fpaymentPlans\")\n" +
 	"\x17NoteStudentByAbsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x81\x04\n" +
 	"\x0fGetGroupStudent\x12\x0e\n" +
@@ -6614,7 +10085,7 @@ const file_education_proto_rawDesc = "" +
 	"\x12studentActivatedAt\x18\f \x01(\tR\x12studentActivatedAt\x12&\n" +
 	"\x0estudentAddedAt\x18\x0e \x01(\tR\x0estudentAddedAt\x12 \n" +
 	"\vteacherName\x18\x0f \x01(\tR\vteacherName\x12(\n" +
-	"\x0fpriceForStudent\x18\r \x01(\x01R\x0fpriceForStudent\"=\n" +
+	"\x0fpriceForStudent\x18\r \x01(\tR\x0fpriceForStudent\"=\n" +
 	"\x11GetNotesByStudent\x12(\n" +
 	"\x05notes\x18\x01 \x03(\v2\x12.education.AbsNoteR\x05notes\"Q\n" +
 	"\aAbsNote\x12\x0e\n" +
@@ -6623,13 +10094,217 @@ const file_education_proto_rawDesc = "" +
 	"\tcreatedAt\x18\x03 \x01(\tR\tcreatedAt\"E\n" +
 	"\x11CreateNoteRequest\x12\x12\n" +
 	"\x04note\x18\x01 \x01(\tR\x04note\x12\x1c\n" +
-	"\tstudentId\x18\x02 \x01(\tR\tstudentId2\xff\x02\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"\xb3\x01\n" +
+	"\x14NotificationSettings\x12\x12\n" +
+	"\x04lang\x18\x01 \x01(\tR\x04lang\x12\x1e\n" +
+	"\n" +
+	"smsEnabled\x18\x02 \x01(\bR\n" +
+	"smsEnabled\x12(\n" +
+	"\x0ftelegramEnabled\x18\x03 \x01(\bR\x0ftelegramEnabled\x12=\n" +
+	"\ttemplates\x18\x04 \x03(\v2\x1f.education.NotificationTemplateR\ttemplates\"r\n" +
+	"\x14NotificationTemplate\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1c\n" +
+	"\tisDefault\x18\x04 \x01(\bR\tisDefault\"^\n" +
+	"\x1cGetNotificationOutboxRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"x\n" +
+	"\x1dGetNotificationOutboxResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.education.NotificationOutboxItemR\x05items\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x92\x02\n" +
+	"\x16NotificationOutboxItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x1c\n" +
+	"\tlastError\x18\b \x01(\tR\tlastError\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06sentAt\x18\n" +
+	" \x01(\tR\x06sentAt\"\xbe\x01\n" +
+	"\x14DebtReminderSettings\x12\x1a\n" +
+	"\bisActive\x18\x01 \x01(\bR\bisActive\x12\"\n" +
+	"\fbalanceBelow\x18\x02 \x01(\tR\fbalanceBelow\x12 \n" +
+	"\voverdueDays\x18\x03 \x01(\x05R\voverdueDays\x12 \n" +
+	"\vcadenceDays\x18\x04 \x01(\x05R\vcadenceDays\x12\"\n" +
+	"\fmaxReminders\x18\x05 \x01(\x05R\fmaxReminders\"Y\n" +
+	"\x17GetDebtRemindersRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"m\n" +
+	"\x18GetDebtRemindersResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.education.DebtReminderItemR\x05items\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xcc\x03\n" +
+	"\x10DebtReminderItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x03 \x01(\tR\vstudentName\x12 \n" +
+	"\vphoneNumber\x18\x04 \x01(\tR\vphoneNumber\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\tR\abalance\x12\x1c\n" +
+	"\tdebtSince\x18\x06 \x01(\tR\tdebtSince\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12$\n" +
+	"\rremindersSent\x18\b \x01(\x05R\rremindersSent\x12\x1e\n" +
+	"\n" +
+	"lastSentAt\x18\t \x01(\tR\n" +
+	"lastSentAt\x12\x1e\n" +
+	"\n" +
+	"nextSendAt\x18\n" +
+	" \x01(\tR\n" +
+	"nextSendAt\x12 \n" +
+	"\vreplyStatus\x18\v \x01(\tR\vreplyStatus\x12\"\n" +
+	"\freplyComment\x18\f \x01(\tR\freplyComment\x12\"\n" +
+	"\fpromisedDate\x18\r \x01(\tR\fpromisedDate\x12&\n" +
+	"\x0edeliveryStatus\x18\x0e \x01(\tR\x0edeliveryStatus\"\xa1\x01\n" +
+	"\x1bSetDebtReminderReplyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vreplyStatus\x18\x02 \x01(\tR\vreplyStatus\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\"\n" +
+	"\fpromisedDate\x18\x04 \x01(\tR\fpromisedDate\x12\x12\n" +
+	"\x04stop\x18\x05 \x01(\bR\x04stop\"\x87\x01\n" +
+	"\x1fRunBalanceReconciliationRequest\x12 \n" +
+	"\vautoCorrect\x18\x01 \x01(\bR\vautoCorrect\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x03 \x01(\tR\factionByName\"I\n" +
+	"\x1fGetReconciliationReportsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"y\n" +
+	" GetReconciliationReportsResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.education.ReconciliationReportR\x05items\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\"0\n" +
+	"\x1eGetReconciliationReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x02\n" +
+	"\x14ReconciliationReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tcreatedAt\x18\x02 \x01(\tR\tcreatedAt\x12\"\n" +
+	"\fcheckedCount\x18\x03 \x01(\x05R\fcheckedCount\x12\"\n" +
+	"\fskippedCount\x18\x04 \x01(\x05R\fskippedCount\x12\x1e\n" +
+	"\n" +
+	"driftCount\x18\x05 \x01(\x05R\n" +
+	"driftCount\x12\x1e\n" +
+	"\n" +
+	"totalDrift\x18\x06 \x01(\tR\n" +
+	"totalDrift\x12$\n" +
+	"\rautoCorrected\x18\a \x01(\bR\rautoCorrected\x12 \n" +
+	"\vtriggeredBy\x18\b \x01(\tR\vtriggeredBy\x12/\n" +
+	"\x06drifts\x18\t \x03(\v2\x17.education.BalanceDriftR\x06drifts\"\xd8\x01\n" +
+	"\fBalanceDrift\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12$\n" +
+	"\rledgerBalance\x18\x03 \x01(\tR\rledgerBalance\x12$\n" +
+	"\ractualBalance\x18\x04 \x01(\tR\ractualBalance\x12\x1e\n" +
+	"\n" +
+	"difference\x18\x05 \x01(\tR\n" +
+	"difference\x12\x1c\n" +
+	"\tcorrected\x18\x06 \x01(\bR\tcorrected\"<\n" +
+	"\x16PlatformMetricsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\x91\x03\n" +
+	"\x0fPlatformMetrics\x12\x10\n" +
+	"\x03mrr\x18\x01 \x01(\tR\x03mrr\x12\x10\n" +
+	"\x03arr\x18\x02 \x01(\tR\x03arr\x12(\n" +
+	"\x0fpayingCompanies\x18\x03 \x01(\x05R\x0fpayingCompanies\x12$\n" +
+	"\rdemoCompanies\x18\x04 \x01(\x05R\rdemoCompanies\x12&\n" +
+	"\x0egraceCompanies\x18\x05 \x01(\x05R\x0egraceCompanies\x12(\n" +
+	"\x0flockedCompanies\x18\x06 \x01(\x05R\x0flockedCompanies\x12&\n" +
+	"\x0etotalCompanies\x18\a \x01(\x05R\x0etotalCompanies\x12&\n" +
+	"\x0eactiveStudents\x18\b \x01(\x05R\x0eactiveStudents\x122\n" +
+	"\brenewals\x18\t \x03(\v2\x16.education.RenewalStatR\brenewals\x124\n" +
+	"\acohorts\x18\n" +
+	" \x03(\v2\x1a.education.CohortRetentionR\acohorts\"\xc3\x01\n" +
+	"\vRenewalStat\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x10\n" +
+	"\x03due\x18\x02 \x01(\x05R\x03due\x12\x18\n" +
+	"\arenewed\x18\x03 \x01(\x05R\arenewed\x12\x18\n" +
+	"\achurned\x18\x04 \x01(\x05R\achurned\x12\x18\n" +
+	"\apending\x18\x05 \x01(\x05R\apending\x12 \n" +
+	"\vrenewalRate\x18\x06 \x01(\x01R\vrenewalRate\x12\x1c\n" +
+	"\tchurnRate\x18\a \x01(\x01R\tchurnRate\"c\n" +
+	"\x0fCohortRetention\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x1c\n" +
+	"\tcompanies\x18\x02 \x01(\x05R\tcompanies\x12\x1c\n" +
+	"\tretention\x18\x03 \x03(\x01R\tretention\"S\n" +
+	"\x13TenantHealthRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"\xee\x03\n" +
+	"\fTenantHealth\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\x05R\tcompanyId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1c\n" +
+	"\tsubdomain\x18\x03 \x01(\tR\tsubdomain\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x16\n" +
+	"\x06isDemo\x18\x05 \x01(\bR\x06isDemo\x12\x1e\n" +
+	"\n" +
+	"tariffName\x18\x06 \x01(\tR\n" +
+	"tariffName\x12\x1c\n" +
+	"\tvalidDate\x18\a \x01(\tR\tvalidDate\x12\x1a\n" +
+	"\bstudents\x18\b \x01(\x05R\bstudents\x12\"\n" +
+	"\fstudentLimit\x18\t \x01(\x05R\fstudentLimit\x12\x16\n" +
+	"\x06groups\x18\n" +
+	" \x01(\x05R\x06groups\x12\x14\n" +
+	"\x05staff\x18\v \x01(\x05R\x05staff\x12\x14\n" +
+	"\x05leads\x18\f \x01(\x05R\x05leads\x12 \n" +
+	"\vrecentLeads\x18\r \x01(\x05R\vrecentLeads\x12\x1a\n" +
+	"\bpayments\x18\x0e \x01(\x05R\bpayments\x12 \n" +
+	"\vpaymentsSum\x18\x0f \x01(\tR\vpaymentsSum\x12\x14\n" +
+	"\x05score\x18\x10 \x01(\x05R\x05score\x12\x14\n" +
+	"\x05level\x18\x11 \x01(\tR\x05level\x12\x10\n" +
+	"\x03day\x18\x12 \x01(\tR\x03day\"w\n" +
+	"\x10TenantHealthList\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.education.TenantHealthR\x05items\x12\x1e\n" +
+	"\n" +
+	"incomplete\x18\x03 \x01(\bR\n" +
+	"incomplete\"R\n" +
+	"\x0eHistoryRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1c\n" +
+	"\tcompanyId\x18\x03 \x01(\x05R\tcompanyId\"\xe0\x02\n" +
+	"\x10PlatformSnapshot\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x10\n" +
+	"\x03mrr\x18\x02 \x01(\tR\x03mrr\x12\x10\n" +
+	"\x03arr\x18\x03 \x01(\tR\x03arr\x12(\n" +
+	"\x0fpayingCompanies\x18\x04 \x01(\x05R\x0fpayingCompanies\x12$\n" +
+	"\rdemoCompanies\x18\x05 \x01(\x05R\rdemoCompanies\x12&\n" +
+	"\x0egraceCompanies\x18\x06 \x01(\x05R\x0egraceCompanies\x12(\n" +
+	"\x0flockedCompanies\x18\a \x01(\x05R\x0flockedCompanies\x12&\n" +
+	"\x0etotalCompanies\x18\b \x01(\x05R\x0etotalCompanies\x12&\n" +
+	"\x0eactiveStudents\x18\t \x01(\x05R\x0eactiveStudents\x12$\n" +
+	"\raverageHealth\x18\n" +
+	" \x01(\x01R\raverageHealth\"D\n" +
+	"\x0fPlatformHistory\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.education.PlatformSnapshotR\x05items2\xea\x04\n" +
+	"\x0eBillingService\x128\n" +
+	"\x05Quote\x12\x17.education.QuoteRequest\x1a\x16.education.TariffQuote\x12<\n" +
+	"\rCreateInvoice\x12\x17.education.QuoteRequest\x1a\x12.education.Invoice\x12D\n" +
+	"\vGetInvoices\x12\x1d.education.GetInvoicesRequest\x1a\x16.education.InvoiceList\x12<\n" +
+	"\vVoidInvoice\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12=\n" +
+	"\x0fCreatePromoCode\x12\x14.education.PromoCode\x1a\x14.education.PromoCode\x12A\n" +
+	"\rGetPromoCodes\x12\x16.google.protobuf.Empty\x1a\x18.education.PromoCodeList\x12@\n" +
+	"\x13DeactivatePromoCode\x12\x14.education.PromoCode\x1a\x13.common.AbsResponse\x12F\n" +
+	"\x10SetPriceOverride\x12\x18.education.PriceOverride\x1a\x18.education.PriceOverride\x12P\n" +
+	"\x10GetPriceOverride\x12\".education.GetPriceOverrideRequest\x1a\x18.education.PriceOverride2\xa5\x01\n" +
+	"\rSignupService\x12U\n" +
+	"\x0eCheckSubdomain\x12 .education.CheckSubdomainRequest\x1a!.education.CheckSubdomainResponse\x12=\n" +
+	"\x06Signup\x12\x18.education.SignupRequest\x1a\x19.education.SignupResponse2\xd5\x03\n" +
 	"\x0eCompanyService\x12T\n" +
 	"\x15GetCompanyBySubdomain\x12\x1c.education.GetCompanyRequest\x1a\x1d.education.GetCompanyResponse\x12E\n" +
 	"\rCreateCompany\x12\x1f.education.CreateCompanyRequest\x1a\x13.common.AbsResponse\x128\n" +
 	"\x06GetAll\x12\x13.common.PageRequest\x1a\x19.education.GetAllResponse\x12E\n" +
 	"\rUpdateCompany\x12\x1f.education.UpdateCompanyRequest\x1a\x13.common.AbsResponse\x12O\n" +
-	"\fGetStatistic\x12\x1e.education.GetStatisticRequest\x1a\x1f.education.GetStatisticResponse2\xd5\x01\n" +
+	"\fGetStatistic\x12\x1e.education.GetStatisticRequest\x1a\x1f.education.GetStatisticResponse\x12T\n" +
+	"\x0fGetSubscription\x12!.education.GetSubscriptionRequest\x1a\x1e.education.CompanySubscription2\xd5\x01\n" +
 	"\rTariffService\x12.\n" +
 	"\x06Create\x12\x11.education.Tariff\x1a\x11.education.Tariff\x12.\n" +
 	"\x06Update\x12\x11.education.Tariff\x1a\x11.education.Tariff\x12.\n" +
@@ -6669,8 +10344,7 @@ const file_education_proto_rawDesc = "" +
 	"\x11AttendanceService\x12R\n" +
 	"\rGetAttendance\x12\x1f.education.GetAttendanceRequest\x1a .education.GetAttendanceResponse\x12E\n" +
 	"\rSetAttendance\x12\x1f.education.SetAttendanceRequest\x1a\x13.common.AbsResponse\x12y\n" +
-	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2\xc4\n" +
-	"\n" +
+	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2\xeb\v\n" +
 	"\x0eStudentService\x12R\n" +
 	"\rGetAllStudent\x12\x1f.education.GetAllStudentRequest\x1a .education.GetAllStudentResponse\x12E\n" +
 	"\rCreateStudent\x12\x1f.education.CreateStudentRequest\x1a\x13.common.AbsResponse\x12E\n" +
@@ -6688,7 +10362,27 @@ const file_education_proto_rawDesc = "" +
 	"\x12TransferLessonDate\x12 .education.TransferLessonRequest\x1a\x13.common.AbsResponse\x12W\n" +
 	"\x16ChangeConditionStudent\x12(.education.ChangeConditionStudentRequest\x1a\x13.common.AbsResponse\x12g\n" +
 	"\x14GetStudentsByGroupId\x12&.education.GetStudentsByGroupIdRequest\x1a'.education.GetStudentsByGroupIdResponse\x12[\n" +
-	"\x18ChangeUserBalanceHistory\x12*.education.ChangeUserBalanceHistoryRequest\x1a\x13.common.AbsResponseB\x0fZ\rgrpc/proto/pbb\x06proto3"
+	"\x18ChangeUserBalanceHistory\x12*.education.ChangeUserBalanceHistoryRequest\x1a\x13.common.AbsResponse\x12^\n" +
+	"\x13FindStudentsByPhone\x12%.education.FindStudentsByPhoneRequest\x1a .education.SearchStudentResponse\x12E\n" +
+	"\rMergeStudents\x12\x1f.education.MergeStudentsRequest\x1a\x13.common.AbsResponse2\xa9\x02\n" +
+	"\x13NotificationService\x12R\n" +
+	"\x17GetNotificationSettings\x12\x16.google.protobuf.Empty\x1a\x1f.education.NotificationSettings\x12R\n" +
+	"\x1aUpdateNotificationSettings\x12\x1f.education.NotificationSettings\x1a\x13.common.AbsResponse\x12j\n" +
+	"\x15GetNotificationOutbox\x12'.education.GetNotificationOutboxRequest\x1a(.education.GetNotificationOutboxResponse2\xef\x02\n" +
+	"\x13DebtReminderService\x12R\n" +
+	"\x17GetDebtReminderSettings\x12\x16.google.protobuf.Empty\x1a\x1f.education.DebtReminderSettings\x12R\n" +
+	"\x1aUpdateDebtReminderSettings\x12\x1f.education.DebtReminderSettings\x1a\x13.common.AbsResponse\x12[\n" +
+	"\x10GetDebtReminders\x12\".education.GetDebtRemindersRequest\x1a#.education.GetDebtRemindersResponse\x12S\n" +
+	"\x14SetDebtReminderReply\x12&.education.SetDebtReminderReplyRequest\x1a\x13.common.AbsResponse2\xdc\x02\n" +
+	"\x15ReconciliationService\x12g\n" +
+	"\x18RunBalanceReconciliation\x12*.education.RunBalanceReconciliationRequest\x1a\x1f.education.ReconciliationReport\x12s\n" +
+	"\x18GetReconciliationReports\x12*.education.GetReconciliationReportsRequest\x1a+.education.GetReconciliationReportsResponse\x12e\n" +
+	"\x17GetReconciliationReport\x12).education.GetReconciliationReportRequest\x1a\x1f.education.ReconciliationReport2\xd0\x02\n" +
+	"\x10AnalyticsService\x12S\n" +
+	"\x12GetPlatformMetrics\x12!.education.PlatformMetricsRequest\x1a\x1a.education.PlatformMetrics\x12N\n" +
+	"\x0fGetTenantHealth\x12\x1e.education.TenantHealthRequest\x1a\x1b.education.TenantHealthList\x12K\n" +
+	"\x12GetPlatformHistory\x12\x19.education.HistoryRequest\x1a\x1a.education.PlatformHistory\x12J\n" +
+	"\x10GetTenantHistory\x12\x19.education.HistoryRequest\x1a\x1b.education.TenantHealthListB\x0fZ\rgrpc/proto/pbb\x06proto3"
 
 var (
 	file_education_proto_rawDescOnce sync.Once
@@ -6702,240 +10396,355 @@ func file_education_proto_rawDescGZIP() []byte {
 	return file_education_proto_rawDescData
 }
 
-var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
 var file_education_proto_goTypes = []any{
-	(*GetStatisticResponse)(nil),                  // 0: education.GetStatisticResponse
-	(*OtherDetails)(nil),                          // 1: education.OtherDetails
-	(*CompanyCommonDetails)(nil),                  // 2: education.CompanyCommonDetails
-	(*GetStatisticRequest)(nil),                   // 3: education.GetStatisticRequest
-	(*UpdateCompanyRequest)(nil),                  // 4: education.UpdateCompanyRequest
-	(*GetAllResponse)(nil),                        // 5: education.GetAllResponse
-	(*CreateCompanyRequest)(nil),                  // 6: education.CreateCompanyRequest
-	(*GetCompanyRequest)(nil),                     // 7: education.GetCompanyRequest
-	(*GetCompanyResponse)(nil),                    // 8: education.GetCompanyResponse
-	(*Tariff)(nil),                                // 9: education.Tariff
-	(*TariffList)(nil),                            // 10: education.TariffList
-	(*CompanyFinance)(nil),                        // 11: education.CompanyFinance
-	(*CompanyFinanceSelf)(nil),                    // 12: education.CompanyFinanceSelf
-	(*CompanyFinanceSelfList)(nil),                // 13: education.CompanyFinanceSelfList
-	(*CompanyFinanceList)(nil),                    // 14: education.CompanyFinanceList
-	(*CompanyFinanceForList)(nil),                 // 15: education.CompanyFinanceForList
-	(*CreateRoomRequest)(nil),                     // 16: education.CreateRoomRequest
-	(*GetUpdateRoomAbs)(nil),                      // 17: education.GetUpdateRoomAbs
-	(*AbsRoom)(nil),                               // 18: education.AbsRoom
-	(*CreateCourseRequest)(nil),                   // 19: education.CreateCourseRequest
-	(*GetUpdateCourseAbs)(nil),                    // 20: education.GetUpdateCourseAbs
-	(*AbsCourse)(nil),                             // 21: education.AbsCourse
-	(*GetCourseByIdResponse)(nil),                 // 22: education.GetCourseByIdResponse
-	(*GetCourseByIdRequest)(nil),                  // 23: education.GetCourseByIdRequest
-	(*GetLeftAfterTrialPeriodRequest)(nil),        // 24: education.GetLeftAfterTrialPeriodRequest
-	(*GetLeftAfterTrialPeriodResponse)(nil),       // 25: education.GetLeftAfterTrialPeriodResponse
-	(*AbsGetLeftAfter)(nil),                       // 26: education.AbsGetLeftAfter
-	(*GetCommonInformationEducationResponse)(nil), // 27: education.GetCommonInformationEducationResponse
-	(*GetGroupsByTeacherIdRequest)(nil),           // 28: education.GetGroupsByTeacherIdRequest
-	(*GetGroupsByTeacherResponse)(nil),            // 29: education.GetGroupsByTeacherResponse
-	(*GetGroupByTeacherAbs)(nil),                  // 30: education.GetGroupByTeacherAbs
-	(*CreateGroupRequest)(nil),                    // 31: education.CreateGroupRequest
-	(*GetGroupByIdRequest)(nil),                   // 32: education.GetGroupByIdRequest
-	(*GetUpdateGroupAbs)(nil),                     // 33: education.GetUpdateGroupAbs
-	(*GetGroupsByCourseResponse)(nil),             // 34: education.GetGroupsByCourseResponse
-	(*GetGroupByCourseAbsResponse)(nil),           // 35: education.GetGroupByCourseAbsResponse
-	(*GetGroupAbsResponse)(nil),                   // 36: education.GetGroupAbsResponse
-	(*GetGroupsResponse)(nil),                     // 37: education.GetGroupsResponse
-	(*GetGroupsRequest)(nil),                      // 38: education.GetGroupsRequest
-	(*CalculateTeacherSalaryRequest)(nil),         // 39: education.CalculateTeacherSalaryRequest
-	(*CalculateTeacherSalaryResponse)(nil),        // 40: education.CalculateTeacherSalaryResponse
-	(*AbsCalculateSalary)(nil),                    // 41: education.AbsCalculateSalary
-	(*StudentSalary)(nil),                         // 42: education.StudentSalary
-	(*GetAttendanceRequest)(nil),                  // 43: education.GetAttendanceRequest
-	(*GetAttendanceResponse)(nil),                 // 44: education.GetAttendanceResponse
-	(*Day)(nil),                                   // 45: education.Day
-	(*Student)(nil),                               // 46: education.Student
-	(*Attendance)(nil),                            // 47: education.Attendance
-	(*FreezeDetail)(nil),                          // 48: education.FreezeDetail
-	(*SetAttendanceRequest)(nil),                  // 49: education.SetAttendanceRequest
-	(*ChangeUserBalanceHistoryRequest)(nil),       // 50: education.ChangeUserBalanceHistoryRequest
-	(*DeleteStudentRequest)(nil),                  // 51: education.DeleteStudentRequest
-	(*GetStudentsByGroupIdResponse)(nil),          // 52: education.GetStudentsByGroupIdResponse
-	(*GetStudentsByGroupIdRequest)(nil),           // 53: education.GetStudentsByGroupIdRequest
-	(*ChangeConditionStudentRequest)(nil),         // 54: education.ChangeConditionStudentRequest
-	(*TransferLessonRequest)(nil),                 // 55: education.TransferLessonRequest
-	(*GetHistoryGroupResponse)(nil),               // 56: education.GetHistoryGroupResponse
-	(*GetHistoryStudentResponse)(nil),             // 57: education.GetHistoryStudentResponse
-	(*AbsStudentHistory)(nil),                     // 58: education.AbsStudentHistory
-	(*AbsGroup)(nil),                              // 59: education.AbsGroup
-	(*AbsHistory)(nil),                            // 60: education.AbsHistory
-	(*SearchStudentRequest)(nil),                  // 61: education.SearchStudentRequest
-	(*SearchStudentResponse)(nil),                 // 62: education.SearchStudentResponse
-	(*AbsStudent)(nil),                            // 63: education.AbsStudent
-	(*GetAllStudentRequest)(nil),                  // 64: education.GetAllStudentRequest
-	(*GetAllStudentResponse)(nil),                 // 65: education.GetAllStudentResponse
-	(*GetGroupsAbsForStudent)(nil),                // 66: education.GetGroupsAbsForStudent
-	(*GroupGetAllStudentAbs)(nil),                 // 67: education.GroupGetAllStudentAbs
-	(*CreateStudentRequest)(nil),                  // 68: education.CreateStudentRequest
-	(*UpdateStudentRequest)(nil),                  // 69: education.UpdateStudentRequest
-	(*AddToGroupRequest)(nil),                     // 70: education.AddToGroupRequest
-	(*GetStudentByIdResponse)(nil),                // 71: education.GetStudentByIdResponse
-	(*NoteStudentByAbsRequest)(nil),               // 72: education.NoteStudentByAbsRequest
-	(*GetGroupStudent)(nil),                       // 73: education.GetGroupStudent
-	(*GetNotesByStudent)(nil),                     // 74: education.GetNotesByStudent
-	(*AbsNote)(nil),                               // 75: education.AbsNote
-	(*CreateNoteRequest)(nil),                     // 76: education.CreateNoteRequest
-	nil,                                           // 77: education.OtherDetails.DetailsEntry
-	(*PageRequest)(nil),                           // 78: common.PageRequest
-	(*emptypb.Empty)(nil),                         // 79: google.protobuf.Empty
-	(*DeleteAbsRequest)(nil),                      // 80: common.DeleteAbsRequest
-	(*AbsResponse)(nil),                           // 81: common.AbsResponse
+	(*QuoteRequest)(nil),                          // 0: education.QuoteRequest
+	(*TariffQuote)(nil),                           // 1: education.TariffQuote
+	(*Invoice)(nil),                               // 2: education.Invoice
+	(*GetInvoicesRequest)(nil),                    // 3: education.GetInvoicesRequest
+	(*InvoiceList)(nil),                           // 4: education.InvoiceList
+	(*PromoCode)(nil),                             // 5: education.PromoCode
+	(*PromoCodeList)(nil),                         // 6: education.PromoCodeList
+	(*PriceOverride)(nil),                         // 7: education.PriceOverride
+	(*GetPriceOverrideRequest)(nil),               // 8: education.GetPriceOverrideRequest
+	(*CheckSubdomainRequest)(nil),                 // 9: education.CheckSubdomainRequest
+	(*CheckSubdomainResponse)(nil),                // 10: education.CheckSubdomainResponse
+	(*SignupRequest)(nil),                         // 11: education.SignupRequest
+	(*SignupResponse)(nil),                        // 12: education.SignupResponse
+	(*GetSubscriptionRequest)(nil),                // 13: education.GetSubscriptionRequest
+	(*CompanySubscription)(nil),                   // 14: education.CompanySubscription
+	(*GetStatisticResponse)(nil),                  // 15: education.GetStatisticResponse
+	(*OtherDetails)(nil),                          // 16: education.OtherDetails
+	(*CompanyCommonDetails)(nil),                  // 17: education.CompanyCommonDetails
+	(*GetStatisticRequest)(nil),                   // 18: education.GetStatisticRequest
+	(*UpdateCompanyRequest)(nil),                  // 19: education.UpdateCompanyRequest
+	(*GetAllResponse)(nil),                        // 20: education.GetAllResponse
+	(*CreateCompanyRequest)(nil),                  // 21: education.CreateCompanyRequest
+	(*GetCompanyRequest)(nil),                     // 22: education.GetCompanyRequest
+	(*GetCompanyResponse)(nil),                    // 23: education.GetCompanyResponse
+	(*Tariff)(nil),                                // 24: education.Tariff
+	(*PrepayDiscount)(nil),                        // 25: education.PrepayDiscount
+	(*TariffList)(nil),                            // 26: education.TariffList
+	(*CompanyFinance)(nil),                        // 27: education.CompanyFinance
+	(*CompanyFinanceSelf)(nil),                    // 28: education.CompanyFinanceSelf
+	(*CompanyFinanceSelfList)(nil),                // 29: education.CompanyFinanceSelfList
+	(*CompanyFinanceList)(nil),                    // 30: education.CompanyFinanceList
+	(*CompanyFinanceForList)(nil),                 // 31: education.CompanyFinanceForList
+	(*CreateRoomRequest)(nil),                     // 32: education.CreateRoomRequest
+	(*GetUpdateRoomAbs)(nil),                      // 33: education.GetUpdateRoomAbs
+	(*AbsRoom)(nil),                               // 34: education.AbsRoom
+	(*CreateCourseRequest)(nil),                   // 35: education.CreateCourseRequest
+	(*GetUpdateCourseAbs)(nil),                    // 36: education.GetUpdateCourseAbs
+	(*AbsCourse)(nil),                             // 37: education.AbsCourse
+	(*GetCourseByIdResponse)(nil),                 // 38: education.GetCourseByIdResponse
+	(*GetCourseByIdRequest)(nil),                  // 39: education.GetCourseByIdRequest
+	(*GetLeftAfterTrialPeriodRequest)(nil),        // 40: education.GetLeftAfterTrialPeriodRequest
+	(*GetLeftAfterTrialPeriodResponse)(nil),       // 41: education.GetLeftAfterTrialPeriodResponse
+	(*AbsGetLeftAfter)(nil),                       // 42: education.AbsGetLeftAfter
+	(*GetCommonInformationEducationResponse)(nil), // 43: education.GetCommonInformationEducationResponse
+	(*GetGroupsByTeacherIdRequest)(nil),           // 44: education.GetGroupsByTeacherIdRequest
+	(*GetGroupsByTeacherResponse)(nil),            // 45: education.GetGroupsByTeacherResponse
+	(*GetGroupByTeacherAbs)(nil),                  // 46: education.GetGroupByTeacherAbs
+	(*CreateGroupRequest)(nil),                    // 47: education.CreateGroupRequest
+	(*GetGroupByIdRequest)(nil),                   // 48: education.GetGroupByIdRequest
+	(*GetUpdateGroupAbs)(nil),                     // 49: education.GetUpdateGroupAbs
+	(*GetGroupsByCourseResponse)(nil),             // 50: education.GetGroupsByCourseResponse
+	(*GetGroupByCourseAbsResponse)(nil),           // 51: education.GetGroupByCourseAbsResponse
+	(*GetGroupAbsResponse)(nil),                   // 52: education.GetGroupAbsResponse
+	(*GetGroupsResponse)(nil),                     // 53: education.GetGroupsResponse
+	(*GetGroupsRequest)(nil),                      // 54: education.GetGroupsRequest
+	(*CalculateTeacherSalaryRequest)(nil),         // 55: education.CalculateTeacherSalaryRequest
+	(*CalculateTeacherSalaryResponse)(nil),        // 56: education.CalculateTeacherSalaryResponse
+	(*AbsCalculateSalary)(nil),                    // 57: education.AbsCalculateSalary
+	(*StudentSalary)(nil),                         // 58: education.StudentSalary
+	(*GetAttendanceRequest)(nil),                  // 59: education.GetAttendanceRequest
+	(*GetAttendanceResponse)(nil),                 // 60: education.GetAttendanceResponse
+	(*Day)(nil),                                   // 61: education.Day
+	(*Student)(nil),                               // 62: education.Student
+	(*Attendance)(nil),                            // 63: education.Attendance
+	(*FreezeDetail)(nil),                          // 64: education.FreezeDetail
+	(*SetAttendanceRequest)(nil),                  // 65: education.SetAttendanceRequest
+	(*FindStudentsByPhoneRequest)(nil),            // 66: education.FindStudentsByPhoneRequest
+	(*MergeStudentsRequest)(nil),                  // 67: education.MergeStudentsRequest
+	(*ChangeUserBalanceHistoryRequest)(nil),       // 68: education.ChangeUserBalanceHistoryRequest
+	(*DeleteStudentRequest)(nil),                  // 69: education.DeleteStudentRequest
+	(*GetStudentsByGroupIdResponse)(nil),          // 70: education.GetStudentsByGroupIdResponse
+	(*GetStudentsByGroupIdRequest)(nil),           // 71: education.GetStudentsByGroupIdRequest
+	(*ChangeConditionStudentRequest)(nil),         // 72: education.ChangeConditionStudentRequest
+	(*TransferLessonRequest)(nil),                 // 73: education.TransferLessonRequest
+	(*GetHistoryGroupResponse)(nil),               // 74: education.GetHistoryGroupResponse
+	(*GetHistoryStudentResponse)(nil),             // 75: education.GetHistoryStudentResponse
+	(*AbsStudentHistory)(nil),                     // 76: education.AbsStudentHistory
+	(*AbsGroup)(nil),                              // 77: education.AbsGroup
+	(*AbsHistory)(nil),                            // 78: education.AbsHistory
+	(*SearchStudentRequest)(nil),                  // 79: education.SearchStudentRequest
+	(*SearchStudentResponse)(nil),                 // 80: education.SearchStudentResponse
+	(*AbsStudent)(nil),                            // 81: education.AbsStudent
+	(*GetAllStudentRequest)(nil),                  // 82: education.GetAllStudentRequest
+	(*GetAllStudentResponse)(nil),                 // 83: education.GetAllStudentResponse
+	(*GetGroupsAbsForStudent)(nil),                // 84: education.GetGroupsAbsForStudent
+	(*GroupGetAllStudentAbs)(nil),                 // 85: education.GroupGetAllStudentAbs
+	(*CreateStudentRequest)(nil),                  // 86: education.CreateStudentRequest
+	(*UpdateStudentRequest)(nil),                  // 87: education.UpdateStudentRequest
+	(*AddToGroupRequest)(nil),                     // 88: education.AddToGroupRequest
+	(*GetStudentByIdResponse)(nil),                // 89: education.GetStudentByIdResponse
+	(*NoteStudentByAbsRequest)(nil),               // 90: education.NoteStudentByAbsRequest
+	(*GetGroupStudent)(nil),                       // 91: education.GetGroupStudent
+	(*GetNotesByStudent)(nil),                     // 92: education.GetNotesByStudent
+	(*AbsNote)(nil),                               // 93: education.AbsNote
+	(*CreateNoteRequest)(nil),                     // 94: education.CreateNoteRequest
+	(*NotificationSettings)(nil),                  // 95: education.NotificationSettings
+	(*NotificationTemplate)(nil),                  // 96: education.NotificationTemplate
+	(*GetNotificationOutboxRequest)(nil),          // 97: education.GetNotificationOutboxRequest
+	(*GetNotificationOutboxResponse)(nil),         // 98: education.GetNotificationOutboxResponse
+	(*NotificationOutboxItem)(nil),                // 99: education.NotificationOutboxItem
+	(*DebtReminderSettings)(nil),                  // 100: education.DebtReminderSettings
+	(*GetDebtRemindersRequest)(nil),               // 101: education.GetDebtRemindersRequest
+	(*GetDebtRemindersResponse)(nil),              // 102: education.GetDebtRemindersResponse
+	(*DebtReminderItem)(nil),                      // 103: education.DebtReminderItem
+	(*SetDebtReminderReplyRequest)(nil),           // 104: education.SetDebtReminderReplyRequest
+	(*RunBalanceReconciliationRequest)(nil),       // 105: education.RunBalanceReconciliationRequest
+	(*GetReconciliationReportsRequest)(nil),       // 106: education.GetReconciliationReportsRequest
+	(*GetReconciliationReportsResponse)(nil),      // 107: education.GetReconciliationReportsResponse
+	(*GetReconciliationReportRequest)(nil),        // 108: education.GetReconciliationReportRequest
+	(*ReconciliationReport)(nil),                  // 109: education.ReconciliationReport
+	(*BalanceDrift)(nil),                          // 110: education.BalanceDrift
+	(*PlatformMetricsRequest)(nil),                // 111: education.PlatformMetricsRequest
+	(*PlatformMetrics)(nil),                       // 112: education.PlatformMetrics
+	(*RenewalStat)(nil),                           // 113: education.RenewalStat
+	(*CohortRetention)(nil),                       // 114: education.CohortRetention
+	(*TenantHealthRequest)(nil),                   // 115: education.TenantHealthRequest
+	(*TenantHealth)(nil),                          // 116: education.TenantHealth
+	(*TenantHealthList)(nil),                      // 117: education.TenantHealthList
+	(*HistoryRequest)(nil),                        // 118: education.HistoryRequest
+	(*PlatformSnapshot)(nil),                      // 119: education.PlatformSnapshot
+	(*PlatformHistory)(nil),                       // 120: education.PlatformHistory
+	nil,                                           // 121: education.OtherDetails.DetailsEntry
+	(*PageRequest)(nil),                           // 122: common.PageRequest
+	(*PaymentPlanStatus)(nil),                     // 123: common.PaymentPlanStatus
+	(*DeleteAbsRequest)(nil),                      // 124: common.DeleteAbsRequest
+	(*emptypb.Empty)(nil),                         // 125: google.protobuf.Empty
+	(*AbsResponse)(nil),                           // 126: common.AbsResponse
 }
 var file_education_proto_depIdxs = []int32{
-	2,  // 0: education.GetStatisticResponse.details:type_name -> education.CompanyCommonDetails
-	1,  // 1: education.GetStatisticResponse.registerDetails:type_name -> education.OtherDetails
-	1,  // 2: education.GetStatisticResponse.paymentDetails:type_name -> education.OtherDetails
-	77, // 3: education.OtherDetails.details:type_name -> education.OtherDetails.DetailsEntry
-	8,  // 4: education.GetAllResponse.items:type_name -> education.GetCompanyResponse
-	9,  // 5: education.GetCompanyResponse.tariff:type_name -> education.Tariff
-	9,  // 6: education.TariffList.items:type_name -> education.Tariff
-	12, // 7: education.CompanyFinanceSelfList.items:type_name -> education.CompanyFinanceSelf
-	15, // 8: education.CompanyFinanceList.items:type_name -> education.CompanyFinanceForList
-	18, // 9: education.GetUpdateRoomAbs.rooms:type_name -> education.AbsRoom
-	21, // 10: education.GetUpdateCourseAbs.courses:type_name -> education.AbsCourse
-	26, // 11: education.GetLeftAfterTrialPeriodResponse.items:type_name -> education.AbsGetLeftAfter
-	30, // 12: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
-	63, // 13: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
-	35, // 14: education.GetGroupsByCourseResponse.groups:type_name -> education.GetGroupByCourseAbsResponse
-	21, // 15: education.GetGroupAbsResponse.course:type_name -> education.AbsCourse
-	18, // 16: education.GetGroupAbsResponse.room:type_name -> education.AbsRoom
-	36, // 17: education.GetGroupsResponse.groups:type_name -> education.GetGroupAbsResponse
-	78, // 18: education.GetGroupsRequest.page:type_name -> common.PageRequest
-	41, // 19: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
-	42, // 20: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
-	45, // 21: education.GetAttendanceResponse.days:type_name -> education.Day
-	46, // 22: education.GetAttendanceResponse.students:type_name -> education.Student
-	47, // 23: education.Student.attendance:type_name -> education.Attendance
-	48, // 24: education.Student.freezeDetail:type_name -> education.FreezeDetail
-	63, // 25: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
-	60, // 26: education.GetHistoryGroupResponse.groupHistory:type_name -> education.AbsHistory
-	58, // 27: education.GetHistoryGroupResponse.studentsHistory:type_name -> education.AbsStudentHistory
-	60, // 28: education.GetHistoryStudentResponse.studentHistory:type_name -> education.AbsHistory
-	58, // 29: education.GetHistoryStudentResponse.conditionsHistory:type_name -> education.AbsStudentHistory
-	63, // 30: education.AbsStudentHistory.student:type_name -> education.AbsStudent
-	59, // 31: education.AbsStudentHistory.group:type_name -> education.AbsGroup
-	21, // 32: education.AbsGroup.course:type_name -> education.AbsCourse
-	63, // 33: education.SearchStudentResponse.students:type_name -> education.AbsStudent
-	66, // 34: education.GetAllStudentResponse.response:type_name -> education.GetGroupsAbsForStudent
-	67, // 35: education.GetGroupsAbsForStudent.groups:type_name -> education.GroupGetAllStudentAbs
-	21, // 36: education.GroupGetAllStudentAbs.course:type_name -> education.AbsCourse
-	73, // 37: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
-	18, // 38: education.GetGroupStudent.room:type_name -> education.AbsRoom
-	21, // 39: education.GetGroupStudent.course:type_name -> education.AbsCourse
-	75, // 40: education.GetNotesByStudent.notes:type_name -> education.AbsNote
-	7,  // 41: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
-	6,  // 42: education.CompanyService.CreateCompany:input_type -> education.CreateCompanyRequest
-	78, // 43: education.CompanyService.GetAll:input_type -> common.PageRequest
-	4,  // 44: education.CompanyService.UpdateCompany:input_type -> education.UpdateCompanyRequest
-	3,  // 45: education.CompanyService.GetStatistic:input_type -> education.GetStatisticRequest
-	9,  // 46: education.TariffService.Create:input_type -> education.Tariff
-	9,  // 47: education.TariffService.Update:input_type -> education.Tariff
-	9,  // 48: education.TariffService.Delete:input_type -> education.Tariff
-	79, // 49: education.TariffService.Get:input_type -> google.protobuf.Empty
-	11, // 50: education.CompanyFinanceService.Create:input_type -> education.CompanyFinance
-	80, // 51: education.CompanyFinanceService.Delete:input_type -> common.DeleteAbsRequest
-	78, // 52: education.CompanyFinanceService.GetAll:input_type -> common.PageRequest
-	78, // 53: education.CompanyFinanceService.GetByCompany:input_type -> common.PageRequest
-	11, // 54: education.CompanyFinanceService.UpdateByCompany:input_type -> education.CompanyFinance
-	16, // 55: education.RoomService.CreateRoom:input_type -> education.CreateRoomRequest
-	79, // 56: education.RoomService.GetRooms:input_type -> google.protobuf.Empty
-	18, // 57: education.RoomService.UpdateRoom:input_type -> education.AbsRoom
-	80, // 58: education.RoomService.DeleteRoom:input_type -> common.DeleteAbsRequest
-	19, // 59: education.CourseService.CreateCourse:input_type -> education.CreateCourseRequest
-	79, // 60: education.CourseService.GetCourses:input_type -> google.protobuf.Empty
-	23, // 61: education.CourseService.GetCourseById:input_type -> education.GetCourseByIdRequest
-	21, // 62: education.CourseService.UpdateCourse:input_type -> education.AbsCourse
-	80, // 63: education.CourseService.DeleteCourse:input_type -> common.DeleteAbsRequest
-	31, // 64: education.GroupService.CreateGroup:input_type -> education.CreateGroupRequest
-	38, // 65: education.GroupService.GetGroups:input_type -> education.GetGroupsRequest
-	32, // 66: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
-	32, // 67: education.GroupService.GetGroupsByCourseId:input_type -> education.GetGroupByIdRequest
-	33, // 68: education.GroupService.UpdateGroup:input_type -> education.GetUpdateGroupAbs
-	80, // 69: education.GroupService.DeleteGroup:input_type -> common.DeleteAbsRequest
-	28, // 70: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
-	79, // 71: education.GroupService.GetCommonInformationEducation:input_type -> google.protobuf.Empty
-	24, // 72: education.GroupService.GetLeftAfterTrialPeriod:input_type -> education.GetLeftAfterTrialPeriodRequest
-	43, // 73: education.AttendanceService.GetAttendance:input_type -> education.GetAttendanceRequest
-	49, // 74: education.AttendanceService.SetAttendance:input_type -> education.SetAttendanceRequest
-	39, // 75: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
-	64, // 76: education.StudentService.GetAllStudent:input_type -> education.GetAllStudentRequest
-	68, // 77: education.StudentService.CreateStudent:input_type -> education.CreateStudentRequest
-	69, // 78: education.StudentService.UpdateStudent:input_type -> education.UpdateStudentRequest
-	51, // 79: education.StudentService.DeleteStudent:input_type -> education.DeleteStudentRequest
-	70, // 80: education.StudentService.AddToGroup:input_type -> education.AddToGroupRequest
-	72, // 81: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
-	72, // 82: education.StudentService.GetNoteByStudent:input_type -> education.NoteStudentByAbsRequest
-	76, // 83: education.StudentService.CreateNoteForStudent:input_type -> education.CreateNoteRequest
-	72, // 84: education.StudentService.DeleteStudentNote:input_type -> education.NoteStudentByAbsRequest
-	61, // 85: education.StudentService.SearchStudent:input_type -> education.SearchStudentRequest
-	72, // 86: education.StudentService.GetHistoryGroupById:input_type -> education.NoteStudentByAbsRequest
-	72, // 87: education.StudentService.GetHistoryStudentById:input_type -> education.NoteStudentByAbsRequest
-	55, // 88: education.StudentService.TransferLessonDate:input_type -> education.TransferLessonRequest
-	54, // 89: education.StudentService.ChangeConditionStudent:input_type -> education.ChangeConditionStudentRequest
-	53, // 90: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
-	50, // 91: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
-	8,  // 92: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
-	81, // 93: education.CompanyService.CreateCompany:output_type -> common.AbsResponse
-	5,  // 94: education.CompanyService.GetAll:output_type -> education.GetAllResponse
-	81, // 95: education.CompanyService.UpdateCompany:output_type -> common.AbsResponse
-	0,  // 96: education.CompanyService.GetStatistic:output_type -> education.GetStatisticResponse
-	9,  // 97: education.TariffService.Create:output_type -> education.Tariff
-	9,  // 98: education.TariffService.Update:output_type -> education.Tariff
-	9,  // 99: education.TariffService.Delete:output_type -> education.Tariff
-	10, // 100: education.TariffService.Get:output_type -> education.TariffList
-	11, // 101: education.CompanyFinanceService.Create:output_type -> education.CompanyFinance
-	81, // 102: education.CompanyFinanceService.Delete:output_type -> common.AbsResponse
-	14, // 103: education.CompanyFinanceService.GetAll:output_type -> education.CompanyFinanceList
-	13, // 104: education.CompanyFinanceService.GetByCompany:output_type -> education.CompanyFinanceSelfList
-	11, // 105: education.CompanyFinanceService.UpdateByCompany:output_type -> education.CompanyFinance
-	81, // 106: education.RoomService.CreateRoom:output_type -> common.AbsResponse
-	17, // 107: education.RoomService.GetRooms:output_type -> education.GetUpdateRoomAbs
-	81, // 108: education.RoomService.UpdateRoom:output_type -> common.AbsResponse
-	81, // 109: education.RoomService.DeleteRoom:output_type -> common.AbsResponse
-	81, // 110: education.CourseService.CreateCourse:output_type -> common.AbsResponse
-	20, // 111: education.CourseService.GetCourses:output_type -> education.GetUpdateCourseAbs
-	22, // 112: education.CourseService.GetCourseById:output_type -> education.GetCourseByIdResponse
-	81, // 113: education.CourseService.UpdateCourse:output_type -> common.AbsResponse
-	81, // 114: education.CourseService.DeleteCourse:output_type -> common.AbsResponse
-	81, // 115: education.GroupService.CreateGroup:output_type -> common.AbsResponse
-	37, // 116: education.GroupService.GetGroups:output_type -> education.GetGroupsResponse
-	36, // 117: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
-	34, // 118: education.GroupService.GetGroupsByCourseId:output_type -> education.GetGroupsByCourseResponse
-	81, // 119: education.GroupService.UpdateGroup:output_type -> common.AbsResponse
-	81, // 120: education.GroupService.DeleteGroup:output_type -> common.AbsResponse
-	29, // 121: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
-	27, // 122: education.GroupService.GetCommonInformationEducation:output_type -> education.GetCommonInformationEducationResponse
-	25, // 123: education.GroupService.GetLeftAfterTrialPeriod:output_type -> education.GetLeftAfterTrialPeriodResponse
-	44, // 124: education.AttendanceService.GetAttendance:output_type -> education.GetAttendanceResponse
-	81, // 125: education.AttendanceService.SetAttendance:output_type -> common.AbsResponse
-	40, // 126: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
-	65, // 127: education.StudentService.GetAllStudent:output_type -> education.GetAllStudentResponse
-	81, // 128: education.StudentService.CreateStudent:output_type -> common.AbsResponse
-	81, // 129: education.StudentService.UpdateStudent:output_type -> common.AbsResponse
-	81, // 130: education.StudentService.DeleteStudent:output_type -> common.AbsResponse
-	81, // 131: education.StudentService.AddToGroup:output_type -> common.AbsResponse
-	71, // 132: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
-	74, // 133: education.StudentService.GetNoteByStudent:output_type -> education.GetNotesByStudent
-	81, // 134: education.StudentService.CreateNoteForStudent:output_type -> common.AbsResponse
-	81, // 135: education.StudentService.DeleteStudentNote:output_type -> common.AbsResponse
-	62, // 136: education.StudentService.SearchStudent:output_type -> education.SearchStudentResponse
-	56, // 137: education.StudentService.GetHistoryGroupById:output_type -> education.GetHistoryGroupResponse
-	57, // 138: education.StudentService.GetHistoryStudentById:output_type -> education.GetHistoryStudentResponse
-	81, // 139: education.StudentService.TransferLessonDate:output_type -> common.AbsResponse
-	81, // 140: education.StudentService.ChangeConditionStudent:output_type -> common.AbsResponse
-	52, // 141: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
-	81, // 142: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
-	92, // [92:143] is the sub-list for method output_type
-	41, // [41:92] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	1,   // 0: education.Invoice.quote:type_name -> education.TariffQuote
+	2,   // 1: education.InvoiceList.items:type_name -> education.Invoice
+	5,   // 2: education.PromoCodeList.items:type_name -> education.PromoCode
+	17,  // 3: education.GetStatisticResponse.details:type_name -> education.CompanyCommonDetails
+	16,  // 4: education.GetStatisticResponse.registerDetails:type_name -> education.OtherDetails
+	16,  // 5: education.GetStatisticResponse.paymentDetails:type_name -> education.OtherDetails
+	121, // 6: education.OtherDetails.details:type_name -> education.OtherDetails.DetailsEntry
+	23,  // 7: education.GetAllResponse.items:type_name -> education.GetCompanyResponse
+	24,  // 8: education.GetCompanyResponse.tariff:type_name -> education.Tariff
+	25,  // 9: education.Tariff.prepay_discounts:type_name -> education.PrepayDiscount
+	24,  // 10: education.TariffList.items:type_name -> education.Tariff
+	28,  // 11: education.CompanyFinanceSelfList.items:type_name -> education.CompanyFinanceSelf
+	31,  // 12: education.CompanyFinanceList.items:type_name -> education.CompanyFinanceForList
+	34,  // 13: education.GetUpdateRoomAbs.rooms:type_name -> education.AbsRoom
+	37,  // 14: education.GetUpdateCourseAbs.courses:type_name -> education.AbsCourse
+	42,  // 15: education.GetLeftAfterTrialPeriodResponse.items:type_name -> education.AbsGetLeftAfter
+	46,  // 16: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
+	81,  // 17: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
+	51,  // 18: education.GetGroupsByCourseResponse.groups:type_name -> education.GetGroupByCourseAbsResponse
+	37,  // 19: education.GetGroupAbsResponse.course:type_name -> education.AbsCourse
+	34,  // 20: education.GetGroupAbsResponse.room:type_name -> education.AbsRoom
+	52,  // 21: education.GetGroupsResponse.groups:type_name -> education.GetGroupAbsResponse
+	122, // 22: education.GetGroupsRequest.page:type_name -> common.PageRequest
+	57,  // 23: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
+	58,  // 24: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
+	61,  // 25: education.GetAttendanceResponse.days:type_name -> education.Day
+	62,  // 26: education.GetAttendanceResponse.students:type_name -> education.Student
+	63,  // 27: education.Student.attendance:type_name -> education.Attendance
+	64,  // 28: education.Student.freezeDetail:type_name -> education.FreezeDetail
+	81,  // 29: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
+	78,  // 30: education.GetHistoryGroupResponse.groupHistory:type_name -> education.AbsHistory
+	76,  // 31: education.GetHistoryGroupResponse.studentsHistory:type_name -> education.AbsStudentHistory
+	78,  // 32: education.GetHistoryStudentResponse.studentHistory:type_name -> education.AbsHistory
+	76,  // 33: education.GetHistoryStudentResponse.conditionsHistory:type_name -> education.AbsStudentHistory
+	81,  // 34: education.AbsStudentHistory.student:type_name -> education.AbsStudent
+	77,  // 35: education.AbsStudentHistory.group:type_name -> education.AbsGroup
+	37,  // 36: education.AbsGroup.course:type_name -> education.AbsCourse
+	81,  // 37: education.SearchStudentResponse.students:type_name -> education.AbsStudent
+	84,  // 38: education.GetAllStudentResponse.response:type_name -> education.GetGroupsAbsForStudent
+	85,  // 39: education.GetGroupsAbsForStudent.groups:type_name -> education.GroupGetAllStudentAbs
+	37,  // 40: education.GroupGetAllStudentAbs.course:type_name -> education.AbsCourse
+	91,  // 41: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
+	123, // 42: education.GetStudentByIdResponse.paymentPlans:type_name -> common.PaymentPlanStatus
+	34,  // 43: education.GetGroupStudent.room:type_name -> education.AbsRoom
+	37,  // 44: education.GetGroupStudent.course:type_name -> education.AbsCourse
+	93,  // 45: education.GetNotesByStudent.notes:type_name -> education.AbsNote
+	96,  // 46: education.NotificationSettings.templates:type_name -> education.NotificationTemplate
+	99,  // 47: education.GetNotificationOutboxResponse.items:type_name -> education.NotificationOutboxItem
+	103, // 48: education.GetDebtRemindersResponse.items:type_name -> education.DebtReminderItem
+	109, // 49: education.GetReconciliationReportsResponse.items:type_name -> education.ReconciliationReport
+	110, // 50: education.ReconciliationReport.drifts:type_name -> education.BalanceDrift
+	113, // 51: education.PlatformMetrics.renewals:type_name -> education.RenewalStat
+	114, // 52: education.PlatformMetrics.cohorts:type_name -> education.CohortRetention
+	116, // 53: education.TenantHealthList.items:type_name -> education.TenantHealth
+	119, // 54: education.PlatformHistory.items:type_name -> education.PlatformSnapshot
+	0,   // 55: education.BillingService.Quote:input_type -> education.QuoteRequest
+	0,   // 56: education.BillingService.CreateInvoice:input_type -> education.QuoteRequest
+	3,   // 57: education.BillingService.GetInvoices:input_type -> education.GetInvoicesRequest
+	124, // 58: education.BillingService.VoidInvoice:input_type -> common.DeleteAbsRequest
+	5,   // 59: education.BillingService.CreatePromoCode:input_type -> education.PromoCode
+	125, // 60: education.BillingService.GetPromoCodes:input_type -> google.protobuf.Empty
+	5,   // 61: education.BillingService.DeactivatePromoCode:input_type -> education.PromoCode
+	7,   // 62: education.BillingService.SetPriceOverride:input_type -> education.PriceOverride
+	8,   // 63: education.BillingService.GetPriceOverride:input_type -> education.GetPriceOverrideRequest
+	9,   // 64: education.SignupService.CheckSubdomain:input_type -> education.CheckSubdomainRequest
+	11,  // 65: education.SignupService.Signup:input_type -> education.SignupRequest
+	22,  // 66: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
+	21,  // 67: education.CompanyService.CreateCompany:input_type -> education.CreateCompanyRequest
+	122, // 68: education.CompanyService.GetAll:input_type -> common.PageRequest
+	19,  // 69: education.CompanyService.UpdateCompany:input_type -> education.UpdateCompanyRequest
+	18,  // 70: education.CompanyService.GetStatistic:input_type -> education.GetStatisticRequest
+	13,  // 71: education.CompanyService.GetSubscription:input_type -> education.GetSubscriptionRequest
+	24,  // 72: education.TariffService.Create:input_type -> education.Tariff
+	24,  // 73: education.TariffService.Update:input_type -> education.Tariff
+	24,  // 74: education.TariffService.Delete:input_type -> education.Tariff
+	125, // 75: education.TariffService.Get:input_type -> google.protobuf.Empty
+	27,  // 76: education.CompanyFinanceService.Create:input_type -> education.CompanyFinance
+	124, // 77: education.CompanyFinanceService.Delete:input_type -> common.DeleteAbsRequest
+	122, // 78: education.CompanyFinanceService.GetAll:input_type -> common.PageRequest
+	122, // 79: education.CompanyFinanceService.GetByCompany:input_type -> common.PageRequest
+	27,  // 80: education.CompanyFinanceService.UpdateByCompany:input_type -> education.CompanyFinance
+	32,  // 81: education.RoomService.CreateRoom:input_type -> education.CreateRoomRequest
+	125, // 82: education.RoomService.GetRooms:input_type -> google.protobuf.Empty
+	34,  // 83: education.RoomService.UpdateRoom:input_type -> education.AbsRoom
+	124, // 84: education.RoomService.DeleteRoom:input_type -> common.DeleteAbsRequest
+	35,  // 85: education.CourseService.CreateCourse:input_type -> education.CreateCourseRequest
+	125, // 86: education.CourseService.GetCourses:input_type -> google.protobuf.Empty
+	39,  // 87: education.CourseService.GetCourseById:input_type -> education.GetCourseByIdRequest
+	37,  // 88: education.CourseService.UpdateCourse:input_type -> education.AbsCourse
+	124, // 89: education.CourseService.DeleteCourse:input_type -> common.DeleteAbsRequest
+	47,  // 90: education.GroupService.CreateGroup:input_type -> education.CreateGroupRequest
+	54,  // 91: education.GroupService.GetGroups:input_type -> education.GetGroupsRequest
+	48,  // 92: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
+	48,  // 93: education.GroupService.GetGroupsByCourseId:input_type -> education.GetGroupByIdRequest
+	49,  // 94: education.GroupService.UpdateGroup:input_type -> education.GetUpdateGroupAbs
+	124, // 95: education.GroupService.DeleteGroup:input_type -> common.DeleteAbsRequest
+	44,  // 96: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
+	125, // 97: education.GroupService.GetCommonInformationEducation:input_type -> google.protobuf.Empty
+	40,  // 98: education.GroupService.GetLeftAfterTrialPeriod:input_type -> education.GetLeftAfterTrialPeriodRequest
+	59,  // 99: education.AttendanceService.GetAttendance:input_type -> education.GetAttendanceRequest
+	65,  // 100: education.AttendanceService.SetAttendance:input_type -> education.SetAttendanceRequest
+	55,  // 101: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
+	82,  // 102: education.StudentService.GetAllStudent:input_type -> education.GetAllStudentRequest
+	86,  // 103: education.StudentService.CreateStudent:input_type -> education.CreateStudentRequest
+	87,  // 104: education.StudentService.UpdateStudent:input_type -> education.UpdateStudentRequest
+	69,  // 105: education.StudentService.DeleteStudent:input_type -> education.DeleteStudentRequest
+	88,  // 106: education.StudentService.AddToGroup:input_type -> education.AddToGroupRequest
+	90,  // 107: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
+	90,  // 108: education.StudentService.GetNoteByStudent:input_type -> education.NoteStudentByAbsRequest
+	94,  // 109: education.StudentService.CreateNoteForStudent:input_type -> education.CreateNoteRequest
+	90,  // 110: education.StudentService.DeleteStudentNote:input_type -> education.NoteStudentByAbsRequest
+	79,  // 111: education.StudentService.SearchStudent:input_type -> education.SearchStudentRequest
+	90,  // 112: education.StudentService.GetHistoryGroupById:input_type -> education.NoteStudentByAbsRequest
+	90,  // 113: education.StudentService.GetHistoryStudentById:input_type -> education.NoteStudentByAbsRequest
+	73,  // 114: education.StudentService.TransferLessonDate:input_type -> education.TransferLessonRequest
+	72,  // 115: education.StudentService.ChangeConditionStudent:input_type -> education.ChangeConditionStudentRequest
+	71,  // 116: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
+	68,  // 117: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
+	66,  // 118: education.StudentService.FindStudentsByPhone:input_type -> education.FindStudentsByPhoneRequest
+	67,  // 119: education.StudentService.MergeStudents:input_type -> education.MergeStudentsRequest
+	125, // 120: education.NotificationService.GetNotificationSettings:input_type -> google.protobuf.Empty
+	95,  // 121: education.NotificationService.UpdateNotificationSettings:input_type -> education.NotificationSettings
+	97,  // 122: education.NotificationService.GetNotificationOutbox:input_type -> education.GetNotificationOutboxRequest
+	125, // 123: education.DebtReminderService.GetDebtReminderSettings:input_type -> google.protobuf.Empty
+	100, // 124: education.DebtReminderService.UpdateDebtReminderSettings:input_type -> education.DebtReminderSettings
+	101, // 125: education.DebtReminderService.GetDebtReminders:input_type -> education.GetDebtRemindersRequest
+	104, // 126: education.DebtReminderService.SetDebtReminderReply:input_type -> education.SetDebtReminderReplyRequest
+	105, // 127: education.ReconciliationService.RunBalanceReconciliation:input_type -> education.RunBalanceReconciliationRequest
+	106, // 128: education.ReconciliationService.GetReconciliationReports:input_type -> education.GetReconciliationReportsRequest
+	108, // 129: education.ReconciliationService.GetReconciliationReport:input_type -> education.GetReconciliationReportRequest
+	111, // 130: education.AnalyticsService.GetPlatformMetrics:input_type -> education.PlatformMetricsRequest
+	115, // 131: education.AnalyticsService.GetTenantHealth:input_type -> education.TenantHealthRequest
+	118, // 132: education.AnalyticsService.GetPlatformHistory:input_type -> education.HistoryRequest
+	118, // 133: education.AnalyticsService.GetTenantHistory:input_type -> education.HistoryRequest
+	1,   // 134: education.BillingService.Quote:output_type -> education.TariffQuote
+	2,   // 135: education.BillingService.CreateInvoice:output_type -> education.Invoice
+	4,   // 136: education.BillingService.GetInvoices:output_type -> education.InvoiceList
+	126, // 137: education.BillingService.VoidInvoice:output_type -> common.AbsResponse
+	5,   // 138: education.BillingService.CreatePromoCode:output_type -> education.PromoCode
+	6,   // 139: education.BillingService.GetPromoCodes:output_type -> education.PromoCodeList
+	126, // 140: education.BillingService.DeactivatePromoCode:output_type -> common.AbsResponse
+	7,   // 141: education.BillingService.SetPriceOverride:output_type -> education.PriceOverride
+	7,   // 142: education.BillingService.GetPriceOverride:output_type -> education.PriceOverride
+	10,  // 143: education.SignupService.CheckSubdomain:output_type -> education.CheckSubdomainResponse
+	12,  // 144: education.SignupService.Signup:output_type -> education.SignupResponse
+	23,  // 145: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
+	126, // 146: education.CompanyService.CreateCompany:output_type -> common.AbsResponse
+	20,  // 147: education.CompanyService.GetAll:output_type -> education.GetAllResponse
+	126, // 148: education.CompanyService.UpdateCompany:output_type -> common.AbsResponse
+	15,  // 149: education.CompanyService.GetStatistic:output_type -> education.GetStatisticResponse
+	14,  // 150: education.CompanyService.GetSubscription:output_type -> education.CompanySubscription
+	24,  // 151: education.TariffService.Create:output_type -> education.Tariff
+	24,  // 152: education.TariffService.Update:output_type -> education.Tariff
+	24,  // 153: education.TariffService.Delete:output_type -> education.Tariff
+	26,  // 154: education.TariffService.Get:output_type -> education.TariffList
+	27,  // 155: education.CompanyFinanceService.Create:output_type -> education.CompanyFinance
+	126, // 156: education.CompanyFinanceService.Delete:output_type -> common.AbsResponse
+	30,  // 157: education.CompanyFinanceService.GetAll:output_type -> education.CompanyFinanceList
+	29,  // 158: education.CompanyFinanceService.GetByCompany:output_type -> education.CompanyFinanceSelfList
+	27,  // 159: education.CompanyFinanceService.UpdateByCompany:output_type -> education.CompanyFinance
+	126, // 160: education.RoomService.CreateRoom:output_type -> common.AbsResponse
+	33,  // 161: education.RoomService.GetRooms:output_type -> education.GetUpdateRoomAbs
+	126, // 162: education.RoomService.UpdateRoom:output_type -> common.AbsResponse
+	126, // 163: education.RoomService.DeleteRoom:output_type -> common.AbsResponse
+	126, // 164: education.CourseService.CreateCourse:output_type -> common.AbsResponse
+	36,  // 165: education.CourseService.GetCourses:output_type -> education.GetUpdateCourseAbs
+	38,  // 166: education.CourseService.GetCourseById:output_type -> education.GetCourseByIdResponse
+	126, // 167: education.CourseService.UpdateCourse:output_type -> common.AbsResponse
+	126, // 168: education.CourseService.DeleteCourse:output_type -> common.AbsResponse
+	126, // 169: education.GroupService.CreateGroup:output_type -> common.AbsResponse
+	53,  // 170: education.GroupService.GetGroups:output_type -> education.GetGroupsResponse
+	52,  // 171: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
+	50,  // 172: education.GroupService.GetGroupsByCourseId:output_type -> education.GetGroupsByCourseResponse
+	126, // 173: education.GroupService.UpdateGroup:output_type -> common.AbsResponse
+	126, // 174: education.GroupService.DeleteGroup:output_type -> common.AbsResponse
+	45,  // 175: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
+	43,  // 176: education.GroupService.GetCommonInformationEducation:output_type -> education.GetCommonInformationEducationResponse
+	41,  // 177: education.GroupService.GetLeftAfterTrialPeriod:output_type -> education.GetLeftAfterTrialPeriodResponse
+	60,  // 178: education.AttendanceService.GetAttendance:output_type -> education.GetAttendanceResponse
+	126, // 179: education.AttendanceService.SetAttendance:output_type -> common.AbsResponse
+	56,  // 180: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
+	83,  // 181: education.StudentService.GetAllStudent:output_type -> education.GetAllStudentResponse
+	126, // 182: education.StudentService.CreateStudent:output_type -> common.AbsResponse
+	126, // 183: education.StudentService.UpdateStudent:output_type -> common.AbsResponse
+	126, // 184: education.StudentService.DeleteStudent:output_type -> common.AbsResponse
+	126, // 185: education.StudentService.AddToGroup:output_type -> common.AbsResponse
+	89,  // 186: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
+	92,  // 187: education.StudentService.GetNoteByStudent:output_type -> education.GetNotesByStudent
+	126, // 188: education.StudentService.CreateNoteForStudent:output_type -> common.AbsResponse
+	126, // 189: education.StudentService.DeleteStudentNote:output_type -> common.AbsResponse
+	80,  // 190: education.StudentService.SearchStudent:output_type -> education.SearchStudentResponse
+	74,  // 191: education.StudentService.GetHistoryGroupById:output_type -> education.GetHistoryGroupResponse
+	75,  // 192: education.StudentService.GetHistoryStudentById:output_type -> education.GetHistoryStudentResponse
+	126, // 193: education.StudentService.TransferLessonDate:output_type -> common.AbsResponse
+	126, // 194: education.StudentService.ChangeConditionStudent:output_type -> common.AbsResponse
+	70,  // 195: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
+	126, // 196: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
+	80,  // 197: education.StudentService.FindStudentsByPhone:output_type -> education.SearchStudentResponse
+	126, // 198: education.StudentService.MergeStudents:output_type -> common.AbsResponse
+	95,  // 199: education.NotificationService.GetNotificationSettings:output_type -> education.NotificationSettings
+	126, // 200: education.NotificationService.UpdateNotificationSettings:output_type -> common.AbsResponse
+	98,  // 201: education.NotificationService.GetNotificationOutbox:output_type -> education.GetNotificationOutboxResponse
+	100, // 202: education.DebtReminderService.GetDebtReminderSettings:output_type -> education.DebtReminderSettings
+	126, // 203: education.DebtReminderService.UpdateDebtReminderSettings:output_type -> common.AbsResponse
+	102, // 204: education.DebtReminderService.GetDebtReminders:output_type -> education.GetDebtRemindersResponse
+	126, // 205: education.DebtReminderService.SetDebtReminderReply:output_type -> common.AbsResponse
+	109, // 206: education.ReconciliationService.RunBalanceReconciliation:output_type -> education.ReconciliationReport
+	107, // 207: education.ReconciliationService.GetReconciliationReports:output_type -> education.GetReconciliationReportsResponse
+	109, // 208: education.ReconciliationService.GetReconciliationReport:output_type -> education.ReconciliationReport
+	112, // 209: education.AnalyticsService.GetPlatformMetrics:output_type -> education.PlatformMetrics
+	117, // 210: education.AnalyticsService.GetTenantHealth:output_type -> education.TenantHealthList
+	120, // 211: education.AnalyticsService.GetPlatformHistory:output_type -> education.PlatformHistory
+	117, // 212: education.AnalyticsService.GetTenantHistory:output_type -> education.TenantHealthList
+	134, // [134:213] is the sub-list for method output_type
+	55,  // [55:134] is the sub-list for method input_type
+	55,  // [55:55] is the sub-list for extension type_name
+	55,  // [55:55] is the sub-list for extension extendee
+	0,   // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_education_proto_init() }
@@ -6944,16 +10753,16 @@ func file_education_proto_init() {
 		return
 	}
 	file_common_proto_init()
-	file_education_proto_msgTypes[38].OneofWrappers = []any{}
+	file_education_proto_msgTypes[54].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   78,
+			NumMessages:   122,
 			NumExtensions: 0,
-			NumServices:   8,
+			NumServices:   14,
 		},
 		GoTypes:           file_education_proto_goTypes,
 		DependencyIndexes: file_education_proto_depIdxs,
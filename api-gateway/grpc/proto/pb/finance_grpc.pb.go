@@ -24,6 +24,14 @@ const (
 	DiscountService_CreateDiscount_FullMethodName            = "/finance.DiscountService/CreateDiscount"
 	DiscountService_DeleteDiscount_FullMethodName            = "/finance.DiscountService/DeleteDiscount"
 	DiscountService_GetHistoryDiscount_FullMethodName        = "/finance.DiscountService/GetHistoryDiscount"
+	DiscountService_GetDiscountByStudentId_FullMethodName    = "/finance.DiscountService/GetDiscountByStudentId"
+	DiscountService_CreateDiscountRule_FullMethodName        = "/finance.DiscountService/CreateDiscountRule"
+	DiscountService_UpdateDiscountRule_FullMethodName        = "/finance.DiscountService/UpdateDiscountRule"
+	DiscountService_DeleteDiscountRule_FullMethodName        = "/finance.DiscountService/DeleteDiscountRule"
+	DiscountService_GetDiscountRules_FullMethodName          = "/finance.DiscountService/GetDiscountRules"
+	DiscountService_GetDiscountPolicy_FullMethodName         = "/finance.DiscountService/GetDiscountPolicy"
+	DiscountService_SetDiscountPolicy_FullMethodName         = "/finance.DiscountService/SetDiscountPolicy"
+	DiscountService_GetRuleApplications_FullMethodName       = "/finance.DiscountService/GetRuleApplications"
 )
 
 // DiscountServiceClient is the client API for DiscountService service.
@@ -36,6 +44,14 @@ type DiscountServiceClient interface {
 	CreateDiscount(ctx context.Context, in *AbsDiscountRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	DeleteDiscount(ctx context.Context, in *AbsDiscountRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	GetHistoryDiscount(ctx context.Context, in *GetHistoryDiscountRequest, opts ...grpc.CallOption) (*GetHistoryDiscountResponse, error)
+	GetDiscountByStudentId(ctx context.Context, in *GetDiscountByStudentIdRequest, opts ...grpc.CallOption) (*GetDiscountByStudentIdResponse, error)
+	CreateDiscountRule(ctx context.Context, in *DiscountRule, opts ...grpc.CallOption) (*DiscountRule, error)
+	UpdateDiscountRule(ctx context.Context, in *DiscountRule, opts ...grpc.CallOption) (*DiscountRule, error)
+	DeleteDiscountRule(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetDiscountRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiscountRuleList, error)
+	GetDiscountPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiscountPolicy, error)
+	SetDiscountPolicy(ctx context.Context, in *DiscountPolicy, opts ...grpc.CallOption) (*DiscountPolicy, error)
+	GetRuleApplications(ctx context.Context, in *GetRuleApplicationsRequest, opts ...grpc.CallOption) (*RuleApplicationList, error)
 }
 
 type discountServiceClient struct {
@@ -86,6 +102,86 @@ func (c *discountServiceClient) GetHistoryDiscount(ctx context.Context, in *GetH
 	return out, nil
 }
 
+func (c *discountServiceClient) GetDiscountByStudentId(ctx context.Context, in *GetDiscountByStudentIdRequest, opts ...grpc.CallOption) (*GetDiscountByStudentIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiscountByStudentIdResponse)
+	err := c.cc.Invoke(ctx, DiscountService_GetDiscountByStudentId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) CreateDiscountRule(ctx context.Context, in *DiscountRule, opts ...grpc.CallOption) (*DiscountRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscountRule)
+	err := c.cc.Invoke(ctx, DiscountService_CreateDiscountRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) UpdateDiscountRule(ctx context.Context, in *DiscountRule, opts ...grpc.CallOption) (*DiscountRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscountRule)
+	err := c.cc.Invoke(ctx, DiscountService_UpdateDiscountRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) DeleteDiscountRule(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, DiscountService_DeleteDiscountRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetDiscountRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiscountRuleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscountRuleList)
+	err := c.cc.Invoke(ctx, DiscountService_GetDiscountRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetDiscountPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiscountPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscountPolicy)
+	err := c.cc.Invoke(ctx, DiscountService_GetDiscountPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) SetDiscountPolicy(ctx context.Context, in *DiscountPolicy, opts ...grpc.CallOption) (*DiscountPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscountPolicy)
+	err := c.cc.Invoke(ctx, DiscountService_SetDiscountPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetRuleApplications(ctx context.Context, in *GetRuleApplicationsRequest, opts ...grpc.CallOption) (*RuleApplicationList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuleApplicationList)
+	err := c.cc.Invoke(ctx, DiscountService_GetRuleApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscountServiceServer is the server API for DiscountService service.
 // All implementations must embed UnimplementedDiscountServiceServer
 // for forward compatibility.
@@ -96,6 +192,14 @@ type DiscountServiceServer interface {
 	CreateDiscount(context.Context, *AbsDiscountRequest) (*AbsResponse, error)
 	DeleteDiscount(context.Context, *AbsDiscountRequest) (*AbsResponse, error)
 	GetHistoryDiscount(context.Context, *GetHistoryDiscountRequest) (*GetHistoryDiscountResponse, error)
+	GetDiscountByStudentId(context.Context, *GetDiscountByStudentIdRequest) (*GetDiscountByStudentIdResponse, error)
+	CreateDiscountRule(context.Context, *DiscountRule) (*DiscountRule, error)
+	UpdateDiscountRule(context.Context, *DiscountRule) (*DiscountRule, error)
+	DeleteDiscountRule(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	GetDiscountRules(context.Context, *emptypb.Empty) (*DiscountRuleList, error)
+	GetDiscountPolicy(context.Context, *emptypb.Empty) (*DiscountPolicy, error)
+	SetDiscountPolicy(context.Context, *DiscountPolicy) (*DiscountPolicy, error)
+	GetRuleApplications(context.Context, *GetRuleApplicationsRequest) (*RuleApplicationList, error)
 	mustEmbedUnimplementedDiscountServiceServer()
 }
 
@@ -118,6 +222,30 @@ func (UnimplementedDiscountServiceServer) DeleteDiscount(context.Context, *AbsDi
 func (UnimplementedDiscountServiceServer) GetHistoryDiscount(context.Context, *GetHistoryDiscountRequest) (*GetHistoryDiscountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryDiscount not implemented")
 }
+func (UnimplementedDiscountServiceServer) GetDiscountByStudentId(context.Context, *GetDiscountByStudentIdRequest) (*GetDiscountByStudentIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountByStudentId not implemented")
+}
+func (UnimplementedDiscountServiceServer) CreateDiscountRule(context.Context, *DiscountRule) (*DiscountRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiscountRule not implemented")
+}
+func (UnimplementedDiscountServiceServer) UpdateDiscountRule(context.Context, *DiscountRule) (*DiscountRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiscountRule not implemented")
+}
+func (UnimplementedDiscountServiceServer) DeleteDiscountRule(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiscountRule not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetDiscountRules(context.Context, *emptypb.Empty) (*DiscountRuleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountRules not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetDiscountPolicy(context.Context, *emptypb.Empty) (*DiscountPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountPolicy not implemented")
+}
+func (UnimplementedDiscountServiceServer) SetDiscountPolicy(context.Context, *DiscountPolicy) (*DiscountPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiscountPolicy not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetRuleApplications(context.Context, *GetRuleApplicationsRequest) (*RuleApplicationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleApplications not implemented")
+}
 func (UnimplementedDiscountServiceServer) mustEmbedUnimplementedDiscountServiceServer() {}
 func (UnimplementedDiscountServiceServer) testEmbeddedByValue()                         {}
 
@@ -211,6 +339,150 @@ func _DiscountService_GetHistoryDiscount_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscountService_GetDiscountByStudentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscountByStudentIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetDiscountByStudentId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetDiscountByStudentId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetDiscountByStudentId(ctx, req.(*GetDiscountByStudentIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_CreateDiscountRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscountRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).CreateDiscountRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_CreateDiscountRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).CreateDiscountRule(ctx, req.(*DiscountRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_UpdateDiscountRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscountRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).UpdateDiscountRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_UpdateDiscountRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).UpdateDiscountRule(ctx, req.(*DiscountRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_DeleteDiscountRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).DeleteDiscountRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_DeleteDiscountRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).DeleteDiscountRule(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetDiscountRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetDiscountRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetDiscountRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetDiscountRules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetDiscountPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetDiscountPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetDiscountPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetDiscountPolicy(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_SetDiscountPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscountPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).SetDiscountPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_SetDiscountPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).SetDiscountPolicy(ctx, req.(*DiscountPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetRuleApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetRuleApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetRuleApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetRuleApplications(ctx, req.(*GetRuleApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DiscountService_ServiceDesc is the grpc.ServiceDesc for DiscountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -234,6 +506,38 @@ var DiscountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHistoryDiscount",
 			Handler:    _DiscountService_GetHistoryDiscount_Handler,
 		},
+		{
+			MethodName: "GetDiscountByStudentId",
+			Handler:    _DiscountService_GetDiscountByStudentId_Handler,
+		},
+		{
+			MethodName: "CreateDiscountRule",
+			Handler:    _DiscountService_CreateDiscountRule_Handler,
+		},
+		{
+			MethodName: "UpdateDiscountRule",
+			Handler:    _DiscountService_UpdateDiscountRule_Handler,
+		},
+		{
+			MethodName: "DeleteDiscountRule",
+			Handler:    _DiscountService_DeleteDiscountRule_Handler,
+		},
+		{
+			MethodName: "GetDiscountRules",
+			Handler:    _DiscountService_GetDiscountRules_Handler,
+		},
+		{
+			MethodName: "GetDiscountPolicy",
+			Handler:    _DiscountService_GetDiscountPolicy_Handler,
+		},
+		{
+			MethodName: "SetDiscountPolicy",
+			Handler:    _DiscountService_SetDiscountPolicy_Handler,
+		},
+		{
+			MethodName: "GetRuleApplications",
+			Handler:    _DiscountService_GetRuleApplications_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
@@ -654,6 +958,8 @@ const (
 	PaymentService_GetAllDebtsInformation_FullMethodName      = "/finance.PaymentService/GetAllDebtsInformation"
 	PaymentService_GetCommonFinanceInformation_FullMethodName = "/finance.PaymentService/GetCommonFinanceInformation"
 	PaymentService_GetIncomeChart_FullMethodName              = "/finance.PaymentService/GetIncomeChart"
+	PaymentService_GetFailedBalanceEvents_FullMethodName      = "/finance.PaymentService/GetFailedBalanceEvents"
+	PaymentService_RetryBalanceEvents_FullMethodName          = "/finance.PaymentService/RetryBalanceEvents"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -675,6 +981,8 @@ type PaymentServiceClient interface {
 	GetAllDebtsInformation(ctx context.Context, in *GetAllDebtsRequest, opts ...grpc.CallOption) (*GetAllDebtsInformationResponse, error)
 	GetCommonFinanceInformation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationResponse, error)
 	GetIncomeChart(ctx context.Context, in *GetIncomeChartRequest, opts ...grpc.CallOption) (*GetIncomeChartResponse, error)
+	GetFailedBalanceEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BalanceEventList, error)
+	RetryBalanceEvents(ctx context.Context, in *RetryBalanceEventsRequest, opts ...grpc.CallOption) (*RetryBalanceEventsResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -805,6 +1113,26 @@ func (c *paymentServiceClient) GetIncomeChart(ctx context.Context, in *GetIncome
 	return out, nil
 }
 
+func (c *paymentServiceClient) GetFailedBalanceEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BalanceEventList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceEventList)
+	err := c.cc.Invoke(ctx, PaymentService_GetFailedBalanceEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RetryBalanceEvents(ctx context.Context, in *RetryBalanceEventsRequest, opts ...grpc.CallOption) (*RetryBalanceEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryBalanceEventsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RetryBalanceEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -824,6 +1152,8 @@ type PaymentServiceServer interface {
 	GetAllDebtsInformation(context.Context, *GetAllDebtsRequest) (*GetAllDebtsInformationResponse, error)
 	GetCommonFinanceInformation(context.Context, *emptypb.Empty) (*GetCommonInformationResponse, error)
 	GetIncomeChart(context.Context, *GetIncomeChartRequest) (*GetIncomeChartResponse, error)
+	GetFailedBalanceEvents(context.Context, *emptypb.Empty) (*BalanceEventList, error)
+	RetryBalanceEvents(context.Context, *RetryBalanceEventsRequest) (*RetryBalanceEventsResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -870,6 +1200,12 @@ func (UnimplementedPaymentServiceServer) GetCommonFinanceInformation(context.Con
 func (UnimplementedPaymentServiceServer) GetIncomeChart(context.Context, *GetIncomeChartRequest) (*GetIncomeChartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIncomeChart not implemented")
 }
+func (UnimplementedPaymentServiceServer) GetFailedBalanceEvents(context.Context, *emptypb.Empty) (*BalanceEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailedBalanceEvents not implemented")
+}
+func (UnimplementedPaymentServiceServer) RetryBalanceEvents(context.Context, *RetryBalanceEventsRequest) (*RetryBalanceEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryBalanceEvents not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -1107,6 +1443,42 @@ func _PaymentService_GetIncomeChart_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_GetFailedBalanceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetFailedBalanceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetFailedBalanceEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetFailedBalanceEvents(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RetryBalanceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryBalanceEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RetryBalanceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RetryBalanceEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RetryBalanceEvents(ctx, req.(*RetryBalanceEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1162,6 +1534,14 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIncomeChart",
 			Handler:    _PaymentService_GetIncomeChart_Handler,
 		},
+		{
+			MethodName: "GetFailedBalanceEvents",
+			Handler:    _PaymentService_GetFailedBalanceEvents_Handler,
+		},
+		{
+			MethodName: "RetryBalanceEvents",
+			Handler:    _PaymentService_RetryBalanceEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
@@ -1386,3 +1766,1507 @@ var TeacherSalaryService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
 }
+
+const (
+	SponsorService_CreateSponsor_FullMethodName        = "/finance.SponsorService/CreateSponsor"
+	SponsorService_UpdateSponsor_FullMethodName        = "/finance.SponsorService/UpdateSponsor"
+	SponsorService_DeleteSponsor_FullMethodName        = "/finance.SponsorService/DeleteSponsor"
+	SponsorService_GetSponsors_FullMethodName          = "/finance.SponsorService/GetSponsors"
+	SponsorService_AddSponsorship_FullMethodName       = "/finance.SponsorService/AddSponsorship"
+	SponsorService_EndSponsorship_FullMethodName       = "/finance.SponsorService/EndSponsorship"
+	SponsorService_GetSponsorships_FullMethodName      = "/finance.SponsorService/GetSponsorships"
+	SponsorService_SponsorPaymentAdd_FullMethodName    = "/finance.SponsorService/SponsorPaymentAdd"
+	SponsorService_SponsorPaymentReturn_FullMethodName = "/finance.SponsorService/SponsorPaymentReturn"
+	SponsorService_GetSponsorStatement_FullMethodName  = "/finance.SponsorService/GetSponsorStatement"
+)
+
+// SponsorServiceClient is the client API for SponsorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// sponsor service start
+// A sponsor is a payer account of its own: a company or an NGO covering part
+// of some students' tuition. Its payments and the parts of monthly charges it
+// covers are kept in its ledger, not on the students' balances.
+type SponsorServiceClient interface {
+	CreateSponsor(ctx context.Context, in *Sponsor, opts ...grpc.CallOption) (*Sponsor, error)
+	UpdateSponsor(ctx context.Context, in *Sponsor, opts ...grpc.CallOption) (*Sponsor, error)
+	DeleteSponsor(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetSponsors(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SponsorList, error)
+	AddSponsorship(ctx context.Context, in *Sponsorship, opts ...grpc.CallOption) (*Sponsorship, error)
+	EndSponsorship(ctx context.Context, in *EndSponsorshipRequest, opts ...grpc.CallOption) (*Sponsorship, error)
+	GetSponsorships(ctx context.Context, in *GetSponsorshipsRequest, opts ...grpc.CallOption) (*SponsorshipList, error)
+	SponsorPaymentAdd(ctx context.Context, in *SponsorPaymentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	SponsorPaymentReturn(ctx context.Context, in *SponsorPaymentReturnRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetSponsorStatement(ctx context.Context, in *SponsorStatementRequest, opts ...grpc.CallOption) (*SponsorStatement, error)
+}
+
+type sponsorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSponsorServiceClient(cc grpc.ClientConnInterface) SponsorServiceClient {
+	return &sponsorServiceClient{cc}
+}
+
+func (c *sponsorServiceClient) CreateSponsor(ctx context.Context, in *Sponsor, opts ...grpc.CallOption) (*Sponsor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sponsor)
+	err := c.cc.Invoke(ctx, SponsorService_CreateSponsor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) UpdateSponsor(ctx context.Context, in *Sponsor, opts ...grpc.CallOption) (*Sponsor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sponsor)
+	err := c.cc.Invoke(ctx, SponsorService_UpdateSponsor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) DeleteSponsor(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, SponsorService_DeleteSponsor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) GetSponsors(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SponsorList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SponsorList)
+	err := c.cc.Invoke(ctx, SponsorService_GetSponsors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) AddSponsorship(ctx context.Context, in *Sponsorship, opts ...grpc.CallOption) (*Sponsorship, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sponsorship)
+	err := c.cc.Invoke(ctx, SponsorService_AddSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) EndSponsorship(ctx context.Context, in *EndSponsorshipRequest, opts ...grpc.CallOption) (*Sponsorship, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Sponsorship)
+	err := c.cc.Invoke(ctx, SponsorService_EndSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) GetSponsorships(ctx context.Context, in *GetSponsorshipsRequest, opts ...grpc.CallOption) (*SponsorshipList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SponsorshipList)
+	err := c.cc.Invoke(ctx, SponsorService_GetSponsorships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) SponsorPaymentAdd(ctx context.Context, in *SponsorPaymentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, SponsorService_SponsorPaymentAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) SponsorPaymentReturn(ctx context.Context, in *SponsorPaymentReturnRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, SponsorService_SponsorPaymentReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) GetSponsorStatement(ctx context.Context, in *SponsorStatementRequest, opts ...grpc.CallOption) (*SponsorStatement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SponsorStatement)
+	err := c.cc.Invoke(ctx, SponsorService_GetSponsorStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SponsorServiceServer is the server API for SponsorService service.
+// All implementations must embed UnimplementedSponsorServiceServer
+// for forward compatibility.
+//
+// sponsor service start
+// A sponsor is a payer account of its own: a company or an NGO covering part
+// of some students' tuition. Its payments and the parts of monthly charges it
+// covers are kept in its ledger, not on the students' balances.
+type SponsorServiceServer interface {
+	CreateSponsor(context.Context, *Sponsor) (*Sponsor, error)
+	UpdateSponsor(context.Context, *Sponsor) (*Sponsor, error)
+	DeleteSponsor(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	GetSponsors(context.Context, *emptypb.Empty) (*SponsorList, error)
+	AddSponsorship(context.Context, *Sponsorship) (*Sponsorship, error)
+	EndSponsorship(context.Context, *EndSponsorshipRequest) (*Sponsorship, error)
+	GetSponsorships(context.Context, *GetSponsorshipsRequest) (*SponsorshipList, error)
+	SponsorPaymentAdd(context.Context, *SponsorPaymentRequest) (*AbsResponse, error)
+	SponsorPaymentReturn(context.Context, *SponsorPaymentReturnRequest) (*AbsResponse, error)
+	GetSponsorStatement(context.Context, *SponsorStatementRequest) (*SponsorStatement, error)
+	mustEmbedUnimplementedSponsorServiceServer()
+}
+
+// UnimplementedSponsorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSponsorServiceServer struct{}
+
+func (UnimplementedSponsorServiceServer) CreateSponsor(context.Context, *Sponsor) (*Sponsor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSponsor not implemented")
+}
+func (UnimplementedSponsorServiceServer) UpdateSponsor(context.Context, *Sponsor) (*Sponsor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSponsor not implemented")
+}
+func (UnimplementedSponsorServiceServer) DeleteSponsor(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSponsor not implemented")
+}
+func (UnimplementedSponsorServiceServer) GetSponsors(context.Context, *emptypb.Empty) (*SponsorList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSponsors not implemented")
+}
+func (UnimplementedSponsorServiceServer) AddSponsorship(context.Context, *Sponsorship) (*Sponsorship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSponsorship not implemented")
+}
+func (UnimplementedSponsorServiceServer) EndSponsorship(context.Context, *EndSponsorshipRequest) (*Sponsorship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndSponsorship not implemented")
+}
+func (UnimplementedSponsorServiceServer) GetSponsorships(context.Context, *GetSponsorshipsRequest) (*SponsorshipList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSponsorships not implemented")
+}
+func (UnimplementedSponsorServiceServer) SponsorPaymentAdd(context.Context, *SponsorPaymentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorPaymentAdd not implemented")
+}
+func (UnimplementedSponsorServiceServer) SponsorPaymentReturn(context.Context, *SponsorPaymentReturnRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorPaymentReturn not implemented")
+}
+func (UnimplementedSponsorServiceServer) GetSponsorStatement(context.Context, *SponsorStatementRequest) (*SponsorStatement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSponsorStatement not implemented")
+}
+func (UnimplementedSponsorServiceServer) mustEmbedUnimplementedSponsorServiceServer() {}
+func (UnimplementedSponsorServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeSponsorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SponsorServiceServer will
+// result in compilation errors.
+type UnsafeSponsorServiceServer interface {
+	mustEmbedUnimplementedSponsorServiceServer()
+}
+
+func RegisterSponsorServiceServer(s grpc.ServiceRegistrar, srv SponsorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSponsorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SponsorService_ServiceDesc, srv)
+}
+
+func _SponsorService_CreateSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Sponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).CreateSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_CreateSponsor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).CreateSponsor(ctx, req.(*Sponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_UpdateSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Sponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).UpdateSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_UpdateSponsor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).UpdateSponsor(ctx, req.(*Sponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_DeleteSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).DeleteSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_DeleteSponsor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).DeleteSponsor(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_GetSponsors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).GetSponsors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_GetSponsors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).GetSponsors(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_AddSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Sponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).AddSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_AddSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).AddSponsorship(ctx, req.(*Sponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_EndSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).EndSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_EndSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).EndSponsorship(ctx, req.(*EndSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_GetSponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).GetSponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_GetSponsorships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).GetSponsorships(ctx, req.(*GetSponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_SponsorPaymentAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SponsorPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).SponsorPaymentAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_SponsorPaymentAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).SponsorPaymentAdd(ctx, req.(*SponsorPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_SponsorPaymentReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SponsorPaymentReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).SponsorPaymentReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_SponsorPaymentReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).SponsorPaymentReturn(ctx, req.(*SponsorPaymentReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_GetSponsorStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SponsorStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).GetSponsorStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SponsorService_GetSponsorStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).GetSponsorStatement(ctx, req.(*SponsorStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SponsorService_ServiceDesc is the grpc.ServiceDesc for SponsorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SponsorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.SponsorService",
+	HandlerType: (*SponsorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSponsor",
+			Handler:    _SponsorService_CreateSponsor_Handler,
+		},
+		{
+			MethodName: "UpdateSponsor",
+			Handler:    _SponsorService_UpdateSponsor_Handler,
+		},
+		{
+			MethodName: "DeleteSponsor",
+			Handler:    _SponsorService_DeleteSponsor_Handler,
+		},
+		{
+			MethodName: "GetSponsors",
+			Handler:    _SponsorService_GetSponsors_Handler,
+		},
+		{
+			MethodName: "AddSponsorship",
+			Handler:    _SponsorService_AddSponsorship_Handler,
+		},
+		{
+			MethodName: "EndSponsorship",
+			Handler:    _SponsorService_EndSponsorship_Handler,
+		},
+		{
+			MethodName: "GetSponsorships",
+			Handler:    _SponsorService_GetSponsorships_Handler,
+		},
+		{
+			MethodName: "SponsorPaymentAdd",
+			Handler:    _SponsorService_SponsorPaymentAdd_Handler,
+		},
+		{
+			MethodName: "SponsorPaymentReturn",
+			Handler:    _SponsorService_SponsorPaymentReturn_Handler,
+		},
+		{
+			MethodName: "GetSponsorStatement",
+			Handler:    _SponsorService_GetSponsorStatement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	PaymentPlanService_PreviewPaymentPlan_FullMethodName     = "/finance.PaymentPlanService/PreviewPaymentPlan"
+	PaymentPlanService_CreatePaymentPlan_FullMethodName      = "/finance.PaymentPlanService/CreatePaymentPlan"
+	PaymentPlanService_GetPaymentPlan_FullMethodName         = "/finance.PaymentPlanService/GetPaymentPlan"
+	PaymentPlanService_CancelPaymentPlan_FullMethodName      = "/finance.PaymentPlanService/CancelPaymentPlan"
+	PaymentPlanService_GetStudentPaymentPlans_FullMethodName = "/finance.PaymentPlanService/GetStudentPaymentPlans"
+	PaymentPlanService_GetOverdueInstallments_FullMethodName = "/finance.PaymentPlanService/GetOverdueInstallments"
+)
+
+// PaymentPlanServiceClient is the client API for PaymentPlanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// payment plan service start
+// A payment plan is the schedule a student agreed to pay a group's fee by.
+// Payments added for the student are matched to its installments, oldest
+// due first.
+type PaymentPlanServiceClient interface {
+	PreviewPaymentPlan(ctx context.Context, in *CreatePaymentPlanRequest, opts ...grpc.CallOption) (*PaymentPlan, error)
+	CreatePaymentPlan(ctx context.Context, in *CreatePaymentPlanRequest, opts ...grpc.CallOption) (*PaymentPlan, error)
+	GetPaymentPlan(ctx context.Context, in *PaymentPlanRequest, opts ...grpc.CallOption) (*PaymentPlan, error)
+	CancelPaymentPlan(ctx context.Context, in *PaymentPlanRequest, opts ...grpc.CallOption) (*PaymentPlan, error)
+	GetStudentPaymentPlans(ctx context.Context, in *StudentPaymentPlansRequest, opts ...grpc.CallOption) (*PaymentPlanStatusList, error)
+	GetOverdueInstallments(ctx context.Context, in *GetOverdueInstallmentsRequest, opts ...grpc.CallOption) (*OverdueInstallmentList, error)
+}
+
+type paymentPlanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentPlanServiceClient(cc grpc.ClientConnInterface) PaymentPlanServiceClient {
+	return &paymentPlanServiceClient{cc}
+}
+
+func (c *paymentPlanServiceClient) PreviewPaymentPlan(ctx context.Context, in *CreatePaymentPlanRequest, opts ...grpc.CallOption) (*PaymentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentPlan)
+	err := c.cc.Invoke(ctx, PaymentPlanService_PreviewPaymentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentPlanServiceClient) CreatePaymentPlan(ctx context.Context, in *CreatePaymentPlanRequest, opts ...grpc.CallOption) (*PaymentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentPlan)
+	err := c.cc.Invoke(ctx, PaymentPlanService_CreatePaymentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentPlanServiceClient) GetPaymentPlan(ctx context.Context, in *PaymentPlanRequest, opts ...grpc.CallOption) (*PaymentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentPlan)
+	err := c.cc.Invoke(ctx, PaymentPlanService_GetPaymentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentPlanServiceClient) CancelPaymentPlan(ctx context.Context, in *PaymentPlanRequest, opts ...grpc.CallOption) (*PaymentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentPlan)
+	err := c.cc.Invoke(ctx, PaymentPlanService_CancelPaymentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentPlanServiceClient) GetStudentPaymentPlans(ctx context.Context, in *StudentPaymentPlansRequest, opts ...grpc.CallOption) (*PaymentPlanStatusList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentPlanStatusList)
+	err := c.cc.Invoke(ctx, PaymentPlanService_GetStudentPaymentPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentPlanServiceClient) GetOverdueInstallments(ctx context.Context, in *GetOverdueInstallmentsRequest, opts ...grpc.CallOption) (*OverdueInstallmentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OverdueInstallmentList)
+	err := c.cc.Invoke(ctx, PaymentPlanService_GetOverdueInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentPlanServiceServer is the server API for PaymentPlanService service.
+// All implementations must embed UnimplementedPaymentPlanServiceServer
+// for forward compatibility.
+//
+// payment plan service start
+// A payment plan is the schedule a student agreed to pay a group's fee by.
+// Payments added for the student are matched to its installments, oldest
+// due first.
+type PaymentPlanServiceServer interface {
+	PreviewPaymentPlan(context.Context, *CreatePaymentPlanRequest) (*PaymentPlan, error)
+	CreatePaymentPlan(context.Context, *CreatePaymentPlanRequest) (*PaymentPlan, error)
+	GetPaymentPlan(context.Context, *PaymentPlanRequest) (*PaymentPlan, error)
+	CancelPaymentPlan(context.Context, *PaymentPlanRequest) (*PaymentPlan, error)
+	GetStudentPaymentPlans(context.Context, *StudentPaymentPlansRequest) (*PaymentPlanStatusList, error)
+	GetOverdueInstallments(context.Context, *GetOverdueInstallmentsRequest) (*OverdueInstallmentList, error)
+	mustEmbedUnimplementedPaymentPlanServiceServer()
+}
+
+// UnimplementedPaymentPlanServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentPlanServiceServer struct{}
+
+func (UnimplementedPaymentPlanServiceServer) PreviewPaymentPlan(context.Context, *CreatePaymentPlanRequest) (*PaymentPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewPaymentPlan not implemented")
+}
+func (UnimplementedPaymentPlanServiceServer) CreatePaymentPlan(context.Context, *CreatePaymentPlanRequest) (*PaymentPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentPlan not implemented")
+}
+func (UnimplementedPaymentPlanServiceServer) GetPaymentPlan(context.Context, *PaymentPlanRequest) (*PaymentPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentPlan not implemented")
+}
+func (UnimplementedPaymentPlanServiceServer) CancelPaymentPlan(context.Context, *PaymentPlanRequest) (*PaymentPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentPlan not implemented")
+}
+func (UnimplementedPaymentPlanServiceServer) GetStudentPaymentPlans(context.Context, *StudentPaymentPlansRequest) (*PaymentPlanStatusList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentPaymentPlans not implemented")
+}
+func (UnimplementedPaymentPlanServiceServer) GetOverdueInstallments(context.Context, *GetOverdueInstallmentsRequest) (*OverdueInstallmentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOverdueInstallments not implemented")
+}
+func (UnimplementedPaymentPlanServiceServer) mustEmbedUnimplementedPaymentPlanServiceServer() {}
+func (UnimplementedPaymentPlanServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePaymentPlanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentPlanServiceServer will
+// result in compilation errors.
+type UnsafePaymentPlanServiceServer interface {
+	mustEmbedUnimplementedPaymentPlanServiceServer()
+}
+
+func RegisterPaymentPlanServiceServer(s grpc.ServiceRegistrar, srv PaymentPlanServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentPlanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentPlanService_ServiceDesc, srv)
+}
+
+func _PaymentPlanService_PreviewPaymentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentPlanServiceServer).PreviewPaymentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentPlanService_PreviewPaymentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentPlanServiceServer).PreviewPaymentPlan(ctx, req.(*CreatePaymentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentPlanService_CreatePaymentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentPlanServiceServer).CreatePaymentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentPlanService_CreatePaymentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentPlanServiceServer).CreatePaymentPlan(ctx, req.(*CreatePaymentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentPlanService_GetPaymentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentPlanServiceServer).GetPaymentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentPlanService_GetPaymentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentPlanServiceServer).GetPaymentPlan(ctx, req.(*PaymentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentPlanService_CancelPaymentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentPlanServiceServer).CancelPaymentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentPlanService_CancelPaymentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentPlanServiceServer).CancelPaymentPlan(ctx, req.(*PaymentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentPlanService_GetStudentPaymentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentPaymentPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentPlanServiceServer).GetStudentPaymentPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentPlanService_GetStudentPaymentPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentPlanServiceServer).GetStudentPaymentPlans(ctx, req.(*StudentPaymentPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentPlanService_GetOverdueInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverdueInstallmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentPlanServiceServer).GetOverdueInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentPlanService_GetOverdueInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentPlanServiceServer).GetOverdueInstallments(ctx, req.(*GetOverdueInstallmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentPlanService_ServiceDesc is the grpc.ServiceDesc for PaymentPlanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentPlanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.PaymentPlanService",
+	HandlerType: (*PaymentPlanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewPaymentPlan",
+			Handler:    _PaymentPlanService_PreviewPaymentPlan_Handler,
+		},
+		{
+			MethodName: "CreatePaymentPlan",
+			Handler:    _PaymentPlanService_CreatePaymentPlan_Handler,
+		},
+		{
+			MethodName: "GetPaymentPlan",
+			Handler:    _PaymentPlanService_GetPaymentPlan_Handler,
+		},
+		{
+			MethodName: "CancelPaymentPlan",
+			Handler:    _PaymentPlanService_CancelPaymentPlan_Handler,
+		},
+		{
+			MethodName: "GetStudentPaymentPlans",
+			Handler:    _PaymentPlanService_GetStudentPaymentPlans_Handler,
+		},
+		{
+			MethodName: "GetOverdueInstallments",
+			Handler:    _PaymentPlanService_GetOverdueInstallments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	CashService_CreateCashAccount_FullMethodName = "/finance.CashService/CreateCashAccount"
+	CashService_UpdateCashAccount_FullMethodName = "/finance.CashService/UpdateCashAccount"
+	CashService_GetCashAccounts_FullMethodName   = "/finance.CashService/GetCashAccounts"
+	CashService_OpenCashShift_FullMethodName     = "/finance.CashService/OpenCashShift"
+	CashService_CloseCashShift_FullMethodName    = "/finance.CashService/CloseCashShift"
+	CashService_GetCashShifts_FullMethodName     = "/finance.CashService/GetCashShifts"
+	CashService_SetExchangeRate_FullMethodName   = "/finance.CashService/SetExchangeRate"
+	CashService_GetExchangeRates_FullMethodName  = "/finance.CashService/GetExchangeRates"
+)
+
+// CashServiceClient is the client API for CashService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// cash service start
+type CashServiceClient interface {
+	CreateCashAccount(ctx context.Context, in *CashAccount, opts ...grpc.CallOption) (*CashAccount, error)
+	UpdateCashAccount(ctx context.Context, in *CashAccount, opts ...grpc.CallOption) (*CashAccount, error)
+	GetCashAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CashAccountList, error)
+	OpenCashShift(ctx context.Context, in *CashShiftRequest, opts ...grpc.CallOption) (*CashShift, error)
+	CloseCashShift(ctx context.Context, in *CashShiftRequest, opts ...grpc.CallOption) (*CashShift, error)
+	GetCashShifts(ctx context.Context, in *GetCashShiftsRequest, opts ...grpc.CallOption) (*CashShiftList, error)
+	SetExchangeRate(ctx context.Context, in *ExchangeRate, opts ...grpc.CallOption) (*ExchangeRate, error)
+	GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*ExchangeRateList, error)
+}
+
+type cashServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCashServiceClient(cc grpc.ClientConnInterface) CashServiceClient {
+	return &cashServiceClient{cc}
+}
+
+func (c *cashServiceClient) CreateCashAccount(ctx context.Context, in *CashAccount, opts ...grpc.CallOption) (*CashAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashAccount)
+	err := c.cc.Invoke(ctx, CashService_CreateCashAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) UpdateCashAccount(ctx context.Context, in *CashAccount, opts ...grpc.CallOption) (*CashAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashAccount)
+	err := c.cc.Invoke(ctx, CashService_UpdateCashAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) GetCashAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CashAccountList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashAccountList)
+	err := c.cc.Invoke(ctx, CashService_GetCashAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) OpenCashShift(ctx context.Context, in *CashShiftRequest, opts ...grpc.CallOption) (*CashShift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashShift)
+	err := c.cc.Invoke(ctx, CashService_OpenCashShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) CloseCashShift(ctx context.Context, in *CashShiftRequest, opts ...grpc.CallOption) (*CashShift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashShift)
+	err := c.cc.Invoke(ctx, CashService_CloseCashShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) GetCashShifts(ctx context.Context, in *GetCashShiftsRequest, opts ...grpc.CallOption) (*CashShiftList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashShiftList)
+	err := c.cc.Invoke(ctx, CashService_GetCashShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) SetExchangeRate(ctx context.Context, in *ExchangeRate, opts ...grpc.CallOption) (*ExchangeRate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRate)
+	err := c.cc.Invoke(ctx, CashService_SetExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*ExchangeRateList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRateList)
+	err := c.cc.Invoke(ctx, CashService_GetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CashServiceServer is the server API for CashService service.
+// All implementations must embed UnimplementedCashServiceServer
+// for forward compatibility.
+//
+// cash service start
+type CashServiceServer interface {
+	CreateCashAccount(context.Context, *CashAccount) (*CashAccount, error)
+	UpdateCashAccount(context.Context, *CashAccount) (*CashAccount, error)
+	GetCashAccounts(context.Context, *emptypb.Empty) (*CashAccountList, error)
+	OpenCashShift(context.Context, *CashShiftRequest) (*CashShift, error)
+	CloseCashShift(context.Context, *CashShiftRequest) (*CashShift, error)
+	GetCashShifts(context.Context, *GetCashShiftsRequest) (*CashShiftList, error)
+	SetExchangeRate(context.Context, *ExchangeRate) (*ExchangeRate, error)
+	GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*ExchangeRateList, error)
+	mustEmbedUnimplementedCashServiceServer()
+}
+
+// UnimplementedCashServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCashServiceServer struct{}
+
+func (UnimplementedCashServiceServer) CreateCashAccount(context.Context, *CashAccount) (*CashAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCashAccount not implemented")
+}
+func (UnimplementedCashServiceServer) UpdateCashAccount(context.Context, *CashAccount) (*CashAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCashAccount not implemented")
+}
+func (UnimplementedCashServiceServer) GetCashAccounts(context.Context, *emptypb.Empty) (*CashAccountList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCashAccounts not implemented")
+}
+func (UnimplementedCashServiceServer) OpenCashShift(context.Context, *CashShiftRequest) (*CashShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenCashShift not implemented")
+}
+func (UnimplementedCashServiceServer) CloseCashShift(context.Context, *CashShiftRequest) (*CashShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseCashShift not implemented")
+}
+func (UnimplementedCashServiceServer) GetCashShifts(context.Context, *GetCashShiftsRequest) (*CashShiftList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCashShifts not implemented")
+}
+func (UnimplementedCashServiceServer) SetExchangeRate(context.Context, *ExchangeRate) (*ExchangeRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedCashServiceServer) GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*ExchangeRateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedCashServiceServer) mustEmbedUnimplementedCashServiceServer() {}
+func (UnimplementedCashServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCashServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CashServiceServer will
+// result in compilation errors.
+type UnsafeCashServiceServer interface {
+	mustEmbedUnimplementedCashServiceServer()
+}
+
+func RegisterCashServiceServer(s grpc.ServiceRegistrar, srv CashServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCashServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CashService_ServiceDesc, srv)
+}
+
+func _CashService_CreateCashAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).CreateCashAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_CreateCashAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).CreateCashAccount(ctx, req.(*CashAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_UpdateCashAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).UpdateCashAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_UpdateCashAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).UpdateCashAccount(ctx, req.(*CashAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_GetCashAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).GetCashAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_GetCashAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).GetCashAccounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_OpenCashShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).OpenCashShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_OpenCashShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).OpenCashShift(ctx, req.(*CashShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_CloseCashShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).CloseCashShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_CloseCashShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).CloseCashShift(ctx, req.(*CashShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_GetCashShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCashShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).GetCashShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_GetCashShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).GetCashShifts(ctx, req.(*GetCashShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_SetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).SetExchangeRate(ctx, req.(*ExchangeRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_GetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).GetExchangeRates(ctx, req.(*GetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CashService_ServiceDesc is the grpc.ServiceDesc for CashService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CashService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.CashService",
+	HandlerType: (*CashServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCashAccount",
+			Handler:    _CashService_CreateCashAccount_Handler,
+		},
+		{
+			MethodName: "UpdateCashAccount",
+			Handler:    _CashService_UpdateCashAccount_Handler,
+		},
+		{
+			MethodName: "GetCashAccounts",
+			Handler:    _CashService_GetCashAccounts_Handler,
+		},
+		{
+			MethodName: "OpenCashShift",
+			Handler:    _CashService_OpenCashShift_Handler,
+		},
+		{
+			MethodName: "CloseCashShift",
+			Handler:    _CashService_CloseCashShift_Handler,
+		},
+		{
+			MethodName: "GetCashShifts",
+			Handler:    _CashService_GetCashShifts_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _CashService_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _CashService_GetExchangeRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	LedgerService_GetLedgerAccounts_FullMethodName   = "/finance.LedgerService/GetLedgerAccounts"
+	LedgerService_CreateLedgerAccount_FullMethodName = "/finance.LedgerService/CreateLedgerAccount"
+	LedgerService_CreateJournalEntry_FullMethodName  = "/finance.LedgerService/CreateJournalEntry"
+	LedgerService_ReverseJournalEntry_FullMethodName = "/finance.LedgerService/ReverseJournalEntry"
+	LedgerService_GetJournalEntries_FullMethodName   = "/finance.LedgerService/GetJournalEntries"
+	LedgerService_GetTrialBalance_FullMethodName     = "/finance.LedgerService/GetTrialBalance"
+	LedgerService_GetProfitAndLoss_FullMethodName    = "/finance.LedgerService/GetProfitAndLoss"
+	LedgerService_GetCashFlow_FullMethodName         = "/finance.LedgerService/GetCashFlow"
+)
+
+// LedgerServiceClient is the client API for LedgerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ledger service start
+// The general ledger: every payment, charge, refund and expense is posted
+// to it as a balanced journal entry, in UZS.
+type LedgerServiceClient interface {
+	GetLedgerAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LedgerAccountList, error)
+	CreateLedgerAccount(ctx context.Context, in *LedgerAccount, opts ...grpc.CallOption) (*LedgerAccount, error)
+	CreateJournalEntry(ctx context.Context, in *JournalEntry, opts ...grpc.CallOption) (*JournalEntry, error)
+	ReverseJournalEntry(ctx context.Context, in *ReverseJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error)
+	GetJournalEntries(ctx context.Context, in *GetJournalEntriesRequest, opts ...grpc.CallOption) (*JournalEntryList, error)
+	GetTrialBalance(ctx context.Context, in *TrialBalanceRequest, opts ...grpc.CallOption) (*TrialBalance, error)
+	GetProfitAndLoss(ctx context.Context, in *LedgerPeriodRequest, opts ...grpc.CallOption) (*ProfitAndLoss, error)
+	GetCashFlow(ctx context.Context, in *LedgerPeriodRequest, opts ...grpc.CallOption) (*CashFlow, error)
+}
+
+type ledgerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLedgerServiceClient(cc grpc.ClientConnInterface) LedgerServiceClient {
+	return &ledgerServiceClient{cc}
+}
+
+func (c *ledgerServiceClient) GetLedgerAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LedgerAccountList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LedgerAccountList)
+	err := c.cc.Invoke(ctx, LedgerService_GetLedgerAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateLedgerAccount(ctx context.Context, in *LedgerAccount, opts ...grpc.CallOption) (*LedgerAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LedgerAccount)
+	err := c.cc.Invoke(ctx, LedgerService_CreateLedgerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateJournalEntry(ctx context.Context, in *JournalEntry, opts ...grpc.CallOption) (*JournalEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalEntry)
+	err := c.cc.Invoke(ctx, LedgerService_CreateJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ReverseJournalEntry(ctx context.Context, in *ReverseJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalEntry)
+	err := c.cc.Invoke(ctx, LedgerService_ReverseJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetJournalEntries(ctx context.Context, in *GetJournalEntriesRequest, opts ...grpc.CallOption) (*JournalEntryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalEntryList)
+	err := c.cc.Invoke(ctx, LedgerService_GetJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetTrialBalance(ctx context.Context, in *TrialBalanceRequest, opts ...grpc.CallOption) (*TrialBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrialBalance)
+	err := c.cc.Invoke(ctx, LedgerService_GetTrialBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetProfitAndLoss(ctx context.Context, in *LedgerPeriodRequest, opts ...grpc.CallOption) (*ProfitAndLoss, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfitAndLoss)
+	err := c.cc.Invoke(ctx, LedgerService_GetProfitAndLoss_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetCashFlow(ctx context.Context, in *LedgerPeriodRequest, opts ...grpc.CallOption) (*CashFlow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashFlow)
+	err := c.cc.Invoke(ctx, LedgerService_GetCashFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LedgerServiceServer is the server API for LedgerService service.
+// All implementations must embed UnimplementedLedgerServiceServer
+// for forward compatibility.
+//
+// ledger service start
+// The general ledger: every payment, charge, refund and expense is posted
+// to it as a balanced journal entry, in UZS.
+type LedgerServiceServer interface {
+	GetLedgerAccounts(context.Context, *emptypb.Empty) (*LedgerAccountList, error)
+	CreateLedgerAccount(context.Context, *LedgerAccount) (*LedgerAccount, error)
+	CreateJournalEntry(context.Context, *JournalEntry) (*JournalEntry, error)
+	ReverseJournalEntry(context.Context, *ReverseJournalEntryRequest) (*JournalEntry, error)
+	GetJournalEntries(context.Context, *GetJournalEntriesRequest) (*JournalEntryList, error)
+	GetTrialBalance(context.Context, *TrialBalanceRequest) (*TrialBalance, error)
+	GetProfitAndLoss(context.Context, *LedgerPeriodRequest) (*ProfitAndLoss, error)
+	GetCashFlow(context.Context, *LedgerPeriodRequest) (*CashFlow, error)
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+// UnimplementedLedgerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLedgerServiceServer struct{}
+
+func (UnimplementedLedgerServiceServer) GetLedgerAccounts(context.Context, *emptypb.Empty) (*LedgerAccountList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedgerAccounts not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateLedgerAccount(context.Context, *LedgerAccount) (*LedgerAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLedgerAccount not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateJournalEntry(context.Context, *JournalEntry) (*JournalEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJournalEntry not implemented")
+}
+func (UnimplementedLedgerServiceServer) ReverseJournalEntry(context.Context, *ReverseJournalEntryRequest) (*JournalEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseJournalEntry not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetJournalEntries(context.Context, *GetJournalEntriesRequest) (*JournalEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalEntries not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetTrialBalance(context.Context, *TrialBalanceRequest) (*TrialBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetProfitAndLoss(context.Context, *LedgerPeriodRequest) (*ProfitAndLoss, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfitAndLoss not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetCashFlow(context.Context, *LedgerPeriodRequest) (*CashFlow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCashFlow not implemented")
+}
+func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
+func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LedgerServiceServer will
+// result in compilation errors.
+type UnsafeLedgerServiceServer interface {
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLedgerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LedgerService_ServiceDesc, srv)
+}
+
+func _LedgerService_GetLedgerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetLedgerAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetLedgerAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetLedgerAccounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateLedgerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateLedgerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateLedgerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateLedgerAccount(ctx, req.(*LedgerAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JournalEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateJournalEntry(ctx, req.(*JournalEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ReverseJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ReverseJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ReverseJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ReverseJournalEntry(ctx, req.(*ReverseJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetJournalEntries(ctx, req.(*GetJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetTrialBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetTrialBalance(ctx, req.(*TrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetProfitAndLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetProfitAndLoss(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetProfitAndLoss_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetProfitAndLoss(ctx, req.(*LedgerPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetCashFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetCashFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetCashFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetCashFlow(ctx, req.(*LedgerPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LedgerService_ServiceDesc is the grpc.ServiceDesc for LedgerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LedgerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.LedgerService",
+	HandlerType: (*LedgerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLedgerAccounts",
+			Handler:    _LedgerService_GetLedgerAccounts_Handler,
+		},
+		{
+			MethodName: "CreateLedgerAccount",
+			Handler:    _LedgerService_CreateLedgerAccount_Handler,
+		},
+		{
+			MethodName: "CreateJournalEntry",
+			Handler:    _LedgerService_CreateJournalEntry_Handler,
+		},
+		{
+			MethodName: "ReverseJournalEntry",
+			Handler:    _LedgerService_ReverseJournalEntry_Handler,
+		},
+		{
+			MethodName: "GetJournalEntries",
+			Handler:    _LedgerService_GetJournalEntries_Handler,
+		},
+		{
+			MethodName: "GetTrialBalance",
+			Handler:    _LedgerService_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "GetProfitAndLoss",
+			Handler:    _LedgerService_GetProfitAndLoss_Handler,
+		},
+		{
+			MethodName: "GetCashFlow",
+			Handler:    _LedgerService_GetCashFlow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
@@ -24,7 +24,7 @@ const (
 
 type GetActiveLeadCountResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	ActiveLeadCount int32                  `protobuf:"varint,1,opt,name=activeLeadCount,proto3" json:"activeLeadCount"`
+	ActiveLeadCount int32                  `protobuf:"varint,1,opt,name=activeLeadCount,proto3" json:"activeLeadCount,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -68,8 +68,8 @@ func (x *GetActiveLeadCountResponse) GetActiveLeadCount() int32 {
 
 type GetLeadReportsResponse struct {
 	state                   protoimpl.MessageState     `protogen:"open.v1"`
-	LeadConversion          []*LeadConversion          `protobuf:"bytes,1,rep,name=leadConversion,proto3" json:"leadConversion"`
-	LeadConversionForSource []*LeadConversionForSource `protobuf:"bytes,2,rep,name=leadConversionForSource,proto3" json:"leadConversionForSource"`
+	LeadConversion          []*LeadConversion          `protobuf:"bytes,1,rep,name=leadConversion,proto3" json:"leadConversion,omitempty"`
+	LeadConversionForSource []*LeadConversionForSource `protobuf:"bytes,2,rep,name=leadConversionForSource,proto3" json:"leadConversionForSource,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -120,8 +120,8 @@ func (x *GetLeadReportsResponse) GetLeadConversionForSource() []*LeadConversionF
 
 type LeadConversion struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversionDate string                 `protobuf:"bytes,1,opt,name=conversionDate,proto3" json:"conversionDate"`
-	LeadCount      int32                  `protobuf:"varint,2,opt,name=lead_count,json=leadCount,proto3" json:"lead_count"`
+	ConversionDate string                 `protobuf:"bytes,1,opt,name=conversionDate,proto3" json:"conversionDate,omitempty"`
+	LeadCount      int32                  `protobuf:"varint,2,opt,name=lead_count,json=leadCount,proto3" json:"lead_count,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -172,8 +172,8 @@ func (x *LeadConversion) GetLeadCount() int32 {
 
 type LeadConversionForSource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source"`
-	LeadsCount    int32                  `protobuf:"varint,2,opt,name=leads_count,json=leadsCount,proto3" json:"leads_count"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	LeadsCount    int32                  `protobuf:"varint,2,opt,name=leads_count,json=leadsCount,proto3" json:"leads_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -224,8 +224,8 @@ func (x *LeadConversionForSource) GetLeadsCount() int32 {
 
 type GetLeadReportsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartYear     string                 `protobuf:"bytes,1,opt,name=startYear,proto3" json:"startYear"`
-	EndYear       string                 `protobuf:"bytes,2,opt,name=endYear,proto3" json:"endYear"`
+	StartYear     string                 `protobuf:"bytes,1,opt,name=startYear,proto3" json:"startYear,omitempty"`
+	EndYear       string                 `protobuf:"bytes,2,opt,name=endYear,proto3" json:"endYear,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -276,7 +276,7 @@ func (x *GetLeadReportsRequest) GetEndYear() string {
 
 type CreateLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -320,9 +320,9 @@ func (x *CreateLeadRequest) GetTitle() string {
 
 type GetLeadCommonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Leads         []*Section             `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads"`
-	Expectations  []*Section             `protobuf:"bytes,2,rep,name=expectations,proto3" json:"expectations"`
-	Sets          []*Section             `protobuf:"bytes,3,rep,name=sets,proto3" json:"sets"`
+	Leads         []*Section             `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads,omitempty"`
+	Expectations  []*Section             `protobuf:"bytes,2,rep,name=expectations,proto3" json:"expectations,omitempty"`
+	Sets          []*Section             `protobuf:"bytes,3,rep,name=sets,proto3" json:"sets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -380,7 +380,7 @@ func (x *GetLeadCommonResponse) GetSets() []*Section {
 
 type GetLeadCommonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Requests      []*LeadCommonRequest   `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	Requests      []*LeadCommonRequest   `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -424,8 +424,8 @@ func (x *GetLeadCommonRequest) GetRequests() []*LeadCommonRequest {
 
 type LeadCommonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -476,11 +476,11 @@ func (x *LeadCommonRequest) GetId() string {
 
 type Section struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	LeadsCount    int32                  `protobuf:"varint,2,opt,name=leadsCount,proto3" json:"leadsCount"`
-	Leads         []*Lead                `protobuf:"bytes,3,rep,name=leads,proto3" json:"leads"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LeadsCount    int32                  `protobuf:"varint,2,opt,name=leadsCount,proto3" json:"leadsCount,omitempty"`
+	Leads         []*Lead                `protobuf:"bytes,3,rep,name=leads,proto3" json:"leads,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -552,11 +552,11 @@ func (x *Section) GetType() string {
 
 type Lead struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
-	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt"`
-	PhoneNumber   string                 `protobuf:"bytes,4,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,4,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -628,8 +628,8 @@ func (x *Lead) GetPhoneNumber() string {
 
 type UpdateLeadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -680,7 +680,7 @@ func (x *UpdateLeadRequest) GetTitle() string {
 
 type GetLeadListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sections      []*DynamicSection      `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections"`
+	Sections      []*DynamicSection      `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -724,8 +724,8 @@ func (x *GetLeadListResponse) GetSections() []*DynamicSection {
 
 type DynamicSection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -776,7 +776,7 @@ func (x *DynamicSection) GetName() string {
 
 type CreateExpectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -820,8 +820,8 @@ func (x *CreateExpectRequest) GetTitle() string {
 
 type UpdateExpectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -872,12 +872,12 @@ func (x *UpdateExpectRequest) GetTitle() string {
 
 type CreateSetRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
-	CourseId        string                 `protobuf:"bytes,2,opt,name=courseId,proto3" json:"courseId"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
-	DateType        string                 `protobuf:"bytes,4,opt,name=dateType,proto3" json:"dateType"`
-	Date            []string               `protobuf:"bytes,5,rep,name=date,proto3" json:"date"`
-	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	CourseId        string                 `protobuf:"bytes,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	DateType        string                 `protobuf:"bytes,4,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Date            []string               `protobuf:"bytes,5,rep,name=date,proto3" json:"date,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -956,13 +956,13 @@ func (x *CreateSetRequest) GetLessonStartTime() string {
 
 type UpdateSetRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id"`
-	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
-	CourseId        string                 `protobuf:"bytes,2,opt,name=courseId,proto3" json:"courseId"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
-	DateType        string                 `protobuf:"bytes,4,opt,name=dateType,proto3" json:"dateType"`
-	Date            []string               `protobuf:"bytes,5,rep,name=date,proto3" json:"date"`
-	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
+	Id              string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	CourseId        string                 `protobuf:"bytes,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	DateType        string                 `protobuf:"bytes,4,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Date            []string               `protobuf:"bytes,5,rep,name=date,proto3" json:"date,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1048,14 +1048,14 @@ func (x *UpdateSetRequest) GetLessonStartTime() string {
 
 type SetDataResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
-	TeacherId       string                 `protobuf:"bytes,2,opt,name=teacherId,proto3" json:"teacherId"`
-	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName"`
-	CourseId        string                 `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId"`
-	CourseName      string                 `protobuf:"bytes,5,opt,name=courseName,proto3" json:"courseName"`
-	DateType        string                 `protobuf:"bytes,6,opt,name=dateType,proto3" json:"dateType"`
-	Dates           []string               `protobuf:"bytes,7,rep,name=dates,proto3" json:"dates"`
-	LessonStartTime string                 `protobuf:"bytes,8,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,2,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	CourseId        string                 `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	CourseName      string                 `protobuf:"bytes,5,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	DateType        string                 `protobuf:"bytes,6,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Dates           []string               `protobuf:"bytes,7,rep,name=dates,proto3" json:"dates,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,8,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1148,16 +1148,18 @@ func (x *SetDataResponse) GetLessonStartTime() string {
 
 type ChangeToSetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	RoomId        string                 `protobuf:"bytes,2,opt,name=roomId,proto3" json:"roomId"`
-	CourseId      string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId"`
-	TeacherId     string                 `protobuf:"bytes,4,opt,name=teacherId,proto3" json:"teacherId"`
-	DateType      string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType"`
-	Days          []string               `protobuf:"bytes,6,rep,name=days,proto3" json:"days"`
-	StartTime     string                 `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime"`
-	StartDate     string                 `protobuf:"bytes,8,opt,name=startDate,proto3" json:"startDate"`
-	EndDate       string                 `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date"`
-	SetId         string                 `protobuf:"bytes,10,opt,name=setId,proto3" json:"setId"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,4,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	DateType      string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Days          []string               `protobuf:"bytes,6,rep,name=days,proto3" json:"days,omitempty"`
+	StartTime     string                 `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	StartDate     string                 `protobuf:"bytes,8,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate       string                 `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	SetId         string                 `protobuf:"bytes,10,opt,name=setId,proto3" json:"setId,omitempty"`
+	ActionById    string                 `protobuf:"bytes,11,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,12,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1262,12 +1264,26 @@ func (x *ChangeToSetRequest) GetSetId() string {
 	return ""
 }
 
+func (x *ChangeToSetRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ChangeToSetRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
 type CreateLeadDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	LeadId        string                 `protobuf:"bytes,3,opt,name=leadId,proto3" json:"leadId"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	LeadId        string                 `protobuf:"bytes,3,opt,name=leadId,proto3" json:"leadId,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1332,12 +1348,12 @@ func (x *CreateLeadDataRequest) GetComment() string {
 
 type UpdateLeadDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id"`
-	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type"`
-	SectionId     string                 `protobuf:"bytes,6,opt,name=sectionId,proto3" json:"sectionId"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	SectionId     string                 `protobuf:"bytes,6,opt,name=sectionId,proto3" json:"sectionId,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1416,8 +1432,8 @@ func (x *UpdateLeadDataRequest) GetComment() string {
 
 type ChangeLeadPlaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeadDataId    string                 `protobuf:"bytes,1,opt,name=leadDataId,proto3" json:"leadDataId"`
-	ChangedSet    *ChangeLeadDataRequest `protobuf:"bytes,2,opt,name=changedSet,proto3" json:"changedSet"`
+	LeadDataId    string                 `protobuf:"bytes,1,opt,name=leadDataId,proto3" json:"leadDataId,omitempty"`
+	ChangedSet    *ChangeLeadDataRequest `protobuf:"bytes,2,opt,name=changedSet,proto3" json:"changedSet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1468,8 +1484,8 @@ func (x *ChangeLeadPlaceRequest) GetChangedSet() *ChangeLeadDataRequest {
 
 type ChangeLeadDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	SectionType   string                 `protobuf:"bytes,2,opt,name=sectionType,proto3" json:"sectionType"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SectionType   string                 `protobuf:"bytes,2,opt,name=sectionType,proto3" json:"sectionType,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1518,6 +1534,380 @@ func (x *ChangeLeadDataRequest) GetSectionType() string {
 	return ""
 }
 
+type MergeLeadDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=targetId,proto3" json:"targetId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeLeadDataRequest) Reset() {
+	*x = MergeLeadDataRequest{}
+	mi := &file_lead_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeLeadDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeLeadDataRequest) ProtoMessage() {}
+
+func (x *MergeLeadDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeLeadDataRequest.ProtoReflect.Descriptor instead.
+func (*MergeLeadDataRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MergeLeadDataRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *MergeLeadDataRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type LeadForm struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Title            string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Fields           []*LeadFormField       `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	DefaultLeadId    string                 `protobuf:"bytes,3,opt,name=defaultLeadId,proto3" json:"defaultLeadId,omitempty"`
+	RateLimitPerHour int32                  `protobuf:"varint,4,opt,name=rateLimitPerHour,proto3" json:"rateLimitPerHour,omitempty"`
+	IsActive         bool                   `protobuf:"varint,5,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	SuccessMessage   string                 `protobuf:"bytes,6,opt,name=successMessage,proto3" json:"successMessage,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeadForm) Reset() {
+	*x = LeadForm{}
+	mi := &file_lead_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadForm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadForm) ProtoMessage() {}
+
+func (x *LeadForm) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadForm.ProtoReflect.Descriptor instead.
+func (*LeadForm) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LeadForm) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LeadForm) GetFields() []*LeadFormField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *LeadForm) GetDefaultLeadId() string {
+	if x != nil {
+		return x.DefaultLeadId
+	}
+	return ""
+}
+
+func (x *LeadForm) GetRateLimitPerHour() int32 {
+	if x != nil {
+		return x.RateLimitPerHour
+	}
+	return 0
+}
+
+func (x *LeadForm) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *LeadForm) GetSuccessMessage() string {
+	if x != nil {
+		return x.SuccessMessage
+	}
+	return ""
+}
+
+type LeadFormField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeadFormField) Reset() {
+	*x = LeadFormField{}
+	mi := &file_lead_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadFormField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadFormField) ProtoMessage() {}
+
+func (x *LeadFormField) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadFormField.ProtoReflect.Descriptor instead.
+func (*LeadFormField) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LeadFormField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeadFormField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *LeadFormField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type PublicLeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Extra         map[string]string      `protobuf:"bytes,4,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	UtmSource     string                 `protobuf:"bytes,6,opt,name=utmSource,proto3" json:"utmSource,omitempty"`
+	UtmMedium     string                 `protobuf:"bytes,7,opt,name=utmMedium,proto3" json:"utmMedium,omitempty"`
+	UtmCampaign   string                 `protobuf:"bytes,8,opt,name=utmCampaign,proto3" json:"utmCampaign,omitempty"`
+	Source        string                 `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,10,opt,name=clientIp,proto3" json:"clientIp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicLeadRequest) Reset() {
+	*x = PublicLeadRequest{}
+	mi := &file_lead_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicLeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicLeadRequest) ProtoMessage() {}
+
+func (x *PublicLeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicLeadRequest.ProtoReflect.Descriptor instead.
+func (*PublicLeadRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PublicLeadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicLeadRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *PublicLeadRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *PublicLeadRequest) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *PublicLeadRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *PublicLeadRequest) GetUtmSource() string {
+	if x != nil {
+		return x.UtmSource
+	}
+	return ""
+}
+
+func (x *PublicLeadRequest) GetUtmMedium() string {
+	if x != nil {
+		return x.UtmMedium
+	}
+	return ""
+}
+
+func (x *PublicLeadRequest) GetUtmCampaign() string {
+	if x != nil {
+		return x.UtmCampaign
+	}
+	return ""
+}
+
+func (x *PublicLeadRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PublicLeadRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type PublicLeadResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Status  int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Deprecated: Marked as deprecated in lead.proto.
+	Duplicate     bool `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicLeadResponse) Reset() {
+	*x = PublicLeadResponse{}
+	mi := &file_lead_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicLeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicLeadResponse) ProtoMessage() {}
+
+func (x *PublicLeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicLeadResponse.ProtoReflect.Descriptor instead.
+func (*PublicLeadResponse) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PublicLeadResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PublicLeadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in lead.proto.
+func (x *PublicLeadResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
 var File_lead_proto protoreflect.FileDescriptor
 
 const file_lead_proto_rawDesc = "" +
@@ -1604,7 +1994,7 @@ const file_lead_proto_rawDesc = "" +
 	"courseName\x12\x1a\n" +
 	"\bdateType\x18\x06 \x01(\tR\bdateType\x12\x14\n" +
 	"\x05dates\x18\a \x03(\tR\x05dates\x12(\n" +
-	"\x0flessonStartTime\x18\b \x01(\tR\x0flessonStartTime\"\x97\x02\n" +
+	"\x0flessonStartTime\x18\b \x01(\tR\x0flessonStartTime\"\xdb\x02\n" +
 	"\x12ChangeToSetRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06roomId\x18\x02 \x01(\tR\x06roomId\x12\x1a\n" +
@@ -1616,7 +2006,11 @@ const file_lead_proto_rawDesc = "" +
 	"\tstartDate\x18\b \x01(\tR\tstartDate\x12\x19\n" +
 	"\bend_date\x18\t \x01(\tR\aendDate\x12\x14\n" +
 	"\x05setId\x18\n" +
-	" \x01(\tR\x05setId\"\x7f\n" +
+	" \x01(\tR\x05setId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\v \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\f \x01(\tR\factionByName\"\x7f\n" +
 	"\x15CreateLeadDataRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vphoneNumber\x18\x02 \x01(\tR\vphoneNumber\x12\x16\n" +
@@ -1638,7 +2032,41 @@ const file_lead_proto_rawDesc = "" +
 	"changedSet\"I\n" +
 	"\x15ChangeLeadDataRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
-	"\vsectionType\x18\x02 \x01(\tR\vsectionType2\xee\x03\n" +
+	"\vsectionType\x18\x02 \x01(\tR\vsectionType\"N\n" +
+	"\x14MergeLeadDataRequest\x12\x1a\n" +
+	"\bsourceId\x18\x01 \x01(\tR\bsourceId\x12\x1a\n" +
+	"\btargetId\x18\x02 \x01(\tR\btargetId\"\xe3\x01\n" +
+	"\bLeadForm\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12+\n" +
+	"\x06fields\x18\x02 \x03(\v2\x13.lead.LeadFormFieldR\x06fields\x12$\n" +
+	"\rdefaultLeadId\x18\x03 \x01(\tR\rdefaultLeadId\x12*\n" +
+	"\x10rateLimitPerHour\x18\x04 \x01(\x05R\x10rateLimitPerHour\x12\x1a\n" +
+	"\bisActive\x18\x05 \x01(\bR\bisActive\x12&\n" +
+	"\x0esuccessMessage\x18\x06 \x01(\tR\x0esuccessMessage\"U\n" +
+	"\rLeadFormField\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\"\x83\x03\n" +
+	"\x11PublicLeadRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vphoneNumber\x18\x02 \x01(\tR\vphoneNumber\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x128\n" +
+	"\x05extra\x18\x04 \x03(\v2\".lead.PublicLeadRequest.ExtraEntryR\x05extra\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsite\x12\x1c\n" +
+	"\tutmSource\x18\x06 \x01(\tR\tutmSource\x12\x1c\n" +
+	"\tutmMedium\x18\a \x01(\tR\tutmMedium\x12 \n" +
+	"\vutmCampaign\x18\b \x01(\tR\vutmCampaign\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06source\x12\x1a\n" +
+	"\bclientIp\x18\n" +
+	" \x01(\tR\bclientIp\x1a8\n" +
+	"\n" +
+	"ExtraEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"h\n" +
+	"\x12PublicLeadResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12 \n" +
+	"\tduplicate\x18\x03 \x01(\bB\x02\x18\x01R\tduplicate2\xee\x03\n" +
 	"\vLeadService\x12:\n" +
 	"\n" +
 	"CreateLead\x12\x17.lead.CreateLeadRequest\x1a\x13.common.AbsResponse\x12H\n" +
@@ -1660,12 +2088,17 @@ const file_lead_proto_rawDesc = "" +
 	"\tUpdateSet\x12\x16.lead.UpdateSetRequest\x1a\x13.common.AbsResponse\x12:\n" +
 	"\tDeleteSet\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12<\n" +
 	"\vChangeToSet\x12\x18.lead.ChangeToSetRequest\x1a\x13.common.AbsResponse\x12:\n" +
-	"\aGetById\x12\x18.common.DeleteAbsRequest\x1a\x15.lead.SetDataResponse2\xa0\x02\n" +
+	"\aGetById\x12\x18.common.DeleteAbsRequest\x1a\x15.lead.SetDataResponse2\xe2\x02\n" +
 	"\x0fLeadDataService\x12B\n" +
 	"\x0eCreateLeadData\x12\x1b.lead.CreateLeadDataRequest\x1a\x13.common.AbsResponse\x12B\n" +
 	"\x0eUpdateLeadData\x12\x1b.lead.UpdateLeadDataRequest\x1a\x13.common.AbsResponse\x12?\n" +
 	"\x0eDeleteLeadData\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12D\n" +
-	"\x0fChangeLeadPlace\x12\x1c.lead.ChangeLeadPlaceRequest\x1a\x13.common.AbsResponseB\x0fZ\rgrpc/proto/pbb\x06proto3"
+	"\x0fChangeLeadPlace\x12\x1c.lead.ChangeLeadPlaceRequest\x1a\x13.common.AbsResponse\x12@\n" +
+	"\rMergeLeadData\x12\x1a.lead.MergeLeadDataRequest\x1a\x13.common.AbsResponse2\xc6\x01\n" +
+	"\x0fLeadFormService\x125\n" +
+	"\vGetLeadForm\x12\x16.google.protobuf.Empty\x1a\x0e.lead.LeadForm\x125\n" +
+	"\x0eUpsertLeadForm\x12\x0e.lead.LeadForm\x1a\x13.common.AbsResponse\x12E\n" +
+	"\x10SubmitPublicLead\x12\x17.lead.PublicLeadRequest\x1a\x18.lead.PublicLeadResponseB\x0fZ\rgrpc/proto/pbb\x06proto3"
 
 var (
 	file_lead_proto_rawDescOnce sync.Once
@@ -1679,7 +2112,7 @@ func file_lead_proto_rawDescGZIP() []byte {
 	return file_lead_proto_rawDescData
 }
 
-var file_lead_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_lead_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_lead_proto_goTypes = []any{
 	(*GetActiveLeadCountResponse)(nil), // 0: lead.GetActiveLeadCountResponse
 	(*GetLeadReportsResponse)(nil),     // 1: lead.GetLeadReportsResponse
@@ -1705,9 +2138,15 @@ var file_lead_proto_goTypes = []any{
 	(*UpdateLeadDataRequest)(nil),      // 21: lead.UpdateLeadDataRequest
 	(*ChangeLeadPlaceRequest)(nil),     // 22: lead.ChangeLeadPlaceRequest
 	(*ChangeLeadDataRequest)(nil),      // 23: lead.ChangeLeadDataRequest
-	(*DeleteAbsRequest)(nil),           // 24: common.DeleteAbsRequest
-	(*emptypb.Empty)(nil),              // 25: google.protobuf.Empty
-	(*AbsResponse)(nil),                // 26: common.AbsResponse
+	(*MergeLeadDataRequest)(nil),       // 24: lead.MergeLeadDataRequest
+	(*LeadForm)(nil),                   // 25: lead.LeadForm
+	(*LeadFormField)(nil),              // 26: lead.LeadFormField
+	(*PublicLeadRequest)(nil),          // 27: lead.PublicLeadRequest
+	(*PublicLeadResponse)(nil),         // 28: lead.PublicLeadResponse
+	nil,                                // 29: lead.PublicLeadRequest.ExtraEntry
+	(*DeleteAbsRequest)(nil),           // 30: common.DeleteAbsRequest
+	(*emptypb.Empty)(nil),              // 31: google.protobuf.Empty
+	(*AbsResponse)(nil),                // 32: common.AbsResponse
 }
 var file_lead_proto_depIdxs = []int32{
 	2,  // 0: lead.GetLeadReportsResponse.leadConversion:type_name -> lead.LeadConversion
@@ -1719,49 +2158,59 @@ var file_lead_proto_depIdxs = []int32{
 	10, // 6: lead.Section.leads:type_name -> lead.Lead
 	13, // 7: lead.GetLeadListResponse.sections:type_name -> lead.DynamicSection
 	23, // 8: lead.ChangeLeadPlaceRequest.changedSet:type_name -> lead.ChangeLeadDataRequest
-	5,  // 9: lead.LeadService.CreateLead:input_type -> lead.CreateLeadRequest
-	7,  // 10: lead.LeadService.GetLeadCommon:input_type -> lead.GetLeadCommonRequest
-	11, // 11: lead.LeadService.UpdateLead:input_type -> lead.UpdateLeadRequest
-	24, // 12: lead.LeadService.DeleteLead:input_type -> common.DeleteAbsRequest
-	25, // 13: lead.LeadService.GetListSection:input_type -> google.protobuf.Empty
-	4,  // 14: lead.LeadService.GetLeadReports:input_type -> lead.GetLeadReportsRequest
-	25, // 15: lead.LeadService.GetActiveLeadCount:input_type -> google.protobuf.Empty
-	14, // 16: lead.ExpectService.CreateExpect:input_type -> lead.CreateExpectRequest
-	15, // 17: lead.ExpectService.UpdateExpect:input_type -> lead.UpdateExpectRequest
-	24, // 18: lead.ExpectService.DeleteExpect:input_type -> common.DeleteAbsRequest
-	16, // 19: lead.SetService.CreateSet:input_type -> lead.CreateSetRequest
-	17, // 20: lead.SetService.UpdateSet:input_type -> lead.UpdateSetRequest
-	24, // 21: lead.SetService.DeleteSet:input_type -> common.DeleteAbsRequest
-	19, // 22: lead.SetService.ChangeToSet:input_type -> lead.ChangeToSetRequest
-	24, // 23: lead.SetService.GetById:input_type -> common.DeleteAbsRequest
-	20, // 24: lead.LeadDataService.CreateLeadData:input_type -> lead.CreateLeadDataRequest
-	21, // 25: lead.LeadDataService.UpdateLeadData:input_type -> lead.UpdateLeadDataRequest
-	24, // 26: lead.LeadDataService.DeleteLeadData:input_type -> common.DeleteAbsRequest
-	22, // 27: lead.LeadDataService.ChangeLeadPlace:input_type -> lead.ChangeLeadPlaceRequest
-	26, // 28: lead.LeadService.CreateLead:output_type -> common.AbsResponse
-	6,  // 29: lead.LeadService.GetLeadCommon:output_type -> lead.GetLeadCommonResponse
-	26, // 30: lead.LeadService.UpdateLead:output_type -> common.AbsResponse
-	26, // 31: lead.LeadService.DeleteLead:output_type -> common.AbsResponse
-	12, // 32: lead.LeadService.GetListSection:output_type -> lead.GetLeadListResponse
-	1,  // 33: lead.LeadService.GetLeadReports:output_type -> lead.GetLeadReportsResponse
-	0,  // 34: lead.LeadService.GetActiveLeadCount:output_type -> lead.GetActiveLeadCountResponse
-	26, // 35: lead.ExpectService.CreateExpect:output_type -> common.AbsResponse
-	26, // 36: lead.ExpectService.UpdateExpect:output_type -> common.AbsResponse
-	26, // 37: lead.ExpectService.DeleteExpect:output_type -> common.AbsResponse
-	26, // 38: lead.SetService.CreateSet:output_type -> common.AbsResponse
-	26, // 39: lead.SetService.UpdateSet:output_type -> common.AbsResponse
-	26, // 40: lead.SetService.DeleteSet:output_type -> common.AbsResponse
-	26, // 41: lead.SetService.ChangeToSet:output_type -> common.AbsResponse
-	18, // 42: lead.SetService.GetById:output_type -> lead.SetDataResponse
-	26, // 43: lead.LeadDataService.CreateLeadData:output_type -> common.AbsResponse
-	26, // 44: lead.LeadDataService.UpdateLeadData:output_type -> common.AbsResponse
-	26, // 45: lead.LeadDataService.DeleteLeadData:output_type -> common.AbsResponse
-	26, // 46: lead.LeadDataService.ChangeLeadPlace:output_type -> common.AbsResponse
-	28, // [28:47] is the sub-list for method output_type
-	9,  // [9:28] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	26, // 9: lead.LeadForm.fields:type_name -> lead.LeadFormField
+	29, // 10: lead.PublicLeadRequest.extra:type_name -> lead.PublicLeadRequest.ExtraEntry
+	5,  // 11: lead.LeadService.CreateLead:input_type -> lead.CreateLeadRequest
+	7,  // 12: lead.LeadService.GetLeadCommon:input_type -> lead.GetLeadCommonRequest
+	11, // 13: lead.LeadService.UpdateLead:input_type -> lead.UpdateLeadRequest
+	30, // 14: lead.LeadService.DeleteLead:input_type -> common.DeleteAbsRequest
+	31, // 15: lead.LeadService.GetListSection:input_type -> google.protobuf.Empty
+	4,  // 16: lead.LeadService.GetLeadReports:input_type -> lead.GetLeadReportsRequest
+	31, // 17: lead.LeadService.GetActiveLeadCount:input_type -> google.protobuf.Empty
+	14, // 18: lead.ExpectService.CreateExpect:input_type -> lead.CreateExpectRequest
+	15, // 19: lead.ExpectService.UpdateExpect:input_type -> lead.UpdateExpectRequest
+	30, // 20: lead.ExpectService.DeleteExpect:input_type -> common.DeleteAbsRequest
+	16, // 21: lead.SetService.CreateSet:input_type -> lead.CreateSetRequest
+	17, // 22: lead.SetService.UpdateSet:input_type -> lead.UpdateSetRequest
+	30, // 23: lead.SetService.DeleteSet:input_type -> common.DeleteAbsRequest
+	19, // 24: lead.SetService.ChangeToSet:input_type -> lead.ChangeToSetRequest
+	30, // 25: lead.SetService.GetById:input_type -> common.DeleteAbsRequest
+	20, // 26: lead.LeadDataService.CreateLeadData:input_type -> lead.CreateLeadDataRequest
+	21, // 27: lead.LeadDataService.UpdateLeadData:input_type -> lead.UpdateLeadDataRequest
+	30, // 28: lead.LeadDataService.DeleteLeadData:input_type -> common.DeleteAbsRequest
+	22, // 29: lead.LeadDataService.ChangeLeadPlace:input_type -> lead.ChangeLeadPlaceRequest
+	24, // 30: lead.LeadDataService.MergeLeadData:input_type -> lead.MergeLeadDataRequest
+	31, // 31: lead.LeadFormService.GetLeadForm:input_type -> google.protobuf.Empty
+	25, // 32: lead.LeadFormService.UpsertLeadForm:input_type -> lead.LeadForm
+	27, // 33: lead.LeadFormService.SubmitPublicLead:input_type -> lead.PublicLeadRequest
+	32, // 34: lead.LeadService.CreateLead:output_type -> common.AbsResponse
+	6,  // 35: lead.LeadService.GetLeadCommon:output_type -> lead.GetLeadCommonResponse
+	32, // 36: lead.LeadService.UpdateLead:output_type -> common.AbsResponse
+	32, // 37: lead.LeadService.DeleteLead:output_type -> common.AbsResponse
+	12, // 38: lead.LeadService.GetListSection:output_type -> lead.GetLeadListResponse
+	1,  // 39: lead.LeadService.GetLeadReports:output_type -> lead.GetLeadReportsResponse
+	0,  // 40: lead.LeadService.GetActiveLeadCount:output_type -> lead.GetActiveLeadCountResponse
+	32, // 41: lead.ExpectService.CreateExpect:output_type -> common.AbsResponse
+	32, // 42: lead.ExpectService.UpdateExpect:output_type -> common.AbsResponse
+	32, // 43: lead.ExpectService.DeleteExpect:output_type -> common.AbsResponse
+	32, // 44: lead.SetService.CreateSet:output_type -> common.AbsResponse
+	32, // 45: lead.SetService.UpdateSet:output_type -> common.AbsResponse
+	32, // 46: lead.SetService.DeleteSet:output_type -> common.AbsResponse
+	32, // 47: lead.SetService.ChangeToSet:output_type -> common.AbsResponse
+	18, // 48: lead.SetService.GetById:output_type -> lead.SetDataResponse
+	32, // 49: lead.LeadDataService.CreateLeadData:output_type -> common.AbsResponse
+	32, // 50: lead.LeadDataService.UpdateLeadData:output_type -> common.AbsResponse
+	32, // 51: lead.LeadDataService.DeleteLeadData:output_type -> common.AbsResponse
+	32, // 52: lead.LeadDataService.ChangeLeadPlace:output_type -> common.AbsResponse
+	32, // 53: lead.LeadDataService.MergeLeadData:output_type -> common.AbsResponse
+	25, // 54: lead.LeadFormService.GetLeadForm:output_type -> lead.LeadForm
+	32, // 55: lead.LeadFormService.UpsertLeadForm:output_type -> common.AbsResponse
+	28, // 56: lead.LeadFormService.SubmitPublicLead:output_type -> lead.PublicLeadResponse
+	34, // [34:57] is the sub-list for method output_type
+	11, // [11:34] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_lead_proto_init() }
@@ -1776,9 +2225,9 @@ func file_lead_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lead_proto_rawDesc), len(file_lead_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   30,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   5,
 		},
 		GoTypes:           file_lead_proto_goTypes,
 		DependencyIndexes: file_lead_proto_depIdxs,
@@ -798,6 +798,7 @@ const (
 	LeadDataService_UpdateLeadData_FullMethodName  = "/lead.LeadDataService/UpdateLeadData"
 	LeadDataService_DeleteLeadData_FullMethodName  = "/lead.LeadDataService/DeleteLeadData"
 	LeadDataService_ChangeLeadPlace_FullMethodName = "/lead.LeadDataService/ChangeLeadPlace"
+	LeadDataService_MergeLeadData_FullMethodName   = "/lead.LeadDataService/MergeLeadData"
 )
 
 // LeadDataServiceClient is the client API for LeadDataService service.
@@ -810,6 +811,7 @@ type LeadDataServiceClient interface {
 	UpdateLeadData(ctx context.Context, in *UpdateLeadDataRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	DeleteLeadData(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	ChangeLeadPlace(ctx context.Context, in *ChangeLeadPlaceRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	MergeLeadData(ctx context.Context, in *MergeLeadDataRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
 type leadDataServiceClient struct {
@@ -860,6 +862,16 @@ func (c *leadDataServiceClient) ChangeLeadPlace(ctx context.Context, in *ChangeL
 	return out, nil
 }
 
+func (c *leadDataServiceClient) MergeLeadData(ctx context.Context, in *MergeLeadDataRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, LeadDataService_MergeLeadData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeadDataServiceServer is the server API for LeadDataService service.
 // All implementations must embed UnimplementedLeadDataServiceServer
 // for forward compatibility.
@@ -870,6 +882,7 @@ type LeadDataServiceServer interface {
 	UpdateLeadData(context.Context, *UpdateLeadDataRequest) (*AbsResponse, error)
 	DeleteLeadData(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
 	ChangeLeadPlace(context.Context, *ChangeLeadPlaceRequest) (*AbsResponse, error)
+	MergeLeadData(context.Context, *MergeLeadDataRequest) (*AbsResponse, error)
 	mustEmbedUnimplementedLeadDataServiceServer()
 }
 
@@ -892,6 +905,9 @@ func (UnimplementedLeadDataServiceServer) DeleteLeadData(context.Context, *Delet
 func (UnimplementedLeadDataServiceServer) ChangeLeadPlace(context.Context, *ChangeLeadPlaceRequest) (*AbsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeLeadPlace not implemented")
 }
+func (UnimplementedLeadDataServiceServer) MergeLeadData(context.Context, *MergeLeadDataRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeLeadData not implemented")
+}
 func (UnimplementedLeadDataServiceServer) mustEmbedUnimplementedLeadDataServiceServer() {}
 func (UnimplementedLeadDataServiceServer) testEmbeddedByValue()                         {}
 
@@ -985,6 +1001,24 @@ func _LeadDataService_ChangeLeadPlace_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeadDataService_MergeLeadData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeLeadDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadDataServiceServer).MergeLeadData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadDataService_MergeLeadData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadDataServiceServer).MergeLeadData(ctx, req.(*MergeLeadDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeadDataService_ServiceDesc is the grpc.ServiceDesc for LeadDataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1008,6 +1042,192 @@ var LeadDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeLeadPlace",
 			Handler:    _LeadDataService_ChangeLeadPlace_Handler,
 		},
+		{
+			MethodName: "MergeLeadData",
+			Handler:    _LeadDataService_MergeLeadData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lead.proto",
+}
+
+const (
+	LeadFormService_GetLeadForm_FullMethodName      = "/lead.LeadFormService/GetLeadForm"
+	LeadFormService_UpsertLeadForm_FullMethodName   = "/lead.LeadFormService/UpsertLeadForm"
+	LeadFormService_SubmitPublicLead_FullMethodName = "/lead.LeadFormService/SubmitPublicLead"
+)
+
+// LeadFormServiceClient is the client API for LeadFormService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// lead_form_service_start
+type LeadFormServiceClient interface {
+	GetLeadForm(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LeadForm, error)
+	UpsertLeadForm(ctx context.Context, in *LeadForm, opts ...grpc.CallOption) (*AbsResponse, error)
+	SubmitPublicLead(ctx context.Context, in *PublicLeadRequest, opts ...grpc.CallOption) (*PublicLeadResponse, error)
+}
+
+type leadFormServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeadFormServiceClient(cc grpc.ClientConnInterface) LeadFormServiceClient {
+	return &leadFormServiceClient{cc}
+}
+
+func (c *leadFormServiceClient) GetLeadForm(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LeadForm, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeadForm)
+	err := c.cc.Invoke(ctx, LeadFormService_GetLeadForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadFormServiceClient) UpsertLeadForm(ctx context.Context, in *LeadForm, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, LeadFormService_UpsertLeadForm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadFormServiceClient) SubmitPublicLead(ctx context.Context, in *PublicLeadRequest, opts ...grpc.CallOption) (*PublicLeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublicLeadResponse)
+	err := c.cc.Invoke(ctx, LeadFormService_SubmitPublicLead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeadFormServiceServer is the server API for LeadFormService service.
+// All implementations must embed UnimplementedLeadFormServiceServer
+// for forward compatibility.
+//
+// lead_form_service_start
+type LeadFormServiceServer interface {
+	GetLeadForm(context.Context, *emptypb.Empty) (*LeadForm, error)
+	UpsertLeadForm(context.Context, *LeadForm) (*AbsResponse, error)
+	SubmitPublicLead(context.Context, *PublicLeadRequest) (*PublicLeadResponse, error)
+	mustEmbedUnimplementedLeadFormServiceServer()
+}
+
+// UnimplementedLeadFormServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLeadFormServiceServer struct{}
+
+func (UnimplementedLeadFormServiceServer) GetLeadForm(context.Context, *emptypb.Empty) (*LeadForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeadForm not implemented")
+}
+func (UnimplementedLeadFormServiceServer) UpsertLeadForm(context.Context, *LeadForm) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertLeadForm not implemented")
+}
+func (UnimplementedLeadFormServiceServer) SubmitPublicLead(context.Context, *PublicLeadRequest) (*PublicLeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPublicLead not implemented")
+}
+func (UnimplementedLeadFormServiceServer) mustEmbedUnimplementedLeadFormServiceServer() {}
+func (UnimplementedLeadFormServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeLeadFormServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeadFormServiceServer will
+// result in compilation errors.
+type UnsafeLeadFormServiceServer interface {
+	mustEmbedUnimplementedLeadFormServiceServer()
+}
+
+func RegisterLeadFormServiceServer(s grpc.ServiceRegistrar, srv LeadFormServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLeadFormServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LeadFormService_ServiceDesc, srv)
+}
+
+func _LeadFormService_GetLeadForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadFormServiceServer).GetLeadForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadFormService_GetLeadForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadFormServiceServer).GetLeadForm(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadFormService_UpsertLeadForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeadForm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadFormServiceServer).UpsertLeadForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadFormService_UpsertLeadForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadFormServiceServer).UpsertLeadForm(ctx, req.(*LeadForm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadFormService_SubmitPublicLead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicLeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadFormServiceServer).SubmitPublicLead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadFormService_SubmitPublicLead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadFormServiceServer).SubmitPublicLead(ctx, req.(*PublicLeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeadFormService_ServiceDesc is the grpc.ServiceDesc for LeadFormService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeadFormService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lead.LeadFormService",
+	HandlerType: (*LeadFormServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLeadForm",
+			Handler:    _LeadFormService_GetLeadForm_Handler,
+		},
+		{
+			MethodName: "UpsertLeadForm",
+			Handler:    _LeadFormService_UpsertLeadForm_Handler,
+		},
+		{
+			MethodName: "SubmitPublicLead",
+			Handler:    _LeadFormService_SubmitPublicLead_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lead.proto",
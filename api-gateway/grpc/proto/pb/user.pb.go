@@ -578,13 +578,15 @@ func (x *GetUserByIdResponse) GetHasAccessFinance() bool {
 }
 
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullName      string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName"`
-	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role"`
-	BirthDate     string                 `protobuf:"bytes,5,opt,name=birthDate,proto3" json:"birthDate"`
-	Gender        bool                   `protobuf:"varint,6,opt,name=gender,proto3" json:"gender"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FullName    string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName"`
+	PhoneNumber string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Password    string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
+	Role        string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role"`
+	BirthDate   string                 `protobuf:"bytes,5,opt,name=birthDate,proto3" json:"birthDate"`
+	Gender      bool                   `protobuf:"varint,6,opt,name=gender,proto3" json:"gender"`
+	// optional, generated when empty; lets a caller retry or undo the create
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -661,6 +663,13 @@ func (x *CreateUserRequest) GetGender() bool {
 	return false
 }
 
+func (x *CreateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type GetTeachersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsDeleted     bool                   `protobuf:"varint,1,opt,name=isDeleted,proto3" json:"isDeleted"`
@@ -1036,14 +1045,15 @@ const file_user_proto_rawDesc = "" +
 	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\x12\x1c\n" +
 	"\tcompanyId\x18\t \x01(\x05R\tcompanyId\x12,\n" +
 	"\x12has_access_finance\x18\n" +
-	" \x01(\bR\x10hasAccessFinance\"\xb7\x01\n" +
+	" \x01(\bR\x10hasAccessFinance\"\xc7\x01\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\bfullName\x18\x01 \x01(\tR\bfullName\x12 \n" +
 	"\vphoneNumber\x18\x02 \x01(\tR\vphoneNumber\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1c\n" +
 	"\tbirthDate\x18\x05 \x01(\tR\tbirthDate\x12\x16\n" +
-	"\x06gender\x18\x06 \x01(\bR\x06gender\"2\n" +
+	"\x06gender\x18\x06 \x01(\bR\x06gender\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\"2\n" +
 	"\x12GetTeachersRequest\x12\x1c\n" +
 	"\tisDeleted\x18\x01 \x01(\bR\tisDeleted\"C\n" +
 	"\x13GetTeachersResponse\x12,\n" +
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"api-gateway/internal/metrics"
+	"api-gateway/internal/tracing"
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCallTimeout = 30 * time.Second
+	attemptTimeout     = 10 * time.Second
+	maxAttempts        = 3
+	retryBaseDelay     = 200 * time.Millisecond
+	breakerThreshold   = 5
+	breakerCooldown    = 30 * time.Second
+)
+
+// serviceConfig turns on client side health checking, a backend reporting
+// NOT_SERVING is skipped until it recovers. Servers without the health
+// service are treated as healthy.
+const serviceConfig = `{
+	"loadBalancingConfig": [{"round_robin": {}}],
+	"healthCheckConfig": {"serviceName": ""}
+}`
+
+// Dial returns a connection to the named peer. It does not wait for the
+// peer: the connection is made on the first call and re-made whenever it
+// drops, so services can start in any order. Calls without a deadline get
+// one, read calls (Get*) are retried with backoff while the peer is
+// unavailable, and after repeated failures the circuit opens and calls fail
+// fast for a while instead of piling up.
+func Dial(name, addr string) (*grpc.ClientConn, error) {
+	breaker := &circuitBreaker{name: name}
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithStatsHandler(tracing.ClientHandler()),
+		grpc.WithChainUnaryInterceptor(metrics.UnaryClientInterceptor(name), deadlineInterceptor, breaker.intercept, retryInterceptor),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s client: %w", name, err)
+	}
+	return conn, nil
+}
+
+func deadlineInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultCallTimeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// isIdempotent reports whether a call may be sent again. Only reads qualify,
+// writes are sent once and their outcome is left to the caller.
+func isIdempotent(method string) bool {
+	return strings.HasPrefix(method[strings.LastIndex(method, "/")+1:], "Get")
+}
+
+func retryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !isIdempotent(method) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := retryBaseDelay << (attempt - 1)
+			delay += time.Duration(rand.Int63n(int64(delay)))
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(delay):
+			}
+		}
+		attemptCtx, cancel := context.WithTimeout(ctx, attemptTimeout)
+		err = invoker(attemptCtx, method, req, reply, cc, opts...)
+		cancel()
+		if !isTransient(err) || ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// circuitBreaker opens after breakerThreshold calls in a row failed with a
+// transient error. While open every call fails with Unavailable; after the
+// cooldown one call is let through and its result closes or re-opens it.
+type circuitBreaker struct {
+	name      string
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *circuitBreaker) intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !b.allow() {
+		return status.Errorf(codes.Unavailable, "%s is unavailable, try again later", b.name)
+	}
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	b.record(isTransient(err))
+	return err
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < breakerThreshold {
+		return true
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *circuitBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	wasOpen := b.failures >= breakerThreshold
+	b.probing = false
+	if !failed {
+		if wasOpen {
+			slog.Info("peer is reachable again, circuit closed", "peer", b.name)
+		}
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= breakerThreshold {
+		if !wasOpen {
+			slog.Warn("peer failed too many calls in a row, circuit open", "peer", b.name, "failures", b.failures, "cooldown", breakerCooldown.String())
+		}
+		b.openUntil = time.Now().Add(breakerCooldown)
+	}
+}
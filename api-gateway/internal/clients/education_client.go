@@ -3,7 +3,7 @@ package client
 import (
 	"api-gateway/grpc/proto/pb"
 	"context"
-	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"strconv"
 )
@@ -15,12 +15,19 @@ type EducationClient struct {
 	attendanceClient     pb.AttendanceServiceClient
 	studentClient        pb.StudentServiceClient
 	companyClient        pb.CompanyServiceClient
+	signupClient         pb.SignupServiceClient
 	tariffClient         pb.TariffServiceClient
 	companyFinanceClient pb.CompanyFinanceServiceClient
+	billingClient        pb.BillingServiceClient
+	analyticsClient      pb.AnalyticsServiceClient
+	notificationClient   pb.NotificationServiceClient
+	debtReminderClient   pb.DebtReminderServiceClient
+	reconciliationClient pb.ReconciliationServiceClient
+	health               healthpb.HealthClient
 }
 
 func NewEducationClient(addr string) (*EducationClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("education-service", addr)
 	if err != nil {
 		return nil, err
 	}
@@ -31,9 +38,15 @@ func NewEducationClient(addr string) (*EducationClient, error) {
 	attendanceClient := pb.NewAttendanceServiceClient(conn)
 	studentClient := pb.NewStudentServiceClient(conn)
 	companyClient := pb.NewCompanyServiceClient(conn)
+	signupClient := pb.NewSignupServiceClient(conn)
 	tariffClient := pb.NewTariffServiceClient(conn)
 	companyFinanceClient := pb.NewCompanyFinanceServiceClient(conn)
-	return &EducationClient{roomClient: roomClient, courseClient: courseClient, groupClient: groupClient, attendanceClient: attendanceClient, studentClient: studentClient, companyClient: companyClient, tariffClient: tariffClient, companyFinanceClient: companyFinanceClient}, nil
+	billingClient := pb.NewBillingServiceClient(conn)
+	analyticsClient := pb.NewAnalyticsServiceClient(conn)
+	notificationClient := pb.NewNotificationServiceClient(conn)
+	debtReminderClient := pb.NewDebtReminderServiceClient(conn)
+	reconciliationClient := pb.NewReconciliationServiceClient(conn)
+	return &EducationClient{roomClient: roomClient, courseClient: courseClient, groupClient: groupClient, attendanceClient: attendanceClient, studentClient: studentClient, companyClient: companyClient, signupClient: signupClient, tariffClient: tariffClient, companyFinanceClient: companyFinanceClient, billingClient: billingClient, analyticsClient: analyticsClient, notificationClient: notificationClient, debtReminderClient: debtReminderClient, reconciliationClient: reconciliationClient, health: healthpb.NewHealthClient(conn)}, nil
 }
 
 // Education Service method client
@@ -159,6 +172,14 @@ func (lc *EducationClient) SearchStudentByPhoneName(ctx context.Context, value s
 	return lc.studentClient.SearchStudent(ctx, &pb.SearchStudentRequest{Value: value})
 }
 
+func (lc *EducationClient) FindStudentsByPhone(ctx context.Context, phoneNumber string) (*pb.SearchStudentResponse, error) {
+	return lc.studentClient.FindStudentsByPhone(ctx, &pb.FindStudentsByPhoneRequest{PhoneNumber: phoneNumber})
+}
+
+func (lc *EducationClient) MergeStudents(ctx context.Context, req *pb.MergeStudentsRequest) (*pb.AbsResponse, error) {
+	return lc.studentClient.MergeStudents(ctx, req)
+}
+
 func (lc *EducationClient) GetHistoryGroupById(ctx context.Context, value string) (*pb.GetHistoryGroupResponse, error) {
 	return lc.studentClient.GetHistoryGroupById(ctx, &pb.NoteStudentByAbsRequest{Id: value})
 }
@@ -276,6 +297,115 @@ func (lc *EducationClient) FinanceUpdate(req *pb.CompanyFinance) (*pb.CompanyFin
 	return lc.companyFinanceClient.UpdateByCompany(context.TODO(), req)
 }
 
+func (lc *EducationClient) Quote(ctx context.Context, req *pb.QuoteRequest) (*pb.TariffQuote, error) {
+	return lc.billingClient.Quote(ctx, req)
+}
+
+func (lc *EducationClient) CreateInvoice(ctx context.Context, req *pb.QuoteRequest) (*pb.Invoice, error) {
+	return lc.billingClient.CreateInvoice(ctx, req)
+}
+
+func (lc *EducationClient) GetInvoices(ctx context.Context, req *pb.GetInvoicesRequest) (*pb.InvoiceList, error) {
+	return lc.billingClient.GetInvoices(ctx, req)
+}
+
+func (lc *EducationClient) VoidInvoice(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return lc.billingClient.VoidInvoice(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+
+func (lc *EducationClient) CreatePromoCode(ctx context.Context, req *pb.PromoCode) (*pb.PromoCode, error) {
+	return lc.billingClient.CreatePromoCode(ctx, req)
+}
+
+func (lc *EducationClient) GetPromoCodes(ctx context.Context) (*pb.PromoCodeList, error) {
+	return lc.billingClient.GetPromoCodes(ctx, &emptypb.Empty{})
+}
+
+func (lc *EducationClient) DeactivatePromoCode(ctx context.Context, code string) (*pb.AbsResponse, error) {
+	return lc.billingClient.DeactivatePromoCode(ctx, &pb.PromoCode{Code: code})
+}
+
+func (lc *EducationClient) SetPriceOverride(ctx context.Context, req *pb.PriceOverride) (*pb.PriceOverride, error) {
+	return lc.billingClient.SetPriceOverride(ctx, req)
+}
+
+func (lc *EducationClient) GetPriceOverride(ctx context.Context, companyId string) (*pb.PriceOverride, error) {
+	return lc.billingClient.GetPriceOverride(ctx, &pb.GetPriceOverrideRequest{CompanyId: companyId})
+}
+
+func (lc *EducationClient) GetPlatformMetrics(ctx context.Context, from, to string) (*pb.PlatformMetrics, error) {
+	return lc.analyticsClient.GetPlatformMetrics(ctx, &pb.PlatformMetricsRequest{From: from, To: to})
+}
+
+func (lc *EducationClient) GetTenantHealth(ctx context.Context, req *pb.TenantHealthRequest) (*pb.TenantHealthList, error) {
+	return lc.analyticsClient.GetTenantHealth(ctx, req)
+}
+
+func (lc *EducationClient) GetPlatformHistory(ctx context.Context, from, to string) (*pb.PlatformHistory, error) {
+	return lc.analyticsClient.GetPlatformHistory(ctx, &pb.HistoryRequest{From: from, To: to})
+}
+
+func (lc *EducationClient) GetTenantHistory(ctx context.Context, companyId int32, from, to string) (*pb.TenantHealthList, error) {
+	return lc.analyticsClient.GetTenantHistory(ctx, &pb.HistoryRequest{CompanyId: companyId, From: from, To: to})
+}
+
+func (lc *EducationClient) CheckSubdomain(ctx context.Context, subdomain string) (*pb.CheckSubdomainResponse, error) {
+	return lc.signupClient.CheckSubdomain(ctx, &pb.CheckSubdomainRequest{Subdomain: subdomain})
+}
+
+func (lc *EducationClient) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.SignupResponse, error) {
+	return lc.signupClient.Signup(ctx, req)
+}
+
+func (lc *EducationClient) GetSubscription(ctx context.Context) (*pb.CompanySubscription, error) {
+	return lc.companyClient.GetSubscription(ctx, &pb.GetSubscriptionRequest{})
+}
+
 func (lc *EducationClient) GetStatisticCompany(req *pb.GetStatisticRequest) (*pb.GetStatisticResponse, error) {
 	return lc.companyClient.GetStatistic(context.TODO(), req)
 }
+
+func (lc *EducationClient) GetNotificationSettings(ctx context.Context) (*pb.NotificationSettings, error) {
+	return lc.notificationClient.GetNotificationSettings(ctx, &emptypb.Empty{})
+}
+
+func (lc *EducationClient) UpdateNotificationSettings(ctx context.Context, req *pb.NotificationSettings) (*pb.AbsResponse, error) {
+	return lc.notificationClient.UpdateNotificationSettings(ctx, req)
+}
+
+func (lc *EducationClient) GetNotificationOutbox(ctx context.Context, status string, page, size int32) (*pb.GetNotificationOutboxResponse, error) {
+	return lc.notificationClient.GetNotificationOutbox(ctx, &pb.GetNotificationOutboxRequest{Status: status, Page: page, Size: size})
+}
+
+func (lc *EducationClient) GetDebtReminderSettings(ctx context.Context) (*pb.DebtReminderSettings, error) {
+	return lc.debtReminderClient.GetDebtReminderSettings(ctx, &emptypb.Empty{})
+}
+
+func (lc *EducationClient) UpdateDebtReminderSettings(ctx context.Context, req *pb.DebtReminderSettings) (*pb.AbsResponse, error) {
+	return lc.debtReminderClient.UpdateDebtReminderSettings(ctx, req)
+}
+
+func (lc *EducationClient) GetDebtReminders(ctx context.Context, status string, page, size int32) (*pb.GetDebtRemindersResponse, error) {
+	return lc.debtReminderClient.GetDebtReminders(ctx, &pb.GetDebtRemindersRequest{Status: status, Page: page, Size: size})
+}
+
+func (lc *EducationClient) SetDebtReminderReply(ctx context.Context, req *pb.SetDebtReminderReplyRequest) (*pb.AbsResponse, error) {
+	return lc.debtReminderClient.SetDebtReminderReply(ctx, req)
+}
+
+func (lc *EducationClient) RunBalanceReconciliation(ctx context.Context, req *pb.RunBalanceReconciliationRequest) (*pb.ReconciliationReport, error) {
+	return lc.reconciliationClient.RunBalanceReconciliation(ctx, req)
+}
+
+func (lc *EducationClient) GetReconciliationReports(ctx context.Context, page, size int32) (*pb.GetReconciliationReportsResponse, error) {
+	return lc.reconciliationClient.GetReconciliationReports(ctx, &pb.GetReconciliationReportsRequest{Page: page, Size: size})
+}
+
+func (lc *EducationClient) GetReconciliationReport(ctx context.Context, id string) (*pb.ReconciliationReport, error) {
+	return lc.reconciliationClient.GetReconciliationReport(ctx, &pb.GetReconciliationReportRequest{Id: id})
+}
+
+// Check reports whether the service answers its health check as SERVING.
+func (lc *EducationClient) Check(ctx context.Context) error {
+	return checkHealth(ctx, lc.health)
+}
@@ -5,7 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/spf13/cast"
-	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"strconv"
 )
@@ -16,6 +16,11 @@ type FinanceClient struct {
 	expenseClient       pb.ExpenseServiceClient
 	paymentClient       pb.PaymentServiceClient
 	teacherSalaryClient pb.TeacherSalaryServiceClient
+	sponsorClient       pb.SponsorServiceClient
+	planClient          pb.PaymentPlanServiceClient
+	cashClient          pb.CashServiceClient
+	ledgerClient        pb.LedgerServiceClient
+	health              healthpb.HealthClient
 }
 
 func (fc *FinanceClient) GetDiscountsInformationByGroupId(ctx context.Context, groupId string) (*pb.GetInformationDiscountResponse, error) {
@@ -24,14 +29,36 @@ func (fc *FinanceClient) GetDiscountsInformationByGroupId(ctx context.Context, g
 func (fc *FinanceClient) CreateDiscount(ctx context.Context, req *pb.AbsDiscountRequest) (*pb.AbsResponse, error) {
 	return fc.discountClient.CreateDiscount(ctx, req)
 }
-func (fc *FinanceClient) DeleteDiscount(ctx context.Context, groupId string, studentId string) (*pb.AbsResponse, error) {
+func (fc *FinanceClient) DeleteDiscount(ctx context.Context, groupId string, studentId string, id string) (*pb.AbsResponse, error) {
 	return fc.discountClient.DeleteDiscount(ctx, &pb.AbsDiscountRequest{
 		GroupId:       groupId,
 		StudentId:     studentId,
 		DiscountPrice: "",
 		Comment:       "",
+		Id:            id,
 	})
 }
+func (fc *FinanceClient) CreateDiscountRule(ctx context.Context, req *pb.DiscountRule) (*pb.DiscountRule, error) {
+	return fc.discountClient.CreateDiscountRule(ctx, req)
+}
+func (fc *FinanceClient) UpdateDiscountRule(ctx context.Context, req *pb.DiscountRule) (*pb.DiscountRule, error) {
+	return fc.discountClient.UpdateDiscountRule(ctx, req)
+}
+func (fc *FinanceClient) DeleteDiscountRule(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return fc.discountClient.DeleteDiscountRule(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+func (fc *FinanceClient) GetDiscountRules(ctx context.Context) (*pb.DiscountRuleList, error) {
+	return fc.discountClient.GetDiscountRules(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) GetDiscountPolicy(ctx context.Context) (*pb.DiscountPolicy, error) {
+	return fc.discountClient.GetDiscountPolicy(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) SetDiscountPolicy(ctx context.Context, req *pb.DiscountPolicy) (*pb.DiscountPolicy, error) {
+	return fc.discountClient.SetDiscountPolicy(ctx, req)
+}
+func (fc *FinanceClient) GetRuleApplications(ctx context.Context, req *pb.GetRuleApplicationsRequest) (*pb.RuleApplicationList, error) {
+	return fc.discountClient.GetRuleApplications(ctx, req)
+}
 func (fc *FinanceClient) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.AbsResponse, error) {
 	return fc.categoryClient.CreateCategory(ctx, req)
 }
@@ -71,6 +98,12 @@ func (fc *FinanceClient) GetExpenseChartDiagram(from string, to string, ctx cont
 func (fc *FinanceClient) PaymentAdd(ctx context.Context, req *pb.PaymentAddRequest) (*pb.AbsResponse, error) {
 	return fc.paymentClient.PaymentAdd(ctx, req)
 }
+func (fc *FinanceClient) GetFailedBalanceEvents(ctx context.Context) (*pb.BalanceEventList, error) {
+	return fc.paymentClient.GetFailedBalanceEvents(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) RetryBalanceEvents(ctx context.Context, req *pb.RetryBalanceEventsRequest) (*pb.RetryBalanceEventsResponse, error) {
+	return fc.paymentClient.RetryBalanceEvents(ctx, req)
+}
 func (fc *FinanceClient) PaymentReturn(ctx context.Context, req *pb.PaymentReturnRequest) (*pb.AbsResponse, error) {
 	return fc.paymentClient.PaymentReturn(ctx, req)
 }
@@ -146,12 +179,15 @@ func (fc *FinanceClient) GetAllDebtsInformation(ctx context.Context, page, size,
 		AmountTo:   cast.ToInt64(amountTo),
 	})
 }
-func (fc *FinanceClient) GetCommonFinanceInformation(ctx context.Context) (int, int) {
+
+// GetCommonFinanceInformation never fails, the dashboard shows zeros while
+// finance-service is down.
+func (fc *FinanceClient) GetCommonFinanceInformation(ctx context.Context) *pb.GetCommonInformationResponse {
 	response, err := fc.paymentClient.GetCommonFinanceInformation(ctx, &emptypb.Empty{})
 	if err != nil {
-		return 0, 0
+		return &pb.GetCommonInformationResponse{IncomeInCurrentMonth: "0.00", ExpenseInCurrentMonth: "0.00", ProfitInCurrentMonth: "0.00"}
 	}
-	return int(response.DebtorsCount), int(response.PayInCurrentMonth)
+	return response
 }
 func (fc *FinanceClient) GetChartIncome(ctx context.Context, from string, to string) (*pb.GetIncomeChartResponse, error) {
 	resp, err := fc.paymentClient.GetIncomeChart(ctx, &pb.GetIncomeChartRequest{
@@ -166,8 +202,104 @@ func (fc *FinanceClient) GetChartIncome(ctx context.Context, from string, to str
 func (fc *FinanceClient) GetTableGroups(ctx context.Context) (interface{}, error) {
 	return nil, nil
 }
+func (fc *FinanceClient) CreateSponsor(ctx context.Context, req *pb.Sponsor) (*pb.Sponsor, error) {
+	return fc.sponsorClient.CreateSponsor(ctx, req)
+}
+func (fc *FinanceClient) UpdateSponsor(ctx context.Context, req *pb.Sponsor) (*pb.Sponsor, error) {
+	return fc.sponsorClient.UpdateSponsor(ctx, req)
+}
+func (fc *FinanceClient) DeleteSponsor(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return fc.sponsorClient.DeleteSponsor(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+func (fc *FinanceClient) GetSponsors(ctx context.Context) (*pb.SponsorList, error) {
+	return fc.sponsorClient.GetSponsors(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) AddSponsorship(ctx context.Context, req *pb.Sponsorship) (*pb.Sponsorship, error) {
+	return fc.sponsorClient.AddSponsorship(ctx, req)
+}
+func (fc *FinanceClient) EndSponsorship(ctx context.Context, id, endAt string) (*pb.Sponsorship, error) {
+	return fc.sponsorClient.EndSponsorship(ctx, &pb.EndSponsorshipRequest{Id: id, EndAt: endAt})
+}
+func (fc *FinanceClient) GetSponsorships(ctx context.Context, sponsorId, studentId string) (*pb.SponsorshipList, error) {
+	return fc.sponsorClient.GetSponsorships(ctx, &pb.GetSponsorshipsRequest{SponsorId: sponsorId, StudentId: studentId})
+}
+func (fc *FinanceClient) SponsorPaymentAdd(ctx context.Context, req *pb.SponsorPaymentRequest) (*pb.AbsResponse, error) {
+	return fc.sponsorClient.SponsorPaymentAdd(ctx, req)
+}
+func (fc *FinanceClient) SponsorPaymentReturn(ctx context.Context, req *pb.SponsorPaymentReturnRequest) (*pb.AbsResponse, error) {
+	return fc.sponsorClient.SponsorPaymentReturn(ctx, req)
+}
+func (fc *FinanceClient) GetSponsorStatement(ctx context.Context, sponsorId, from, to string) (*pb.SponsorStatement, error) {
+	return fc.sponsorClient.GetSponsorStatement(ctx, &pb.SponsorStatementRequest{SponsorId: sponsorId, From: from, To: to})
+}
+func (fc *FinanceClient) PreviewPaymentPlan(ctx context.Context, req *pb.CreatePaymentPlanRequest) (*pb.PaymentPlan, error) {
+	return fc.planClient.PreviewPaymentPlan(ctx, req)
+}
+func (fc *FinanceClient) CreatePaymentPlan(ctx context.Context, req *pb.CreatePaymentPlanRequest) (*pb.PaymentPlan, error) {
+	return fc.planClient.CreatePaymentPlan(ctx, req)
+}
+func (fc *FinanceClient) GetPaymentPlan(ctx context.Context, id string) (*pb.PaymentPlan, error) {
+	return fc.planClient.GetPaymentPlan(ctx, &pb.PaymentPlanRequest{Id: id})
+}
+func (fc *FinanceClient) CancelPaymentPlan(ctx context.Context, id, reason string) (*pb.PaymentPlan, error) {
+	return fc.planClient.CancelPaymentPlan(ctx, &pb.PaymentPlanRequest{Id: id, Reason: reason})
+}
+func (fc *FinanceClient) GetStudentPaymentPlans(ctx context.Context, studentId string) (*pb.PaymentPlanStatusList, error) {
+	return fc.planClient.GetStudentPaymentPlans(ctx, &pb.StudentPaymentPlansRequest{StudentId: studentId})
+}
+func (fc *FinanceClient) GetOverdueInstallments(ctx context.Context, page, size int32) (*pb.OverdueInstallmentList, error) {
+	return fc.planClient.GetOverdueInstallments(ctx, &pb.GetOverdueInstallmentsRequest{Page: page, Size: size})
+}
+func (fc *FinanceClient) CreateCashAccount(ctx context.Context, req *pb.CashAccount) (*pb.CashAccount, error) {
+	return fc.cashClient.CreateCashAccount(ctx, req)
+}
+func (fc *FinanceClient) UpdateCashAccount(ctx context.Context, req *pb.CashAccount) (*pb.CashAccount, error) {
+	return fc.cashClient.UpdateCashAccount(ctx, req)
+}
+func (fc *FinanceClient) GetCashAccounts(ctx context.Context) (*pb.CashAccountList, error) {
+	return fc.cashClient.GetCashAccounts(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) OpenCashShift(ctx context.Context, req *pb.CashShiftRequest) (*pb.CashShift, error) {
+	return fc.cashClient.OpenCashShift(ctx, req)
+}
+func (fc *FinanceClient) CloseCashShift(ctx context.Context, req *pb.CashShiftRequest) (*pb.CashShift, error) {
+	return fc.cashClient.CloseCashShift(ctx, req)
+}
+func (fc *FinanceClient) GetCashShifts(ctx context.Context, accountId string, page, size int32) (*pb.CashShiftList, error) {
+	return fc.cashClient.GetCashShifts(ctx, &pb.GetCashShiftsRequest{AccountId: accountId, Page: page, Size: size})
+}
+func (fc *FinanceClient) SetExchangeRate(ctx context.Context, req *pb.ExchangeRate) (*pb.ExchangeRate, error) {
+	return fc.cashClient.SetExchangeRate(ctx, req)
+}
+func (fc *FinanceClient) GetExchangeRates(ctx context.Context, currency string) (*pb.ExchangeRateList, error) {
+	return fc.cashClient.GetExchangeRates(ctx, &pb.GetExchangeRatesRequest{Currency: currency})
+}
+func (fc *FinanceClient) GetLedgerAccounts(ctx context.Context) (*pb.LedgerAccountList, error) {
+	return fc.ledgerClient.GetLedgerAccounts(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) CreateLedgerAccount(ctx context.Context, req *pb.LedgerAccount) (*pb.LedgerAccount, error) {
+	return fc.ledgerClient.CreateLedgerAccount(ctx, req)
+}
+func (fc *FinanceClient) CreateJournalEntry(ctx context.Context, req *pb.JournalEntry) (*pb.JournalEntry, error) {
+	return fc.ledgerClient.CreateJournalEntry(ctx, req)
+}
+func (fc *FinanceClient) ReverseJournalEntry(ctx context.Context, req *pb.ReverseJournalEntryRequest) (*pb.JournalEntry, error) {
+	return fc.ledgerClient.ReverseJournalEntry(ctx, req)
+}
+func (fc *FinanceClient) GetJournalEntries(ctx context.Context, req *pb.GetJournalEntriesRequest) (*pb.JournalEntryList, error) {
+	return fc.ledgerClient.GetJournalEntries(ctx, req)
+}
+func (fc *FinanceClient) GetTrialBalance(ctx context.Context, date string) (*pb.TrialBalance, error) {
+	return fc.ledgerClient.GetTrialBalance(ctx, &pb.TrialBalanceRequest{Date: date})
+}
+func (fc *FinanceClient) GetProfitAndLoss(ctx context.Context, from, to string) (*pb.ProfitAndLoss, error) {
+	return fc.ledgerClient.GetProfitAndLoss(ctx, &pb.LedgerPeriodRequest{From: from, To: to})
+}
+func (fc *FinanceClient) GetCashFlow(ctx context.Context, from, to string) (*pb.CashFlow, error) {
+	return fc.ledgerClient.GetCashFlow(ctx, &pb.LedgerPeriodRequest{From: from, To: to})
+}
 func NewFinanceClient(addr string) (*FinanceClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("finance-service", addr)
 	if err != nil {
 		return nil, err
 	}
@@ -176,5 +308,14 @@ func NewFinanceClient(addr string) (*FinanceClient, error) {
 	expenseClient := pb.NewExpenseServiceClient(conn)
 	paymentClient := pb.NewPaymentServiceClient(conn)
 	teacherClient := pb.NewTeacherSalaryServiceClient(conn)
-	return &FinanceClient{discountClient: discountClient, categoryClient: categoryClient, expenseClient: expenseClient, paymentClient: paymentClient, teacherSalaryClient: teacherClient}, nil
+	sponsorClient := pb.NewSponsorServiceClient(conn)
+	planClient := pb.NewPaymentPlanServiceClient(conn)
+	cashClient := pb.NewCashServiceClient(conn)
+	ledgerClient := pb.NewLedgerServiceClient(conn)
+	return &FinanceClient{discountClient: discountClient, categoryClient: categoryClient, expenseClient: expenseClient, paymentClient: paymentClient, teacherSalaryClient: teacherClient, sponsorClient: sponsorClient, planClient: planClient, cashClient: cashClient, ledgerClient: ledgerClient, health: healthpb.NewHealthClient(conn)}, nil
+}
+
+// Check reports whether the service answers its health check as SERVING.
+func (fc *FinanceClient) Check(ctx context.Context) error {
+	return checkHealth(ctx, fc.health)
 }
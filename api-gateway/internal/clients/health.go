@@ -0,0 +1,18 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func checkHealth(ctx context.Context, client healthpb.HealthClient) error {
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("service is %s", resp.Status)
+	}
+	return nil
+}
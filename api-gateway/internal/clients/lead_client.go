@@ -4,7 +4,7 @@ import (
 	"api-gateway/grpc/proto/pb"
 	"context"
 	"fmt"
-	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -13,11 +13,13 @@ type LidClient struct {
 	expectClient   pb.ExpectServiceClient
 	setClient      pb.SetServiceClient
 	leadDataClient pb.LeadDataServiceClient
+	leadFormClient pb.LeadFormServiceClient
+	health         healthpb.HealthClient
 }
 
 // NewLidClient creates a new gRPC client for LidService
 func NewLidClient(addr string) (*LidClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("lead-service", addr)
 	if err != nil {
 		return nil, err
 	}
@@ -26,8 +28,9 @@ func NewLidClient(addr string) (*LidClient, error) {
 	expectClient := pb.NewExpectServiceClient(conn)
 	setClient := pb.NewSetServiceClient(conn)
 	leadDataClient := pb.NewLeadDataServiceClient(conn)
+	leadFormClient := pb.NewLeadFormServiceClient(conn)
 
-	return &LidClient{leadClient: leadClient, expectClient: expectClient, setClient: setClient, leadDataClient: leadDataClient}, nil
+	return &LidClient{leadClient: leadClient, expectClient: expectClient, setClient: setClient, leadDataClient: leadDataClient, leadFormClient: leadFormClient, health: healthpb.NewHealthClient(conn)}, nil
 }
 
 // LeadService methods
@@ -195,6 +198,14 @@ func (lc *LidClient) ChangeLeadPlace(ctx context.Context, p *pb.ChangeLeadPlaceR
 	return resp, nil
 }
 
+func (lc *LidClient) MergeLeadData(ctx context.Context, p *pb.MergeLeadDataRequest) (*pb.AbsResponse, error) {
+	resp, err := lc.leadDataClient.MergeLeadData(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (lc *LidClient) ChangeSetToGroup(ctx context.Context, p *pb.ChangeToSetRequest) (*pb.AbsResponse, error) {
 	resp, err := lc.setClient.ChangeToSet(ctx, p)
 	if err != nil {
@@ -221,3 +232,21 @@ func (lc *LidClient) GetActiveLeadCount(ctx context.Context) int {
 func (lc *LidClient) GetByIdSet(setId string, ctx context.Context) (*pb.SetDataResponse, error) {
 	return lc.setClient.GetById(ctx, &pb.DeleteAbsRequest{Id: setId})
 }
+
+// LeadFormService methods
+func (lc *LidClient) GetLeadForm(ctx context.Context) (*pb.LeadForm, error) {
+	return lc.leadFormClient.GetLeadForm(ctx, &emptypb.Empty{})
+}
+
+func (lc *LidClient) UpsertLeadForm(ctx context.Context, req *pb.LeadForm) (*pb.AbsResponse, error) {
+	return lc.leadFormClient.UpsertLeadForm(ctx, req)
+}
+
+func (lc *LidClient) SubmitPublicLead(ctx context.Context, req *pb.PublicLeadRequest) (*pb.PublicLeadResponse, error) {
+	return lc.leadFormClient.SubmitPublicLead(ctx, req)
+}
+
+// Check reports whether the service answers its health check as SERVING.
+func (lc *LidClient) Check(ctx context.Context) error {
+	return checkHealth(ctx, lc.health)
+}
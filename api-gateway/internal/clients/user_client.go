@@ -3,24 +3,25 @@ package client
 import (
 	"api-gateway/grpc/proto/pb"
 	"context"
-	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"time"
 )
 
 type UserClient struct {
 	client     pb.UserServiceClient
 	authClient pb.AuthServiceClient
+	health     healthpb.HealthClient
 }
 
 func NewUserClient(addr string) (*UserClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("user-service", addr)
 	if err != nil {
 		return nil, err
 	}
 
 	client := pb.NewUserServiceClient(conn)
 	authClient := pb.NewAuthServiceClient(conn)
-	return &UserClient{client: client, authClient: authClient}, nil
+	return &UserClient{client: client, authClient: authClient, health: healthpb.NewHealthClient(conn)}, nil
 }
 
 func (c *UserClient) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.AbsResponse, error) {
@@ -80,3 +81,8 @@ func (c *UserClient) UpdateUserPassword(ctx context.Context, userId, password st
 		NewPassword: password,
 	})
 }
+
+// Check reports whether the service answers its health check as SERVING.
+func (c *UserClient) Check(ctx context.Context) error {
+	return checkHealth(ctx, c.health)
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/cast"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/metadata"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -38,13 +41,88 @@ func AuthMiddleware(requiredRoles []string, userClient *client.UserClient) gin.H
 		}
 		ctx.Set("user", user)
 		ctx.Set("company_id", cast.ToString(user.CompanyId))
+		ctx.Set("user_id", user.Id)
+		ctx.Set("role", user.Role)
+		ctx.Next()
+	}
+}
+
+// SubdomainMiddleware resolves the company of unauthenticated requests from the
+// :subdomain path parameter so that downstream services get company_id as usual.
+func SubdomainMiddleware(educationClient *client.EducationClient) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		subdomain := ctx.Param("subdomain")
+		if subdomain == "" {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "subdomain is required"})
+			ctx.Abort()
+			return
+		}
+		company, err := educationClient.GetCompanyBySubdomain(subdomain)
+		if err != nil || company == nil || company.Id == "" {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "company not found"})
+			ctx.Abort()
+			return
+		}
+		ctx.Set("company_id", company.Id)
+		ctx.Next()
+	}
+}
+
+// RateLimitMiddleware lets every client IP through at most limit times per
+// window and answers 429 after that. The client IP is the connection address
+// unless the request came through one of the trusted proxies of the router.
+// The counters live in this gateway instance, which is enough to slow down
+// scripted abuse of public endpoints. Counters whose window is over are
+// evicted, at most a minute after it ended, so the map only holds the
+// addresses seen in the current window.
+func RateLimitMiddleware(limit int, window time.Duration) gin.HandlerFunc {
+	type counter struct {
+		count   int
+		resetAt time.Time
+	}
+	sweepEvery := window
+	if sweepEvery > time.Minute {
+		sweepEvery = time.Minute
+	}
+	var mu sync.Mutex
+	var nextSweep time.Time
+	counters := map[string]*counter{}
+	return func(ctx *gin.Context) {
+		now := time.Now()
+		ip := ctx.ClientIP()
+		mu.Lock()
+		if now.After(nextSweep) {
+			for key, c := range counters {
+				if now.After(c.resetAt) {
+					delete(counters, key)
+				}
+			}
+			nextSweep = now.Add(sweepEvery)
+		}
+		c, ok := counters[ip]
+		if ok && now.After(c.resetAt) {
+			c, ok = nil, false
+		}
+		if !ok {
+			c = &counter{resetAt: now.Add(window)}
+			counters[ip] = c
+		}
+		c.count++
+		allowed, retryAfter := c.count <= limit, c.resetAt.Sub(now)
+		mu.Unlock()
+		if !allowed {
+			ctx.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			ctx.JSON(http.StatusTooManyRequests, gin.H{"error": "too many requests, try again later"})
+			ctx.Abort()
+			return
+		}
 		ctx.Next()
 	}
 }
 
 func NewTimoutContext(ctx context.Context) (context.Context, context.CancelFunc) {
 	md := metadata.Pairs()
-	for _, key := range []string{"company_id"} {
+	for _, key := range []string{"company_id", "user_id", "role"} {
 		if ctx.Value(key) != nil {
 			val, ok := ctx.Value(key).(string)
 			if ok {
@@ -52,6 +130,11 @@ func NewTimoutContext(ctx context.Context) (context.Context, context.CancelFunc)
 			}
 		}
 	}
+	if ginCtx, ok := ctx.(*gin.Context); ok {
+		// the request span is kept in the http request where gin does not
+		// look, the client stats handler sends it next to company_id
+		ctx = trace.ContextWithSpan(ctx, trace.SpanFromContext(ginCtx.Request.Context()))
+	}
 	ctx = metadata.NewOutgoingContext(ctx, md)
 	res, cancel := context.WithTimeout(ctx, time.Second*15)
 	return res, cancel
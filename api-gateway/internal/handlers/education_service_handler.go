@@ -36,7 +36,7 @@ func CreateRoom(ctx *gin.Context) {
 	}
 	resp, err := educationClient.CreateRoom(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -66,7 +66,7 @@ func UpdateRoom(ctx *gin.Context) {
 	}
 	resp, err := educationClient.UpdateRoom(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -89,7 +89,7 @@ func DeleteRoom(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := educationClient.DeleteRoom(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -110,7 +110,7 @@ func GetAllRoom(ctx *gin.Context) {
 	defer cancel()
 	rooms, err := educationClient.GetRoom(ctxR)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, rooms)
@@ -140,7 +140,7 @@ func CreateCourse(ctx *gin.Context) {
 	}
 	resp, err := educationClient.CreateCourse(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -170,7 +170,7 @@ func UpdateCourse(ctx *gin.Context) {
 	defer cancel()
 	resp, err := educationClient.UpdateCourse(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -193,7 +193,7 @@ func DeleteCourse(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := educationClient.DeleteCourse(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -214,7 +214,7 @@ func GetAllCourse(ctx *gin.Context) {
 	defer cancel()
 	rooms, err := educationClient.GetCourse(ctxR)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, rooms)
@@ -237,7 +237,7 @@ func GetCourseById(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := educationClient.GetCourseById(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -267,7 +267,7 @@ func CreateGroup(ctx *gin.Context) {
 	}
 	resp, err := educationClient.CreateGroup(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -297,7 +297,7 @@ func UpdateGroup(ctx *gin.Context) {
 	}
 	resp, err := educationClient.UpdateGroup(ctxR, req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -320,7 +320,7 @@ func DeleteGroup(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := educationClient.DeleteGroup(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -350,7 +350,7 @@ func GetAllGroup(ctx *gin.Context) {
 	}
 	resp, err := educationClient.GetAllGroup(ctxR, req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, &resp)
@@ -377,7 +377,7 @@ func GetGroupById(ctx *gin.Context) {
 	}
 	resp, err := educationClient.GetGroupById(ctxR, id, user.Id, user.Role)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, &resp)
@@ -413,7 +413,7 @@ func SetAttendance(ctx *gin.Context) {
 	defer cancelFunc()
 	resp, err := educationClient.SetAttendanceByGroup(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -449,7 +449,7 @@ func GetAttendance(ctx *gin.Context) {
 	req.ActionId = user.Id
 	resp, err := educationClient.GetAttendanceByGroup(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, &resp)
@@ -472,7 +472,7 @@ func GetGroupByCourseId(ctx *gin.Context) {
 	courseId := ctx.Param("courseId")
 	resp, err := educationClient.GetGroupByCourseId(ctxR, courseId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, &resp)
@@ -503,7 +503,7 @@ func GetAllStudent(ctx *gin.Context) {
 	defer cancel()
 	response, err := educationClient.GetAllStudent(ctxR, req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -518,6 +518,7 @@ func GetAllStudent(ctx *gin.Context) {
 // @Param request body pb.CreateStudentRequest true "Student details"
 // @Success 200 {object} utils.AbsResponse "Created student details"
 // @Failure 400 {object} utils.AbsResponse "Invalid request"
+// @Failure 403 {object} utils.AbsResponse "Tariff student limit reached or subscription expired"
 // @Failure 500 {object} utils.AbsResponse "Internal server error"
 // @Router /api/student/create [post]
 func CreateStudent(ctx *gin.Context) {
@@ -531,7 +532,7 @@ func CreateStudent(ctx *gin.Context) {
 	req.CreatedBy = "c1d6503f-31dc-4f99-b61f-2e4ebc7a7639"
 	response, err := educationClient.CreateStudent(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -546,6 +547,7 @@ func CreateStudent(ctx *gin.Context) {
 // @Param request body pb.AddToGroupRequest true "Add student to group details"
 // @Success 200 {object} utils.AbsResponse "Success message"
 // @Failure 400 {object} utils.AbsResponse "Invalid request"
+// @Failure 403 {object} utils.AbsResponse "Tariff student limit exceeded or subscription expired"
 // @Failure 500 {object} utils.AbsResponse "Internal server error"
 // @Router /api/student/add-to-group [post]
 func AddStudentToGroup(ctx *gin.Context) {
@@ -557,10 +559,9 @@ func AddStudentToGroup(ctx *gin.Context) {
 		return
 	}
 	req.CreatedBy = "c1d6503f-31dc-4f99-b61f-2e4ebc7a7639"
-	fmt.Println(req.StudentIds)
 	response, err := educationClient.AddStudentToGroup(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, response.Status, response.Message)
@@ -587,7 +588,7 @@ func UpdateStudent(ctx *gin.Context) {
 	}
 	response, err := educationClient.UpdateStudent(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, response.Status, response.Message)
@@ -619,7 +620,7 @@ func DeleteStudent(ctx *gin.Context) {
 	}
 	response, err := educationClient.DeleteStudent(ctxR, id, returnMoney, user.Id, user.Name)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, response.Status, response.Message)
@@ -642,7 +643,7 @@ func GetStudentById(ctx *gin.Context) {
 	studentId := ctx.Param("studentId")
 	response, err := educationClient.GetStudentById(ctxR, studentId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -666,7 +667,7 @@ func GetNotesByStudent(ctx *gin.Context) {
 	studentId := ctx.Param("studentId")
 	response, err := educationClient.GetNotesByStudentId(ctxR, studentId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -695,7 +696,7 @@ func CreateNoteForStudent(ctx *gin.Context) {
 	}
 	resp, err := educationClient.CreateNoteForStudent(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -719,7 +720,7 @@ func DeleteStudentNote(ctx *gin.Context) {
 	note := ctx.Param("noteId")
 	resp, err := educationClient.DeleteNote(ctxR, note)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -743,7 +744,7 @@ func SearchStudent(ctx *gin.Context) {
 	value := ctx.Param("value")
 	resp, err := educationClient.SearchStudentByPhoneName(ctxR, value)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -767,7 +768,7 @@ func GetHistoryGroup(ctx *gin.Context) {
 	value := ctx.Param("groupId")
 	resp, err := educationClient.GetHistoryGroupById(ctxR, value)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -791,7 +792,7 @@ func GetHistoryStudent(ctx *gin.Context) {
 	value := ctx.Param("studentId")
 	resp, err := educationClient.GetHistoryStudentById(ctxR, value)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -820,7 +821,7 @@ func TransferLessonDate(ctx *gin.Context) {
 	}
 	resp, err := educationClient.TransferLessonDate(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -857,7 +858,66 @@ func ChangeConditionStudent(ctx *gin.Context) {
 	req.ActionByName = user.Name
 	resp, err := educationClient.ChangeConditionStudent(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// FindStudentsByPhone godoc
+// @Summary ADMIN
+// @Description Find students registered with the same phone number, the number is normalized before lookup
+// @Tags students
+// @Produce json
+// @Param phone query string true "Phone number"
+// @Success 200 {object} pb.SearchStudentResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/student/find-by-phone [get]
+func FindStudentsByPhone(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.FindStudentsByPhone(ctxR, ctx.Query("phone"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// MergeStudents godoc
+// @Summary ADMIN, CEO
+// @Description Merge the source student into the target: groups, attendance, notes, balance, payments and discounts are moved and the source is archived
+// @Tags students
+// @Accept json
+// @Produce json
+// @Param request body pb.MergeStudentsRequest true "Merge Students Request"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 404 {object} utils.AbsResponse
+// @Failure 500 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/student/merge [post]
+func MergeStudents(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.MergeStudentsRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := educationClient.MergeStudents(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -886,7 +946,7 @@ func GetInformationByTeacher(ctx *gin.Context) {
 	}
 	resp, err := educationClient.GetInformationByTeacher(ctxR, teacherId, isArchived)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -898,7 +958,7 @@ func GetInformationByTeacher(ctx *gin.Context) {
 // @Description Get common information about company
 // @Tags education
 // @Produce json
-// @Success 200 {object} map[string]int
+// @Success 200 {object} map[string]interface{}
 // @Failure 400 {object} utils.AbsResponse
 // @Security Bearer
 // @Router /api/common-information-company [get]
@@ -907,13 +967,16 @@ func GetCommonInformationCompany(ctx *gin.Context) {
 	defer cancel()
 	activeLeadCount := leadClient.GetActiveLeadCount(ctxR)
 	activeStudentCount, activeGroupCount, leaveGroupCount, commonDebtorsCount, eleminatedInTrial := educationClient.GetCommonEducationInformation(ctxR)
-	_, payInCurrentMonth := financeClient.GetCommonFinanceInformation(ctxR)
-	response := make(map[string]int)
+	finance := financeClient.GetCommonFinanceInformation(ctxR)
+	response := make(map[string]interface{})
 	response["activeLeadCount"] = activeLeadCount
 	response["activeStudentsCount"] = activeStudentCount
 	response["activeGroupCount"] = activeGroupCount
 	response["debtorsCount"] = commonDebtorsCount
-	response["payInCurrentMonth"] = payInCurrentMonth
+	response["payInCurrentMonth"] = int(finance.PayInCurrentMonth)
+	response["incomeInCurrentMonth"] = finance.IncomeInCurrentMonth
+	response["expenseInCurrentMonth"] = finance.ExpenseInCurrentMonth
+	response["profitInCurrentMonth"] = finance.ProfitInCurrentMonth
 	response["leaveGroupCount"] = leaveGroupCount
 	response["eleminatedInTrial"] = eleminatedInTrial
 	ctx.JSON(http.StatusOK, response)
@@ -936,7 +999,7 @@ func GetChartIncome(ctx *gin.Context) {
 	defer cancel()
 	resp, err := financeClient.GetChartIncome(ctxR, ctx.Query("from"), ctx.Query("to"))
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -1414,6 +1477,74 @@ func FinanceUpdateByCompany(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, res)
 }
 
+// CheckSubdomain godoc
+// @Summary ALL
+// @Description Tell whether a subdomain can be taken at signup, and why not
+// @Tags signup
+// @Produce json
+// @Param subdomain path string true "Subdomain"
+// @Success 200 {object} pb.CheckSubdomainResponse
+// @Failure 503 {object} utils.AbsResponse
+// @Router /api/public/signup/check-subdomain/{subdomain} [get]
+func CheckSubdomain(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.CheckSubdomain(ctxR, ctx.Param("subdomain"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// Signup godoc
+// @Summary ALL
+// @Description Sign a learning center up: creates a demo company on the subdomain with its CEO, who can log in right away
+// @Tags signup
+// @Accept json
+// @Produce json
+// @Param request body pb.SignupRequest true "Company and CEO"
+// @Success 200 {object} pb.SignupResponse
+// @Failure 400 {object} utils.AbsResponse "Invalid subdomain, phone or password"
+// @Failure 409 {object} utils.AbsResponse "Subdomain or phone already taken"
+// @Failure 429 {object} utils.AbsResponse "More than 5 signups from this address in an hour"
+// @Router /api/public/signup [post]
+func Signup(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.SignupRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.Signup(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetSubscription godoc
+// @Summary ALL
+// @Description Subscription of the caller's company: ACTIVE, GRACE (read-only after the paid period) or LOCKED, and how many active students its tariff allows
+// @Tags company
+// @Produce json
+// @Success 200 {object} pb.CompanySubscription
+// @Failure 404 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/company/subscription [get]
+func GetSubscription(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetSubscription(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // GetStatisticCompany godoc
 // @Summary SUPER_CEO
 // @Description
@@ -1439,3 +1570,636 @@ func GetStatisticCompany(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, response)
 }
+
+// GetNotificationSettings godoc
+// @Summary ADMIN , CEO
+// @Description Get notification language, enabled channels and the template of every event
+// @Tags notification
+// @Produce json
+// @Success 200 {object} pb.NotificationSettings
+// @Failure 500 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/notification/settings [get]
+func GetNotificationSettings(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetNotificationSettings(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateNotificationSettings godoc
+// @Summary CEO
+// @Description Update notification settings. Templates use {{.student}}, {{.amount}}, {{.balance}}, {{.group}}, {{.from}}, {{.to}} and {{.date}}; an empty body restores the default text
+// @Tags notification
+// @Accept json
+// @Produce json
+// @Param request body pb.NotificationSettings true "Notification settings"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/notification/settings [put]
+func UpdateNotificationSettings(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.NotificationSettings{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.UpdateNotificationSettings(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetNotificationOutbox godoc
+// @Summary ADMIN , CEO
+// @Description List queued and delivered notifications of the company
+// @Tags notification
+// @Produce json
+// @Param status query string false "PENDING, SENDING, SENT or FAILED"
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} pb.GetNotificationOutboxResponse
+// @Failure 500 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/notification/outbox [get]
+func GetNotificationOutbox(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := educationClient.GetNotificationOutbox(ctxR, ctx.Query("status"), int32(page), int32(size))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetDebtReminderSettings godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Get the debt reminder campaign settings of the company
+// @Tags debt-reminder
+// @Produce json
+// @Success 200 {object} pb.DebtReminderSettings
+// @Failure 500 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/debt-reminder/settings [get]
+func GetDebtReminderSettings(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetDebtReminderSettings(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateDebtReminderSettings godoc
+// @Summary CEO
+// @Description Update debt reminders. Students whose balance drops below balanceBelow get the first reminder after overdueDays, then every cadenceDays, at most maxReminders times. The DEBT_REMINDER template uses {{.student}}, {{.debt}}, {{.balance}} and {{.days}}
+// @Tags debt-reminder
+// @Accept json
+// @Produce json
+// @Param request body pb.DebtReminderSettings true "Debt reminder settings"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/debt-reminder/settings [put]
+func UpdateDebtReminderSettings(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.DebtReminderSettings{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.UpdateDebtReminderSettings(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetDebtReminders godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description List debt reminders with the number sent, the delivery status of the last one and the recorded reply
+// @Tags debt-reminder
+// @Produce json
+// @Param status query string false "ACTIVE, CLEARED or STOPPED"
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} pb.GetDebtRemindersResponse
+// @Failure 500 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/debt-reminder/list [get]
+func GetDebtReminders(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := educationClient.GetDebtReminders(ctxR, ctx.Query("status"), int32(page), int32(size))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// SetDebtReminderReply godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Record the debtor's reply (NONE, PROMISED, REFUSED, NO_ANSWER, WRONG_NUMBER). A promised date pauses reminders until it passes; stop closes the reminder
+// @Tags debt-reminder
+// @Accept json
+// @Produce json
+// @Param request body pb.SetDebtReminderReplyRequest true "Reply"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/debt-reminder/reply [post]
+func SetDebtReminderReply(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.SetDebtReminderReplyRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.SetDebtReminderReply(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// RunBalanceReconciliation godoc
+// @Summary CEO , FINANCIST
+// @Description Compare every student's balance with the finance payment ledger and store the drift report. With autoCorrect=true drifted balances are set to the ledger value and recorded in the student history
+// @Tags reconciliation
+// @Produce json
+// @Param autoCorrect query bool false "Overwrite drifted balances with the ledger value"
+// @Success 200 {object} pb.ReconciliationReport
+// @Failure 500 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/reconciliation/run [post]
+func RunBalanceReconciliation(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	autoCorrect, _ := strconv.ParseBool(ctx.DefaultQuery("autoCorrect", "false"))
+	resp, err := educationClient.RunBalanceReconciliation(ctxR, &pb.RunBalanceReconciliationRequest{
+		AutoCorrect:  autoCorrect,
+		ActionById:   user.Id,
+		ActionByName: user.Name,
+	})
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetReconciliationReports godoc
+// @Summary CEO , FINANCIST
+// @Description List balance reconciliation reports, newest first
+// @Tags reconciliation
+// @Produce json
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} pb.GetReconciliationReportsResponse
+// @Failure 500 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/reconciliation/reports [get]
+func GetReconciliationReports(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := educationClient.GetReconciliationReports(ctxR, int32(page), int32(size))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetReconciliationReport godoc
+// @Summary CEO , FINANCIST
+// @Description Get one reconciliation report with the drifted students
+// @Tags reconciliation
+// @Produce json
+// @Param id path string true "Report ID"
+// @Success 200 {object} pb.ReconciliationReport
+// @Failure 404 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/reconciliation/reports/{id} [get]
+func GetReconciliationReport(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetReconciliationReport(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// SubscriptionQuote godoc
+// @Summary CEO , FINANCIST
+// @Description Price of renewing the caller's company for some months on a tariff, with prepay, company and promo code discounts, and the valid date it would get
+// @Tags subscription
+// @Accept json
+// @Produce json
+// @Param body body pb.QuoteRequest true "tariffId, months and an optional promoCode"
+// @Success 200 {object} pb.TariffQuote
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 403 {object} utils.AbsResponse "Promo code cannot be used"
+// @Security Bearer
+// @Router /api/company/subscription/quote [post]
+func SubscriptionQuote(ctx *gin.Context) {
+	var req pb.QuoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.CompanyId = ""
+	quote(ctx, &req)
+}
+
+// SubscriptionCreateInvoice godoc
+// @Summary CEO , FINANCIST
+// @Description Issue an invoice for renewing the caller's company, priced like the quote. Paying it extends the valid date
+// @Tags subscription
+// @Accept json
+// @Produce json
+// @Param body body pb.QuoteRequest true "tariffId, months and an optional promoCode"
+// @Success 200 {object} pb.Invoice
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/company/subscription/invoices [post]
+func SubscriptionCreateInvoice(ctx *gin.Context) {
+	var req pb.QuoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.CompanyId = ""
+	createInvoice(ctx, &req)
+}
+
+// SubscriptionInvoices godoc
+// @Summary CEO , FINANCIST
+// @Description Invoices of the caller's company
+// @Tags subscription
+// @Produce json
+// @Param status query string false "OPEN, PAID or VOID"
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} pb.InvoiceList
+// @Security Bearer
+// @Router /api/company/subscription/invoices [get]
+func SubscriptionInvoices(ctx *gin.Context) {
+	invoices(ctx, "")
+}
+
+// BillingQuote godoc
+// @Summary SUPER_CEO
+// @Description Price of renewing a company for some months on a tariff, with prepay, company and promo code discounts
+// @Tags billing
+// @Accept json
+// @Produce json
+// @Param body body pb.QuoteRequest true "companyId, tariffId, months and an optional promoCode"
+// @Success 200 {object} pb.TariffQuote
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/company/billing/quote [post]
+func BillingQuote(ctx *gin.Context) {
+	var req pb.QuoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil || req.CompanyId == "" {
+		utils.RespondError(ctx, http.StatusBadRequest, "companyId, tariffId and months are required")
+		return
+	}
+	quote(ctx, &req)
+}
+
+// BillingCreateInvoice godoc
+// @Summary SUPER_CEO
+// @Description Issue an invoice to a company. Payments recorded with its invoiceId, or of exactly its open amount, settle it
+// @Tags billing
+// @Accept json
+// @Produce json
+// @Param body body pb.QuoteRequest true "companyId, tariffId, months and an optional promoCode"
+// @Success 200 {object} pb.Invoice
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/company/billing/invoices [post]
+func BillingCreateInvoice(ctx *gin.Context) {
+	var req pb.QuoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil || req.CompanyId == "" {
+		utils.RespondError(ctx, http.StatusBadRequest, "companyId, tariffId and months are required")
+		return
+	}
+	createInvoice(ctx, &req)
+}
+
+// BillingInvoices godoc
+// @Summary SUPER_CEO
+// @Description Invoices of a company
+// @Tags billing
+// @Produce json
+// @Param companyId query string true "Company id"
+// @Param status query string false "OPEN, PAID or VOID"
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} pb.InvoiceList
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/company/billing/invoices [get]
+func BillingInvoices(ctx *gin.Context) {
+	companyId := ctx.Query("companyId")
+	if companyId == "" {
+		utils.RespondError(ctx, http.StatusBadRequest, "companyId is required")
+		return
+	}
+	invoices(ctx, companyId)
+}
+
+// BillingVoidInvoice godoc
+// @Summary SUPER_CEO
+// @Description Void an open invoice nothing was paid on yet
+// @Tags billing
+// @Produce json
+// @Param id path string true "Invoice id"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 403 {object} utils.AbsResponse "Invoice is paid or void"
+// @Security Bearer
+// @Router /api/company/billing/invoices/{id} [delete]
+func BillingVoidInvoice(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	if _, err := educationClient.VoidInvoice(ctxR, ctx.Param("id")); err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, http.StatusOK, "voided")
+}
+
+// CreatePromoCode godoc
+// @Summary SUPER_CEO
+// @Description Create a promo code taking either a percent or a fixed amount off, optionally for one tariff, a date range and a number of uses
+// @Tags billing
+// @Accept json
+// @Produce json
+// @Param body body pb.PromoCode true "Promo code"
+// @Success 200 {object} pb.PromoCode
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse "Code exists"
+// @Security Bearer
+// @Router /api/company/billing/promo-codes [post]
+func CreatePromoCode(ctx *gin.Context) {
+	var req pb.PromoCode
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.CreatePromoCode(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPromoCodes godoc
+// @Summary SUPER_CEO
+// @Description List promo codes with how often they were used
+// @Tags billing
+// @Produce json
+// @Success 200 {object} pb.PromoCodeList
+// @Security Bearer
+// @Router /api/company/billing/promo-codes [get]
+func GetPromoCodes(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetPromoCodes(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// DeactivatePromoCode godoc
+// @Summary SUPER_CEO
+// @Description Stop a promo code from being used, invoices already issued with it keep their price
+// @Tags billing
+// @Produce json
+// @Param code path string true "Promo code"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 404 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/company/billing/promo-codes/{code} [delete]
+func DeactivatePromoCode(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	if _, err := educationClient.DeactivatePromoCode(ctxR, ctx.Param("code")); err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, http.StatusOK, "deactivated")
+}
+
+// SetPriceOverride godoc
+// @Summary SUPER_CEO
+// @Description Give a company its own monthly price and/or a percent off, optionally until a date
+// @Tags billing
+// @Accept json
+// @Produce json
+// @Param body body pb.PriceOverride true "Price override"
+// @Success 200 {object} pb.PriceOverride
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/company/billing/override [put]
+func SetPriceOverride(ctx *gin.Context) {
+	var req pb.PriceOverride
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.SetPriceOverride(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPriceOverride godoc
+// @Summary SUPER_CEO
+// @Description Price override of a company
+// @Tags billing
+// @Produce json
+// @Param companyId path string true "Company id"
+// @Success 200 {object} pb.PriceOverride
+// @Failure 404 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/company/billing/override/{companyId} [get]
+func GetPriceOverride(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetPriceOverride(ctxR, ctx.Param("companyId"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func quote(ctx *gin.Context, req *pb.QuoteRequest) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.Quote(ctxR, req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func createInvoice(ctx *gin.Context, req *pb.QuoteRequest) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.CreateInvoice(ctxR, req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func invoices(ctx *gin.Context, companyId string) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := educationClient.GetInvoices(ctxR, &pb.GetInvoicesRequest{CompanyId: companyId, Status: ctx.Query("status"), Page: int32(page), Size: int32(size)})
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPlatformMetrics godoc
+// @Summary SUPER_CEO
+// @Description MRR and ARR of the periods paid for today, companies by state, and per month the renewal and churn rates and the retention of the companies that first paid in it
+// @Tags analytics
+// @Produce json
+// @Param from query string false "First month reported, YYYY-MM-DD (twelve months back by default)"
+// @Param to query string false "Last month reported, YYYY-MM-DD (this month by default)"
+// @Success 200 {object} pb.PlatformMetrics
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/analytics/metrics [get]
+func GetPlatformMetrics(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetPlatformMetrics(ctxR, ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetTenantHealth godoc
+// @Summary SUPER_CEO
+// @Description Usage and health score of every company, least healthy first. Students, groups, staff, leads and payments are gathered from all services
+// @Tags analytics
+// @Produce json
+// @Param level query string false "HEALTHY, AT_RISK or CRITICAL"
+// @Param page query int false "Page number"
+// @Param size query int false "Page size"
+// @Success 200 {object} pb.TenantHealthList
+// @Security Bearer
+// @Router /api/analytics/tenants [get]
+func GetTenantHealth(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := educationClient.GetTenantHealth(ctxR, &pb.TenantHealthRequest{Level: ctx.Query("level"), Page: int32(page), Size: int32(size)})
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPlatformHistory godoc
+// @Summary SUPER_CEO
+// @Description Daily snapshots of the platform metrics, for charts
+// @Tags analytics
+// @Produce json
+// @Param from query string false "YYYY-MM-DD, 90 days back by default"
+// @Param to query string false "YYYY-MM-DD, today by default"
+// @Success 200 {object} pb.PlatformHistory
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/analytics/history [get]
+func GetPlatformHistory(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetPlatformHistory(ctxR, ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetTenantHistory godoc
+// @Summary SUPER_CEO
+// @Description Daily snapshots of the usage and health of a company
+// @Tags analytics
+// @Produce json
+// @Param companyId path int true "Company id"
+// @Param from query string false "YYYY-MM-DD, 90 days back by default"
+// @Param to query string false "YYYY-MM-DD, today by default"
+// @Success 200 {object} pb.TenantHealthList
+// @Failure 400 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/analytics/tenants/{companyId}/history [get]
+func GetTenantHistory(ctx *gin.Context) {
+	companyId, err := strconv.Atoi(ctx.Param("companyId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "companyId must be a number")
+		return
+	}
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetTenantHistory(ctxR, int32(companyId), ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
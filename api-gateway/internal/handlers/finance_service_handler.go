@@ -4,6 +4,7 @@ import (
 	"api-gateway/grpc/proto/pb"
 	"api-gateway/internal/etc"
 	"api-gateway/internal/utils"
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/shopspring/decimal"
 	"net/http"
@@ -27,7 +28,7 @@ func GetAllDiscountInformationByGroup(ctx *gin.Context) {
 	groupId := ctx.Param("groupId")
 	resp, err := financeClient.GetDiscountsInformationByGroupId(ctxR, groupId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -57,7 +58,7 @@ func CreateDiscount(ctx *gin.Context) {
 	}
 	resp, err := financeClient.CreateDiscount(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -72,6 +73,7 @@ func CreateDiscount(ctx *gin.Context) {
 // @Produce json
 // @Param groupId query string true "Group ID"
 // @Param studentId query string true "Student ID"
+// @Param id query string false "Discount ID, all discounts of the student in the group when empty"
 // @Security Bearer
 // @Success 200 {object} utils.AbsResponse "Success response with status and message"
 // @Failure 500 {object} utils.AbsResponse "Internal Server Error"
@@ -81,15 +83,191 @@ func DeleteDiscount(ctx *gin.Context) {
 	defer cancel()
 	groupId := ctx.Query("groupId")
 	studentId := ctx.Query("studentId")
-	resp, err := financeClient.DeleteDiscount(ctxR, groupId, studentId)
+	resp, err := financeClient.DeleteDiscount(ctxR, groupId, studentId, ctx.Query("id"))
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
 	return
 }
 
+// CreateDiscountRule godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Creates a company discount rule. ruleType is SIBLING (minCount students sharing a parent contact), MULTI_GROUP (minCount active groups) or EARLY_PAYMENT (the month is covered by the balance when it is charged); discountType is FIXED or PERCENT
+// @Tags discount
+// @Accept json
+// @Produce json
+// @Param request body pb.DiscountRule true "Discount rule"
+// @Success 200 {object} pb.DiscountRule
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/discount/rules [post]
+func CreateDiscountRule(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.DiscountRule{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.CreateDiscountRule(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateDiscountRule godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Updates a company discount rule, isActive switches it on and off
+// @Tags discount
+// @Accept json
+// @Produce json
+// @Param id path string true "Rule ID"
+// @Param request body pb.DiscountRule true "Discount rule"
+// @Success 200 {object} pb.DiscountRule
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/discount/rules/{id} [put]
+func UpdateDiscountRule(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.DiscountRule{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.Id = ctx.Param("id")
+	resp, err := financeClient.UpdateDiscountRule(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// DeleteDiscountRule godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Deactivates a company discount rule, its applications stay in the history
+// @Tags discount
+// @Produce json
+// @Param id path string true "Rule ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/discount/rules/{id} [delete]
+func DeleteDiscountRule(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.DeleteDiscountRule(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetDiscountRules godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Lists the discount rules of the company, active ones first
+// @Tags discount
+// @Produce json
+// @Success 200 {object} pb.DiscountRuleList
+// @Security Bearer
+// @Router /api/finance/discount/rules [get]
+func GetDiscountRules(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetDiscountRules(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetDiscountPolicy godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description How the discounts of a student are combined: STACK adds them up to maxPercent of the price, BEST takes the largest
+// @Tags discount
+// @Produce json
+// @Success 200 {object} pb.DiscountPolicy
+// @Security Bearer
+// @Router /api/finance/discount/policy [get]
+func GetDiscountPolicy(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetDiscountPolicy(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// SetDiscountPolicy godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Sets how the discounts of a student are combined
+// @Tags discount
+// @Accept json
+// @Produce json
+// @Param request body pb.DiscountPolicy true "Discount policy"
+// @Success 200 {object} pb.DiscountPolicy
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/discount/policy [put]
+func SetDiscountPolicy(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.DiscountPolicy{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.SetDiscountPolicy(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetRuleApplications godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description History of discount rules applied by the monthly charge
+// @Tags discount
+// @Produce json
+// @Param ruleId query string false "Rule ID"
+// @Param studentId query string false "Student ID"
+// @Param month query string false "Month, YYYY-MM"
+// @Param page query int false "Page" default(1)
+// @Param size query int false "Size" default(20)
+// @Success 200 {object} pb.RuleApplicationList
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/discount/applications [get]
+func GetRuleApplications(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := financeClient.GetRuleApplications(ctxR, &pb.GetRuleApplicationsRequest{
+		RuleId:    ctx.Query("ruleId"),
+		StudentId: ctx.Query("studentId"),
+		Month:     ctx.Query("month"),
+		Page:      int32(page),
+		Size:      int32(size),
+	})
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // CreateCategory godoc
 // @Summary      ADMIN , CEO
 // @Description  Creates a new category with the provided name and description
@@ -113,7 +291,7 @@ func CreateCategory(ctx *gin.Context) {
 	}
 	resp, err := financeClient.CreateCategory(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -137,7 +315,7 @@ func DeleteCategory(ctx *gin.Context) {
 	categoryId := ctx.Param("categoryId")
 	resp, err := financeClient.DeleteCategory(ctxR, categoryId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -158,7 +336,7 @@ func GetAllCategories(ctx *gin.Context) {
 	defer cancel()
 	resp, err := financeClient.GetAllCategories(ctxR)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -193,7 +371,7 @@ func CreateExpense(ctx *gin.Context) {
 	req.CreatedById = user.Id
 	resp, err := financeClient.CreateExpense(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -216,7 +394,7 @@ func DeleteExpense(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := financeClient.DeleteExpense(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -259,7 +437,7 @@ func GetAllInformation(ctx *gin.Context) {
 	if idType == "USER" || idType == "CATEGORY" || idType == "" {
 		resp, err := financeClient.GetAllInformation(ctxR, id, idType, page, size, from, to)
 		if err != nil {
-			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 			return
 		}
 		ctx.JSON(http.StatusOK, resp)
@@ -313,7 +491,7 @@ func GetHistoryDiscount(ctx *gin.Context) {
 	userId := ctx.Param("userId")
 	resp, err := financeClient.GetHistoryDiscount(userId, ctxR)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -349,7 +527,8 @@ func PaymentAdd(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-	if amount.LessThan(decimal.NewFromInt(10000)) {
+	// a named account may be in USD, finance-service checks the converted sum
+	if req.AccountId == "" && amount.LessThan(decimal.NewFromInt(10000)) {
 		utils.RespondError(ctx, http.StatusBadRequest, "min 10 000 USD")
 		return
 	}
@@ -368,7 +547,7 @@ func PaymentAdd(ctx *gin.Context) {
 
 // PaymentReturn godoc
 // @Summary ADMIN , CEO
-// @Description Return a payment for a student
+// @Description Return a payment for a student. The payment stays in the ledger and a linked reversal entry with the reason is added
 // @Tags payments
 // @Security Bearer
 // @Accept json
@@ -401,7 +580,7 @@ func PaymentReturn(ctx *gin.Context) {
 
 // PaymentUpdate godoc
 // @Summary ADMIN , CEO
-// @Description Update a payment for a student
+// @Description Update a payment for a student. The original payment is reversed and a new entry with the edited values is added
 // @Tags payments
 // @Security Bearer
 // @Accept json
@@ -426,7 +605,8 @@ func PaymentUpdate(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-	if amount.LessThan(decimal.NewFromInt(10000)) {
+	// a named account may be in USD, finance-service checks the converted sum
+	if req.AccountId == "" && amount.LessThan(decimal.NewFromInt(10000)) {
 		utils.RespondError(ctx, http.StatusBadRequest, "min 10 000 USD")
 		return
 	}
@@ -457,7 +637,7 @@ func GetMonthlyStatusPayment(ctx *gin.Context) {
 	studentId := ctx.Param("studentId")
 	resp, err := financeClient.GetMonthlyStatusPayment(ctxR, studentId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -482,7 +662,7 @@ func GetAllPayments(ctx *gin.Context) {
 	month := ctx.Param("month")
 	resp, err := financeClient.GetAllPayments(ctxR, month, studentId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -503,7 +683,7 @@ func GetSalaryAllTeacher(ctx *gin.Context) {
 	defer cancel()
 	resp, err := financeClient.GetSalaryAllTeacher(ctxR)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -681,7 +861,7 @@ func GetAllPaymentsStudentChart(ctx *gin.Context) {
 
 // GetAllDebtsInformation godoc
 // @Summary ADMIN , CEO
-// @Description Retrieves information about debts within the specified date range.
+// @Description Retrieves information about debts within the specified date range. debts are owed by the students' families, sponsorDebts by sponsors.
 // @Tags payments
 // @Accept json
 // @Produce json
@@ -714,6 +894,52 @@ func GetAllDebtsInformation(ctx *gin.Context) {
 	return
 }
 
+// GetFailedBalanceEvents godoc
+// @Summary CEO , FINANCIST
+// @Description Balance changes education-service rejected. Until they are retried the students' balances do not include them
+// @Tags payments
+// @Produce json
+// @Success 200 {object} pb.BalanceEventList
+// @Security Bearer
+// @Router /api/finance/payment/balance-events/failed [get]
+func GetFailedBalanceEvents(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetFailedBalanceEvents(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// RetryBalanceEvents godoc
+// @Summary CEO , FINANCIST
+// @Description Sends the given failed balance changes again, all of them when ids is empty
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param request body pb.RetryBalanceEventsRequest true "Event ids"
+// @Success 200 {object} pb.RetryBalanceEventsResponse
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/payment/balance-events/retry [post]
+func RetryBalanceEvents(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.RetryBalanceEventsRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.RetryBalanceEvents(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // CalculateSalary godoc
 // @Summary CEO
 // @Description Calculates the salary for a specified teacher within a given date range.
@@ -741,3 +967,840 @@ func CalculateSalary(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp)
 	return
 }
+
+// CreateSponsor godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Creates a sponsor, a payer account covering part of some students' tuition
+// @Tags sponsor
+// @Accept json
+// @Produce json
+// @Param request body pb.Sponsor true "Sponsor"
+// @Success 200 {object} pb.Sponsor
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/sponsor [post]
+func CreateSponsor(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.Sponsor{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.CreateSponsor(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateSponsor godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Updates a sponsor, isActive switches the split of its students' charges on and off
+// @Tags sponsor
+// @Accept json
+// @Produce json
+// @Param id path string true "Sponsor ID"
+// @Param request body pb.Sponsor true "Sponsor"
+// @Success 200 {object} pb.Sponsor
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/sponsor/{id} [put]
+func UpdateSponsor(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.Sponsor{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.Id = ctx.Param("id")
+	resp, err := financeClient.UpdateSponsor(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// DeleteSponsor godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Deactivates a sponsor, its ledger stays
+// @Tags sponsor
+// @Produce json
+// @Param id path string true "Sponsor ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/sponsor/{id} [delete]
+func DeleteSponsor(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.DeleteSponsor(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetSponsors godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Lists the sponsors of the company with their balances and the number of students they cover today
+// @Tags sponsor
+// @Produce json
+// @Success 200 {object} pb.SponsorList
+// @Security Bearer
+// @Router /api/finance/sponsor [get]
+func GetSponsors(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetSponsors(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// AddSponsorship godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Makes a sponsor cover part of a student's monthly charges, in one group or in all of them when groupId is empty. coverType PERCENT takes a percent of each charge, FIXED up to an amount of it
+// @Tags sponsor
+// @Accept json
+// @Produce json
+// @Param request body pb.Sponsorship true "Sponsorship"
+// @Success 200 {object} pb.Sponsorship
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/sponsor/sponsorships [post]
+func AddSponsorship(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.Sponsorship{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.AddSponsorship(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// EndSponsorship godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Ends a sponsorship after a day, today when endAt is not given
+// @Tags sponsor
+// @Produce json
+// @Param id path string true "Sponsorship ID"
+// @Param endAt query string false "Last day covered, YYYY-MM-DD"
+// @Success 200 {object} pb.Sponsorship
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/sponsor/sponsorships/{id}/end [post]
+func EndSponsorship(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.EndSponsorship(ctxR, ctx.Param("id"), ctx.Query("endAt"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetSponsorships godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Lists sponsorships, of a sponsor or of a student
+// @Tags sponsor
+// @Produce json
+// @Param sponsorId query string false "Sponsor ID"
+// @Param studentId query string false "Student ID"
+// @Success 200 {object} pb.SponsorshipList
+// @Security Bearer
+// @Router /api/finance/sponsor/sponsorships [get]
+func GetSponsorships(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetSponsorships(ctxR, ctx.Query("sponsorId"), ctx.Query("studentId"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// SponsorPaymentAdd godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Records money a sponsor paid in
+// @Tags sponsor
+// @Accept json
+// @Produce json
+// @Param request body pb.SponsorPaymentRequest true "Sponsor payment"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/sponsor/payments [post]
+func SponsorPaymentAdd(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.SponsorPaymentRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.SponsorPaymentAdd(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// SponsorPaymentReturn godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Returns a sponsor's payment. A linked reversal entry is added, covered charges go back with the refund of the student's month
+// @Tags sponsor
+// @Accept json
+// @Produce json
+// @Param request body pb.SponsorPaymentReturnRequest true "Sponsor payment return"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 403 {object} utils.AbsResponse "Already returned or not a payment"
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/sponsor/payments/return [post]
+func SponsorPaymentReturn(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.SponsorPaymentReturnRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.SponsorPaymentReturn(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetSponsorStatement godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Statement of a sponsor over a period, this month by default: the opening and closing balance, what it paid, the charges it covered and the students they were for
+// @Tags sponsor
+// @Produce json
+// @Param id path string true "Sponsor ID"
+// @Param from query string false "From, YYYY-MM-DD"
+// @Param to query string false "To, YYYY-MM-DD"
+// @Success 200 {object} pb.SponsorStatement
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/sponsor/{id}/statement [get]
+func GetSponsorStatement(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetSponsorStatement(ctxR, ctx.Param("id"), ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// PreviewPaymentPlan godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Shows the installments a payment plan would have without creating it
+// @Tags payment-plan
+// @Accept json
+// @Produce json
+// @Param request body pb.CreatePaymentPlanRequest true "Payment plan"
+// @Success 200 {object} pb.PaymentPlan
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/payment-plan/preview [post]
+func PreviewPaymentPlan(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CreatePaymentPlanRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.PreviewPaymentPlan(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CreatePaymentPlan godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Splits what a student owes for a group into installments: an explicit schedule, or total split evenly over installments intervalMonths apart from firstDueDate. Payments of the student are matched to the installments, the group's plan first. lateFeeType FIXED or PERCENT charges a fee once per installment overdue past graceDays
+// @Tags payment-plan
+// @Accept json
+// @Produce json
+// @Param request body pb.CreatePaymentPlanRequest true "Payment plan"
+// @Success 200 {object} pb.PaymentPlan
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 409 {object} utils.AbsResponse "The student already has a running plan in the group"
+// @Security Bearer
+// @Router /api/finance/payment-plan [post]
+func CreatePaymentPlan(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.CreatePaymentPlanRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.CreatePaymentPlan(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPaymentPlan godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description A payment plan with its installments and the payments matched to each of them
+// @Tags payment-plan
+// @Produce json
+// @Param id path string true "Payment plan ID"
+// @Success 200 {object} pb.PaymentPlan
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/payment-plan/{id} [get]
+func GetPaymentPlan(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetPaymentPlan(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CancelPaymentPlan godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Cancels a running payment plan, payments already matched to it stay matched
+// @Tags payment-plan
+// @Produce json
+// @Param id path string true "Payment plan ID"
+// @Param reason query string false "Reason"
+// @Success 200 {object} pb.PaymentPlan
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Failure 403 {object} utils.AbsResponse "The plan is not running"
+// @Security Bearer
+// @Router /api/finance/payment-plan/{id}/cancel [post]
+func CancelPaymentPlan(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.CancelPaymentPlan(ctxR, ctx.Param("id"), ctx.Query("reason"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetStudentPaymentPlans godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Where each payment plan of a student stands: ON_TRACK, OVERDUE, COMPLETED or CANCELLED, what is paid, overdue and due next
+// @Tags payment-plan
+// @Produce json
+// @Param studentId path string true "Student ID"
+// @Success 200 {object} pb.PaymentPlanStatusList
+// @Security Bearer
+// @Router /api/finance/payment-plan/student/{studentId} [get]
+func GetStudentPaymentPlans(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetStudentPaymentPlans(ctxR, ctx.Param("studentId"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetOverdueInstallments godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Unpaid installments of running plans past their due date and grace days, longest overdue first
+// @Tags payment-plan
+// @Produce json
+// @Param page query int false "Page" default(1)
+// @Param size query int false "Size" default(20)
+// @Success 200 {object} pb.OverdueInstallmentList
+// @Security Bearer
+// @Router /api/finance/payment-plan/overdue [get]
+func GetOverdueInstallments(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := financeClient.GetOverdueInstallments(ctxR, int32(page), int32(size))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetCashAccounts godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Cash desks, bank accounts and card terminals of the company with what is on each in its currency and its open shift
+// @Tags cash
+// @Produce json
+// @Success 200 {object} pb.CashAccountList
+// @Security Bearer
+// @Router /api/finance/cash/accounts [get]
+func GetCashAccounts(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetCashAccounts(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CreateCashAccount godoc
+// @Summary CEO , FINANCIST
+// @Description Adds a cash account. kind is CASH_DESK, BANK, CARD_TERMINAL or ONLINE, currency UZS or USD, method the payment method it takes. A default account receives the payments of its method that name no account. requiresShift refuses payments while no shift is open
+// @Tags cash
+// @Accept json
+// @Produce json
+// @Param request body pb.CashAccount true "Cash account"
+// @Success 200 {object} pb.CashAccount
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/cash/accounts [post]
+func CreateCashAccount(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CashAccount{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.CreateCashAccount(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateCashAccount godoc
+// @Summary CEO , FINANCIST
+// @Description Changes a cash account, isActive false closes it. The currency cannot change once money went through the account
+// @Tags cash
+// @Accept json
+// @Produce json
+// @Param id path string true "Cash account ID"
+// @Param request body pb.CashAccount true "Cash account"
+// @Success 200 {object} pb.CashAccount
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/cash/accounts/{id} [put]
+func UpdateCashAccount(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CashAccount{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.Id = ctx.Param("id")
+	resp, err := financeClient.UpdateCashAccount(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// OpenCashShift godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Opens a shift on a cash account with the money counted in it
+// @Tags cash
+// @Accept json
+// @Produce json
+// @Param id path string true "Cash account ID"
+// @Param request body pb.CashShiftRequest true "Counted money"
+// @Success 200 {object} pb.CashShift
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Failure 409 {object} utils.AbsResponse "A shift is already open"
+// @Security Bearer
+// @Router /api/finance/cash/accounts/{id}/shift/open [post]
+func OpenCashShift(ctx *gin.Context) {
+	cashShift(ctx, financeClient.OpenCashShift)
+}
+
+// CloseCashShift godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Closes the open shift of a cash account with the money counted in it. The shift keeps what was expected, the opening count plus payments in less money out, and the difference to the count
+// @Tags cash
+// @Accept json
+// @Produce json
+// @Param id path string true "Cash account ID"
+// @Param request body pb.CashShiftRequest true "Counted money"
+// @Success 200 {object} pb.CashShift
+// @Failure 403 {object} utils.AbsResponse "No shift is open"
+// @Security Bearer
+// @Router /api/finance/cash/accounts/{id}/shift/close [post]
+func CloseCashShift(ctx *gin.Context) {
+	cashShift(ctx, financeClient.CloseCashShift)
+}
+
+func cashShift(ctx *gin.Context, call func(context.Context, *pb.CashShiftRequest) (*pb.CashShift, error)) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.CashShiftRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.AccountId = ctx.Param("id")
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := call(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetCashShifts godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Shifts newest first, of one cash account or all of them. An open shift shows what is expected so far
+// @Tags cash
+// @Produce json
+// @Param accountId query string false "Cash account ID"
+// @Param page query int false "Page" default(1)
+// @Param size query int false "Size" default(20)
+// @Success 200 {object} pb.CashShiftList
+// @Security Bearer
+// @Router /api/finance/cash/shifts [get]
+func GetCashShifts(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := financeClient.GetCashShifts(ctxR, ctx.Query("accountId"), int32(page), int32(size))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// SetExchangeRate godoc
+// @Summary CEO , FINANCIST
+// @Description Records how many UZS one unit of a currency is from a day on, today by default. Payments and expenses of USD accounts are converted at the rate of their date
+// @Tags cash
+// @Accept json
+// @Produce json
+// @Param request body pb.ExchangeRate true "Exchange rate"
+// @Success 200 {object} pb.ExchangeRate
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/cash/rates [post]
+func SetExchangeRate(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.ExchangeRate{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.SetExchangeRate(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetExchangeRates godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Recorded exchange rates, newest first
+// @Tags cash
+// @Produce json
+// @Param currency query string false "Currency"
+// @Success 200 {object} pb.ExchangeRateList
+// @Security Bearer
+// @Router /api/finance/cash/rates [get]
+func GetExchangeRates(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetExchangeRates(ctxR, ctx.Query("currency"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetLedgerAccounts godoc
+// @Summary CEO , FINANCIST
+// @Description The chart of accounts of the company with the balance of each, debit less credit. The system accounts are created on first use
+// @Tags ledger
+// @Produce json
+// @Success 200 {object} pb.LedgerAccountList
+// @Security Bearer
+// @Router /api/finance/ledger/accounts [get]
+func GetLedgerAccounts(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetLedgerAccounts(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CreateLedgerAccount godoc
+// @Summary CEO , FINANCIST
+// @Description Adds an account to the chart. code is 3 to 10 digits, type ASSET, LIABILITY, EQUITY, INCOME or EXPENSE. Only an ASSET account can be isCash
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param request body pb.LedgerAccount true "Ledger account"
+// @Success 200 {object} pb.LedgerAccount
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 409 {object} utils.AbsResponse "The code is taken"
+// @Security Bearer
+// @Router /api/finance/ledger/accounts [post]
+func CreateLedgerAccount(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.LedgerAccount{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.CreateLedgerAccount(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetJournalEntries godoc
+// @Summary CEO , FINANCIST
+// @Description Journal entries newest first with their lines. Payments, charges, refunds and expenses post theirs on their own
+// @Tags ledger
+// @Produce json
+// @Param from query string false "From, YYYY-MM-DD"
+// @Param to query string false "To, YYYY-MM-DD"
+// @Param source query string false "STUDENT_PAYMENT, SPONSOR_PAYMENT, EXPENSE or MANUAL"
+// @Param accountCode query string false "Account code"
+// @Param studentId query string false "Student ID"
+// @Param page query int false "Page" default(1)
+// @Param size query int false "Size" default(20)
+// @Success 200 {object} pb.JournalEntryList
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/ledger/entries [get]
+func GetJournalEntries(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	size, _ := strconv.Atoi(ctx.DefaultQuery("size", "20"))
+	resp, err := financeClient.GetJournalEntries(ctxR, &pb.GetJournalEntriesRequest{
+		From: ctx.Query("from"), To: ctx.Query("to"), Source: ctx.Query("source"), AccountCode: ctx.Query("accountCode"),
+		StudentId: ctx.Query("studentId"), Page: int32(page), Size: int32(size),
+	})
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CreateJournalEntry godoc
+// @Summary CEO , FINANCIST
+// @Description Posts an entry by hand, such as money the owners put in. It needs at least two lines, each with a debit or a credit, and debits must equal credits. The balances of students and sponsors are moved by their payments only
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param request body pb.JournalEntry true "Journal entry"
+// @Success 200 {object} pb.JournalEntry
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/ledger/entries [post]
+func CreateJournalEntry(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.JournalEntry{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.CreateJournalEntry(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ReverseJournalEntry godoc
+// @Summary CEO , FINANCIST
+// @Description Reverses an entry posted by hand with one that swaps its debits and credits. Entries of payments and expenses are reversed by returning or deleting those
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param id path string true "Journal entry ID"
+// @Param request body pb.ReverseJournalEntryRequest true "Reason and date of the reversal"
+// @Success 200 {object} pb.JournalEntry
+// @Failure 403 {object} utils.AbsResponse "Not an entry made by hand, or already reversed"
+// @Failure 404 {object} utils.AbsResponse "Not Found"
+// @Security Bearer
+// @Router /api/finance/ledger/entries/{id}/reverse [post]
+func ReverseJournalEntry(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.ReverseJournalEntryRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.Id = ctx.Param("id")
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.ReverseJournalEntry(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetTrialBalance godoc
+// @Summary CEO , FINANCIST
+// @Description Debits and credits of every account up to a day, today by default. The totals are equal
+// @Tags ledger
+// @Produce json
+// @Param date query string false "Date, YYYY-MM-DD"
+// @Success 200 {object} pb.TrialBalance
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/ledger/trial-balance [get]
+func GetTrialBalance(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetTrialBalance(ctxR, ctx.Query("date"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetProfitAndLoss godoc
+// @Summary CEO , FINANCIST
+// @Description Income and expenses by account over a period, this month by default, and the profit. The income chart and the expense diagram show the same figures
+// @Tags ledger
+// @Produce json
+// @Param from query string false "From, YYYY-MM-DD"
+// @Param to query string false "To, YYYY-MM-DD"
+// @Success 200 {object} pb.ProfitAndLoss
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/ledger/profit-and-loss [get]
+func GetProfitAndLoss(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetProfitAndLoss(ctxR, ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetCashFlow godoc
+// @Summary CEO , FINANCIST
+// @Description Opening, money in, money out and closing of every cash account over a period, this month by default, and what the money came from or went to
+// @Tags ledger
+// @Produce json
+// @Param from query string false "From, YYYY-MM-DD"
+// @Param to query string false "To, YYYY-MM-DD"
+// @Success 200 {object} pb.CashFlow
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Security Bearer
+// @Router /api/finance/ledger/cash-flow [get]
+func GetCashFlow(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetCashFlow(ctxR, ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
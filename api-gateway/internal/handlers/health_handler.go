@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const readinessTimeout = 2 * time.Second
+
+type ReadinessResponse struct {
+	Status   string            `json:"status"`
+	Services map[string]string `json:"services"`
+}
+
+// Healthz godoc
+// @Summary Liveness probe
+// @Description Answers as long as the gateway process is running
+// @Tags health
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /healthz [get]
+func Healthz(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz godoc
+// @Summary Readiness probe
+// @Description Checks the health service of every downstream service, 503 when one of them is not serving
+// @Tags health
+// @Produce json
+// @Success 200 {object} handlers.ReadinessResponse
+// @Failure 503 {object} handlers.ReadinessResponse
+// @Router /readyz [get]
+func Readyz(ctx *gin.Context) {
+	checks := map[string]func(context.Context) error{
+		"user-service":      userClient.Check,
+		"education-service": educationClient.Check,
+		"lead-service":      leadClient.Check,
+		"finance-service":   financeClient.Check,
+	}
+	checkCtx, cancel := context.WithTimeout(ctx.Request.Context(), readinessTimeout)
+	defer cancel()
+
+	resp := ReadinessResponse{Status: "ok", Services: make(map[string]string, len(checks))}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check func(context.Context) error) {
+			defer wg.Done()
+			state := "ok"
+			if err := check(checkCtx); err != nil {
+				state = err.Error()
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			resp.Services[name] = state
+			if state != "ok" {
+				resp.Status = "unavailable"
+			}
+		}(name, check)
+	}
+	wg.Wait()
+
+	if resp.Status != "ok" {
+		ctx.JSON(http.StatusServiceUnavailable, resp)
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
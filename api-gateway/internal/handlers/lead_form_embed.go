@@ -0,0 +1,68 @@
+package handlers
+
+import "html/template"
+
+// leadFormEmbedTemplate renders the public lead form. The "website" input is a
+// honeypot hidden from people, bots that fill it are silently dropped.
+var leadFormEmbedTemplate = template.Must(template.New("lead-form").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Form.Title}}</title>
+  <style>
+    body { font-family: sans-serif; margin: 0; padding: 16px; }
+    form { display: flex; flex-direction: column; gap: 12px; max-width: 420px; }
+    label { display: flex; flex-direction: column; gap: 4px; font-size: 14px; }
+    input, textarea { padding: 8px; font-size: 14px; border: 1px solid #ccc; border-radius: 6px; }
+    button { padding: 10px; font-size: 15px; border: 0; border-radius: 6px; background: #2563eb; color: #fff; cursor: pointer; }
+    .hp { position: absolute; left: -10000px; }
+    #result { font-size: 14px; }
+  </style>
+</head>
+<body>
+<form id="lead-form">
+  <h3>{{.Form.Title}}</h3>
+  {{range .Form.Fields}}
+  <label>{{if .Label}}{{.Label}}{{else}}{{.Name}}{{end}}
+    {{if eq .Name "comment"}}<textarea name="{{.Name}}"{{if .Required}} required{{end}}></textarea>
+    {{else if eq .Name "phoneNumber"}}<input type="tel" name="{{.Name}}"{{if .Required}} required{{end}}>
+    {{else}}<input type="text" name="{{.Name}}"{{if .Required}} required{{end}}>{{end}}
+  </label>
+  {{end}}
+  <div class="hp" aria-hidden="true"><input type="text" name="website" tabindex="-1" autocomplete="off"></div>
+  <button type="submit">Yuborish</button>
+  <div id="result"></div>
+</form>
+<script>
+  (function () {
+    var form = document.getElementById('lead-form');
+    var result = document.getElementById('result');
+    var params = new URLSearchParams(window.location.search);
+    form.addEventListener('submit', function (e) {
+      e.preventDefault();
+      var body = { extra: {} };
+      new FormData(form).forEach(function (value, key) {
+        if (key === 'name' || key === 'phoneNumber' || key === 'comment' || key === 'website') {
+          body[key] = value;
+        } else {
+          body.extra[key] = value;
+        }
+      });
+      body.utmSource = params.get('utm_source') || '';
+      body.utmMedium = params.get('utm_medium') || '';
+      body.utmCampaign = params.get('utm_campaign') || '';
+      body.source = document.referrer;
+      fetch({{.SubmitUrl}}, { method: 'POST', headers: { 'Content-Type': 'application/json' }, body: JSON.stringify(body) })
+        .then(function (resp) { return resp.json(); })
+        .then(function (data) {
+          result.textContent = data.message || '';
+          if (data.status === 200) { form.reset(); }
+        })
+        .catch(function () { result.textContent = 'Error'; });
+    });
+  })();
+</script>
+</body>
+</html>
+`))
@@ -26,7 +26,7 @@ func CreateLead(ctx *gin.Context) {
 	value := ctx.Query("title")
 	resp, err := leadClient.CreateLead(ctxR, value)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -57,7 +57,7 @@ func GetLeadCommon(ctx *gin.Context) {
 
 	response, err := leadClient.GetLeadCommon(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -84,7 +84,7 @@ func UpdateLead(ctx *gin.Context) {
 	title := ctx.Query("title")
 	resp, err := leadClient.UpdateLead(ctxR, id, title)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -110,7 +110,7 @@ func DeleteLead(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := leadClient.DeleteLead(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -135,7 +135,7 @@ func CreateExpectation(ctx *gin.Context) {
 	title := ctx.Query("title")
 	resp, err := leadClient.CreateExpect(ctxR, title)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -162,7 +162,7 @@ func UpdateExpectation(ctx *gin.Context) {
 	title := ctx.Query("title")
 	resp, err := leadClient.UpdateExpect(ctxR, id, title)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -187,7 +187,7 @@ func DeleteExpectation(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := leadClient.DeleteExpect(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -217,7 +217,7 @@ func CreateSet(ctx *gin.Context) {
 	}
 	resp, err := leadClient.CreateSet(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -248,7 +248,7 @@ func UpdateSet(ctx *gin.Context) {
 	}
 	resp, err := leadClient.UpdateSet(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -273,7 +273,7 @@ func DeleteSet(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := leadClient.DeleteSet(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -303,7 +303,7 @@ func CreateLeadData(ctx *gin.Context) {
 	}
 	resp, err := leadClient.CreateLeadData(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -328,7 +328,7 @@ func DeleteLeadData(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := leadClient.DeleteLeadData(ctxR, id)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -358,7 +358,7 @@ func UpdateLeadData(ctx *gin.Context) {
 	}
 	resp, err := leadClient.UpdateLeadData(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -389,7 +389,37 @@ func ChangeLeadData(ctx *gin.Context) {
 	}
 	resp, err := leadClient.ChangeLeadPlace(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// MergeLeadData godoc
+// @Summary ADMIN , CEO
+// @Description Merge a duplicated lead into another one, the source lead is removed
+// @Tags leadData
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.MergeLeadDataRequest true "Lead merge request"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/leadData/merge [post]
+func MergeLeadData(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.MergeLeadDataRequest{}
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := leadClient.MergeLeadData(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -414,7 +444,7 @@ func GetAllLead(ctx *gin.Context) {
 
 	resp, err := leadClient.GetAllLead(ctxR)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -442,9 +472,16 @@ func ChangeToSet(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
 	resp, err := leadClient.ChangeSetToGroup(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -470,7 +507,7 @@ func GetLeadReports(ctx *gin.Context) {
 	till := ctx.Query("till")
 	resp, err := leadClient.GetLeadReports(from, till, ctxR)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -495,8 +532,156 @@ func GetByIdSet(ctx *gin.Context) {
 	id := ctx.Param("id")
 	resp, err := leadClient.GetByIdSet(id, ctxR)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
 }
+
+// GetLeadForm godoc
+// @Summary ADMIN , CEO
+// @Description Get the public lead form configuration of the company.
+// @Tags leadForm
+// @Accept json
+// @Produce json
+// @Success 200 {object} pb.LeadForm
+// @Failure 409 {object} utils.AbsResponse "Conflict error with details"
+// @Security Bearer
+// @Router /api/leadForm/get [get]
+func GetLeadForm(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := leadClient.GetLeadForm(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateLeadForm godoc
+// @Summary ADMIN , CEO
+// @Description Create or update the public lead form: fields, target lead section, spam limits.
+// @Tags leadForm
+// @Accept json
+// @Produce json
+// @Param request body pb.LeadForm true "Lead form"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/leadForm/update [put]
+func UpdateLeadForm(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	var req pb.LeadForm
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := leadClient.UpsertLeadForm(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetPublicLeadForm godoc
+// @Summary ALL
+// @Description Get the lead form of the company resolved by subdomain, used by landing pages.
+// @Tags leadForm
+// @Accept json
+// @Produce json
+// @Param subdomain path string true "Company subdomain"
+// @Success 200 {object} pb.LeadForm
+// @Failure 404 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/public/lead-form/{subdomain} [get]
+func GetPublicLeadForm(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := leadClient.GetLeadForm(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	if !resp.IsActive {
+		utils.RespondError(ctx, http.StatusNotFound, "lead form is disabled")
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// SubmitPublicLead godoc
+// @Summary ALL
+// @Description Submit a lead from a marketing site. utm_* query parameters are used when the body has none.
+// @Tags leadForm
+// @Accept json
+// @Produce json
+// @Param subdomain path string true "Company subdomain"
+// @Param request body pb.PublicLeadRequest true "Lead"
+// @Success 200 {object} pb.PublicLeadResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 404 {object} utils.AbsResponse
+// @Failure 429 {object} utils.AbsResponse
+// @Router /api/public/lead-form/{subdomain} [post]
+func SubmitPublicLead(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	var req pb.PublicLeadRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.UtmSource == "" {
+		req.UtmSource = ctx.Query("utm_source")
+	}
+	if req.UtmMedium == "" {
+		req.UtmMedium = ctx.Query("utm_medium")
+	}
+	if req.UtmCampaign == "" {
+		req.UtmCampaign = ctx.Query("utm_campaign")
+	}
+	if req.Source == "" {
+		req.Source = ctx.GetHeader("Referer")
+	}
+	req.ClientIp = ctx.ClientIP()
+	resp, err := leadClient.SubmitPublicLead(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// EmbedLeadForm godoc
+// @Summary ALL
+// @Description Html page with the lead form of the company, meant to be embedded with an iframe.
+// @Tags leadForm
+// @Produce html
+// @Param subdomain path string true "Company subdomain"
+// @Success 200 {string} string "html"
+// @Failure 404 {object} utils.AbsResponse
+// @Router /api/public/lead-form/{subdomain}/embed [get]
+func EmbedLeadForm(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	form, err := leadClient.GetLeadForm(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
+		return
+	}
+	if !form.IsActive {
+		utils.RespondError(ctx, http.StatusNotFound, "lead form is disabled")
+		return
+	}
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	err = leadFormEmbedTemplate.Execute(ctx.Writer, gin.H{
+		"Form":      form,
+		"SubmitUrl": "/api/public/lead-form/" + ctx.Param("subdomain"),
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"api-gateway/grpc/proto/pb"
 	"api-gateway/internal/etc"
 	"api-gateway/internal/utils"
-	"context"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/metadata"
 	"net/http"
@@ -34,7 +33,7 @@ func CreateUser(ctx *gin.Context) {
 	}
 	resp, err := userClient.CreateUser(ctxR, req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -63,7 +62,7 @@ func GetTeachers(ctx *gin.Context) {
 	defer cancel()
 	resp, err := userClient.GetTeachers(ctxR, isDeleted)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -85,7 +84,7 @@ func GetUserById(ctx *gin.Context) {
 	userId := ctx.Param("userId")
 	response, err := userClient.GetUserById(ctxR, userId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -121,7 +120,7 @@ func UpdateUserById(ctx *gin.Context) {
 	}
 	resp, err := userClient.UpdateUserById(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -143,7 +142,7 @@ func DeleteUserById(ctx *gin.Context) {
 	userId := ctx.Param("userId")
 	resp, err := userClient.DeleteUserById(ctxR, userId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -174,7 +173,7 @@ func GetAllEmployee(ctx *gin.Context) {
 	}
 	resp, err := userClient.GetAllEmployee(ctxR, isArchived)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -190,9 +189,10 @@ func GetAllEmployee(ctx *gin.Context) {
 // @Param LoginRequest body pb.LoginRequest true "Login credentials"
 // @Success 200 {object} pb.LoginResponse "Successful login"
 // @Failure 400 {object} utils.AbsResponse "Bad request - Invalid JSON or login failure"
+// @Failure 403 {object} utils.AbsResponse "Subscription of the company expired and it is locked"
 // @Router /api/user/login [post]
 func Login(ctx *gin.Context) {
-	ctxR := context.Background()
+	ctxR := ctx.Request.Context()
 	req := pb.LoginRequest{}
 	err := ctx.ShouldBindJSON(&req)
 	if err != nil {
@@ -201,7 +201,7 @@ func Login(ctx *gin.Context) {
 	}
 	resp, err := userClient.Login(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -234,7 +234,7 @@ func GetMyInformation(ctx *gin.Context) {
 	if user.Role == "TEACHER" {
 		groups, err := educationClient.GetInformationByTeacher(ctxR, user.Id, false)
 		if err != nil {
-			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 			return
 		}
 		response := map[string]interface{}{
@@ -270,7 +270,7 @@ func GetAllStaff(ctx *gin.Context) {
 	}
 	resp, err := userClient.GetAllStuff(ctxR, isArchived)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 	}
 	ctx.JSON(http.StatusOK, resp)
 	return
@@ -291,7 +291,7 @@ func GetUserHistoryById(ctx *gin.Context) {
 	userId := ctx.Param("userId")
 	resp, err := userClient.GetHistoryByUserId(ctxR, userId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -317,7 +317,7 @@ func UpdateUserPassword(ctx *gin.Context) {
 	password := ctx.Param("password")
 	resp, err := userClient.UpdateUserPassword(ctxR, userId, password)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
@@ -341,7 +341,7 @@ func CreateUserForCompany(ctx *gin.Context) {
 	companyId := ctx.Query("companyId")
 	md := metadata.Pairs()
 	md.Set("company_id", companyId)
-	ctxR := metadata.NewOutgoingContext(context.Background(), md)
+	ctxR := metadata.NewOutgoingContext(ctx.Request.Context(), md)
 
 	var userForCompany pb.CreateUserRequest
 	if err := ctx.ShouldBindJSON(&userForCompany); err != nil {
@@ -350,7 +350,7 @@ func CreateUserForCompany(ctx *gin.Context) {
 	}
 	resp, err := userClient.CreateUser(ctxR, &userForCompany)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -376,10 +376,10 @@ func GetUserByIdForCompany(ctx *gin.Context) {
 	userId := ctx.Param("userId")
 	md := metadata.Pairs()
 	md.Set("company_id", companyId)
-	ctxR := metadata.NewOutgoingContext(ctx, md)
+	ctxR := metadata.NewOutgoingContext(ctx.Request.Context(), md)
 	response, err := userClient.GetUserById(ctxR, userId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	ctx.JSON(http.StatusOK, response)
@@ -409,10 +409,10 @@ func UpdateUserbyIdForCompany(ctx *gin.Context) {
 	companyId := ctx.Query("companyId")
 	md := metadata.Pairs()
 	md.Set("company_id", companyId)
-	ctxR := metadata.NewOutgoingContext(ctx, md)
+	ctxR := metadata.NewOutgoingContext(ctx.Request.Context(), md)
 	resp, err := userClient.UpdateUserById(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
@@ -438,10 +438,10 @@ func DeleteUserByIdForCompany(ctx *gin.Context) {
 	userId := ctx.Param("userId")
 	md := metadata.Pairs()
 	md.Set("company_id", companyId)
-	ctxR := metadata.NewOutgoingContext(ctx, md)
+	ctxR := metadata.NewOutgoingContext(ctx.Request.Context(), md)
 	resp, err := userClient.DeleteUserById(ctxR, userId)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		utils.RespondError(ctx, utils.GrpcErrorStatus(err), utils.GrpcErrorMessage(err))
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
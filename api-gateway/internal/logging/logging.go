@@ -0,0 +1,112 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Init makes slog write JSON lines to stdout for the given level (debug,
+// info, warn or error). Messages of the standard log package end up there
+// too, at info level, and gin's debug messages at debug level.
+func Init(service, level string) error {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(strings.TrimSpace(level))); err != nil {
+		return fmt.Errorf("unknown log level %q, use debug, info, warn or error", level)
+	}
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: lvl})
+	slog.SetDefault(slog.New(contextHandler{handler}).With("service", service))
+	gin.DebugPrintFunc = func(format string, values ...interface{}) {
+		slog.Debug(strings.TrimSpace(fmt.Sprintf(format, values...)))
+	}
+	return nil
+}
+
+// Fatal logs err and stops the gateway, for failures it cannot start with.
+func Fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// contextHandler adds what is known about the request being served to every
+// record logged with a context: the tenant and user set by the auth
+// middleware and the trace.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx == nil {
+		return h.Handler.Handle(ctx, record)
+	}
+	for _, key := range []string{"company_id", "user_id"} {
+		if value, ok := ctx.Value(key).(string); ok && value != "" {
+			record.AddAttrs(slog.String(key, value))
+		}
+	}
+	spanContext := trace.SpanContextFromContext(ctx)
+	if ginCtx, ok := ctx.(*gin.Context); ok && ginCtx.Request != nil {
+		spanContext = trace.SpanContextFromContext(ginCtx.Request.Context())
+	}
+	if spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+// Middleware logs one line per request, in place of the gin logger. Server
+// errors are logged as errors, client errors as warnings.
+func Middleware(ctx *gin.Context) {
+	start := time.Now()
+	ctx.Next()
+	route := ctx.FullPath()
+	if route == "" {
+		route = "unmatched"
+	}
+	level := slog.LevelInfo
+	switch status := ctx.Writer.Status(); {
+	case status >= http.StatusInternalServerError:
+		level = slog.LevelError
+	case status >= http.StatusBadRequest:
+		level = slog.LevelWarn
+	}
+	attrs := []slog.Attr{
+		slog.String("http_method", ctx.Request.Method),
+		slog.String("route", route),
+		slog.String("path", ctx.Request.URL.Path),
+		slog.Int("status", ctx.Writer.Status()),
+		slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+		slog.String("client_ip", ctx.ClientIP()),
+	}
+	if len(ctx.Errors) > 0 {
+		attrs = append(attrs, slog.String("error", ctx.Errors.String()))
+	}
+	slog.LogAttrs(ctx, level, "request handled", attrs...)
+}
+
+// Recovery turns a panic in a handler into a 500 and logs it with the
+// request attributes.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(ctx *gin.Context, recovered any) {
+		slog.ErrorContext(ctx, "recovered from panic in handler", "panic", recovered)
+		ctx.AbortWithStatus(http.StatusInternalServerError)
+	})
+}
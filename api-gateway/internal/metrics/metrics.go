@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"time"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests handled by the gateway, by method, route and status.",
+	}, []string{"method", "route", "status"})
+	httpLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Time the gateway spent on an HTTP request.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+	clientHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_handled_total",
+		Help: "RPCs made to other services, by target, method and status code.",
+	}, []string{"target", "method", "code"})
+	clientLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_client_handling_seconds",
+		Help:    "Time until another service answered an RPC.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"target", "method"})
+)
+
+// Middleware records every request under its route pattern, so paths with
+// ids do not create a series per id. Unknown paths are grouped together.
+func Middleware(ctx *gin.Context) {
+	start := time.Now()
+	ctx.Next()
+	route := ctx.FullPath()
+	if route == "" {
+		route = "unmatched"
+	}
+	httpLatency.WithLabelValues(ctx.Request.Method, route).Observe(time.Since(start).Seconds())
+	httpRequests.WithLabelValues(ctx.Request.Method, route, strconv.Itoa(ctx.Writer.Status())).Inc()
+}
+
+// Handler serves the collected metrics.
+func Handler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
+
+// UnaryClientInterceptor records the calls made to target.
+func UnaryClientInterceptor(target string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		clientLatency.WithLabelValues(target, method).Observe(time.Since(start).Seconds())
+		clientHandled.WithLabelValues(target, method, status.Code(err).String()).Inc()
+		return err
+	}
+}
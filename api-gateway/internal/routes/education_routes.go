@@ -5,6 +5,7 @@ import (
 	"api-gateway/internal/etc"
 	"api-gateway/internal/handlers"
 	"github.com/gin-gonic/gin"
+	"time"
 )
 
 func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
@@ -18,13 +19,35 @@ func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		image.GET("/get-image", handlers.GetImage)
 	}
 
+	signup := api.Group("/public/signup")
+	{
+		signup.GET("/check-subdomain/:subdomain", etc.RateLimitMiddleware(60, time.Minute), handlers.CheckSubdomain)
+		signup.POST("", etc.RateLimitMiddleware(5, time.Hour), handlers.Signup)
+	}
+
 	company := api.Group("/company")
 	{
 		company.GET("/subdomain/:domain", handlers.GetCompanyBySubdomain)
 		company.POST("/create", handlers.CompanyCreate)
 		company.GET("/get-all", handlers.GetAllCompanies)
 		company.PUT("/update", handlers.CompanyUpdate)
-		company.POST("/get-statistic", handlers.GetStatisticCompany)
+		company.POST("/get-statistic", etc.AuthMiddleware([]string{"SUPER_CEO"}, userClient), handlers.GetStatisticCompany)
+		company.GET("/subscription", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER", "SUPER_CEO", "FINANCIST"}, userClient), handlers.GetSubscription)
+		company.POST("/subscription/quote", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.SubscriptionQuote)
+		company.POST("/subscription/invoices", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.SubscriptionCreateInvoice)
+		company.GET("/subscription/invoices", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.SubscriptionInvoices)
+		billing := company.Group("/billing", etc.AuthMiddleware([]string{"SUPER_CEO"}, userClient))
+		{
+			billing.POST("/quote", handlers.BillingQuote)
+			billing.POST("/invoices", handlers.BillingCreateInvoice)
+			billing.GET("/invoices", handlers.BillingInvoices)
+			billing.DELETE("/invoices/:id", handlers.BillingVoidInvoice)
+			billing.POST("/promo-codes", handlers.CreatePromoCode)
+			billing.GET("/promo-codes", handlers.GetPromoCodes)
+			billing.DELETE("/promo-codes/:code", handlers.DeactivatePromoCode)
+			billing.PUT("/override", handlers.SetPriceOverride)
+			billing.GET("/override/:companyId", handlers.GetPriceOverride)
+		}
 		tariff := company.Group("/tariff")
 		{
 			tariff.POST("/create", handlers.TariffCreate)
@@ -42,6 +65,14 @@ func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		}
 	}
 
+	analytics := api.Group("/analytics", etc.AuthMiddleware([]string{"SUPER_CEO"}, userClient))
+	{
+		analytics.GET("/metrics", handlers.GetPlatformMetrics)
+		analytics.GET("/tenants", handlers.GetTenantHealth)
+		analytics.GET("/history", handlers.GetPlatformHistory)
+		analytics.GET("/tenants/:companyId/history", handlers.GetTenantHistory)
+	}
+
 	room := api.Group("/room")
 	{
 		room.POST("/create", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreateRoom)
@@ -88,6 +119,8 @@ func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		student.DELETE("/delete/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteStudent)
 		student.POST("/add-to-group", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.AddStudentToGroup)
 		student.PUT("/change-condition", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.ChangeConditionStudent)
+		student.GET("/find-by-phone", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.FindStudentsByPhone)
+		student.POST("/merge", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.MergeStudents)
 
 		studentNote := student.Group("/note")
 		{
@@ -102,4 +135,26 @@ func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		history.GET("/group/:groupId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetHistoryGroup)
 		history.GET("/student/:studentId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetHistoryStudent)
 	}
+
+	notification := api.Group("/notification")
+	{
+		notification.GET("/settings", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.GetNotificationSettings)
+		notification.PUT("/settings", etc.AuthMiddleware([]string{"CEO"}, userClient), handlers.UpdateNotificationSettings)
+		notification.GET("/outbox", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.GetNotificationOutbox)
+	}
+
+	debtReminder := api.Group("/debt-reminder")
+	{
+		debtReminder.GET("/settings", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetDebtReminderSettings)
+		debtReminder.PUT("/settings", etc.AuthMiddleware([]string{"CEO"}, userClient), handlers.UpdateDebtReminderSettings)
+		debtReminder.GET("/list", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetDebtReminders)
+		debtReminder.POST("/reply", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.SetDebtReminderReply)
+	}
+
+	reconciliation := api.Group("/reconciliation")
+	{
+		reconciliation.POST("/run", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.RunBalanceReconciliation)
+		reconciliation.GET("/reports", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetReconciliationReports)
+		reconciliation.GET("/reports/:id", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetReconciliationReport)
+	}
 }
@@ -16,6 +16,13 @@ func FinanceRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 			discount.POST("/create", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreateDiscount)
 			discount.DELETE("/delete", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteDiscount)
 			discount.GET("/history/:userId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetHistoryDiscount)
+			discount.GET("/rules", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetDiscountRules)
+			discount.POST("/rules", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreateDiscountRule)
+			discount.PUT("/rules/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.UpdateDiscountRule)
+			discount.DELETE("/rules/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteDiscountRule)
+			discount.GET("/policy", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetDiscountPolicy)
+			discount.PUT("/policy", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.SetDiscountPolicy)
+			discount.GET("/applications", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetRuleApplications)
 		}
 		category := finance.Group("/category")
 		{
@@ -42,6 +49,52 @@ func FinanceRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 			payment.POST("/all-student-payments", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetAllStudentPayment)
 			payment.POST("/all-student-payments/chart", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetAllPaymentsStudentChart)
 			payment.GET("/get-all-debts/:page/:size", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetAllDebtsInformation)
+			payment.GET("/balance-events/failed", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetFailedBalanceEvents)
+			payment.POST("/balance-events/retry", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.RetryBalanceEvents)
+		}
+		sponsor := finance.Group("/sponsor")
+		{
+			sponsor.GET("", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetSponsors)
+			sponsor.POST("", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreateSponsor)
+			sponsor.PUT("/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.UpdateSponsor)
+			sponsor.DELETE("/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteSponsor)
+			sponsor.GET("/:id/statement", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetSponsorStatement)
+			sponsor.GET("/sponsorships", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetSponsorships)
+			sponsor.POST("/sponsorships", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.AddSponsorship)
+			sponsor.POST("/sponsorships/:id/end", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.EndSponsorship)
+			sponsor.POST("/payments", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.SponsorPaymentAdd)
+			sponsor.POST("/payments/return", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.SponsorPaymentReturn)
+		}
+		plan := finance.Group("/payment-plan")
+		{
+			plan.POST("", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreatePaymentPlan)
+			plan.POST("/preview", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.PreviewPaymentPlan)
+			plan.GET("/overdue", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetOverdueInstallments)
+			plan.GET("/student/:studentId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetStudentPaymentPlans)
+			plan.GET("/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetPaymentPlan)
+			plan.POST("/:id/cancel", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CancelPaymentPlan)
+		}
+		cash := finance.Group("/cash")
+		{
+			cash.GET("/accounts", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetCashAccounts)
+			cash.POST("/accounts", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.CreateCashAccount)
+			cash.PUT("/accounts/:id", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.UpdateCashAccount)
+			cash.POST("/accounts/:id/shift/open", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.OpenCashShift)
+			cash.POST("/accounts/:id/shift/close", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CloseCashShift)
+			cash.GET("/shifts", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetCashShifts)
+			cash.GET("/rates", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetExchangeRates)
+			cash.POST("/rates", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.SetExchangeRate)
+		}
+		ledger := finance.Group("/ledger")
+		{
+			ledger.GET("/accounts", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetLedgerAccounts)
+			ledger.POST("/accounts", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.CreateLedgerAccount)
+			ledger.GET("/entries", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetJournalEntries)
+			ledger.POST("/entries", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.CreateJournalEntry)
+			ledger.POST("/entries/:id/reverse", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.ReverseJournalEntry)
+			ledger.GET("/trial-balance", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetTrialBalance)
+			ledger.GET("/profit-and-loss", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetProfitAndLoss)
+			ledger.GET("/cash-flow", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetCashFlow)
 		}
 		salary := finance.Group("/salary")
 		{
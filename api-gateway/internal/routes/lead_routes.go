@@ -7,7 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func LeadRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
+func LeadRoutes(api *gin.RouterGroup, userClient *client.UserClient, educationClient *client.EducationClient) {
 	lead := api.Group("/lead")
 	{
 		lead.POST("/create", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.CreateLead)
@@ -37,5 +37,17 @@ func LeadRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		leadData.PUT("/update", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.UpdateLeadData)
 		leadData.DELETE("/delete/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.DeleteLeadData)
 		leadData.PATCH("/change-lead-data", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.ChangeLeadData)
+		leadData.POST("/merge", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.MergeLeadData)
+	}
+	leadForm := api.Group("/leadForm")
+	{
+		leadForm.GET("/get", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.GetLeadForm)
+		leadForm.PUT("/update", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.UpdateLeadForm)
+	}
+	publicLeadForm := api.Group("/public/lead-form/:subdomain", etc.SubdomainMiddleware(educationClient))
+	{
+		publicLeadForm.GET("", handlers.GetPublicLeadForm)
+		publicLeadForm.POST("", handlers.SubmitPublicLead)
+		publicLeadForm.GET("/embed", handlers.EmbedLeadForm)
 	}
 }
@@ -2,16 +2,21 @@ package routes
 
 import (
 	client "api-gateway/internal/clients"
+	"api-gateway/internal/handlers"
+	"api-gateway/internal/metrics"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func SetUpRoutes(r *gin.Engine, userClient *client.UserClient) {
+func SetUpRoutes(r *gin.Engine, userClient *client.UserClient, educationClient *client.EducationClient) {
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	r.GET("/healthz", handlers.Healthz)
+	r.GET("/readyz", handlers.Readyz)
+	r.GET("/metrics", metrics.Handler())
 	api := r.Group("/api")
 	{
-		LeadRoutes(api, userClient)
+		LeadRoutes(api, userClient, educationClient)
 		EducationRoutes(api, userClient)
 		UserRoutes(api, userClient)
 		FinanceRoutes(api, userClient)
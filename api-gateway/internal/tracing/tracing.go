@@ -0,0 +1,124 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/stats"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+
+	flushTimeout = 5 * time.Second
+)
+
+// Init installs the tracer provider of the service. exporter is one of
+// otlp, stdout or none; with none spans are not recorded but incoming trace
+// context is still passed on to the next service. The returned function
+// flushes the spans not yet exported, call it on shutdown.
+func Init(ctx context.Context, service, exporter, endpoint string, sampleRatio float64) (func(), error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var spanExporter sdktrace.SpanExporter
+	var err error
+	switch exporter {
+	case "", ExporterNone:
+		return func() {}, nil
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		spanExporter, err = otlptracegrpc.New(ctx, otlpOptions(endpoint)...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, use otlp, stdout or none", exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(service)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe tracing resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	slog.Info("tracing enabled", "exporter", exporter)
+	return func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+		defer cancel()
+		if err := provider.Shutdown(flushCtx); err != nil {
+			slog.Error("failed to flush spans", "error", err)
+		}
+	}, nil
+}
+
+// otlpOptions accepts both a bare host:port and a url, the collector is
+// reached without TLS unless the url says https.
+func otlpOptions(endpoint string) []otlptracegrpc.Option {
+	switch {
+	case endpoint == "":
+		return nil
+	case strings.Contains(endpoint, "://"):
+		return []otlptracegrpc.Option{otlptracegrpc.WithEndpointURL(endpoint)}
+	default:
+		return []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure()}
+	}
+}
+
+// ClientHandler starts a span for every outgoing RPC and sends the trace
+// context along with the rest of the metadata.
+func ClientHandler() stats.Handler {
+	return otelgrpc.NewClientHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck())))
+}
+
+// Middleware starts a span for every request, continuing the trace of the
+// caller when it sent one. Probes and metric scrapes are left out.
+func Middleware(service string) gin.HandlerFunc {
+	return otelgin.Middleware(service, otelgin.WithFilter(func(r *http.Request) bool {
+		switch r.URL.Path {
+		case "/healthz", "/readyz", "/metrics":
+			return false
+		}
+		return true
+	}))
+}
+
+// TraceHeader returns the trace id of the request in the X-Trace-Id header,
+// so a failed request can be looked up by whoever got the response.
+func TraceHeader(ctx *gin.Context) {
+	if traceId := TraceID(ctx.Request.Context()); traceId != "" {
+		ctx.Header("X-Trace-Id", traceId)
+	}
+	ctx.Next()
+}
+
+// TraceID returns the id of the trace ctx belongs to, empty when the
+// request is not traced.
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
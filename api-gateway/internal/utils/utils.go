@@ -2,14 +2,19 @@ package utils
 
 import (
 	"api-gateway/grpc/proto/pb"
+	"api-gateway/internal/tracing"
 	"errors"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
 )
 
 // AbsResponse represents an error API response
 type AbsResponse struct {
 	Status  int32  `json:"statusCode"`
 	Message string `json:"message"`
+	TraceId string `json:"traceId,omitempty"`
 }
 
 func RespondSuccess(ctx *gin.Context, statusCode int32, message string) {
@@ -23,6 +28,7 @@ func RespondError(ctx *gin.Context, statusCode int32, message string) {
 	ctx.JSON(int(statusCode), AbsResponse{
 		Status:  statusCode,
 		Message: message,
+		TraceId: tracing.TraceID(ctx.Request.Context()),
 	})
 }
 
@@ -40,3 +46,37 @@ func GetUserFromContext(c *gin.Context) (*pb.GetUserByIdResponse, error) {
 	}
 	return user, nil
 }
+
+// GrpcErrorStatus maps a downstream gRPC error onto the closest http status code.
+func GrpcErrorStatus(err error) int32 {
+	st, ok := status.FromError(err)
+	if !ok {
+		return http.StatusConflict
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.PermissionDenied, codes.FailedPrecondition:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusConflict
+	}
+}
+
+// GrpcErrorMessage strips the gRPC "rpc error: code = ... desc =" prefix.
+func GrpcErrorMessage(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
@@ -1,7 +1,15 @@
 package main
 
-import "education-service/internal/server"
+import (
+	"education-service/internal/server"
+	"flag"
+)
 
 func main() {
+	flag.Parse()
+	if flag.Arg(0) == "migrate" {
+		server.RunMigrate(flag.Args()[1:])
+		return
+	}
 	server.RunServer()
 }
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const defaultPath = "config/config.yaml"
+
+const redacted = "******"
+
+var configPath = flag.String("config", "", "path to the yaml config file, CONFIG_PATH is used when empty")
+
+type envTag struct {
+	name     string
+	required bool
+	secret   bool
+}
+
+// load fills cfg in layers: the defaults already set on cfg, then the yaml
+// file, then the environment variables named in the env tags. The default
+// file may be missing, a path given by flag or CONFIG_PATH must exist.
+// Fields tagged `env:"NAME,required"` must end up set.
+func load(cfg interface{}) error {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+	path, explicit := *configPath, true
+	if path == "" {
+		path = os.Getenv("CONFIG_PATH")
+	}
+	if path == "" {
+		path, explicit = defaultPath, false
+	}
+	bytes, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err = yaml.Unmarshal(bytes, cfg); err != nil {
+			return fmt.Errorf("failed to parse config file %s: %w", path, err)
+		}
+	case errors.Is(err, os.ErrNotExist) && !explicit:
+	default:
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	value := reflect.ValueOf(cfg).Elem()
+	err = walk(value, "", func(field reflect.Value, path string, tag envTag) error {
+		raw, ok := os.LookupEnv(tag.name)
+		if !ok {
+			return nil
+		}
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(raw)
+		case reflect.Int:
+			number, err := strconv.Atoi(raw)
+			if err != nil {
+				return fmt.Errorf("%s must be a number, got %q", tag.name, raw)
+			}
+			field.SetInt(int64(number))
+		case reflect.Float64:
+			number, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return fmt.Errorf("%s must be a number, got %q", tag.name, raw)
+			}
+			field.SetFloat(number)
+		case reflect.Bool:
+			enabled, err := strconv.ParseBool(raw)
+			if err != nil {
+				return fmt.Errorf("%s must be true or false, got %q", tag.name, raw)
+			}
+			field.SetBool(enabled)
+		default:
+			return fmt.Errorf("%s: unsupported config field type %s", path, field.Kind())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var missing []error
+	_ = walk(value, "", func(field reflect.Value, path string, tag envTag) error {
+		if tag.required && field.IsZero() {
+			missing = append(missing, fmt.Errorf("%s is required, set it in the config file or with %s", path, tag.name))
+		}
+		return nil
+	})
+	return errors.Join(missing...)
+}
+
+// redact renders cfg as yaml with every field tagged secret masked, so a
+// config can be logged as a whole.
+func redact(cfg interface{}) string {
+	value := reflect.New(reflect.TypeOf(cfg))
+	value.Elem().Set(reflect.ValueOf(cfg))
+	_ = walk(value.Elem(), "", func(field reflect.Value, path string, tag envTag) error {
+		if tag.secret && field.Kind() == reflect.String && field.String() != "" {
+			field.SetString(redacted)
+		}
+		return nil
+	})
+	bytes, err := yaml.Marshal(value.Interface())
+	if err != nil {
+		return fmt.Sprintf("<config: %v>", err)
+	}
+	return string(bytes)
+}
+
+// walk calls visit for every field with an env tag, path is the yaml path of
+// the field.
+func walk(value reflect.Value, prefix string, visit func(field reflect.Value, path string, tag envTag) error) error {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		structField := valueType.Field(i)
+		name, _, _ := strings.Cut(structField.Tag.Get("yaml"), ",")
+		if name == "" {
+			name = structField.Name
+		}
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		field := value.Field(i)
+		if field.Kind() == reflect.Struct {
+			if err := walk(field, name, visit); err != nil {
+				return err
+			}
+			continue
+		}
+		rawTag := structField.Tag.Get("env")
+		if rawTag == "" {
+			continue
+		}
+		parts := strings.Split(rawTag, ",")
+		tag := envTag{name: parts[0]}
+		for _, option := range parts[1:] {
+			switch option {
+			case "required":
+				tag.required = true
+			case "secret":
+				tag.secret = true
+			}
+		}
+		if err := visit(field, name, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,19 +2,22 @@ package clients
 
 import (
 	"context"
+	"education-service/internal/money"
 	"education-service/proto/pb"
-	"google.golang.org/grpc"
-	"strconv"
+	"fmt"
+	"github.com/shopspring/decimal"
 )
 
 type FinanceClient struct {
 	discountClient      pb.DiscountServiceClient
 	paymentClient       pb.PaymentServiceClient
 	teacherSalaryClient pb.TeacherSalaryServiceClient
+	categoryClient      pb.CategoryServiceClient
+	planClient          pb.PaymentPlanServiceClient
 }
 
 func NewFinanceClient(addr string) (*FinanceClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("finance-service", addr)
 	if err != nil {
 		return nil, err
 	}
@@ -22,23 +25,57 @@ func NewFinanceClient(addr string) (*FinanceClient, error) {
 	discountClient := pb.NewDiscountServiceClient(conn)
 	paymentClient := pb.NewPaymentServiceClient(conn)
 	teacherSalaryClient := pb.NewTeacherSalaryServiceClient(conn)
-	return &FinanceClient{discountClient: discountClient, paymentClient: paymentClient, teacherSalaryClient: teacherSalaryClient}, nil
+	categoryClient := pb.NewCategoryServiceClient(conn)
+	planClient := pb.NewPaymentPlanServiceClient(conn)
+	return &FinanceClient{discountClient: discountClient, paymentClient: paymentClient, teacherSalaryClient: teacherSalaryClient, categoryClient: categoryClient, planClient: planClient}, nil
 
 }
 
-func (fc *FinanceClient) GetDiscountByStudentId(ctx context.Context, studentId, groupId string) (*float64, string) {
-	resp, err := fc.discountClient.GetDiscountByStudentId(ctx, &pb.GetDiscountByStudentIdRequest{StudentId: studentId, GroupId: groupId})
+// Discount is what a student gets off a month of a group. TeacherAmount is
+// the part of it that comes out of the teacher's share, Applied what it is
+// made of; a charge sends Applied back with its TAKE_OFF.
+type Discount struct {
+	Amount        decimal.Decimal
+	TeacherAmount decimal.Decimal
+	Owner         string
+	Applied       []*pb.AppliedDiscount
+}
+
+// GetDiscountByStudentId is nil when the student has no discount. An error
+// means finance-service could not be asked and the price is not known.
+func (fc *FinanceClient) GetDiscountByStudentId(ctx context.Context, req *pb.GetDiscountByStudentIdRequest) (*Discount, error) {
+	resp, err := fc.discountClient.GetDiscountByStudentId(ctx, req)
 	if err != nil {
-		return nil, "CENTER"
+		return nil, err
 	}
 	if !resp.IsHave {
-		return nil, "CENTER"
+		return nil, nil
+	}
+	amount, err := money.Parse(resp.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid discount amount: %w", err)
 	}
-	discountAmount, err := strconv.ParseFloat(resp.Amount, 64)
-	return &discountAmount, resp.DiscountOwner
+	teacherAmount, err := money.Parse(resp.TeacherAmount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid teacher discount amount: %w", err)
+	}
+	return &Discount{Amount: amount, TeacherAmount: teacherAmount, Owner: resp.DiscountOwner, Applied: resp.Applied}, nil
 }
 
-func (fc *FinanceClient) PaymentAdd(ctx context.Context, comment, date, method, sum, userId, paymentType, actionById, actionByName, groupId, studentconditiondate string) (*pb.AbsResponse, error) {
+// GetStudentPaymentPlans is where the student's installment plans stand,
+// nil when finance-service could not be asked.
+func (fc *FinanceClient) GetStudentPaymentPlans(ctx context.Context, studentId string) []*pb.PaymentPlanStatus {
+	resp, err := fc.planClient.GetStudentPaymentPlans(ctx, &pb.StudentPaymentPlansRequest{StudentId: studentId})
+	if err != nil {
+		return nil
+	}
+	return resp.Items
+}
+
+// PaymentAdd books the charge or refund of a student's month in a group.
+// finance-service splits a sponsored one with the student's sponsors and
+// records the discount rules a charge was priced with.
+func (fc *FinanceClient) PaymentAdd(ctx context.Context, comment, date, method, sum, userId, paymentType, actionById, actionByName, groupId, studentconditiondate string, sponsored bool, discounts []*pb.AppliedDiscount) (*pb.AbsResponse, error) {
 	return fc.paymentClient.PaymentAdd(ctx, &pb.PaymentAddRequest{
 		Comment:              comment,
 		Date:                 date,
@@ -50,9 +87,28 @@ func (fc *FinanceClient) PaymentAdd(ctx context.Context, comment, date, method,
 		ActionByName:         actionByName,
 		GroupId:              groupId,
 		Studentconditiondate: studentconditiondate,
+		Sponsored:            sponsored,
+		Discounts:            discounts,
 	})
 }
 
 func (fc *FinanceClient) GetTeacherSalaryByTeacherID(ctx context.Context, teacherId string) (*pb.AbsGetTeachersSalary, error) {
 	return fc.teacherSalaryClient.GetTeacherSalaryByTeacherID(ctx, &pb.DeleteTeacherSalaryRequest{TeacherId: teacherId})
 }
+
+func (fc *FinanceClient) MergeStudent(ctx context.Context, sourceStudentId, targetStudentId string) (*pb.AbsResponse, error) {
+	return fc.paymentClient.MergeStudent(ctx, &pb.MergeStudentRequest{SourceStudentId: sourceStudentId, TargetStudentId: targetStudentId})
+}
+
+func (fc *FinanceClient) GetStudentLedgerBalances(ctx context.Context) (*pb.GetStudentLedgerBalancesResponse, error) {
+	return fc.paymentClient.GetStudentLedgerBalances(ctx, &pb.GetStudentLedgerBalancesRequest{})
+}
+
+func (fc *FinanceClient) CreateCategory(ctx context.Context, name, desc string) error {
+	_, err := fc.categoryClient.CreateCategory(ctx, &pb.CreateCategoryRequest{Name: name, Desc: desc})
+	return err
+}
+
+func (fc *FinanceClient) GetTenantUsage(ctx context.Context, from, to string) (*pb.TenantUsageList, error) {
+	return fc.paymentClient.GetTenantUsage(ctx, &pb.TenantUsageRequest{From: from, To: to})
+}
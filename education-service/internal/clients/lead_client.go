@@ -0,0 +1,36 @@
+package clients
+
+import (
+	"context"
+	"education-service/proto/pb"
+)
+
+type LeadClient struct {
+	leadClient   pb.LeadServiceClient
+	expectClient pb.ExpectServiceClient
+}
+
+func NewLeadClient(addr string) (*LeadClient, error) {
+	conn, err := Dial("lead-service", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	leadClient := pb.NewLeadServiceClient(conn)
+	expectClient := pb.NewExpectServiceClient(conn)
+	return &LeadClient{leadClient: leadClient, expectClient: expectClient}, nil
+}
+
+func (lc *LeadClient) CreateLeadSection(ctx context.Context, title string) error {
+	_, err := lc.leadClient.CreateLead(ctx, &pb.CreateLeadRequest{Title: title})
+	return err
+}
+
+func (lc *LeadClient) CreateExpectSection(ctx context.Context, title string) error {
+	_, err := lc.expectClient.CreateExpect(ctx, &pb.CreateExpectRequest{Title: title})
+	return err
+}
+
+func (lc *LeadClient) GetTenantUsage(ctx context.Context, from, to string) (*pb.TenantUsageList, error) {
+	return lc.leadClient.GetTenantUsage(ctx, &pb.TenantUsageRequest{From: from, To: to})
+}
@@ -3,7 +3,6 @@ package clients
 import (
 	"context"
 	"education-service/proto/pb"
-	"google.golang.org/grpc"
 )
 
 type UserClient struct {
@@ -11,7 +10,7 @@ type UserClient struct {
 }
 
 func NewUserClient(addr string) (*UserClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("user-service", addr)
 	if err != nil {
 		return nil, err
 	}
@@ -31,3 +30,17 @@ func (uc *UserClient) GetTeacherById(ctx context.Context, teacherId string) (str
 func (uc *UserClient) GetUserByCompanyId(ctx context.Context, companyId, role string) (*pb.GetUserByCompanyIdResponse, error) {
 	return uc.client.GetUserByCompanyId(ctx, &pb.GetUserByCompanyIdRequest{CompanyId: companyId, Role: role})
 }
+
+func (uc *UserClient) CreateUser(ctx context.Context, req *pb.CreateUserRequest) error {
+	_, err := uc.client.CreateUser(ctx, req)
+	return err
+}
+
+func (uc *UserClient) DeleteUser(ctx context.Context, userId string) error {
+	_, err := uc.client.DeleteUserById(ctx, &pb.UserAbsRequest{UserId: userId})
+	return err
+}
+
+func (uc *UserClient) GetTenantUsage(ctx context.Context, from, to string) (*pb.TenantUsageList, error) {
+	return uc.client.GetTenantUsage(ctx, &pb.TenantUsageRequest{From: from, To: to})
+}
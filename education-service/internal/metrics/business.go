@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	AttendanceMarked = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "education_attendance_marked_total",
+		Help: "Attendance marks saved, by status (1 came, 0 absent).",
+	}, []string{"status"})
+	BillingRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "education_billing_runs_total",
+		Help: "Monthly balance taker runs, by outcome.",
+	}, []string{"outcome"})
+	BillingRunDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "education_billing_run_seconds",
+		Help:    "Duration of a monthly balance taker run.",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	})
+	BillingCharges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "education_billing_charges_total",
+		Help: "Student charges made by the monthly balance taker, by outcome.",
+	}, []string{"outcome"})
+)
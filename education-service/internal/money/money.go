@@ -0,0 +1,79 @@
+// Package money is the arithmetic on sums of money. Amounts are exact
+// decimals: they travel in protos as strings and are stored in numeric
+// columns.
+//
+// Balances are kept in tiyin, two decimal places. Prices of a lesson or of
+// the rest of a month are charged in whole UZS. Both are rounded half away
+// from zero: 0.125 is 0.13, 2.5 UZS is 3 and -2.5 UZS is -3.
+package money
+
+import (
+	"fmt"
+	"github.com/shopspring/decimal"
+	"strings"
+)
+
+// Places is the number of decimal places a balance is kept in.
+const Places = 2
+
+var (
+	Zero    = decimal.Zero
+	hundred = decimal.NewFromInt(100)
+)
+
+// Parse reads an amount sent as a string, like the amount of a balance change.
+func Parse(s string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(strings.TrimSpace(s))
+	if err != nil {
+		return Zero, fmt.Errorf("invalid amount %q", s)
+	}
+	return Round(d), nil
+}
+
+// ParseOptional reads an amount a request may leave empty, which is zero.
+func ParseOptional(s string) (decimal.Decimal, error) {
+	if strings.TrimSpace(s) == "" {
+		return Zero, nil
+	}
+	return Parse(s)
+}
+
+// Format writes an amount with exactly two decimal places.
+func Format(d decimal.Decimal) string {
+	return d.StringFixed(Places)
+}
+
+// Round rounds an amount to tiyin.
+func Round(d decimal.Decimal) decimal.Decimal {
+	return d.Round(Places)
+}
+
+// Whole rounds an amount to whole UZS, the unit lessons are priced in.
+func Whole(d decimal.Decimal) decimal.Decimal {
+	return d.Round(0)
+}
+
+// LessPercent is what is left of an amount after percent off. It is not
+// rounded, so that several discounts in a row are rounded once at the end.
+func LessPercent(amount, percent decimal.Decimal) decimal.Decimal {
+	return amount.Sub(amount.Mul(percent).Div(hundred))
+}
+
+// PerLesson is the price of one of the lessons of a month, in whole UZS:
+// 500 000 over 12 lessons is 41 667.
+func PerLesson(monthly decimal.Decimal, lessons int) decimal.Decimal {
+	if lessons <= 0 {
+		return Zero
+	}
+	return Whole(monthly.Div(decimal.NewFromInt(int64(lessons))))
+}
+
+// Prorate is the part of an amount that part out of whole stands for, like
+// the days of a month a group runs. It is not rounded, so that further
+// shares of it add up before the final rounding.
+func Prorate(amount decimal.Decimal, part, whole int) decimal.Decimal {
+	if whole <= 0 {
+		return Zero
+	}
+	return amount.Mul(decimal.NewFromInt(int64(part))).Div(decimal.NewFromInt(int64(whole)))
+}
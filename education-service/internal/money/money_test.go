@@ -0,0 +1,144 @@
+package money
+
+import (
+	"github.com/shopspring/decimal"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"0.125", "0.13"},
+		{"-0.125", "-0.13"},
+		// half away from zero, not to even: banker's rounding gives 0.12
+		{"0.115", "0.12"},
+		{"41666.665", "41666.67"},
+		{"0.124999", "0.12"},
+		{"7", "7.00"},
+	}
+	for _, tt := range tests {
+		if got := Format(Round(decimal.RequireFromString(tt.in))); got != tt.want {
+			t.Errorf("Round(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestWhole(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"2.5", "3"},
+		{"-2.5", "-3"},
+		// banker's rounding gives 4 and 41666
+		{"4.5", "5"},
+		{"41666.5", "41667"},
+		{"41666.49", "41666"},
+		{"0.4", "0"},
+		{"100", "100"},
+	}
+	for _, tt := range tests {
+		if got := Whole(decimal.RequireFromString(tt.in)); !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("Whole(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPerLesson(t *testing.T) {
+	tests := []struct {
+		monthly string
+		lessons int
+		want    string
+	}{
+		{"500000", 12, "41667"},
+		{"500000", 8, "62500"},
+		{"300000", 13, "23077"},
+		{"100000", 16, "6250"},
+		{"250000", 16, "15625"},
+		{"10", 4, "3"},
+		{"14", 4, "4"},
+		{"500000", 0, "0"},
+		{"500000", -1, "0"},
+	}
+	for _, tt := range tests {
+		got := PerLesson(decimal.RequireFromString(tt.monthly), tt.lessons)
+		if !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("PerLesson(%s, %d) = %s, want %s", tt.monthly, tt.lessons, got, tt.want)
+		}
+	}
+}
+
+func TestProrate(t *testing.T) {
+	tests := []struct {
+		amount      string
+		part, whole int
+		want        string
+	}{
+		{"300000", 15, 30, "150000"},
+		{"300000", 30, 30, "300000"},
+		{"300000", 0, 30, "0"},
+		{"500000", 0, 0, "0"},
+	}
+	for _, tt := range tests {
+		got := Prorate(decimal.RequireFromString(tt.amount), tt.part, tt.whole)
+		if !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("Prorate(%s, %d, %d) = %s, want %s", tt.amount, tt.part, tt.whole, got, tt.want)
+		}
+	}
+
+	// an unrounded third adds back up to the amount before rounding
+	third := Prorate(decimal.RequireFromString("100000"), 1, 3)
+	if got := Whole(third.Mul(decimal.NewFromInt(3))); !got.Equal(decimal.NewFromInt(100000)) {
+		t.Errorf("three thirds of 100000 are %s", got)
+	}
+	if got := Whole(third); !got.Equal(decimal.NewFromInt(33333)) {
+		t.Errorf("Whole(third of 100000) = %s, want 33333", got)
+	}
+}
+
+func TestLessPercent(t *testing.T) {
+	tests := []struct {
+		amount, percent, want string
+	}{
+		{"1000000", "10", "900000"},
+		// not rounded to tiyin
+		{"99.99", "12.5", "87.49125"},
+		{"333.33", "10", "299.997"},
+		{"100", "0", "100"},
+	}
+	for _, tt := range tests {
+		got := LessPercent(decimal.RequireFromString(tt.amount), decimal.RequireFromString(tt.percent))
+		if !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("LessPercent(%s, %s) = %s, want %s", tt.amount, tt.percent, got, tt.want)
+		}
+	}
+
+	// the discounts of a quote in a row round once, not after each one
+	total := LessPercent(LessPercent(decimal.RequireFromString("333.33"), decimal.NewFromInt(10)), decimal.NewFromInt(20))
+	if got := Format(Round(total)); got != "240.00" {
+		t.Errorf("333.33 less 10%% and 20%% = %s, want 240.00", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"-41666.665", "-41666.67", false},
+		{" 1200 ", "1200.00", false},
+		{"", "", true},
+		{"1 200", "", true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && Format(got) != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.in, Format(got), tt.want)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ChannelSMS      = "SMS"
+	ChannelTelegram = "TELEGRAM"
+)
+
+// Channel delivers an already rendered message to a single recipient. The
+// recipient format depends on the channel: phone number for SMS, chat id or
+// username for Telegram.
+type Channel interface {
+	Send(ctx context.Context, to string, text string) error
+}
+
+// LogChannel only writes messages to the service log, it is used when no real
+// gateway is configured so local setups still exercise the outbox.
+type LogChannel struct {
+	Kind string
+}
+
+func (c LogChannel) Send(ctx context.Context, to string, text string) error {
+	slog.InfoContext(ctx, "notification not delivered, no gateway configured", "channel", c.Kind, "to", to, "text", text)
+	return nil
+}
+
+// SMSChannel posts messages to an HTTP SMS gateway as JSON
+// {"to": "998901234567", "from": "...", "text": "..."} with a bearer token.
+type SMSChannel struct {
+	URL    string
+	Token  string
+	From   string
+	client *http.Client
+}
+
+func NewSMSChannel(url, token, from string) *SMSChannel {
+	return &SMSChannel{URL: url, Token: token, From: from, client: &http.Client{Timeout: 15 * time.Second}}
+}
+
+func (c *SMSChannel) Send(ctx context.Context, to string, text string) error {
+	body, err := json.Marshal(map[string]string{
+		"to":   strings.TrimPrefix(to, "+"),
+		"from": c.From,
+		"text": text,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("sms gateway responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// TelegramChannel sends messages through the Telegram Bot API. Bots cannot
+// write to a username directly, so usernames are resolved to chat ids from the
+// bot updates; until the student starts the bot the send fails and the outbox
+// retries it later.
+type TelegramChannel struct {
+	Token   string
+	baseURL string
+	client  *http.Client
+}
+
+func NewTelegramChannel(token string) *TelegramChannel {
+	return &TelegramChannel{Token: token, baseURL: "https://api.telegram.org", client: &http.Client{Timeout: 15 * time.Second}}
+}
+
+func (c *TelegramChannel) Send(ctx context.Context, to string, text string) error {
+	chatId := strings.TrimPrefix(to, "@")
+	if _, err := strconv.ParseInt(chatId, 10, 64); err != nil {
+		chatId, err = c.resolveChatId(ctx, chatId)
+		if err != nil {
+			return err
+		}
+	}
+	var result struct {
+		Ok          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	if err := c.call(ctx, "sendMessage", map[string]string{"chat_id": chatId, "text": text}, &result); err != nil {
+		return err
+	}
+	if !result.Ok {
+		return fmt.Errorf("telegram: %s", result.Description)
+	}
+	return nil
+}
+
+func (c *TelegramChannel) resolveChatId(ctx context.Context, username string) (string, error) {
+	var result struct {
+		Ok     bool `json:"ok"`
+		Result []struct {
+			Message struct {
+				Chat struct {
+					Id       int64  `json:"id"`
+					Username string `json:"username"`
+				} `json:"chat"`
+			} `json:"message"`
+		} `json:"result"`
+	}
+	if err := c.call(ctx, "getUpdates", map[string]string{}, &result); err != nil {
+		return "", err
+	}
+	for _, update := range result.Result {
+		if strings.EqualFold(update.Message.Chat.Username, username) {
+			return strconv.FormatInt(update.Message.Chat.Id, 10), nil
+		}
+	}
+	return "", fmt.Errorf("telegram: user %s has not started the bot yet", username)
+}
+
+func (c *TelegramChannel) call(ctx context.Context, method string, payload map[string]string, out interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("%s/bot%s/%s", c.baseURL, c.Token, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		// the url carries the bot token, keep it out of stored errors
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("telegram %s: %w", method, urlErr.Err)
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,231 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Queryer is satisfied by both *sql.DB and *sql.Tx so notifications can be
+// queued inside the transaction that caused them.
+type Queryer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+const (
+	maxAttempts = 5
+	batchSize   = 50
+	// sendLease is how long a claimed row is left to one dispatcher
+	sendLease = 5 * time.Minute
+)
+
+// Notifier renders per-company templates into notification_outbox rows and
+// delivers them through the configured channels in the background.
+type Notifier struct {
+	db       *sql.DB
+	channels map[string]Channel
+}
+
+func NewNotifier(db *sql.DB, channels map[string]Channel) *Notifier {
+	return &Notifier{db: db, channels: channels}
+}
+
+type recipient struct {
+	name     string
+	phone    string
+	telegram string
+}
+
+// NotifyStudent queues the event for one student on every channel the company
+// has enabled and the student can be reached on. A nil Notifier is a no-op.
+func (n *Notifier) NotifyStudent(q Queryer, companyId, studentId string, event Event, data map[string]string) error {
+	return n.NotifyStudentWithReference(q, companyId, studentId, event, data, "")
+}
+
+// NotifyStudentWithReference is NotifyStudent with a reference stored on the
+// outbox rows, so callers can later look up the delivery status.
+func (n *Notifier) NotifyStudentWithReference(q Queryer, companyId, studentId string, event Event, data map[string]string, reference string) error {
+	if n == nil {
+		return nil
+	}
+	var to recipient
+	err := q.QueryRow(`SELECT name, phone, coalesce(telegram_username, '') FROM students where id=$1 and company_id=$2`, studentId, companyId).
+		Scan(&to.name, &to.phone, &to.telegram)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return n.enqueue(q, companyId, event, []recipient{to}, data, reference)
+}
+
+// NotifyGroup queues the event for every active student of the group.
+func (n *Notifier) NotifyGroup(q Queryer, companyId, groupId string, event Event, data map[string]string) error {
+	if n == nil {
+		return nil
+	}
+	rows, err := q.Query(`
+		SELECT s.name, s.phone, coalesce(s.telegram_username, '')
+		FROM group_students gs JOIN students s ON s.id = gs.student_id
+		WHERE gs.group_id=$1 and gs.condition='ACTIVE' and gs.company_id=$2`, groupId, companyId)
+	if err != nil {
+		return err
+	}
+	var recipients []recipient
+	for rows.Next() {
+		var to recipient
+		if err = rows.Scan(&to.name, &to.phone, &to.telegram); err != nil {
+			rows.Close()
+			return err
+		}
+		recipients = append(recipients, to)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	return n.enqueue(q, companyId, event, recipients, data, "")
+}
+
+func (n *Notifier) enqueue(q Queryer, companyId string, event Event, recipients []recipient, data map[string]string, reference string) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	lang, smsEnabled, telegramEnabled := LangUz, true, true
+	err := q.QueryRow(`SELECT lang, sms_enabled, telegram_enabled FROM notification_setting where company_id=$1`, companyId).
+		Scan(&lang, &smsEnabled, &telegramEnabled)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	body := DefaultTemplate(event, lang)
+	var custom string
+	err = q.QueryRow(`SELECT body FROM notification_template where company_id=$1 and event=$2 and lang=$3`, companyId, event, lang).Scan(&custom)
+	if err == nil {
+		body = custom
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	for _, to := range recipients {
+		values := map[string]string{"student": to.name}
+		for key, value := range data {
+			values[key] = value
+		}
+		text, err := render(body, values)
+		if err != nil {
+			return fmt.Errorf("failed to render %s notification: %w", event, err)
+		}
+		if smsEnabled && to.phone != "" {
+			if err = insertOutbox(q, companyId, event, ChannelSMS, to.phone, text, reference); err != nil {
+				return err
+			}
+		}
+		if telegramEnabled && strings.TrimSpace(to.telegram) != "" {
+			if err = insertOutbox(q, companyId, event, ChannelTelegram, strings.TrimSpace(to.telegram), text, reference); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func insertOutbox(q Queryer, companyId string, event Event, channel, to, text, reference string) error {
+	var ref *string
+	if reference != "" {
+		ref = &reference
+	}
+	_, err := q.Exec(`INSERT INTO notification_outbox(id, company_id, event, channel, recipient, body, reference) values (gen_random_uuid(), $1, $2, $3, $4, $5, $6)`,
+		companyId, event, channel, to, text, ref)
+	if err != nil {
+		return fmt.Errorf("failed to queue notification: %w", err)
+	}
+	return nil
+}
+
+// Run delivers pending outbox rows every interval until ctx is cancelled.
+func (n *Notifier) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// a batch that already started is finished even when stopping
+			if err := n.dispatch(context.WithoutCancel(ctx)); err != nil {
+				slog.Error("notification dispatch failed", "error", err)
+			}
+		}
+	}
+}
+
+// dispatch sends one batch. Rows are claimed as SENDING for sendLease in a
+// single statement and sent outside of any transaction, so a slow channel
+// holds no locks; each result is recorded on its own afterwards. A claim that
+// lapses, because the process died while sending, is picked up again.
+// Failures are retried with a growing delay until maxAttempts is reached.
+func (n *Notifier) dispatch(ctx context.Context) error {
+	_, err := n.db.ExecContext(ctx, `
+		UPDATE notification_outbox SET status='FAILED', last_error='delivery was not confirmed'
+		WHERE status='SENDING' and next_attempt_at <= now() and attempts >= $1`, maxAttempts)
+	if err != nil {
+		return err
+	}
+	rows, err := n.db.QueryContext(ctx, `
+		UPDATE notification_outbox SET status='SENDING', attempts=attempts+1, next_attempt_at=$2
+		WHERE id IN (SELECT id FROM notification_outbox
+		             WHERE status IN ('PENDING', 'SENDING') and next_attempt_at <= now()
+		             ORDER BY created_at LIMIT $1 FOR UPDATE SKIP LOCKED)
+		RETURNING id, channel, recipient, body, attempts`, batchSize, time.Now().Add(sendLease))
+	if err != nil {
+		return err
+	}
+	type outboxRow struct {
+		id, channel, to, body string
+		attempts              int
+	}
+	var batch []outboxRow
+	for rows.Next() {
+		var row outboxRow
+		if err = rows.Scan(&row.id, &row.channel, &row.to, &row.body, &row.attempts); err != nil {
+			rows.Close()
+			return err
+		}
+		batch = append(batch, row)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, row := range batch {
+		channel, ok := n.channels[row.channel]
+		if !ok {
+			err = fmt.Errorf("channel %s is not configured", row.channel)
+		} else {
+			err = channel.Send(ctx, row.to, row.body)
+		}
+		if err == nil {
+			_, err = n.db.ExecContext(ctx, `UPDATE notification_outbox SET status='SENT', sent_at=now(), last_error=null where id=$1 and status='SENDING'`, row.id)
+		} else {
+			nextStatus := "PENDING"
+			if row.attempts >= maxAttempts {
+				nextStatus = "FAILED"
+			}
+			delay := time.Duration(row.attempts*row.attempts) * time.Minute
+			_, err = n.db.ExecContext(ctx, `UPDATE notification_outbox SET status=$1, last_error=$2, next_attempt_at=$3 where id=$4 and status='SENDING'`,
+				nextStatus, err.Error(), time.Now().Add(delay), row.id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package notification
+
+import (
+	"bytes"
+	"text/template"
+)
+
+type Event string
+
+const (
+	EventPaymentReceived   Event = "PAYMENT_RECEIVED"
+	EventBalanceNegative   Event = "BALANCE_NEGATIVE"
+	EventLessonTransferred Event = "LESSON_TRANSFERRED"
+	EventStudentFrozen     Event = "STUDENT_FROZEN"
+	EventDebtReminder      Event = "DEBT_REMINDER"
+)
+
+var Events = []Event{EventPaymentReceived, EventBalanceNegative, EventLessonTransferred, EventStudentFrozen, EventDebtReminder}
+
+const (
+	LangUz = "uz"
+	LangRu = "ru"
+)
+
+// defaultTemplates are used when a company has not overridden the text for an
+// event. Templates are text/template strings rendered with the event data.
+var defaultTemplates = map[Event]map[string]string{
+	EventPaymentReceived: {
+		LangUz: "Hurmatli {{.student}}, {{.amount}} so'm to'lovingiz qabul qilindi. Joriy balans: {{.balance}} so'm.",
+		LangRu: "Уважаемый(ая) {{.student}}, ваш платёж {{.amount}} сум принят. Текущий баланс: {{.balance}} сум.",
+	},
+	EventBalanceNegative: {
+		LangUz: "Hurmatli {{.student}}, balansingiz manfiy: {{.balance}} so'm. Iltimos, to'lovni amalga oshiring.",
+		LangRu: "Уважаемый(ая) {{.student}}, ваш баланс отрицательный: {{.balance}} сум. Пожалуйста, пополните счёт.",
+	},
+	EventLessonTransferred: {
+		LangUz: "Hurmatli {{.student}}, {{.group}} guruhining {{.from}} kungi darsi {{.to}} kuniga ko'chirildi.",
+		LangRu: "Уважаемый(ая) {{.student}}, занятие группы {{.group}} с {{.from}} перенесено на {{.to}}.",
+	},
+	EventDebtReminder: {
+		LangUz: "Hurmatli {{.student}}, sizda {{.debt}} so'm qarzdorlik mavjud ({{.days}} kundan beri). Iltimos, to'lovni amalga oshiring.",
+		LangRu: "Уважаемый(ая) {{.student}}, у вас задолженность {{.debt}} сум ({{.days}} дн.). Пожалуйста, оплатите обучение.",
+	},
+	EventStudentFrozen: {
+		LangUz: "Hurmatli {{.student}}, {{.group}} guruhidagi o'qishingiz {{.date}} sanasidan muzlatildi.",
+		LangRu: "Уважаемый(ая) {{.student}}, ваше обучение в группе {{.group}} заморожено с {{.date}}.",
+	},
+}
+
+func DefaultTemplate(event Event, lang string) string {
+	if texts, ok := defaultTemplates[event]; ok {
+		if text, ok := texts[lang]; ok {
+			return text
+		}
+		return texts[LangUz]
+	}
+	return ""
+}
+
+// ValidateTemplate reports whether body is a template the notifier can render.
+func ValidateTemplate(body string) error {
+	_, err := template.New("notification").Option("missingkey=zero").Parse(body)
+	return err
+}
+
+func render(body string, data map[string]string) (string, error) {
+	tmpl, err := template.New("notification").Option("missingkey=zero").Parse(body)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	if err = tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,577 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"education-service/internal/clients"
+	"education-service/internal/money"
+	"education-service/internal/subscription"
+	"education-service/proto/pb"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	HealthHealthy  = "HEALTHY"
+	HealthAtRisk   = "AT_RISK"
+	HealthCritical = "CRITICAL"
+
+	// recentDays is the window of the activity counted into tenant health.
+	recentDays = 30
+	// cohortMonths is how many months a cohort is followed for.
+	cohortMonths = 12
+)
+
+type AnalyticsRepository struct {
+	db               *sql.DB
+	subscriptionRepo *SubscriptionRepository
+	userClient       *clients.UserClient
+	leadClient       *clients.LeadClient
+	financeClient    *clients.FinanceClient
+}
+
+// NewAnalyticsRepository reports on the platform as a whole. Usage kept by
+// the other services is asked from them for all companies at once.
+func NewAnalyticsRepository(db *sql.DB, subscriptionRepo *SubscriptionRepository, userClient *clients.UserClient, leadClient *clients.LeadClient, financeClient *clients.FinanceClient) *AnalyticsRepository {
+	return &AnalyticsRepository{db: db, subscriptionRepo: subscriptionRepo, userClient: userClient, leadClient: leadClient, financeClient: financeClient}
+}
+
+// paidPeriod is what one company payment paid for. A payment made before the
+// previous period ran out continues it, a later one starts on its own day.
+type paidPeriod struct {
+	companyId int32
+	start     time.Time
+	end       time.Time
+	monthly   decimal.Decimal
+	nextPaid  sql.NullTime
+}
+
+func (r *AnalyticsRepository) paidPeriods() (map[int32][]paidPeriod, error) {
+	rows, err := r.db.Query(`WITH payments AS (
+    SELECT p.company_id, p.sum, p.edited_valid_date AS ends, i.months AS invoiced_months,
+           greatest(lag(p.edited_valid_date) OVER w + 1, p.created_at::date) AS starts,
+           lead(p.created_at) OVER w AS next_paid
+    FROM company_payments p
+             LEFT JOIN company_invoice i ON i.id = p.invoice_id
+    WINDOW w AS (PARTITION BY p.company_id ORDER BY p.edited_valid_date, p.id)
+)
+SELECT company_id, starts, ends,
+       sum / coalesce(invoiced_months, greatest(1, round((ends - starts + 1) / 30.44))),
+       next_paid
+FROM payments
+ORDER BY company_id, ends`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	periods := map[int32][]paidPeriod{}
+	for rows.Next() {
+		var period paidPeriod
+		if err = rows.Scan(&period.companyId, &period.start, &period.end, &period.monthly, &period.nextPaid); err != nil {
+			return nil, err
+		}
+		periods[period.companyId] = append(periods[period.companyId], period)
+	}
+	return periods, rows.Err()
+}
+
+// covering is the period of a company that pays for day, if any.
+func covering(periods []paidPeriod, day time.Time) (paidPeriod, bool) {
+	for i := len(periods) - 1; i >= 0; i-- {
+		if !day.Before(periods[i].start) && !day.After(periods[i].end) {
+			return periods[i], true
+		}
+	}
+	return paidPeriod{}, false
+}
+
+func (r *AnalyticsRepository) GetPlatformMetrics(req *pb.PlatformMetricsRequest) (*pb.PlatformMetrics, error) {
+	today := dateOf(time.Now())
+	from, to, err := monthRange(req.From, req.To, today)
+	if err != nil {
+		return nil, err
+	}
+	periods, err := r.paidPeriods()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read company payments: %w", err)
+	}
+	result := &pb.PlatformMetrics{}
+	mrr := money.Zero
+	for _, companyPeriods := range periods {
+		if period, ok := covering(companyPeriods, today); ok {
+			mrr = mrr.Add(period.monthly)
+			result.PayingCompanies++
+		}
+	}
+	mrr = money.Round(mrr)
+	result.Mrr = money.Format(mrr)
+	result.Arr = money.Format(mrr.Mul(decimal.NewFromInt(12)))
+	if err = r.countCompanies(result, today); err != nil {
+		return nil, err
+	}
+	result.Renewals = r.renewals(periods, from, to, today)
+	result.Cohorts = cohorts(periods, from, to, today)
+	return result, nil
+}
+
+func (r *AnalyticsRepository) countCompanies(result *pb.PlatformMetrics, today time.Time) error {
+	rows, err := r.db.Query(`SELECT c.valid_date, coalesce(c.is_demo, false),
+       (SELECT count(*) FROM students s WHERE s.condition = 'ACTIVE' AND s.company_id = c.id)
+FROM company c`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var validDate sql.NullTime
+		var isDemo bool
+		var students int32
+		if err = rows.Scan(&validDate, &isDemo, &students); err != nil {
+			return err
+		}
+		var sub pb.CompanySubscription
+		r.subscriptionRepo.fillState(&sub, validDate, today)
+		result.TotalCompanies++
+		result.ActiveStudents += students
+		switch {
+		case isDemo:
+			result.DemoCompanies++
+		case sub.State == subscription.StateGrace:
+			result.GraceCompanies++
+		case sub.State == subscription.StateLocked:
+			result.LockedCompanies++
+		}
+	}
+	return rows.Err()
+}
+
+// renewals sorts the paid periods ending in each month: renewed when the next
+// payment came before the grace period was over, churned when it did not,
+// pending while the grace period still runs.
+func (r *AnalyticsRepository) renewals(periods map[int32][]paidPeriod, from, to, today time.Time) []*pb.RenewalStat {
+	byMonth := map[string]*pb.RenewalStat{}
+	for month := from; !month.After(to); month = month.AddDate(0, 1, 0) {
+		byMonth[month.Format("2006-01")] = &pb.RenewalStat{Month: month.Format("2006-01")}
+	}
+	for _, companyPeriods := range periods {
+		for _, period := range companyPeriods {
+			stat, ok := byMonth[period.end.Format("2006-01")]
+			if !ok {
+				continue
+			}
+			graceUntil := period.end.AddDate(0, 0, r.subscriptionRepo.graceDays)
+			stat.Due++
+			switch {
+			case period.nextPaid.Valid && !dateOf(period.nextPaid.Time).After(graceUntil):
+				stat.Renewed++
+			case today.After(graceUntil):
+				stat.Churned++
+			default:
+				stat.Pending++
+			}
+		}
+	}
+	result := make([]*pb.RenewalStat, 0, len(byMonth))
+	for _, stat := range byMonth {
+		if decided := stat.Renewed + stat.Churned; decided > 0 {
+			stat.RenewalRate = rate(stat.Renewed, decided)
+			stat.ChurnRate = rate(stat.Churned, decided)
+		}
+		result = append(result, stat)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Month < result[j].Month })
+	return result
+}
+
+// cohorts groups the companies by the month they first paid in and follows
+// each group for cohortMonths, checking at the end of every month (today for
+// the current one) which share of it still pays.
+func cohorts(periods map[int32][]paidPeriod, from, to, today time.Time) []*pb.CohortRetention {
+	members := map[string][]int32{}
+	for companyId, companyPeriods := range periods {
+		first := companyPeriods[0].start
+		for _, period := range companyPeriods {
+			if period.start.Before(first) {
+				first = period.start
+			}
+		}
+		month := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if month.Before(from) || month.After(to) {
+			continue
+		}
+		members[month.Format("2006-01")] = append(members[month.Format("2006-01")], companyId)
+	}
+	result := make([]*pb.CohortRetention, 0, len(members))
+	for key, companies := range members {
+		month, _ := time.Parse("2006-01", key)
+		cohort := &pb.CohortRetention{Month: key, Companies: int32(len(companies))}
+		for k := 0; k < cohortMonths; k++ {
+			start := month.AddDate(0, k, 0)
+			if start.After(today) {
+				break
+			}
+			checkpoint := start.AddDate(0, 1, -1)
+			if checkpoint.After(today) {
+				checkpoint = today
+			}
+			var paying int32
+			for _, companyId := range companies {
+				if _, ok := covering(periods[companyId], checkpoint); ok {
+					paying++
+				}
+			}
+			cohort.Retention = append(cohort.Retention, rate(paying, cohort.Companies))
+		}
+		result = append(result, cohort)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Month < result[j].Month })
+	return result
+}
+
+// tenantUsage is what the other services know about every company; a nil
+// map means the service could not be asked.
+type tenantUsage struct {
+	staff    map[int32]*pb.TenantUsage
+	leads    map[int32]*pb.TenantUsage
+	payments map[int32]*pb.TenantUsage
+}
+
+func (r *AnalyticsRepository) tenantUsage(ctx context.Context, today time.Time) tenantUsage {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	from := today.AddDate(0, 0, -recentDays+1).Format(time.DateOnly)
+	to := today.Format(time.DateOnly)
+	byCompany := func(source string, list *pb.TenantUsageList, err error) map[int32]*pb.TenantUsage {
+		if err != nil {
+			slog.WarnContext(ctx, "failed to get tenant usage, health is scored without it", "source", source, "error", err)
+			return nil
+		}
+		usage := make(map[int32]*pb.TenantUsage, len(list.Items))
+		for _, item := range list.Items {
+			usage[item.CompanyId] = item
+		}
+		return usage
+	}
+	var usage tenantUsage
+	staff, err := r.userClient.GetTenantUsage(ctx, from, to)
+	usage.staff = byCompany("user-service", staff, err)
+	leads, err := r.leadClient.GetTenantUsage(ctx, from, to)
+	usage.leads = byCompany("lead-service", leads, err)
+	payments, err := r.financeClient.GetTenantUsage(ctx, from, to)
+	usage.payments = byCompany("finance-service", payments, err)
+	return usage
+}
+
+func (u tenantUsage) complete() bool {
+	return u.staff != nil && u.leads != nil && u.payments != nil
+}
+
+// tenants works the health of every company out live.
+func (r *AnalyticsRepository) tenants(ctx context.Context, today time.Time) ([]*pb.TenantHealth, bool, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT c.id, c.title, c.subdomain, coalesce(c.is_demo, false), c.valid_date, t.name, coalesce(t.student_count, 0),
+       (SELECT count(*) FROM students s WHERE s.condition = 'ACTIVE' AND s.company_id = c.id),
+       (SELECT count(*) FROM groups g WHERE g.is_archived = false AND g.company_id = c.id)
+FROM company c
+         JOIN tariff t ON t.id = c.tariff_id
+ORDER BY c.id`)
+	if err != nil {
+		return nil, false, err
+	}
+	var tenants []*pb.TenantHealth
+	for rows.Next() {
+		var tenant pb.TenantHealth
+		var validDate sql.NullTime
+		err = rows.Scan(&tenant.CompanyId, &tenant.Title, &tenant.Subdomain, &tenant.IsDemo, &validDate, &tenant.TariffName, &tenant.StudentLimit, &tenant.Students, &tenant.Groups)
+		if err != nil {
+			rows.Close()
+			return nil, false, err
+		}
+		var sub pb.CompanySubscription
+		r.subscriptionRepo.fillState(&sub, validDate, today)
+		tenant.State = sub.State
+		tenant.ValidDate = sub.ValidDate
+		tenants = append(tenants, &tenant)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	usage := r.tenantUsage(ctx, today)
+	for _, tenant := range tenants {
+		if staff, ok := usage.staff[tenant.CompanyId]; ok {
+			tenant.Staff = int32(staff.Count)
+		}
+		if leads, ok := usage.leads[tenant.CompanyId]; ok {
+			tenant.Leads = int32(leads.Count)
+			tenant.RecentLeads = int32(leads.Recent)
+		}
+		tenant.PaymentsSum = money.Format(money.Zero)
+		if payments, ok := usage.payments[tenant.CompanyId]; ok {
+			tenant.Payments = int32(payments.Recent)
+			tenant.PaymentsSum = payments.Sum
+		}
+		tenant.Score = healthScore(tenant, usage)
+		tenant.Level = healthLevel(tenant.Score)
+	}
+	return tenants, usage.complete(), nil
+}
+
+// healthScore rates a company out of 100: its subscription (30), active
+// students against half of what its tariff allows (25), groups (10), staff
+// besides the CEO (10), new leads (10) and the share of students who paid in
+// the last 30 days (15). Parts kept by a service that could not be asked are
+// left out and the rest is scaled up.
+func healthScore(tenant *pb.TenantHealth, usage tenantUsage) int32 {
+	var earned, possible float64
+	possible += 30
+	switch tenant.State {
+	case subscription.StateActive:
+		earned += 30
+	case subscription.StateGrace:
+		earned += 10
+	}
+	target := 20.0
+	if tenant.StudentLimit > 0 {
+		target = math.Max(1, float64(tenant.StudentLimit)/2)
+	}
+	possible += 25
+	earned += 25 * math.Min(1, float64(tenant.Students)/target)
+	possible += 10
+	if tenant.Groups > 0 {
+		earned += 10
+	}
+	if usage.staff != nil {
+		possible += 10
+		if tenant.Staff > 1 {
+			earned += 10
+		}
+	}
+	if usage.leads != nil {
+		possible += 10
+		if tenant.RecentLeads > 0 {
+			earned += 10
+		}
+	}
+	if usage.payments != nil {
+		possible += 15
+		if tenant.Students > 0 {
+			earned += 15 * math.Min(1, float64(tenant.Payments)/float64(tenant.Students))
+		}
+	}
+	return int32(math.Round(earned / possible * 100))
+}
+
+func healthLevel(score int32) string {
+	switch {
+	case score >= 70:
+		return HealthHealthy
+	case score >= 40:
+		return HealthAtRisk
+	}
+	return HealthCritical
+}
+
+// GetTenantHealth lists the companies, least healthy first.
+func (r *AnalyticsRepository) GetTenantHealth(ctx context.Context, req *pb.TenantHealthRequest) (*pb.TenantHealthList, error) {
+	tenants, complete, err := r.tenants(ctx, dateOf(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	filtered := tenants[:0]
+	for _, tenant := range tenants {
+		if req.Level == "" || tenant.Level == req.Level {
+			filtered = append(filtered, tenant)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool { return filtered[i].Score < filtered[j].Score })
+	page, size := req.Page, req.Size
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 20
+	}
+	start := min(int((page-1)*size), len(filtered))
+	end := min(start+int(size), len(filtered))
+	return &pb.TenantHealthList{Count: int32(len(filtered)), Items: filtered[start:end], Incomplete: !complete}, nil
+}
+
+// TakeSnapshot stores today's platform metrics and the health of every
+// company, so history can be charted from them. Taking it again the same day
+// replaces it.
+func (r *AnalyticsRepository) TakeSnapshot(ctx context.Context) error {
+	today := dateOf(time.Now())
+	metrics, err := r.GetPlatformMetrics(&pb.PlatformMetricsRequest{From: today.Format(time.DateOnly), To: today.Format(time.DateOnly)})
+	if err != nil {
+		return err
+	}
+	tenants, complete, err := r.tenants(ctx, today)
+	if err != nil {
+		return err
+	}
+	if !complete {
+		slog.WarnContext(ctx, "taking the analytics snapshot without the usage of every service")
+	}
+	var averageHealth float64
+	for _, tenant := range tenants {
+		averageHealth += float64(tenant.Score)
+	}
+	if len(tenants) > 0 {
+		averageHealth = math.Round(averageHealth/float64(len(tenants))*100) / 100
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.Exec(`INSERT INTO platform_snapshot(day, mrr, paying_companies, demo_companies, grace_companies, locked_companies, total_companies, active_students, average_health)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (day) DO UPDATE SET mrr = excluded.mrr, paying_companies = excluded.paying_companies, demo_companies = excluded.demo_companies,
+                                grace_companies = excluded.grace_companies, locked_companies = excluded.locked_companies,
+                                total_companies = excluded.total_companies, active_students = excluded.active_students,
+                                average_health = excluded.average_health, created_at = now()`,
+		today, metrics.Mrr, metrics.PayingCompanies, metrics.DemoCompanies, metrics.GraceCompanies, metrics.LockedCompanies, metrics.TotalCompanies, metrics.ActiveStudents, averageHealth)
+	if err != nil {
+		return fmt.Errorf("failed to save platform snapshot: %w", err)
+	}
+	if _, err = tx.Exec(`DELETE FROM tenant_snapshot WHERE day = $1`, today); err != nil {
+		return err
+	}
+	for _, tenant := range tenants {
+		_, err = tx.Exec(`INSERT INTO tenant_snapshot(day, company_id, state, is_demo, tariff_name, valid_date, students, student_limit, groups, staff, leads, recent_leads, payments, payments_sum, score, level)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+			today, tenant.CompanyId, tenant.State, tenant.IsDemo, tenant.TariffName, tenant.ValidDate, tenant.Students, tenant.StudentLimit, tenant.Groups,
+			tenant.Staff, tenant.Leads, tenant.RecentLeads, tenant.Payments, tenant.PaymentsSum, tenant.Score, tenant.Level)
+		if err != nil {
+			return fmt.Errorf("failed to save snapshot of company %d: %w", tenant.CompanyId, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *AnalyticsRepository) RunSnapshot() {
+	if err := r.TakeSnapshot(context.Background()); err != nil {
+		slog.Error("analytics snapshot failed", "error", err)
+	}
+}
+
+func (r *AnalyticsRepository) GetPlatformHistory(req *pb.HistoryRequest) (*pb.PlatformHistory, error) {
+	from, to, err := historyRange(req)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`SELECT day, mrr, paying_companies, demo_companies, grace_companies, locked_companies, total_companies, active_students, average_health
+FROM platform_snapshot
+WHERE day BETWEEN $1 AND $2
+ORDER BY day`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.PlatformHistory{}
+	for rows.Next() {
+		var snapshot pb.PlatformSnapshot
+		var day time.Time
+		var mrr decimal.Decimal
+		err = rows.Scan(&day, &mrr, &snapshot.PayingCompanies, &snapshot.DemoCompanies, &snapshot.GraceCompanies,
+			&snapshot.LockedCompanies, &snapshot.TotalCompanies, &snapshot.ActiveStudents, &snapshot.AverageHealth)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Day = day.Format(time.DateOnly)
+		snapshot.Mrr = money.Format(mrr)
+		snapshot.Arr = money.Format(mrr.Mul(decimal.NewFromInt(12)))
+		result.Items = append(result.Items, &snapshot)
+	}
+	return result, rows.Err()
+}
+
+func (r *AnalyticsRepository) GetTenantHistory(req *pb.HistoryRequest) (*pb.TenantHealthList, error) {
+	if req.CompanyId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "companyId is required")
+	}
+	from, to, err := historyRange(req)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`SELECT s.day, s.company_id, c.title, c.subdomain, s.state, s.is_demo, s.tariff_name, s.valid_date, s.students, s.student_limit,
+       s.groups, s.staff, s.leads, s.recent_leads, s.payments, s.payments_sum, s.score, s.level
+FROM tenant_snapshot s
+         JOIN company c ON c.id = s.company_id
+WHERE s.company_id = $1 AND s.day BETWEEN $2 AND $3
+ORDER BY s.day`, req.CompanyId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.TenantHealthList{}
+	for rows.Next() {
+		var tenant pb.TenantHealth
+		var day time.Time
+		err = rows.Scan(&day, &tenant.CompanyId, &tenant.Title, &tenant.Subdomain, &tenant.State, &tenant.IsDemo, &tenant.TariffName, &tenant.ValidDate,
+			&tenant.Students, &tenant.StudentLimit, &tenant.Groups, &tenant.Staff, &tenant.Leads, &tenant.RecentLeads, &tenant.Payments, &tenant.PaymentsSum,
+			&tenant.Score, &tenant.Level)
+		if err != nil {
+			return nil, err
+		}
+		tenant.Day = day.Format(time.DateOnly)
+		result.Items = append(result.Items, &tenant)
+	}
+	result.Count = int32(len(result.Items))
+	return result, rows.Err()
+}
+
+// monthRange turns from and to into the first days of their months; by
+// default the last twelve months up to today.
+func monthRange(from, to string, today time.Time) (time.Time, time.Time, error) {
+	end, start := today, today.AddDate(0, -11, 0)
+	var err error
+	if to != "" {
+		if end, err = time.Parse(time.DateOnly, to); err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "to must be YYYY-MM-DD, got %q", to)
+		}
+	}
+	if from != "" {
+		if start, err = time.Parse(time.DateOnly, from); err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "from must be YYYY-MM-DD, got %q", from)
+		}
+	}
+	firstOf := func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC) }
+	return firstOf(start), firstOf(end), nil
+}
+
+// historyRange defaults to the last 90 days.
+func historyRange(req *pb.HistoryRequest) (time.Time, time.Time, error) {
+	to, from := dateOf(time.Now()), dateOf(time.Now()).AddDate(0, 0, -90)
+	var err error
+	if req.To != "" {
+		if to, err = time.Parse(time.DateOnly, req.To); err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "to must be YYYY-MM-DD, got %q", req.To)
+		}
+	}
+	if req.From != "" {
+		if from, err = time.Parse(time.DateOnly, req.From); err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "from must be YYYY-MM-DD, got %q", req.From)
+		}
+	}
+	return from, to, nil
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func rate(part, whole int32) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(whole)*10000) / 10000
+}
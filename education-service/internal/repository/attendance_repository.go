@@ -4,21 +4,24 @@ import (
 	"context"
 	"database/sql"
 	"education-service/internal/clients"
+	"education-service/internal/metrics"
+	"education-service/internal/money"
 	"education-service/internal/utils"
 	"education-service/proto/pb"
 	"errors"
 	"fmt"
 	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"sort"
+	"strconv"
 	"time"
 )
 
 type AttendanceRepository struct {
-	db                *sql.DB
-	financeClient     *clients.FinanceClient
-	financeClientChan chan *clients.FinanceClient
+	db            *sql.DB
+	financeClient *clients.FinanceClient
 }
 
 type Groups struct {
@@ -37,7 +40,7 @@ type Groups struct {
 type Attendance struct {
 	IsDiscounted  bool
 	DiscountOwner string
-	Price         float32
+	Price         decimal.Decimal
 	GroupId       int64
 	StudentId     string
 	StudentName   string
@@ -48,36 +51,21 @@ type Attendance struct {
 	CreatedBy     string
 	CreatorRole   string
 	PriceType     string
-	TotalCount    float64
-	CoursePrice   float64
+	TotalCount    decimal.Decimal
+	CoursePrice   decimal.Decimal
 }
 
-func (r *AttendanceRepository) ensureFinanceClient() error {
-	if r.financeClient == nil {
-		select {
-		case client := <-r.financeClientChan:
-			r.financeClient = client
-		case <-time.After(5 * time.Second):
-			return fmt.Errorf("failed to initialize GroupClient within timeout")
-		}
-	}
-	return nil
-}
-
-func NewAttendanceRepository(db *sql.DB, financeClientChan chan *clients.FinanceClient) *AttendanceRepository {
-	return &AttendanceRepository{db: db, financeClientChan: financeClientChan}
+func NewAttendanceRepository(db *sql.DB, financeClient *clients.FinanceClient) *AttendanceRepository {
+	return &AttendanceRepository{db: db, financeClient: financeClient}
 }
 func (r *AttendanceRepository) CreateAttendance(ctx context.Context, companyId, groupId string, studentId string, teacherId string, attendDate string, status int32, actionById, actionByRole string) error {
-	if err := r.ensureFinanceClient(); err != nil {
-		return fmt.Errorf("error while ensuring finance client %v", err)
-	}
 
 	var (
 		isDiscounted bool
-		price        float64
+		price        decimal.Decimal
 		priceType    string
-		totalCount   int
-		coursePrice  float64
+		totalCount   decimal.Decimal
+		coursePrice  decimal.Decimal
 	)
 	ctx, c := utils.NewTimoutContext(ctx, companyId)
 	defer c()
@@ -85,15 +73,36 @@ func (r *AttendanceRepository) CreateAttendance(ctx context.Context, companyId,
 	if err != nil {
 		return errors.New("error while getting teacher salary information")
 	}
+	salaryAmount, err := money.Parse(resp.Amount)
+	if err != nil {
+		return fmt.Errorf("invalid teacher salary amount: %v", err)
+	}
 	if !utils.CheckGroupAndTeacher(r.db, groupId, "TEACHER", teacherId) {
 		return fmt.Errorf("oops this teacherid not the same for this group")
 	}
-	discountAmount, discountOwner := r.financeClient.GetDiscountByStudentId(ctx, studentId, groupId)
+	day, err := time.Parse(time.DateOnly, attendDate)
+	if err != nil {
+		day = time.Now()
+	}
+	discountReq, err := discountRequest(r.db, companyId, studentId, groupId, day)
+	if err != nil {
+		return fmt.Errorf("error while getting discount information: %v", err)
+	}
+	// a lesson is priced with the part of the discount the teacher carries
+	var discountAmount *decimal.Decimal
+	discountOwner := "CENTER"
+	discount, err := r.financeClient.GetDiscountByStudentId(ctx, discountReq)
+	if err != nil {
+		return fmt.Errorf("error while getting discount information: %v", err)
+	}
+	if discount != nil {
+		discountAmount = &discount.TeacherAmount
+		discountOwner = discount.Owner
+	}
 
 	if resp.Type == "FIXED" {
 		priceType = "FIXED"
-		totalCount = int(resp.Amount)
-		f := float64(totalCount)
+		f := salaryAmount
 		if discountAmount != nil {
 			isDiscounted = true
 			priceType = "FIXED_DISCOUNT"
@@ -102,16 +111,16 @@ func (r *AttendanceRepository) CreateAttendance(ctx context.Context, companyId,
 			if err = utils.CalculateMoneyForLesson(r.db, &price, studentId, groupId, attendDate, nil, &coursePrice, &f); err != nil {
 				return errors.New("error while getting calculate money")
 			}
-			totalCount = int(f)
+			totalCount = f
 		} else {
 			if err = utils.CalculateMoneyForLesson(r.db, &price, studentId, groupId, attendDate, discountAmount, &coursePrice, &f); err != nil {
 				return errors.New("error while getting calculate money")
 			}
-			totalCount = int(f)
+			totalCount = f
 		}
 	} else {
 		priceType = "PERCENT"
-		totalCount = int(resp.Amount)
+		totalCount = salaryAmount
 		if discountAmount != nil {
 			isDiscounted = true
 			priceType = "PERCENT_DISCOUNT"
@@ -135,6 +144,7 @@ func (r *AttendanceRepository) CreateAttendance(ctx context.Context, companyId,
 	if err != nil {
 		return fmt.Errorf("error while creating attendance %v", err)
 	}
+	metrics.AttendanceMarked.WithLabelValues(strconv.Itoa(int(status))).Inc()
 	return err
 }
 func (r *AttendanceRepository) DeleteAttendance(groupId string, studentId string, teacherId string, attendDate string) error {
@@ -291,7 +301,7 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
 			teacherId                                                sql.NullString
 			status                                                   sql.NullInt32
 			gender                                                   sql.NullBool
-			balance                                                  float64
+			balance                                                  string
 			attendanceCreatedAt                                      sql.NullTime
 		)
 
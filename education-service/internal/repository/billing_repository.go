@@ -0,0 +1,426 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/internal/money"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxQuoteMonths = 36
+
+type BillingRepository struct {
+	db *sql.DB
+}
+
+func NewBillingRepository(db *sql.DB) *BillingRepository {
+	return &BillingRepository{db: db}
+}
+
+// Quote prices months of a tariff for a company. Discounts are applied one
+// after the other: the prepay discount of the tariff, the company's own
+// discount, then the promo code. The period starts the day after valid_date,
+// or today when the company has already run out.
+func (r *BillingRepository) Quote(req *pb.QuoteRequest) (*pb.TariffQuote, error) {
+	return quote(r.db, req, time.Now())
+}
+
+func quote(q queryRower, req *pb.QuoteRequest, now time.Time) (*pb.TariffQuote, error) {
+	if req.Months < 1 || req.Months > maxQuoteMonths {
+		return nil, status.Errorf(codes.InvalidArgument, "months must be between 1 and %d", maxQuoteMonths)
+	}
+	companyId, err := strconv.Atoi(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company id")
+	}
+	var currentTariff int32
+	var validDate sql.NullTime
+	err = q.QueryRow(`SELECT tariff_id, valid_date FROM company WHERE id = $1`, companyId).Scan(&currentTariff, &validDate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "company %d not found", companyId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.TariffQuote{CompanyId: req.CompanyId, TariffId: req.TariffId, Months: req.Months}
+	if result.TariffId == 0 {
+		result.TariffId = currentTariff
+	}
+	var monthlyPrice decimal.Decimal
+	err = q.QueryRow(`SELECT name, sum FROM tariff WHERE id = $1 AND is_deleted = false`, result.TariffId).Scan(&result.TariffName, &monthlyPrice)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "tariff %d not found", result.TariffId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var overridePrice decimal.NullDecimal
+	var overridePercent, prepayPercent decimal.Decimal
+	err = q.QueryRow(`SELECT monthly_price, percent FROM company_price_override
+WHERE company_id = $1 AND (valid_to IS NULL OR valid_to >= $2::date)`, companyId, now.Format(time.DateOnly)).Scan(&overridePrice, &overridePercent)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if overridePrice.Valid {
+		monthlyPrice = overridePrice.Decimal
+	}
+	err = q.QueryRow(`SELECT percent FROM tariff_prepay_discount WHERE tariff_id = $1 AND months <= $2 ORDER BY months DESC LIMIT 1`, result.TariffId, req.Months).Scan(&prepayPercent)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	baseSum := monthlyPrice.Mul(decimal.NewFromInt(int64(req.Months)))
+	total := money.LessPercent(money.LessPercent(baseSum, prepayPercent), overridePercent)
+	if code := strings.ToUpper(strings.TrimSpace(req.PromoCode)); code != "" {
+		percent, amount, err := promoDiscount(q, code, result.TariffId, now)
+		if err != nil {
+			return nil, err
+		}
+		result.PromoCode = code
+		total = money.LessPercent(total, percent).Sub(amount)
+	}
+	total = decimal.Max(money.Zero, money.Round(total))
+	result.PrepayPercent = prepayPercent.InexactFloat64()
+	result.OverridePercent = overridePercent.InexactFloat64()
+	result.MonthlyPrice = money.Format(monthlyPrice)
+	result.BaseSum = money.Format(baseSum)
+	result.Total = money.Format(total)
+	result.DiscountSum = money.Format(baseSum.Sub(total))
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := today
+	if validDate.Valid {
+		if next := time.Date(validDate.Time.Year(), validDate.Time.Month(), validDate.Time.Day()+1, 0, 0, 0, 0, time.UTC); next.After(today) {
+			from = next
+		}
+	}
+	result.PeriodFrom = from.Format(time.DateOnly)
+	result.ValidDate = from.AddDate(0, int(req.Months), -1).Format(time.DateOnly)
+	return result, nil
+}
+
+func promoDiscount(q queryRower, code string, tariffId int32, now time.Time) (decimal.Decimal, decimal.Decimal, error) {
+	var percent, amount decimal.Decimal
+	var promoTariff int32
+	var validFrom, validTo sql.NullTime
+	var maxUses sql.NullInt32
+	var usedCount int32
+	var isActive bool
+	err := q.QueryRow(`SELECT percent, amount, coalesce(tariff_id, 0), valid_from, valid_to, max_uses, used_count, is_active FROM promo_code WHERE code = $1`, code).
+		Scan(&percent, &amount, &promoTariff, &validFrom, &validTo, &maxUses, &usedCount, &isActive)
+	if errors.Is(err, sql.ErrNoRows) {
+		return money.Zero, money.Zero, status.Errorf(codes.InvalidArgument, "promo code %s does not exist", code)
+	}
+	if err != nil {
+		return money.Zero, money.Zero, err
+	}
+	today := now.Format(time.DateOnly)
+	switch {
+	case !isActive:
+		return money.Zero, money.Zero, status.Errorf(codes.FailedPrecondition, "promo code %s is no longer active", code)
+	case validFrom.Valid && today < validFrom.Time.Format(time.DateOnly):
+		return money.Zero, money.Zero, status.Errorf(codes.FailedPrecondition, "promo code %s is valid from %s", code, validFrom.Time.Format(time.DateOnly))
+	case validTo.Valid && today > validTo.Time.Format(time.DateOnly):
+		return money.Zero, money.Zero, status.Errorf(codes.FailedPrecondition, "promo code %s expired on %s", code, validTo.Time.Format(time.DateOnly))
+	case maxUses.Valid && usedCount >= maxUses.Int32:
+		return money.Zero, money.Zero, status.Errorf(codes.FailedPrecondition, "promo code %s is used up", code)
+	case promoTariff != 0 && promoTariff != tariffId:
+		return money.Zero, money.Zero, status.Errorf(codes.FailedPrecondition, "promo code %s is not valid for this tariff", code)
+	}
+	return percent, amount, nil
+}
+
+// CreateInvoice fixes a quote as an open invoice, later prices and promo
+// codes do not change what it asks for. The promo code use is taken here, in
+// the same statement that checks max_uses, so two invoices cannot both get
+// the last use; voiding the invoice gives it back.
+func (r *BillingRepository) CreateInvoice(req *pb.QuoteRequest) (*pb.Invoice, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	q, err := quote(tx, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	var promoCode *string
+	if q.PromoCode != "" {
+		promoCode = &q.PromoCode
+		result, err := tx.Exec(`UPDATE promo_code SET used_count = used_count + 1 WHERE code = $1 AND (max_uses IS NULL OR used_count < max_uses)`, q.PromoCode)
+		if err != nil {
+			return nil, err
+		}
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "promo code %s is used up", q.PromoCode)
+		}
+	}
+	invoice := &pb.Invoice{Quote: q, Status: "OPEN"}
+	var createdAt time.Time
+	err = tx.QueryRow(`INSERT INTO company_invoice(company_id, tariff_id, months, monthly_price, base_sum, prepay_percent, override_percent, promo_code, discount_sum, total, period_from, period_to)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, created_at`, q.CompanyId, q.TariffId, q.Months, q.MonthlyPrice, q.BaseSum, q.PrepayPercent, q.OverridePercent, promoCode, q.DiscountSum, q.Total, q.PeriodFrom, q.ValidDate).
+		Scan(&invoice.Id, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	invoice.CreatedAt = createdAt.Format(time.RFC3339)
+	return invoice, nil
+}
+
+func (r *BillingRepository) GetInvoices(req *pb.GetInvoicesRequest) (*pb.InvoiceList, error) {
+	page, size := req.Page, req.Size
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	filter := `($1 = '' OR i.company_id::text = $1) AND ($2 = '' OR i.status = $2)`
+	var result pb.InvoiceList
+	if err := r.db.QueryRow(`SELECT count(*) FROM company_invoice i WHERE `+filter, req.CompanyId, req.Status).Scan(&result.Count); err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`SELECT i.id, i.company_id, i.tariff_id, t.name, i.months, i.monthly_price, i.base_sum, i.prepay_percent, i.override_percent,
+       coalesce(i.promo_code, ''), i.discount_sum, i.total, i.period_from, i.period_to, i.status, i.paid_sum, i.created_at, i.paid_at
+FROM company_invoice i
+         JOIN tariff t ON t.id = i.tariff_id
+WHERE `+filter+`
+ORDER BY i.id DESC
+LIMIT $3 OFFSET $4`, req.CompanyId, req.Status, size, (page-1)*size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		invoice := pb.Invoice{Quote: &pb.TariffQuote{}}
+		q := invoice.Quote
+		var periodFrom, periodTo, createdAt time.Time
+		var paidAt sql.NullTime
+		err = rows.Scan(&invoice.Id, &q.CompanyId, &q.TariffId, &q.TariffName, &q.Months, &q.MonthlyPrice, &q.BaseSum, &q.PrepayPercent, &q.OverridePercent,
+			&q.PromoCode, &q.DiscountSum, &q.Total, &periodFrom, &periodTo, &invoice.Status, &invoice.PaidSum, &createdAt, &paidAt)
+		if err != nil {
+			return nil, err
+		}
+		q.PeriodFrom = periodFrom.Format(time.DateOnly)
+		q.ValidDate = periodTo.Format(time.DateOnly)
+		invoice.CreatedAt = createdAt.Format(time.RFC3339)
+		if paidAt.Valid {
+			invoice.PaidAt = paidAt.Time.Format(time.RFC3339)
+		}
+		result.Items = append(result.Items, &invoice)
+	}
+	return &result, rows.Err()
+}
+
+func (r *BillingRepository) VoidInvoice(id string) (*pb.AbsResponse, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	var promoCode sql.NullString
+	err = tx.QueryRow(`UPDATE company_invoice SET status = 'VOID' WHERE id = $1 AND status = 'OPEN' AND paid_sum = 0 RETURNING promo_code`, id).Scan(&promoCode)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.FailedPrecondition, "only open invoices without payments can be voided")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if promoCode.Valid {
+		if _, err = tx.Exec(`UPDATE promo_code SET used_count = used_count - 1 WHERE code = $1`, promoCode.String); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "voided"}, nil
+}
+
+func (r *BillingRepository) CreatePromoCode(req *pb.PromoCode) (*pb.PromoCode, error) {
+	req.Code = strings.ToUpper(strings.TrimSpace(req.Code))
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+	amount, err := money.ParseOptional(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "amount: %v", err)
+	}
+	if (req.Percent > 0) == amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "set either percent or amount")
+	}
+	req.Amount = money.Format(amount)
+	_, err = r.db.Exec(`INSERT INTO promo_code(code, percent, amount, tariff_id, valid_from, valid_to, max_uses)
+VALUES ($1, $2, $3, nullif($4, 0), nullif($5, '')::date, nullif($6, '')::date, nullif($7, 0))`,
+		req.Code, req.Percent, req.Amount, req.TariffId, req.ValidFrom, req.ValidTo, req.MaxUses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create promo code: %w", err)
+	}
+	req.IsActive = true
+	req.UsedCount = 0
+	return req, nil
+}
+
+func (r *BillingRepository) GetPromoCodes() (*pb.PromoCodeList, error) {
+	rows, err := r.db.Query(`SELECT code, percent, amount, coalesce(tariff_id, 0), coalesce(valid_from::text, ''), coalesce(valid_to::text, ''),
+       coalesce(max_uses, 0), used_count, is_active
+FROM promo_code
+ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var result pb.PromoCodeList
+	for rows.Next() {
+		var promo pb.PromoCode
+		if err = rows.Scan(&promo.Code, &promo.Percent, &promo.Amount, &promo.TariffId, &promo.ValidFrom, &promo.ValidTo, &promo.MaxUses, &promo.UsedCount, &promo.IsActive); err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, &promo)
+	}
+	return &result, rows.Err()
+}
+
+func (r *BillingRepository) DeactivatePromoCode(code string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`UPDATE promo_code SET is_active = false WHERE code = $1`, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "promo code %s does not exist", code)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "deactivated"}, nil
+}
+
+func (r *BillingRepository) SetPriceOverride(req *pb.PriceOverride) (*pb.PriceOverride, error) {
+	monthlyPrice, err := money.ParseOptional(req.MonthlyPrice)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "monthlyPrice: %v", err)
+	}
+	if monthlyPrice.IsNegative() || req.Percent < 0 || req.Percent >= 100 {
+		return nil, status.Error(codes.InvalidArgument, "monthlyPrice must not be negative and percent must be below 100")
+	}
+	req.MonthlyPrice = money.Format(monthlyPrice)
+	_, err = r.db.Exec(`INSERT INTO company_price_override(company_id, monthly_price, percent, valid_to, comment)
+VALUES ($1, nullif($2::numeric, 0), $3, nullif($4, '')::date, $5)
+ON CONFLICT (company_id) DO UPDATE SET monthly_price = excluded.monthly_price,
+                                       percent       = excluded.percent,
+                                       valid_to      = excluded.valid_to,
+                                       comment       = excluded.comment,
+                                       updated_at    = now()`,
+		req.CompanyId, req.MonthlyPrice, req.Percent, req.ValidTo, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (r *BillingRepository) GetPriceOverride(companyId string) (*pb.PriceOverride, error) {
+	result := pb.PriceOverride{CompanyId: companyId}
+	err := r.db.QueryRow(`SELECT coalesce(monthly_price, 0), percent, coalesce(valid_to::text, ''), coalesce(comment, '')
+FROM company_price_override WHERE company_id = $1`, companyId).Scan(&result.MonthlyPrice, &result.Percent, &result.ValidTo, &result.Comment)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// applyPaymentToInvoice books a company payment on an invoice: the one named
+// by the payment, or else the oldest open invoice of the company with exactly
+// the amount left to pay. Fields the operator left empty are taken from the
+// invoice. A payment that matches no invoice is returned unlinked. partial is
+// true when the payment leaves the invoice open: such a payment does not move
+// the valid date, its EditedValidDate is cleared.
+func applyPaymentToInvoice(tx *sql.Tx, req *pb.CompanyFinance) (invoice sql.NullInt32, partial bool, err error) {
+	var invoiceId, companyId, tariffId int32
+	var total, paidSum, monthlyPrice decimal.Decimal
+	var periodTo time.Time
+	var invoiceStatus string
+	var promoCode sql.NullString
+	row := tx.QueryRow(`SELECT id, company_id, tariff_id, total, paid_sum, monthly_price, period_to, status, promo_code
+FROM company_invoice WHERE id = $1 FOR UPDATE`, req.InvoiceId)
+	if req.InvoiceId == 0 {
+		row = tx.QueryRow(`SELECT id, company_id, tariff_id, total, paid_sum, monthly_price, period_to, status, promo_code
+FROM company_invoice
+WHERE company_id = $1 AND status = 'OPEN' AND round((total - paid_sum)::numeric, 2) = round($2::numeric, 2)
+ORDER BY id
+LIMIT 1 FOR UPDATE`, req.CompanyId, req.Sum)
+	}
+	err = row.Scan(&invoiceId, &companyId, &tariffId, &total, &paidSum, &monthlyPrice, &periodTo, &invoiceStatus, &promoCode)
+	if errors.Is(err, sql.ErrNoRows) {
+		if req.InvoiceId != 0 {
+			return sql.NullInt32{}, false, status.Errorf(codes.NotFound, "invoice %d not found", req.InvoiceId)
+		}
+		return sql.NullInt32{}, false, nil
+	}
+	if err != nil {
+		return sql.NullInt32{}, false, err
+	}
+	if companyId != req.CompanyId {
+		return sql.NullInt32{}, false, status.Errorf(codes.InvalidArgument, "invoice %d belongs to another company", invoiceId)
+	}
+	if invoiceStatus != "OPEN" {
+		return sql.NullInt32{}, false, status.Errorf(codes.FailedPrecondition, "invoice %d is %s", invoiceId, invoiceStatus)
+	}
+
+	if req.TariffId == 0 {
+		req.TariffId = tariffId
+	}
+	if req.TariffSum == "" {
+		req.TariffSum = money.Format(monthlyPrice)
+	}
+	if req.DiscountName == "" && promoCode.Valid {
+		req.DiscountName = promoCode.String
+	}
+
+	sum, err := money.Parse(req.Sum)
+	if err != nil {
+		return sql.NullInt32{}, false, status.Errorf(codes.InvalidArgument, "sum: %v", err)
+	}
+	paidSum = paidSum.Add(sum)
+	paid := !paidSum.LessThan(total)
+	if !paid {
+		req.EditedValidDate = ""
+	} else if req.EditedValidDate == "" {
+		req.EditedValidDate = periodTo.Format(time.DateOnly)
+	}
+	_, err = tx.Exec(`UPDATE company_invoice
+SET paid_sum = $2,
+    status   = CASE WHEN $3 THEN 'PAID' ELSE status END,
+    paid_at  = CASE WHEN $3 THEN now() ELSE paid_at END
+WHERE id = $1`, invoiceId, money.Format(paidSum), paid)
+	if err != nil {
+		return sql.NullInt32{}, false, err
+	}
+	return sql.NullInt32{Int32: invoiceId, Valid: true}, !paid, nil
+}
+
+// revertInvoicePayment takes a deleted company payment off its invoice, which
+// is open again if the payment had settled it. The invoice keeps its promo
+// code use.
+func revertInvoicePayment(tx *sql.Tx, paymentId string) error {
+	_, err := tx.Exec(`UPDATE company_invoice i
+SET paid_sum = i.paid_sum - p.sum,
+    status   = CASE WHEN i.status = 'PAID' AND i.paid_sum - p.sum < i.total - 0.005 THEN 'OPEN' ELSE i.status END,
+    paid_at  = CASE WHEN i.paid_sum - p.sum < i.total - 0.005 THEN NULL ELSE i.paid_at END
+FROM company_payments p
+WHERE p.id = $1
+  AND i.id = p.invoice_id`, paymentId)
+	return err
+}
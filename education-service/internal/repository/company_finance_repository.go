@@ -2,9 +2,13 @@ package repository
 
 import (
 	"database/sql"
+	"education-service/internal/money"
 	"education-service/proto/pb"
 	"errors"
 	"fmt"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CompanyFinanceRepository struct {
@@ -16,44 +20,60 @@ func NewCompanyFinanceRepository(db *sql.DB) *CompanyFinanceRepository {
 }
 
 func (r CompanyFinanceRepository) Create(req *pb.CompanyFinance) (*pb.CompanyFinance, error) {
+	if err := normalizeCompanyPayment(req); err != nil {
+		return nil, err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	var validDate string
-	err := r.db.QueryRow(`SELECT valid_date FROM company WHERE id = $1`, req.GetCompanyId()).Scan(&validDate)
+	err = tx.QueryRow(`SELECT valid_date FROM company WHERE id = $1 FOR UPDATE`, req.GetCompanyId()).Scan(&validDate)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("company with id %d not found", req.GetCompanyId())
 		}
 		return nil, err
 	}
-
-	editedValidDate := req.GetEditedValidDate()
-	if editedValidDate <= validDate {
-		return nil, fmt.Errorf("edited_valid_date (%s) must be greater than valid_date (%s)", editedValidDate, validDate)
-	}
-	tx, err := r.db.Begin()
+	invoiceId, partial, err := applyPaymentToInvoice(tx, req)
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
-	_, err = tx.Exec(`INSERT INTO company_payments(company_id, tariff_id, comment, sum, edited_valid_date , discount_name , discount_id ,tariff_sum) values ($1 ,$2,$3,$4,$5 , $6,$7 , $8)`, req.CompanyId, req.TariffId, req.Comment, req.Sum, req.EditedValidDate, req.DiscountName, req.DiscountId, req.TariffSum)
+	if req.TariffSum == "" {
+		req.TariffSum = money.Format(money.Zero)
+	}
+	if partial {
+		// a part payment of an invoice keeps the current valid date, the one
+		// that settles the invoice extends it
+		req.EditedValidDate = validDate
+	} else if editedValidDate := req.GetEditedValidDate(); editedValidDate <= validDate {
+		return nil, fmt.Errorf("edited_valid_date (%s) must be greater than valid_date (%s)", editedValidDate, validDate)
+	}
+	err = tx.QueryRow(`INSERT INTO company_payments(company_id, tariff_id, comment, sum, edited_valid_date , discount_name , discount_id ,tariff_sum, invoice_id) values ($1 ,$2,$3,$4,$5 , $6,$7 , $8, $9) RETURNING id`, req.CompanyId, req.TariffId, req.Comment, req.Sum, req.EditedValidDate, req.DiscountName, req.DiscountId, req.TariffSum, invoiceId).Scan(&req.Id)
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
-	_, err = tx.Exec(`UPDATE company
+	if !partial {
+		_, err = tx.Exec(`UPDATE company
 SET 
     valid_date = $1,
+    tariff_id = $3,
+    converted_at = CASE WHEN is_demo = true THEN now() ELSE converted_at END,
     is_demo = CASE WHEN is_demo = true THEN false ELSE is_demo END
 WHERE id = $2;
-`, req.EditedValidDate, req.CompanyId)
-	if err != nil {
-		tx.Rollback()
-		return nil, err
+`, req.EditedValidDate, req.CompanyId, req.TariffId)
+		if err != nil {
+			return nil, err
+		}
 	}
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	req.InvoiceId = invoiceId.Int32
+	return req, nil
 }
 
 func (r CompanyFinanceRepository) Delete(req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
@@ -68,6 +88,11 @@ func (r CompanyFinanceRepository) Delete(req *pb.DeleteAbsRequest) (*pb.AbsRespo
 			SELECT 1 
 			FROM company 
 			WHERE valid_date = (SELECT edited_valid_date FROM company_payments WHERE id = $1)
+		) AND NOT EXISTS(
+			SELECT 1
+			FROM company_payments p
+			JOIN company_invoice i ON i.id = p.invoice_id
+			WHERE p.id = $1 AND i.status = 'OPEN'
 		)
 	`, req.Id).Scan(&exists)
 	if err != nil {
@@ -126,6 +151,11 @@ func (r CompanyFinanceRepository) Delete(req *pb.DeleteAbsRequest) (*pb.AbsRespo
 		}
 	}
 
+	if err = revertInvoicePayment(tx, req.Id); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	_, err = tx.Exec(`DELETE FROM company_payments WHERE id = $1`, req.Id)
 	if err != nil {
 		tx.Rollback()
@@ -315,10 +345,10 @@ func (r CompanyFinanceRepository) GetByCompany(req *pb.PageRequest) (*pb.Company
 
 	totalPageCount := (totalCount + int32(size) - 1) / int32(size) // Ceiling division
 
-	var sumAmountPeriod float32
+	var sumAmountPeriod decimal.Decimal
 	var tariffName string
 	var discountName string
-	var requiredSum float32
+	var requiredSum string
 	err = r.db.QueryRow(sumQuery, companyId, from, to).Scan(&sumAmountPeriod, &tariffName, &discountName, &requiredSum)
 	if err != nil {
 		return nil, err
@@ -357,7 +387,7 @@ func (r CompanyFinanceRepository) GetByCompany(req *pb.PageRequest) (*pb.Company
 
 	return &pb.CompanyFinanceSelfList{
 		Count:           totalPageCount,
-		SumAmountPeriod: sumAmountPeriod,
+		SumAmountPeriod: money.Format(sumAmountPeriod),
 		TariffName:      tariffName,
 		DiscountName:    discountName,
 		RequiredSum:     requiredSum,
@@ -366,6 +396,9 @@ func (r CompanyFinanceRepository) GetByCompany(req *pb.PageRequest) (*pb.Company
 }
 
 func (r CompanyFinanceRepository) UpdateByCompany(req *pb.CompanyFinance) (*pb.CompanyFinance, error) {
+	if err := normalizeCompanyPayment(req); err != nil {
+		return nil, err
+	}
 	tx, err := r.db.Begin()
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction: %v", err)
@@ -428,3 +461,21 @@ func (r CompanyFinanceRepository) UpdateByCompany(req *pb.CompanyFinance) (*pb.C
 
 	return updatedRecord, nil
 }
+
+// normalizeCompanyPayment checks the amounts of a company payment and writes
+// them with two decimal places. An empty tariff sum is taken from the invoice.
+func normalizeCompanyPayment(req *pb.CompanyFinance) error {
+	sum, err := money.Parse(req.Sum)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "sum: %v", err)
+	}
+	req.Sum = money.Format(sum)
+	if req.TariffSum != "" {
+		tariffSum, err := money.Parse(req.TariffSum)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "tariffSum: %v", err)
+		}
+		req.TariffSum = money.Format(tariffSum)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"education-service/proto/pb"
 	"fmt"
+	"github.com/shopspring/decimal"
 )
 
 type CourseRepository struct {
@@ -14,7 +15,7 @@ func NewCourseRepository(db *sql.DB) *CourseRepository {
 	return &CourseRepository{db: db}
 }
 
-func (r *CourseRepository) CreateCourse(companyId, title, description string, durationLesson, courseDuration int32, price float64) error {
+func (r *CourseRepository) CreateCourse(companyId, title, description string, durationLesson, courseDuration int32, price decimal.Decimal) error {
 	query := "INSERT INTO courses (title, duration_lesson, course_duration, price, description , company_id) VALUES ($1 , $2 , $3 , $4 , $5 , $6)"
 	_, err := r.db.Exec(query, title, durationLesson, courseDuration, price, description, companyId)
 	if err != nil {
@@ -23,7 +24,7 @@ func (r *CourseRepository) CreateCourse(companyId, title, description string, du
 	return nil
 }
 
-func (r *CourseRepository) UpdateCourse(companyId, title, description, id string, durationLesson, courseDuration int32, price float64) error {
+func (r *CourseRepository) UpdateCourse(companyId, title, description, id string, durationLesson, courseDuration int32, price decimal.Decimal) error {
 	query := "UPDATE courses SET title=$1, duration_lesson=$2, course_duration=$3, price=$4, description=$5  WHERE id = $6 and company_id=$7"
 	_, err := r.db.Exec(query, title, durationLesson, courseDuration, price, description, id, companyId)
 	if err != nil {
@@ -3,8 +3,9 @@ package repository
 import (
 	"database/sql"
 	"education-service/config"
+	"education-service/internal/tracing"
 	"fmt"
-	"log"
+	"log/slog"
 )
 
 func NewPostgresDB(cfg *config.DatabaseConfig) (*sql.DB, error) {
@@ -12,7 +13,7 @@ func NewPostgresDB(cfg *config.DatabaseConfig) (*sql.DB, error) {
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode,
 	)
-	db, err := sql.Open("postgres", connStr)
+	db, err := tracing.OpenDB(connStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
@@ -21,6 +22,6 @@ func NewPostgresDB(cfg *config.DatabaseConfig) (*sql.DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	log.Println("Database connection established")
+	slog.Info("database connection established")
 	return db, nil
 }
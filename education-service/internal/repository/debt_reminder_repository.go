@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/internal/money"
+	"education-service/internal/notification"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"time"
+)
+
+const debtReminderReferencePrefix = "debt_reminder:"
+
+type DebtReminderRepository struct {
+	db       *sql.DB
+	notifier *notification.Notifier
+}
+
+func NewDebtReminderRepository(db *sql.DB, notifier *notification.Notifier) *DebtReminderRepository {
+	return &DebtReminderRepository{db: db, notifier: notifier}
+}
+
+func (r *DebtReminderRepository) GetDebtReminderSettings(companyId string) (*pb.DebtReminderSettings, error) {
+	settings := pb.DebtReminderSettings{BalanceBelow: money.Format(money.Zero), OverdueDays: 3, CadenceDays: 7, MaxReminders: 5}
+	err := r.db.QueryRow(`SELECT is_active, balance_below, overdue_days, cadence_days, max_reminders FROM debt_reminder_setting where company_id=$1`, companyId).
+		Scan(&settings.IsActive, &settings.BalanceBelow, &settings.OverdueDays, &settings.CadenceDays, &settings.MaxReminders)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (r *DebtReminderRepository) UpdateDebtReminderSettings(companyId string, settings *pb.DebtReminderSettings) error {
+	balanceBelow, err := money.ParseOptional(settings.BalanceBelow)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "balanceBelow: %v", err)
+	}
+	if balanceBelow.IsPositive() {
+		return status.Error(codes.InvalidArgument, "balanceBelow should not be positive")
+	}
+	settings.BalanceBelow = money.Format(balanceBelow)
+	if settings.OverdueDays < 0 || settings.CadenceDays <= 0 || settings.MaxReminders <= 0 {
+		return status.Error(codes.InvalidArgument, "overdueDays, cadenceDays and maxReminders should be positive")
+	}
+	_, err = r.db.Exec(`
+		INSERT INTO debt_reminder_setting(company_id, is_active, balance_below, overdue_days, cadence_days, max_reminders) values ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (company_id) DO UPDATE SET is_active=$2, balance_below=$3, overdue_days=$4, cadence_days=$5, max_reminders=$6`,
+		companyId, settings.IsActive, settings.BalanceBelow, settings.OverdueDays, settings.CadenceDays, settings.MaxReminders)
+	if err != nil {
+		return fmt.Errorf("failed to save debt reminder settings: %w", err)
+	}
+	return nil
+}
+
+func (r *DebtReminderRepository) GetDebtReminders(companyId string, reminderStatus string, page, size int32) (*pb.GetDebtRemindersResponse, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	response := pb.GetDebtRemindersResponse{}
+	err := r.db.QueryRow(`SELECT count(*) FROM debt_reminder where company_id=$1 and ($2 = '' or status=$2)`, companyId, reminderStatus).Scan(&response.TotalCount)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`
+		SELECT d.id, d.student_id, s.name, s.phone, s.balance, to_char(d.debt_since, 'YYYY-MM-DD'), d.status, d.reminders_sent,
+		       coalesce(to_char(d.last_sent_at, 'YYYY-MM-DD HH24:MI'), ''), to_char(d.next_send_at, 'YYYY-MM-DD HH24:MI'),
+		       d.reply_status, coalesce(d.reply_comment, ''), coalesce(to_char(d.promised_date, 'YYYY-MM-DD'), ''),
+		       coalesce((SELECT o.status FROM notification_outbox o where o.reference = $5 || d.id::text ORDER BY o.created_at DESC LIMIT 1), '')
+		FROM debt_reminder d JOIN students s ON s.id = d.student_id
+		WHERE d.company_id=$1 and ($2 = '' or d.status=$2)
+		ORDER BY d.status, s.balance LIMIT $3 OFFSET $4`, companyId, reminderStatus, size, (page-1)*size, debtReminderReferencePrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var item pb.DebtReminderItem
+		err = rows.Scan(&item.Id, &item.StudentId, &item.StudentName, &item.PhoneNumber, &item.Balance, &item.DebtSince, &item.Status, &item.RemindersSent,
+			&item.LastSentAt, &item.NextSendAt, &item.ReplyStatus, &item.ReplyComment, &item.PromisedDate, &item.DeliveryStatus)
+		if err != nil {
+			return nil, err
+		}
+		response.Items = append(response.Items, &item)
+	}
+	return &response, rows.Err()
+}
+
+// SetDebtReminderReply records what the debtor answered. A promised date
+// postpones reminders until it passes, stop ends the reminder until the debt
+// is paid or the balance changes.
+func (r *DebtReminderRepository) SetDebtReminderReply(companyId string, req *pb.SetDebtReminderReplyRequest) error {
+	validReplies := map[string]bool{"NONE": true, "PROMISED": true, "REFUSED": true, "NO_ANSWER": true, "WRONG_NUMBER": true}
+	if !validReplies[req.ReplyStatus] {
+		return status.Errorf(codes.InvalidArgument, "invalid reply status: %s", req.ReplyStatus)
+	}
+	var promisedDate *string
+	if req.PromisedDate != "" {
+		if _, err := time.Parse("2006-01-02", req.PromisedDate); err != nil {
+			return status.Error(codes.InvalidArgument, "promisedDate should be in YYYY-MM-DD format")
+		}
+		promisedDate = &req.PromisedDate
+	}
+	result, err := r.db.Exec(`
+		UPDATE debt_reminder
+		SET reply_status=$1, reply_comment=$2, promised_date=$3,
+		    status=CASE WHEN $4 THEN 'STOPPED' ELSE status END,
+		    balance=CASE WHEN $4 THEN (SELECT s.balance FROM students s where s.id = debt_reminder.student_id) ELSE balance END,
+		    closed_at=CASE WHEN $4 THEN now() ELSE closed_at END
+		WHERE id=$5 and company_id=$6 and status='ACTIVE'`,
+		req.ReplyStatus, req.Comment, promisedDate, req.Stop, req.Id, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to save debt reminder reply: %w", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return status.Error(codes.NotFound, "active debt reminder not found")
+	}
+	return nil
+}
+
+type debtReminderSetting struct {
+	companyId    string
+	balanceBelow decimal.Decimal
+	overdueDays  int
+	cadenceDays  int
+	maxReminders int
+}
+
+// RunDebtReminders is the scheduled job: for every company with reminders
+// switched on it closes reminders whose debt was paid, opens reminders for new
+// debtors and queues the reminders that are due.
+func (r *DebtReminderRepository) RunDebtReminders() {
+	rows, err := r.db.Query(`SELECT company_id, balance_below, overdue_days, cadence_days, max_reminders FROM debt_reminder_setting where is_active`)
+	if err != nil {
+		slog.Error("failed to load debt reminder settings", "error", err)
+		return
+	}
+	var settings []debtReminderSetting
+	for rows.Next() {
+		var setting debtReminderSetting
+		if err = rows.Scan(&setting.companyId, &setting.balanceBelow, &setting.overdueDays, &setting.cadenceDays, &setting.maxReminders); err != nil {
+			slog.Error("failed to read debt reminder settings", "error", err)
+			rows.Close()
+			return
+		}
+		settings = append(settings, setting)
+	}
+	rows.Close()
+	for _, setting := range settings {
+		if err = r.runCompanyDebtReminders(setting); err != nil {
+			slog.Error("debt reminders failed", "company_id", setting.companyId, "error", err)
+		}
+	}
+}
+
+func (r *DebtReminderRepository) runCompanyDebtReminders(setting debtReminderSetting) error {
+	_, err := r.db.Exec(`
+		UPDATE debt_reminder d SET status='CLEARED', balance=s.balance, closed_at=coalesce(d.closed_at, now())
+		FROM students s
+		WHERE s.id = d.student_id and d.company_id=$1 and d.status IN ('ACTIVE', 'STOPPED') and s.balance >= 0`, setting.companyId)
+	if err != nil {
+		return fmt.Errorf("failed to close cleared reminders: %w", err)
+	}
+	_, err = r.db.Exec(`
+		INSERT INTO debt_reminder(id, company_id, student_id, balance, next_send_at)
+		SELECT gen_random_uuid(), s.company_id, s.id, s.balance, now() + make_interval(days => $3)
+		FROM students s
+		WHERE s.company_id=$1 and s.condition='ACTIVE' and s.merged_into is null and s.balance < 0 and s.balance < $2
+		  and not exists(SELECT 1 FROM debt_reminder d where d.student_id = s.id and d.status='ACTIVE')
+		  and not exists(SELECT 1 FROM debt_reminder d where d.student_id = s.id and d.status='STOPPED' and d.balance = s.balance)
+		ON CONFLICT DO NOTHING`, setting.companyId, setting.balanceBelow, setting.overdueDays)
+	if err != nil {
+		return fmt.Errorf("failed to open debt reminders: %w", err)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	rows, err := tx.Query(`
+		SELECT d.id, d.student_id, s.balance, d.debt_since
+		FROM debt_reminder d JOIN students s ON s.id = d.student_id
+		WHERE d.company_id=$1 and d.status='ACTIVE' and d.next_send_at <= now() and d.reminders_sent < $2
+		  and (d.promised_date is null or d.promised_date < CURRENT_DATE)
+		FOR UPDATE OF d SKIP LOCKED`, setting.companyId, setting.maxReminders)
+	if err != nil {
+		return err
+	}
+	type dueReminder struct {
+		id, studentId string
+		balance       decimal.Decimal
+		debtSince     time.Time
+	}
+	var due []dueReminder
+	for rows.Next() {
+		var reminder dueReminder
+		if err = rows.Scan(&reminder.id, &reminder.studentId, &reminder.balance, &reminder.debtSince); err != nil {
+			rows.Close()
+			return err
+		}
+		due = append(due, reminder)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	for _, reminder := range due {
+		days := int(time.Since(reminder.debtSince).Hours() / 24)
+		err = r.notifier.NotifyStudentWithReference(tx, setting.companyId, reminder.studentId, notification.EventDebtReminder, map[string]string{
+			"debt":    money.Format(reminder.balance.Abs()),
+			"balance": money.Format(reminder.balance),
+			"days":    fmt.Sprintf("%d", days),
+		}, debtReminderReferencePrefix+reminder.id)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			UPDATE debt_reminder
+			SET reminders_sent=reminders_sent+1, last_sent_at=now(), balance=$1, next_send_at=now() + make_interval(days => $2)
+			WHERE id=$3`, reminder.balance, setting.cadenceDays, reminder.id)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// clearDebtReminders closes the active and stopped reminders of a student whose
+// balance was brought back to non-negative by a payment.
+func clearDebtReminders(tx *sql.Tx, studentId string, balance decimal.Decimal) error {
+	_, err := tx.Exec(`UPDATE debt_reminder SET status='CLEARED', balance=$1, closed_at=coalesce(closed_at, now()) where student_id=$2 and status IN ('ACTIVE', 'STOPPED')`, balance, studentId)
+	return err
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"log"
+	"log/slog"
 	"strconv"
 )
 
@@ -23,14 +23,18 @@ type GroupRepository struct {
 func NewGroupRepository(db *sql.DB, userClient *clients.UserClient) *GroupRepository {
 	return &GroupRepository{db: db, userClient: userClient}
 }
-func (r *GroupRepository) CreateGroup(companyId string, name string, courseId int32, teacherId string, dateType string, days []string, roomId int32, lessonStartTime string, groupStartDate string, groupEndDate string) (string, error) {
+
+// CreateGroup creates a group. A group created before with the same non-empty
+// requestKey is returned instead of a new one.
+func (r *GroupRepository) CreateGroup(companyId string, name string, courseId int32, teacherId string, dateType string, days []string, roomId int32, lessonStartTime string, groupStartDate string, groupEndDate string, requestKey string) (string, error) {
 	query := `
-		INSERT INTO groups(course_id, teacher_id, room_id, date_type, days, start_time, start_date, end_date, is_archived, name, company_id) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10 , $11) 
+		INSERT INTO groups(course_id, teacher_id, room_id, date_type, days, start_time, start_date, end_date, is_archived, name, company_id, request_key) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10 , $11, nullif($12, '')) 
+		ON CONFLICT (company_id, request_key) WHERE request_key IS NOT NULL DO UPDATE SET request_key = excluded.request_key
 		RETURNING id`
 
 	var groupId string
-	err := r.db.QueryRow(query, courseId, teacherId, roomId, dateType, pq.Array(days), lessonStartTime, groupStartDate, groupEndDate, false, name, companyId).Scan(&groupId)
+	err := r.db.QueryRow(query, courseId, teacherId, roomId, dateType, pq.Array(days), lessonStartTime, groupStartDate, groupEndDate, false, name, companyId, requestKey).Scan(&groupId)
 	if err != nil {
 		return "", err
 	}
@@ -81,7 +85,7 @@ func (r *GroupRepository) GetGroup(
 	var totalCount int32
 	err := r.db.QueryRow(countQuery, isArchive, companyId).Scan(&totalCount)
 	if err != nil {
-		log.Printf("Error counting total groups: %v (isArchived=%v, companyId=%v)", err, isArchive, companyId)
+		slog.ErrorContext(ctx, "failed to count groups", "is_archived", isArchive, "error", err)
 		return nil, fmt.Errorf("error counting total groups: %w", err)
 	}
 
@@ -112,14 +116,14 @@ func (r *GroupRepository) GetGroup(
 			pq.Array(&group.Days), &group.LessonStartTime, &group.DateType,
 		)
 		if err != nil {
-			log.Printf("Error scanning row: %v", err)
+			slog.ErrorContext(ctx, "failed to scan group", "error", err)
 			return nil, fmt.Errorf("error scanning row: %w", err)
 		}
 
 		// ✅ Fetch teacher name (optional, can be removed if not needed)
 		teacherName, err := r.userClient.GetTeacherById(ctx, group.TeacherId)
 		if err != nil {
-			log.Printf("Error fetching teacher by ID: %v", err)
+			slog.WarnContext(ctx, "failed to fetch group teacher", "teacher_id", group.TeacherId, "error", err)
 			continue
 		}
 		group.TeacherName = teacherName
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/internal/notification"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// GetNotificationSettings returns the company settings together with the
+// template of every event and language, falling back to the built-in texts.
+func (r *NotificationRepository) GetNotificationSettings(companyId string) (*pb.NotificationSettings, error) {
+	settings := pb.NotificationSettings{Lang: notification.LangUz, SmsEnabled: true, TelegramEnabled: true}
+	err := r.db.QueryRow(`SELECT lang, sms_enabled, telegram_enabled FROM notification_setting where company_id=$1`, companyId).
+		Scan(&settings.Lang, &settings.SmsEnabled, &settings.TelegramEnabled)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	custom := make(map[string]string)
+	rows, err := r.db.Query(`SELECT event, lang, body FROM notification_template where company_id=$1`, companyId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var event, lang, body string
+		if err = rows.Scan(&event, &lang, &body); err != nil {
+			return nil, err
+		}
+		custom[event+"/"+lang] = body
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, event := range notification.Events {
+		for _, lang := range []string{notification.LangUz, notification.LangRu} {
+			template := &pb.NotificationTemplate{Event: string(event), Lang: lang}
+			if body, ok := custom[string(event)+"/"+lang]; ok {
+				template.Body = body
+			} else {
+				template.Body = notification.DefaultTemplate(event, lang)
+				template.IsDefault = true
+			}
+			settings.Templates = append(settings.Templates, template)
+		}
+	}
+	return &settings, nil
+}
+
+// UpdateNotificationSettings stores the settings and the given templates. A
+// template with an empty body restores the built-in text.
+func (r *NotificationRepository) UpdateNotificationSettings(companyId string, settings *pb.NotificationSettings) error {
+	if settings.Lang != notification.LangUz && settings.Lang != notification.LangRu {
+		return status.Error(codes.InvalidArgument, "lang should be uz or ru")
+	}
+	knownEvents := make(map[string]bool)
+	for _, event := range notification.Events {
+		knownEvents[string(event)] = true
+	}
+	for _, template := range settings.Templates {
+		if !knownEvents[template.Event] {
+			return status.Errorf(codes.InvalidArgument, "unknown notification event %s", template.Event)
+		}
+		if template.Lang != notification.LangUz && template.Lang != notification.LangRu {
+			return status.Error(codes.InvalidArgument, "template lang should be uz or ru")
+		}
+		if err := notification.ValidateTemplate(template.Body); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid template for %s: %v", template.Event, err)
+		}
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.Exec(`
+		INSERT INTO notification_setting(company_id, lang, sms_enabled, telegram_enabled) values ($1, $2, $3, $4)
+		ON CONFLICT (company_id) DO UPDATE SET lang=$2, sms_enabled=$3, telegram_enabled=$4`,
+		companyId, settings.Lang, settings.SmsEnabled, settings.TelegramEnabled)
+	if err != nil {
+		return fmt.Errorf("failed to save notification settings: %w", err)
+	}
+	for _, template := range settings.Templates {
+		if template.IsDefault || strings.TrimSpace(template.Body) == "" {
+			_, err = tx.Exec(`DELETE FROM notification_template where company_id=$1 and event=$2 and lang=$3`, companyId, template.Event, template.Lang)
+		} else {
+			_, err = tx.Exec(`
+				INSERT INTO notification_template(company_id, event, lang, body) values ($1, $2, $3, $4)
+				ON CONFLICT (company_id, event, lang) DO UPDATE SET body=$4`,
+				companyId, template.Event, template.Lang, template.Body)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save notification template: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *NotificationRepository) GetNotificationOutbox(companyId string, outboxStatus string, page, size int32) (*pb.GetNotificationOutboxResponse, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	response := pb.GetNotificationOutboxResponse{}
+	err := r.db.QueryRow(`SELECT count(*) FROM notification_outbox where company_id=$1 and ($2 = '' or status=$2)`, companyId, outboxStatus).Scan(&response.TotalCount)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`
+		SELECT id, event, channel, recipient, body, status, attempts, coalesce(last_error, ''),
+		       to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'), coalesce(to_char(sent_at, 'YYYY-MM-DD HH24:MI:SS'), '')
+		FROM notification_outbox where company_id=$1 and ($2 = '' or status=$2)
+		ORDER BY created_at DESC LIMIT $3 OFFSET $4`, companyId, outboxStatus, size, (page-1)*size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var item pb.NotificationOutboxItem
+		if err = rows.Scan(&item.Id, &item.Event, &item.Channel, &item.Recipient, &item.Body, &item.Status, &item.Attempts, &item.LastError, &item.CreatedAt, &item.SentAt); err != nil {
+			return nil, err
+		}
+		response.Items = append(response.Items, &item)
+	}
+	return &response, rows.Err()
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"education-service/internal/clients"
+	"education-service/internal/money"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"time"
+)
+
+type ReconciliationRepository struct {
+	db            *sql.DB
+	financeClient *clients.FinanceClient
+}
+
+func NewReconciliationRepository(db *sql.DB, financeClient *clients.FinanceClient) *ReconciliationRepository {
+	return &ReconciliationRepository{db: db, financeClient: financeClient}
+}
+
+// RunBalanceReconciliation compares students.balance with the balance the
+// finance payment ledger gives for every student of the company and stores the
+// result as a report. Students with balance events still on their way from
+// finance are skipped. With autoCorrect the drifted balances are overwritten
+// with the ledger value and the change is written to the student history.
+//
+// With autoCorrect the students are locked before the ledger is read. A balance
+// event finance delivers meanwhile waits for the lock and is still pending in
+// the ledger answer, so its student is skipped instead of being overwritten
+// with a ledger value the balance already includes.
+func (r *ReconciliationRepository) RunBalanceReconciliation(ctx context.Context, companyId string, autoCorrect bool, actionById, actionByName string) (*pb.ReconciliationReport, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := `SELECT id, name, balance FROM students where company_id=$1 ORDER BY name`
+	if autoCorrect {
+		query += ` FOR UPDATE`
+	}
+	rows, err := tx.Query(query, companyId)
+	if err != nil {
+		return nil, err
+	}
+	type studentBalance struct {
+		id, name string
+		balance  decimal.Decimal
+	}
+	var students []studentBalance
+	for rows.Next() {
+		var student studentBalance
+		if err = rows.Scan(&student.id, &student.name, &student.balance); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		students = append(students, student)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
+	defer cancel()
+	ledger, err := r.financeClient.GetStudentLedgerBalances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load payment ledger: %w", err)
+	}
+	ledgerBalances := make(map[string]*pb.StudentLedgerBalance, len(ledger.Balances))
+	for _, balance := range ledger.Balances {
+		ledgerBalances[balance.StudentId] = balance
+	}
+
+	report := pb.ReconciliationReport{
+		Id:            uuid.New().String(),
+		CreatedAt:     time.Now().Format("2006-01-02 15:04:05"),
+		AutoCorrected: autoCorrect,
+		TriggeredBy:   actionByName,
+	}
+	var totalDrift decimal.Decimal
+	for _, student := range students {
+		drift := pb.BalanceDrift{StudentId: student.id, StudentName: student.name}
+		actual := student.balance
+		var ledgerBalance decimal.Decimal
+		if balance, ok := ledgerBalances[drift.StudentId]; ok {
+			if balance.HasPendingEvents {
+				report.SkippedCount++
+				continue
+			}
+			ledgerBalance, err = money.Parse(balance.Balance)
+			if err != nil {
+				return nil, fmt.Errorf("ledger balance of student %s: %w", drift.StudentId, err)
+			}
+		}
+		report.CheckedCount++
+		difference := actual.Sub(ledgerBalance)
+		if difference.IsZero() {
+			continue
+		}
+		drift.ActualBalance = money.Format(actual)
+		drift.LedgerBalance = money.Format(ledgerBalance)
+		drift.Difference = money.Format(difference)
+		report.DriftCount++
+		totalDrift = totalDrift.Add(difference.Abs())
+		drift.Corrected = autoCorrect
+		report.Drifts = append(report.Drifts, &drift)
+	}
+	report.TotalDrift = money.Format(totalDrift)
+
+	_, err = tx.Exec(`
+		INSERT INTO reconciliation_run(id, company_id, checked_count, skipped_count, drift_count, total_drift, auto_corrected, triggered_by)
+		values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		report.Id, companyId, report.CheckedCount, report.SkippedCount, report.DriftCount, report.TotalDrift, autoCorrect, actionByName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save reconciliation report: %w", err)
+	}
+	for _, drift := range report.Drifts {
+		_, err = tx.Exec(`
+			INSERT INTO reconciliation_drift(id, run_id, student_id, student_name, ledger_balance, actual_balance, difference, corrected)
+			values (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7)`,
+			report.Id, drift.StudentId, drift.StudentName, drift.LedgerBalance, drift.ActualBalance, drift.Difference, drift.Corrected)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save balance drift: %w", err)
+		}
+		if autoCorrect {
+			if err = correctBalanceDrift(tx, companyId, report.Id, drift, actionById, actionByName); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func correctBalanceDrift(tx *sql.Tx, companyId, runId string, drift *pb.BalanceDrift, actionById, actionByName string) error {
+	if _, err := tx.Exec(`UPDATE students SET balance = $1 WHERE id = $2`, drift.LedgerBalance, drift.StudentId); err != nil {
+		return fmt.Errorf("failed to correct student balance: %w", err)
+	}
+	historyJSON, err := json.Marshal(map[string]interface{}{
+		"runId":         runId,
+		"ledgerBalance": drift.LedgerBalance,
+		"difference":    drift.Difference,
+		"createdById":   actionById,
+		"createdByName": actionByName,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`
+        INSERT INTO student_history (id, student_id, field, old_value, current_value, created_at , company_id)
+        VALUES (gen_random_uuid(), $1, 'balance_reconciled', $2, $3, $4 , $5)`, drift.StudentId, drift.ActualBalance, historyJSON, time.Now(), companyId)
+	if err != nil {
+		return fmt.Errorf("failed to write reconciliation history: %w", err)
+	}
+	ledgerBalance, err := money.Parse(drift.LedgerBalance)
+	if err != nil {
+		return err
+	}
+	if !ledgerBalance.IsNegative() {
+		return clearDebtReminders(tx, drift.StudentId, ledgerBalance)
+	}
+	return nil
+}
+
+// RunScheduledReconciliation builds a report for every company. It never
+// corrects balances, that stays a decision of the company.
+func (r *ReconciliationRepository) RunScheduledReconciliation() {
+	rows, err := r.db.Query(`SELECT id FROM company`)
+	if err != nil {
+		slog.Error("failed to load companies for reconciliation", "error", err)
+		return
+	}
+	var companyIds []string
+	for rows.Next() {
+		var companyId string
+		if err = rows.Scan(&companyId); err != nil {
+			rows.Close()
+			slog.Error("failed to read company for reconciliation", "error", err)
+			return
+		}
+		companyIds = append(companyIds, companyId)
+	}
+	rows.Close()
+	for _, companyId := range companyIds {
+		report, err := r.RunBalanceReconciliation(context.Background(), companyId, false, "00000000-0000-0000-0000-000000000000", "TIZIM")
+		if err != nil {
+			slog.Error("balance reconciliation failed", "company_id", companyId, "error", err)
+			continue
+		}
+		if report.DriftCount > 0 {
+			slog.Warn("balance reconciliation found drifted balances", "company_id", companyId, "drift_count", report.DriftCount)
+		}
+	}
+}
+
+func (r *ReconciliationRepository) GetReconciliationReports(companyId string, page, size int32) (*pb.GetReconciliationReportsResponse, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 20
+	}
+	response := pb.GetReconciliationReportsResponse{}
+	err := r.db.QueryRow(`SELECT count(*) FROM reconciliation_run where company_id=$1`, companyId).Scan(&response.TotalCount)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`
+		SELECT id, to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'), checked_count, skipped_count, drift_count, total_drift, auto_corrected, triggered_by
+		FROM reconciliation_run where company_id=$1
+		ORDER BY created_at DESC LIMIT $2 OFFSET $3`, companyId, size, (page-1)*size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var report pb.ReconciliationReport
+		err = rows.Scan(&report.Id, &report.CreatedAt, &report.CheckedCount, &report.SkippedCount, &report.DriftCount, &report.TotalDrift, &report.AutoCorrected, &report.TriggeredBy)
+		if err != nil {
+			return nil, err
+		}
+		response.Items = append(response.Items, &report)
+	}
+	return &response, rows.Err()
+}
+
+func (r *ReconciliationRepository) GetReconciliationReport(companyId, id string) (*pb.ReconciliationReport, error) {
+	var report pb.ReconciliationReport
+	err := r.db.QueryRow(`
+		SELECT id, to_char(created_at, 'YYYY-MM-DD HH24:MI:SS'), checked_count, skipped_count, drift_count, total_drift, auto_corrected, triggered_by
+		FROM reconciliation_run where id=$1 and company_id=$2`, id, companyId).
+		Scan(&report.Id, &report.CreatedAt, &report.CheckedCount, &report.SkippedCount, &report.DriftCount, &report.TotalDrift, &report.AutoCorrected, &report.TriggeredBy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "reconciliation report not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`
+		SELECT student_id, student_name, ledger_balance, actual_balance, difference, corrected
+		FROM reconciliation_drift where run_id=$1
+		ORDER BY abs(difference) DESC`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var drift pb.BalanceDrift
+		if err = rows.Scan(&drift.StudentId, &drift.StudentName, &drift.LedgerBalance, &drift.ActualBalance, &drift.Difference, &drift.Corrected); err != nil {
+			return nil, err
+		}
+		report.Drifts = append(report.Drifts, &drift)
+	}
+	return &report, rows.Err()
+}
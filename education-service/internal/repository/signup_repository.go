@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"education-service/internal/clients"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const subdomainTaken = "this subdomain is taken"
+
+var subdomainPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,30}[a-z0-9]$`)
+
+// reservedSubdomains are served by the platform itself.
+var reservedSubdomains = map[string]bool{
+	"www": true, "api": true, "app": true, "admin": true, "mail": true, "static": true, "cdn": true,
+	"docs": true, "help": true, "support": true, "status": true, "grafana": true, "swagger": true,
+}
+
+// What a new company starts with, so its first screens are not empty.
+var (
+	defaultLeadSections   = []string{"Yangi so'rovlar", "Qo'ng'iroq qilindi"}
+	defaultExpectSections = []string{"Sinov darsiga yozildi"}
+	defaultCategories     = []struct{ name, desc string }{
+		{"Ijara", "Xona ijarasi"},
+		{"Kommunal", "Kommunal to'lovlar"},
+		{"Reklama", "Marketing va reklama"},
+		{"Boshqa", "Boshqa xarajatlar"},
+	}
+)
+
+const (
+	sampleCourseTitle   = "Namuna kurs"
+	sampleCourseLesson  = 90
+	sampleCourseLessons = 12
+	sampleCoursePrice   = 600000
+)
+
+type SignupRepository struct {
+	db            *sql.DB
+	userClient    *clients.UserClient
+	financeClient *clients.FinanceClient
+	leadClient    *clients.LeadClient
+	demoTariffId  int
+	demoDays      int
+}
+
+func NewSignupRepository(db *sql.DB, userClient *clients.UserClient, financeClient *clients.FinanceClient, leadClient *clients.LeadClient, demoTariffId, demoDays int) *SignupRepository {
+	return &SignupRepository{db: db, userClient: userClient, financeClient: financeClient, leadClient: leadClient, demoTariffId: demoTariffId, demoDays: demoDays}
+}
+
+func (r *SignupRepository) CheckSubdomain(subdomain string) (*pb.CheckSubdomainResponse, error) {
+	subdomain = strings.ToLower(strings.TrimSpace(subdomain))
+	resp := &pb.CheckSubdomainResponse{Subdomain: subdomain}
+	switch {
+	case !subdomainPattern.MatchString(subdomain):
+		resp.Reason = "use 3 to 32 latin letters, digits or dashes, not starting or ending with a dash"
+		return resp, nil
+	case reservedSubdomains[subdomain]:
+		resp.Reason = "this subdomain is reserved"
+		return resp, nil
+	}
+	var exists bool
+	if err := r.db.QueryRow(`SELECT exists(SELECT 1 FROM company WHERE lower(subdomain) = $1)`, subdomain).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if exists {
+		resp.Reason = subdomainTaken
+		return resp, nil
+	}
+	resp.Available = true
+	return resp, nil
+}
+
+// Signup creates a demo company together with its CEO. The company row is
+// only committed once user-service has created the CEO; if anything fails from
+// the CreateUser call on, including the call itself timing out after the user
+// was made, the CEO is deleted again, so neither is left without the other.
+// Default sections, categories and a sample course are added afterwards.
+func (r *SignupRepository) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.SignupResponse, error) {
+	if strings.TrimSpace(req.Title) == "" || strings.TrimSpace(req.CeoName) == "" {
+		return nil, status.Error(codes.InvalidArgument, "title and ceoName are required")
+	}
+	if len(req.Password) < 6 {
+		return nil, status.Error(codes.InvalidArgument, "password must be at least 6 characters")
+	}
+	companyPhone, err := utils.NormalizePhone(req.CompanyPhone)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ceoPhone, err := utils.NormalizePhone(req.CeoPhone)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	check, err := r.CheckSubdomain(req.Subdomain)
+	if err != nil {
+		return nil, err
+	}
+	if !check.Available {
+		if check.Reason == subdomainTaken {
+			return nil, status.Error(codes.AlreadyExists, check.Reason)
+		}
+		return nil, status.Error(codes.InvalidArgument, check.Reason)
+	}
+	var exists bool
+	if err = r.db.QueryRow(`SELECT exists(SELECT 1 FROM company WHERE company_phone = $1)`, companyPhone).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, status.Error(codes.AlreadyExists, "a company with this phone number already exists")
+	}
+	tariffId, err := r.demoTariff()
+	if err != nil {
+		return nil, err
+	}
+	startTime, endTime := req.StartTime, req.EndTime
+	if startTime == "" {
+		startTime = "09:00"
+	}
+	if endTime == "" {
+		endTime = "18:00"
+	}
+	validDate := time.Now().AddDate(0, 0, r.demoDays).Format(time.DateOnly)
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	var companyId int
+	err = tx.QueryRow(`INSERT INTO company(title, avatar, start_time, end_time, company_phone, subdomain, valid_date, tariff_id, is_demo, source)
+VALUES ($1, '', $2, $3, $4, $5, $6, $7, true, 'SIGNUP')
+RETURNING id`, req.Title, startTime, endTime, companyPhone, check.Subdomain, validDate, tariffId).Scan(&companyId)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return nil, status.Error(codes.AlreadyExists, subdomainTaken)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(`INSERT INTO courses (title, duration_lesson, course_duration, price, description, company_id) VALUES ($1, $2, $3, $4, $5, $6)`,
+		sampleCourseTitle, sampleCourseLesson, sampleCourseLessons, sampleCoursePrice, "Namuna sifatida qo'shilgan kurs, o'zgartiring yoki o'chiring", companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	company := strconv.Itoa(companyId)
+	ceoId := uuid.New().String()
+	userCtx, cancel := utils.NewTimoutContext(ctx, company)
+	defer cancel()
+	committed := false
+	defer func() {
+		if committed {
+			return
+		}
+		cleanupCtx, cancel := utils.NewTimoutContext(context.WithoutCancel(ctx), company)
+		defer cancel()
+		if deleteErr := r.userClient.DeleteUser(cleanupCtx, ceoId); deleteErr != nil && status.Code(deleteErr) != codes.NotFound {
+			slog.ErrorContext(ctx, "failed to delete the CEO of a signup that was not saved", "user_id", ceoId, "error", deleteErr)
+		}
+	}()
+	err = r.userClient.CreateUser(userCtx, &pb.CreateUserRequest{
+		Id:          ceoId,
+		FullName:    req.CeoName,
+		PhoneNumber: ceoPhone,
+		Password:    req.Password,
+		Role:        "CEO",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	committed = true
+	slog.InfoContext(ctx, "company signed up", "company_id", companyId, "subdomain", check.Subdomain)
+
+	r.seed(userCtx, company)
+	return &pb.SignupResponse{
+		CompanyId: company,
+		Subdomain: check.Subdomain,
+		CeoId:     ceoId,
+		ValidDate: validDate,
+		TariffId:  int32(tariffId),
+	}, nil
+}
+
+// demoTariff is the configured demo tariff, or the cheapest one there is.
+func (r *SignupRepository) demoTariff() (int, error) {
+	if r.demoTariffId > 0 {
+		return r.demoTariffId, nil
+	}
+	var id int
+	err := r.db.QueryRow(`SELECT id FROM tariff WHERE is_deleted = false ORDER BY sum, id LIMIT 1`).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, status.Error(codes.FailedPrecondition, "no tariff to start a demo with, create one first")
+	}
+	return id, err
+}
+
+// seed adds the defaults kept by the other services. The company is usable
+// without them, failures are only logged.
+func (r *SignupRepository) seed(ctx context.Context, companyId string) {
+	for _, title := range defaultLeadSections {
+		if err := r.leadClient.CreateLeadSection(ctx, title); err != nil {
+			slog.WarnContext(ctx, "failed to seed lead section", "company_id", companyId, "title", title, "error", err)
+		}
+	}
+	for _, title := range defaultExpectSections {
+		if err := r.leadClient.CreateExpectSection(ctx, title); err != nil {
+			slog.WarnContext(ctx, "failed to seed expectation section", "company_id", companyId, "title", title, "error", err)
+		}
+	}
+	for _, category := range defaultCategories {
+		if err := r.financeClient.CreateCategory(ctx, category.name, category.desc); err != nil {
+			slog.WarnContext(ctx, "failed to seed expense category", "company_id", companyId, "name", category.name, "error", err)
+		}
+	}
+}
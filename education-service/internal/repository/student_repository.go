@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"education-service/internal/clients"
+	"education-service/internal/metrics"
+	"education-service/internal/money"
+	"education-service/internal/notification"
 	"education-service/internal/utils"
 	"education-service/proto/pb"
 	"encoding/json"
@@ -11,9 +14,10 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
-	"github.com/spf13/cast"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"log/slog"
 	"math"
 	"net/http"
 	"strconv"
@@ -22,27 +26,16 @@ import (
 )
 
 type StudentRepository struct {
-	db                *sql.DB
-	userClient        *clients.UserClient
-	financeClient     *clients.FinanceClient
-	financeClientChan chan *clients.FinanceClient
+	db            *sql.DB
+	userClient    *clients.UserClient
+	financeClient *clients.FinanceClient
+	notifier      *notification.Notifier
 }
 
-func NewStudentRepository(db *sql.DB, userClient *clients.UserClient, financeClientChan chan *clients.FinanceClient) *StudentRepository {
-	return &StudentRepository{db: db, userClient: userClient, financeClientChan: financeClientChan}
+func NewStudentRepository(db *sql.DB, userClient *clients.UserClient, financeClient *clients.FinanceClient, notifier *notification.Notifier) *StudentRepository {
+	return &StudentRepository{db: db, userClient: userClient, financeClient: financeClient, notifier: notifier}
 }
 
-func (r *StudentRepository) ensureFinanceClient() error {
-	if r.financeClient == nil {
-		select {
-		case client := <-r.financeClientChan:
-			r.financeClient = client
-		case <-time.After(5 * time.Second):
-			return fmt.Errorf("failed to initialize GroupClient within timeout")
-		}
-	}
-	return nil
-}
 func (r *StudentRepository) GetAllStudent(ctx context.Context, companyId string, condition string, page string, size string, courseId string, nameOrSurname string, trainingDateStart, trainingDateEnd string) (*pb.GetAllStudentResponse, error) {
 	pageInt, err := strconv.Atoi(page)
 	if err != nil || pageInt < 1 {
@@ -99,14 +92,16 @@ func (r *StudentRepository) GetAllStudent(ctx context.Context, companyId string,
 	var students []*pb.GetGroupsAbsForStudent
 	for studentRows.Next() {
 		var student pb.GetGroupsAbsForStudent
+		var dateOfBirth sql.NullString
 		err := studentRows.Scan(
-			&student.Id, &student.Name, &student.Gender, &student.DateOfBirth, &student.Phone,
+			&student.Id, &student.Name, &student.Gender, &dateOfBirth, &student.Phone,
 			&student.Address, &student.PassportId, &student.AdditionalContact, &student.Balance,
 			&student.Condition, &student.TelegramUsername, &student.CreatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan student row: %v", err)
 		}
+		student.DateOfBirth = dateOfBirth.String
 		students = append(students, &student)
 	}
 
@@ -199,22 +194,147 @@ func (r *StudentRepository) GetAllStudent(ctx context.Context, companyId string,
 		TotalCount: totalPages,
 	}, nil
 }
-func (r *StudentRepository) CreateStudent(companyId string, createdBy string, phoneNumber string, name string, groupId string, address string, additionalContact string, dateFrom string, birthDate string, gender bool, passportId string, telegramUsername string) error {
-	studentId := uuid.New()
-	_, err := r.db.Exec(`INSERT INTO students(id, name, phone, date_of_birth, gender, telegram_username, passport_id, additional_contact, address , company_id) values ($1, $2,$3,$4,$5,$6,$7,$8,$9 , $10)`, studentId, name, phoneNumber, birthDate, gender, telegramUsername, passportId, additionalContact, address, companyId)
+
+// CreateStudent stores a new student. A caller supplied studentId makes the call
+// idempotent: when the student already exists nothing is changed, which lets
+// lead-service retry a set conversion safely.
+func (r *StudentRepository) CreateStudent(companyId string, createdBy string, phoneNumber string, name string, groupId string, address string, additionalContact string, dateFrom string, birthDate string, gender bool, passportId string, telegramUsername string, studentId string, comment string, source string) ([]string, error) {
+	phoneNumber, err := utils.NormalizePhone(phoneNumber)
 	if err != nil {
-		return err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if studentId == "" {
+		studentId = uuid.New().String()
+	} else if _, err = uuid.Parse(studentId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid student id")
+	}
+	duplicates, err := r.findStudentNamesByPhone(companyId, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	var dateOfBirth *string
+	if birthDate != "" {
+		dateOfBirth = &birthDate
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	result, err := tx.Exec(`INSERT INTO students(id, name, phone, date_of_birth, gender, telegram_username, passport_id, additional_contact, address , company_id) values ($1, $2,$3,$4::date,$5,$6,$7,$8,$9 , $10) ON CONFLICT (id) DO NOTHING`, studentId, name, phoneNumber, dateOfBirth, gender, telegramUsername, passportId, additionalContact, address, companyId)
+	if err != nil {
+		return nil, err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, nil
+	}
+	// checked only once the row is in: a repeated call with the same id inserts
+	// nothing and must not fail on a company that reached its limit meanwhile
+	if err = checkStudentLimit(tx, companyId, 0); err != nil {
+		return nil, err
 	}
 	if groupId != "" && dateFrom != "" && createdBy != "" {
-		_, err = r.db.Exec(`INSERT INTO group_students(id, group_id, student_id, created_by , company_id) values ($1 ,$2 ,$3 ,$4 , $5)`, uuid.New(), groupId, studentId, createdBy, companyId)
+		_, err = tx.Exec(`INSERT INTO group_students(id, group_id, student_id, created_by , company_id) values ($1 ,$2 ,$3 ,$4 , $5)`, uuid.New(), groupId, studentId, createdBy, companyId)
 		if err != nil {
+			return nil, err
+		}
+	}
+	if note := leadNote(comment, source); note != "" {
+		_, err = tx.Exec(`INSERT INTO student_note(id , student_id, comment , company_id) values ($1,$2,$3 , $4)`, uuid.New(), studentId, note, companyId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return duplicates, nil
+}
+func leadNote(comment, source string) string {
+	comment = strings.TrimSpace(comment)
+	if source = strings.TrimSpace(source); source != "" {
+		if comment != "" {
+			return fmt.Sprintf("Manba: %s\n%s", source, comment)
+		}
+		return "Manba: " + source
+	}
+	return comment
+}
+
+// DiscardStudents removes students created by a failed set conversion in
+// lead-service. Students that already have attendance are kept. The group,
+// given by id or by the request key it was created with, is removed only when
+// nobody else is left in it.
+func (r *StudentRepository) DiscardStudents(companyId string, studentIds []string, groupId, groupRequestKey string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if groupId == "" && groupRequestKey != "" {
+		// the group of a request whose reply was lost, if it was created
+		err = tx.QueryRow(`SELECT id FROM groups WHERE company_id = $1 and request_key = $2`, companyId, groupRequestKey).Scan(&groupId)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return err
 		}
 	}
-	return nil
+	ids := pq.Array(studentIds)
+	const discardable = `SELECT id FROM students s WHERE s.id = ANY($1::uuid[]) and s.company_id = $2 and not exists(SELECT 1 FROM attendance a where a.student_id = s.id)`
+	queries := []string{
+		`DELETE FROM group_student_condition_history WHERE student_id IN (` + discardable + `)`,
+		`DELETE FROM group_students WHERE student_id IN (` + discardable + `)`,
+		`DELETE FROM student_note WHERE student_id IN (` + discardable + `)`,
+		`DELETE FROM student_history WHERE student_id IN (` + discardable + `)`,
+		`DELETE FROM students WHERE id IN (` + discardable + `)`,
+	}
+	for _, query := range queries {
+		if _, err = tx.Exec(query, ids, companyId); err != nil {
+			return fmt.Errorf("failed to discard students: %w", err)
+		}
+	}
+	if groupId != "" {
+		var inUse bool
+		err = tx.QueryRow(`SELECT exists(SELECT 1 FROM group_students where group_id = $1) or exists(SELECT 1 FROM attendance where group_id = $1)`, groupId).Scan(&inUse)
+		if err != nil {
+			return err
+		}
+		if !inUse {
+			if _, err = tx.Exec(`DELETE FROM group_history WHERE group_id = $1 and exists(SELECT 1 FROM groups where id = $1 and company_id = $2)`, groupId, companyId); err != nil {
+				return err
+			}
+			if _, err = tx.Exec(`DELETE FROM groups WHERE id = $1 and company_id = $2`, groupId, companyId); err != nil {
+				return fmt.Errorf("failed to discard group: %w", err)
+			}
+		}
+	}
+	return tx.Commit()
+}
+func (r *StudentRepository) findStudentNamesByPhone(companyId string, phoneNumber string) ([]string, error) {
+	rows, err := r.db.Query(`SELECT name FROM students where company_id=$1 and phone=$2 and merged_into is null`, companyId, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
 }
 func (r *StudentRepository) UpdateStudent(companyId string, studentId string, number string, name string, address string, additionalContact string, birth string, gender bool, passportId string) error {
-	_, err := r.db.Exec(`UPDATE students SET phone =$1, name=$2, address =$3, additional_contact =$4, date_of_birth =$5, gender =$6, passport_id=$7 where id=$8 and company_id=$9`, number, name, address, additionalContact, birth, gender, passportId, studentId, companyId)
+	number, err := utils.NormalizePhone(number)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	var dateOfBirth *string
+	if birth != "" {
+		dateOfBirth = &birth
+	}
+	_, err = r.db.Exec(`UPDATE students SET phone =$1, name=$2, address =$3, additional_contact =$4, date_of_birth =$5, gender =$6, passport_id=$7 where id=$8 and company_id=$9`, number, name, address, additionalContact, dateOfBirth, gender, passportId, studentId, companyId)
 	if err != nil {
 		return err
 	}
@@ -246,47 +366,63 @@ func (r *StudentRepository) DeleteStudent(ctx context.Context, companyId string,
 			return err
 		}
 	} else {
-		_, err := r.db.Exec(`UPDATE students SET condition='ACTIVE' where id=$1 and company_id=$2`, studentId, companyId)
+		tx, err := r.db.Begin()
 		if err != nil {
 			return err
 		}
+		defer tx.Rollback()
+		if err = checkStudentLimit(tx, companyId, 1); err != nil {
+			return err
+		}
+		_, err = tx.Exec(`UPDATE students SET condition='ACTIVE' where id=$1 and company_id=$2`, studentId, companyId)
+		if err != nil {
+			return err
+		}
+		return tx.Commit()
 	}
 	return nil
 }
 func (r *StudentRepository) AddToGroup(companyId string, groupId string, studentIds []string, createdDate, createdBy string) error {
 	var checker bool
-	query := `INSERT INTO group_students(id, group_id, student_id, condition, last_specific_date, created_by , company_id) values ($1 ,$2 ,$3 ,$4 , $5 , $6 , $7)`
+	query := `INSERT INTO group_students(id, group_id, student_id, condition, last_specific_date, created_by , company_id) values ($1 ,$2 ,$3 ,$4 , $5 , $6 , $7) ON CONFLICT (group_id, student_id) DO NOTHING`
 	queryForChecking := `SELECT exists(SELECT 1 FROM students where condition = 'ARCHIVED' and id=$1 and company_id=$2)`
 	queryGroupChecking := `SELECT exists(SELECT 1 FROM groups where id=$1 and is_archived=true and company_id=$2)`
 	err := r.db.QueryRow(queryGroupChecking, groupId, companyId).Scan(&checker)
 	if err != nil || checker {
 		return errors.New(fmt.Sprintf("forbidden (archived group action error) id=%s", groupId))
 	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	// the students are counted already, but a company over the limit of its
+	// tariff may not enrol more until it is back under it
+	if err = checkStudentLimit(tx, companyId, 0); err != nil {
+		return err
+	}
 	for _, data := range studentIds {
-		err := r.db.QueryRow(queryForChecking, data, companyId).Scan(&checker)
+		err := tx.QueryRow(queryForChecking, data, companyId).Scan(&checker)
 		if err != nil || checker {
 			return errors.New(fmt.Sprintf("forbidden (archived student action error) id=%s", data))
 		}
-		_, err = r.db.Exec(query, uuid.New(), groupId, data, "FREEZE", createdDate, createdBy, companyId)
-		if err != nil {
-			continue
+		if _, err = tx.Exec(query, uuid.New(), groupId, data, "FREEZE", createdDate, createdBy, companyId); err != nil {
+			return err
 		}
 	}
-	return nil
+	return tx.Commit()
 }
 func (r *StudentRepository) GetStudentById(ctx context.Context, companyId string, id string) (*pb.GetStudentByIdResponse, error) {
-	if err := r.ensureFinanceClient(); err != nil {
-		return nil, err
-	}
-
 	var result pb.GetStudentByIdResponse
+	var dateOfBirth sql.NullString
 
 	err := r.db.QueryRow(`SELECT id, name, gender, date_of_birth, phone, balance, created_at , condition , additional_contact
                           FROM students WHERE id = $1 and company_id=$2`, id, companyId).
-		Scan(&result.Id, &result.Name, &result.Gender, &result.DateOfBirth, &result.Phone, &result.Balance, &result.CreatedAt, &result.Condition, &result.AdditionalContact)
+		Scan(&result.Id, &result.Name, &result.Gender, &dateOfBirth, &result.Phone, &result.Balance, &result.CreatedAt, &result.Condition, &result.AdditionalContact)
 	if err != nil {
 		return nil, err
 	}
+	result.DateOfBirth = dateOfBirth.String
 	rows, err := r.db.Query(`
         SELECT gs.created_at, gs.group_id, gs.condition, gs.last_specific_date, 
                g.name, g.date_type, g.days, g.start_time, g.start_date, g.end_date,
@@ -318,10 +454,21 @@ func (r *StudentRepository) GetStudentById(ctx context.Context, companyId string
 			return nil, err
 		}
 
-		discount, _ := r.financeClient.GetDiscountByStudentId(ctx, result.Id, groupStudent.Id)
+		discountReq, err := discountRequest(r.db, companyId, result.Id, groupStudent.Id, time.Now())
+		if err != nil {
+			return nil, err
+		}
 		groupStudent.PriceForStudent = course.Price
+		discount, err := r.financeClient.GetDiscountByStudentId(ctx, discountReq)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get discount: %v", err)
+		}
 		if discount != nil {
-			groupStudent.PriceForStudent = course.Price - *discount
+			price, err := money.Parse(course.Price)
+			if err != nil {
+				return nil, err
+			}
+			groupStudent.PriceForStudent = money.Format(price.Sub(discount.Amount))
 		}
 		groupStudent.Room = &room
 		groupStudent.Course = &course
@@ -336,6 +483,7 @@ func (r *StudentRepository) GetStudentById(ctx context.Context, companyId string
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
+	result.PaymentPlans = r.financeClient.GetStudentPaymentPlans(ctx, result.Id)
 
 	return &result, nil
 }
@@ -404,6 +552,175 @@ func (r *StudentRepository) SearchStudent(companyId string, value string) (*pb.S
 
 	return &pb.SearchStudentResponse{Students: students}, nil
 }
+func (r *StudentRepository) FindStudentsByPhone(companyId string, phoneNumber string) (*pb.SearchStudentResponse, error) {
+	phoneNumber, err := utils.NormalizePhone(phoneNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rows, err := r.db.Query(`SELECT id, name, phone FROM students where company_id=$1 and phone=$2 and merged_into is null order by created_at`, companyId, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var students []*pb.AbsStudent
+	for rows.Next() {
+		var student pb.AbsStudent
+		if err = rows.Scan(&student.Id, &student.Name, &student.PhoneNumber); err != nil {
+			return nil, err
+		}
+		students = append(students, &student)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return &pb.SearchStudentResponse{Students: students}, nil
+}
+
+// MergeStudents moves groups, attendance, notes, history and balance of the
+// source student onto the target and archives the source. Finance records are
+// moved through the finance service once the local transaction has committed;
+// until that succeeds the source stays merge_pending and RetryPendingMerges
+// tries again. The finance side is idempotent so retries are safe.
+func (r *StudentRepository) MergeStudents(ctx context.Context, companyId string, sourceId, targetId, actionById, actionByName string) error {
+	if sourceId == targetId {
+		return status.Error(codes.InvalidArgument, "source and target students should be different")
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var sourceBalance, targetBalance decimal.Decimal
+	var sourceName string
+	err = tx.QueryRow(`SELECT name, balance FROM students where id=$1 and company_id=$2 and merged_into is null FOR UPDATE`, sourceId, companyId).Scan(&sourceName, &sourceBalance)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "source student not found")
+	}
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRow(`SELECT balance FROM students where id=$1 and company_id=$2 and merged_into is null FOR UPDATE`, targetId, companyId).Scan(&targetBalance)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "target student not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	// groups both students belong to keep the target membership, the source
+	// condition history is re-pointed to it before the source row goes away
+	_, err = tx.Exec(`
+		UPDATE group_student_condition_history h
+		SET group_student_id = t.id, student_id = $2
+		FROM group_students s
+		JOIN group_students t ON t.group_id = s.group_id and t.student_id = $2
+		WHERE h.group_student_id = s.id and s.student_id = $1 and s.company_id = $3`, sourceId, targetId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move group condition history: %w", err)
+	}
+	_, err = tx.Exec(`DELETE FROM group_students s WHERE s.student_id = $1 and s.company_id = $3 and exists(SELECT 1 FROM group_students t where t.group_id = s.group_id and t.student_id = $2)`, sourceId, targetId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to drop duplicated group membership: %w", err)
+	}
+	_, err = tx.Exec(`UPDATE group_students SET student_id = $2 WHERE student_id = $1 and company_id = $3`, sourceId, targetId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move group membership: %w", err)
+	}
+	_, err = tx.Exec(`UPDATE group_student_condition_history SET student_id = $2 WHERE student_id = $1 and company_id = $3`, sourceId, targetId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move group condition history: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		UPDATE attendance a SET student_id = $2
+		WHERE a.student_id = $1 and a.company_id = $3
+		  and not exists(SELECT 1 FROM attendance t where t.group_id = a.group_id and t.attend_date = a.attend_date and t.student_id = $2)`, sourceId, targetId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move attendance: %w", err)
+	}
+	_, err = tx.Exec(`DELETE FROM attendance WHERE student_id = $1 and company_id = $2`, sourceId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to drop duplicated attendance: %w", err)
+	}
+	if _, err = tx.Exec(`UPDATE student_note SET student_id = $2 WHERE student_id = $1`, sourceId, targetId); err != nil {
+		return fmt.Errorf("failed to move student notes: %w", err)
+	}
+	if _, err = tx.Exec(`UPDATE student_history SET student_id = $2 WHERE student_id = $1`, sourceId, targetId); err != nil {
+		return fmt.Errorf("failed to move student history: %w", err)
+	}
+
+	if _, err = tx.Exec(`UPDATE students SET balance = balance + $1 WHERE id = $2`, sourceBalance, targetId); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(`UPDATE students SET balance = 0, condition = 'ARCHIVED', merged_into = $2, merge_pending = true WHERE id = $1`, sourceId, targetId); err != nil {
+		return err
+	}
+	historyJSON, err := json.Marshal(map[string]interface{}{
+		"sourceStudentId":   sourceId,
+		"sourceStudentName": sourceName,
+		"movedBalance":      money.Format(sourceBalance),
+		"createdById":       actionById,
+		"createdByName":     actionByName,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`
+        INSERT INTO student_history (id, student_id, field, old_value, current_value, created_at , company_id)
+        VALUES (gen_random_uuid(), $1, 'merge', $2, $3, $4 , $5)`, targetId, money.Format(targetBalance), historyJSON, time.Now(), companyId)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err = r.syncMergedStudent(ctx, companyId, sourceId, targetId); err != nil {
+		slog.ErrorContext(ctx, "failed to merge student payments, will retry", "source_id", sourceId, "target_id", targetId, "error", err)
+	}
+	return nil
+}
+
+// syncMergedStudent moves the finance records of a merged student and clears
+// its merge_pending flag.
+func (r *StudentRepository) syncMergedStudent(ctx context.Context, companyId, sourceId, targetId string) error {
+	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
+	defer cancel()
+	if _, err := r.financeClient.MergeStudent(ctx, sourceId, targetId); err != nil {
+		return err
+	}
+	_, err := r.db.Exec(`UPDATE students SET merge_pending = false WHERE id = $1`, sourceId)
+	return err
+}
+
+// RetryPendingMerges finishes the merges whose finance records could not be
+// moved right after the local commit.
+func (r *StudentRepository) RetryPendingMerges() {
+	rows, err := r.db.Query(`SELECT id, merged_into, company_id FROM students where merge_pending`)
+	if err != nil {
+		slog.Error("failed to load pending student merges", "error", err)
+		return
+	}
+	type pendingMerge struct{ sourceId, targetId, companyId string }
+	var pending []pendingMerge
+	for rows.Next() {
+		var merge pendingMerge
+		if err = rows.Scan(&merge.sourceId, &merge.targetId, &merge.companyId); err != nil {
+			slog.Error("failed to read pending student merge", "error", err)
+			continue
+		}
+		pending = append(pending, merge)
+	}
+	rows.Close()
+	for _, merge := range pending {
+		if err = r.syncMergedStudent(context.Background(), merge.companyId, merge.sourceId, merge.targetId); err != nil {
+			slog.Error("failed to merge student payments", "source_id", merge.sourceId, "target_id", merge.targetId, "error", err)
+		}
+	}
+}
+
 func (r *StudentRepository) GetHistoryGroupById(companyId string, groupId string) (*pb.GetHistoryGroupResponse, error) {
 	response := &pb.GetHistoryGroupResponse{}
 	groupHistoryQuery := `SELECT id, field, old_value, current_value 
@@ -542,10 +859,24 @@ func (r *StudentRepository) TransferLessonDate(companyId string, groupId string,
 			return nil, err
 		}
 	} else {
-		_, err = r.db.Exec(`INSERT INTO transfer_lesson(id, group_id, real_date, transfer_date , company_id) values ($1, $2, $3, $4 , $5)`, uuid.New(), groupId, from, to, companyId)
+		tx, err := r.db.Begin()
 		if err != nil {
 			return nil, err
 		}
+		defer tx.Rollback()
+		_, err = tx.Exec(`INSERT INTO transfer_lesson(id, group_id, real_date, transfer_date , company_id) values ($1, $2, $3, $4 , $5)`, uuid.New(), groupId, from, to, companyId)
+		if err != nil {
+			return nil, err
+		}
+		var groupName string
+		_ = tx.QueryRow(`SELECT name FROM groups where id=$1 and company_id=$2`, groupId, companyId).Scan(&groupName)
+		err = r.notifier.NotifyGroup(tx, companyId, groupId, notification.EventLessonTransferred, map[string]string{"group": groupName, "from": from, "to": to})
+		if err != nil {
+			return nil, err
+		}
+		if err = tx.Commit(); err != nil {
+			return nil, err
+		}
 	}
 	return &pb.AbsResponse{
 		Status:  200,
@@ -555,10 +886,6 @@ func (r *StudentRepository) TransferLessonDate(companyId string, groupId string,
 func (r *StudentRepository) ChangeConditionStudent(ctx context.Context, companyId string, studentId string, groupId string, status string, returnTheMoney bool, tillDate string, actionById, actionByName, comment string) (*pb.AbsResponse, error) {
 	isEliminatedInTrial := false
 
-	if err := r.ensureFinanceClient(); err != nil {
-		return nil, err
-	}
-
 	validStatuses := map[string]bool{"FREEZE": true, "ACTIVE": true, "DELETE": true}
 	if !validStatuses[status] {
 		return nil, fmt.Errorf("invalid status: %s", status)
@@ -640,6 +967,15 @@ func (r *StudentRepository) ChangeConditionStudent(ctx context.Context, companyI
 		tx.Rollback()
 		return nil, fmt.Errorf("failed to insert into group_student_condition_history: %v", err)
 	}
+	if status == "FREEZE" {
+		var groupName string
+		_ = tx.QueryRow(`SELECT name FROM groups where id=$1`, groupId).Scan(&groupName)
+		err = r.notifier.NotifyStudent(tx, companyId, studentId, notification.EventStudentFrozen, map[string]string{"group": groupName, "date": tillDate})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
 
 	fromDate := tillDateParsed.Time
 	currentDate := time.Now()
@@ -649,7 +985,20 @@ func (r *StudentRepository) ChangeConditionStudent(ctx context.Context, companyI
 		for d := fromDate; d.Before(currentDate) || d.Equal(currentDate); d = d.AddDate(0, 1, 0) {
 			monthYearDate := d.Format("2006-01-02")
 
-			manaulPriceForCourse, _ := r.financeClient.GetDiscountByStudentId(ctx, studentId, groupId)
+			discountReq, err := discountRequest(tx, companyId, studentId, groupId, d)
+			if err != nil {
+				tx.Rollback()
+				return nil, fmt.Errorf("failed to gather discount facts for %s: %v", monthYearDate, err)
+			}
+			var manaulPriceForCourse *decimal.Decimal
+			discount, err := r.financeClient.GetDiscountByStudentId(ctx, discountReq)
+			if err != nil {
+				tx.Rollback()
+				return nil, fmt.Errorf("failed to get discount for %s: %v", monthYearDate, err)
+			}
+			if discount != nil {
+				manaulPriceForCourse = &discount.Amount
+			}
 			amount, err := utils.CalculateMoneyForStatus(r.db, manaulPriceForCourse, groupId, monthYearDate)
 			if err != nil {
 				tx.Rollback()
@@ -673,8 +1022,8 @@ func (r *StudentRepository) ChangeConditionStudent(ctx context.Context, companyI
 			}
 
 			_, err = r.financeClient.PaymentAdd(ctx,
-				description, monthYearDate, "CASH", fmt.Sprintf("%v", amount),
-				studentId, transactionType, actionById, actionByName, groupId, tillDate)
+				description, monthYearDate, "CASH", money.Format(amount),
+				studentId, transactionType, actionById, actionByName, groupId, tillDate, true, nil)
 			if err != nil {
 				tx.Rollback()
 				return nil, fmt.Errorf("failed to add payment for %s: %v", monthYearDate, err)
@@ -723,7 +1072,7 @@ func (r *StudentRepository) GetStudentsByGroupId(companyId string, groupId strin
 
 	return &pb.GetStudentsByGroupIdResponse{Students: students}, nil
 }
-func (r *StudentRepository) ChangeUserBalanceHistory(companyId string, comment string, groupId string, createdById string, createdByName string, givenDate string, amount string, paymentType string, studentId string) (*pb.AbsResponse, error) {
+func (r *StudentRepository) ChangeUserBalanceHistory(companyId string, comment string, groupId string, createdById string, createdByName string, givenDate string, amount string, paymentType string, studentId string, eventId string) (*pb.AbsResponse, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
@@ -735,11 +1084,21 @@ func (r *StudentRepository) ChangeUserBalanceHistory(companyId string, comment s
 			panic(p)
 		}
 	}()
+	if applied, err := markBalanceEventProcessed(tx, companyId, eventId); err != nil || applied {
+		tx.Rollback()
+		return balanceEventResponse(err)
+	}
 
-	var currentBalance float64
+	var currentBalance decimal.Decimal
 	var groupName string
 
-	err = tx.QueryRow("SELECT balance FROM students WHERE id = $1 and company_id=$2", studentId, companyId).Scan(&currentBalance)
+	// locked until commit, so the balance taker, merges and other deliveries
+	// for the student cannot change it in between
+	err = tx.QueryRow("SELECT balance FROM students WHERE id = $1 and company_id=$2 FOR UPDATE", studentId, companyId).Scan(&currentBalance)
+	if errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "student %s not found", studentId)
+	}
 	if err != nil {
 		tx.Rollback()
 		return nil, status.Errorf(codes.Internal, "Failed to get current balance: %v", err)
@@ -755,32 +1114,43 @@ func (r *StudentRepository) ChangeUserBalanceHistory(companyId string, comment s
 		}
 	}
 
-	amountValue, err := strconv.ParseFloat(amount, 64)
+	amountValue, err := money.Parse(amount)
 	if err != nil {
 		tx.Rollback()
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid amount: %v", err)
 	}
 
-	var newBalance float64
+	var newBalance decimal.Decimal
 	switch paymentType {
 	case "ADD":
-		newBalance = currentBalance + amountValue
+		newBalance = currentBalance.Add(amountValue)
 	case "TAKE_OFF":
-		newBalance = currentBalance - amountValue
+		newBalance = currentBalance.Sub(amountValue)
 	default:
 		tx.Rollback()
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid payment type: %s", paymentType)
 	}
-	err = r.BalanceHistoryMaker(companyId, tx, currentBalance, newBalance, studentId, comment, groupId, groupName, createdById, createdByName, givenDate, amount, paymentType)
+	if paymentType == "ADD" {
+		err = r.notifier.NotifyStudent(tx, companyId, studentId, notification.EventPaymentReceived, map[string]string{
+			"amount":  money.Format(amountValue),
+			"balance": money.Format(newBalance),
+			"group":   groupName,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	err = r.BalanceHistoryMaker(companyId, tx, currentBalance, newBalance, studentId, comment, groupId, groupName, createdById, createdByName, givenDate, money.Format(amountValue), paymentType)
 	if err != nil {
-		return nil, status.Errorf(codes.Canceled, err.Error())
+		return nil, balanceHistoryError(err)
 	}
 	return &pb.AbsResponse{
 		Status:  http.StatusOK,
 		Message: "balance edited",
 	}, nil
 }
-func (r *StudentRepository) ChangeUserBalanceHistoryByDebit(companyId string, studentId string, oldDebit string, currentDebit string, givenDate string, comment string, paymentType string, createdById string, createdByName string, groupId string) (*pb.AbsResponse, error) {
+func (r *StudentRepository) ChangeUserBalanceHistoryByDebit(companyId string, studentId string, oldDebit string, currentDebit string, givenDate string, comment string, paymentType string, createdById string, createdByName string, groupId string, eventId string) (*pb.AbsResponse, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to begin transaction: %v", err)
@@ -792,18 +1162,26 @@ func (r *StudentRepository) ChangeUserBalanceHistoryByDebit(companyId string, st
 			panic(p)
 		}
 	}()
+	if applied, err := markBalanceEventProcessed(tx, companyId, eventId); err != nil || applied {
+		tx.Rollback()
+		return balanceEventResponse(err)
+	}
 
-	var currentBalance float64
+	var currentBalance decimal.Decimal
 	var groupName string
 
-	err = tx.QueryRow("SELECT balance FROM students WHERE id = $1", studentId).Scan(&currentBalance)
+	err = tx.QueryRow("SELECT balance FROM students WHERE id = $1 and company_id=$2 FOR UPDATE", studentId, companyId).Scan(&currentBalance)
+	if errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return nil, status.Errorf(codes.NotFound, "student %s not found", studentId)
+	}
 	if err != nil {
 		tx.Rollback()
 		return nil, status.Errorf(codes.Internal, "Failed to get current balance: %v", err)
 	}
 
 	if paymentType != "TAKE_OFF" && groupId != "" {
-		err = tx.QueryRow("SELECT name FROM groups WHERE id = $1", groupId).Scan(&groupName)
+		err = tx.QueryRow("SELECT name FROM groups WHERE id = $1 and company_id=$2", groupId, companyId).Scan(&groupName)
 		if errors.Is(err, sql.ErrNoRows) {
 			groupName = ""
 		} else if err != nil {
@@ -812,32 +1190,74 @@ func (r *StudentRepository) ChangeUserBalanceHistoryByDebit(companyId string, st
 		}
 	}
 	oldBalance := currentBalance
-	amountValue, err := strconv.ParseFloat(oldDebit, 64)
-	currentAmountValue, err := strconv.ParseFloat(currentDebit, 64)
+	amountValue, err := money.Parse(oldDebit)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid amount: %v", err)
+	}
+	currentAmountValue, err := money.Parse(currentDebit)
 	if err != nil {
 		tx.Rollback()
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid amount: %v", err)
 	}
 	switch paymentType {
 	case "ADD":
-		currentBalance = (currentBalance - amountValue) + currentAmountValue
+		currentBalance = currentBalance.Sub(amountValue).Add(currentAmountValue)
 	case "TAKE_OFF":
-		currentBalance = (currentBalance + amountValue) - currentAmountValue
+		currentBalance = currentBalance.Add(amountValue).Sub(currentAmountValue)
 	default:
 		tx.Rollback()
 		return nil, status.Errorf(codes.Aborted, "invalid payment type")
 	}
-	err = r.BalanceHistoryMaker(companyId, tx, oldBalance, currentBalance, studentId, comment, groupId, groupName, createdById, createdByName, givenDate, fmt.Sprintf("%.2f", currentAmountValue), paymentType)
+	err = r.BalanceHistoryMaker(companyId, tx, oldBalance, currentBalance, studentId, comment, groupId, groupName, createdById, createdByName, givenDate, money.Format(currentAmountValue), paymentType)
 	if err != nil {
-		return nil, status.Errorf(codes.Canceled, err.Error())
+		return nil, balanceHistoryError(err)
 	}
 	return &pb.AbsResponse{
 		Status:  http.StatusOK,
 		Message: "balance edited",
 	}, nil
 }
-func (r *StudentRepository) BalanceHistoryMaker(companyId string, tx *sql.Tx, currentBalance, newBalance float64, studentId string, comment, groupId, groupName, createdById, createdByName, givenDate, amount, paymentType string) error {
-	result, err := tx.Exec("UPDATE students SET balance = $1 WHERE id = $2", newBalance, studentId)
+
+// markBalanceEventProcessed records the finance outbox event id inside the
+// balance transaction and reports whether the event was applied before, so a
+// redelivered event does not change the balance twice. Calls without an event
+// id are not deduplicated.
+func markBalanceEventProcessed(tx *sql.Tx, companyId, eventId string) (bool, error) {
+	if eventId == "" {
+		return false, nil
+	}
+	result, err := tx.Exec(`INSERT INTO processed_balance_event(event_id, company_id) values ($1, $2) ON CONFLICT (event_id) DO NOTHING`, eventId, companyId)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Failed to record balance event: %v", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Failed to record balance event: %v", err)
+	}
+	return inserted == 0, nil
+}
+
+// balanceHistoryError keeps the status of an error BalanceHistoryMaker
+// returned, like NotFound, and reports any other error as Canceled.
+func balanceHistoryError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Canceled, err.Error())
+}
+
+func balanceEventResponse(err error) (*pb.AbsResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "balance event already applied"}, nil
+}
+
+// BalanceHistoryMaker writes newBalance and its history entry. The caller read
+// currentBalance with FOR UPDATE in tx, so no other change of it is lost.
+func (r *StudentRepository) BalanceHistoryMaker(companyId string, tx *sql.Tx, currentBalance, newBalance decimal.Decimal, studentId string, comment, groupId, groupName, createdById, createdByName, givenDate, amount, paymentType string) error {
+	result, err := tx.Exec("UPDATE students SET balance = $1 WHERE id = $2 and company_id=$3", newBalance, studentId, companyId)
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -851,7 +1271,7 @@ func (r *StudentRepository) BalanceHistoryMaker(companyId string, tx *sql.Tx, cu
 
 	if rowsAffected == 0 {
 		tx.Rollback()
-		return err
+		return status.Errorf(codes.NotFound, "student %s not found", studentId)
 	}
 	if groupId != "" && groupName == "" {
 		groupName = "Group"
@@ -873,6 +1293,20 @@ func (r *StudentRepository) BalanceHistoryMaker(companyId string, tx *sql.Tx, cu
 		return err
 	}
 
+	if !currentBalance.IsNegative() && newBalance.IsNegative() {
+		err = r.notifier.NotifyStudent(tx, companyId, studentId, notification.EventBalanceNegative, map[string]string{"balance": money.Format(newBalance)})
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if !newBalance.IsNegative() {
+		if err = clearDebtReminders(tx, studentId, newBalance); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
 	field := "balance_add"
 	if paymentType == "TAKE_OFF" {
 		field = "balance_take_off"
@@ -880,7 +1314,7 @@ func (r *StudentRepository) BalanceHistoryMaker(companyId string, tx *sql.Tx, cu
 	_, err = tx.Exec(`
         INSERT INTO student_history (id, student_id, field, old_value, current_value, created_at , company_id)
         VALUES (gen_random_uuid(), $1, $2, $3, $4, $5 , $6)
-    `, studentId, field, currentBalance, historyJSON, time.Now(), companyId)
+    `, studentId, field, money.Format(currentBalance), historyJSON, time.Now(), companyId)
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -906,9 +1340,16 @@ func (r *StudentRepository) checkArgumentsIsActive(companyId string, groupId, st
 	return checker
 }
 func (r *StudentRepository) StudentBalanceTaker() {
+	start := time.Now()
+	outcome := "completed"
+	defer func() {
+		metrics.BillingRuns.WithLabelValues(outcome).Inc()
+		metrics.BillingRunDuration.Observe(time.Since(start).Seconds())
+	}()
 	rows, err := r.db.Query(`SELECT id FROM company WHERE valid_date > CURRENT_DATE`)
 	if err != nil {
-		fmt.Println("error while getting companies:", err)
+		slog.Error("balance taker failed to load companies", "error", err)
+		outcome = "failed"
 		return
 	}
 
@@ -916,15 +1357,14 @@ func (r *StudentRepository) StudentBalanceTaker() {
 		var companyId string
 		err := rows.Scan(&companyId)
 		if err != nil {
-			fmt.Println("erorr while scanning companyid")
+			slog.Error("balance taker failed to read company", "error", err)
 			continue
 		}
-		if err := r.ensureFinanceClient(); err != nil {
-			return
-		}
-		rows, err := r.db.Query(`SELECT id FROM students where condition='ACTIVE' and company_id = $1 `, companyId)
+		logger := slog.With("company_id", companyId)
+		rows, err := r.db.Query(`SELECT id, coalesce(balance, 0) FROM students where condition='ACTIVE' and company_id = $1 `, companyId)
 		if err != nil {
-			fmt.Printf("error get active student %v", err)
+			logger.Error("balance taker failed to load active students", "error", err)
+			outcome = "failed"
 			return
 		}
 		defer rows.Close()
@@ -932,60 +1372,106 @@ func (r *StudentRepository) StudentBalanceTaker() {
 		defer cancelFunc()
 		for rows.Next() {
 			var studentId string
-			err = rows.Scan(&studentId)
+			var balance decimal.Decimal
+			err = rows.Scan(&studentId, &balance)
 			if err != nil {
-				fmt.Printf("error scanning active student %v", err)
+				logger.Error("balance taker failed to read student", "error", err)
 				continue
 			}
 			extraRow, err := r.db.Query(`SELECT group_id FROM group_students where student_id=$1 and condition='ACTIVE' and company_id= $2`, studentId, companyId)
-			fmt.Println(studentId)
+			logger.Debug("charging student", "student_id", studentId)
 			if err != nil {
-				fmt.Printf("error getting  active groupid %v", err)
+				logger.Error("balance taker failed to load student groups", "student_id", studentId, "error", err)
 				continue
 			}
 			for extraRow.Next() {
 				var (
 					groupId     string
-					takingPrice float64
+					takingPrice decimal.Decimal
 					comment     string
 				)
 				err = extraRow.Scan(&groupId)
 				if err != nil {
-					fmt.Printf("error scanning groupid student %v", err)
+					logger.Error("balance taker failed to read student group", "student_id", studentId, "error", err)
 					continue
 				}
-				discountAmount, _ := r.financeClient.GetDiscountByStudentId(ctx, studentId, groupId)
-				err = r.db.QueryRow(`SELECT c.price FROM groups g join courses c on g.course_id=c.id where g.id=$1 and c.company_id=$2`, groupId, companyId).Scan(&takingPrice)
+				discountReq, err := discountRequest(r.db, companyId, studentId, groupId, time.Now())
 				if err != nil {
-					fmt.Printf("error getting course price active student %v", err)
+					logger.Error("balance taker failed to load course price", "student_id", studentId, "group_id", groupId, "error", err)
 					continue
 				}
-				if discountAmount == nil {
+				// the charge is what rule applications are recorded for, an
+				// early-payment rule holds when the month is already covered
+				discountReq.Apply = true
+				takingPrice, err = money.Parse(discountReq.CoursePrice)
+				if err != nil {
+					logger.Error("balance taker failed to read course price", "student_id", studentId, "group_id", groupId, "error", err)
+					continue
+				}
+				discountReq.Prepaid = !balance.LessThan(takingPrice)
+				discount, err := r.financeClient.GetDiscountByStudentId(ctx, discountReq)
+				if err != nil {
+					// without the discount the price is not known, the month
+					// is not charged rather than charged in full
+					logger.Error("balance taker failed to get discount", "student_id", studentId, "group_id", groupId, "error", err)
+					metrics.BillingCharges.WithLabelValues("failed").Inc()
+					continue
+				}
+				var applied []*pb.AppliedDiscount
+				if discount == nil {
 					comment = "ushbu oy uchun oylik tolov student balansidan yechib olindi."
 				} else {
-					takingPrice = takingPrice - *discountAmount
+					takingPrice = takingPrice.Sub(discount.Amount)
 					comment = "ushbu oy uchun oylik tolov student balansidan yechib olindi chegirma narxida"
+					applied = discount.Applied
 				}
 				//_, err := r.ChangeUserBalanceHistory("ushbu oy uchun oylik tolov student balansidan yechib olindi.", groupId, "00000000-0000-0000-0000-000000000000", "TIZIM", time.Now().Format("2006-01-02"), takingPrice, "TAKE_OFF", studentId)
-				_, err :=
-					r.financeClient.PaymentAdd(ctx, comment, time.Now().Format("2006-01-02"), "CASH", fmt.Sprintf("%.2f", takingPrice), studentId, "TAKE_OFF", "00000000-0000-0000-0000-000000000000", "TIZIM", groupId, time.Now().AddDate(0, 0, -1).String())
+				_, err =
+					r.financeClient.PaymentAdd(ctx, comment, time.Now().Format("2006-01-02"), "CASH", money.Format(takingPrice), studentId, "TAKE_OFF", "00000000-0000-0000-0000-000000000000", "TIZIM", groupId, time.Now().AddDate(0, 0, -1).String(), true, applied)
 				if err != nil {
-					fmt.Printf("error changing balance history active student %v", err)
+					logger.Error("balance taker failed to charge student", "student_id", studentId, "group_id", groupId, "error", err)
+					metrics.BillingCharges.WithLabelValues("failed").Inc()
 					continue
 				}
+				metrics.BillingCharges.WithLabelValues("charged").Inc()
 			}
 			extraRow.Close()
 		}
 	}
 }
 
-func (r *StudentRepository) CalculateDiscountSumma(companyId string, groupId string, startDate string, endDate string, discountPrice string, studentId string, paymentDate, activationDate string) (*pb.CalculateDiscountResponse, error) {
+// discountRequest gathers what a company's discount rules are matched
+// against for a student in a group: the course price, the student's active
+// groups and the other active students sharing their parent's contact.
+func discountRequest(q queryRower, companyId, studentId, groupId string, date time.Time) (*pb.GetDiscountByStudentIdRequest, error) {
+	req := &pb.GetDiscountByStudentIdRequest{StudentId: studentId, GroupId: groupId, Date: date.Format(time.DateOnly)}
+	err := q.QueryRow(`SELECT c.price FROM groups g JOIN courses c ON g.course_id = c.id WHERE g.id = $1 AND g.company_id = $2`,
+		groupId, companyId).Scan(&req.CoursePrice)
+	if err != nil {
+		return nil, err
+	}
+	err = q.QueryRow(`SELECT (SELECT count(*) FROM group_students WHERE student_id = $1 AND condition = 'ACTIVE' AND company_id = $2),
+       (SELECT count(*)
+        FROM students s
+                 JOIN students o ON o.company_id = s.company_id AND o.id <> s.id AND o.condition = 'ACTIVE'
+        WHERE s.id = $1
+          AND s.company_id = $2
+          AND regexp_replace(coalesce(s.additional_contact, ''), '\D', '', 'g') <> ''
+          AND regexp_replace(o.additional_contact, '\D', '', 'g') = regexp_replace(s.additional_contact, '\D', '', 'g'))`,
+		studentId, companyId).Scan(&req.ActiveGroups, &req.Siblings)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (r *StudentRepository) CalculateDiscountSumma(companyId string, groupId string, startDate string, endDate string, discountPrice, discountType string, studentId string, paymentDate, activationDate string) (*pb.CalculateDiscountResponse, error) {
 	groupIDInt, err := strconv.ParseInt(groupId, 10, 64)
 	if err != nil {
 		return nil, fmt.Errorf("invalid group ID: %v", err)
 	}
 
-	discountPriceFloat, err := strconv.ParseFloat(discountPrice, 64)
+	discountValue, err := money.Parse(discountPrice)
 	if err != nil {
 		return nil, fmt.Errorf("invalid discount price: %v", err)
 	}
@@ -993,17 +1479,22 @@ func (r *StudentRepository) CalculateDiscountSumma(companyId string, groupId str
 	var group struct {
 		EndDate string
 		Days    []string
+		Price   decimal.Decimal
 	}
 
 	err = r.db.QueryRow(`
-        SELECT end_date, days 
-        FROM groups 
-        WHERE id = $1 AND company_id = $2
-    `, groupIDInt, companyId).Scan(&group.EndDate, pq.Array(&group.Days))
+        SELECT g.end_date, g.days, c.price
+        FROM groups g
+        JOIN courses c ON c.id = g.course_id
+        WHERE g.id = $1 AND g.company_id = $2
+    `, groupIDInt, companyId).Scan(&group.EndDate, pq.Array(&group.Days), &group.Price)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get group info: %v", err)
 	}
+	if discountType == "PERCENT" {
+		discountValue = group.Price.Mul(discountValue).Div(decimal.NewFromInt(100))
+	}
 	paymentDateTime, err := parseDate(paymentDate)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse payment date: %v", err)
@@ -1051,10 +1542,10 @@ func (r *StudentRepository) CalculateDiscountSumma(companyId string, groupId str
 		return nil, fmt.Errorf("failed to count remaining lessons: %v", err)
 	}
 
-	finalDiscountAmount := discountPriceFloat * float64(remainingLessons) / float64(totalMonthLessons)
+	finalDiscountAmount := money.Round(money.Prorate(discountValue, remainingLessons, totalMonthLessons))
 
 	return &pb.CalculateDiscountResponse{
-		CalculatedPrice: cast.ToString(finalDiscountAmount),
+		CalculatedPrice: money.Format(finalDiscountAmount),
 	}, nil
 }
 func parseDate(input string) (time.Time, error) {
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/internal/subscription"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"time"
+)
+
+type SubscriptionRepository struct {
+	db        *sql.DB
+	graceDays int
+}
+
+// NewSubscriptionRepository reads company subscriptions. For graceDays after
+// valid_date a company is read-only, after that it is locked out.
+func NewSubscriptionRepository(db *sql.DB, graceDays int) *SubscriptionRepository {
+	return &SubscriptionRepository{db: db, graceDays: graceDays}
+}
+
+func (r *SubscriptionRepository) GetSubscription(companyId string) (*pb.CompanySubscription, error) {
+	var result pb.CompanySubscription
+	var validDate sql.NullTime
+	var studentLimit sql.NullInt32
+	err := r.db.QueryRow(`SELECT c.id, c.valid_date, c.is_demo, t.id, t.name, t.student_count,
+       (SELECT count(*) FROM students s WHERE s.condition = 'ACTIVE' AND s.company_id = c.id)
+FROM company c
+         JOIN tariff t ON t.id = c.tariff_id
+WHERE c.id = $1`, companyId).
+		Scan(&result.CompanyId, &validDate, &result.IsDemo, &result.TariffId, &result.TariffName, &studentLimit, &result.ActiveStudents)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "company %s not found", companyId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.StudentLimit = studentLimit.Int32
+	r.fillState(&result, validDate, time.Now())
+	return &result, nil
+}
+
+// fillState works the state out from valid_date, which is the last day the
+// company has paid for. A company without one is treated as expired.
+func (r *SubscriptionRepository) fillState(result *pb.CompanySubscription, validDate sql.NullTime, now time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if !validDate.Valid {
+		result.State = subscription.StateLocked
+		return
+	}
+	valid := time.Date(validDate.Time.Year(), validDate.Time.Month(), validDate.Time.Day(), 0, 0, 0, 0, time.UTC)
+	graceUntil := valid.AddDate(0, 0, r.graceDays)
+	result.ValidDate = valid.Format(time.DateOnly)
+	result.GraceUntil = graceUntil.Format(time.DateOnly)
+	result.DaysLeft = int32(valid.Sub(today).Hours() / 24)
+	switch {
+	case !today.After(valid):
+		result.State = subscription.StateActive
+	case !today.After(graceUntil):
+		result.State = subscription.StateGrace
+	default:
+		result.State = subscription.StateLocked
+	}
+}
+
+type queryRower interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// checkStudentLimit fails with FailedPrecondition when adding students would
+// take the company past the student count of its tariff. Called inside a
+// transaction it locks the company row, so concurrent creates are counted one
+// after another. A tariff without a student count is unlimited.
+func checkStudentLimit(q queryRower, companyId string, adding int) error {
+	id, err := strconv.Atoi(companyId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid company id")
+	}
+	var limit sql.NullInt32
+	var tariffName string
+	err = q.QueryRow(`SELECT t.student_count, t.name FROM company c JOIN tariff t ON t.id = c.tariff_id WHERE c.id = $1 FOR UPDATE OF c`, id).Scan(&limit, &tariffName)
+	if err != nil {
+		return fmt.Errorf("failed to read tariff of company %d: %w", id, err)
+	}
+	if !limit.Valid || limit.Int32 <= 0 {
+		return nil
+	}
+	var active int
+	err = q.QueryRow(`SELECT count(*) FROM students WHERE condition = 'ACTIVE' AND company_id = $1`, id).Scan(&active)
+	if err != nil {
+		return err
+	}
+	if active+adding > int(limit.Int32) {
+		return status.Errorf(codes.FailedPrecondition, "tariff %s allows %d active students, the company has %d; archive students or upgrade the tariff", tariffName, limit.Int32, active)
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"education-service/proto/pb"
 	"encoding/json"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"time"
 )
@@ -57,8 +59,53 @@ func (r TariffRepository) Get(ctx context.Context, req *emptypb.Empty) (*pb.Tari
 		tariff.CreatedAt = createdAt.Format(time.RFC3339)
 		tariffs = append(tariffs, &tariff)
 	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if err = r.loadPrepayDiscounts(ctx, tariffs); err != nil {
+		return nil, err
+	}
+	return &pb.TariffList{Items: tariffs}, nil
+}
 
-	return &pb.TariffList{Items: tariffs}, rows.Err()
+func (r TariffRepository) loadPrepayDiscounts(ctx context.Context, tariffs []*pb.Tariff) error {
+	byId := make(map[int32]*pb.Tariff, len(tariffs))
+	for _, tariff := range tariffs {
+		byId[tariff.Id] = tariff
+	}
+	rows, err := r.db.QueryContext(ctx, `SELECT tariff_id, months, percent FROM tariff_prepay_discount ORDER BY tariff_id, months`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tariffId int32
+		var discount pb.PrepayDiscount
+		if err = rows.Scan(&tariffId, &discount.Months, &discount.Percent); err != nil {
+			return err
+		}
+		if tariff, ok := byId[tariffId]; ok {
+			tariff.PrepayDiscounts = append(tariff.PrepayDiscounts, &discount)
+		}
+	}
+	return rows.Err()
+}
+
+// savePrepayDiscounts replaces the prepay discounts of a tariff.
+func savePrepayDiscounts(ctx context.Context, tx *sql.Tx, tariffId int32, discounts []*pb.PrepayDiscount) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM tariff_prepay_discount WHERE tariff_id = $1`, tariffId); err != nil {
+		return err
+	}
+	for _, discount := range discounts {
+		if discount.Months < 2 || discount.Percent <= 0 || discount.Percent >= 100 {
+			return status.Errorf(codes.InvalidArgument, "prepay discount needs at least 2 months and a percent between 0 and 100, got %d months %.2f%%", discount.Months, discount.Percent)
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO tariff_prepay_discount(tariff_id, months, percent) VALUES ($1, $2, $3)`, tariffId, discount.Months, discount.Percent)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r TariffRepository) Create(ctx context.Context, req *pb.Tariff) (*pb.Tariff, error) {
@@ -72,8 +119,13 @@ func (r TariffRepository) Create(ctx context.Context, req *pb.Tariff) (*pb.Tarif
 		return nil, err
 	}
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 	var createdAt time.Time
-	err = r.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		req.Name,
 		req.StudentCount,
 		req.Sum,
@@ -83,6 +135,12 @@ func (r TariffRepository) Create(ctx context.Context, req *pb.Tariff) (*pb.Tarif
 	if err != nil {
 		return nil, err
 	}
+	if err = savePrepayDiscounts(ctx, tx, req.Id, req.PrepayDiscounts); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	req.CreatedAt = createdAt.Format(time.RFC3339)
 	return req, nil
@@ -103,8 +161,13 @@ func (r TariffRepository) Update(ctx context.Context, req *pb.Tariff) (*pb.Tarif
 		return nil, err
 	}
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 	var createdAt time.Time
-	err = r.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		req.Name,
 		req.StudentCount,
 		req.Sum,
@@ -115,6 +178,12 @@ func (r TariffRepository) Update(ctx context.Context, req *pb.Tariff) (*pb.Tarif
 	if err != nil {
 		return nil, err
 	}
+	if err = savePrepayDiscounts(ctx, tx, req.Id, req.PrepayDiscounts); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	req.CreatedAt = createdAt.Format(time.RFC3339)
 	return req, nil
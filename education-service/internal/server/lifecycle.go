@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"education-service/internal/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"log/slog"
+	"net"
+	"time"
+)
+
+const (
+	// shutdownTimeout bounds how long in-flight calls may run after a stop
+	// signal; whatever is still running afterwards is cut off.
+	shutdownTimeout     = 30 * time.Second
+	databaseCheckPeriod = 10 * time.Second
+)
+
+// registerHealth adds the standard gRPC health service. The service reports
+// SERVING while the database answers and NOT_SERVING otherwise, so probes and
+// clients route around an instance that lost its database.
+func registerHealth(ctx context.Context, grpcServer *grpc.Server, db *sql.DB) *health.Server {
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go func() {
+		ticker := time.NewTicker(databaseCheckPeriod)
+		defer ticker.Stop()
+		for {
+			pingCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+			err := db.PingContext(pingCtx)
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				slog.Error("database check failed", "error", err)
+				healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+			} else {
+				healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return healthServer
+}
+
+// serve runs grpcServer until ctx is cancelled by a stop signal. The health
+// status turns NOT_SERVING first so no new traffic is routed here, then the
+// running calls are given shutdownTimeout to finish.
+func serve(ctx context.Context, grpcServer *grpc.Server, healthServer *health.Server, lis net.Listener) {
+	served := make(chan error, 1)
+	go func() {
+		served <- grpcServer.Serve(lis)
+	}()
+	select {
+	case err := <-served:
+		if err != nil {
+			logging.Fatal("failed to serve", err)
+		}
+		return
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down, waiting for running calls")
+	healthServer.Shutdown()
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		slog.Warn("running calls did not finish in time, stopping")
+		grpcServer.Stop()
+	}
+}
@@ -1,20 +1,30 @@
 package server
 
 import (
+	"context"
 	"education-service/config"
 	"education-service/internal/clients"
+	"education-service/internal/logging"
+	"education-service/internal/metrics"
+	"education-service/internal/notification"
 	"education-service/internal/repository"
 	"education-service/internal/service"
+	"education-service/internal/subscription"
+	"education-service/internal/tracing"
 	"education-service/internal/utils"
 	"education-service/migrations"
 	"education-service/proto/pb"
-	"fmt"
 	_ "github.com/lib/pq"
 	"github.com/robfig/cron/v3"
 	"google.golang.org/grpc"
 	"log"
+	"log/slog"
 	"net"
+	"os"
+	"os/signal"
 	"strconv"
+	"sync"
+	"syscall"
 	"time"
 )
 
@@ -23,54 +33,42 @@ func RunServer() {
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	if err = logging.Init("education-service", cfg.Log.Level); err != nil {
+		log.Fatalf("Failed to set up logging: %v", err)
+	}
+	slog.Info("loaded config", "config", cfg.String())
 	db, err := repository.NewPostgresDB(&cfg.Database)
 	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+		logging.Fatal("failed to initialize database", err)
 	}
 	defer db.Close()
 	migrations.SetUpMigrating(cfg.Database.Action, db)
 
-	userClient, err := clients.NewUserClient(cfg.Grpc.UserService.Address)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	flushTraces, err := tracing.Init(ctx, "education-service", cfg.Tracing.Exporter, cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
 	if err != nil {
-		log.Fatalf("error %v", err)
+		logging.Fatal("failed to set up tracing", err)
 	}
+	defer flushTraces()
+	metrics.RegisterDB(db, "education")
+	go metrics.Serve(ctx, cfg.Metrics.Port)
+	var workers sync.WaitGroup
 
-	financeClientChanForAttendance := make(chan *clients.FinanceClient)
-	financeClientChanForStudent := make(chan *clients.FinanceClient)
+	userClient, err := clients.NewUserClient(cfg.Grpc.UserService.Address)
+	if err != nil {
+		logging.Fatal("failed to create user client", err)
+	}
 
-	go func() {
-		time.Sleep(2 * time.Second)
-		var client *clients.FinanceClient
-		for {
-			fmt.Println(cfg.Grpc.FinanceService.Address)
-			client, err = clients.NewFinanceClient(cfg.Grpc.FinanceService.Address)
-			if err == nil {
-				log.Println("Connected to Finance Service successfully.")
-				financeClientChanForAttendance <- client
-				close(financeClientChanForAttendance)
-				break
-			}
-			log.Printf("Waiting for Finance Service...")
-			time.Sleep(2 * time.Second)
-		}
-	}()
+	financeClient, err := clients.NewFinanceClient(cfg.Grpc.FinanceService.Address)
+	if err != nil {
+		logging.Fatal("failed to create finance client", err)
+	}
 
-	go func() {
-		time.Sleep(2 * time.Second)
-		var client *clients.FinanceClient
-		for {
-			fmt.Println(cfg.Grpc.FinanceService.Address)
-			client, err = clients.NewFinanceClient(cfg.Grpc.FinanceService.Address)
-			if err == nil {
-				log.Println("Connected to Finance Service successfully.")
-				financeClientChanForStudent <- client
-				close(financeClientChanForStudent)
-				break
-			}
-			log.Printf("Waiting for Finance Service...")
-			time.Sleep(2 * time.Second)
-		}
-	}()
+	leadClient, err := clients.NewLeadClient(cfg.Grpc.LeadService.Address)
+	if err != nil {
+		logging.Fatal("failed to create lead client", err)
+	}
 
 	roomRepo := repository.NewRoomRepository(db)
 	roomService := service.NewRoomService(roomRepo)
@@ -78,50 +76,130 @@ func RunServer() {
 	courseService := service.NewCourseService(courseRepo)
 	groupRepo := repository.NewGroupRepository(db, userClient)
 	groupService := service.NewGroupService(groupRepo)
-	attendanceRepo := repository.NewAttendanceRepository(db, financeClientChanForAttendance)
+	attendanceRepo := repository.NewAttendanceRepository(db, financeClient)
 	attendanceService := service.NewAttendanceService(attendanceRepo)
-	studentRepo := repository.NewStudentRepository(db, userClient, financeClientChanForStudent)
+	notifier := notification.NewNotifier(db, notificationChannels(cfg.Notification))
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		notifier.Run(ctx, notificationPollInterval(cfg.Notification))
+	}()
+	studentRepo := repository.NewStudentRepository(db, userClient, financeClient, notifier)
 	studentService := service.NewStudentService(studentRepo)
 	companyRepo := repository.NewCompanyRepository(db, userClient)
-	companyService := service.NewCompanyService(companyRepo)
+	subscriptionRepo := repository.NewSubscriptionRepository(db, cfg.Subscription.GraceDays)
+	companyService := service.NewCompanyService(companyRepo, subscriptionRepo)
+	enforcer := subscription.NewEnforcer(func(ctx context.Context, companyId string) (*pb.CompanySubscription, error) {
+		return subscriptionRepo.GetSubscription(companyId)
+	})
+	signupRepo := repository.NewSignupRepository(db, userClient, financeClient, leadClient, cfg.Signup.DemoTariffId, cfg.Signup.DemoDays)
+	signupService := service.NewSignupService(signupRepo)
 	tarrifRepo := repository.NewTariffRepository(db)
 	tarrifService := service.NewTariffService(tarrifRepo)
 	companyFinanceRepo := repository.NewCompanyFinanceRepository(db)
 	companyFinanceService := service.NewCompanyFinanceService(companyFinanceRepo)
+	billingRepo := repository.NewBillingRepository(db)
+	billingService := service.NewBillingService(billingRepo)
+	analyticsRepo := repository.NewAnalyticsRepository(db, subscriptionRepo, userClient, leadClient, financeClient)
+	analyticsService := service.NewAnalyticsService(analyticsRepo)
+	notificationRepo := repository.NewNotificationRepository(db)
+	notificationService := service.NewNotificationService(notificationRepo)
+	debtReminderRepo := repository.NewDebtReminderRepository(db, notifier)
+	debtReminderService := service.NewDebtReminderService(debtReminderRepo)
+	reconciliationRepo := repository.NewReconciliationRepository(db, financeClient)
+	reconciliationService := service.NewReconciliationService(reconciliationRepo)
 	lis, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.Server.Port))
 	if err != nil {
-		log.Fatalf("Failed to listen on port %v: %v", cfg.Server.Port, err)
+		logging.Fatal("failed to listen", err)
 	}
 
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(utils.RecoveryInterceptor),
+		grpc.StatsHandler(tracing.ServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor, logging.UnaryServerInterceptor, utils.RecoveryInterceptor, enforcer.UnaryServerInterceptor),
 	)
+	healthServer := registerHealth(ctx, grpcServer, db)
 	pb.RegisterRoomServiceServer(grpcServer, roomService)
 	pb.RegisterCourseServiceServer(grpcServer, courseService)
 	pb.RegisterGroupServiceServer(grpcServer, groupService)
 	pb.RegisterAttendanceServiceServer(grpcServer, attendanceService)
 	pb.RegisterStudentServiceServer(grpcServer, studentService)
 	pb.RegisterCompanyServiceServer(grpcServer, companyService)
+	pb.RegisterSignupServiceServer(grpcServer, signupService)
 	pb.RegisterTariffServiceServer(grpcServer, tarrifService)
 	pb.RegisterCompanyFinanceServiceServer(grpcServer, companyFinanceService)
+	pb.RegisterBillingServiceServer(grpcServer, billingService)
+	pb.RegisterAnalyticsServiceServer(grpcServer, analyticsService)
+	pb.RegisterNotificationServiceServer(grpcServer, notificationService)
+	pb.RegisterDebtReminderServiceServer(grpcServer, debtReminderService)
+	pb.RegisterReconciliationServiceServer(grpcServer, reconciliationService)
 	c := cron.New()
 	_, err = c.AddFunc("10 1 1 * *", func() {
-		fmt.Println("Running student balance taker ....")
+		slog.Info("running student balance taker")
 		studentRepo.StudentBalanceTaker()
-		fmt.Println("Completed student balance taker")
+		slog.Info("completed student balance taker")
+	})
+	if err != nil {
+		logging.Fatal("failed to schedule cron job", err)
+	}
+	_, err = c.AddFunc("0 10 * * *", func() {
+		slog.Info("running debt reminders")
+		debtReminderRepo.RunDebtReminders()
+		slog.Info("completed debt reminders")
+	})
+	if err != nil {
+		logging.Fatal("failed to schedule cron job", err)
+	}
+	_, err = c.AddFunc("30 3 * * *", func() {
+		slog.Info("running balance reconciliation")
+		reconciliationRepo.RunScheduledReconciliation()
+		slog.Info("completed balance reconciliation")
+	})
+	if err != nil {
+		logging.Fatal("failed to schedule cron job", err)
+	}
+	_, err = c.AddFunc("*/10 * * * *", func() {
+		studentRepo.RetryPendingMerges()
+	})
+	if err != nil {
+		logging.Fatal("failed to schedule cron job", err)
+	}
+	_, err = c.AddFunc("0 2 * * *", func() {
+		slog.Info("running analytics snapshot")
+		analyticsRepo.RunSnapshot()
+		slog.Info("completed analytics snapshot")
 	})
-
 	if err != nil {
-		log.Fatalf("Failed to schedule cron job: %v", err)
+		logging.Fatal("failed to schedule cron job", err)
 	}
 	c.Start()
 
-	go func() {
-		select {}
-	}()
+	slog.Info("server listening", "port", cfg.Server.Port)
+	serve(ctx, grpcServer, healthServer, lis)
+
+	// jobs already running, the monthly balance taker above all, are let finish
+	slog.Info("waiting for running jobs")
+	<-c.Stop().Done()
+	workers.Wait()
+	slog.Info("server stopped")
+}
+
+func notificationChannels(cfg config.NotificationConfig) map[string]notification.Channel {
+	channels := map[string]notification.Channel{
+		notification.ChannelSMS:      notification.LogChannel{Kind: notification.ChannelSMS},
+		notification.ChannelTelegram: notification.LogChannel{Kind: notification.ChannelTelegram},
+	}
+	if cfg.Sms.Url != "" {
+		channels[notification.ChannelSMS] = notification.NewSMSChannel(cfg.Sms.Url, cfg.Sms.Token, cfg.Sms.From)
+	}
+	if cfg.Telegram.BotToken != "" {
+		channels[notification.ChannelTelegram] = notification.NewTelegramChannel(cfg.Telegram.BotToken)
+	}
+	return channels
+}
 
-	log.Printf("Server listening on port %v", cfg.Server.Port)
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+func notificationPollInterval(cfg config.NotificationConfig) time.Duration {
+	if cfg.PollIntervalSeconds <= 0 {
+		return 10 * time.Second
 	}
+	return time.Duration(cfg.PollIntervalSeconds) * time.Second
 }
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/proto/pb"
+)
+
+type AnalyticsService struct {
+	pb.UnimplementedAnalyticsServiceServer
+	analyticsRepo *repository.AnalyticsRepository
+}
+
+func NewAnalyticsService(repo *repository.AnalyticsRepository) *AnalyticsService {
+	return &AnalyticsService{
+		analyticsRepo: repo,
+	}
+}
+
+func (a *AnalyticsService) GetPlatformMetrics(ctx context.Context, req *pb.PlatformMetricsRequest) (*pb.PlatformMetrics, error) {
+	return a.analyticsRepo.GetPlatformMetrics(req)
+}
+
+func (a *AnalyticsService) GetTenantHealth(ctx context.Context, req *pb.TenantHealthRequest) (*pb.TenantHealthList, error) {
+	return a.analyticsRepo.GetTenantHealth(ctx, req)
+}
+
+func (a *AnalyticsService) GetPlatformHistory(ctx context.Context, req *pb.HistoryRequest) (*pb.PlatformHistory, error) {
+	return a.analyticsRepo.GetPlatformHistory(req)
+}
+
+func (a *AnalyticsService) GetTenantHistory(ctx context.Context, req *pb.HistoryRequest) (*pb.TenantHealthList, error) {
+	return a.analyticsRepo.GetTenantHistory(req)
+}
@@ -2,11 +2,13 @@ package service
 
 import (
 	"context"
+	"education-service/internal/money"
 	"education-service/internal/repository"
 	"education-service/internal/utils"
 	"education-service/proto/pb"
 	"errors"
 	"fmt"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"time"
@@ -158,27 +160,27 @@ func (s *AttendanceService) CalculateTeacherSalaryByAttendance(ctx context.Conte
 
 		for studentId, attendances := range attendancesMap {
 			var passedLessonCount int32
-			var totalSalary float32
+			var totalSalary decimal.Decimal
 			var studentName string
 			var priceType string
-			var totalCount float64
-			var coursePrice float64
+			var totalCount decimal.Decimal
+			var coursePrice decimal.Decimal
 			for _, attendance := range attendances {
 				passedLessonCount++
-				totalSalary += attendance.Price
+				totalSalary = totalSalary.Add(attendance.Price)
 				studentName = attendance.StudentName
 				priceType = attendance.PriceType
 				totalCount = attendance.TotalCount
 				coursePrice = attendance.CoursePrice
 			}
 			absCalculate.Salaries = append(absCalculate.Salaries, &pb.StudentSalary{
-				StudentId:                studentId,
-				StudentName:              studentName,
-				PassedLessonCount:        passedLessonCount,
-				CalculatedSalaryInPeriod: int32(totalSalary),
-				PriceType:                priceType,
-				TotalCount:               totalCount,
-				CoursePrice:              coursePrice,
+				StudentId:         studentId,
+				StudentName:       studentName,
+				PassedLessonCount: passedLessonCount,
+				CalculatedSalary:  money.Format(totalSalary),
+				PriceType:         priceType,
+				TotalCount:        money.Format(totalCount),
+				CoursePrice:       money.Format(coursePrice),
 			})
 		}
 
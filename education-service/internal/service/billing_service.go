@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type BillingService struct {
+	pb.UnimplementedBillingServiceServer
+	billingRepo *repository.BillingRepository
+}
+
+func NewBillingService(repo *repository.BillingRepository) *BillingService {
+	return &BillingService{
+		billingRepo: repo,
+	}
+}
+
+// companyOf is the company a request is for: the one given by the platform
+// owner, otherwise the caller's own.
+func companyOf(ctx context.Context, companyId string) (string, error) {
+	if companyId != "" {
+		return companyId, nil
+	}
+	companyId = utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return "", status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return companyId, nil
+}
+
+func (b *BillingService) Quote(ctx context.Context, req *pb.QuoteRequest) (*pb.TariffQuote, error) {
+	companyId, err := companyOf(ctx, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	req.CompanyId = companyId
+	return b.billingRepo.Quote(req)
+}
+
+func (b *BillingService) CreateInvoice(ctx context.Context, req *pb.QuoteRequest) (*pb.Invoice, error) {
+	companyId, err := companyOf(ctx, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	req.CompanyId = companyId
+	return b.billingRepo.CreateInvoice(req)
+}
+
+func (b *BillingService) GetInvoices(ctx context.Context, req *pb.GetInvoicesRequest) (*pb.InvoiceList, error) {
+	companyId, err := companyOf(ctx, req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	req.CompanyId = companyId
+	return b.billingRepo.GetInvoices(req)
+}
+
+func (b *BillingService) VoidInvoice(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
+	return b.billingRepo.VoidInvoice(req.Id)
+}
+
+func (b *BillingService) CreatePromoCode(ctx context.Context, req *pb.PromoCode) (*pb.PromoCode, error) {
+	return b.billingRepo.CreatePromoCode(req)
+}
+
+func (b *BillingService) GetPromoCodes(ctx context.Context, req *emptypb.Empty) (*pb.PromoCodeList, error) {
+	return b.billingRepo.GetPromoCodes()
+}
+
+func (b *BillingService) DeactivatePromoCode(ctx context.Context, req *pb.PromoCode) (*pb.AbsResponse, error) {
+	return b.billingRepo.DeactivatePromoCode(req.Code)
+}
+
+func (b *BillingService) SetPriceOverride(ctx context.Context, req *pb.PriceOverride) (*pb.PriceOverride, error) {
+	return b.billingRepo.SetPriceOverride(req)
+}
+
+func (b *BillingService) GetPriceOverride(ctx context.Context, req *pb.GetPriceOverrideRequest) (*pb.PriceOverride, error) {
+	return b.billingRepo.GetPriceOverride(req.CompanyId)
+}
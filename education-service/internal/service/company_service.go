@@ -3,17 +3,22 @@ package service
 import (
 	"context"
 	"education-service/internal/repository"
+	"education-service/internal/utils"
 	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CompanyService struct {
 	pb.UnimplementedCompanyServiceServer
-	companyRepo *repository.CompanyRepository
+	companyRepo      *repository.CompanyRepository
+	subscriptionRepo *repository.SubscriptionRepository
 }
 
-func NewCompanyService(repo *repository.CompanyRepository) *CompanyService {
+func NewCompanyService(repo *repository.CompanyRepository, subscriptionRepo *repository.SubscriptionRepository) *CompanyService {
 	return &CompanyService{
-		companyRepo: repo,
+		companyRepo:      repo,
+		subscriptionRepo: subscriptionRepo,
 	}
 }
 
@@ -35,3 +40,12 @@ func (cs *CompanyService) UpdateCompany(ctx context.Context, req *pb.UpdateCompa
 func (cs *CompanyService) GetStatistic(ctx context.Context, req *pb.GetStatisticRequest) (*pb.GetStatisticResponse, error) {
 	return cs.companyRepo.GetStatistic(req)
 }
+
+// GetSubscription reports the subscription of the caller's own company.
+func (cs *CompanyService) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.CompanySubscription, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.subscriptionRepo.GetSubscription(companyId)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"education-service/internal/money"
 	"education-service/internal/repository"
 	"education-service/internal/utils"
 	"education-service/proto/pb"
@@ -24,7 +25,11 @@ func (s *CourseService) CreateCourse(ctx context.Context, req *pb.CreateCourseRe
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	err := s.repo.CreateCourse(companyId, req.Name, req.Description, req.LessonDuration, req.CourseDuration, req.Price)
+	price, err := money.Parse(req.Price)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "price: %v", err)
+	}
+	err = s.repo.CreateCourse(companyId, req.Name, req.Description, req.LessonDuration, req.CourseDuration, price)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +41,11 @@ func (s *CourseService) UpdateCourse(ctx context.Context, req *pb.AbsCourse) (*p
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	err := s.repo.UpdateCourse(companyId, req.Name, req.Description, req.Id, req.LessonDuration, req.CourseDuration, req.Price)
+	price, err := money.Parse(req.Price)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "price: %v", err)
+	}
+	err = s.repo.UpdateCourse(companyId, req.Name, req.Description, req.Id, req.LessonDuration, req.CourseDuration, price)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DebtReminderService struct {
+	pb.UnimplementedDebtReminderServiceServer
+	repo *repository.DebtReminderRepository
+}
+
+func NewDebtReminderService(repo *repository.DebtReminderRepository) *DebtReminderService {
+	return &DebtReminderService{repo: repo}
+}
+
+func (s *DebtReminderService) GetDebtReminderSettings(ctx context.Context, req *emptypb.Empty) (*pb.DebtReminderSettings, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetDebtReminderSettings(companyId)
+}
+
+func (s *DebtReminderService) UpdateDebtReminderSettings(ctx context.Context, req *pb.DebtReminderSettings) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if err := s.repo.UpdateDebtReminderSettings(companyId, req); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{Status: 200, Message: "debt reminder settings updated"}, nil
+}
+
+func (s *DebtReminderService) GetDebtReminders(ctx context.Context, req *pb.GetDebtRemindersRequest) (*pb.GetDebtRemindersResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetDebtReminders(companyId, req.Status, req.Page, req.Size)
+}
+
+func (s *DebtReminderService) SetDebtReminderReply(ctx context.Context, req *pb.SetDebtReminderReplyRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if err := s.repo.SetDebtReminderReply(companyId, req); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{Status: 200, Message: "debt reminder reply saved"}, nil
+}
@@ -8,7 +8,6 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"log"
 )
 
 type GroupService struct {
@@ -25,7 +24,7 @@ func (s *GroupService) CreateGroup(ctx context.Context, req *pb.CreateGroupReque
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	id, err := s.repo.CreateGroup(companyId, req.Name, req.CourseId, req.TeacherId, req.Type, req.Days, req.RoomId, req.LessonStartTime, req.GroupStartDate, req.GroupEndDate)
+	id, err := s.repo.CreateGroup(companyId, req.Name, req.CourseId, req.TeacherId, req.Type, req.Days, req.RoomId, req.LessonStartTime, req.GroupStartDate, req.GroupEndDate, req.RequestKey)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +86,6 @@ func (s *GroupService) GetGroups(ctx context.Context, req *pb.GetGroupsRequest)
 		orderDirection,
 	)
 	if err != nil {
-		log.Printf("Error in GetGroups: %v", err)
 		return nil, err
 	}
 	return group, nil
@@ -100,7 +98,6 @@ func (s *GroupService) GetGroupById(ctx context.Context, req *pb.GetGroupByIdReq
 	}
 	group, err := s.repo.GetGroupById(ctx, companyId, req.Id, req.ActionRole, req.ActionId)
 	if err != nil {
-		log.Printf("Error in GetGroupById: %v", err)
 		return nil, err
 	}
 	return group, nil
@@ -113,7 +110,6 @@ func (s *GroupService) GetGroupsByCourseId(ctx context.Context, req *pb.GetGroup
 	}
 	groups, err := s.repo.GetGroupByCourseId(ctx, companyId, req.Id)
 	if err != nil {
-		log.Printf("Error in GetGroupById: %v", err)
 		return nil, err
 	}
 	return groups, nil
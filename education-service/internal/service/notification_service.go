@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type NotificationService struct {
+	pb.UnimplementedNotificationServiceServer
+	repo *repository.NotificationRepository
+}
+
+func NewNotificationService(repo *repository.NotificationRepository) *NotificationService {
+	return &NotificationService{repo: repo}
+}
+
+func (s *NotificationService) GetNotificationSettings(ctx context.Context, req *emptypb.Empty) (*pb.NotificationSettings, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetNotificationSettings(companyId)
+}
+
+func (s *NotificationService) UpdateNotificationSettings(ctx context.Context, req *pb.NotificationSettings) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if err := s.repo.UpdateNotificationSettings(companyId, req); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{Status: 200, Message: "notification settings updated"}, nil
+}
+
+func (s *NotificationService) GetNotificationOutbox(ctx context.Context, req *pb.GetNotificationOutboxRequest) (*pb.GetNotificationOutboxResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetNotificationOutbox(companyId, req.Status, req.Page, req.Size)
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReconciliationService struct {
+	pb.UnimplementedReconciliationServiceServer
+	repo *repository.ReconciliationRepository
+}
+
+func NewReconciliationService(repo *repository.ReconciliationRepository) *ReconciliationService {
+	return &ReconciliationService{repo: repo}
+}
+
+func (s *ReconciliationService) RunBalanceReconciliation(ctx context.Context, req *pb.RunBalanceReconciliationRequest) (*pb.ReconciliationReport, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.RunBalanceReconciliation(ctx, companyId, req.AutoCorrect, req.ActionById, req.ActionByName)
+}
+
+func (s *ReconciliationService) GetReconciliationReports(ctx context.Context, req *pb.GetReconciliationReportsRequest) (*pb.GetReconciliationReportsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetReconciliationReports(companyId, req.Page, req.Size)
+}
+
+func (s *ReconciliationService) GetReconciliationReport(ctx context.Context, req *pb.GetReconciliationReportRequest) (*pb.ReconciliationReport, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetReconciliationReport(companyId, req.Id)
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/proto/pb"
+)
+
+type SignupService struct {
+	pb.UnimplementedSignupServiceServer
+	signupRepo *repository.SignupRepository
+}
+
+func NewSignupService(repo *repository.SignupRepository) *SignupService {
+	return &SignupService{
+		signupRepo: repo,
+	}
+}
+
+func (s *SignupService) CheckSubdomain(ctx context.Context, req *pb.CheckSubdomainRequest) (*pb.CheckSubdomainResponse, error) {
+	return s.signupRepo.CheckSubdomain(req.Subdomain)
+}
+
+func (s *SignupService) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.SignupResponse, error) {
+	return s.signupRepo.Signup(ctx, req)
+}
@@ -5,8 +5,10 @@ import (
 	"education-service/internal/repository"
 	"education-service/internal/utils"
 	"education-service/proto/pb"
+	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strings"
 )
 
 type StudentService struct {
@@ -29,12 +31,17 @@ func (s *StudentService) CreateStudent(ctx context.Context, req *pb.CreateStuden
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	if err := s.repo.CreateStudent(companyId, req.CreatedBy, req.PhoneNumber, req.Name, req.GroupId, req.Address, req.AdditionalContact, req.DateFrom, req.DateOfBirth, req.Gender, req.PassportId, req.TelegramUsername); err != nil {
+	duplicates, err := s.repo.CreateStudent(companyId, req.CreatedBy, req.PhoneNumber, req.Name, req.GroupId, req.Address, req.AdditionalContact, req.DateFrom, req.DateOfBirth, req.Gender, req.PassportId, req.TelegramUsername, req.Id, req.Comment, req.Source)
+	if err != nil {
 		return nil, err
 	}
+	message := "student created successfully"
+	if len(duplicates) > 0 {
+		message += fmt.Sprintf("; warning: phone number already used by %s", strings.Join(duplicates, ", "))
+	}
 	return &pb.AbsResponse{
 		Status:  200,
-		Message: "student created successfully",
+		Message: message,
 	}, nil
 }
 func (s *StudentService) UpdateStudent(ctx context.Context, req *pb.UpdateStudentRequest) (*pb.AbsResponse, error) {
@@ -151,19 +158,52 @@ func (s *StudentService) ChangeUserBalanceHistory(ctx context.Context, req *pb.C
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return s.repo.ChangeUserBalanceHistory(companyId, req.Comment, req.GroupId, req.CreatedBy, req.CreatedByName, req.GivenDate, req.Amount, req.PaymentType, req.StudentId)
+	return s.repo.ChangeUserBalanceHistory(companyId, req.Comment, req.GroupId, req.CreatedBy, req.CreatedByName, req.GivenDate, req.Amount, req.PaymentType, req.StudentId, req.EventId)
 }
 func (s *StudentService) ChangeUserBalanceHistoryByDebit(ctx context.Context, req *pb.ChangeUserBalanceHistoryByDebitRequest) (*pb.AbsResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return s.repo.ChangeUserBalanceHistoryByDebit(companyId, req.StudentId, req.OldDebit, req.CurrentDebit, req.GivenDate, req.Comment, req.PaymentType, req.CreatedBy, req.CreatedByName, req.GroupId)
+	return s.repo.ChangeUserBalanceHistoryByDebit(companyId, req.StudentId, req.OldDebit, req.CurrentDebit, req.GivenDate, req.Comment, req.PaymentType, req.CreatedBy, req.CreatedByName, req.GroupId, req.EventId)
 }
 func (s *StudentService) CalculateDiscountSumma(ctx context.Context, req *pb.CalculateDiscountSummaRequest) (*pb.CalculateDiscountResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
 		return nil, status.Error(codes.Canceled, "error while getting company from context")
 	}
-	return s.repo.CalculateDiscountSumma(companyId, req.GroupId, req.StartDate, req.EndDate, req.DiscountPrice, req.StudentId, req.PaymentDate, req.StudentActivationDateInThisGroupWhilePayment)
+	return s.repo.CalculateDiscountSumma(companyId, req.GroupId, req.StartDate, req.EndDate, req.DiscountPrice, req.DiscountType, req.StudentId, req.PaymentDate, req.StudentActivationDateInThisGroupWhilePayment)
+}
+func (s *StudentService) FindStudentsByPhone(ctx context.Context, req *pb.FindStudentsByPhoneRequest) (*pb.SearchStudentResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.FindStudentsByPhone(companyId, req.PhoneNumber)
+}
+func (s *StudentService) MergeStudents(ctx context.Context, req *pb.MergeStudentsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if err := s.repo.MergeStudents(ctx, companyId, req.SourceStudentId, req.TargetStudentId, req.ActionById, req.ActionByName); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{
+		Status:  200,
+		Message: "students merged successfully",
+	}, nil
+}
+func (s *StudentService) DiscardStudents(ctx context.Context, req *pb.DiscardStudentsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if err := s.repo.DiscardStudents(companyId, req.StudentIds, req.GroupId, req.GroupRequestKey); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{
+		Status:  200,
+		Message: "students discarded",
+	}, nil
 }
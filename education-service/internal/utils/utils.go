@@ -3,13 +3,17 @@ package utils
 import (
 	"context"
 	"database/sql"
+	"education-service/internal/money"
 	"fmt"
 	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"math"
+	"log/slog"
+	"regexp"
+	"runtime/debug"
 	"strings"
 	"time"
 )
@@ -55,14 +59,14 @@ func IsValidLessonDay(db *sql.DB, groupId, fromDate string) (bool, error) {
 func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Recovered from panic in gRPC call: %v\n", r)
+			slog.ErrorContext(ctx, "recovered from panic in gRPC call", "panic", r, "stack", string(debug.Stack()))
 			err = status.Errorf(codes.Internal, "Internal server error")
 		}
 	}()
 	return handler(ctx, req)
 }
-func CalculateMoneyForStatus(db *sql.DB, manualPriceForCourse *float64, groupId string, tillDate string) (float64, error) {
-	var coursePrice float64
+func CalculateMoneyForStatus(db *sql.DB, manualPriceForCourse *decimal.Decimal, groupId string, tillDate string) (decimal.Decimal, error) {
+	var coursePrice decimal.Decimal
 	var courseDurationLesson int
 	var groupStartDate string
 	var groupEndDate string
@@ -78,16 +82,16 @@ func CalculateMoneyForStatus(db *sql.DB, manualPriceForCourse *float64, groupId
 
 	err := db.QueryRow(query, groupId).Scan(&coursePrice, &courseDurationLesson, &groupStartDate, &groupEndDate, pq.Array(&groupDays), &dateType)
 	if err != nil {
-		return 0, fmt.Errorf("error getting course details: %v", err)
+		return money.Zero, fmt.Errorf("error getting course details: %v", err)
 	}
 
 	if manualPriceForCourse != nil {
-		coursePrice = coursePrice - *manualPriceForCourse
+		coursePrice = coursePrice.Sub(*manualPriceForCourse)
 	}
 
 	tillDateParsed, err := time.Parse("2006-01-02", tillDate)
 	if err != nil {
-		return 0, fmt.Errorf("error parsing till date: %v", err)
+		return money.Zero, fmt.Errorf("error parsing till date: %v", err)
 	}
 
 	startOfMonth := time.Date(tillDateParsed.Year(), tillDateParsed.Month(), 1, 0, 0, 0, 0, tillDateParsed.Location())
@@ -99,7 +103,7 @@ func CalculateMoneyForStatus(db *sql.DB, manualPriceForCourse *float64, groupId
 		// Try alternate format if RFC3339 fails
 		groupEndDateParsed, err = time.Parse("2006-01-02T15:04:05Z", groupEndDate)
 		if err != nil {
-			return 0, fmt.Errorf("error parsing group end date: %v", err)
+			return money.Zero, fmt.Errorf("error parsing group end date: %v", err)
 		}
 	}
 
@@ -114,19 +118,19 @@ func CalculateMoneyForStatus(db *sql.DB, manualPriceForCourse *float64, groupId
 
 	// Calculate the proportional price based on days
 	daysToConsider := effectiveEndDate.Day()
-	proportionalPrice := (coursePrice * float64(daysToConsider)) / float64(daysInMonth)
+	proportionalPrice := money.Prorate(coursePrice, daysToConsider, daysInMonth)
 
 	// Get lesson dates for the actual period
 	lessonDates := getLessonDatesInMonth(groupDays, dateType, startOfMonth, effectiveEndDate)
 	if len(lessonDates) == 0 {
-		return 0, fmt.Errorf("no lessons scheduled for the current month")
+		return money.Zero, fmt.Errorf("no lessons scheduled for the current month")
 	}
 
 	firstLessonDate := lessonDates[0]
 
 	// If we're before or on the first lesson date, return proportional price
 	if !tillDateParsed.After(firstLessonDate) {
-		return math.Round(proportionalPrice), nil
+		return money.Whole(proportionalPrice), nil
 	}
 
 	// Count passed lessons
@@ -138,10 +142,9 @@ func CalculateMoneyForStatus(db *sql.DB, manualPriceForCourse *float64, groupId
 	}
 
 	// Calculate remaining money based on proportional price
-	pricePerLesson := proportionalPrice / float64(len(lessonDates))
-	remainingMoney := proportionalPrice - (float64(passedLessons) * pricePerLesson)
+	remainingMoney := money.Prorate(proportionalPrice, len(lessonDates)-passedLessons, len(lessonDates))
 
-	return math.Round(remainingMoney), nil
+	return money.Whole(remainingMoney), nil
 }
 func getLessonDatesInMonth(groupDays []string, dateType string, startDate, endDate time.Time) []time.Time {
 	var lessonDates []time.Time
@@ -192,8 +195,8 @@ func CheckGroupAndTeacher(db *sql.DB, groupId, actionRole string, actionId strin
 	return true
 }
 
-func CalculateMoneyForLesson(db *sql.DB, price *float64, studentId string, groupId string, attendDate string, discountAmount, courseP, fixedSum *float64) error {
-	var coursePrice float64
+func CalculateMoneyForLesson(db *sql.DB, price *decimal.Decimal, studentId string, groupId string, attendDate string, discountAmount, courseP, fixedSum *decimal.Decimal) error {
+	var coursePrice decimal.Decimal
 	err := db.QueryRow(`SELECT price FROM courses c JOIN groups g ON c.id = g.course_id WHERE g.id = $1`, groupId).Scan(&coursePrice)
 	if err != nil {
 		return fmt.Errorf("failed to fetch course price: %v", err)
@@ -201,22 +204,21 @@ func CalculateMoneyForLesson(db *sql.DB, price *float64, studentId string, group
 
 	if fixedSum != nil {
 		if discountAmount != nil {
-			fmt.Println("discount amount ", *discountAmount)
-			percent := (*discountAmount * 100) / coursePrice
-			teacherAmount := (*fixedSum * percent) / 100
-			*fixedSum = coursePrice - *discountAmount
-			fmt.Println("fixed summa narxi ", *fixedSum)
+			// the teacher's fixed sum shrinks by the share of the price discounted
+			teacherAmount := money.Zero
+			if !coursePrice.IsZero() {
+				teacherAmount = fixedSum.Mul(*discountAmount).Div(coursePrice)
+			}
+			*fixedSum = coursePrice.Sub(*discountAmount)
 			coursePrice = teacherAmount
 		} else {
-			fmt.Println("discount yoq ekan ")
 			coursePrice = *fixedSum
 		}
 	} else if discountAmount != nil {
-		fmt.Println("fixed summada emas ekan")
-		coursePrice -= *discountAmount
+		coursePrice = coursePrice.Sub(*discountAmount)
 	}
 
-	fmt.Println("calculate moneyga kridi")
+	slog.Debug("lesson price calculated", "group_id", groupId, "student_id", studentId, "price", coursePrice.String())
 	*courseP = coursePrice
 
 	parsedDate, err := time.Parse("2006-01-02", attendDate)
@@ -273,7 +275,7 @@ func CalculateMoneyForLesson(db *sql.DB, price *float64, studentId string, group
 	}
 
 	// Calculate and set the price per lesson
-	*price = math.Round(coursePrice / float64(lessonCount))
+	*price = money.PerLesson(coursePrice, lessonCount)
 	return nil
 }
 func GetCompanyId(ctx context.Context) string {
@@ -293,3 +295,25 @@ func NewTimoutContext(ctx context.Context, companyId string) (context.Context, c
 	res, cancelFunc := context.WithTimeout(ctx, time.Second*60)
 	return res, cancelFunc
 }
+
+var phoneDigitsRegex = regexp.MustCompile(`\D`)
+
+// NormalizePhone converts a phone number into E.164 form. Numbers written
+// without a country code are treated as Uzbek ones.
+func NormalizePhone(phone string) (string, error) {
+	trimmed := strings.TrimSpace(phone)
+	digits := phoneDigitsRegex.ReplaceAllString(trimmed, "")
+	switch {
+	case strings.HasPrefix(trimmed, "+"):
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	case len(digits) == 9:
+		digits = "998" + digits
+	case len(digits) == 10 && strings.HasPrefix(digits, "8"):
+		digits = "998" + digits[1:]
+	}
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", fmt.Errorf("invalid phone number: %s", phone)
+	}
+	return "+" + digits, nil
+}
@@ -0,0 +1,20 @@
+DROP TRIGGER IF EXISTS trg_student_update ON students;
+DROP TRIGGER IF EXISTS trg_group_update ON groups;
+DROP FUNCTION IF EXISTS log_student_update();
+DROP FUNCTION IF EXISTS log_group_update();
+DROP FUNCTION IF EXISTS sort_groups;
+DROP FUNCTION IF EXISTS filter_groups;
+DROP TABLE IF EXISTS group_student_condition_history;
+DROP TABLE IF EXISTS group_students;
+DROP TABLE IF EXISTS transfer_lesson;
+DROP TABLE IF EXISTS student_history;
+DROP TABLE IF EXISTS group_history;
+DROP TABLE IF EXISTS student_note;
+DROP TABLE IF EXISTS attendance;
+DROP TABLE IF EXISTS students;
+DROP TABLE IF EXISTS groups;
+DROP TABLE IF EXISTS courses;
+DROP TABLE IF EXISTS rooms;
+DROP TABLE IF EXISTS company_payments;
+DROP TABLE IF EXISTS company;
+DROP TABLE IF EXISTS tariff;
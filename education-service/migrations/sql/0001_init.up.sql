@@ -360,6 +360,7 @@ BEGIN
 END;
 $$ LANGUAGE plpgsql;
 
+DROP TRIGGER IF EXISTS trg_group_update ON groups;
 CREATE TRIGGER trg_group_update
     AFTER UPDATE
     ON groups
@@ -426,6 +427,7 @@ BEGIN
 END;
 $$ LANGUAGE plpgsql;
 
+DROP TRIGGER IF EXISTS trg_student_update ON students;
 CREATE TRIGGER trg_student_update
     AFTER UPDATE
     ON students
@@ -0,0 +1,4 @@
+-- normalized phone numbers are kept, the original formatting is not recoverable
+DROP INDEX IF EXISTS idx_students_company_phone;
+ALTER TABLE students
+    DROP COLUMN IF EXISTS merged_into;
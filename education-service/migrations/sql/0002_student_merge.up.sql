@@ -0,0 +1,13 @@
+ALTER TABLE students
+    ADD COLUMN IF NOT EXISTS merged_into uuid references students (id);
+
+UPDATE students
+SET phone = '+998' || regexp_replace(phone, '\D', '', 'g')
+WHERE length(regexp_replace(phone, '\D', '', 'g')) = 9;
+
+UPDATE students
+SET phone = '+' || regexp_replace(phone, '\D', '', 'g')
+WHERE phone !~ '^\+[1-9][0-9]{7,14}$'
+  AND regexp_replace(phone, '\D', '', 'g') ~ '^[1-9][0-9]{9,14}$';
+
+CREATE INDEX IF NOT EXISTS idx_students_company_phone ON students (company_id, phone);
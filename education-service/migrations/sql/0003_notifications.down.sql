@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS notification_outbox;
+DROP TABLE IF EXISTS notification_template;
+DROP TABLE IF EXISTS notification_setting;
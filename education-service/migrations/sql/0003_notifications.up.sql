@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS notification_setting
+(
+    company_id       int references company (id) PRIMARY KEY,
+    lang             varchar NOT NULL DEFAULT 'uz' CHECK (lang IN ('uz', 'ru')),
+    sms_enabled      boolean NOT NULL DEFAULT TRUE,
+    telegram_enabled boolean NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE IF NOT EXISTS notification_template
+(
+    id         serial PRIMARY KEY,
+    company_id int references company (id) NOT NULL,
+    event      varchar                     NOT NULL,
+    lang       varchar                     NOT NULL CHECK (lang IN ('uz', 'ru')),
+    body       text                        NOT NULL,
+    UNIQUE (company_id, event, lang)
+);
+
+CREATE TABLE IF NOT EXISTS notification_outbox
+(
+    id              uuid PRIMARY KEY,
+    company_id      int references company (id),
+    event           varchar   NOT NULL,
+    channel         varchar   NOT NULL,
+    recipient       varchar   NOT NULL,
+    body            text      NOT NULL,
+    status          varchar   NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'SENT', 'FAILED')),
+    attempts        int       NOT NULL DEFAULT 0,
+    last_error      text,
+    next_attempt_at timestamp NOT NULL DEFAULT now(),
+    created_at      timestamp          DEFAULT now(),
+    sent_at         timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_outbox_pending ON notification_outbox (next_attempt_at) WHERE status = 'PENDING';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS debt_reminder;
+DROP TABLE IF EXISTS debt_reminder_setting;
+DROP INDEX IF EXISTS idx_notification_outbox_reference;
+ALTER TABLE notification_outbox
+    DROP COLUMN IF EXISTS reference;
@@ -0,0 +1,34 @@
+ALTER TABLE notification_outbox
+    ADD COLUMN IF NOT EXISTS reference varchar;
+
+CREATE INDEX IF NOT EXISTS idx_notification_outbox_reference ON notification_outbox (reference) WHERE reference IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS debt_reminder_setting
+(
+    company_id    int references company (id) PRIMARY KEY,
+    is_active     boolean          NOT NULL DEFAULT FALSE,
+    balance_below double precision NOT NULL DEFAULT 0,
+    overdue_days  int              NOT NULL DEFAULT 3 CHECK (overdue_days >= 0),
+    cadence_days  int              NOT NULL DEFAULT 7 CHECK (cadence_days > 0),
+    max_reminders int              NOT NULL DEFAULT 5 CHECK (max_reminders > 0)
+);
+
+CREATE TABLE IF NOT EXISTS debt_reminder
+(
+    id             uuid PRIMARY KEY,
+    company_id     int references company (id)    NOT NULL,
+    student_id     uuid references students (id)  NOT NULL,
+    debt_since     date                           NOT NULL DEFAULT CURRENT_DATE,
+    balance        double precision               NOT NULL,
+    status         varchar                        NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'CLEARED', 'STOPPED')),
+    reminders_sent int                            NOT NULL DEFAULT 0,
+    last_sent_at   timestamp,
+    next_send_at   timestamp                      NOT NULL,
+    reply_status   varchar                        NOT NULL DEFAULT 'NONE' CHECK (reply_status IN ('NONE', 'PROMISED', 'REFUSED', 'NO_ANSWER', 'WRONG_NUMBER')),
+    reply_comment  varchar,
+    promised_date  date,
+    created_at     timestamp                               DEFAULT now(),
+    closed_at      timestamp
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_debt_reminder_active ON debt_reminder (student_id) WHERE status = 'ACTIVE';
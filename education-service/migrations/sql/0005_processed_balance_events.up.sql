@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS processed_balance_event
+(
+    event_id     uuid PRIMARY KEY,
+    company_id   int references company (id),
+    processed_at timestamp NOT NULL DEFAULT now()
+);
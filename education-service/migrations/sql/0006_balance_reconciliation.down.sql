@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS reconciliation_drift;
+DROP TABLE IF EXISTS reconciliation_run;
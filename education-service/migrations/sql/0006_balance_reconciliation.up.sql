@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS reconciliation_run
+(
+    id            uuid PRIMARY KEY,
+    company_id    int references company (id) NOT NULL,
+    checked_count int                         NOT NULL DEFAULT 0,
+    skipped_count int                         NOT NULL DEFAULT 0,
+    drift_count   int                         NOT NULL DEFAULT 0,
+    total_drift   double precision            NOT NULL DEFAULT 0,
+    auto_corrected boolean                    NOT NULL DEFAULT FALSE,
+    triggered_by  varchar                     NOT NULL,
+    created_at    timestamp                            DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS reconciliation_drift
+(
+    id             uuid PRIMARY KEY,
+    run_id         uuid references reconciliation_run (id) ON DELETE CASCADE NOT NULL,
+    student_id     uuid                                                    NOT NULL,
+    student_name   varchar                                                 NOT NULL,
+    ledger_balance double precision                                        NOT NULL,
+    actual_balance double precision                                        NOT NULL,
+    difference     double precision                                        NOT NULL,
+    corrected      boolean                                                 NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reconciliation_run_company ON reconciliation_run (company_id, created_at);
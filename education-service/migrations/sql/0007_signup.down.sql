@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS uq_company_subdomain;
+ALTER TABLE company
+    DROP COLUMN IF EXISTS converted_at,
+    DROP COLUMN IF EXISTS source;
@@ -0,0 +1,5 @@
+ALTER TABLE company
+    ADD COLUMN IF NOT EXISTS source       varchar NOT NULL DEFAULT 'OPERATOR' CHECK (source IN ('OPERATOR', 'SIGNUP')),
+    ADD COLUMN IF NOT EXISTS converted_at timestamp;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_company_subdomain ON company (lower(subdomain));
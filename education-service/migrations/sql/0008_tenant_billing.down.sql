@@ -0,0 +1,6 @@
+ALTER TABLE company_payments
+    DROP COLUMN IF EXISTS invoice_id;
+DROP TABLE IF EXISTS company_invoice;
+DROP TABLE IF EXISTS company_price_override;
+DROP TABLE IF EXISTS promo_code;
+DROP TABLE IF EXISTS tariff_prepay_discount;
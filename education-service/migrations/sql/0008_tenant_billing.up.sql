@@ -0,0 +1,64 @@
+-- the payment code writes tariff_sum, the column was never created
+ALTER TABLE company_payments
+    ADD COLUMN IF NOT EXISTS tariff_sum double precision;
+
+CREATE TABLE IF NOT EXISTS tariff_prepay_discount
+(
+    tariff_id int references tariff (id) NOT NULL,
+    months    int                        NOT NULL CHECK (months > 1),
+    percent   double precision           NOT NULL CHECK (percent > 0 AND percent < 100),
+    PRIMARY KEY (tariff_id, months)
+);
+
+CREATE TABLE IF NOT EXISTS promo_code
+(
+    code        varchar PRIMARY KEY CHECK (code = upper(code)),
+    percent     double precision NOT NULL DEFAULT 0 CHECK (percent >= 0 AND percent < 100),
+    amount      double precision NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    tariff_id   int references tariff (id),
+    valid_from  date,
+    valid_to    date,
+    max_uses    int CHECK (max_uses > 0),
+    used_count  int              NOT NULL DEFAULT 0,
+    is_active   boolean          NOT NULL DEFAULT TRUE,
+    created_at  timestamp                 DEFAULT now(),
+    CHECK ((percent > 0) <> (amount > 0))
+);
+
+-- negotiated terms of one company: a monthly price replacing the tariff's
+-- and/or a standing discount
+CREATE TABLE IF NOT EXISTS company_price_override
+(
+    company_id    int references company (id) PRIMARY KEY,
+    monthly_price double precision CHECK (monthly_price > 0),
+    percent       double precision NOT NULL DEFAULT 0 CHECK (percent >= 0 AND percent < 100),
+    valid_to      date,
+    comment       varchar,
+    updated_at    timestamp                 DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS company_invoice
+(
+    id               serial PRIMARY KEY,
+    company_id       int references company (id) NOT NULL,
+    tariff_id        int references tariff (id)  NOT NULL,
+    months           int                         NOT NULL CHECK (months > 0),
+    monthly_price    double precision            NOT NULL,
+    base_sum         double precision            NOT NULL,
+    prepay_percent   double precision            NOT NULL DEFAULT 0,
+    override_percent double precision            NOT NULL DEFAULT 0,
+    promo_code       varchar references promo_code (code),
+    discount_sum     double precision            NOT NULL DEFAULT 0,
+    total            double precision            NOT NULL CHECK (total >= 0),
+    period_from      date                        NOT NULL,
+    period_to        date                        NOT NULL,
+    paid_sum         double precision            NOT NULL DEFAULT 0,
+    status           varchar                     NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'PAID', 'VOID')),
+    created_at       timestamp                            DEFAULT now(),
+    paid_at          timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_company_invoice_company ON company_invoice (company_id, status);
+
+ALTER TABLE company_payments
+    ADD COLUMN IF NOT EXISTS invoice_id int references company_invoice (id);
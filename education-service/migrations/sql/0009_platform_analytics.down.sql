@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS tenant_snapshot;
+DROP TABLE IF EXISTS platform_snapshot;
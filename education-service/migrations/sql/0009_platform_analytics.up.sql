@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS platform_snapshot
+(
+    day              date PRIMARY KEY,
+    mrr              double precision NOT NULL,
+    paying_companies int              NOT NULL,
+    demo_companies   int              NOT NULL,
+    grace_companies  int              NOT NULL,
+    locked_companies int              NOT NULL,
+    total_companies  int              NOT NULL,
+    active_students  int              NOT NULL,
+    average_health   double precision NOT NULL,
+    created_at       timestamp DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS tenant_snapshot
+(
+    day           date             NOT NULL,
+    company_id    int              NOT NULL REFERENCES company (id) ON DELETE CASCADE,
+    state         varchar          NOT NULL,
+    is_demo       bool             NOT NULL,
+    tariff_name   varchar          NOT NULL,
+    valid_date    varchar          NOT NULL,
+    students      int              NOT NULL,
+    student_limit int              NOT NULL,
+    groups        int              NOT NULL,
+    staff         int              NOT NULL,
+    leads         int              NOT NULL,
+    recent_leads  int              NOT NULL,
+    payments      int              NOT NULL,
+    payments_sum  double precision NOT NULL,
+    score         int              NOT NULL,
+    level         varchar          NOT NULL,
+    PRIMARY KEY (day, company_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_snapshot_company ON tenant_snapshot (company_id, day);
@@ -0,0 +1,41 @@
+ALTER TABLE tariff
+    ALTER COLUMN sum TYPE double precision USING sum::double precision;
+ALTER TABLE company_payments
+    ALTER COLUMN sum TYPE double precision USING sum::double precision,
+    ALTER COLUMN tariff_sum TYPE double precision USING tariff_sum::double precision;
+
+ALTER TABLE courses
+    ALTER COLUMN price TYPE double precision USING price::double precision;
+ALTER TABLE attendance
+    ALTER COLUMN price TYPE double precision USING price::double precision,
+    ALTER COLUMN course_price TYPE double precision USING course_price::double precision;
+ALTER TABLE students
+    ALTER COLUMN balance TYPE double precision USING balance::double precision;
+
+ALTER TABLE debt_reminder_setting
+    ALTER COLUMN balance_below TYPE double precision USING balance_below::double precision;
+ALTER TABLE debt_reminder
+    ALTER COLUMN balance TYPE double precision USING balance::double precision;
+
+ALTER TABLE reconciliation_run
+    ALTER COLUMN total_drift TYPE double precision USING total_drift::double precision;
+ALTER TABLE reconciliation_drift
+    ALTER COLUMN ledger_balance TYPE double precision USING ledger_balance::double precision,
+    ALTER COLUMN actual_balance TYPE double precision USING actual_balance::double precision,
+    ALTER COLUMN difference TYPE double precision USING difference::double precision;
+
+ALTER TABLE promo_code
+    ALTER COLUMN amount TYPE double precision USING amount::double precision;
+ALTER TABLE company_price_override
+    ALTER COLUMN monthly_price TYPE double precision USING monthly_price::double precision;
+ALTER TABLE company_invoice
+    ALTER COLUMN monthly_price TYPE double precision USING monthly_price::double precision,
+    ALTER COLUMN base_sum TYPE double precision USING base_sum::double precision,
+    ALTER COLUMN discount_sum TYPE double precision USING discount_sum::double precision,
+    ALTER COLUMN total TYPE double precision USING total::double precision,
+    ALTER COLUMN paid_sum TYPE double precision USING paid_sum::double precision;
+
+ALTER TABLE platform_snapshot
+    ALTER COLUMN mrr TYPE double precision USING mrr::double precision;
+ALTER TABLE tenant_snapshot
+    ALTER COLUMN payments_sum TYPE double precision USING payments_sum::double precision;
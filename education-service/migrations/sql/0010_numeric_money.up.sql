@@ -0,0 +1,44 @@
+-- Money is stored as exact numeric with two decimal places. Existing values
+-- are rounded half away from zero, which is the rule internal/money applies
+-- in code.
+ALTER TABLE tariff
+    ALTER COLUMN sum TYPE numeric(16, 2) USING round(sum::numeric, 2);
+ALTER TABLE company_payments
+    ALTER COLUMN sum TYPE numeric(16, 2) USING round(sum::numeric, 2),
+    ALTER COLUMN tariff_sum TYPE numeric(16, 2) USING round(tariff_sum::numeric, 2);
+
+ALTER TABLE courses
+    ALTER COLUMN price TYPE numeric(16, 2) USING round(price::numeric, 2);
+ALTER TABLE attendance
+    ALTER COLUMN price TYPE numeric(16, 2) USING round(price::numeric, 2),
+    ALTER COLUMN course_price TYPE numeric(16, 2) USING round(course_price::numeric, 2);
+ALTER TABLE students
+    ALTER COLUMN balance TYPE numeric(16, 2) USING round(balance::numeric, 2);
+
+ALTER TABLE debt_reminder_setting
+    ALTER COLUMN balance_below TYPE numeric(16, 2) USING round(balance_below::numeric, 2);
+ALTER TABLE debt_reminder
+    ALTER COLUMN balance TYPE numeric(16, 2) USING round(balance::numeric, 2);
+
+ALTER TABLE reconciliation_run
+    ALTER COLUMN total_drift TYPE numeric(16, 2) USING round(total_drift::numeric, 2);
+ALTER TABLE reconciliation_drift
+    ALTER COLUMN ledger_balance TYPE numeric(16, 2) USING round(ledger_balance::numeric, 2),
+    ALTER COLUMN actual_balance TYPE numeric(16, 2) USING round(actual_balance::numeric, 2),
+    ALTER COLUMN difference TYPE numeric(16, 2) USING round(difference::numeric, 2);
+
+ALTER TABLE promo_code
+    ALTER COLUMN amount TYPE numeric(16, 2) USING round(amount::numeric, 2);
+ALTER TABLE company_price_override
+    ALTER COLUMN monthly_price TYPE numeric(16, 2) USING round(monthly_price::numeric, 2);
+ALTER TABLE company_invoice
+    ALTER COLUMN monthly_price TYPE numeric(16, 2) USING round(monthly_price::numeric, 2),
+    ALTER COLUMN base_sum TYPE numeric(16, 2) USING round(base_sum::numeric, 2),
+    ALTER COLUMN discount_sum TYPE numeric(16, 2) USING round(discount_sum::numeric, 2),
+    ALTER COLUMN total TYPE numeric(16, 2) USING round(total::numeric, 2),
+    ALTER COLUMN paid_sum TYPE numeric(16, 2) USING round(paid_sum::numeric, 2);
+
+ALTER TABLE platform_snapshot
+    ALTER COLUMN mrr TYPE numeric(16, 2) USING round(mrr::numeric, 2);
+ALTER TABLE tenant_snapshot
+    ALTER COLUMN payments_sum TYPE numeric(16, 2) USING round(payments_sum::numeric, 2);
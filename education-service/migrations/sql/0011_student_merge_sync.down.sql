@@ -0,0 +1,2 @@
+-- normalized phone numbers are kept, the original formatting is not recoverable
+ALTER TABLE students DROP COLUMN IF EXISTS merge_pending;
@@ -0,0 +1,42 @@
+-- a merged student keeps merge_pending until the finance service has moved its
+-- payments, a job retries the ones that failed after the local commit
+ALTER TABLE students
+    ADD COLUMN IF NOT EXISTS merge_pending boolean NOT NULL DEFAULT false;
+
+-- pg_temp.normalize_phone follows utils.NormalizePhone so stored numbers match
+-- what the services write. Values it cannot read are left as they are.
+CREATE FUNCTION pg_temp.normalize_phone(phone varchar) RETURNS varchar
+    LANGUAGE plpgsql
+    IMMUTABLE
+AS
+$$
+DECLARE
+    trimmed varchar := btrim(phone, E' \t\r\n');
+    digits  varchar := regexp_replace(phone, '\D', '', 'g');
+BEGIN
+    IF trimmed LIKE '+%' THEN
+        NULL;
+    ELSIF digits LIKE '00%' THEN
+        digits := substr(digits, 3);
+    ELSIF length(digits) = 9 THEN
+        digits := '998' || digits;
+    ELSIF length(digits) = 10 AND digits LIKE '8%' THEN
+        digits := '998' || substr(digits, 2);
+    END IF;
+    IF digits !~ '^[1-9][0-9]{7,14}$' THEN
+        RETURN phone;
+    END IF;
+    RETURN '+' || digits;
+END
+$$;
+
+-- The earlier migration turned ten digit local numbers starting with 8 into
+-- +8XXXXXXXXX instead of +998XXXXXXXXX. No country uses ten digit numbers
+-- starting with 8, so these are all local numbers.
+UPDATE students
+SET phone = '+998' || substr(phone, 3)
+WHERE phone ~ '^\+8[0-9]{9}$';
+
+UPDATE students
+SET phone = pg_temp.normalize_phone(phone)
+WHERE phone IS DISTINCT FROM pg_temp.normalize_phone(phone);
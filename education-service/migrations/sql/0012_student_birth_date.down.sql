@@ -0,0 +1 @@
+ALTER TABLE students ALTER COLUMN date_of_birth SET DEFAULT '2000-12-12';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_notification_outbox_sending;
+
+UPDATE notification_outbox SET status = 'PENDING' WHERE status = 'SENDING';
+ALTER TABLE notification_outbox DROP CONSTRAINT IF EXISTS notification_outbox_status_check;
+ALTER TABLE notification_outbox
+    ADD CONSTRAINT notification_outbox_status_check CHECK (status IN ('PENDING', 'SENT', 'FAILED'));
@@ -0,0 +1,7 @@
+-- SENDING rows are claimed by a dispatcher until next_attempt_at, the claim
+-- lapses when the dispatcher dies before recording the result
+ALTER TABLE notification_outbox DROP CONSTRAINT IF EXISTS notification_outbox_status_check;
+ALTER TABLE notification_outbox
+    ADD CONSTRAINT notification_outbox_status_check CHECK (status IN ('PENDING', 'SENDING', 'SENT', 'FAILED'));
+
+CREATE INDEX IF NOT EXISTS idx_notification_outbox_sending ON notification_outbox (next_attempt_at) WHERE status = 'SENDING';
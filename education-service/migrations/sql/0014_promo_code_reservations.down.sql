@@ -0,0 +1 @@
+-- used_count is not restored
@@ -0,0 +1,7 @@
+-- promo code uses are taken when an invoice is issued and given back when it
+-- is voided, recount them from the invoices that still hold one
+UPDATE promo_code p
+SET used_count = (SELECT count(*)
+                  FROM company_invoice i
+                  WHERE i.promo_code = p.code
+                    AND i.status IN ('OPEN', 'PAID'));
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS uq_groups_request_key;
+
+ALTER TABLE groups
+    DROP COLUMN IF EXISTS request_key;
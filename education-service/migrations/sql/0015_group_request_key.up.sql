@@ -0,0 +1,4 @@
+ALTER TABLE groups
+    ADD COLUMN IF NOT EXISTS request_key varchar;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_groups_request_key ON groups (company_id, request_key) WHERE request_key IS NOT NULL;
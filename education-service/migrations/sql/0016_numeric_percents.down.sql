@@ -0,0 +1,9 @@
+ALTER TABLE company_invoice
+    ALTER COLUMN prepay_percent TYPE double precision USING prepay_percent::double precision,
+    ALTER COLUMN override_percent TYPE double precision USING override_percent::double precision;
+ALTER TABLE company_price_override
+    ALTER COLUMN percent TYPE double precision USING percent::double precision;
+ALTER TABLE promo_code
+    ALTER COLUMN percent TYPE double precision USING percent::double precision;
+ALTER TABLE tariff_prepay_discount
+    ALTER COLUMN percent TYPE double precision USING percent::double precision;
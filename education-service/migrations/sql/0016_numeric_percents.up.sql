@@ -0,0 +1,9 @@
+ALTER TABLE tariff_prepay_discount
+    ALTER COLUMN percent TYPE numeric(5, 2) USING round(percent::numeric, 2);
+ALTER TABLE promo_code
+    ALTER COLUMN percent TYPE numeric(5, 2) USING round(percent::numeric, 2);
+ALTER TABLE company_price_override
+    ALTER COLUMN percent TYPE numeric(5, 2) USING round(percent::numeric, 2);
+ALTER TABLE company_invoice
+    ALTER COLUMN prepay_percent TYPE numeric(5, 2) USING round(prepay_percent::numeric, 2),
+    ALTER COLUMN override_percent TYPE numeric(5, 2) USING round(override_percent::numeric, 2);
@@ -0,0 +1,2 @@
+ALTER TABLE attendance
+    ALTER COLUMN total_count TYPE double precision USING total_count::double precision;
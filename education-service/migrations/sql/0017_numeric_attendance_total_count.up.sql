@@ -0,0 +1,2 @@
+ALTER TABLE attendance
+    ALTER COLUMN total_count TYPE numeric(16, 2) USING round(total_count::numeric, 2);
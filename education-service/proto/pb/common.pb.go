@@ -201,6 +201,335 @@ func (x *PageRequest) GetCompanyId() string {
 	return ""
 }
 
+// What each company uses of a service, for the platform analytics.
+type TenantUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// recent counts what was added from this date up to to
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantUsageRequest) Reset() {
+	*x = TenantUsageRequest{}
+	mi := &file_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsageRequest) ProtoMessage() {}
+
+func (x *TenantUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsageRequest.ProtoReflect.Descriptor instead.
+func (*TenantUsageRequest) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TenantUsageRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TenantUsageRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type TenantUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     int32                  `protobuf:"varint,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Recent        int64                  `protobuf:"varint,3,opt,name=recent,proto3" json:"recent,omitempty"`
+	Sum           string                 `protobuf:"bytes,4,opt,name=sum,proto3" json:"sum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantUsage) Reset() {
+	*x = TenantUsage{}
+	mi := &file_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsage) ProtoMessage() {}
+
+func (x *TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsage.ProtoReflect.Descriptor instead.
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TenantUsage) GetCompanyId() int32 {
+	if x != nil {
+		return x.CompanyId
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetRecent() int64 {
+	if x != nil {
+		return x.Recent
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+type TenantUsageList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TenantUsage         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantUsageList) Reset() {
+	*x = TenantUsageList{}
+	mi := &file_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsageList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsageList) ProtoMessage() {}
+
+func (x *TenantUsageList) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsageList.ProtoReflect.Descriptor instead.
+func (*TenantUsageList) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TenantUsageList) GetItems() []*TenantUsage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// PaymentPlanStatus is where a student's installment plan stands.
+type PaymentPlanStatus struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	PlanId  string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId,omitempty"`
+	GroupId string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// ON_TRACK, OVERDUE, COMPLETED or CANCELLED
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Total       string `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Paid        string `protobuf:"bytes,5,opt,name=paid,proto3" json:"paid,omitempty"`
+	Outstanding string `protobuf:"bytes,6,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	// due and unpaid past the grace days, late fees included
+	Overdue             string `protobuf:"bytes,7,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	OverdueInstallments int32  `protobuf:"varint,8,opt,name=overdueInstallments,proto3" json:"overdueInstallments,omitempty"`
+	NextDueDate         string `protobuf:"bytes,9,opt,name=nextDueDate,proto3" json:"nextDueDate,omitempty"`
+	NextDueAmount       string `protobuf:"bytes,10,opt,name=nextDueAmount,proto3" json:"nextDueAmount,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PaymentPlanStatus) Reset() {
+	*x = PaymentPlanStatus{}
+	mi := &file_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentPlanStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentPlanStatus) ProtoMessage() {}
+
+func (x *PaymentPlanStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentPlanStatus.ProtoReflect.Descriptor instead.
+func (*PaymentPlanStatus) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PaymentPlanStatus) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetOutstanding() string {
+	if x != nil {
+		return x.Outstanding
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetOverdue() string {
+	if x != nil {
+		return x.Overdue
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetOverdueInstallments() int32 {
+	if x != nil {
+		return x.OverdueInstallments
+	}
+	return 0
+}
+
+func (x *PaymentPlanStatus) GetNextDueDate() string {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return ""
+}
+
+func (x *PaymentPlanStatus) GetNextDueAmount() string {
+	if x != nil {
+		return x.NextDueAmount
+	}
+	return ""
+}
+
+type PaymentPlanStatusList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PaymentPlanStatus   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentPlanStatusList) Reset() {
+	*x = PaymentPlanStatusList{}
+	mi := &file_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentPlanStatusList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentPlanStatusList) ProtoMessage() {}
+
+func (x *PaymentPlanStatusList) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentPlanStatusList.ProtoReflect.Descriptor instead.
+func (*PaymentPlanStatusList) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PaymentPlanStatusList) GetItems() []*PaymentPlanStatus {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
@@ -217,7 +546,31 @@ const file_common_proto_rawDesc = "" +
 	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x12\n" +
 	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x05 \x01(\tR\x02to\x12\x1c\n" +
-	"\tcompanyId\x18\x06 \x01(\tR\tcompanyIdB\n" +
+	"\tcompanyId\x18\x06 \x01(\tR\tcompanyId\"8\n" +
+	"\x12TenantUsageRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"k\n" +
+	"\vTenantUsage\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\x05R\tcompanyId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06recent\x18\x03 \x01(\x03R\x06recent\x12\x10\n" +
+	"\x03sum\x18\x04 \x01(\tR\x03sum\"<\n" +
+	"\x0fTenantUsageList\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.common.TenantUsageR\x05items\"\xbd\x02\n" +
+	"\x11PaymentPlanStatus\x12\x16\n" +
+	"\x06planId\x18\x01 \x01(\tR\x06planId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\tR\x05total\x12\x12\n" +
+	"\x04paid\x18\x05 \x01(\tR\x04paid\x12 \n" +
+	"\voutstanding\x18\x06 \x01(\tR\voutstanding\x12\x18\n" +
+	"\aoverdue\x18\a \x01(\tR\aoverdue\x120\n" +
+	"\x13overdueInstallments\x18\b \x01(\x05R\x13overdueInstallments\x12 \n" +
+	"\vnextDueDate\x18\t \x01(\tR\vnextDueDate\x12$\n" +
+	"\rnextDueAmount\x18\n" +
+	" \x01(\tR\rnextDueAmount\"H\n" +
+	"\x15PaymentPlanStatusList\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.common.PaymentPlanStatusR\x05itemsB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -232,18 +585,25 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_common_proto_goTypes = []any{
-	(*AbsResponse)(nil),      // 0: common.AbsResponse
-	(*DeleteAbsRequest)(nil), // 1: common.DeleteAbsRequest
-	(*PageRequest)(nil),      // 2: common.PageRequest
+	(*AbsResponse)(nil),           // 0: common.AbsResponse
+	(*DeleteAbsRequest)(nil),      // 1: common.DeleteAbsRequest
+	(*PageRequest)(nil),           // 2: common.PageRequest
+	(*TenantUsageRequest)(nil),    // 3: common.TenantUsageRequest
+	(*TenantUsage)(nil),           // 4: common.TenantUsage
+	(*TenantUsageList)(nil),       // 5: common.TenantUsageList
+	(*PaymentPlanStatus)(nil),     // 6: common.PaymentPlanStatus
+	(*PaymentPlanStatusList)(nil), // 7: common.PaymentPlanStatusList
 }
 var file_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: common.TenantUsageList.items:type_name -> common.TenantUsage
+	6, // 1: common.PaymentPlanStatusList.items:type_name -> common.PaymentPlanStatus
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -257,7 +617,7 @@ func file_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
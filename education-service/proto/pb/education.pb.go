@@ -27,15 +27,17 @@ type CompanyFinance struct {
 	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CompanyId       int32                  `protobuf:"varint,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
 	TariffId        int32                  `protobuf:"varint,3,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
-	TariffSum       float32                `protobuf:"fixed32,10,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
+	TariffSum       string                 `protobuf:"bytes,10,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
 	Comment         string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Sum             float32                `protobuf:"fixed32,5,opt,name=sum,proto3" json:"sum,omitempty"`
+	Sum             string                 `protobuf:"bytes,5,opt,name=sum,proto3" json:"sum,omitempty"`
 	EditedValidDate string                 `protobuf:"bytes,6,opt,name=edited_valid_date,json=editedValidDate,proto3" json:"edited_valid_date,omitempty"`
 	CreatedAt       string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	DiscountId      string                 `protobuf:"bytes,8,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
 	DiscountName    string                 `protobuf:"bytes,9,opt,name=discount_name,json=discountName,proto3" json:"discount_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// the invoice paid; when 0 an open invoice of the same total is matched
+	InvoiceId     int32 `protobuf:"varint,11,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CompanyFinance) Reset() {
@@ -89,11 +91,11 @@ func (x *CompanyFinance) GetTariffId() int32 {
 	return 0
 }
 
-func (x *CompanyFinance) GetTariffSum() float32 {
+func (x *CompanyFinance) GetTariffSum() string {
 	if x != nil {
 		return x.TariffSum
 	}
-	return 0
+	return ""
 }
 
 func (x *CompanyFinance) GetComment() string {
@@ -103,11 +105,11 @@ func (x *CompanyFinance) GetComment() string {
 	return ""
 }
 
-func (x *CompanyFinance) GetSum() float32 {
+func (x *CompanyFinance) GetSum() string {
 	if x != nil {
 		return x.Sum
 	}
-	return 0
+	return ""
 }
 
 func (x *CompanyFinance) GetEditedValidDate() string {
@@ -138,13 +140,20 @@ func (x *CompanyFinance) GetDiscountName() string {
 	return ""
 }
 
+func (x *CompanyFinance) GetInvoiceId() int32 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
 type CompanyFinanceSelf struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	TariffId      int32                  `protobuf:"varint,2,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
-	TariffSum     int32                  `protobuf:"varint,3,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
+	TariffSum     string                 `protobuf:"bytes,3,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
 	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Sum           float32                `protobuf:"fixed32,5,opt,name=sum,proto3" json:"sum,omitempty"`
+	Sum           string                 `protobuf:"bytes,5,opt,name=sum,proto3" json:"sum,omitempty"`
 	EditValidDate string                 `protobuf:"bytes,6,opt,name=edit_valid_date,json=editValidDate,proto3" json:"edit_valid_date,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	DiscountId    string                 `protobuf:"bytes,8,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
@@ -198,11 +207,11 @@ func (x *CompanyFinanceSelf) GetTariffId() int32 {
 	return 0
 }
 
-func (x *CompanyFinanceSelf) GetTariffSum() int32 {
+func (x *CompanyFinanceSelf) GetTariffSum() string {
 	if x != nil {
 		return x.TariffSum
 	}
-	return 0
+	return ""
 }
 
 func (x *CompanyFinanceSelf) GetComment() string {
@@ -212,11 +221,11 @@ func (x *CompanyFinanceSelf) GetComment() string {
 	return ""
 }
 
-func (x *CompanyFinanceSelf) GetSum() float32 {
+func (x *CompanyFinanceSelf) GetSum() string {
 	if x != nil {
 		return x.Sum
 	}
-	return 0
+	return ""
 }
 
 func (x *CompanyFinanceSelf) GetEditValidDate() string {
@@ -257,10 +266,10 @@ func (x *CompanyFinanceSelf) GetTariffName() string {
 type CompanyFinanceSelfList struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Count           int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	SumAmountPeriod float32                `protobuf:"fixed32,2,opt,name=sum_amount_period,json=sumAmountPeriod,proto3" json:"sum_amount_period,omitempty"`
+	SumAmountPeriod string                 `protobuf:"bytes,2,opt,name=sum_amount_period,json=sumAmountPeriod,proto3" json:"sum_amount_period,omitempty"`
 	TariffName      string                 `protobuf:"bytes,3,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name,omitempty"`
 	DiscountName    string                 `protobuf:"bytes,4,opt,name=discount_name,json=discountName,proto3" json:"discount_name,omitempty"`
-	RequiredSum     float32                `protobuf:"fixed32,5,opt,name=required_sum,json=requiredSum,proto3" json:"required_sum,omitempty"`
+	RequiredSum     string                 `protobuf:"bytes,5,opt,name=required_sum,json=requiredSum,proto3" json:"required_sum,omitempty"`
 	Items           []*CompanyFinanceSelf  `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -303,11 +312,11 @@ func (x *CompanyFinanceSelfList) GetCount() int32 {
 	return 0
 }
 
-func (x *CompanyFinanceSelfList) GetSumAmountPeriod() float32 {
+func (x *CompanyFinanceSelfList) GetSumAmountPeriod() string {
 	if x != nil {
 		return x.SumAmountPeriod
 	}
-	return 0
+	return ""
 }
 
 func (x *CompanyFinanceSelfList) GetTariffName() string {
@@ -324,11 +333,11 @@ func (x *CompanyFinanceSelfList) GetDiscountName() string {
 	return ""
 }
 
-func (x *CompanyFinanceSelfList) GetRequiredSum() float32 {
+func (x *CompanyFinanceSelfList) GetRequiredSum() string {
 	if x != nil {
 		return x.RequiredSum
 	}
-	return 0
+	return ""
 }
 
 func (x *CompanyFinanceSelfList) GetItems() []*CompanyFinanceSelf {
@@ -399,10 +408,10 @@ type CompanyFinanceForList struct {
 	FinishedTo    string                 `protobuf:"bytes,5,opt,name=finished_to,json=finishedTo,proto3" json:"finished_to,omitempty"`
 	TariffId      string                 `protobuf:"bytes,6,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
 	TariffName    string                 `protobuf:"bytes,7,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name,omitempty"`
-	Sum           float32                `protobuf:"fixed32,8,opt,name=sum,proto3" json:"sum,omitempty"`
+	Sum           string                 `protobuf:"bytes,8,opt,name=sum,proto3" json:"sum,omitempty"`
 	DiscountId    string                 `protobuf:"bytes,9,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
 	DiscountName  string                 `protobuf:"bytes,10,opt,name=discount_name,json=discountName,proto3" json:"discount_name,omitempty"`
-	TariffSum     float32                `protobuf:"fixed32,11,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
+	TariffSum     string                 `protobuf:"bytes,11,opt,name=tariff_sum,json=tariffSum,proto3" json:"tariff_sum,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -486,11 +495,11 @@ func (x *CompanyFinanceForList) GetTariffName() string {
 	return ""
 }
 
-func (x *CompanyFinanceForList) GetSum() float32 {
+func (x *CompanyFinanceForList) GetSum() string {
 	if x != nil {
 		return x.Sum
 	}
-	return 0
+	return ""
 }
 
 func (x *CompanyFinanceForList) GetDiscountId() string {
@@ -507,36 +516,39 @@ func (x *CompanyFinanceForList) GetDiscountName() string {
 	return ""
 }
 
-func (x *CompanyFinanceForList) GetTariffSum() float32 {
+func (x *CompanyFinanceForList) GetTariffSum() string {
 	if x != nil {
 		return x.TariffSum
 	}
-	return 0
+	return ""
 }
 
-type GetStatisticResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Details         *CompanyCommonDetails  `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	RegisterDetails []*OtherDetails        `protobuf:"bytes,2,rep,name=registerDetails,proto3" json:"registerDetails,omitempty"`
-	PaymentDetails  []*OtherDetails        `protobuf:"bytes,3,rep,name=paymentDetails,proto3" json:"paymentDetails,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// companyId may be left empty by a company asking for itself
+type QuoteRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// the company's current tariff when 0
+	TariffId      int32  `protobuf:"varint,2,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	Months        int32  `protobuf:"varint,3,opt,name=months,proto3" json:"months,omitempty"`
+	PromoCode     string `protobuf:"bytes,4,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatisticResponse) Reset() {
-	*x = GetStatisticResponse{}
+func (x *QuoteRequest) Reset() {
+	*x = QuoteRequest{}
 	mi := &file_education_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatisticResponse) String() string {
+func (x *QuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatisticResponse) ProtoMessage() {}
+func (*QuoteRequest) ProtoMessage() {}
 
-func (x *GetStatisticResponse) ProtoReflect() protoreflect.Message {
+func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,53 +560,73 @@ func (x *GetStatisticResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatisticResponse.ProtoReflect.Descriptor instead.
-func (*GetStatisticResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuoteRequest.ProtoReflect.Descriptor instead.
+func (*QuoteRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetStatisticResponse) GetDetails() *CompanyCommonDetails {
+func (x *QuoteRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Details
+		return x.CompanyId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStatisticResponse) GetRegisterDetails() []*OtherDetails {
+func (x *QuoteRequest) GetTariffId() int32 {
 	if x != nil {
-		return x.RegisterDetails
+		return x.TariffId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetStatisticResponse) GetPaymentDetails() []*OtherDetails {
+func (x *QuoteRequest) GetMonths() int32 {
 	if x != nil {
-		return x.PaymentDetails
+		return x.Months
 	}
-	return nil
+	return 0
 }
 
-type OtherDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Details       map[string]string      `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *QuoteRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type TariffQuote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId       string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	TariffId        int32                  `protobuf:"varint,2,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	TariffName      string                 `protobuf:"bytes,3,opt,name=tariffName,proto3" json:"tariffName,omitempty"`
+	Months          int32                  `protobuf:"varint,4,opt,name=months,proto3" json:"months,omitempty"`
+	MonthlyPrice    string                 `protobuf:"bytes,5,opt,name=monthlyPrice,proto3" json:"monthlyPrice,omitempty"`
+	BaseSum         string                 `protobuf:"bytes,6,opt,name=baseSum,proto3" json:"baseSum,omitempty"`
+	PrepayPercent   float64                `protobuf:"fixed64,7,opt,name=prepayPercent,proto3" json:"prepayPercent,omitempty"`
+	OverridePercent float64                `protobuf:"fixed64,8,opt,name=overridePercent,proto3" json:"overridePercent,omitempty"`
+	PromoCode       string                 `protobuf:"bytes,9,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	DiscountSum     string                 `protobuf:"bytes,10,opt,name=discountSum,proto3" json:"discountSum,omitempty"`
+	Total           string                 `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`
+	PeriodFrom      string                 `protobuf:"bytes,12,opt,name=periodFrom,proto3" json:"periodFrom,omitempty"`
+	// the company's valid_date once paid
+	ValidDate     string `protobuf:"bytes,13,opt,name=validDate,proto3" json:"validDate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OtherDetails) Reset() {
-	*x = OtherDetails{}
+func (x *TariffQuote) Reset() {
+	*x = TariffQuote{}
 	mi := &file_education_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OtherDetails) String() string {
+func (x *TariffQuote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OtherDetails) ProtoMessage() {}
+func (*TariffQuote) ProtoMessage() {}
 
-func (x *OtherDetails) ProtoReflect() protoreflect.Message {
+func (x *TariffQuote) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,43 +638,129 @@ func (x *OtherDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OtherDetails.ProtoReflect.Descriptor instead.
-func (*OtherDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use TariffQuote.ProtoReflect.Descriptor instead.
+func (*TariffQuote) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *OtherDetails) GetDetails() map[string]string {
+func (x *TariffQuote) GetCompanyId() string {
 	if x != nil {
-		return x.Details
+		return x.CompanyId
 	}
-	return nil
+	return ""
 }
 
-type CompanyCommonDetails struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ActiveStudents  int32                  `protobuf:"varint,1,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
-	WholeCompanies  int32                  `protobuf:"varint,2,opt,name=wholeCompanies,proto3" json:"wholeCompanies,omitempty"`
-	DebtorCompanies int32                  `protobuf:"varint,3,opt,name=debtorCompanies,proto3" json:"debtorCompanies,omitempty"`
-	ActiveCompanies int32                  `protobuf:"varint,4,opt,name=activeCompanies,proto3" json:"activeCompanies,omitempty"`
-	DemoCompanies   int32                  `protobuf:"varint,5,opt,name=demoCompanies,proto3" json:"demoCompanies,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *TariffQuote) GetTariffId() int32 {
+	if x != nil {
+		return x.TariffId
+	}
+	return 0
 }
 
-func (x *CompanyCommonDetails) Reset() {
-	*x = CompanyCommonDetails{}
+func (x *TariffQuote) GetTariffName() string {
+	if x != nil {
+		return x.TariffName
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetMonthlyPrice() string {
+	if x != nil {
+		return x.MonthlyPrice
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetBaseSum() string {
+	if x != nil {
+		return x.BaseSum
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetPrepayPercent() float64 {
+	if x != nil {
+		return x.PrepayPercent
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetOverridePercent() float64 {
+	if x != nil {
+		return x.OverridePercent
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetDiscountSum() string {
+	if x != nil {
+		return x.DiscountSum
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetPeriodFrom() string {
+	if x != nil {
+		return x.PeriodFrom
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetValidDate() string {
+	if x != nil {
+		return x.ValidDate
+	}
+	return ""
+}
+
+type Invoice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quote *TariffQuote           `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// OPEN, PAID or VOID
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PaidSum       string `protobuf:"bytes,4,opt,name=paidSum,proto3" json:"paidSum,omitempty"`
+	CreatedAt     string `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	PaidAt        string `protobuf:"bytes,6,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
 	mi := &file_education_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyCommonDetails) String() string {
+func (x *Invoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanyCommonDetails) ProtoMessage() {}
+func (*Invoice) ProtoMessage() {}
 
-func (x *CompanyCommonDetails) ProtoReflect() protoreflect.Message {
+func (x *Invoice) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,68 +772,77 @@ func (x *CompanyCommonDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanyCommonDetails.ProtoReflect.Descriptor instead.
-func (*CompanyCommonDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CompanyCommonDetails) GetActiveStudents() int32 {
+func (x *Invoice) GetId() int32 {
 	if x != nil {
-		return x.ActiveStudents
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CompanyCommonDetails) GetWholeCompanies() int32 {
+func (x *Invoice) GetQuote() *TariffQuote {
 	if x != nil {
-		return x.WholeCompanies
+		return x.Quote
 	}
-	return 0
+	return nil
 }
 
-func (x *CompanyCommonDetails) GetDebtorCompanies() int32 {
+func (x *Invoice) GetStatus() string {
 	if x != nil {
-		return x.DebtorCompanies
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyCommonDetails) GetActiveCompanies() int32 {
+func (x *Invoice) GetPaidSum() string {
 	if x != nil {
-		return x.ActiveCompanies
+		return x.PaidSum
 	}
-	return 0
+	return ""
 }
 
-func (x *CompanyCommonDetails) GetDemoCompanies() int32 {
+func (x *Invoice) GetCreatedAt() string {
 	if x != nil {
-		return x.DemoCompanies
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-type GetStatisticRequest struct {
+func (x *Invoice) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+type GetInvoicesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatisticRequest) Reset() {
-	*x = GetStatisticRequest{}
+func (x *GetInvoicesRequest) Reset() {
+	*x = GetInvoicesRequest{}
 	mi := &file_education_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatisticRequest) String() string {
+func (x *GetInvoicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatisticRequest) ProtoMessage() {}
+func (*GetInvoicesRequest) ProtoMessage() {}
 
-func (x *GetStatisticRequest) ProtoReflect() protoreflect.Message {
+func (x *GetInvoicesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -727,56 +854,61 @@ func (x *GetStatisticRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatisticRequest.ProtoReflect.Descriptor instead.
-func (*GetStatisticRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoicesRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetStatisticRequest) GetFrom() string {
+func (x *GetInvoicesRequest) GetCompanyId() string {
 	if x != nil {
-		return x.From
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *GetStatisticRequest) GetTo() string {
+func (x *GetInvoicesRequest) GetStatus() string {
 	if x != nil {
-		return x.To
+		return x.Status
 	}
 	return ""
 }
 
-type UpdateCompanyRequest struct {
+func (x *GetInvoicesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetInvoicesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type InvoiceList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
-	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	CompanyPhone  string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
-	Subdomain     string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
-	ValidDate     string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
-	TariffId      int64                  `protobuf:"varint,9,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
-	DiscountId    string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
-	IsDemo        bool                   `protobuf:"varint,11,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*Invoice             `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyRequest) Reset() {
-	*x = UpdateCompanyRequest{}
+func (x *InvoiceList) Reset() {
+	*x = InvoiceList{}
 	mi := &file_education_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyRequest) String() string {
+func (x *InvoiceList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyRequest) ProtoMessage() {}
+func (*InvoiceList) ProtoMessage() {}
 
-func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+func (x *InvoiceList) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,111 +920,157 @@ func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvoiceList.ProtoReflect.Descriptor instead.
+func (*InvoiceList) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateCompanyRequest) GetId() string {
+func (x *InvoiceList) GetCount() int32 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCompanyRequest) GetTitle() string {
+func (x *InvoiceList) GetItems() []*Invoice {
 	if x != nil {
-		return x.Title
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateCompanyRequest) GetAvatarUrl() string {
-	if x != nil {
-		return x.AvatarUrl
-	}
-	return ""
+type PromoCode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// either percent or amount
+	Percent float64 `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount  string  `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// any tariff when 0
+	TariffId      int32  `protobuf:"varint,4,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	ValidFrom     string `protobuf:"bytes,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidTo       string `protobuf:"bytes,6,opt,name=validTo,proto3" json:"validTo,omitempty"`
+	MaxUses       int32  `protobuf:"varint,7,opt,name=maxUses,proto3" json:"maxUses,omitempty"`
+	UsedCount     int32  `protobuf:"varint,8,opt,name=usedCount,proto3" json:"usedCount,omitempty"`
+	IsActive      bool   `protobuf:"varint,9,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyRequest) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
-	}
-	return ""
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_education_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyRequest) GetEndTime() string {
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[10]
 	if x != nil {
-		return x.EndTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateCompanyRequest) GetCompanyPhone() string {
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PromoCode) GetCode() string {
 	if x != nil {
-		return x.CompanyPhone
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetSubdomain() string {
+func (x *PromoCode) GetPercent() float64 {
 	if x != nil {
-		return x.Subdomain
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCompanyRequest) GetValidDate() string {
+func (x *PromoCode) GetAmount() string {
 	if x != nil {
-		return x.ValidDate
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetTariffId() int64 {
+func (x *PromoCode) GetTariffId() int32 {
 	if x != nil {
 		return x.TariffId
 	}
 	return 0
 }
 
-func (x *UpdateCompanyRequest) GetDiscountId() string {
+func (x *PromoCode) GetValidFrom() string {
 	if x != nil {
-		return x.DiscountId
+		return x.ValidFrom
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetIsDemo() bool {
+func (x *PromoCode) GetValidTo() string {
 	if x != nil {
-		return x.IsDemo
+		return x.ValidTo
+	}
+	return ""
+}
+
+func (x *PromoCode) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *PromoCode) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *PromoCode) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
 	}
 	return false
 }
 
-type GetAllResponse struct {
+type PromoCodeList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*GetCompanyResponse  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Items         []*PromoCode           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllResponse) Reset() {
-	*x = GetAllResponse{}
-	mi := &file_education_proto_msgTypes[10]
+func (x *PromoCodeList) Reset() {
+	*x = PromoCodeList{}
+	mi := &file_education_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllResponse) String() string {
+func (x *PromoCodeList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllResponse) ProtoMessage() {}
+func (*PromoCodeList) ProtoMessage() {}
 
-func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[10]
+func (x *PromoCodeList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,56 +1081,45 @@ func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllResponse.ProtoReflect.Descriptor instead.
-func (*GetAllResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PromoCodeList.ProtoReflect.Descriptor instead.
+func (*PromoCodeList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetAllResponse) GetItems() []*GetCompanyResponse {
+func (x *PromoCodeList) GetItems() []*PromoCode {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *GetAllResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-type CreateCompanyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
-	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	CompanyPhone  string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
-	Subdomain     string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
-	ValidDate     string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
-	TariffId      int64                  `protobuf:"varint,9,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
-	DiscountId    string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
-	IsDemo        bool                   `protobuf:"varint,1,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
+type PriceOverride struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// replaces the tariff's monthly price when set
+	MonthlyPrice  string  `protobuf:"bytes,2,opt,name=monthlyPrice,proto3" json:"monthlyPrice,omitempty"`
+	Percent       float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	ValidTo       string  `protobuf:"bytes,4,opt,name=validTo,proto3" json:"validTo,omitempty"`
+	Comment       string  `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyRequest) Reset() {
-	*x = CreateCompanyRequest{}
-	mi := &file_education_proto_msgTypes[11]
+func (x *PriceOverride) Reset() {
+	*x = PriceOverride{}
+	mi := &file_education_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyRequest) String() string {
+func (x *PriceOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompanyRequest) ProtoMessage() {}
+func (*PriceOverride) ProtoMessage() {}
 
-func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[11]
+func (x *PriceOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,104 +1130,159 @@ func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PriceOverride.ProtoReflect.Descriptor instead.
+func (*PriceOverride) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateCompanyRequest) GetTitle() string {
+func (x *PriceOverride) GetCompanyId() string {
 	if x != nil {
-		return x.Title
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetAvatarUrl() string {
+func (x *PriceOverride) GetMonthlyPrice() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.MonthlyPrice
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetStartTime() string {
+func (x *PriceOverride) GetPercent() float64 {
 	if x != nil {
-		return x.StartTime
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCompanyRequest) GetEndTime() string {
+func (x *PriceOverride) GetValidTo() string {
 	if x != nil {
-		return x.EndTime
+		return x.ValidTo
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetCompanyPhone() string {
+func (x *PriceOverride) GetComment() string {
 	if x != nil {
-		return x.CompanyPhone
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetSubdomain() string {
+type GetPriceOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceOverrideRequest) Reset() {
+	*x = GetPriceOverrideRequest{}
+	mi := &file_education_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceOverrideRequest) ProtoMessage() {}
+
+func (x *GetPriceOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[13]
 	if x != nil {
-		return x.Subdomain
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCompanyRequest) GetValidDate() string {
+// Deprecated: Use GetPriceOverrideRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPriceOverrideRequest) GetCompanyId() string {
 	if x != nil {
-		return x.ValidDate
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetTariffId() int64 {
-	if x != nil {
-		return x.TariffId
-	}
-	return 0
+type CheckSubdomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subdomain     string                 `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyRequest) GetDiscountId() string {
+func (x *CheckSubdomainRequest) Reset() {
+	*x = CheckSubdomainRequest{}
+	mi := &file_education_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSubdomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSubdomainRequest) ProtoMessage() {}
+
+func (x *CheckSubdomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[14]
 	if x != nil {
-		return x.DiscountId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCompanyRequest) GetIsDemo() bool {
+// Deprecated: Use CheckSubdomainRequest.ProtoReflect.Descriptor instead.
+func (*CheckSubdomainRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckSubdomainRequest) GetSubdomain() string {
 	if x != nil {
-		return x.IsDemo
+		return x.Subdomain
 	}
-	return false
+	return ""
 }
 
-type GetCompanyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+type CheckSubdomainResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Subdomain string                 `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	Available bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// why the subdomain can not be taken, empty when available
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyRequest) Reset() {
-	*x = GetCompanyRequest{}
-	mi := &file_education_proto_msgTypes[12]
+func (x *CheckSubdomainResponse) Reset() {
+	*x = CheckSubdomainResponse{}
+	mi := &file_education_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyRequest) String() string {
+func (x *CheckSubdomainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyRequest) ProtoMessage() {}
+func (*CheckSubdomainResponse) ProtoMessage() {}
 
-func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[12]
+func (x *CheckSubdomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,60 +1293,61 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CheckSubdomainResponse.ProtoReflect.Descriptor instead.
+func (*CheckSubdomainResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetCompanyRequest) GetDomain() string {
+func (x *CheckSubdomainResponse) GetSubdomain() string {
 	if x != nil {
-		return x.Domain
+		return x.Subdomain
 	}
 	return ""
 }
 
-func (x *GetCompanyRequest) GetId() string {
+func (x *CheckSubdomainResponse) GetAvailable() bool {
 	if x != nil {
-		return x.Id
+		return x.Available
+	}
+	return false
+}
+
+func (x *CheckSubdomainResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-type GetCompanyResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	AvatarUrl          string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
-	StartTime          string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime            string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	CompanyPhone       string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
-	Subdomain          string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
-	ValidDate          string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
-	Tariff             *Tariff                `protobuf:"bytes,9,opt,name=tariff,proto3" json:"tariff,omitempty"`
-	DiscountId         string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
-	CreatedAt          string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IsDemo             bool                   `protobuf:"varint,12,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
-	ActiveStudentCount int32                  `protobuf:"varint,13,opt,name=active_student_count,json=activeStudentCount,proto3" json:"active_student_count,omitempty"`
-	CeoId              string                 `protobuf:"bytes,14,opt,name=ceo_id,json=ceoId,proto3" json:"ceo_id,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type SignupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Subdomain     string                 `protobuf:"bytes,2,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	CompanyPhone  string                 `protobuf:"bytes,3,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	CeoName       string                 `protobuf:"bytes,6,opt,name=ceoName,proto3" json:"ceoName,omitempty"`
+	CeoPhone      string                 `protobuf:"bytes,7,opt,name=ceoPhone,proto3" json:"ceoPhone,omitempty"`
+	Password      string                 `protobuf:"bytes,8,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyResponse) Reset() {
-	*x = GetCompanyResponse{}
-	mi := &file_education_proto_msgTypes[13]
+func (x *SignupRequest) Reset() {
+	*x = SignupRequest{}
+	mi := &file_education_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyResponse) String() string {
+func (x *SignupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyResponse) ProtoMessage() {}
+func (*SignupRequest) ProtoMessage() {}
 
-func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[13]
+func (x *SignupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,137 +1358,165 @@ func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SignupRequest.ProtoReflect.Descriptor instead.
+func (*SignupRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetCompanyResponse) GetId() string {
+func (x *SignupRequest) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetTitle() string {
+func (x *SignupRequest) GetSubdomain() string {
 	if x != nil {
-		return x.Title
+		return x.Subdomain
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetAvatarUrl() string {
+func (x *SignupRequest) GetCompanyPhone() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.CompanyPhone
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetStartTime() string {
+func (x *SignupRequest) GetStartTime() string {
 	if x != nil {
 		return x.StartTime
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetEndTime() string {
+func (x *SignupRequest) GetEndTime() string {
 	if x != nil {
 		return x.EndTime
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetCompanyPhone() string {
+func (x *SignupRequest) GetCeoName() string {
 	if x != nil {
-		return x.CompanyPhone
+		return x.CeoName
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetSubdomain() string {
+func (x *SignupRequest) GetCeoPhone() string {
 	if x != nil {
-		return x.Subdomain
+		return x.CeoPhone
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetValidDate() string {
+func (x *SignupRequest) GetPassword() string {
 	if x != nil {
-		return x.ValidDate
+		return x.Password
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetTariff() *Tariff {
+type SignupResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	Subdomain string                 `protobuf:"bytes,2,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	CeoId     string                 `protobuf:"bytes,3,opt,name=ceoId,proto3" json:"ceoId,omitempty"`
+	// last day of the demo
+	ValidDate     string `protobuf:"bytes,4,opt,name=validDate,proto3" json:"validDate,omitempty"`
+	TariffId      int32  `protobuf:"varint,5,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignupResponse) Reset() {
+	*x = SignupResponse{}
+	mi := &file_education_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignupResponse) ProtoMessage() {}
+
+func (x *SignupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[17]
 	if x != nil {
-		return x.Tariff
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetCompanyResponse) GetDiscountId() string {
+// Deprecated: Use SignupResponse.ProtoReflect.Descriptor instead.
+func (*SignupResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SignupResponse) GetCompanyId() string {
 	if x != nil {
-		return x.DiscountId
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetCreatedAt() string {
+func (x *SignupResponse) GetSubdomain() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Subdomain
 	}
 	return ""
 }
 
-func (x *GetCompanyResponse) GetIsDemo() bool {
+func (x *SignupResponse) GetCeoId() string {
 	if x != nil {
-		return x.IsDemo
+		return x.CeoId
 	}
-	return false
+	return ""
 }
 
-func (x *GetCompanyResponse) GetActiveStudentCount() int32 {
+func (x *SignupResponse) GetValidDate() string {
 	if x != nil {
-		return x.ActiveStudentCount
+		return x.ValidDate
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCompanyResponse) GetCeoId() string {
+func (x *SignupResponse) GetTariffId() int32 {
 	if x != nil {
-		return x.CeoId
+		return x.TariffId
 	}
-	return ""
+	return 0
 }
 
-type Tariff struct {
+type GetSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	StudentCount  int32                  `protobuf:"varint,3,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
-	Sum           float32                `protobuf:"fixed32,4,opt,name=sum,proto3" json:"sum,omitempty"`
-	Discounts     string                 `protobuf:"bytes,5,opt,name=discounts,proto3" json:"discounts,omitempty"`
-	IsDeleted     bool                   `protobuf:"varint,6,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tariff) Reset() {
-	*x = Tariff{}
-	mi := &file_education_proto_msgTypes[14]
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_education_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tariff) String() string {
+func (x *GetSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tariff) ProtoMessage() {}
+func (*GetSubscriptionRequest) ProtoMessage() {}
 
-func (x *Tariff) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[14]
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,83 +1527,152 @@ func (x *Tariff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
-func (*Tariff) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Tariff) GetId() int32 {
+type CompanySubscription struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// ACTIVE, GRACE (read-only after valid_date) or LOCKED
+	State          string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ValidDate      string `protobuf:"bytes,3,opt,name=validDate,proto3" json:"validDate,omitempty"`
+	GraceUntil     string `protobuf:"bytes,4,opt,name=graceUntil,proto3" json:"graceUntil,omitempty"`
+	DaysLeft       int32  `protobuf:"varint,5,opt,name=daysLeft,proto3" json:"daysLeft,omitempty"`
+	IsDemo         bool   `protobuf:"varint,6,opt,name=isDemo,proto3" json:"isDemo,omitempty"`
+	TariffId       int32  `protobuf:"varint,7,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	TariffName     string `protobuf:"bytes,8,opt,name=tariffName,proto3" json:"tariffName,omitempty"`
+	StudentLimit   int32  `protobuf:"varint,9,opt,name=studentLimit,proto3" json:"studentLimit,omitempty"`
+	ActiveStudents int32  `protobuf:"varint,10,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompanySubscription) Reset() {
+	*x = CompanySubscription{}
+	mi := &file_education_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanySubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanySubscription) ProtoMessage() {}
+
+func (x *CompanySubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[19]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Tariff) GetName() string {
+// Deprecated: Use CompanySubscription.ProtoReflect.Descriptor instead.
+func (*CompanySubscription) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CompanySubscription) GetCompanyId() string {
 	if x != nil {
-		return x.Name
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *Tariff) GetStudentCount() int32 {
+func (x *CompanySubscription) GetState() string {
 	if x != nil {
-		return x.StudentCount
+		return x.State
 	}
-	return 0
+	return ""
 }
 
-func (x *Tariff) GetSum() float32 {
+func (x *CompanySubscription) GetValidDate() string {
 	if x != nil {
-		return x.Sum
+		return x.ValidDate
 	}
-	return 0
+	return ""
 }
 
-func (x *Tariff) GetDiscounts() string {
+func (x *CompanySubscription) GetGraceUntil() string {
 	if x != nil {
-		return x.Discounts
+		return x.GraceUntil
 	}
 	return ""
 }
 
-func (x *Tariff) GetIsDeleted() bool {
+func (x *CompanySubscription) GetDaysLeft() int32 {
 	if x != nil {
-		return x.IsDeleted
+		return x.DaysLeft
+	}
+	return 0
+}
+
+func (x *CompanySubscription) GetIsDemo() bool {
+	if x != nil {
+		return x.IsDemo
 	}
 	return false
 }
 
-func (x *Tariff) GetCreatedAt() string {
+func (x *CompanySubscription) GetTariffId() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TariffId
+	}
+	return 0
+}
+
+func (x *CompanySubscription) GetTariffName() string {
+	if x != nil {
+		return x.TariffName
 	}
 	return ""
 }
 
-type TariffList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Items         []*Tariff              `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CompanySubscription) GetStudentLimit() int32 {
+	if x != nil {
+		return x.StudentLimit
+	}
+	return 0
 }
 
-func (x *TariffList) Reset() {
-	*x = TariffList{}
-	mi := &file_education_proto_msgTypes[15]
+func (x *CompanySubscription) GetActiveStudents() int32 {
+	if x != nil {
+		return x.ActiveStudents
+	}
+	return 0
+}
+
+type GetStatisticResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Details         *CompanyCommonDetails  `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	RegisterDetails []*OtherDetails        `protobuf:"bytes,2,rep,name=registerDetails,proto3" json:"registerDetails,omitempty"`
+	PaymentDetails  []*OtherDetails        `protobuf:"bytes,3,rep,name=paymentDetails,proto3" json:"paymentDetails,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetStatisticResponse) Reset() {
+	*x = GetStatisticResponse{}
+	mi := &file_education_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TariffList) String() string {
+func (x *GetStatisticResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TariffList) ProtoMessage() {}
+func (*GetStatisticResponse) ProtoMessage() {}
 
-func (x *TariffList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[15]
+func (x *GetStatisticResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,48 +1683,54 @@ func (x *TariffList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TariffList.ProtoReflect.Descriptor instead.
-func (*TariffList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetStatisticResponse.ProtoReflect.Descriptor instead.
+func (*GetStatisticResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TariffList) GetCount() int32 {
+func (x *GetStatisticResponse) GetDetails() *CompanyCommonDetails {
 	if x != nil {
-		return x.Count
+		return x.Details
 	}
-	return 0
+	return nil
 }
 
-func (x *TariffList) GetItems() []*Tariff {
+func (x *GetStatisticResponse) GetRegisterDetails() []*OtherDetails {
 	if x != nil {
-		return x.Items
+		return x.RegisterDetails
 	}
 	return nil
 }
 
-type CreateRoomRequest struct {
+func (x *GetStatisticResponse) GetPaymentDetails() []*OtherDetails {
+	if x != nil {
+		return x.PaymentDetails
+	}
+	return nil
+}
+
+type OtherDetails struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoomRequest) Reset() {
-	*x = CreateRoomRequest{}
-	mi := &file_education_proto_msgTypes[16]
+func (x *OtherDetails) Reset() {
+	*x = OtherDetails{}
+	mi := &file_education_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoomRequest) String() string {
+func (x *OtherDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomRequest) ProtoMessage() {}
+func (*OtherDetails) ProtoMessage() {}
 
-func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[16]
+func (x *OtherDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,47 +1741,44 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *CreateRoomRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use OtherDetails.ProtoReflect.Descriptor instead.
+func (*OtherDetails) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateRoomRequest) GetCapacity() int32 {
+func (x *OtherDetails) GetDetails() map[string]string {
 	if x != nil {
-		return x.Capacity
+		return x.Details
 	}
-	return 0
+	return nil
 }
 
-type GetUpdateRoomAbs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rooms         []*AbsRoom             `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CompanyCommonDetails struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActiveStudents  int32                  `protobuf:"varint,1,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	WholeCompanies  int32                  `protobuf:"varint,2,opt,name=wholeCompanies,proto3" json:"wholeCompanies,omitempty"`
+	DebtorCompanies int32                  `protobuf:"varint,3,opt,name=debtorCompanies,proto3" json:"debtorCompanies,omitempty"`
+	ActiveCompanies int32                  `protobuf:"varint,4,opt,name=activeCompanies,proto3" json:"activeCompanies,omitempty"`
+	DemoCompanies   int32                  `protobuf:"varint,5,opt,name=demoCompanies,proto3" json:"demoCompanies,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetUpdateRoomAbs) Reset() {
-	*x = GetUpdateRoomAbs{}
-	mi := &file_education_proto_msgTypes[17]
+func (x *CompanyCommonDetails) Reset() {
+	*x = CompanyCommonDetails{}
+	mi := &file_education_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateRoomAbs) String() string {
+func (x *CompanyCommonDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateRoomAbs) ProtoMessage() {}
+func (*CompanyCommonDetails) ProtoMessage() {}
 
-func (x *GetUpdateRoomAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[17]
+func (x *CompanyCommonDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,42 +1789,69 @@ func (x *GetUpdateRoomAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateRoomAbs.ProtoReflect.Descriptor instead.
-func (*GetUpdateRoomAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CompanyCommonDetails.ProtoReflect.Descriptor instead.
+func (*CompanyCommonDetails) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetUpdateRoomAbs) GetRooms() []*AbsRoom {
+func (x *CompanyCommonDetails) GetActiveStudents() int32 {
 	if x != nil {
-		return x.Rooms
+		return x.ActiveStudents
 	}
-	return nil
+	return 0
 }
 
-type AbsRoom struct {
+func (x *CompanyCommonDetails) GetWholeCompanies() int32 {
+	if x != nil {
+		return x.WholeCompanies
+	}
+	return 0
+}
+
+func (x *CompanyCommonDetails) GetDebtorCompanies() int32 {
+	if x != nil {
+		return x.DebtorCompanies
+	}
+	return 0
+}
+
+func (x *CompanyCommonDetails) GetActiveCompanies() int32 {
+	if x != nil {
+		return x.ActiveCompanies
+	}
+	return 0
+}
+
+func (x *CompanyCommonDetails) GetDemoCompanies() int32 {
+	if x != nil {
+		return x.DemoCompanies
+	}
+	return 0
+}
+
+type GetStatisticRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsRoom) Reset() {
-	*x = AbsRoom{}
-	mi := &file_education_proto_msgTypes[18]
+func (x *GetStatisticRequest) Reset() {
+	*x = GetStatisticRequest{}
+	mi := &file_education_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsRoom) String() string {
+func (x *GetStatisticRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsRoom) ProtoMessage() {}
+func (*GetStatisticRequest) ProtoMessage() {}
 
-func (x *AbsRoom) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[18]
+func (x *GetStatisticRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1512,58 +1862,57 @@ func (x *AbsRoom) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsRoom.ProtoReflect.Descriptor instead.
-func (*AbsRoom) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetStatisticRequest.ProtoReflect.Descriptor instead.
+func (*GetStatisticRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AbsRoom) GetId() string {
+func (x *GetStatisticRequest) GetFrom() string {
 	if x != nil {
-		return x.Id
+		return x.From
 	}
 	return ""
 }
 
-func (x *AbsRoom) GetCapacity() int32 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
-}
-
-func (x *AbsRoom) GetName() string {
+func (x *GetStatisticRequest) GetTo() string {
 	if x != nil {
-		return x.Name
+		return x.To
 	}
 	return ""
 }
 
-type CreateCourseRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
-	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpdateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	CompanyPhone  string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
+	Subdomain     string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	ValidDate     string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
+	TariffId      int64                  `protobuf:"varint,9,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	DiscountId    string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	IsDemo        bool                   `protobuf:"varint,11,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCourseRequest) Reset() {
-	*x = CreateCourseRequest{}
-	mi := &file_education_proto_msgTypes[19]
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	mi := &file_education_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCourseRequest) String() string {
+func (x *UpdateCompanyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCourseRequest) ProtoMessage() {}
+func (*UpdateCompanyRequest) ProtoMessage() {}
 
-func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[19]
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1574,68 +1923,111 @@ func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
-func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateCourseRequest) GetName() string {
+func (x *UpdateCompanyRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateCourseRequest) GetLessonDuration() int32 {
+func (x *UpdateCompanyRequest) GetTitle() string {
 	if x != nil {
-		return x.LessonDuration
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCourseRequest) GetCourseDuration() int32 {
+func (x *UpdateCompanyRequest) GetAvatarUrl() string {
 	if x != nil {
-		return x.CourseDuration
+		return x.AvatarUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateCourseRequest) GetPrice() float64 {
+func (x *UpdateCompanyRequest) GetStartTime() string {
 	if x != nil {
-		return x.Price
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetCompanyPhone() string {
+	if x != nil {
+		return x.CompanyPhone
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetValidDate() string {
+	if x != nil {
+		return x.ValidDate
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetTariffId() int64 {
+	if x != nil {
+		return x.TariffId
 	}
 	return 0
 }
 
-func (x *CreateCourseRequest) GetDescription() string {
+func (x *UpdateCompanyRequest) GetDiscountId() string {
 	if x != nil {
-		return x.Description
+		return x.DiscountId
 	}
 	return ""
 }
 
-type GetUpdateCourseAbs struct {
+func (x *UpdateCompanyRequest) GetIsDemo() bool {
+	if x != nil {
+		return x.IsDemo
+	}
+	return false
+}
+
+type GetAllResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Courses       []*AbsCourse           `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Items         []*GetCompanyResponse  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateCourseAbs) Reset() {
-	*x = GetUpdateCourseAbs{}
-	mi := &file_education_proto_msgTypes[20]
+func (x *GetAllResponse) Reset() {
+	*x = GetAllResponse{}
+	mi := &file_education_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateCourseAbs) String() string {
+func (x *GetAllResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateCourseAbs) ProtoMessage() {}
+func (*GetAllResponse) ProtoMessage() {}
 
-func (x *GetUpdateCourseAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[20]
+func (x *GetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1646,45 +2038,56 @@ func (x *GetUpdateCourseAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateCourseAbs.ProtoReflect.Descriptor instead.
-func (*GetUpdateCourseAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetAllResponse.ProtoReflect.Descriptor instead.
+func (*GetAllResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetUpdateCourseAbs) GetCourses() []*AbsCourse {
+func (x *GetAllResponse) GetItems() []*GetCompanyResponse {
 	if x != nil {
-		return x.Courses
+		return x.Items
 	}
 	return nil
 }
 
-type AbsCourse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
-	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetAllResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
 }
 
-func (x *AbsCourse) Reset() {
-	*x = AbsCourse{}
-	mi := &file_education_proto_msgTypes[21]
+type CreateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	CompanyPhone  string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
+	Subdomain     string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	ValidDate     string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
+	TariffId      int64                  `protobuf:"varint,9,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	DiscountId    string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	IsDemo        bool                   `protobuf:"varint,1,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_education_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsCourse) String() string {
+func (x *CreateCompanyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsCourse) ProtoMessage() {}
+func (*CreateCompanyRequest) ProtoMessage() {}
 
-func (x *AbsCourse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[21]
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,167 +2098,104 @@ func (x *AbsCourse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsCourse.ProtoReflect.Descriptor instead.
-func (*AbsCourse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AbsCourse) GetId() string {
+func (x *CreateCompanyRequest) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
 	}
 	return ""
 }
 
-func (x *AbsCourse) GetName() string {
+func (x *CreateCompanyRequest) GetAvatarUrl() string {
 	if x != nil {
-		return x.Name
+		return x.AvatarUrl
 	}
 	return ""
 }
 
-func (x *AbsCourse) GetLessonDuration() int32 {
-	if x != nil {
-		return x.LessonDuration
-	}
-	return 0
-}
-
-func (x *AbsCourse) GetCourseDuration() int32 {
-	if x != nil {
-		return x.CourseDuration
-	}
-	return 0
-}
-
-func (x *AbsCourse) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *AbsCourse) GetDescription() string {
+func (x *CreateCompanyRequest) GetStartTime() string {
 	if x != nil {
-		return x.Description
+		return x.StartTime
 	}
 	return ""
 }
 
-type GetCourseByIdResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
-	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	StudentCount   int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetCourseByIdResponse) Reset() {
-	*x = GetCourseByIdResponse{}
-	mi := &file_education_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetCourseByIdResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetCourseByIdResponse) ProtoMessage() {}
-
-func (x *GetCourseByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[22]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCourseByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetCourseByIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *GetCourseByIdResponse) GetId() string {
+func (x *CreateCompanyRequest) GetEndTime() string {
 	if x != nil {
-		return x.Id
+		return x.EndTime
 	}
 	return ""
 }
 
-func (x *GetCourseByIdResponse) GetName() string {
+func (x *CreateCompanyRequest) GetCompanyPhone() string {
 	if x != nil {
-		return x.Name
+		return x.CompanyPhone
 	}
 	return ""
 }
 
-func (x *GetCourseByIdResponse) GetLessonDuration() int32 {
+func (x *CreateCompanyRequest) GetSubdomain() string {
 	if x != nil {
-		return x.LessonDuration
+		return x.Subdomain
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCourseByIdResponse) GetCourseDuration() int32 {
+func (x *CreateCompanyRequest) GetValidDate() string {
 	if x != nil {
-		return x.CourseDuration
+		return x.ValidDate
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCourseByIdResponse) GetPrice() float64 {
+func (x *CreateCompanyRequest) GetTariffId() int64 {
 	if x != nil {
-		return x.Price
+		return x.TariffId
 	}
 	return 0
 }
 
-func (x *GetCourseByIdResponse) GetDescription() string {
+func (x *CreateCompanyRequest) GetDiscountId() string {
 	if x != nil {
-		return x.Description
+		return x.DiscountId
 	}
 	return ""
 }
 
-func (x *GetCourseByIdResponse) GetStudentCount() int32 {
+func (x *CreateCompanyRequest) GetIsDemo() bool {
 	if x != nil {
-		return x.StudentCount
+		return x.IsDemo
 	}
-	return 0
+	return false
 }
 
-type GetCourseByIdRequest struct {
+type GetCompanyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCourseByIdRequest) Reset() {
-	*x = GetCourseByIdRequest{}
-	mi := &file_education_proto_msgTypes[23]
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_education_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCourseByIdRequest) String() string {
+func (x *GetCompanyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCourseByIdRequest) ProtoMessage() {}
+func (*GetCompanyRequest) ProtoMessage() {}
 
-func (x *GetCourseByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[23]
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1866,43 +2206,60 @@ func (x *GetCourseByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCourseByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetCourseByIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetCourseByIdRequest) GetId() string {
+func (x *GetCompanyRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *GetCompanyRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetLeftAfterTrialPeriodRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Page          string                 `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
-	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCompanyResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AvatarUrl          string                 `protobuf:"bytes,3,opt,name=avatarUrl,proto3" json:"avatarUrl,omitempty"`
+	StartTime          string                 `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime            string                 `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	CompanyPhone       string                 `protobuf:"bytes,6,opt,name=companyPhone,proto3" json:"companyPhone,omitempty"`
+	Subdomain          string                 `protobuf:"bytes,7,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	ValidDate          string                 `protobuf:"bytes,8,opt,name=valid_date,json=validDate,proto3" json:"valid_date,omitempty"`
+	Tariff             *Tariff                `protobuf:"bytes,9,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	DiscountId         string                 `protobuf:"bytes,10,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsDemo             bool                   `protobuf:"varint,12,opt,name=is_demo,json=isDemo,proto3" json:"is_demo,omitempty"`
+	ActiveStudentCount int32                  `protobuf:"varint,13,opt,name=active_student_count,json=activeStudentCount,proto3" json:"active_student_count,omitempty"`
+	CeoId              string                 `protobuf:"bytes,14,opt,name=ceo_id,json=ceoId,proto3" json:"ceo_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) Reset() {
-	*x = GetLeftAfterTrialPeriodRequest{}
-	mi := &file_education_proto_msgTypes[24]
+func (x *GetCompanyResponse) Reset() {
+	*x = GetCompanyResponse{}
+	mi := &file_education_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) String() string {
+func (x *GetCompanyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeftAfterTrialPeriodRequest) ProtoMessage() {}
+func (*GetCompanyResponse) ProtoMessage() {}
 
-func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[24]
+func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1913,121 +2270,139 @@ func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeftAfterTrialPeriodRequest.ProtoReflect.Descriptor instead.
-func (*GetLeftAfterTrialPeriodRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetFrom() string {
+func (x *GetCompanyResponse) GetId() string {
 	if x != nil {
-		return x.From
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetTo() string {
+func (x *GetCompanyResponse) GetTitle() string {
 	if x != nil {
-		return x.To
+		return x.Title
 	}
 	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetPage() string {
+func (x *GetCompanyResponse) GetAvatarUrl() string {
 	if x != nil {
-		return x.Page
+		return x.AvatarUrl
 	}
 	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetSize() string {
+func (x *GetCompanyResponse) GetStartTime() string {
 	if x != nil {
-		return x.Size
+		return x.StartTime
 	}
 	return ""
 }
 
-type GetLeftAfterTrialPeriodResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Items          []*AbsGetLeftAfter     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalItemCount int32                  `protobuf:"varint,2,opt,name=totalItemCount,proto3" json:"totalItemCount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetLeftAfterTrialPeriodResponse) Reset() {
-	*x = GetLeftAfterTrialPeriodResponse{}
-	mi := &file_education_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetCompanyResponse) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetCompanyResponse) GetCompanyPhone() string {
+	if x != nil {
+		return x.CompanyPhone
+	}
+	return ""
 }
 
-func (*GetLeftAfterTrialPeriodResponse) ProtoMessage() {}
-
-func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[25]
+func (x *GetCompanyResponse) GetSubdomain() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Subdomain
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetLeftAfterTrialPeriodResponse.ProtoReflect.Descriptor instead.
-func (*GetLeftAfterTrialPeriodResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{25}
+func (x *GetCompanyResponse) GetValidDate() string {
+	if x != nil {
+		return x.ValidDate
+	}
+	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) GetItems() []*AbsGetLeftAfter {
+func (x *GetCompanyResponse) GetTariff() *Tariff {
 	if x != nil {
-		return x.Items
+		return x.Tariff
 	}
 	return nil
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) GetTotalItemCount() int32 {
+func (x *GetCompanyResponse) GetDiscountId() string {
 	if x != nil {
-		return x.TotalItemCount
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *GetCompanyResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetCompanyResponse) GetIsDemo() bool {
+	if x != nil {
+		return x.IsDemo
+	}
+	return false
+}
+
+func (x *GetCompanyResponse) GetActiveStudentCount() int32 {
+	if x != nil {
+		return x.ActiveStudentCount
 	}
 	return 0
 }
 
-type AbsGetLeftAfter struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
-	StudentPhone   string                 `protobuf:"bytes,3,opt,name=studentPhone,proto3" json:"studentPhone,omitempty"`
-	StudentBalance string                 `protobuf:"bytes,4,opt,name=studentBalance,proto3" json:"studentBalance,omitempty"`
-	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	GroupName      string                 `protobuf:"bytes,6,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	ReturnMoney    bool                   `protobuf:"varint,7,opt,name=returnMoney,proto3" json:"returnMoney,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	SpecificDate   string                 `protobuf:"bytes,9,opt,name=specificDate,proto3" json:"specificDate,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCompanyResponse) GetCeoId() string {
+	if x != nil {
+		return x.CeoId
+	}
+	return ""
 }
 
-func (x *AbsGetLeftAfter) Reset() {
-	*x = AbsGetLeftAfter{}
-	mi := &file_education_proto_msgTypes[26]
+type Tariff struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StudentCount int32                  `protobuf:"varint,3,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+	Sum          string                 `protobuf:"bytes,4,opt,name=sum,proto3" json:"sum,omitempty"`
+	// legacy free-form discounts, prepay_discounts are what quotes use
+	Discounts       string            `protobuf:"bytes,5,opt,name=discounts,proto3" json:"discounts,omitempty"`
+	IsDeleted       bool              `protobuf:"varint,6,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	CreatedAt       string            `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PrepayDiscounts []*PrepayDiscount `protobuf:"bytes,8,rep,name=prepay_discounts,json=prepayDiscounts,proto3" json:"prepay_discounts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Tariff) Reset() {
+	*x = Tariff{}
+	mi := &file_education_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetLeftAfter) String() string {
+func (x *Tariff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetLeftAfter) ProtoMessage() {}
+func (*Tariff) ProtoMessage() {}
 
-func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[26]
+func (x *Tariff) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2038,96 +2413,91 @@ func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetLeftAfter.ProtoReflect.Descriptor instead.
-func (*AbsGetLeftAfter) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *AbsGetLeftAfter) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
+// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
+func (*Tariff) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AbsGetLeftAfter) GetStudentName() string {
+func (x *Tariff) GetId() int32 {
 	if x != nil {
-		return x.StudentName
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetLeftAfter) GetStudentPhone() string {
+func (x *Tariff) GetName() string {
 	if x != nil {
-		return x.StudentPhone
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetStudentBalance() string {
+func (x *Tariff) GetStudentCount() int32 {
 	if x != nil {
-		return x.StudentBalance
+		return x.StudentCount
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetLeftAfter) GetGroupId() string {
+func (x *Tariff) GetSum() string {
 	if x != nil {
-		return x.GroupId
+		return x.Sum
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetGroupName() string {
+func (x *Tariff) GetDiscounts() string {
 	if x != nil {
-		return x.GroupName
+		return x.Discounts
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetReturnMoney() bool {
+func (x *Tariff) GetIsDeleted() bool {
 	if x != nil {
-		return x.ReturnMoney
+		return x.IsDeleted
 	}
 	return false
 }
 
-func (x *AbsGetLeftAfter) GetCreatedAt() string {
+func (x *Tariff) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetSpecificDate() string {
+func (x *Tariff) GetPrepayDiscounts() []*PrepayDiscount {
 	if x != nil {
-		return x.SpecificDate
+		return x.PrepayDiscounts
 	}
-	return ""
+	return nil
 }
 
-type StudentIdRequest struct {
+// percent off when paying for at least months at once
+type PrepayDiscount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Months        int32                  `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	Percent       float64                `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentIdRequest) Reset() {
-	*x = StudentIdRequest{}
-	mi := &file_education_proto_msgTypes[27]
+func (x *PrepayDiscount) Reset() {
+	*x = PrepayDiscount{}
+	mi := &file_education_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentIdRequest) String() string {
+func (x *PrepayDiscount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentIdRequest) ProtoMessage() {}
+func (*PrepayDiscount) ProtoMessage() {}
 
-func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[27]
+func (x *PrepayDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2138,41 +2508,48 @@ func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentIdRequest.ProtoReflect.Descriptor instead.
-func (*StudentIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use PrepayDiscount.ProtoReflect.Descriptor instead.
+func (*PrepayDiscount) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *StudentIdRequest) GetStudentId() string {
+func (x *PrepayDiscount) GetMonths() int32 {
 	if x != nil {
-		return x.StudentId
+		return x.Months
 	}
-	return ""
+	return 0
 }
 
-type GetGroupsByStudentResponse struct {
+func (x *PrepayDiscount) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type TariffList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*DebtorComment       `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	Groups        []*DebtorGroup         `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*Tariff              `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByStudentResponse) Reset() {
-	*x = GetGroupsByStudentResponse{}
-	mi := &file_education_proto_msgTypes[28]
+func (x *TariffList) Reset() {
+	*x = TariffList{}
+	mi := &file_education_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByStudentResponse) String() string {
+func (x *TariffList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByStudentResponse) ProtoMessage() {}
+func (*TariffList) ProtoMessage() {}
 
-func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[28]
+func (x *TariffList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,48 +2560,48 @@ func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByStudentResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use TariffList.ProtoReflect.Descriptor instead.
+func (*TariffList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetGroupsByStudentResponse) GetComments() []*DebtorComment {
+func (x *TariffList) GetCount() int32 {
 	if x != nil {
-		return x.Comments
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupsByStudentResponse) GetGroups() []*DebtorGroup {
+func (x *TariffList) GetItems() []*Tariff {
 	if x != nil {
-		return x.Groups
+		return x.Items
 	}
 	return nil
 }
 
-type DebtorGroup struct {
+type CreateRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	GroupName     string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DebtorGroup) Reset() {
-	*x = DebtorGroup{}
-	mi := &file_education_proto_msgTypes[29]
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_education_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DebtorGroup) String() string {
+func (x *CreateRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebtorGroup) ProtoMessage() {}
+func (*CreateRoomRequest) ProtoMessage() {}
 
-func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[29]
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2235,48 +2612,47 @@ func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebtorGroup.ProtoReflect.Descriptor instead.
-func (*DebtorGroup) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DebtorGroup) GetGroupId() string {
+func (x *CreateRoomRequest) GetName() string {
 	if x != nil {
-		return x.GroupId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DebtorGroup) GetGroupName() string {
+func (x *CreateRoomRequest) GetCapacity() int32 {
 	if x != nil {
-		return x.GroupName
+		return x.Capacity
 	}
-	return ""
+	return 0
 }
 
-type DebtorComment struct {
+type GetUpdateRoomAbs struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     string                 `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId,omitempty"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Rooms         []*AbsRoom             `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DebtorComment) Reset() {
-	*x = DebtorComment{}
-	mi := &file_education_proto_msgTypes[30]
+func (x *GetUpdateRoomAbs) Reset() {
+	*x = GetUpdateRoomAbs{}
+	mi := &file_education_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DebtorComment) String() string {
+func (x *GetUpdateRoomAbs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebtorComment) ProtoMessage() {}
+func (*GetUpdateRoomAbs) ProtoMessage() {}
 
-func (x *DebtorComment) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[30]
+func (x *GetUpdateRoomAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,51 +2663,42 @@ func (x *DebtorComment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebtorComment.ProtoReflect.Descriptor instead.
-func (*DebtorComment) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetUpdateRoomAbs.ProtoReflect.Descriptor instead.
+func (*GetUpdateRoomAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DebtorComment) GetCommentId() string {
+func (x *GetUpdateRoomAbs) GetRooms() []*AbsRoom {
 	if x != nil {
-		return x.CommentId
+		return x.Rooms
 	}
-	return ""
+	return nil
 }
 
-func (x *DebtorComment) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
+type AbsRoom struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Capacity      int32                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type GetCommonInformationEducationResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ActiveStudentCount int32                  `protobuf:"varint,1,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
-	ActiveGroupCount   int32                  `protobuf:"varint,2,opt,name=activeGroupCount,proto3" json:"activeGroupCount,omitempty"`
-	LeaveGroupCount    int32                  `protobuf:"varint,3,opt,name=leaveGroupCount,proto3" json:"leaveGroupCount,omitempty"`
-	DebtorsCount       int32                  `protobuf:"varint,4,opt,name=debtorsCount,proto3" json:"debtorsCount,omitempty"`
-	EleminatedInTrial  int32                  `protobuf:"varint,5,opt,name=eleminatedInTrial,proto3" json:"eleminatedInTrial,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *GetCommonInformationEducationResponse) Reset() {
-	*x = GetCommonInformationEducationResponse{}
-	mi := &file_education_proto_msgTypes[31]
+func (x *AbsRoom) Reset() {
+	*x = AbsRoom{}
+	mi := &file_education_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommonInformationEducationResponse) String() string {
+func (x *AbsRoom) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommonInformationEducationResponse) ProtoMessage() {}
+func (*AbsRoom) ProtoMessage() {}
 
-func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[31]
+func (x *AbsRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,69 +2709,58 @@ func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommonInformationEducationResponse.ProtoReflect.Descriptor instead.
-func (*GetCommonInformationEducationResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *GetCommonInformationEducationResponse) GetActiveStudentCount() int32 {
-	if x != nil {
-		return x.ActiveStudentCount
-	}
-	return 0
-}
-
-func (x *GetCommonInformationEducationResponse) GetActiveGroupCount() int32 {
-	if x != nil {
-		return x.ActiveGroupCount
-	}
-	return 0
+// Deprecated: Use AbsRoom.ProtoReflect.Descriptor instead.
+func (*AbsRoom) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetCommonInformationEducationResponse) GetLeaveGroupCount() int32 {
+func (x *AbsRoom) GetId() string {
 	if x != nil {
-		return x.LeaveGroupCount
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCommonInformationEducationResponse) GetDebtorsCount() int32 {
+func (x *AbsRoom) GetCapacity() int32 {
 	if x != nil {
-		return x.DebtorsCount
+		return x.Capacity
 	}
 	return 0
 }
 
-func (x *GetCommonInformationEducationResponse) GetEleminatedInTrial() int32 {
+func (x *AbsRoom) GetName() string {
 	if x != nil {
-		return x.EleminatedInTrial
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type GetGroupsByTeacherIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	IsArchived    bool                   `protobuf:"varint,2,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateCourseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
+	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupsByTeacherIdRequest) Reset() {
-	*x = GetGroupsByTeacherIdRequest{}
-	mi := &file_education_proto_msgTypes[32]
+func (x *CreateCourseRequest) Reset() {
+	*x = CreateCourseRequest{}
+	mi := &file_education_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByTeacherIdRequest) String() string {
+func (x *CreateCourseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
+func (*CreateCourseRequest) ProtoMessage() {}
 
-func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[32]
+func (x *CreateCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,47 +2771,68 @@ func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CreateCourseRequest.ProtoReflect.Descriptor instead.
+func (*CreateCourseRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
+func (x *CreateCourseRequest) GetName() string {
 	if x != nil {
-		return x.TeacherId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetGroupsByTeacherIdRequest) GetIsArchived() bool {
+func (x *CreateCourseRequest) GetLessonDuration() int32 {
 	if x != nil {
-		return x.IsArchived
+		return x.LessonDuration
 	}
-	return false
+	return 0
 }
 
-type GetGroupsByTeacherResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Groups        []*GetGroupByTeacherAbs `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+func (x *CreateCourseRequest) GetCourseDuration() int32 {
+	if x != nil {
+		return x.CourseDuration
+	}
+	return 0
+}
+
+func (x *CreateCourseRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *CreateCourseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetUpdateCourseAbs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*AbsCourse           `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByTeacherResponse) Reset() {
-	*x = GetGroupsByTeacherResponse{}
-	mi := &file_education_proto_msgTypes[33]
+func (x *GetUpdateCourseAbs) Reset() {
+	*x = GetUpdateCourseAbs{}
+	mi := &file_education_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByTeacherResponse) String() string {
+func (x *GetUpdateCourseAbs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByTeacherResponse) ProtoMessage() {}
+func (*GetUpdateCourseAbs) ProtoMessage() {}
 
-func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[33]
+func (x *GetUpdateCourseAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,49 +2843,45 @@ func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GetUpdateCourseAbs.ProtoReflect.Descriptor instead.
+func (*GetUpdateCourseAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
+func (x *GetUpdateCourseAbs) GetCourses() []*AbsCourse {
 	if x != nil {
-		return x.Groups
+		return x.Courses
 	}
 	return nil
 }
 
-type GetGroupByTeacherAbs struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CourseName         string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName,omitempty"`
-	RoomName           string                 `protobuf:"bytes,3,opt,name=roomName,proto3" json:"roomName,omitempty"`
-	LessonStartTime    string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	DayType            string                 `protobuf:"bytes,5,opt,name=dayType,proto3" json:"dayType,omitempty"`
-	GroupStartAt       string                 `protobuf:"bytes,6,opt,name=groupStartAt,proto3" json:"groupStartAt,omitempty"`
-	GroupEndAt         string                 `protobuf:"bytes,7,opt,name=groupEndAt,proto3" json:"groupEndAt,omitempty"`
-	ActiveStudentCount int32                  `protobuf:"varint,8,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
-	Students           []*AbsStudent          `protobuf:"bytes,9,rep,name=students,proto3" json:"students,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type AbsCourse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
+	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupByTeacherAbs) Reset() {
-	*x = GetGroupByTeacherAbs{}
-	mi := &file_education_proto_msgTypes[34]
+func (x *AbsCourse) Reset() {
+	*x = AbsCourse{}
+	mi := &file_education_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByTeacherAbs) String() string {
+func (x *AbsCourse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByTeacherAbs) ProtoMessage() {}
+func (*AbsCourse) ProtoMessage() {}
 
-func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[34]
+func (x *AbsCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2519,213 +2892,167 @@ func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
-func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AbsCourse.ProtoReflect.Descriptor instead.
+func (*AbsCourse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetGroupByTeacherAbs) GetId() string {
+func (x *AbsCourse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetName() string {
+func (x *AbsCourse) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetCourseName() string {
+func (x *AbsCourse) GetLessonDuration() int32 {
 	if x != nil {
-		return x.CourseName
+		return x.LessonDuration
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByTeacherAbs) GetRoomName() string {
+func (x *AbsCourse) GetCourseDuration() int32 {
 	if x != nil {
-		return x.RoomName
+		return x.CourseDuration
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByTeacherAbs) GetLessonStartTime() string {
+func (x *AbsCourse) GetPrice() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Price
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetDayType() string {
+func (x *AbsCourse) GetDescription() string {
 	if x != nil {
-		return x.DayType
+		return x.Description
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetGroupStartAt() string {
-	if x != nil {
-		return x.GroupStartAt
-	}
-	return ""
+type GetCourseByIdResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
+	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	StudentCount   int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupByTeacherAbs) GetGroupEndAt() string {
-	if x != nil {
-		return x.GroupEndAt
-	}
-	return ""
+func (x *GetCourseByIdResponse) Reset() {
+	*x = GetCourseByIdResponse{}
+	mi := &file_education_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByTeacherAbs) GetActiveStudentCount() int32 {
-	if x != nil {
-		return x.ActiveStudentCount
-	}
-	return 0
+func (x *GetCourseByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetGroupByTeacherAbs) GetStudents() []*AbsStudent {
+func (*GetCourseByIdResponse) ProtoMessage() {}
+
+func (x *GetCourseByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[38]
 	if x != nil {
-		return x.Students
-	}
-	return nil
-}
-
-type CreateGroupRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
-	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
-	mi := &file_education_proto_msgTypes[35]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateGroupRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateGroupRequest) ProtoMessage() {}
-
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[35]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *CreateGroupRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateGroupRequest) GetCourseId() int32 {
-	if x != nil {
-		return x.CourseId
-	}
-	return 0
+// Deprecated: Use GetCourseByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseByIdResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateGroupRequest) GetTeacherId() string {
+func (x *GetCourseByIdResponse) GetId() string {
 	if x != nil {
-		return x.TeacherId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetType() string {
+func (x *GetCourseByIdResponse) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetDays() []string {
+func (x *GetCourseByIdResponse) GetLessonDuration() int32 {
 	if x != nil {
-		return x.Days
+		return x.LessonDuration
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateGroupRequest) GetRoomId() int32 {
+func (x *GetCourseByIdResponse) GetCourseDuration() int32 {
 	if x != nil {
-		return x.RoomId
+		return x.CourseDuration
 	}
 	return 0
 }
 
-func (x *CreateGroupRequest) GetLessonStartTime() string {
+func (x *GetCourseByIdResponse) GetPrice() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Price
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetGroupStartDate() string {
+func (x *GetCourseByIdResponse) GetDescription() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetGroupEndDate() string {
+func (x *GetCourseByIdResponse) GetStudentCount() int32 {
 	if x != nil {
-		return x.GroupEndDate
+		return x.StudentCount
 	}
-	return ""
+	return 0
 }
 
-type GetGroupByIdRequest struct {
+type GetCourseByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ActionRole    string                 `protobuf:"bytes,2,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
-	ActionId      string                 `protobuf:"bytes,3,opt,name=actionId,proto3" json:"actionId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByIdRequest) Reset() {
-	*x = GetGroupByIdRequest{}
-	mi := &file_education_proto_msgTypes[36]
+func (x *GetCourseByIdRequest) Reset() {
+	*x = GetCourseByIdRequest{}
+	mi := &file_education_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByIdRequest) String() string {
+func (x *GetCourseByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByIdRequest) ProtoMessage() {}
+func (*GetCourseByIdRequest) ProtoMessage() {}
 
-func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[36]
+func (x *GetCourseByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2736,63 +3063,43 @@ func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetCourseByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseByIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetGroupByIdRequest) GetId() string {
+func (x *GetCourseByIdRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGroupByIdRequest) GetActionRole() string {
-	if x != nil {
-		return x.ActionRole
-	}
-	return ""
-}
-
-func (x *GetGroupByIdRequest) GetActionId() string {
-	if x != nil {
-		return x.ActionId
-	}
-	return ""
-}
-
-type GetUpdateGroupAbs struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
-	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetLeftAfterTrialPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Page          string                 `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateGroupAbs) Reset() {
-	*x = GetUpdateGroupAbs{}
-	mi := &file_education_proto_msgTypes[37]
+func (x *GetLeftAfterTrialPeriodRequest) Reset() {
+	*x = GetLeftAfterTrialPeriodRequest{}
+	mi := &file_education_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateGroupAbs) String() string {
+func (x *GetLeftAfterTrialPeriodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateGroupAbs) ProtoMessage() {}
+func (*GetLeftAfterTrialPeriodRequest) ProtoMessage() {}
 
-func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[37]
+func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2803,103 +3110,62 @@ func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateGroupAbs.ProtoReflect.Descriptor instead.
-func (*GetUpdateGroupAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *GetUpdateGroupAbs) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GetUpdateGroupAbs) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GetUpdateGroupAbs) GetCourseId() int32 {
-	if x != nil {
-		return x.CourseId
-	}
-	return 0
-}
-
-func (x *GetUpdateGroupAbs) GetTeacherId() string {
-	if x != nil {
-		return x.TeacherId
-	}
-	return ""
+// Deprecated: Use GetLeftAfterTrialPeriodRequest.ProtoReflect.Descriptor instead.
+func (*GetLeftAfterTrialPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetUpdateGroupAbs) GetType() string {
+func (x *GetLeftAfterTrialPeriodRequest) GetFrom() string {
 	if x != nil {
-		return x.Type
+		return x.From
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetDays() []string {
-	if x != nil {
-		return x.Days
-	}
-	return nil
-}
-
-func (x *GetUpdateGroupAbs) GetRoomId() int32 {
-	if x != nil {
-		return x.RoomId
-	}
-	return 0
-}
-
-func (x *GetUpdateGroupAbs) GetLessonStartTime() string {
+func (x *GetLeftAfterTrialPeriodRequest) GetTo() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.To
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetGroupStartDate() string {
+func (x *GetLeftAfterTrialPeriodRequest) GetPage() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.Page
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetGroupEndDate() string {
+func (x *GetLeftAfterTrialPeriodRequest) GetSize() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.Size
 	}
 	return ""
 }
 
-type GetGroupsByCourseResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Groups        []*GetGroupByCourseAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetLeftAfterTrialPeriodResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Items          []*AbsGetLeftAfter     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalItemCount int32                  `protobuf:"varint,2,opt,name=totalItemCount,proto3" json:"totalItemCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetGroupsByCourseResponse) Reset() {
-	*x = GetGroupsByCourseResponse{}
-	mi := &file_education_proto_msgTypes[38]
+func (x *GetLeftAfterTrialPeriodResponse) Reset() {
+	*x = GetLeftAfterTrialPeriodResponse{}
+	mi := &file_education_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByCourseResponse) String() string {
+func (x *GetLeftAfterTrialPeriodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByCourseResponse) ProtoMessage() {}
+func (*GetLeftAfterTrialPeriodResponse) ProtoMessage() {}
 
-func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[38]
+func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2910,46 +3176,55 @@ func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByCourseResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByCourseResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetLeftAfterTrialPeriodResponse.ProtoReflect.Descriptor instead.
+func (*GetLeftAfterTrialPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetGroupsByCourseResponse) GetGroups() []*GetGroupByCourseAbsResponse {
+func (x *GetLeftAfterTrialPeriodResponse) GetItems() []*AbsGetLeftAfter {
 	if x != nil {
-		return x.Groups
+		return x.Items
 	}
 	return nil
 }
 
-type GetGroupByCourseAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TeacherName     string                 `protobuf:"bytes,2,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	GroupStartDate  string                 `protobuf:"bytes,3,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate    string                 `protobuf:"bytes,4,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	DateType        string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupName       string                 `protobuf:"bytes,7,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetLeftAfterTrialPeriodResponse) GetTotalItemCount() int32 {
+	if x != nil {
+		return x.TotalItemCount
+	}
+	return 0
 }
 
-func (x *GetGroupByCourseAbsResponse) Reset() {
-	*x = GetGroupByCourseAbsResponse{}
-	mi := &file_education_proto_msgTypes[39]
+type AbsGetLeftAfter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	StudentPhone   string                 `protobuf:"bytes,3,opt,name=studentPhone,proto3" json:"studentPhone,omitempty"`
+	StudentBalance string                 `protobuf:"bytes,4,opt,name=studentBalance,proto3" json:"studentBalance,omitempty"`
+	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName      string                 `protobuf:"bytes,6,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	ReturnMoney    bool                   `protobuf:"varint,7,opt,name=returnMoney,proto3" json:"returnMoney,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	SpecificDate   string                 `protobuf:"bytes,9,opt,name=specificDate,proto3" json:"specificDate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsGetLeftAfter) Reset() {
+	*x = AbsGetLeftAfter{}
+	mi := &file_education_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByCourseAbsResponse) String() string {
+func (x *AbsGetLeftAfter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByCourseAbsResponse) ProtoMessage() {}
+func (*AbsGetLeftAfter) ProtoMessage() {}
 
-func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[39]
+func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2960,95 +3235,96 @@ func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByCourseAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupByCourseAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use AbsGetLeftAfter.ProtoReflect.Descriptor instead.
+func (*AbsGetLeftAfter) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetGroupByCourseAbsResponse) GetId() string {
+func (x *AbsGetLeftAfter) GetStudentId() string {
 	if x != nil {
-		return x.Id
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetTeacherName() string {
+func (x *AbsGetLeftAfter) GetStudentName() string {
 	if x != nil {
-		return x.TeacherName
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupStartDate() string {
+func (x *AbsGetLeftAfter) GetStudentPhone() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.StudentPhone
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupEndDate() string {
+func (x *AbsGetLeftAfter) GetStudentBalance() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.StudentBalance
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetDateType() string {
+func (x *AbsGetLeftAfter) GetGroupId() string {
 	if x != nil {
-		return x.DateType
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetLessonStartTime() string {
+func (x *AbsGetLeftAfter) GetGroupName() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupName() string {
+func (x *AbsGetLeftAfter) GetReturnMoney() bool {
 	if x != nil {
-		return x.GroupName
+		return x.ReturnMoney
+	}
+	return false
+}
+
+func (x *AbsGetLeftAfter) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type GetGroupAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Course          *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
-	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	Room            *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
-	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
-	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days,omitempty"`
-	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *AbsGetLeftAfter) GetSpecificDate() string {
+	if x != nil {
+		return x.SpecificDate
+	}
+	return ""
 }
 
-func (x *GetGroupAbsResponse) Reset() {
-	*x = GetGroupAbsResponse{}
-	mi := &file_education_proto_msgTypes[40]
+type StudentIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentIdRequest) Reset() {
+	*x = StudentIdRequest{}
+	mi := &file_education_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) String() string {
+func (x *StudentIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupAbsResponse) ProtoMessage() {}
+func (*StudentIdRequest) ProtoMessage() {}
 
-func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[40]
+func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3059,132 +3335,145 @@ func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use StudentIdRequest.ProtoReflect.Descriptor instead.
+func (*StudentIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetGroupAbsResponse) GetId() string {
+func (x *StudentIdRequest) GetStudentId() string {
 	if x != nil {
-		return x.Id
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetCourse() *AbsCourse {
-	if x != nil {
-		return x.Course
-	}
-	return nil
+type GetGroupsByStudentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*DebtorComment       `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Groups        []*DebtorGroup         `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) GetTeacherName() string {
-	if x != nil {
-		return x.TeacherName
-	}
-	return ""
+func (x *GetGroupsByStudentResponse) Reset() {
+	*x = GetGroupsByStudentResponse{}
+	mi := &file_education_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) GetTeacherId() string {
-	if x != nil {
-		return x.TeacherId
-	}
-	return ""
+func (x *GetGroupsByStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetLessonStartTime() string {
+func (*GetGroupsByStudentResponse) ProtoMessage() {}
+
+func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[44]
 	if x != nil {
-		return x.LessonStartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetRoom() *AbsRoom {
+// Deprecated: Use GetGroupsByStudentResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetGroupsByStudentResponse) GetComments() []*DebtorComment {
 	if x != nil {
-		return x.Room
+		return x.Comments
 	}
 	return nil
 }
 
-func (x *GetGroupAbsResponse) GetStudentCount() int32 {
+func (x *GetGroupsByStudentResponse) GetGroups() []*DebtorGroup {
 	if x != nil {
-		return x.StudentCount
+		return x.Groups
 	}
-	return 0
+	return nil
 }
 
-func (x *GetGroupAbsResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type DebtorGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) GetIsArchived() bool {
-	if x != nil {
-		return x.IsArchived
-	}
-	return false
+func (x *DebtorGroup) Reset() {
+	*x = DebtorGroup{}
+	mi := &file_education_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) GetCreatedAt() string {
+func (x *DebtorGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtorGroup) ProtoMessage() {}
+
+func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[45]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetStartDate() string {
+// Deprecated: Use DebtorGroup.ProtoReflect.Descriptor instead.
+func (*DebtorGroup) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DebtorGroup) GetGroupId() string {
 	if x != nil {
-		return x.StartDate
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetEndDate() string {
+func (x *DebtorGroup) GetGroupName() string {
 	if x != nil {
-		return x.EndDate
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetDays() []string {
-	if x != nil {
-		return x.Days
-	}
-	return nil
-}
-
-func (x *GetGroupAbsResponse) GetDateType() string {
-	if x != nil {
-		return x.DateType
-	}
-	return ""
-}
-
-type GetGroupsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Groups         []*GetGroupAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DebtorComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsResponse) Reset() {
-	*x = GetGroupsResponse{}
-	mi := &file_education_proto_msgTypes[41]
+func (x *DebtorComment) Reset() {
+	*x = DebtorComment{}
+	mi := &file_education_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsResponse) String() string {
+func (x *DebtorComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsResponse) ProtoMessage() {}
+func (*DebtorComment) ProtoMessage() {}
 
-func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[41]
+func (x *DebtorComment) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3195,55 +3484,51 @@ func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DebtorComment.ProtoReflect.Descriptor instead.
+func (*DebtorComment) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetGroupsResponse) GetGroups() []*GetGroupAbsResponse {
+func (x *DebtorComment) GetCommentId() string {
 	if x != nil {
-		return x.Groups
+		return x.CommentId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupsResponse) GetTotalPageCount() int32 {
+func (x *DebtorComment) GetComment() string {
 	if x != nil {
-		return x.TotalPageCount
+		return x.Comment
 	}
-	return 0
+	return ""
 }
 
-type GetGroupsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IsArchived     bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
-	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacherId,proto3,oneof" json:"teacherId,omitempty"`
-	CourseId       *int32                 `protobuf:"varint,3,opt,name=courseId,proto3,oneof" json:"courseId,omitempty"`
-	DateType       *string                `protobuf:"bytes,4,opt,name=dateType,proto3,oneof" json:"dateType,omitempty"`
-	StartDate      *string                `protobuf:"bytes,5,opt,name=startDate,proto3,oneof" json:"startDate,omitempty"`
-	EndDate        *string                `protobuf:"bytes,6,opt,name=endDate,proto3,oneof" json:"endDate,omitempty"`
-	Page           *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`
-	OrderBy        string                 `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
-	OrderDirection string                 `protobuf:"bytes,9,opt,name=orderDirection,proto3" json:"orderDirection,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetCommonInformationEducationResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ActiveStudentCount int32                  `protobuf:"varint,1,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
+	ActiveGroupCount   int32                  `protobuf:"varint,2,opt,name=activeGroupCount,proto3" json:"activeGroupCount,omitempty"`
+	LeaveGroupCount    int32                  `protobuf:"varint,3,opt,name=leaveGroupCount,proto3" json:"leaveGroupCount,omitempty"`
+	DebtorsCount       int32                  `protobuf:"varint,4,opt,name=debtorsCount,proto3" json:"debtorsCount,omitempty"`
+	EleminatedInTrial  int32                  `protobuf:"varint,5,opt,name=eleminatedInTrial,proto3" json:"eleminatedInTrial,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetGroupsRequest) Reset() {
-	*x = GetGroupsRequest{}
-	mi := &file_education_proto_msgTypes[42]
+func (x *GetCommonInformationEducationResponse) Reset() {
+	*x = GetCommonInformationEducationResponse{}
+	mi := &file_education_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsRequest) String() string {
+func (x *GetCommonInformationEducationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsRequest) ProtoMessage() {}
+func (*GetCommonInformationEducationResponse) ProtoMessage() {}
 
-func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[42]
+func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3254,98 +3539,69 @@ func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetCommonInformationEducationResponse.ProtoReflect.Descriptor instead.
+func (*GetCommonInformationEducationResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetGroupsRequest) GetIsArchived() bool {
+func (x *GetCommonInformationEducationResponse) GetActiveStudentCount() int32 {
 	if x != nil {
-		return x.IsArchived
-	}
-	return false
-}
-
-func (x *GetGroupsRequest) GetTeacherId() string {
-	if x != nil && x.TeacherId != nil {
-		return *x.TeacherId
-	}
-	return ""
-}
-
-func (x *GetGroupsRequest) GetCourseId() int32 {
-	if x != nil && x.CourseId != nil {
-		return *x.CourseId
+		return x.ActiveStudentCount
 	}
 	return 0
 }
 
-func (x *GetGroupsRequest) GetDateType() string {
-	if x != nil && x.DateType != nil {
-		return *x.DateType
-	}
-	return ""
-}
-
-func (x *GetGroupsRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
-	}
-	return ""
-}
-
-func (x *GetGroupsRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *GetCommonInformationEducationResponse) GetActiveGroupCount() int32 {
+	if x != nil {
+		return x.ActiveGroupCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupsRequest) GetPage() *PageRequest {
+func (x *GetCommonInformationEducationResponse) GetLeaveGroupCount() int32 {
 	if x != nil {
-		return x.Page
+		return x.LeaveGroupCount
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupsRequest) GetOrderBy() string {
+func (x *GetCommonInformationEducationResponse) GetDebtorsCount() int32 {
 	if x != nil {
-		return x.OrderBy
+		return x.DebtorsCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupsRequest) GetOrderDirection() string {
+func (x *GetCommonInformationEducationResponse) GetEleminatedInTrial() int32 {
 	if x != nil {
-		return x.OrderDirection
+		return x.EleminatedInTrial
 	}
-	return ""
+	return 0
 }
 
-type CalculateTeacherSalaryRequest struct {
+type GetGroupsByTeacherIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	IsArchived    bool                   `protobuf:"varint,2,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateTeacherSalaryRequest) Reset() {
-	*x = CalculateTeacherSalaryRequest{}
-	mi := &file_education_proto_msgTypes[43]
+func (x *GetGroupsByTeacherIdRequest) Reset() {
+	*x = GetGroupsByTeacherIdRequest{}
+	mi := &file_education_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTeacherSalaryRequest) String() string {
+func (x *GetGroupsByTeacherIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
+func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
 
-func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[43]
+func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3356,54 +3612,47 @@ func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *CalculateTeacherSalaryRequest) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+// Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CalculateTeacherSalaryRequest) GetTo() string {
+func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
 	if x != nil {
-		return x.To
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
+func (x *GetGroupsByTeacherIdRequest) GetIsArchived() bool {
 	if x != nil {
-		return x.TeacherId
+		return x.IsArchived
 	}
-	return ""
+	return false
 }
 
-type CalculateTeacherSalaryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries,omitempty"`
+type GetGroupsByTeacherResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Groups        []*GetGroupByTeacherAbs `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateTeacherSalaryResponse) Reset() {
-	*x = CalculateTeacherSalaryResponse{}
-	mi := &file_education_proto_msgTypes[44]
+func (x *GetGroupsByTeacherResponse) Reset() {
+	*x = GetGroupsByTeacherResponse{}
+	mi := &file_education_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTeacherSalaryResponse) String() string {
+func (x *GetGroupsByTeacherResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
+func (*GetGroupsByTeacherResponse) ProtoMessage() {}
 
-func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[44]
+func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,43 +3663,49 @@ func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
-func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
+func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
 	if x != nil {
-		return x.Salaries
+		return x.Groups
 	}
 	return nil
 }
 
-type AbsCalculateSalary struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod,omitempty"`
-	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type GetGroupByTeacherAbs struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseName         string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	RoomName           string                 `protobuf:"bytes,3,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	DayType            string                 `protobuf:"bytes,5,opt,name=dayType,proto3" json:"dayType,omitempty"`
+	GroupStartAt       string                 `protobuf:"bytes,6,opt,name=groupStartAt,proto3" json:"groupStartAt,omitempty"`
+	GroupEndAt         string                 `protobuf:"bytes,7,opt,name=groupEndAt,proto3" json:"groupEndAt,omitempty"`
+	ActiveStudentCount int32                  `protobuf:"varint,8,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
+	Students           []*AbsStudent          `protobuf:"bytes,9,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *AbsCalculateSalary) Reset() {
-	*x = AbsCalculateSalary{}
-	mi := &file_education_proto_msgTypes[45]
+func (x *GetGroupByTeacherAbs) Reset() {
+	*x = GetGroupByTeacherAbs{}
+	mi := &file_education_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsCalculateSalary) String() string {
+func (x *GetGroupByTeacherAbs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsCalculateSalary) ProtoMessage() {}
+func (*GetGroupByTeacherAbs) ProtoMessage() {}
 
-func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[45]
+func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3461,158 +3716,113 @@ func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
-func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
+func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AbsCalculateSalary) GetGroupId() string {
+func (x *GetGroupByTeacherAbs) GetId() string {
 	if x != nil {
-		return x.GroupId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsCalculateSalary) GetGroupName() string {
+func (x *GetGroupByTeacherAbs) GetName() string {
 	if x != nil {
-		return x.GroupName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
-	if x != nil {
-		return x.CommonLessonCountInPeriod
-	}
-	return 0
-}
-
-func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
-	if x != nil {
-		return x.Salaries
-	}
-	return nil
-}
-
-type StudentSalary struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	StudentId                string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	StudentName              string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
-	PassedLessonCount        int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount,omitempty"`
-	CalculatedSalaryInPeriod int32                  `protobuf:"varint,4,opt,name=calculatedSalaryInPeriod,proto3" json:"calculatedSalaryInPeriod,omitempty"`
-	PriceType                string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType,omitempty"`
-	TotalCount               float64                `protobuf:"fixed64,6,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
-	CoursePrice              float64                `protobuf:"fixed64,7,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
-}
-
-func (x *StudentSalary) Reset() {
-	*x = StudentSalary{}
-	mi := &file_education_proto_msgTypes[46]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *StudentSalary) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StudentSalary) ProtoMessage() {}
-
-func (x *StudentSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[46]
+func (x *GetGroupByTeacherAbs) GetCourseName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CourseName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
-func (*StudentSalary) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{46}
+	return ""
 }
 
-func (x *StudentSalary) GetStudentId() string {
+func (x *GetGroupByTeacherAbs) GetRoomName() string {
 	if x != nil {
-		return x.StudentId
+		return x.RoomName
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetStudentName() string {
+func (x *GetGroupByTeacherAbs) GetLessonStartTime() string {
 	if x != nil {
-		return x.StudentName
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetPassedLessonCount() int32 {
+func (x *GetGroupByTeacherAbs) GetDayType() string {
 	if x != nil {
-		return x.PassedLessonCount
+		return x.DayType
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentSalary) GetCalculatedSalaryInPeriod() int32 {
+func (x *GetGroupByTeacherAbs) GetGroupStartAt() string {
 	if x != nil {
-		return x.CalculatedSalaryInPeriod
+		return x.GroupStartAt
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentSalary) GetPriceType() string {
+func (x *GetGroupByTeacherAbs) GetGroupEndAt() string {
 	if x != nil {
-		return x.PriceType
+		return x.GroupEndAt
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetTotalCount() float64 {
+func (x *GetGroupByTeacherAbs) GetActiveStudentCount() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.ActiveStudentCount
 	}
 	return 0
 }
 
-func (x *StudentSalary) GetCoursePrice() float64 {
+func (x *GetGroupByTeacherAbs) GetStudents() []*AbsStudent {
 	if x != nil {
-		return x.CoursePrice
+		return x.Students
 	}
-	return 0
+	return nil
 }
 
-type GetAttendanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till,omitempty"`
-	WithOutdated  bool                   `protobuf:"varint,4,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
-	ActionId      string                 `protobuf:"bytes,5,opt,name=actionId,proto3" json:"actionId,omitempty"`
-	ActionRole    string                 `protobuf:"bytes,6,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
+type CreateGroupRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	// a retried request with the same key gets the group created the first time
+	RequestKey    string `protobuf:"bytes,10,opt,name=requestKey,proto3" json:"requestKey,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAttendanceRequest) Reset() {
-	*x = GetAttendanceRequest{}
-	mi := &file_education_proto_msgTypes[47]
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_education_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttendanceRequest) String() string {
+func (x *CreateGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttendanceRequest) ProtoMessage() {}
+func (*CreateGroupRequest) ProtoMessage() {}
 
-func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[47]
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3623,128 +3833,105 @@ func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetAttendanceRequest) GetGroupId() string {
+func (x *CreateGroupRequest) GetName() string {
 	if x != nil {
-		return x.GroupId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetFrom() string {
+func (x *CreateGroupRequest) GetCourseId() int32 {
 	if x != nil {
-		return x.From
+		return x.CourseId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAttendanceRequest) GetTill() string {
+func (x *CreateGroupRequest) GetTeacherId() string {
 	if x != nil {
-		return x.Till
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetWithOutdated() bool {
+func (x *CreateGroupRequest) GetType() string {
 	if x != nil {
-		return x.WithOutdated
+		return x.Type
 	}
-	return false
+	return ""
 }
 
-func (x *GetAttendanceRequest) GetActionId() string {
+func (x *CreateGroupRequest) GetDays() []string {
 	if x != nil {
-		return x.ActionId
+		return x.Days
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAttendanceRequest) GetActionRole() string {
+func (x *CreateGroupRequest) GetRoomId() int32 {
 	if x != nil {
-		return x.ActionRole
+		return x.RoomId
 	}
-	return ""
-}
-
-type GetAttendanceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          []*Day                 `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
-	Students      []*Student             `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetAttendanceResponse) Reset() {
-	*x = GetAttendanceResponse{}
-	mi := &file_education_proto_msgTypes[48]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetAttendanceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GetAttendanceResponse) ProtoMessage() {}
-
-func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[48]
+func (x *CreateGroupRequest) GetLessonStartTime() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LessonStartTime
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAttendanceResponse.ProtoReflect.Descriptor instead.
-func (*GetAttendanceResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{48}
+func (x *CreateGroupRequest) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
 }
 
-func (x *GetAttendanceResponse) GetDays() []*Day {
+func (x *CreateGroupRequest) GetGroupEndDate() string {
 	if x != nil {
-		return x.Days
+		return x.GroupEndDate
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAttendanceResponse) GetStudents() []*Student {
+func (x *CreateGroupRequest) GetRequestKey() string {
 	if x != nil {
-		return x.Students
+		return x.RequestKey
 	}
-	return nil
+	return ""
 }
 
-type Day struct {
+type GetGroupByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	TransferDate  string                 `protobuf:"bytes,2,opt,name=transferDate,proto3" json:"transferDate,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionRole    string                 `protobuf:"bytes,2,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
+	ActionId      string                 `protobuf:"bytes,3,opt,name=actionId,proto3" json:"actionId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Day) Reset() {
-	*x = Day{}
-	mi := &file_education_proto_msgTypes[49]
+func (x *GetGroupByIdRequest) Reset() {
+	*x = GetGroupByIdRequest{}
+	mi := &file_education_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Day) String() string {
+func (x *GetGroupByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Day) ProtoMessage() {}
+func (*GetGroupByIdRequest) ProtoMessage() {}
 
-func (x *Day) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[49]
+func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3755,58 +3942,63 @@ func (x *Day) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Day.ProtoReflect.Descriptor instead.
-func (*Day) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Day) GetDate() string {
+func (x *GetGroupByIdRequest) GetId() string {
 	if x != nil {
-		return x.Date
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Day) GetTransferDate() string {
+func (x *GetGroupByIdRequest) GetActionRole() string {
 	if x != nil {
-		return x.TransferDate
+		return x.ActionRole
 	}
 	return ""
 }
 
-type Student struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	ActivatedAt   string                 `protobuf:"bytes,1,opt,name=activatedAt,proto3" json:"activatedAt,omitempty"`
-	AddedAt       string                 `protobuf:"bytes,2,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
-	Attendance    []*Attendance          `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance,omitempty"`
-	Balance       float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	FreezeDetail  *FreezeDetail          `protobuf:"bytes,7,opt,name=freezeDetail,proto3" json:"freezeDetail,omitempty"`
-	Gender        bool                   `protobuf:"varint,8,opt,name=gender,proto3" json:"gender,omitempty"`
-	Condition     string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`
-	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetGroupByIdRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
 }
 
-func (x *Student) Reset() {
-	*x = Student{}
-	mi := &file_education_proto_msgTypes[50]
+type GetUpdateGroupAbs struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUpdateGroupAbs) Reset() {
+	*x = GetUpdateGroupAbs{}
+	mi := &file_education_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Student) String() string {
+func (x *GetUpdateGroupAbs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Student) ProtoMessage() {}
+func (*GetUpdateGroupAbs) ProtoMessage() {}
 
-func (x *Student) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[50]
+func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3817,121 +4009,103 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Student.ProtoReflect.Descriptor instead.
-func (*Student) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetUpdateGroupAbs.ProtoReflect.Descriptor instead.
+func (*GetUpdateGroupAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Student) GetId() string {
+func (x *GetUpdateGroupAbs) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Student) GetActivatedAt() string {
-	if x != nil {
-		return x.ActivatedAt
-	}
-	return ""
-}
-
-func (x *Student) GetAddedAt() string {
+func (x *GetUpdateGroupAbs) GetName() string {
 	if x != nil {
-		return x.AddedAt
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Student) GetAttendance() []*Attendance {
-	if x != nil {
-		return x.Attendance
-	}
-	return nil
-}
-
-func (x *Student) GetBalance() float64 {
+func (x *GetUpdateGroupAbs) GetCourseId() int32 {
 	if x != nil {
-		return x.Balance
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *Student) GetCreatedAt() string {
+func (x *GetUpdateGroupAbs) GetTeacherId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *Student) GetDateOfBirth() string {
+func (x *GetUpdateGroupAbs) GetType() string {
 	if x != nil {
-		return x.DateOfBirth
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Student) GetFreezeDetail() *FreezeDetail {
+func (x *GetUpdateGroupAbs) GetDays() []string {
 	if x != nil {
-		return x.FreezeDetail
+		return x.Days
 	}
 	return nil
 }
 
-func (x *Student) GetGender() bool {
+func (x *GetUpdateGroupAbs) GetRoomId() int32 {
 	if x != nil {
-		return x.Gender
+		return x.RoomId
 	}
-	return false
+	return 0
 }
 
-func (x *Student) GetCondition() string {
+func (x *GetUpdateGroupAbs) GetLessonStartTime() string {
 	if x != nil {
-		return x.Condition
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *Student) GetName() string {
+func (x *GetUpdateGroupAbs) GetGroupStartDate() string {
 	if x != nil {
-		return x.Name
+		return x.GroupStartDate
 	}
 	return ""
 }
 
-func (x *Student) GetPhone() string {
+func (x *GetUpdateGroupAbs) GetGroupEndDate() string {
 	if x != nil {
-		return x.Phone
+		return x.GroupEndDate
 	}
 	return ""
 }
 
-type Attendance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AttendDate    string                 `protobuf:"bytes,2,opt,name=attend_date,json=attendDate,proto3" json:"attend_date,omitempty"`
-	IsCome        bool                   `protobuf:"varint,3,opt,name=isCome,proto3" json:"isCome,omitempty"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+type GetGroupsByCourseResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Groups        []*GetGroupByCourseAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Attendance) Reset() {
-	*x = Attendance{}
-	mi := &file_education_proto_msgTypes[51]
+func (x *GetGroupsByCourseResponse) Reset() {
+	*x = GetGroupsByCourseResponse{}
+	mi := &file_education_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Attendance) String() string {
+func (x *GetGroupsByCourseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attendance) ProtoMessage() {}
+func (*GetGroupsByCourseResponse) ProtoMessage() {}
 
-func (x *Attendance) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[51]
+func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3942,69 +4116,46 @@ func (x *Attendance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
-func (*Attendance) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *Attendance) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Attendance) GetAttendDate() string {
-	if x != nil {
-		return x.AttendDate
-	}
-	return ""
-}
-
-func (x *Attendance) GetIsCome() bool {
-	if x != nil {
-		return x.IsCome
-	}
-	return false
-}
-
-func (x *Attendance) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
+// Deprecated: Use GetGroupsByCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByCourseResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Attendance) GetTeacherId() string {
+func (x *GetGroupsByCourseResponse) GetGroups() []*GetGroupByCourseAbsResponse {
 	if x != nil {
-		return x.TeacherId
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type FreezeDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
-	TillDate      string                 `protobuf:"bytes,2,opt,name=till_date,json=tillDate,proto3" json:"till_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetGroupByCourseAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherName     string                 `protobuf:"bytes,2,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,3,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,4,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	DateType        string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupName       string                 `protobuf:"bytes,7,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *FreezeDetail) Reset() {
-	*x = FreezeDetail{}
-	mi := &file_education_proto_msgTypes[52]
+func (x *GetGroupByCourseAbsResponse) Reset() {
+	*x = GetGroupByCourseAbsResponse{}
+	mi := &file_education_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FreezeDetail) String() string {
+func (x *GetGroupByCourseAbsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreezeDetail) ProtoMessage() {}
+func (*GetGroupByCourseAbsResponse) ProtoMessage() {}
 
-func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[52]
+func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4015,53 +4166,95 @@ func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreezeDetail.ProtoReflect.Descriptor instead.
-func (*FreezeDetail) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetGroupByCourseAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupByCourseAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *FreezeDetail) GetReason() string {
+func (x *GetGroupByCourseAbsResponse) GetId() string {
 	if x != nil {
-		return x.Reason
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FreezeDetail) GetTillDate() string {
+func (x *GetGroupByCourseAbsResponse) GetTeacherName() string {
 	if x != nil {
-		return x.TillDate
+		return x.TeacherName
 	}
 	return ""
 }
 
-type SetAttendanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttendDate    string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	ActionById    string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
-	ActionByRole  string                 `protobuf:"bytes,7,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetGroupByCourseAbsResponse) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
 }
 
-func (x *SetAttendanceRequest) Reset() {
-	*x = SetAttendanceRequest{}
-	mi := &file_education_proto_msgTypes[53]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetGroupByCourseAbsResponse) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
 }
 
-func (x *SetAttendanceRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetGroupByCourseAbsResponse) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
 }
 
-func (*SetAttendanceRequest) ProtoMessage() {}
-
-func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[53]
+func (x *GetGroupByCourseAbsResponse) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type GetGroupAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Course          *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	Room            *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days,omitempty"`
+	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetGroupAbsResponse) Reset() {
+	*x = GetGroupAbsResponse{}
+	mi := &file_education_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupAbsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupAbsResponse) ProtoMessage() {}
+
+func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4072,174 +4265,132 @@ func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*SetAttendanceRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *SetAttendanceRequest) GetAttendDate() string {
-	if x != nil {
-		return x.AttendDate
-	}
-	return ""
+// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *SetAttendanceRequest) GetGroupId() string {
+func (x *GetGroupAbsResponse) GetId() string {
 	if x != nil {
-		return x.GroupId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetStatus() int32 {
+func (x *GetGroupAbsResponse) GetCourse() *AbsCourse {
 	if x != nil {
-		return x.Status
+		return x.Course
 	}
-	return 0
+	return nil
 }
 
-func (x *SetAttendanceRequest) GetStudentId() string {
+func (x *GetGroupAbsResponse) GetTeacherName() string {
 	if x != nil {
-		return x.StudentId
+		return x.TeacherName
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetTeacherId() string {
+func (x *GetGroupAbsResponse) GetTeacherId() string {
 	if x != nil {
 		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetActionById() string {
+func (x *GetGroupAbsResponse) GetLessonStartTime() string {
 	if x != nil {
-		return x.ActionById
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetActionByRole() string {
+func (x *GetGroupAbsResponse) GetRoom() *AbsRoom {
 	if x != nil {
-		return x.ActionByRole
+		return x.Room
 	}
-	return ""
-}
-
-type CalculateDiscountSummaRequest struct {
-	state                                        protoimpl.MessageState `protogen:"open.v1"`
-	GroupId                                      string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	StudentId                                    string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	DiscountPrice                                string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice,omitempty"`
-	StartDate                                    string                 `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate                                      string                 `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	PaymentDate                                  string                 `protobuf:"bytes,6,opt,name=paymentDate,proto3" json:"paymentDate,omitempty"`
-	StudentActivationDateInThisGroupWhilePayment string                 `protobuf:"bytes,7,opt,name=studentActivationDateInThisGroupWhilePayment,proto3" json:"studentActivationDateInThisGroupWhilePayment,omitempty"`
-	unknownFields                                protoimpl.UnknownFields
-	sizeCache                                    protoimpl.SizeCache
-}
-
-func (x *CalculateDiscountSummaRequest) Reset() {
-	*x = CalculateDiscountSummaRequest{}
-	mi := &file_education_proto_msgTypes[54]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CalculateDiscountSummaRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*CalculateDiscountSummaRequest) ProtoMessage() {}
-
-func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[54]
+func (x *GetGroupAbsResponse) GetStudentCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StudentCount
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CalculateDiscountSummaRequest.ProtoReflect.Descriptor instead.
-func (*CalculateDiscountSummaRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{54}
+	return 0
 }
 
-func (x *CalculateDiscountSummaRequest) GetGroupId() string {
+func (x *GetGroupAbsResponse) GetName() string {
 	if x != nil {
-		return x.GroupId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CalculateDiscountSummaRequest) GetStudentId() string {
+func (x *GetGroupAbsResponse) GetIsArchived() bool {
 	if x != nil {
-		return x.StudentId
+		return x.IsArchived
 	}
-	return ""
+	return false
 }
 
-func (x *CalculateDiscountSummaRequest) GetDiscountPrice() string {
+func (x *GetGroupAbsResponse) GetCreatedAt() string {
 	if x != nil {
-		return x.DiscountPrice
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *CalculateDiscountSummaRequest) GetStartDate() string {
+func (x *GetGroupAbsResponse) GetStartDate() string {
 	if x != nil {
 		return x.StartDate
 	}
 	return ""
 }
 
-func (x *CalculateDiscountSummaRequest) GetEndDate() string {
+func (x *GetGroupAbsResponse) GetEndDate() string {
 	if x != nil {
 		return x.EndDate
 	}
 	return ""
 }
 
-func (x *CalculateDiscountSummaRequest) GetPaymentDate() string {
+func (x *GetGroupAbsResponse) GetDays() []string {
 	if x != nil {
-		return x.PaymentDate
+		return x.Days
 	}
-	return ""
+	return nil
 }
 
-func (x *CalculateDiscountSummaRequest) GetStudentActivationDateInThisGroupWhilePayment() string {
+func (x *GetGroupAbsResponse) GetDateType() string {
 	if x != nil {
-		return x.StudentActivationDateInThisGroupWhilePayment
+		return x.DateType
 	}
 	return ""
 }
 
-type CalculateDiscountResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CalculatedPrice string                 `protobuf:"bytes,1,opt,name=calculatedPrice,proto3" json:"calculatedPrice,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetGroupsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Groups         []*GetGroupAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CalculateDiscountResponse) Reset() {
-	*x = CalculateDiscountResponse{}
-	mi := &file_education_proto_msgTypes[55]
+func (x *GetGroupsResponse) Reset() {
+	*x = GetGroupsResponse{}
+	mi := &file_education_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateDiscountResponse) String() string {
+func (x *GetGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateDiscountResponse) ProtoMessage() {}
+func (*GetGroupsResponse) ProtoMessage() {}
 
-func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[55]
+func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4250,48 +4401,55 @@ func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateDiscountResponse.ProtoReflect.Descriptor instead.
-func (*CalculateDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CalculateDiscountResponse) GetCalculatedPrice() string {
+func (x *GetGroupsResponse) GetGroups() []*GetGroupAbsResponse {
 	if x != nil {
-		return x.CalculatedPrice
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-type ChangeUserBalanceHistoryByDebitRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	OldDebit      string                 `protobuf:"bytes,2,opt,name=oldDebit,proto3" json:"oldDebit,omitempty"`
-	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
-	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	CurrentDebit  string                 `protobuf:"bytes,9,opt,name=currentDebit,proto3" json:"currentDebit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetGroupsResponse) GetTotalPageCount() int32 {
+	if x != nil {
+		return x.TotalPageCount
+	}
+	return 0
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) Reset() {
-	*x = ChangeUserBalanceHistoryByDebitRequest{}
-	mi := &file_education_proto_msgTypes[56]
+type GetGroupsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IsArchived     bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacherId,proto3,oneof" json:"teacherId,omitempty"`
+	CourseId       *int32                 `protobuf:"varint,3,opt,name=courseId,proto3,oneof" json:"courseId,omitempty"`
+	DateType       *string                `protobuf:"bytes,4,opt,name=dateType,proto3,oneof" json:"dateType,omitempty"`
+	StartDate      *string                `protobuf:"bytes,5,opt,name=startDate,proto3,oneof" json:"startDate,omitempty"`
+	EndDate        *string                `protobuf:"bytes,6,opt,name=endDate,proto3,oneof" json:"endDate,omitempty"`
+	Page           *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`
+	OrderBy        string                 `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	OrderDirection string                 `protobuf:"bytes,9,opt,name=orderDirection,proto3" json:"orderDirection,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupsRequest) Reset() {
+	*x = GetGroupsRequest{}
+	mi := &file_education_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) String() string {
+func (x *GetGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeUserBalanceHistoryByDebitRequest) ProtoMessage() {}
+func (*GetGroupsRequest) ProtoMessage() {}
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[56]
+func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4302,103 +4460,98 @@ func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeUserBalanceHistoryByDebitRequest.ProtoReflect.Descriptor instead.
-func (*ChangeUserBalanceHistoryByDebitRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetStudentId() string {
+func (x *GetGroupsRequest) GetIsArchived() bool {
 	if x != nil {
-		return x.StudentId
+		return x.IsArchived
 	}
-	return ""
+	return false
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetOldDebit() string {
-	if x != nil {
-		return x.OldDebit
+func (x *GetGroupsRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetGivenDate() string {
-	if x != nil {
-		return x.GivenDate
+func (x *GetGroupsRequest) GetCourseId() int32 {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetComment() string {
-	if x != nil {
-		return x.Comment
+func (x *GetGroupsRequest) GetDateType() string {
+	if x != nil && x.DateType != nil {
+		return *x.DateType
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetPaymentType() string {
-	if x != nil {
-		return x.PaymentType
+func (x *GetGroupsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetCreatedBy() string {
-	if x != nil {
-		return x.CreatedBy
+func (x *GetGroupsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetCreatedByName() string {
+func (x *GetGroupsRequest) GetPage() *PageRequest {
 	if x != nil {
-		return x.CreatedByName
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetGroupId() string {
+func (x *GetGroupsRequest) GetOrderBy() string {
 	if x != nil {
-		return x.GroupId
+		return x.OrderBy
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetCurrentDebit() string {
+func (x *GetGroupsRequest) GetOrderDirection() string {
 	if x != nil {
-		return x.CurrentDebit
+		return x.OrderDirection
 	}
 	return ""
 }
 
-type ChangeUserBalanceHistoryRequest struct {
+type CalculateTeacherSalaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
-	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeUserBalanceHistoryRequest) Reset() {
-	*x = ChangeUserBalanceHistoryRequest{}
-	mi := &file_education_proto_msgTypes[57]
+func (x *CalculateTeacherSalaryRequest) Reset() {
+	*x = CalculateTeacherSalaryRequest{}
+	mi := &file_education_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeUserBalanceHistoryRequest) String() string {
+func (x *CalculateTeacherSalaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
+func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
 
-func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[57]
+func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4409,92 +4562,101 @@ func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
+func (x *CalculateTeacherSalaryRequest) GetFrom() string {
 	if x != nil {
-		return x.StudentId
+		return x.From
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetAmount() string {
+func (x *CalculateTeacherSalaryRequest) GetTo() string {
 	if x != nil {
-		return x.Amount
+		return x.To
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetGivenDate() string {
+func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
 	if x != nil {
-		return x.GivenDate
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
+type CalculateTeacherSalaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetPaymentType() string {
-	if x != nil {
-		return x.PaymentType
-	}
-	return ""
+func (x *CalculateTeacherSalaryResponse) Reset() {
+	*x = CalculateTeacherSalaryResponse{}
+	mi := &file_education_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetCreatedBy() string {
-	if x != nil {
-		return x.CreatedBy
-	}
-	return ""
+func (x *CalculateTeacherSalaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetCreatedByName() string {
+func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
+
+func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[60]
 	if x != nil {
-		return x.CreatedByName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetGroupId() string {
+// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
 	if x != nil {
-		return x.GroupId
+		return x.Salaries
 	}
-	return ""
+	return nil
 }
 
-type DeleteStudentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	ReturnMoney   bool                   `protobuf:"varint,2,opt,name=returnMoney,proto3" json:"returnMoney,omitempty"`
-	ActionById    string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
-	ActionByName  string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AbsCalculateSalary struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod,omitempty"`
+	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *DeleteStudentRequest) Reset() {
-	*x = DeleteStudentRequest{}
-	mi := &file_education_proto_msgTypes[58]
+func (x *AbsCalculateSalary) Reset() {
+	*x = AbsCalculateSalary{}
+	mi := &file_education_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStudentRequest) String() string {
+func (x *AbsCalculateSalary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStudentRequest) ProtoMessage() {}
+func (*AbsCalculateSalary) ProtoMessage() {}
 
-func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[58]
+func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4505,61 +4667,67 @@ func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStudentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
+func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DeleteStudentRequest) GetStudentId() string {
+func (x *AbsCalculateSalary) GetGroupId() string {
 	if x != nil {
-		return x.StudentId
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DeleteStudentRequest) GetReturnMoney() bool {
+func (x *AbsCalculateSalary) GetGroupName() string {
 	if x != nil {
-		return x.ReturnMoney
+		return x.GroupName
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteStudentRequest) GetActionById() string {
+func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
 	if x != nil {
-		return x.ActionById
+		return x.CommonLessonCountInPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *DeleteStudentRequest) GetActionByName() string {
+func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
 	if x != nil {
-		return x.ActionByName
+		return x.Salaries
 	}
-	return ""
+	return nil
 }
 
-type GetStudentsByGroupIdResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StudentSalary struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentId         string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName       string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	PassedLessonCount int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount,omitempty"`
+	PriceType         string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType,omitempty"`
+	TotalCount        string                 `protobuf:"bytes,6,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	CoursePrice       string                 `protobuf:"bytes,7,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
+	CalculatedSalary  string                 `protobuf:"bytes,8,opt,name=calculatedSalary,proto3" json:"calculatedSalary,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetStudentsByGroupIdResponse) Reset() {
-	*x = GetStudentsByGroupIdResponse{}
-	mi := &file_education_proto_msgTypes[59]
+func (x *StudentSalary) Reset() {
+	*x = StudentSalary{}
+	mi := &file_education_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentsByGroupIdResponse) String() string {
+func (x *StudentSalary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
+func (*StudentSalary) ProtoMessage() {}
 
-func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[59]
+func (x *StudentSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4570,41 +4738,87 @@ func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
+func (*StudentSalary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
+func (x *StudentSalary) GetStudentId() string {
 	if x != nil {
-		return x.Students
+		return x.StudentId
 	}
-	return nil
+	return ""
 }
 
-type GetStudentsByGroupIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	WithOutdated  bool                   `protobuf:"varint,2,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StudentSalary) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
 }
 
-func (x *GetStudentsByGroupIdRequest) Reset() {
-	*x = GetStudentsByGroupIdRequest{}
-	mi := &file_education_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *StudentSalary) GetPassedLessonCount() int32 {
+	if x != nil {
+		return x.PassedLessonCount
+	}
+	return 0
 }
 
-func (x *GetStudentsByGroupIdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (x *StudentSalary) GetPriceType() string {
+	if x != nil {
+		return x.PriceType
+	}
+	return ""
+}
 
-func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
+func (x *StudentSalary) GetTotalCount() string {
+	if x != nil {
+		return x.TotalCount
+	}
+	return ""
+}
 
-func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[60]
+func (x *StudentSalary) GetCoursePrice() string {
+	if x != nil {
+		return x.CoursePrice
+	}
+	return ""
+}
+
+func (x *StudentSalary) GetCalculatedSalary() string {
+	if x != nil {
+		return x.CalculatedSalary
+	}
+	return ""
+}
+
+type GetAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till,omitempty"`
+	WithOutdated  bool                   `protobuf:"varint,4,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
+	ActionId      string                 `protobuf:"bytes,5,opt,name=actionId,proto3" json:"actionId,omitempty"`
+	ActionRole    string                 `protobuf:"bytes,6,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRequest) Reset() {
+	*x = GetAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRequest) ProtoMessage() {}
+
+func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4615,54 +4829,76 @@ func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
-func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
+func (x *GetAttendanceRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GetStudentsByGroupIdRequest) GetWithOutdated() bool {
+func (x *GetAttendanceRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetWithOutdated() bool {
 	if x != nil {
 		return x.WithOutdated
 	}
 	return false
 }
 
-type ChangeConditionStudentRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StudentId      string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ReturnTheMoney bool                   `protobuf:"varint,2,opt,name=returnTheMoney,proto3" json:"returnTheMoney,omitempty"`
-	TillDate       string                 `protobuf:"bytes,3,opt,name=tillDate,proto3" json:"tillDate,omitempty"`
-	ActionById     string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
-	ActionByName   string                 `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
-	Comment        string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetAttendanceRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
 }
 
-func (x *ChangeConditionStudentRequest) Reset() {
-	*x = ChangeConditionStudentRequest{}
-	mi := &file_education_proto_msgTypes[61]
+func (x *GetAttendanceRequest) GetActionRole() string {
+	if x != nil {
+		return x.ActionRole
+	}
+	return ""
+}
+
+type GetAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*Day                 `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Students      []*Student             `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceResponse) Reset() {
+	*x = GetAttendanceResponse{}
+	mi := &file_education_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeConditionStudentRequest) String() string {
+func (x *GetAttendanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeConditionStudentRequest) ProtoMessage() {}
+func (*GetAttendanceResponse) ProtoMessage() {}
 
-func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[61]
+func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4673,91 +4909,2998 @@ func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeConditionStudentRequest.ProtoReflect.Descriptor instead.
-func (*ChangeConditionStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use GetAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ChangeConditionStudentRequest) GetStudentId() string {
+func (x *GetAttendanceResponse) GetDays() []*Day {
 	if x != nil {
-		return x.StudentId
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetAttendanceResponse) GetStudents() []*Student {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type Day struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TransferDate  string                 `protobuf:"bytes,2,opt,name=transferDate,proto3" json:"transferDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Day) Reset() {
+	*x = Day{}
+	mi := &file_education_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Day) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Day) ProtoMessage() {}
+
+func (x *Day) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Day.ProtoReflect.Descriptor instead.
+func (*Day) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Day) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Day) GetTransferDate() string {
+	if x != nil {
+		return x.TransferDate
+	}
+	return ""
+}
+
+type Student struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ActivatedAt   string                 `protobuf:"bytes,1,opt,name=activatedAt,proto3" json:"activatedAt,omitempty"`
+	AddedAt       string                 `protobuf:"bytes,2,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
+	Attendance    []*Attendance          `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	Balance       string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	FreezeDetail  *FreezeDetail          `protobuf:"bytes,7,opt,name=freezeDetail,proto3" json:"freezeDetail,omitempty"`
+	Gender        bool                   `protobuf:"varint,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	Condition     string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`
+	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Student) Reset() {
+	*x = Student{}
+	mi := &file_education_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Student) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Student) ProtoMessage() {}
+
+func (x *Student) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Student.ProtoReflect.Descriptor instead.
+func (*Student) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Student) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Student) GetActivatedAt() string {
+	if x != nil {
+		return x.ActivatedAt
 	}
 	return ""
 }
 
-func (x *ChangeConditionStudentRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
+func (x *Student) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+func (x *Student) GetAttendance() []*Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *Student) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *Student) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Student) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *Student) GetFreezeDetail() *FreezeDetail {
+	if x != nil {
+		return x.FreezeDetail
+	}
+	return nil
+}
+
+func (x *Student) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *Student) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Student) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Student) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type Attendance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttendDate    string                 `protobuf:"bytes,2,opt,name=attend_date,json=attendDate,proto3" json:"attend_date,omitempty"`
+	IsCome        bool                   `protobuf:"varint,3,opt,name=isCome,proto3" json:"isCome,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	mi := &file_education_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Attendance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attendance) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *Attendance) GetIsCome() bool {
+	if x != nil {
+		return x.IsCome
+	}
+	return false
+}
+
+func (x *Attendance) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Attendance) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type FreezeDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	TillDate      string                 `protobuf:"bytes,2,opt,name=till_date,json=tillDate,proto3" json:"till_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeDetail) Reset() {
+	*x = FreezeDetail{}
+	mi := &file_education_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeDetail) ProtoMessage() {}
+
+func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeDetail.ProtoReflect.Descriptor instead.
+func (*FreezeDetail) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *FreezeDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeDetail) GetTillDate() string {
+	if x != nil {
+		return x.TillDate
+	}
+	return ""
+}
+
+type SetAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttendDate    string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	ActionById    string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string                 `protobuf:"bytes,7,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttendanceRequest) Reset() {
+	*x = SetAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttendanceRequest) ProtoMessage() {}
+
+func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*SetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetAttendanceRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SetAttendanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type DiscardStudentsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	StudentIds []string               `protobuf:"bytes,1,rep,name=studentIds,proto3" json:"studentIds,omitempty"`
+	GroupId    string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// the requestKey the group was created with, when its id is not known
+	GroupRequestKey string `protobuf:"bytes,3,opt,name=groupRequestKey,proto3" json:"groupRequestKey,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiscardStudentsRequest) Reset() {
+	*x = DiscardStudentsRequest{}
+	mi := &file_education_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardStudentsRequest) ProtoMessage() {}
+
+func (x *DiscardStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardStudentsRequest.ProtoReflect.Descriptor instead.
+func (*DiscardStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DiscardStudentsRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *DiscardStudentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DiscardStudentsRequest) GetGroupRequestKey() string {
+	if x != nil {
+		return x.GroupRequestKey
+	}
+	return ""
+}
+
+type FindStudentsByPhoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindStudentsByPhoneRequest) Reset() {
+	*x = FindStudentsByPhoneRequest{}
+	mi := &file_education_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindStudentsByPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindStudentsByPhoneRequest) ProtoMessage() {}
+
+func (x *FindStudentsByPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindStudentsByPhoneRequest.ProtoReflect.Descriptor instead.
+func (*FindStudentsByPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FindStudentsByPhoneRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+type MergeStudentsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceStudentId string                 `protobuf:"bytes,1,opt,name=sourceStudentId,proto3" json:"sourceStudentId,omitempty"`
+	TargetStudentId string                 `protobuf:"bytes,2,opt,name=targetStudentId,proto3" json:"targetStudentId,omitempty"`
+	ActionById      string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName    string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeStudentsRequest) Reset() {
+	*x = MergeStudentsRequest{}
+	mi := &file_education_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeStudentsRequest) ProtoMessage() {}
+
+func (x *MergeStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeStudentsRequest.ProtoReflect.Descriptor instead.
+func (*MergeStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MergeStudentsRequest) GetSourceStudentId() string {
+	if x != nil {
+		return x.SourceStudentId
+	}
+	return ""
+}
+
+func (x *MergeStudentsRequest) GetTargetStudentId() string {
+	if x != nil {
+		return x.TargetStudentId
+	}
+	return ""
+}
+
+func (x *MergeStudentsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *MergeStudentsRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type CalculateDiscountSummaRequest struct {
+	state                                        protoimpl.MessageState `protogen:"open.v1"`
+	GroupId                                      string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	StudentId                                    string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	DiscountPrice                                string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice,omitempty"`
+	StartDate                                    string                 `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate                                      string                 `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	PaymentDate                                  string                 `protobuf:"bytes,6,opt,name=paymentDate,proto3" json:"paymentDate,omitempty"`
+	StudentActivationDateInThisGroupWhilePayment string                 `protobuf:"bytes,7,opt,name=studentActivationDateInThisGroupWhilePayment,proto3" json:"studentActivationDateInThisGroupWhilePayment,omitempty"`
+	// FIXED (default) or PERCENT, a percent is taken of the course price
+	DiscountType  string `protobuf:"bytes,8,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateDiscountSummaRequest) Reset() {
+	*x = CalculateDiscountSummaRequest{}
+	mi := &file_education_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateDiscountSummaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDiscountSummaRequest) ProtoMessage() {}
+
+func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDiscountSummaRequest.ProtoReflect.Descriptor instead.
+func (*CalculateDiscountSummaRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CalculateDiscountSummaRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetDiscountPrice() string {
+	if x != nil {
+		return x.DiscountPrice
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetStudentActivationDateInThisGroupWhilePayment() string {
+	if x != nil {
+		return x.StudentActivationDateInThisGroupWhilePayment
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+type CalculateDiscountResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalculatedPrice string                 `protobuf:"bytes,1,opt,name=calculatedPrice,proto3" json:"calculatedPrice,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CalculateDiscountResponse) Reset() {
+	*x = CalculateDiscountResponse{}
+	mi := &file_education_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDiscountResponse) ProtoMessage() {}
+
+func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDiscountResponse.ProtoReflect.Descriptor instead.
+func (*CalculateDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CalculateDiscountResponse) GetCalculatedPrice() string {
+	if x != nil {
+		return x.CalculatedPrice
+	}
+	return ""
+}
+
+type ChangeUserBalanceHistoryByDebitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	OldDebit      string                 `protobuf:"bytes,2,opt,name=oldDebit,proto3" json:"oldDebit,omitempty"`
+	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	CurrentDebit  string                 `protobuf:"bytes,9,opt,name=currentDebit,proto3" json:"currentDebit,omitempty"`
+	EventId       string                 `protobuf:"bytes,10,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) Reset() {
+	*x = ChangeUserBalanceHistoryByDebitRequest{}
+	mi := &file_education_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserBalanceHistoryByDebitRequest) ProtoMessage() {}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserBalanceHistoryByDebitRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserBalanceHistoryByDebitRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetOldDebit() string {
+	if x != nil {
+		return x.OldDebit
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetCurrentDebit() string {
+	if x != nil {
+		return x.CurrentDebit
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ChangeUserBalanceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	EventId       string                 `protobuf:"bytes,9,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserBalanceHistoryRequest) Reset() {
+	*x = ChangeUserBalanceHistoryRequest{}
+	mi := &file_education_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserBalanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
+
+func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type DeleteStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	ReturnMoney   bool                   `protobuf:"varint,2,opt,name=returnMoney,proto3" json:"returnMoney,omitempty"`
+	ActionById    string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStudentRequest) Reset() {
+	*x = DeleteStudentRequest{}
+	mi := &file_education_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStudentRequest) ProtoMessage() {}
+
+func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStudentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *DeleteStudentRequest) GetReturnMoney() bool {
+	if x != nil {
+		return x.ReturnMoney
+	}
+	return false
+}
+
+func (x *DeleteStudentRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *DeleteStudentRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetStudentsByGroupIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentsByGroupIdResponse) Reset() {
+	*x = GetStudentsByGroupIdResponse{}
+	mi := &file_education_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentsByGroupIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
+
+func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type GetStudentsByGroupIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	WithOutdated  bool                   `protobuf:"varint,2,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentsByGroupIdRequest) Reset() {
+	*x = GetStudentsByGroupIdRequest{}
+	mi := &file_education_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentsByGroupIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
+
+func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetStudentsByGroupIdRequest) GetWithOutdated() bool {
+	if x != nil {
+		return x.WithOutdated
+	}
+	return false
+}
+
+type ChangeConditionStudentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ReturnTheMoney bool                   `protobuf:"varint,2,opt,name=returnTheMoney,proto3" json:"returnTheMoney,omitempty"`
+	TillDate       string                 `protobuf:"bytes,3,opt,name=tillDate,proto3" json:"tillDate,omitempty"`
+	ActionById     string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName   string                 `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	Comment        string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangeConditionStudentRequest) Reset() {
+	*x = ChangeConditionStudentRequest{}
+	mi := &file_education_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeConditionStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeConditionStudentRequest) ProtoMessage() {}
+
+func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeConditionStudentRequest.ProtoReflect.Descriptor instead.
+func (*ChangeConditionStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ChangeConditionStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetReturnTheMoney() bool {
+	if x != nil {
+		return x.ReturnTheMoney
+	}
+	return false
+}
+
+func (x *ChangeConditionStudentRequest) GetTillDate() string {
+	if x != nil {
+		return x.TillDate
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *ChangeConditionStudentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type TransferLessonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLessonRequest) Reset() {
+	*x = TransferLessonRequest{}
+	mi := &file_education_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLessonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLessonRequest) ProtoMessage() {}
+
+func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLessonRequest.ProtoReflect.Descriptor instead.
+func (*TransferLessonRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TransferLessonRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TransferLessonRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TransferLessonRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetHistoryGroupResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GroupHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=groupHistory,proto3" json:"groupHistory,omitempty"`
+	StudentsHistory []*AbsStudentHistory   `protobuf:"bytes,2,rep,name=studentsHistory,proto3" json:"studentsHistory,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetHistoryGroupResponse) Reset() {
+	*x = GetHistoryGroupResponse{}
+	mi := &file_education_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryGroupResponse) ProtoMessage() {}
+
+func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryGroupResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetHistoryGroupResponse) GetGroupHistory() []*AbsHistory {
+	if x != nil {
+		return x.GroupHistory
+	}
+	return nil
+}
+
+func (x *GetHistoryGroupResponse) GetStudentsHistory() []*AbsStudentHistory {
+	if x != nil {
+		return x.StudentsHistory
+	}
+	return nil
+}
+
+type GetHistoryStudentResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=studentHistory,proto3" json:"studentHistory,omitempty"`
+	ConditionsHistory []*AbsStudentHistory   `protobuf:"bytes,2,rep,name=conditionsHistory,proto3" json:"conditionsHistory,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetHistoryStudentResponse) Reset() {
+	*x = GetHistoryStudentResponse{}
+	mi := &file_education_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryStudentResponse) ProtoMessage() {}
+
+func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryStudentResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetHistoryStudentResponse) GetStudentHistory() []*AbsHistory {
+	if x != nil {
+		return x.StudentHistory
+	}
+	return nil
+}
+
+func (x *GetHistoryStudentResponse) GetConditionsHistory() []*AbsStudentHistory {
+	if x != nil {
+		return x.ConditionsHistory
+	}
+	return nil
+}
+
+type AbsStudentHistory struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Student          *AbsStudent            `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
+	Group            *AbsGroup              `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	OldCondition     string                 `protobuf:"bytes,3,opt,name=oldCondition,proto3" json:"oldCondition,omitempty"`
+	CurrentCondition string                 `protobuf:"bytes,6,opt,name=currentCondition,proto3" json:"currentCondition,omitempty"`
+	SpecificDate     string                 `protobuf:"bytes,4,opt,name=specificDate,proto3" json:"specificDate,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Comment          string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AbsStudentHistory) Reset() {
+	*x = AbsStudentHistory{}
+	mi := &file_education_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudentHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudentHistory) ProtoMessage() {}
+
+func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudentHistory.ProtoReflect.Descriptor instead.
+func (*AbsStudentHistory) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AbsStudentHistory) GetStudent() *AbsStudent {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *AbsStudentHistory) GetGroup() *AbsGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *AbsStudentHistory) GetOldCondition() string {
+	if x != nil {
+		return x.OldCondition
+	}
+	return ""
+}
+
+func (x *AbsStudentHistory) GetCurrentCondition() string {
+	if x != nil {
+		return x.CurrentCondition
+	}
+	return ""
+}
+
+func (x *AbsStudentHistory) GetSpecificDate() string {
+	if x != nil {
+		return x.SpecificDate
+	}
+	return ""
+}
+
+func (x *AbsStudentHistory) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsStudentHistory) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type AbsGroup struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TeacherName        string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	CurrentGroupStatus string                 `protobuf:"bytes,4,opt,name=currentGroupStatus,proto3" json:"currentGroupStatus,omitempty"`
+	GroupStartDate     string                 `protobuf:"bytes,5,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate       string                 `protobuf:"bytes,6,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	DateType           string                 `protobuf:"bytes,8,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Course             *AbsCourse             `protobuf:"bytes,9,opt,name=course,proto3" json:"course,omitempty"`
+	IsArchived         bool                   `protobuf:"varint,10,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AbsGroup) Reset() {
+	*x = AbsGroup{}
+	mi := &file_education_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGroup) ProtoMessage() {}
+
+func (x *AbsGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGroup.ProtoReflect.Descriptor instead.
+func (*AbsGroup) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AbsGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetCurrentGroupStatus() string {
+	if x != nil {
+		return x.CurrentGroupStatus
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *AbsGroup) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *AbsGroup) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+type AbsHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EditedField   string                 `protobuf:"bytes,2,opt,name=editedField,proto3" json:"editedField,omitempty"`
+	OldValue      string                 `protobuf:"bytes,3,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	CurrentValue  string                 `protobuf:"bytes,4,opt,name=currentValue,proto3" json:"currentValue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsHistory) Reset() {
+	*x = AbsHistory{}
+	mi := &file_education_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsHistory) ProtoMessage() {}
+
+func (x *AbsHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsHistory.ProtoReflect.Descriptor instead.
+func (*AbsHistory) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AbsHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsHistory) GetEditedField() string {
+	if x != nil {
+		return x.EditedField
+	}
+	return ""
+}
+
+func (x *AbsHistory) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *AbsHistory) GetCurrentValue() string {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return ""
+}
+
+type SearchStudentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStudentRequest) Reset() {
+	*x = SearchStudentRequest{}
+	mi := &file_education_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStudentRequest) ProtoMessage() {}
+
+func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStudentRequest.ProtoReflect.Descriptor instead.
+func (*SearchStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SearchStudentRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SearchStudentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStudentResponse) Reset() {
+	*x = SearchStudentResponse{}
+	mi := &file_education_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStudentResponse) ProtoMessage() {}
+
+func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStudentResponse.ProtoReflect.Descriptor instead.
+func (*SearchStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SearchStudentResponse) GetStudents() []*AbsStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type AbsStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsStudent) Reset() {
+	*x = AbsStudent{}
+	mi := &file_education_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudent) ProtoMessage() {}
+
+func (x *AbsStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
+func (*AbsStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AbsStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsStudent) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+type GetAllStudentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Condition          string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Page               string                 `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size               string                 `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
+	NameOrSurname      string                 `protobuf:"bytes,4,opt,name=nameOrSurname,proto3" json:"nameOrSurname,omitempty"`
+	CourseId           string                 `protobuf:"bytes,5,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TrainingDatesStart string                 `protobuf:"bytes,6,opt,name=trainingDatesStart,proto3" json:"trainingDatesStart,omitempty"`
+	TrainingDatesEnd   string                 `protobuf:"bytes,7,opt,name=trainingDatesEnd,proto3" json:"trainingDatesEnd,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetAllStudentRequest) Reset() {
+	*x = GetAllStudentRequest{}
+	mi := &file_education_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentRequest) ProtoMessage() {}
+
+func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentRequest.ProtoReflect.Descriptor instead.
+func (*GetAllStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetAllStudentRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetPage() string {
+	if x != nil {
+		return x.Page
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetNameOrSurname() string {
+	if x != nil {
+		return x.NameOrSurname
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetTrainingDatesStart() string {
+	if x != nil {
+		return x.TrainingDatesStart
+	}
+	return ""
+}
+
+func (x *GetAllStudentRequest) GetTrainingDatesEnd() string {
+	if x != nil {
+		return x.TrainingDatesEnd
+	}
+	return ""
+}
+
+type GetAllStudentResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Response      []*GetGroupsAbsForStudent `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
+	TotalCount    int32                     `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStudentResponse) Reset() {
+	*x = GetAllStudentResponse{}
+	mi := &file_education_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentResponse) ProtoMessage() {}
+
+func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentResponse.ProtoReflect.Descriptor instead.
+func (*GetAllStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetAllStudentResponse) GetResponse() []*GetGroupsAbsForStudent {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetAllStudentResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetGroupsAbsForStudent struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Id                string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gender            bool                     `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	DateOfBirth       string                   `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Phone             string                   `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address           string                   `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	PassportId        string                   `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
+	AdditionalContact string                   `protobuf:"bytes,8,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
+	Balance           string                   `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance,omitempty"`
+	Condition         string                   `protobuf:"bytes,11,opt,name=condition,proto3" json:"condition,omitempty"`
+	TelegramUsername  string                   `protobuf:"bytes,12,opt,name=telegramUsername,proto3" json:"telegramUsername,omitempty"`
+	CreatedAt         string                   `protobuf:"bytes,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Groups            []*GroupGetAllStudentAbs `protobuf:"bytes,9,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetGroupsAbsForStudent) Reset() {
+	*x = GetGroupsAbsForStudent{}
+	mi := &file_education_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsAbsForStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsAbsForStudent) ProtoMessage() {}
+
+func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsAbsForStudent.ProtoReflect.Descriptor instead.
+func (*GetGroupsAbsForStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetGroupsAbsForStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *GetGroupsAbsForStudent) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetAdditionalContact() string {
+	if x != nil {
+		return x.AdditionalContact
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetTelegramUsername() string {
+	if x != nil {
+		return x.TelegramUsername
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetGroupsAbsForStudent) GetGroups() []*GroupGetAllStudentAbs {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GroupGetAllStudentAbs struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Course             *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	TeacherName        string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Days               []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	RoomId             int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition,omitempty"`
+	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GroupGetAllStudentAbs) Reset() {
+	*x = GroupGetAllStudentAbs{}
+	mi := &file_education_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupGetAllStudentAbs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupGetAllStudentAbs) ProtoMessage() {}
+
+func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupGetAllStudentAbs.ProtoReflect.Descriptor instead.
+func (*GroupGetAllStudentAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GroupGetAllStudentAbs) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GroupGetAllStudentAbs) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GroupGetAllStudentAbs) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *GroupGetAllStudentAbs) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetStudentCondition() string {
+	if x != nil {
+		return x.StudentCondition
+	}
+	return ""
+}
+
+func (x *GroupGetAllStudentAbs) GetStudentActivatedAt() string {
+	if x != nil {
+		return x.StudentActivatedAt
+	}
+	return ""
+}
+
+type CreateStudentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DateOfBirth       string                 `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Gender            bool                   `protobuf:"varint,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	AdditionalContact string                 `protobuf:"bytes,5,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
+	Address           string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	PassportId        string                 `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
+	TelegramUsername  string                 `protobuf:"bytes,10,opt,name=telegramUsername,proto3" json:"telegramUsername,omitempty"`
+	GroupId           string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	DateFrom          string                 `protobuf:"bytes,9,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
+	CreatedBy         string                 `protobuf:"bytes,11,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	Id                string                 `protobuf:"bytes,12,opt,name=id,proto3" json:"id,omitempty"`
+	Comment           string                 `protobuf:"bytes,13,opt,name=comment,proto3" json:"comment,omitempty"`
+	Source            string                 `protobuf:"bytes,14,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateStudentRequest) Reset() {
+	*x = CreateStudentRequest{}
+	mi := &file_education_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStudentRequest) ProtoMessage() {}
+
+func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStudentRequest.ProtoReflect.Descriptor instead.
+func (*CreateStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateStudentRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *CreateStudentRequest) GetAdditionalContact() string {
+	if x != nil {
+		return x.AdditionalContact
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetTelegramUsername() string {
+	if x != nil {
+		return x.TelegramUsername
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateStudentRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type UpdateStudentRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentId         string                 `protobuf:"bytes,8,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DateOfBirth       string                 `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Gender            bool                   `protobuf:"varint,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	AdditionalContact string                 `protobuf:"bytes,5,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
+	Address           string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	PassportId        string                 `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateStudentRequest) Reset() {
+	*x = UpdateStudentRequest{}
+	mi := &file_education_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStudentRequest) ProtoMessage() {}
+
+func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStudentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStudentRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateStudentRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *UpdateStudentRequest) GetAdditionalContact() string {
+	if x != nil {
+		return x.AdditionalContact
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateStudentRequest) GetPassportId() string {
+	if x != nil {
+		return x.PassportId
+	}
+	return ""
+}
+
+type AddToGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedDate   string                 `protobuf:"bytes,1,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	StudentIds    []string               `protobuf:"bytes,3,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToGroupRequest) Reset() {
+	*x = AddToGroupRequest{}
+	mi := &file_education_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToGroupRequest) ProtoMessage() {}
+
+func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToGroupRequest.ProtoReflect.Descriptor instead.
+func (*AddToGroupRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *AddToGroupRequest) GetCreatedDate() string {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return ""
+}
+
+func (x *AddToGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddToGroupRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *AddToGroupRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type GetStudentByIdResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gender            bool                   `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	DateOfBirth       string                 `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Phone             string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Balance           string                 `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Groups            []*GetGroupStudent     `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
+	Condition         string                 `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
+	AdditionalContact string                 `protobuf:"bytes,10,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
+	PaymentPlans      []*PaymentPlanStatus   `protobuf:"bytes,11,rep,name=paymentPlans,proto3" json:"paymentPlans,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetStudentByIdResponse) Reset() {
+	*x = GetStudentByIdResponse{}
+	mi := &file_education_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentByIdResponse) ProtoMessage() {}
+
+func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetStudentByIdResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *GetStudentByIdResponse) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetGroups() []*GetGroupStudent {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetStudentByIdResponse) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetAdditionalContact() string {
+	if x != nil {
+		return x.AdditionalContact
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetPaymentPlans() []*PaymentPlanStatus {
+	if x != nil {
+		return x.PaymentPlans
+	}
+	return nil
+}
+
+type NoteStudentByAbsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteStudentByAbsRequest) Reset() {
+	*x = NoteStudentByAbsRequest{}
+	mi := &file_education_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteStudentByAbsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteStudentByAbsRequest) ProtoMessage() {}
+
+func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
+func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *NoteStudentByAbsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGroupStudent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DateType           string                 `protobuf:"bytes,3,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Days               []string               `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	Room               *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	Course             *AbsCourse             `protobuf:"bytes,6,opt,name=course,proto3" json:"course,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition,omitempty"`
+	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
+	StudentAddedAt     string                 `protobuf:"bytes,14,opt,name=studentAddedAt,proto3" json:"studentAddedAt,omitempty"`
+	TeacherName        string                 `protobuf:"bytes,15,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	PriceForStudent    string                 `protobuf:"bytes,13,opt,name=priceForStudent,proto3" json:"priceForStudent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetGroupStudent) Reset() {
+	*x = GetGroupStudent{}
+	mi := &file_education_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupStudent) ProtoMessage() {}
+
+func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
+func (*GetGroupStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetGroupStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetRoom() *AbsRoom {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentCondition() string {
+	if x != nil {
+		return x.StudentCondition
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentActivatedAt() string {
+	if x != nil {
+		return x.StudentActivatedAt
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentAddedAt() string {
+	if x != nil {
+		return x.StudentAddedAt
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetPriceForStudent() string {
+	if x != nil {
+		return x.PriceForStudent
+	}
+	return ""
+}
+
+type GetNotesByStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*AbsNote             `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotesByStudent) Reset() {
+	*x = GetNotesByStudent{}
+	mi := &file_education_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotesByStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotesByStudent) ProtoMessage() {}
+
+func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotesByStudent.ProtoReflect.Descriptor instead.
+func (*GetNotesByStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetNotesByStudent) GetNotes() []*AbsNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type AbsNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsNote) Reset() {
+	*x = AbsNote{}
+	mi := &file_education_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ChangeConditionStudentRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
+func (*AbsNote) ProtoMessage() {}
 
-func (x *ChangeConditionStudentRequest) GetReturnTheMoney() bool {
+func (x *AbsNote) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[101]
 	if x != nil {
-		return x.ReturnTheMoney
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ChangeConditionStudentRequest) GetTillDate() string {
-	if x != nil {
-		return x.TillDate
-	}
-	return ""
+// Deprecated: Use AbsNote.ProtoReflect.Descriptor instead.
+func (*AbsNote) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *ChangeConditionStudentRequest) GetActionById() string {
+func (x *AbsNote) GetId() string {
 	if x != nil {
-		return x.ActionById
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ChangeConditionStudentRequest) GetActionByName() string {
+func (x *AbsNote) GetComment() string {
 	if x != nil {
-		return x.ActionByName
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *ChangeConditionStudentRequest) GetComment() string {
+func (x *AbsNote) GetCreatedAt() string {
 	if x != nil {
-		return x.Comment
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type TransferLessonRequest struct {
+type CreateNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferLessonRequest) Reset() {
-	*x = TransferLessonRequest{}
-	mi := &file_education_proto_msgTypes[62]
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_education_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferLessonRequest) String() string {
+func (x *CreateNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferLessonRequest) ProtoMessage() {}
+func (*CreateNoteRequest) ProtoMessage() {}
 
-func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[62]
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4768,55 +7911,50 @@ func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferLessonRequest.ProtoReflect.Descriptor instead.
-func (*TransferLessonRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *TransferLessonRequest) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *TransferLessonRequest) GetTo() string {
+func (x *CreateNoteRequest) GetNote() string {
 	if x != nil {
-		return x.To
+		return x.Note
 	}
 	return ""
 }
 
-func (x *TransferLessonRequest) GetGroupId() string {
+func (x *CreateNoteRequest) GetStudentId() string {
 	if x != nil {
-		return x.GroupId
+		return x.StudentId
 	}
 	return ""
 }
 
-type GetHistoryGroupResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	GroupHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=groupHistory,proto3" json:"groupHistory,omitempty"`
-	StudentsHistory []*AbsStudentHistory   `protobuf:"bytes,2,rep,name=studentsHistory,proto3" json:"studentsHistory,omitempty"`
+type NotificationSettings struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Lang            string                  `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	SmsEnabled      bool                    `protobuf:"varint,2,opt,name=smsEnabled,proto3" json:"smsEnabled,omitempty"`
+	TelegramEnabled bool                    `protobuf:"varint,3,opt,name=telegramEnabled,proto3" json:"telegramEnabled,omitempty"`
+	Templates       []*NotificationTemplate `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetHistoryGroupResponse) Reset() {
-	*x = GetHistoryGroupResponse{}
-	mi := &file_education_proto_msgTypes[63]
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	mi := &file_education_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryGroupResponse) String() string {
+func (x *NotificationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryGroupResponse) ProtoMessage() {}
+func (*NotificationSettings) ProtoMessage() {}
 
-func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[63]
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4827,48 +7965,64 @@ func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryGroupResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoryGroupResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetHistoryGroupResponse) GetGroupHistory() []*AbsHistory {
+func (x *NotificationSettings) GetLang() string {
 	if x != nil {
-		return x.GroupHistory
+		return x.Lang
 	}
-	return nil
+	return ""
 }
 
-func (x *GetHistoryGroupResponse) GetStudentsHistory() []*AbsStudentHistory {
+func (x *NotificationSettings) GetSmsEnabled() bool {
 	if x != nil {
-		return x.StudentsHistory
+		return x.SmsEnabled
+	}
+	return false
+}
+
+func (x *NotificationSettings) GetTelegramEnabled() bool {
+	if x != nil {
+		return x.TelegramEnabled
+	}
+	return false
+}
+
+func (x *NotificationSettings) GetTemplates() []*NotificationTemplate {
+	if x != nil {
+		return x.Templates
 	}
 	return nil
 }
 
-type GetHistoryStudentResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	StudentHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=studentHistory,proto3" json:"studentHistory,omitempty"`
-	ConditionsHistory []*AbsStudentHistory   `protobuf:"bytes,2,rep,name=conditionsHistory,proto3" json:"conditionsHistory,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type NotificationTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,4,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryStudentResponse) Reset() {
-	*x = GetHistoryStudentResponse{}
-	mi := &file_education_proto_msgTypes[64]
+func (x *NotificationTemplate) Reset() {
+	*x = NotificationTemplate{}
+	mi := &file_education_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryStudentResponse) String() string {
+func (x *NotificationTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryStudentResponse) ProtoMessage() {}
+func (*NotificationTemplate) ProtoMessage() {}
 
-func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[64]
+func (x *NotificationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4879,53 +8033,63 @@ func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryStudentResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoryStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use NotificationTemplate.ProtoReflect.Descriptor instead.
+func (*NotificationTemplate) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetHistoryStudentResponse) GetStudentHistory() []*AbsHistory {
+func (x *NotificationTemplate) GetEvent() string {
 	if x != nil {
-		return x.StudentHistory
+		return x.Event
 	}
-	return nil
+	return ""
 }
 
-func (x *GetHistoryStudentResponse) GetConditionsHistory() []*AbsStudentHistory {
+func (x *NotificationTemplate) GetLang() string {
 	if x != nil {
-		return x.ConditionsHistory
+		return x.Lang
 	}
-	return nil
+	return ""
 }
 
-type AbsStudentHistory struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Student          *AbsStudent            `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
-	Group            *AbsGroup              `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
-	OldCondition     string                 `protobuf:"bytes,3,opt,name=oldCondition,proto3" json:"oldCondition,omitempty"`
-	CurrentCondition string                 `protobuf:"bytes,6,opt,name=currentCondition,proto3" json:"currentCondition,omitempty"`
-	SpecificDate     string                 `protobuf:"bytes,4,opt,name=specificDate,proto3" json:"specificDate,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	Comment          string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *NotificationTemplate) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
 }
 
-func (x *AbsStudentHistory) Reset() {
-	*x = AbsStudentHistory{}
-	mi := &file_education_proto_msgTypes[65]
+func (x *NotificationTemplate) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type GetNotificationOutboxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationOutboxRequest) Reset() {
+	*x = GetNotificationOutboxRequest{}
+	mi := &file_education_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsStudentHistory) String() string {
+func (x *GetNotificationOutboxRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsStudentHistory) ProtoMessage() {}
+func (*GetNotificationOutboxRequest) ProtoMessage() {}
 
-func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[65]
+func (x *GetNotificationOutboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4936,91 +8100,115 @@ func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsStudentHistory.ProtoReflect.Descriptor instead.
-func (*AbsStudentHistory) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetNotificationOutboxRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationOutboxRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *AbsStudentHistory) GetStudent() *AbsStudent {
+func (x *GetNotificationOutboxRequest) GetStatus() string {
 	if x != nil {
-		return x.Student
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *AbsStudentHistory) GetGroup() *AbsGroup {
+func (x *GetNotificationOutboxRequest) GetPage() int32 {
 	if x != nil {
-		return x.Group
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *AbsStudentHistory) GetOldCondition() string {
+func (x *GetNotificationOutboxRequest) GetSize() int32 {
 	if x != nil {
-		return x.OldCondition
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsStudentHistory) GetCurrentCondition() string {
-	if x != nil {
-		return x.CurrentCondition
-	}
-	return ""
+type GetNotificationOutboxResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*NotificationOutboxItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                     `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsStudentHistory) GetSpecificDate() string {
+func (x *GetNotificationOutboxResponse) Reset() {
+	*x = GetNotificationOutboxResponse{}
+	mi := &file_education_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationOutboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationOutboxResponse) ProtoMessage() {}
+
+func (x *GetNotificationOutboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[106]
 	if x != nil {
-		return x.SpecificDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AbsStudentHistory) GetCreatedAt() string {
+// Deprecated: Use GetNotificationOutboxResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationOutboxResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetNotificationOutboxResponse) GetItems() []*NotificationOutboxItem {
 	if x != nil {
-		return x.CreatedAt
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *AbsStudentHistory) GetComment() string {
+func (x *GetNotificationOutboxResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Comment
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type AbsGroup struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TeacherName        string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	CurrentGroupStatus string                 `protobuf:"bytes,4,opt,name=currentGroupStatus,proto3" json:"currentGroupStatus,omitempty"`
-	GroupStartDate     string                 `protobuf:"bytes,5,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate       string                 `protobuf:"bytes,6,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	DateType           string                 `protobuf:"bytes,8,opt,name=dateType,proto3" json:"dateType,omitempty"`
-	Course             *AbsCourse             `protobuf:"bytes,9,opt,name=course,proto3" json:"course,omitempty"`
-	IsArchived         bool                   `protobuf:"varint,10,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type NotificationOutboxItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Recipient     string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	SentAt        string                 `protobuf:"bytes,10,opt,name=sentAt,proto3" json:"sentAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGroup) Reset() {
-	*x = AbsGroup{}
-	mi := &file_education_proto_msgTypes[66]
+func (x *NotificationOutboxItem) Reset() {
+	*x = NotificationOutboxItem{}
+	mi := &file_education_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGroup) String() string {
+func (x *NotificationOutboxItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGroup) ProtoMessage() {}
+func (*NotificationOutboxItem) ProtoMessage() {}
 
-func (x *AbsGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[66]
+func (x *NotificationOutboxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5031,106 +8219,107 @@ func (x *AbsGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGroup.ProtoReflect.Descriptor instead.
-func (*AbsGroup) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *AbsGroup) GetId() string {
+// Deprecated: Use NotificationOutboxItem.ProtoReflect.Descriptor instead.
+func (*NotificationOutboxItem) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *NotificationOutboxItem) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetName() string {
+func (x *NotificationOutboxItem) GetEvent() string {
 	if x != nil {
-		return x.Name
+		return x.Event
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetTeacherName() string {
+func (x *NotificationOutboxItem) GetChannel() string {
 	if x != nil {
-		return x.TeacherName
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetCurrentGroupStatus() string {
+func (x *NotificationOutboxItem) GetRecipient() string {
 	if x != nil {
-		return x.CurrentGroupStatus
+		return x.Recipient
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetGroupStartDate() string {
+func (x *NotificationOutboxItem) GetBody() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.Body
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetGroupEndDate() string {
+func (x *NotificationOutboxItem) GetStatus() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.Status
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetLessonStartTime() string {
+func (x *NotificationOutboxItem) GetAttempts() int32 {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Attempts
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGroup) GetDateType() string {
+func (x *NotificationOutboxItem) GetLastError() string {
 	if x != nil {
-		return x.DateType
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *AbsGroup) GetCourse() *AbsCourse {
+func (x *NotificationOutboxItem) GetCreatedAt() string {
 	if x != nil {
-		return x.Course
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *AbsGroup) GetIsArchived() bool {
+func (x *NotificationOutboxItem) GetSentAt() string {
 	if x != nil {
-		return x.IsArchived
+		return x.SentAt
 	}
-	return false
+	return ""
 }
 
-type AbsHistory struct {
+type DebtReminderSettings struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EditedField   string                 `protobuf:"bytes,2,opt,name=editedField,proto3" json:"editedField,omitempty"`
-	OldValue      string                 `protobuf:"bytes,3,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
-	CurrentValue  string                 `protobuf:"bytes,4,opt,name=currentValue,proto3" json:"currentValue,omitempty"`
+	IsActive      bool                   `protobuf:"varint,1,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	BalanceBelow  string                 `protobuf:"bytes,2,opt,name=balanceBelow,proto3" json:"balanceBelow,omitempty"`
+	OverdueDays   int32                  `protobuf:"varint,3,opt,name=overdueDays,proto3" json:"overdueDays,omitempty"`
+	CadenceDays   int32                  `protobuf:"varint,4,opt,name=cadenceDays,proto3" json:"cadenceDays,omitempty"`
+	MaxReminders  int32                  `protobuf:"varint,5,opt,name=maxReminders,proto3" json:"maxReminders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsHistory) Reset() {
-	*x = AbsHistory{}
-	mi := &file_education_proto_msgTypes[67]
+func (x *DebtReminderSettings) Reset() {
+	*x = DebtReminderSettings{}
+	mi := &file_education_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsHistory) String() string {
+func (x *DebtReminderSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsHistory) ProtoMessage() {}
+func (*DebtReminderSettings) ProtoMessage() {}
 
-func (x *AbsHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[67]
+func (x *DebtReminderSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5141,61 +8330,70 @@ func (x *AbsHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsHistory.ProtoReflect.Descriptor instead.
-func (*AbsHistory) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use DebtReminderSettings.ProtoReflect.Descriptor instead.
+func (*DebtReminderSettings) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *AbsHistory) GetId() string {
+func (x *DebtReminderSettings) GetIsActive() bool {
 	if x != nil {
-		return x.Id
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *AbsHistory) GetEditedField() string {
+func (x *DebtReminderSettings) GetBalanceBelow() string {
 	if x != nil {
-		return x.EditedField
+		return x.BalanceBelow
 	}
 	return ""
 }
 
-func (x *AbsHistory) GetOldValue() string {
+func (x *DebtReminderSettings) GetOverdueDays() int32 {
 	if x != nil {
-		return x.OldValue
+		return x.OverdueDays
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsHistory) GetCurrentValue() string {
+func (x *DebtReminderSettings) GetCadenceDays() int32 {
 	if x != nil {
-		return x.CurrentValue
+		return x.CadenceDays
 	}
-	return ""
+	return 0
 }
 
-type SearchStudentRequest struct {
+func (x *DebtReminderSettings) GetMaxReminders() int32 {
+	if x != nil {
+		return x.MaxReminders
+	}
+	return 0
+}
+
+type GetDebtRemindersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchStudentRequest) Reset() {
-	*x = SearchStudentRequest{}
-	mi := &file_education_proto_msgTypes[68]
+func (x *GetDebtRemindersRequest) Reset() {
+	*x = GetDebtRemindersRequest{}
+	mi := &file_education_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchStudentRequest) String() string {
+func (x *GetDebtRemindersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchStudentRequest) ProtoMessage() {}
+func (*GetDebtRemindersRequest) ProtoMessage() {}
 
-func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[68]
+func (x *GetDebtRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5206,40 +8404,55 @@ func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchStudentRequest.ProtoReflect.Descriptor instead.
-func (*SearchStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use GetDebtRemindersRequest.ProtoReflect.Descriptor instead.
+func (*GetDebtRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *SearchStudentRequest) GetValue() string {
+func (x *GetDebtRemindersRequest) GetStatus() string {
 	if x != nil {
-		return x.Value
+		return x.Status
 	}
 	return ""
 }
 
-type SearchStudentResponse struct {
+func (x *GetDebtRemindersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDebtRemindersRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetDebtRemindersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	Items         []*DebtReminderItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchStudentResponse) Reset() {
-	*x = SearchStudentResponse{}
-	mi := &file_education_proto_msgTypes[69]
+func (x *GetDebtRemindersResponse) Reset() {
+	*x = GetDebtRemindersResponse{}
+	mi := &file_education_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchStudentResponse) String() string {
+func (x *GetDebtRemindersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchStudentResponse) ProtoMessage() {}
+func (*GetDebtRemindersResponse) ProtoMessage() {}
 
-func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[69]
+func (x *GetDebtRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5250,42 +8463,60 @@ func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchStudentResponse.ProtoReflect.Descriptor instead.
-func (*SearchStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetDebtRemindersResponse.ProtoReflect.Descriptor instead.
+func (*GetDebtRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *SearchStudentResponse) GetStudents() []*AbsStudent {
+func (x *GetDebtRemindersResponse) GetItems() []*DebtReminderItem {
 	if x != nil {
-		return x.Students
+		return x.Items
 	}
 	return nil
 }
 
-type AbsStudent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetDebtRemindersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
 }
 
-func (x *AbsStudent) Reset() {
-	*x = AbsStudent{}
-	mi := &file_education_proto_msgTypes[70]
+type DebtReminderItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId      string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName    string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	PhoneNumber    string                 `protobuf:"bytes,4,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Balance        string                 `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	DebtSince      string                 `protobuf:"bytes,6,opt,name=debtSince,proto3" json:"debtSince,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	RemindersSent  int32                  `protobuf:"varint,8,opt,name=remindersSent,proto3" json:"remindersSent,omitempty"`
+	LastSentAt     string                 `protobuf:"bytes,9,opt,name=lastSentAt,proto3" json:"lastSentAt,omitempty"`
+	NextSendAt     string                 `protobuf:"bytes,10,opt,name=nextSendAt,proto3" json:"nextSendAt,omitempty"`
+	ReplyStatus    string                 `protobuf:"bytes,11,opt,name=replyStatus,proto3" json:"replyStatus,omitempty"`
+	ReplyComment   string                 `protobuf:"bytes,12,opt,name=replyComment,proto3" json:"replyComment,omitempty"`
+	PromisedDate   string                 `protobuf:"bytes,13,opt,name=promisedDate,proto3" json:"promisedDate,omitempty"`
+	DeliveryStatus string                 `protobuf:"bytes,14,opt,name=deliveryStatus,proto3" json:"deliveryStatus,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DebtReminderItem) Reset() {
+	*x = DebtReminderItem{}
+	mi := &file_education_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsStudent) String() string {
+func (x *DebtReminderItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsStudent) ProtoMessage() {}
+func (*DebtReminderItem) ProtoMessage() {}
 
-func (x *AbsStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[70]
+func (x *DebtReminderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5296,147 +8527,135 @@ func (x *AbsStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
-func (*AbsStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use DebtReminderItem.ProtoReflect.Descriptor instead.
+func (*DebtReminderItem) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *AbsStudent) GetId() string {
+func (x *DebtReminderItem) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsStudent) GetName() string {
+func (x *DebtReminderItem) GetStudentId() string {
 	if x != nil {
-		return x.Name
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsStudent) GetPhoneNumber() string {
+func (x *DebtReminderItem) GetStudentName() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.StudentName
 	}
 	return ""
 }
 
-type GetAllStudentRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Condition          string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
-	Page               string                 `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	Size               string                 `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
-	NameOrSurname      string                 `protobuf:"bytes,4,opt,name=nameOrSurname,proto3" json:"nameOrSurname,omitempty"`
-	CourseId           string                 `protobuf:"bytes,5,opt,name=courseId,proto3" json:"courseId,omitempty"`
-	TrainingDatesStart string                 `protobuf:"bytes,6,opt,name=trainingDatesStart,proto3" json:"trainingDatesStart,omitempty"`
-	TrainingDatesEnd   string                 `protobuf:"bytes,7,opt,name=trainingDatesEnd,proto3" json:"trainingDatesEnd,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *GetAllStudentRequest) Reset() {
-	*x = GetAllStudentRequest{}
-	mi := &file_education_proto_msgTypes[71]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DebtReminderItem) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
 }
 
-func (x *GetAllStudentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DebtReminderItem) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
 }
 
-func (*GetAllStudentRequest) ProtoMessage() {}
-
-func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[71]
+func (x *DebtReminderItem) GetDebtSince() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DebtSince
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAllStudentRequest.ProtoReflect.Descriptor instead.
-func (*GetAllStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{71}
+func (x *DebtReminderItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *GetAllStudentRequest) GetCondition() string {
+func (x *DebtReminderItem) GetRemindersSent() int32 {
 	if x != nil {
-		return x.Condition
+		return x.RemindersSent
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAllStudentRequest) GetPage() string {
+func (x *DebtReminderItem) GetLastSentAt() string {
 	if x != nil {
-		return x.Page
+		return x.LastSentAt
 	}
 	return ""
 }
 
-func (x *GetAllStudentRequest) GetSize() string {
+func (x *DebtReminderItem) GetNextSendAt() string {
 	if x != nil {
-		return x.Size
+		return x.NextSendAt
 	}
 	return ""
 }
 
-func (x *GetAllStudentRequest) GetNameOrSurname() string {
+func (x *DebtReminderItem) GetReplyStatus() string {
 	if x != nil {
-		return x.NameOrSurname
+		return x.ReplyStatus
 	}
 	return ""
 }
 
-func (x *GetAllStudentRequest) GetCourseId() string {
+func (x *DebtReminderItem) GetReplyComment() string {
 	if x != nil {
-		return x.CourseId
+		return x.ReplyComment
 	}
 	return ""
 }
 
-func (x *GetAllStudentRequest) GetTrainingDatesStart() string {
+func (x *DebtReminderItem) GetPromisedDate() string {
 	if x != nil {
-		return x.TrainingDatesStart
+		return x.PromisedDate
 	}
 	return ""
 }
 
-func (x *GetAllStudentRequest) GetTrainingDatesEnd() string {
+func (x *DebtReminderItem) GetDeliveryStatus() string {
 	if x != nil {
-		return x.TrainingDatesEnd
+		return x.DeliveryStatus
 	}
 	return ""
 }
 
-type GetAllStudentResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Response      []*GetGroupsAbsForStudent `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
-	TotalCount    int32                     `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+type SetDebtReminderReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReplyStatus   string                 `protobuf:"bytes,2,opt,name=replyStatus,proto3" json:"replyStatus,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	PromisedDate  string                 `protobuf:"bytes,4,opt,name=promisedDate,proto3" json:"promisedDate,omitempty"`
+	Stop          bool                   `protobuf:"varint,5,opt,name=stop,proto3" json:"stop,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllStudentResponse) Reset() {
-	*x = GetAllStudentResponse{}
-	mi := &file_education_proto_msgTypes[72]
+func (x *SetDebtReminderReplyRequest) Reset() {
+	*x = SetDebtReminderReplyRequest{}
+	mi := &file_education_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentResponse) String() string {
+func (x *SetDebtReminderReplyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentResponse) ProtoMessage() {}
+func (*SetDebtReminderReplyRequest) ProtoMessage() {}
 
-func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[72]
+func (x *SetDebtReminderReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5447,59 +8666,70 @@ func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentResponse.ProtoReflect.Descriptor instead.
-func (*GetAllStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use SetDebtReminderReplyRequest.ProtoReflect.Descriptor instead.
+func (*SetDebtReminderReplyRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetAllStudentResponse) GetResponse() []*GetGroupsAbsForStudent {
+func (x *SetDebtReminderReplyRequest) GetId() string {
 	if x != nil {
-		return x.Response
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllStudentResponse) GetTotalCount() int32 {
+func (x *SetDebtReminderReplyRequest) GetReplyStatus() string {
 	if x != nil {
-		return x.TotalCount
+		return x.ReplyStatus
 	}
-	return 0
+	return ""
 }
 
-type GetGroupsAbsForStudent struct {
-	state             protoimpl.MessageState   `protogen:"open.v1"`
-	Id                string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Gender            bool                     `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
-	DateOfBirth       string                   `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	Phone             string                   `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Address           string                   `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
-	PassportId        string                   `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
-	AdditionalContact string                   `protobuf:"bytes,8,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
-	Balance           float64                  `protobuf:"fixed64,10,opt,name=balance,proto3" json:"balance,omitempty"`
-	Condition         string                   `protobuf:"bytes,11,opt,name=condition,proto3" json:"condition,omitempty"`
-	TelegramUsername  string                   `protobuf:"bytes,12,opt,name=telegramUsername,proto3" json:"telegramUsername,omitempty"`
-	CreatedAt         string                   `protobuf:"bytes,13,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	Groups            []*GroupGetAllStudentAbs `protobuf:"bytes,9,rep,name=groups,proto3" json:"groups,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *SetDebtReminderReplyRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SetDebtReminderReplyRequest) GetPromisedDate() string {
+	if x != nil {
+		return x.PromisedDate
+	}
+	return ""
+}
+
+func (x *SetDebtReminderReplyRequest) GetStop() bool {
+	if x != nil {
+		return x.Stop
+	}
+	return false
 }
 
-func (x *GetGroupsAbsForStudent) Reset() {
-	*x = GetGroupsAbsForStudent{}
-	mi := &file_education_proto_msgTypes[73]
+type RunBalanceReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AutoCorrect   bool                   `protobuf:"varint,1,opt,name=autoCorrect,proto3" json:"autoCorrect,omitempty"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunBalanceReconciliationRequest) Reset() {
+	*x = RunBalanceReconciliationRequest{}
+	mi := &file_education_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsAbsForStudent) String() string {
+func (x *RunBalanceReconciliationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsAbsForStudent) ProtoMessage() {}
+func (*RunBalanceReconciliationRequest) ProtoMessage() {}
 
-func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[73]
+func (x *RunBalanceReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5510,135 +8740,158 @@ func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsAbsForStudent.ProtoReflect.Descriptor instead.
-func (*GetGroupsAbsForStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use RunBalanceReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*RunBalanceReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetGroupsAbsForStudent) GetId() string {
+func (x *RunBalanceReconciliationRequest) GetAutoCorrect() bool {
 	if x != nil {
-		return x.Id
+		return x.AutoCorrect
 	}
-	return ""
+	return false
 }
 
-func (x *GetGroupsAbsForStudent) GetName() string {
+func (x *RunBalanceReconciliationRequest) GetActionById() string {
 	if x != nil {
-		return x.Name
+		return x.ActionById
 	}
 	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetGender() bool {
+func (x *RunBalanceReconciliationRequest) GetActionByName() string {
 	if x != nil {
-		return x.Gender
+		return x.ActionByName
 	}
-	return false
+	return ""
 }
 
-func (x *GetGroupsAbsForStudent) GetDateOfBirth() string {
-	if x != nil {
-		return x.DateOfBirth
-	}
-	return ""
+type GetReconciliationReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsAbsForStudent) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+func (x *GetReconciliationReportsRequest) Reset() {
+	*x = GetReconciliationReportsRequest{}
+	mi := &file_education_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsAbsForStudent) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+func (x *GetReconciliationReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetGroupsAbsForStudent) GetPassportId() string {
+func (*GetReconciliationReportsRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[114]
 	if x != nil {
-		return x.PassportId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupsAbsForStudent) GetAdditionalContact() string {
-	if x != nil {
-		return x.AdditionalContact
-	}
-	return ""
+// Deprecated: Use GetReconciliationReportsRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetGroupsAbsForStudent) GetBalance() float64 {
+func (x *GetReconciliationReportsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Balance
+		return x.Page
 	}
 	return 0
 }
 
-func (x *GetGroupsAbsForStudent) GetCondition() string {
+func (x *GetReconciliationReportsRequest) GetSize() int32 {
 	if x != nil {
-		return x.Condition
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupsAbsForStudent) GetTelegramUsername() string {
+type GetReconciliationReportsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*ReconciliationReport `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportsResponse) Reset() {
+	*x = GetReconciliationReportsResponse{}
+	mi := &file_education_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportsResponse) ProtoMessage() {}
+
+func (x *GetReconciliationReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[115]
 	if x != nil {
-		return x.TelegramUsername
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupsAbsForStudent) GetCreatedAt() string {
+// Deprecated: Use GetReconciliationReportsResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetReconciliationReportsResponse) GetItems() []*ReconciliationReport {
 	if x != nil {
-		return x.CreatedAt
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *GetGroupsAbsForStudent) GetGroups() []*GroupGetAllStudentAbs {
+func (x *GetReconciliationReportsResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Groups
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-type GroupGetAllStudentAbs struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Course             *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
-	TeacherName        string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Days               []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
-	RoomId             int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
-	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition,omitempty"`
-	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetReconciliationReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupGetAllStudentAbs) Reset() {
-	*x = GroupGetAllStudentAbs{}
-	mi := &file_education_proto_msgTypes[74]
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_education_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupGetAllStudentAbs) String() string {
+func (x *GetReconciliationReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupGetAllStudentAbs) ProtoMessage() {}
+func (*GetReconciliationReportRequest) ProtoMessage() {}
 
-func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[74]
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5649,127 +8902,153 @@ func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupGetAllStudentAbs.ProtoReflect.Descriptor instead.
-func (*GroupGetAllStudentAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GroupGetAllStudentAbs) GetId() string {
+func (x *GetReconciliationReportRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type ReconciliationReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CheckedCount  int32                  `protobuf:"varint,3,opt,name=checkedCount,proto3" json:"checkedCount,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,4,opt,name=skippedCount,proto3" json:"skippedCount,omitempty"`
+	DriftCount    int32                  `protobuf:"varint,5,opt,name=driftCount,proto3" json:"driftCount,omitempty"`
+	TotalDrift    string                 `protobuf:"bytes,6,opt,name=totalDrift,proto3" json:"totalDrift,omitempty"`
+	AutoCorrected bool                   `protobuf:"varint,7,opt,name=autoCorrected,proto3" json:"autoCorrected,omitempty"`
+	TriggeredBy   string                 `protobuf:"bytes,8,opt,name=triggeredBy,proto3" json:"triggeredBy,omitempty"`
+	Drifts        []*BalanceDrift        `protobuf:"bytes,9,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupGetAllStudentAbs) GetCourse() *AbsCourse {
+func (x *ReconciliationReport) Reset() {
+	*x = ReconciliationReport{}
+	mi := &file_education_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationReport) ProtoMessage() {}
+
+func (x *ReconciliationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[117]
 	if x != nil {
-		return x.Course
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GroupGetAllStudentAbs) GetTeacherName() string {
+// Deprecated: Use ReconciliationReport.ProtoReflect.Descriptor instead.
+func (*ReconciliationReport) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ReconciliationReport) GetId() string {
 	if x != nil {
-		return x.TeacherName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetType() string {
+func (x *ReconciliationReport) GetCreatedAt() string {
 	if x != nil {
-		return x.Type
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetDays() []string {
+func (x *ReconciliationReport) GetCheckedCount() int32 {
 	if x != nil {
-		return x.Days
+		return x.CheckedCount
 	}
-	return nil
+	return 0
 }
 
-func (x *GroupGetAllStudentAbs) GetRoomId() int32 {
+func (x *ReconciliationReport) GetSkippedCount() int32 {
 	if x != nil {
-		return x.RoomId
+		return x.SkippedCount
 	}
 	return 0
 }
 
-func (x *GroupGetAllStudentAbs) GetLessonStartTime() string {
+func (x *ReconciliationReport) GetDriftCount() int32 {
 	if x != nil {
-		return x.LessonStartTime
+		return x.DriftCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GroupGetAllStudentAbs) GetGroupStartDate() string {
+func (x *ReconciliationReport) GetTotalDrift() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.TotalDrift
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetGroupEndDate() string {
+func (x *ReconciliationReport) GetAutoCorrected() bool {
 	if x != nil {
-		return x.GroupEndDate
+		return x.AutoCorrected
 	}
-	return ""
+	return false
 }
 
-func (x *GroupGetAllStudentAbs) GetStudentCondition() string {
+func (x *ReconciliationReport) GetTriggeredBy() string {
 	if x != nil {
-		return x.StudentCondition
+		return x.TriggeredBy
 	}
 	return ""
 }
 
-func (x *GroupGetAllStudentAbs) GetStudentActivatedAt() string {
+func (x *ReconciliationReport) GetDrifts() []*BalanceDrift {
 	if x != nil {
-		return x.StudentActivatedAt
+		return x.Drifts
 	}
-	return ""
+	return nil
 }
 
-type CreateStudentRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DateOfBirth       string                 `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	Gender            bool                   `protobuf:"varint,4,opt,name=gender,proto3" json:"gender,omitempty"`
-	AdditionalContact string                 `protobuf:"bytes,5,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
-	Address           string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
-	PassportId        string                 `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
-	TelegramUsername  string                 `protobuf:"bytes,10,opt,name=telegramUsername,proto3" json:"telegramUsername,omitempty"`
-	GroupId           string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	DateFrom          string                 `protobuf:"bytes,9,opt,name=dateFrom,proto3" json:"dateFrom,omitempty"`
-	CreatedBy         string                 `protobuf:"bytes,11,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type BalanceDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	LedgerBalance string                 `protobuf:"bytes,3,opt,name=ledgerBalance,proto3" json:"ledgerBalance,omitempty"`
+	ActualBalance string                 `protobuf:"bytes,4,opt,name=actualBalance,proto3" json:"actualBalance,omitempty"`
+	Difference    string                 `protobuf:"bytes,5,opt,name=difference,proto3" json:"difference,omitempty"`
+	Corrected     bool                   `protobuf:"varint,6,opt,name=corrected,proto3" json:"corrected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateStudentRequest) Reset() {
-	*x = CreateStudentRequest{}
-	mi := &file_education_proto_msgTypes[75]
+func (x *BalanceDrift) Reset() {
+	*x = BalanceDrift{}
+	mi := &file_education_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateStudentRequest) String() string {
+func (x *BalanceDrift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStudentRequest) ProtoMessage() {}
+func (*BalanceDrift) ProtoMessage() {}
 
-func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[75]
+func (x *BalanceDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5780,117 +9059,138 @@ func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStudentRequest.ProtoReflect.Descriptor instead.
-func (*CreateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use BalanceDrift.ProtoReflect.Descriptor instead.
+func (*BalanceDrift) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *CreateStudentRequest) GetPhoneNumber() string {
+func (x *BalanceDrift) GetStudentId() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetName() string {
+func (x *BalanceDrift) GetStudentName() string {
 	if x != nil {
-		return x.Name
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetDateOfBirth() string {
+func (x *BalanceDrift) GetLedgerBalance() string {
 	if x != nil {
-		return x.DateOfBirth
+		return x.LedgerBalance
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetGender() bool {
+func (x *BalanceDrift) GetActualBalance() string {
 	if x != nil {
-		return x.Gender
+		return x.ActualBalance
 	}
-	return false
+	return ""
 }
 
-func (x *CreateStudentRequest) GetAdditionalContact() string {
+func (x *BalanceDrift) GetDifference() string {
 	if x != nil {
-		return x.AdditionalContact
+		return x.Difference
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetAddress() string {
+func (x *BalanceDrift) GetCorrected() bool {
 	if x != nil {
-		return x.Address
+		return x.Corrected
 	}
-	return ""
+	return false
 }
 
-func (x *CreateStudentRequest) GetPassportId() string {
-	if x != nil {
-		return x.PassportId
-	}
-	return ""
+type PlatformMetricsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// months whose renewals and cohorts are reported, YYYY-MM-DD
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateStudentRequest) GetTelegramUsername() string {
-	if x != nil {
-		return x.TelegramUsername
-	}
-	return ""
+func (x *PlatformMetricsRequest) Reset() {
+	*x = PlatformMetricsRequest{}
+	mi := &file_education_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateStudentRequest) GetGroupId() string {
+func (x *PlatformMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformMetricsRequest) ProtoMessage() {}
+
+func (x *PlatformMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[119]
 	if x != nil {
-		return x.GroupId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateStudentRequest) GetDateFrom() string {
+// Deprecated: Use PlatformMetricsRequest.ProtoReflect.Descriptor instead.
+func (*PlatformMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *PlatformMetricsRequest) GetFrom() string {
 	if x != nil {
-		return x.DateFrom
+		return x.From
 	}
 	return ""
 }
 
-func (x *CreateStudentRequest) GetCreatedBy() string {
+func (x *PlatformMetricsRequest) GetTo() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.To
 	}
 	return ""
 }
 
-type UpdateStudentRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	StudentId         string                 `protobuf:"bytes,8,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DateOfBirth       string                 `protobuf:"bytes,3,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	Gender            bool                   `protobuf:"varint,4,opt,name=gender,proto3" json:"gender,omitempty"`
-	AdditionalContact string                 `protobuf:"bytes,5,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
-	Address           string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
-	PassportId        string                 `protobuf:"bytes,7,opt,name=passportId,proto3" json:"passportId,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type PlatformMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// monthly recurring revenue of the periods paid for today
+	Mrr             string             `protobuf:"bytes,1,opt,name=mrr,proto3" json:"mrr,omitempty"`
+	Arr             string             `protobuf:"bytes,2,opt,name=arr,proto3" json:"arr,omitempty"`
+	PayingCompanies int32              `protobuf:"varint,3,opt,name=payingCompanies,proto3" json:"payingCompanies,omitempty"`
+	DemoCompanies   int32              `protobuf:"varint,4,opt,name=demoCompanies,proto3" json:"demoCompanies,omitempty"`
+	GraceCompanies  int32              `protobuf:"varint,5,opt,name=graceCompanies,proto3" json:"graceCompanies,omitempty"`
+	LockedCompanies int32              `protobuf:"varint,6,opt,name=lockedCompanies,proto3" json:"lockedCompanies,omitempty"`
+	TotalCompanies  int32              `protobuf:"varint,7,opt,name=totalCompanies,proto3" json:"totalCompanies,omitempty"`
+	ActiveStudents  int32              `protobuf:"varint,8,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	Renewals        []*RenewalStat     `protobuf:"bytes,9,rep,name=renewals,proto3" json:"renewals,omitempty"`
+	Cohorts         []*CohortRetention `protobuf:"bytes,10,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateStudentRequest) Reset() {
-	*x = UpdateStudentRequest{}
-	mi := &file_education_proto_msgTypes[76]
+func (x *PlatformMetrics) Reset() {
+	*x = PlatformMetrics{}
+	mi := &file_education_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStudentRequest) String() string {
+func (x *PlatformMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStudentRequest) ProtoMessage() {}
+func (*PlatformMetrics) ProtoMessage() {}
 
-func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[76]
+func (x *PlatformMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5901,92 +9201,111 @@ func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStudentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use PlatformMetrics.ProtoReflect.Descriptor instead.
+func (*PlatformMetrics) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *UpdateStudentRequest) GetStudentId() string {
+func (x *PlatformMetrics) GetMrr() string {
 	if x != nil {
-		return x.StudentId
+		return x.Mrr
 	}
 	return ""
 }
 
-func (x *UpdateStudentRequest) GetPhoneNumber() string {
+func (x *PlatformMetrics) GetArr() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Arr
 	}
 	return ""
 }
 
-func (x *UpdateStudentRequest) GetName() string {
+func (x *PlatformMetrics) GetPayingCompanies() int32 {
 	if x != nil {
-		return x.Name
+		return x.PayingCompanies
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateStudentRequest) GetDateOfBirth() string {
+func (x *PlatformMetrics) GetDemoCompanies() int32 {
 	if x != nil {
-		return x.DateOfBirth
+		return x.DemoCompanies
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateStudentRequest) GetGender() bool {
+func (x *PlatformMetrics) GetGraceCompanies() int32 {
 	if x != nil {
-		return x.Gender
+		return x.GraceCompanies
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateStudentRequest) GetAdditionalContact() string {
+func (x *PlatformMetrics) GetLockedCompanies() int32 {
 	if x != nil {
-		return x.AdditionalContact
+		return x.LockedCompanies
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateStudentRequest) GetAddress() string {
+func (x *PlatformMetrics) GetTotalCompanies() int32 {
 	if x != nil {
-		return x.Address
+		return x.TotalCompanies
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateStudentRequest) GetPassportId() string {
+func (x *PlatformMetrics) GetActiveStudents() int32 {
 	if x != nil {
-		return x.PassportId
+		return x.ActiveStudents
 	}
-	return ""
+	return 0
 }
 
-type AddToGroupRequest struct {
+func (x *PlatformMetrics) GetRenewals() []*RenewalStat {
+	if x != nil {
+		return x.Renewals
+	}
+	return nil
+}
+
+func (x *PlatformMetrics) GetCohorts() []*CohortRetention {
+	if x != nil {
+		return x.Cohorts
+	}
+	return nil
+}
+
+// RenewalStat covers the paid periods ending in a month: renewed within the
+// grace period, churned, or still within it.
+type RenewalStat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatedDate   string                 `protobuf:"bytes,1,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	StudentIds    []string               `protobuf:"bytes,3,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,4,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Due           int32                  `protobuf:"varint,2,opt,name=due,proto3" json:"due,omitempty"`
+	Renewed       int32                  `protobuf:"varint,3,opt,name=renewed,proto3" json:"renewed,omitempty"`
+	Churned       int32                  `protobuf:"varint,4,opt,name=churned,proto3" json:"churned,omitempty"`
+	Pending       int32                  `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	RenewalRate   float64                `protobuf:"fixed64,6,opt,name=renewalRate,proto3" json:"renewalRate,omitempty"`
+	ChurnRate     float64                `protobuf:"fixed64,7,opt,name=churnRate,proto3" json:"churnRate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddToGroupRequest) Reset() {
-	*x = AddToGroupRequest{}
-	mi := &file_education_proto_msgTypes[77]
+func (x *RenewalStat) Reset() {
+	*x = RenewalStat{}
+	mi := &file_education_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddToGroupRequest) String() string {
+func (x *RenewalStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToGroupRequest) ProtoMessage() {}
+func (*RenewalStat) ProtoMessage() {}
 
-func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[77]
+func (x *RenewalStat) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5997,70 +9316,86 @@ func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToGroupRequest.ProtoReflect.Descriptor instead.
-func (*AddToGroupRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use RenewalStat.ProtoReflect.Descriptor instead.
+func (*RenewalStat) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *AddToGroupRequest) GetCreatedDate() string {
+func (x *RenewalStat) GetMonth() string {
 	if x != nil {
-		return x.CreatedDate
+		return x.Month
 	}
 	return ""
 }
 
-func (x *AddToGroupRequest) GetGroupId() string {
+func (x *RenewalStat) GetDue() int32 {
 	if x != nil {
-		return x.GroupId
+		return x.Due
 	}
-	return ""
+	return 0
 }
 
-func (x *AddToGroupRequest) GetStudentIds() []string {
+func (x *RenewalStat) GetRenewed() int32 {
 	if x != nil {
-		return x.StudentIds
+		return x.Renewed
 	}
-	return nil
+	return 0
 }
 
-func (x *AddToGroupRequest) GetCreatedBy() string {
+func (x *RenewalStat) GetChurned() int32 {
 	if x != nil {
-		return x.CreatedBy
+		return x.Churned
 	}
-	return ""
+	return 0
 }
 
-type GetStudentByIdResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Gender            bool                   `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
-	DateOfBirth       string                 `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	Phone             string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Balance           float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt         string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	Groups            []*GetGroupStudent     `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
-	Condition         string                 `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
-	AdditionalContact string                 `protobuf:"bytes,10,opt,name=additionalContact,proto3" json:"additionalContact,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *RenewalStat) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
 }
 
-func (x *GetStudentByIdResponse) Reset() {
-	*x = GetStudentByIdResponse{}
-	mi := &file_education_proto_msgTypes[78]
+func (x *RenewalStat) GetRenewalRate() float64 {
+	if x != nil {
+		return x.RenewalRate
+	}
+	return 0
+}
+
+func (x *RenewalStat) GetChurnRate() float64 {
+	if x != nil {
+		return x.ChurnRate
+	}
+	return 0
+}
+
+// CohortRetention follows the companies that first paid in a month: the share
+// of them paying at the end of that month and each month after.
+type CohortRetention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Companies     int32                  `protobuf:"varint,2,opt,name=companies,proto3" json:"companies,omitempty"`
+	Retention     []float64              `protobuf:"fixed64,3,rep,packed,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CohortRetention) Reset() {
+	*x = CohortRetention{}
+	mi := &file_education_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentByIdResponse) String() string {
+func (x *CohortRetention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentByIdResponse) ProtoMessage() {}
+func (*CohortRetention) ProtoMessage() {}
 
-func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[78]
+func (x *CohortRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6071,103 +9406,135 @@ func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use CohortRetention.ProtoReflect.Descriptor instead.
+func (*CohortRetention) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GetStudentByIdResponse) GetId() string {
+func (x *CohortRetention) GetMonth() string {
 	if x != nil {
-		return x.Id
+		return x.Month
 	}
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetName() string {
+func (x *CohortRetention) GetCompanies() int32 {
 	if x != nil {
-		return x.Name
+		return x.Companies
 	}
-	return ""
+	return 0
 }
 
-func (x *GetStudentByIdResponse) GetGender() bool {
+func (x *CohortRetention) GetRetention() []float64 {
 	if x != nil {
-		return x.Gender
+		return x.Retention
 	}
-	return false
+	return nil
 }
 
-func (x *GetStudentByIdResponse) GetDateOfBirth() string {
-	if x != nil {
-		return x.DateOfBirth
-	}
-	return ""
+type TenantHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HEALTHY, AT_RISK or CRITICAL
+	Level         string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentByIdResponse) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+func (x *TenantHealthRequest) Reset() {
+	*x = TenantHealthRequest{}
+	mi := &file_education_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentByIdResponse) GetBalance() float64 {
-	if x != nil {
-		return x.Balance
-	}
-	return 0
+func (x *TenantHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetStudentByIdResponse) GetCreatedAt() string {
+func (*TenantHealthRequest) ProtoMessage() {}
+
+func (x *TenantHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[123]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetStudentByIdResponse) GetGroups() []*GetGroupStudent {
+// Deprecated: Use TenantHealthRequest.ProtoReflect.Descriptor instead.
+func (*TenantHealthRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *TenantHealthRequest) GetLevel() string {
 	if x != nil {
-		return x.Groups
+		return x.Level
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStudentByIdResponse) GetCondition() string {
+func (x *TenantHealthRequest) GetPage() int32 {
 	if x != nil {
-		return x.Condition
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *GetStudentByIdResponse) GetAdditionalContact() string {
+func (x *TenantHealthRequest) GetSize() int32 {
 	if x != nil {
-		return x.AdditionalContact
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-type NoteStudentByAbsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type TenantHealth struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId    int32                  `protobuf:"varint,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Subdomain    string                 `protobuf:"bytes,3,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	State        string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	IsDemo       bool                   `protobuf:"varint,5,opt,name=isDemo,proto3" json:"isDemo,omitempty"`
+	TariffName   string                 `protobuf:"bytes,6,opt,name=tariffName,proto3" json:"tariffName,omitempty"`
+	ValidDate    string                 `protobuf:"bytes,7,opt,name=validDate,proto3" json:"validDate,omitempty"`
+	Students     int32                  `protobuf:"varint,8,opt,name=students,proto3" json:"students,omitempty"`
+	StudentLimit int32                  `protobuf:"varint,9,opt,name=studentLimit,proto3" json:"studentLimit,omitempty"`
+	Groups       int32                  `protobuf:"varint,10,opt,name=groups,proto3" json:"groups,omitempty"`
+	Staff        int32                  `protobuf:"varint,11,opt,name=staff,proto3" json:"staff,omitempty"`
+	Leads        int32                  `protobuf:"varint,12,opt,name=leads,proto3" json:"leads,omitempty"`
+	// recentLeads, payments and paymentsSum cover the last 30 days
+	RecentLeads int32  `protobuf:"varint,13,opt,name=recentLeads,proto3" json:"recentLeads,omitempty"`
+	Payments    int32  `protobuf:"varint,14,opt,name=payments,proto3" json:"payments,omitempty"`
+	PaymentsSum string `protobuf:"bytes,15,opt,name=paymentsSum,proto3" json:"paymentsSum,omitempty"`
+	// 0 to 100
+	Score int32  `protobuf:"varint,16,opt,name=score,proto3" json:"score,omitempty"`
+	Level string `protobuf:"bytes,17,opt,name=level,proto3" json:"level,omitempty"`
+	// set on snapshots
+	Day           string `protobuf:"bytes,18,opt,name=day,proto3" json:"day,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NoteStudentByAbsRequest) Reset() {
-	*x = NoteStudentByAbsRequest{}
-	mi := &file_education_proto_msgTypes[79]
+func (x *TenantHealth) Reset() {
+	*x = TenantHealth{}
+	mi := &file_education_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NoteStudentByAbsRequest) String() string {
+func (x *TenantHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NoteStudentByAbsRequest) ProtoMessage() {}
+func (*TenantHealth) ProtoMessage() {}
 
-func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[79]
+func (x *TenantHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6178,188 +9545,162 @@ func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
-func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use TenantHealth.ProtoReflect.Descriptor instead.
+func (*TenantHealth) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *NoteStudentByAbsRequest) GetId() string {
+func (x *TenantHealth) GetCompanyId() int32 {
 	if x != nil {
-		return x.Id
+		return x.CompanyId
 	}
-	return ""
-}
-
-type GetGroupStudent struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DateType           string                 `protobuf:"bytes,3,opt,name=dateType,proto3" json:"dateType,omitempty"`
-	Days               []string               `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
-	Room               *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
-	Course             *AbsCourse             `protobuf:"bytes,6,opt,name=course,proto3" json:"course,omitempty"`
-	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition,omitempty"`
-	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
-	StudentAddedAt     string                 `protobuf:"bytes,14,opt,name=studentAddedAt,proto3" json:"studentAddedAt,omitempty"`
-	TeacherName        string                 `protobuf:"bytes,15,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	PriceForStudent    float64                `protobuf:"fixed64,13,opt,name=priceForStudent,proto3" json:"priceForStudent,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *GetGroupStudent) Reset() {
-	*x = GetGroupStudent{}
-	mi := &file_education_proto_msgTypes[80]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *GetGroupStudent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TenantHealth) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (*GetGroupStudent) ProtoMessage() {}
-
-func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[80]
+func (x *TenantHealth) GetSubdomain() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Subdomain
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
-func (*GetGroupStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{80}
+func (x *TenantHealth) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (x *GetGroupStudent) GetId() string {
+func (x *TenantHealth) GetIsDemo() bool {
 	if x != nil {
-		return x.Id
+		return x.IsDemo
 	}
-	return ""
+	return false
 }
 
-func (x *GetGroupStudent) GetName() string {
+func (x *TenantHealth) GetTariffName() string {
 	if x != nil {
-		return x.Name
+		return x.TariffName
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetDateType() string {
+func (x *TenantHealth) GetValidDate() string {
 	if x != nil {
-		return x.DateType
+		return x.ValidDate
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetDays() []string {
+func (x *TenantHealth) GetStudents() int32 {
 	if x != nil {
-		return x.Days
+		return x.Students
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupStudent) GetRoom() *AbsRoom {
+func (x *TenantHealth) GetStudentLimit() int32 {
 	if x != nil {
-		return x.Room
+		return x.StudentLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupStudent) GetCourse() *AbsCourse {
+func (x *TenantHealth) GetGroups() int32 {
 	if x != nil {
-		return x.Course
+		return x.Groups
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupStudent) GetLessonStartTime() string {
+func (x *TenantHealth) GetStaff() int32 {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Staff
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetGroupStartDate() string {
+func (x *TenantHealth) GetLeads() int32 {
 	if x != nil {
-		return x.GroupStartDate
+		return x.Leads
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetGroupEndDate() string {
+func (x *TenantHealth) GetRecentLeads() int32 {
 	if x != nil {
-		return x.GroupEndDate
+		return x.RecentLeads
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetStudentCondition() string {
+func (x *TenantHealth) GetPayments() int32 {
 	if x != nil {
-		return x.StudentCondition
+		return x.Payments
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetStudentActivatedAt() string {
+func (x *TenantHealth) GetPaymentsSum() string {
 	if x != nil {
-		return x.StudentActivatedAt
+		return x.PaymentsSum
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetStudentAddedAt() string {
+func (x *TenantHealth) GetScore() int32 {
 	if x != nil {
-		return x.StudentAddedAt
+		return x.Score
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetTeacherName() string {
+func (x *TenantHealth) GetLevel() string {
 	if x != nil {
-		return x.TeacherName
+		return x.Level
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetPriceForStudent() float64 {
+func (x *TenantHealth) GetDay() string {
 	if x != nil {
-		return x.PriceForStudent
+		return x.Day
 	}
-	return 0
+	return ""
 }
 
-type GetNotesByStudent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notes         []*AbsNote             `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+type TenantHealthList struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Count int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items []*TenantHealth        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// a service could not be asked, its part of the score is left out
+	Incomplete    bool `protobuf:"varint,3,opt,name=incomplete,proto3" json:"incomplete,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNotesByStudent) Reset() {
-	*x = GetNotesByStudent{}
-	mi := &file_education_proto_msgTypes[81]
+func (x *TenantHealthList) Reset() {
+	*x = TenantHealthList{}
+	mi := &file_education_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotesByStudent) String() string {
+func (x *TenantHealthList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotesByStudent) ProtoMessage() {}
+func (*TenantHealthList) ProtoMessage() {}
 
-func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[81]
+func (x *TenantHealthList) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6370,42 +9711,57 @@ func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotesByStudent.ProtoReflect.Descriptor instead.
-func (*GetNotesByStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use TenantHealthList.ProtoReflect.Descriptor instead.
+func (*TenantHealthList) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetNotesByStudent) GetNotes() []*AbsNote {
+func (x *TenantHealthList) GetCount() int32 {
 	if x != nil {
-		return x.Notes
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TenantHealthList) GetItems() []*TenantHealth {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-type AbsNote struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+func (x *TenantHealthList) GetIncomplete() bool {
+	if x != nil {
+		return x.Incomplete
+	}
+	return false
+}
+
+type HistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// for GetTenantHistory
+	CompanyId     int32 `protobuf:"varint,3,opt,name=companyId,proto3" json:"companyId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsNote) Reset() {
-	*x = AbsNote{}
-	mi := &file_education_proto_msgTypes[82]
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	mi := &file_education_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsNote) String() string {
+func (x *HistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsNote) ProtoMessage() {}
+func (*HistoryRequest) ProtoMessage() {}
 
-func (x *AbsNote) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[82]
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6416,55 +9772,63 @@ func (x *AbsNote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsNote.ProtoReflect.Descriptor instead.
-func (*AbsNote) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *AbsNote) GetId() string {
+func (x *HistoryRequest) GetFrom() string {
 	if x != nil {
-		return x.Id
+		return x.From
 	}
 	return ""
 }
 
-func (x *AbsNote) GetComment() string {
+func (x *HistoryRequest) GetTo() string {
 	if x != nil {
-		return x.Comment
+		return x.To
 	}
 	return ""
 }
 
-func (x *AbsNote) GetCreatedAt() string {
+func (x *HistoryRequest) GetCompanyId() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.CompanyId
 	}
-	return ""
+	return 0
 }
 
-type CreateNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PlatformSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Day             string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Mrr             string                 `protobuf:"bytes,2,opt,name=mrr,proto3" json:"mrr,omitempty"`
+	Arr             string                 `protobuf:"bytes,3,opt,name=arr,proto3" json:"arr,omitempty"`
+	PayingCompanies int32                  `protobuf:"varint,4,opt,name=payingCompanies,proto3" json:"payingCompanies,omitempty"`
+	DemoCompanies   int32                  `protobuf:"varint,5,opt,name=demoCompanies,proto3" json:"demoCompanies,omitempty"`
+	GraceCompanies  int32                  `protobuf:"varint,6,opt,name=graceCompanies,proto3" json:"graceCompanies,omitempty"`
+	LockedCompanies int32                  `protobuf:"varint,7,opt,name=lockedCompanies,proto3" json:"lockedCompanies,omitempty"`
+	TotalCompanies  int32                  `protobuf:"varint,8,opt,name=totalCompanies,proto3" json:"totalCompanies,omitempty"`
+	ActiveStudents  int32                  `protobuf:"varint,9,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	AverageHealth   float64                `protobuf:"fixed64,10,opt,name=averageHealth,proto3" json:"averageHealth,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateNoteRequest) Reset() {
-	*x = CreateNoteRequest{}
-	mi := &file_education_proto_msgTypes[83]
+func (x *PlatformSnapshot) Reset() {
+	*x = PlatformSnapshot{}
+	mi := &file_education_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNoteRequest) String() string {
+func (x *PlatformSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNoteRequest) ProtoMessage() {}
+func (*PlatformSnapshot) ProtoMessage() {}
 
-func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[83]
+func (x *PlatformSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6475,30 +9839,130 @@ func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
-func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use PlatformSnapshot.ProtoReflect.Descriptor instead.
+func (*PlatformSnapshot) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *CreateNoteRequest) GetNote() string {
+func (x *PlatformSnapshot) GetDay() string {
 	if x != nil {
-		return x.Note
+		return x.Day
 	}
 	return ""
 }
 
-func (x *CreateNoteRequest) GetStudentId() string {
+func (x *PlatformSnapshot) GetMrr() string {
 	if x != nil {
-		return x.StudentId
+		return x.Mrr
+	}
+	return ""
+}
+
+func (x *PlatformSnapshot) GetArr() string {
+	if x != nil {
+		return x.Arr
 	}
 	return ""
 }
 
+func (x *PlatformSnapshot) GetPayingCompanies() int32 {
+	if x != nil {
+		return x.PayingCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetDemoCompanies() int32 {
+	if x != nil {
+		return x.DemoCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetGraceCompanies() int32 {
+	if x != nil {
+		return x.GraceCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetLockedCompanies() int32 {
+	if x != nil {
+		return x.LockedCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetTotalCompanies() int32 {
+	if x != nil {
+		return x.TotalCompanies
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetActiveStudents() int32 {
+	if x != nil {
+		return x.ActiveStudents
+	}
+	return 0
+}
+
+func (x *PlatformSnapshot) GetAverageHealth() float64 {
+	if x != nil {
+		return x.AverageHealth
+	}
+	return 0
+}
+
+type PlatformHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PlatformSnapshot    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformHistory) Reset() {
+	*x = PlatformHistory{}
+	mi := &file_education_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformHistory) ProtoMessage() {}
+
+func (x *PlatformHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformHistory.ProtoReflect.Descriptor instead.
+func (*PlatformHistory) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *PlatformHistory) GetItems() []*PlatformSnapshot {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_education_proto protoreflect.FileDescriptor
 
 const file_education_proto_rawDesc = "" +
 	"\n" +
-	"\x0feducation.proto\x12\teducation\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xb8\x02\n" +
+	"\x0feducation.proto\x12\teducation\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd7\x02\n" +
 	"\x0eCompanyFinance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -6506,22 +9970,24 @@ const file_education_proto_rawDesc = "" +
 	"\ttariff_id\x18\x03 \x01(\x05R\btariffId\x12\x1d\n" +
 	"\n" +
 	"tariff_sum\x18\n" +
-	" \x01(\x02R\ttariffSum\x12\x18\n" +
+	" \x01(\tR\ttariffSum\x12\x18\n" +
 	"\acomment\x18\x04 \x01(\tR\acomment\x12\x10\n" +
-	"\x03sum\x18\x05 \x01(\x02R\x03sum\x12*\n" +
+	"\x03sum\x18\x05 \x01(\tR\x03sum\x12*\n" +
 	"\x11edited_valid_date\x18\x06 \x01(\tR\x0feditedValidDate\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1f\n" +
 	"\vdiscount_id\x18\b \x01(\tR\n" +
 	"discountId\x12#\n" +
-	"\rdiscount_name\x18\t \x01(\tR\fdiscountName\"\xba\x02\n" +
+	"\rdiscount_name\x18\t \x01(\tR\fdiscountName\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\v \x01(\x05R\tinvoiceId\"\xba\x02\n" +
 	"\x12CompanyFinanceSelf\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
 	"\ttariff_id\x18\x02 \x01(\x05R\btariffId\x12\x1d\n" +
 	"\n" +
-	"tariff_sum\x18\x03 \x01(\x05R\ttariffSum\x12\x18\n" +
+	"tariff_sum\x18\x03 \x01(\tR\ttariffSum\x12\x18\n" +
 	"\acomment\x18\x04 \x01(\tR\acomment\x12\x10\n" +
-	"\x03sum\x18\x05 \x01(\x02R\x03sum\x12&\n" +
+	"\x03sum\x18\x05 \x01(\tR\x03sum\x12&\n" +
 	"\x0fedit_valid_date\x18\x06 \x01(\tR\reditValidDate\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1f\n" +
@@ -6533,11 +9999,11 @@ const file_education_proto_rawDesc = "" +
 	"tariffName\"\xf8\x01\n" +
 	"\x16CompanyFinanceSelfList\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\x05R\x05count\x12*\n" +
-	"\x11sum_amount_period\x18\x02 \x01(\x02R\x0fsumAmountPeriod\x12\x1f\n" +
+	"\x11sum_amount_period\x18\x02 \x01(\tR\x0fsumAmountPeriod\x12\x1f\n" +
 	"\vtariff_name\x18\x03 \x01(\tR\n" +
 	"tariffName\x12#\n" +
 	"\rdiscount_name\x18\x04 \x01(\tR\fdiscountName\x12!\n" +
-	"\frequired_sum\x18\x05 \x01(\x02R\vrequiredSum\x123\n" +
+	"\frequired_sum\x18\x05 \x01(\tR\vrequiredSum\x123\n" +
 	"\x05items\x18\x06 \x03(\v2\x1d.education.CompanyFinanceSelfR\x05items\"b\n" +
 	"\x12CompanyFinanceList\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\x05R\x05count\x126\n" +
@@ -6554,13 +10020,110 @@ const file_education_proto_rawDesc = "" +
 	"\ttariff_id\x18\x06 \x01(\tR\btariffId\x12\x1f\n" +
 	"\vtariff_name\x18\a \x01(\tR\n" +
 	"tariffName\x12\x10\n" +
-	"\x03sum\x18\b \x01(\x02R\x03sum\x12\x1f\n" +
+	"\x03sum\x18\b \x01(\tR\x03sum\x12\x1f\n" +
 	"\vdiscount_id\x18\t \x01(\tR\n" +
 	"discountId\x12#\n" +
 	"\rdiscount_name\x18\n" +
 	" \x01(\tR\fdiscountName\x12\x1d\n" +
 	"\n" +
-	"tariff_sum\x18\v \x01(\x02R\ttariffSum\"\xd5\x01\n" +
+	"tariff_sum\x18\v \x01(\tR\ttariffSum\"~\n" +
+	"\fQuoteRequest\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x1a\n" +
+	"\btariffId\x18\x02 \x01(\x05R\btariffId\x12\x16\n" +
+	"\x06months\x18\x03 \x01(\x05R\x06months\x12\x1c\n" +
+	"\tpromoCode\x18\x04 \x01(\tR\tpromoCode\"\xa1\x03\n" +
+	"\vTariffQuote\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x1a\n" +
+	"\btariffId\x18\x02 \x01(\x05R\btariffId\x12\x1e\n" +
+	"\n" +
+	"tariffName\x18\x03 \x01(\tR\n" +
+	"tariffName\x12\x16\n" +
+	"\x06months\x18\x04 \x01(\x05R\x06months\x12\"\n" +
+	"\fmonthlyPrice\x18\x05 \x01(\tR\fmonthlyPrice\x12\x18\n" +
+	"\abaseSum\x18\x06 \x01(\tR\abaseSum\x12$\n" +
+	"\rprepayPercent\x18\a \x01(\x01R\rprepayPercent\x12(\n" +
+	"\x0foverridePercent\x18\b \x01(\x01R\x0foverridePercent\x12\x1c\n" +
+	"\tpromoCode\x18\t \x01(\tR\tpromoCode\x12 \n" +
+	"\vdiscountSum\x18\n" +
+	" \x01(\tR\vdiscountSum\x12\x14\n" +
+	"\x05total\x18\v \x01(\tR\x05total\x12\x1e\n" +
+	"\n" +
+	"periodFrom\x18\f \x01(\tR\n" +
+	"periodFrom\x12\x1c\n" +
+	"\tvalidDate\x18\r \x01(\tR\tvalidDate\"\xaf\x01\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12,\n" +
+	"\x05quote\x18\x02 \x01(\v2\x16.education.TariffQuoteR\x05quote\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\apaidSum\x18\x04 \x01(\tR\apaidSum\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06paidAt\x18\x06 \x01(\tR\x06paidAt\"r\n" +
+	"\x12GetInvoicesRequest\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"M\n" +
+	"\vInvoiceList\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.education.InvoiceR\x05items\"\xf9\x01\n" +
+	"\tPromoCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\apercent\x18\x02 \x01(\x01R\apercent\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1a\n" +
+	"\btariffId\x18\x04 \x01(\x05R\btariffId\x12\x1c\n" +
+	"\tvalidFrom\x18\x05 \x01(\tR\tvalidFrom\x12\x18\n" +
+	"\avalidTo\x18\x06 \x01(\tR\avalidTo\x12\x18\n" +
+	"\amaxUses\x18\a \x01(\x05R\amaxUses\x12\x1c\n" +
+	"\tusedCount\x18\b \x01(\x05R\tusedCount\x12\x1a\n" +
+	"\bisActive\x18\t \x01(\bR\bisActive\";\n" +
+	"\rPromoCodeList\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.education.PromoCodeR\x05items\"\x9f\x01\n" +
+	"\rPriceOverride\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\"\n" +
+	"\fmonthlyPrice\x18\x02 \x01(\tR\fmonthlyPrice\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\x12\x18\n" +
+	"\avalidTo\x18\x04 \x01(\tR\avalidTo\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"7\n" +
+	"\x17GetPriceOverrideRequest\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\"5\n" +
+	"\x15CheckSubdomainRequest\x12\x1c\n" +
+	"\tsubdomain\x18\x01 \x01(\tR\tsubdomain\"l\n" +
+	"\x16CheckSubdomainResponse\x12\x1c\n" +
+	"\tsubdomain\x18\x01 \x01(\tR\tsubdomain\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xf1\x01\n" +
+	"\rSignupRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
+	"\tsubdomain\x18\x02 \x01(\tR\tsubdomain\x12\"\n" +
+	"\fcompanyPhone\x18\x03 \x01(\tR\fcompanyPhone\x12\x1c\n" +
+	"\tstartTime\x18\x04 \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x05 \x01(\tR\aendTime\x12\x18\n" +
+	"\aceoName\x18\x06 \x01(\tR\aceoName\x12\x1a\n" +
+	"\bceoPhone\x18\a \x01(\tR\bceoPhone\x12\x1a\n" +
+	"\bpassword\x18\b \x01(\tR\bpassword\"\x9c\x01\n" +
+	"\x0eSignupResponse\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x1c\n" +
+	"\tsubdomain\x18\x02 \x01(\tR\tsubdomain\x12\x14\n" +
+	"\x05ceoId\x18\x03 \x01(\tR\x05ceoId\x12\x1c\n" +
+	"\tvalidDate\x18\x04 \x01(\tR\tvalidDate\x12\x1a\n" +
+	"\btariffId\x18\x05 \x01(\x05R\btariffId\"\x18\n" +
+	"\x16GetSubscriptionRequest\"\xc3\x02\n" +
+	"\x13CompanySubscription\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1c\n" +
+	"\tvalidDate\x18\x03 \x01(\tR\tvalidDate\x12\x1e\n" +
+	"\n" +
+	"graceUntil\x18\x04 \x01(\tR\n" +
+	"graceUntil\x12\x1a\n" +
+	"\bdaysLeft\x18\x05 \x01(\x05R\bdaysLeft\x12\x16\n" +
+	"\x06isDemo\x18\x06 \x01(\bR\x06isDemo\x12\x1a\n" +
+	"\btariffId\x18\a \x01(\x05R\btariffId\x12\x1e\n" +
+	"\n" +
+	"tariffName\x18\b \x01(\tR\n" +
+	"tariffName\x12\"\n" +
+	"\fstudentLimit\x18\t \x01(\x05R\fstudentLimit\x12&\n" +
+	"\x0eactiveStudents\x18\n" +
+	" \x01(\x05R\x0eactiveStudents\"\xd5\x01\n" +
 	"\x14GetStatisticResponse\x129\n" +
 	"\adetails\x18\x01 \x01(\v2\x1f.education.CompanyCommonDetailsR\adetails\x12A\n" +
 	"\x0fregisterDetails\x18\x02 \x03(\v2\x17.education.OtherDetailsR\x0fregisterDetails\x12?\n" +
@@ -6634,17 +10197,21 @@ const file_education_proto_rawDesc = "" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x17\n" +
 	"\ais_demo\x18\f \x01(\bR\x06isDemo\x120\n" +
 	"\x14active_student_count\x18\r \x01(\x05R\x12activeStudentCount\x12\x15\n" +
-	"\x06ceo_id\x18\x0e \x01(\tR\x05ceoId\"\xbf\x01\n" +
+	"\x06ceo_id\x18\x0e \x01(\tR\x05ceoId\"\x85\x02\n" +
 	"\x06Tariff\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
 	"\rstudent_count\x18\x03 \x01(\x05R\fstudentCount\x12\x10\n" +
-	"\x03sum\x18\x04 \x01(\x02R\x03sum\x12\x1c\n" +
+	"\x03sum\x18\x04 \x01(\tR\x03sum\x12\x1c\n" +
 	"\tdiscounts\x18\x05 \x01(\tR\tdiscounts\x12\x1d\n" +
 	"\n" +
 	"is_deleted\x18\x06 \x01(\bR\tisDeleted\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\"K\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12D\n" +
+	"\x10prepay_discounts\x18\b \x03(\v2\x19.education.PrepayDiscountR\x0fprepayDiscounts\"B\n" +
+	"\x0ePrepayDiscount\x12\x16\n" +
+	"\x06months\x18\x01 \x01(\x05R\x06months\x12\x18\n" +
+	"\apercent\x18\x02 \x01(\x01R\apercent\"K\n" +
 	"\n" +
 	"TariffList\x12\x14\n" +
 	"\x05count\x18\x01 \x01(\x05R\x05count\x12'\n" +
@@ -6662,7 +10229,7 @@ const file_education_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
 	"\x0elessonDuration\x18\x02 \x01(\x05R\x0elessonDuration\x12&\n" +
 	"\x0ecourseDuration\x18\x03 \x01(\x05R\x0ecourseDuration\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12 \n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\"D\n" +
 	"\x12GetUpdateCourseAbs\x12.\n" +
 	"\acourses\x18\x01 \x03(\v2\x14.education.AbsCourseR\acourses\"\xb7\x01\n" +
@@ -6671,14 +10238,14 @@ const file_education_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
 	"\x0elessonDuration\x18\x02 \x01(\x05R\x0elessonDuration\x12&\n" +
 	"\x0ecourseDuration\x18\x03 \x01(\x05R\x0ecourseDuration\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12 \n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xe7\x01\n" +
 	"\x15GetCourseByIdResponse\x12\x0e\n" +
 	"\x02id\x18\a \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
 	"\x0elessonDuration\x18\x02 \x01(\x05R\x0elessonDuration\x12&\n" +
 	"\x0ecourseDuration\x18\x03 \x01(\x05R\x0ecourseDuration\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12 \n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\"\n" +
 	"\fstudentCount\x18\x06 \x01(\x05R\fstudentCount\"&\n" +
 	"\x14GetCourseByIdRequest\x12\x0e\n" +
@@ -6740,7 +10307,7 @@ const file_education_proto_rawDesc = "" +
 	"groupEndAt\x18\a \x01(\tR\n" +
 	"groupEndAt\x12.\n" +
 	"\x12activeStudentCount\x18\b \x01(\x05R\x12activeStudentCount\x121\n" +
-	"\bstudents\x18\t \x03(\v2\x15.education.AbsStudentR\bstudents\"\x98\x02\n" +
+	"\bstudents\x18\t \x03(\v2\x15.education.AbsStudentR\bstudents\"\xb8\x02\n" +
 	"\x12CreateGroupRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcourseId\x18\x02 \x01(\x05R\bcourseId\x12\x1c\n" +
@@ -6750,7 +10317,11 @@ const file_education_proto_rawDesc = "" +
 	"\x06roomId\x18\x06 \x01(\x05R\x06roomId\x12(\n" +
 	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
 	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
-	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"a\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\x12\x1e\n" +
+	"\n" +
+	"requestKey\x18\n" +
+	" \x01(\tR\n" +
+	"requestKey\"a\n" +
 	"\x13GetGroupByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
 	"\n" +
@@ -6830,17 +10401,17 @@ const file_education_proto_rawDesc = "" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12<\n" +
 	"\x19commonLessonCountInPeriod\x18\x03 \x01(\x05R\x19commonLessonCountInPeriod\x124\n" +
-	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\"\x99\x02\n" +
+	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\"\xa9\x02\n" +
 	"\rStudentSalary\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
 	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12,\n" +
-	"\x11passedLessonCount\x18\x03 \x01(\x05R\x11passedLessonCount\x12:\n" +
-	"\x18calculatedSalaryInPeriod\x18\x04 \x01(\x05R\x18calculatedSalaryInPeriod\x12\x1c\n" +
+	"\x11passedLessonCount\x18\x03 \x01(\x05R\x11passedLessonCount\x12\x1c\n" +
 	"\tpriceType\x18\x05 \x01(\tR\tpriceType\x12\x1e\n" +
 	"\n" +
-	"totalCount\x18\x06 \x01(\x01R\n" +
+	"totalCount\x18\x06 \x01(\tR\n" +
 	"totalCount\x12 \n" +
-	"\vcoursePrice\x18\a \x01(\x01R\vcoursePrice\"\xb8\x01\n" +
+	"\vcoursePrice\x18\a \x01(\tR\vcoursePrice\x12*\n" +
+	"\x10calculatedSalary\x18\b \x01(\tR\x10calculatedSalaryJ\x04\b\x04\x10\x05R\x18calculatedSalaryInPeriod\"\xb8\x01\n" +
 	"\x14GetAttendanceRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
 	"\x04from\x18\x02 \x01(\tR\x04from\x12\x12\n" +
@@ -6863,7 +10434,7 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"attendance\x18\x03 \x03(\v2\x15.education.AttendanceR\n" +
 	"attendance\x12\x18\n" +
-	"\abalance\x18\x04 \x01(\x01R\abalance\x12\x1c\n" +
+	"\abalance\x18\x04 \x01(\tR\abalance\x12\x1c\n" +
 	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\x12 \n" +
 	"\vdateOfBirth\x18\x06 \x01(\tR\vdateOfBirth\x12;\n" +
 	"\ffreezeDetail\x18\a \x01(\v2\x17.education.FreezeDetailR\ffreezeDetail\x12\x16\n" +
@@ -6894,7 +10465,22 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"actionById\x18\x06 \x01(\tR\n" +
 	"actionById\x12\"\n" +
-	"\factionByRole\x18\a \x01(\tR\factionByRole\"\xbb\x02\n" +
+	"\factionByRole\x18\a \x01(\tR\factionByRole\"|\n" +
+	"\x16DiscardStudentsRequest\x12\x1e\n" +
+	"\n" +
+	"studentIds\x18\x01 \x03(\tR\n" +
+	"studentIds\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12(\n" +
+	"\x0fgroupRequestKey\x18\x03 \x01(\tR\x0fgroupRequestKey\">\n" +
+	"\x1aFindStudentsByPhoneRequest\x12 \n" +
+	"\vphoneNumber\x18\x01 \x01(\tR\vphoneNumber\"\xae\x01\n" +
+	"\x14MergeStudentsRequest\x12(\n" +
+	"\x0fsourceStudentId\x18\x01 \x01(\tR\x0fsourceStudentId\x12(\n" +
+	"\x0ftargetStudentId\x18\x02 \x01(\tR\x0ftargetStudentId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x04 \x01(\tR\factionByName\"\xdf\x02\n" +
 	"\x1dCalculateDiscountSummaRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12$\n" +
@@ -6902,9 +10488,10 @@ const file_education_proto_rawDesc = "" +
 	"\tstartDate\x18\x04 \x01(\tR\tstartDate\x12\x18\n" +
 	"\aendDate\x18\x05 \x01(\tR\aendDate\x12 \n" +
 	"\vpaymentDate\x18\x06 \x01(\tR\vpaymentDate\x12b\n" +
-	",studentActivationDateInThisGroupWhilePayment\x18\a \x01(\tR,studentActivationDateInThisGroupWhilePayment\"E\n" +
+	",studentActivationDateInThisGroupWhilePayment\x18\a \x01(\tR,studentActivationDateInThisGroupWhilePayment\x12\"\n" +
+	"\fdiscountType\x18\b \x01(\tR\fdiscountType\"E\n" +
 	"\x19CalculateDiscountResponse\x12(\n" +
-	"\x0fcalculatedPrice\x18\x01 \x01(\tR\x0fcalculatedPrice\"\xbf\x02\n" +
+	"\x0fcalculatedPrice\x18\x01 \x01(\tR\x0fcalculatedPrice\"\xd9\x02\n" +
 	"&ChangeUserBalanceHistoryByDebitRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x1a\n" +
 	"\boldDebit\x18\x02 \x01(\tR\boldDebit\x12\x1c\n" +
@@ -6914,7 +10501,9 @@ const file_education_proto_rawDesc = "" +
 	"\tcreatedBy\x18\x06 \x01(\tR\tcreatedBy\x12$\n" +
 	"\rcreatedByName\x18\a \x01(\tR\rcreatedByName\x12\x18\n" +
 	"\agroupId\x18\b \x01(\tR\agroupId\x12\"\n" +
-	"\fcurrentDebit\x18\t \x01(\tR\fcurrentDebit\"\x90\x02\n" +
+	"\fcurrentDebit\x18\t \x01(\tR\fcurrentDebit\x12\x18\n" +
+	"\aeventId\x18\n" +
+	" \x01(\tR\aeventId\"\xaa\x02\n" +
 	"\x1fChangeUserBalanceHistoryRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
@@ -6923,7 +10512,8 @@ const file_education_proto_rawDesc = "" +
 	"\fpayment_type\x18\x05 \x01(\tR\vpaymentType\x12\x1c\n" +
 	"\tcreatedBy\x18\x06 \x01(\tR\tcreatedBy\x12$\n" +
 	"\rcreatedByName\x18\a \x01(\tR\rcreatedByName\x12\x18\n" +
-	"\agroupId\x18\b \x01(\tR\agroupId\"\x9a\x01\n" +
+	"\agroupId\x18\b \x01(\tR\agroupId\x12\x18\n" +
+	"\aeventId\x18\t \x01(\tR\aeventId\"\x9a\x01\n" +
 	"\x14DeleteStudentRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
 	"\vreturnMoney\x18\x02 \x01(\bR\vreturnMoney\x12\x1e\n" +
@@ -7019,7 +10609,7 @@ const file_education_proto_rawDesc = "" +
 	"passportId\x12,\n" +
 	"\x11additionalContact\x18\b \x01(\tR\x11additionalContact\x12\x18\n" +
 	"\abalance\x18\n" +
-	" \x01(\x01R\abalance\x12\x1c\n" +
+	" \x01(\tR\abalance\x12\x1c\n" +
 	"\tcondition\x18\v \x01(\tR\tcondition\x12*\n" +
 	"\x10telegramUsername\x18\f \x01(\tR\x10telegramUsername\x12\x1c\n" +
 	"\tcreatedAt\x18\r \x01(\tR\tcreatedAt\x128\n" +
@@ -7037,7 +10627,7 @@ const file_education_proto_rawDesc = "" +
 	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
 	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\x12*\n" +
 	"\x10studentCondition\x18\v \x01(\tR\x10studentCondition\x12.\n" +
-	"\x12studentActivatedAt\x18\f \x01(\tR\x12studentActivatedAt\"\xee\x02\n" +
+	"\x12studentActivatedAt\x18\f \x01(\tR\x12studentActivatedAt\"\xb0\x03\n" +
 	"\x14CreateStudentRequest\x12 \n" +
 	"\vphoneNumber\x18\x01 \x01(\tR\vphoneNumber\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -7052,7 +10642,10 @@ const file_education_proto_rawDesc = "" +
 	" \x01(\tR\x10telegramUsername\x12\x18\n" +
 	"\agroupId\x18\b \x01(\tR\agroupId\x12\x1a\n" +
 	"\bdateFrom\x18\t \x01(\tR\bdateFrom\x12\x1c\n" +
-	"\tcreatedBy\x18\v \x01(\tR\tcreatedBy\"\x8c\x02\n" +
+	"\tcreatedBy\x18\v \x01(\tR\tcreatedBy\x12\x0e\n" +
+	"\x02id\x18\f \x01(\tR\x02id\x12\x18\n" +
+	"\acomment\x18\r \x01(\tR\acomment\x12\x16\n" +
+	"\x06source\x18\x0e \x01(\tR\x06source\"\x8c\x02\n" +
 	"\x14UpdateStudentRequest\x12\x1c\n" +
 	"\tstudentId\x18\b \x01(\tR\tstudentId\x12 \n" +
 	"\vphoneNumber\x18\x01 \x01(\tR\vphoneNumber\x12\x12\n" +
@@ -7069,19 +10662,20 @@ const file_education_proto_rawDesc = "" +
 	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1f\n" +
 	"\vstudent_ids\x18\x03 \x03(\tR\n" +
 	"studentIds\x12\x1c\n" +
-	"\tcreatedBy\x18\x04 \x01(\tR\tcreatedBy\"\xc4\x02\n" +
+	"\tcreatedBy\x18\x04 \x01(\tR\tcreatedBy\"\x83\x03\n" +
 	"\x16GetStudentByIdResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
 	"\x06gender\x18\x03 \x01(\bR\x06gender\x12 \n" +
 	"\vdateOfBirth\x18\x04 \x01(\tR\vdateOfBirth\x12\x14\n" +
 	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x18\n" +
-	"\abalance\x18\x06 \x01(\x01R\abalance\x12\x1c\n" +
+	"\abalance\x18\x06 \x01(\tR\abalance\x12\x1c\n" +
 	"\tcreatedAt\x18\a \x01(\tR\tcreatedAt\x122\n" +
 	"\x06groups\x18\b \x03(\v2\x1a.education.GetGroupStudentR\x06groups\x12\x1c\n" +
 	"\tcondition\x18\t \x01(\tR\tcondition\x12,\n" +
 	"\x11additionalContact\x18\n" +
-	" \x01(\tR\x11additionalContact\")\n" +
+	" \x01(\tR\x11additionalContact\x12=\n" +
+	"\fpaymentPlans\x18\v \x03(\v2\x19.common.PaymentPlanStatusR\fpaymentPlans\")\n" +
 	"\x17NoteStudentByAbsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x81\x04\n" +
 	"\x0fGetGroupStudent\x12\x0e\n" +
@@ -7098,7 +10692,7 @@ const file_education_proto_rawDesc = "" +
 	"\x12studentActivatedAt\x18\f \x01(\tR\x12studentActivatedAt\x12&\n" +
 	"\x0estudentAddedAt\x18\x0e \x01(\tR\x0estudentAddedAt\x12 \n" +
 	"\vteacherName\x18\x0f \x01(\tR\vteacherName\x12(\n" +
-	"\x0fpriceForStudent\x18\r \x01(\x01R\x0fpriceForStudent\"=\n" +
+	"\x0fpriceForStudent\x18\r \x01(\tR\x0fpriceForStudent\"=\n" +
 	"\x11GetNotesByStudent\x12(\n" +
 	"\x05notes\x18\x01 \x03(\v2\x12.education.AbsNoteR\x05notes\"Q\n" +
 	"\aAbsNote\x12\x0e\n" +
@@ -7107,19 +10701,223 @@ const file_education_proto_rawDesc = "" +
 	"\tcreatedAt\x18\x03 \x01(\tR\tcreatedAt\"E\n" +
 	"\x11CreateNoteRequest\x12\x12\n" +
 	"\x04note\x18\x01 \x01(\tR\x04note\x12\x1c\n" +
-	"\tstudentId\x18\x02 \x01(\tR\tstudentId2\xe9\x02\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"\xb3\x01\n" +
+	"\x14NotificationSettings\x12\x12\n" +
+	"\x04lang\x18\x01 \x01(\tR\x04lang\x12\x1e\n" +
+	"\n" +
+	"smsEnabled\x18\x02 \x01(\bR\n" +
+	"smsEnabled\x12(\n" +
+	"\x0ftelegramEnabled\x18\x03 \x01(\bR\x0ftelegramEnabled\x12=\n" +
+	"\ttemplates\x18\x04 \x03(\v2\x1f.education.NotificationTemplateR\ttemplates\"r\n" +
+	"\x14NotificationTemplate\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1c\n" +
+	"\tisDefault\x18\x04 \x01(\bR\tisDefault\"^\n" +
+	"\x1cGetNotificationOutboxRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"x\n" +
+	"\x1dGetNotificationOutboxResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.education.NotificationOutboxItemR\x05items\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x92\x02\n" +
+	"\x16NotificationOutboxItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x1c\n" +
+	"\tlastError\x18\b \x01(\tR\tlastError\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06sentAt\x18\n" +
+	" \x01(\tR\x06sentAt\"\xbe\x01\n" +
+	"\x14DebtReminderSettings\x12\x1a\n" +
+	"\bisActive\x18\x01 \x01(\bR\bisActive\x12\"\n" +
+	"\fbalanceBelow\x18\x02 \x01(\tR\fbalanceBelow\x12 \n" +
+	"\voverdueDays\x18\x03 \x01(\x05R\voverdueDays\x12 \n" +
+	"\vcadenceDays\x18\x04 \x01(\x05R\vcadenceDays\x12\"\n" +
+	"\fmaxReminders\x18\x05 \x01(\x05R\fmaxReminders\"Y\n" +
+	"\x17GetDebtRemindersRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"m\n" +
+	"\x18GetDebtRemindersResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.education.DebtReminderItemR\x05items\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xcc\x03\n" +
+	"\x10DebtReminderItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x03 \x01(\tR\vstudentName\x12 \n" +
+	"\vphoneNumber\x18\x04 \x01(\tR\vphoneNumber\x12\x18\n" +
+	"\abalance\x18\x05 \x01(\tR\abalance\x12\x1c\n" +
+	"\tdebtSince\x18\x06 \x01(\tR\tdebtSince\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12$\n" +
+	"\rremindersSent\x18\b \x01(\x05R\rremindersSent\x12\x1e\n" +
+	"\n" +
+	"lastSentAt\x18\t \x01(\tR\n" +
+	"lastSentAt\x12\x1e\n" +
+	"\n" +
+	"nextSendAt\x18\n" +
+	" \x01(\tR\n" +
+	"nextSendAt\x12 \n" +
+	"\vreplyStatus\x18\v \x01(\tR\vreplyStatus\x12\"\n" +
+	"\freplyComment\x18\f \x01(\tR\freplyComment\x12\"\n" +
+	"\fpromisedDate\x18\r \x01(\tR\fpromisedDate\x12&\n" +
+	"\x0edeliveryStatus\x18\x0e \x01(\tR\x0edeliveryStatus\"\xa1\x01\n" +
+	"\x1bSetDebtReminderReplyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vreplyStatus\x18\x02 \x01(\tR\vreplyStatus\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\"\n" +
+	"\fpromisedDate\x18\x04 \x01(\tR\fpromisedDate\x12\x12\n" +
+	"\x04stop\x18\x05 \x01(\bR\x04stop\"\x87\x01\n" +
+	"\x1fRunBalanceReconciliationRequest\x12 \n" +
+	"\vautoCorrect\x18\x01 \x01(\bR\vautoCorrect\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x03 \x01(\tR\factionByName\"I\n" +
+	"\x1fGetReconciliationReportsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"y\n" +
+	" GetReconciliationReportsResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.education.ReconciliationReportR\x05items\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\"0\n" +
+	"\x1eGetReconciliationReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x02\n" +
+	"\x14ReconciliationReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tcreatedAt\x18\x02 \x01(\tR\tcreatedAt\x12\"\n" +
+	"\fcheckedCount\x18\x03 \x01(\x05R\fcheckedCount\x12\"\n" +
+	"\fskippedCount\x18\x04 \x01(\x05R\fskippedCount\x12\x1e\n" +
+	"\n" +
+	"driftCount\x18\x05 \x01(\x05R\n" +
+	"driftCount\x12\x1e\n" +
+	"\n" +
+	"totalDrift\x18\x06 \x01(\tR\n" +
+	"totalDrift\x12$\n" +
+	"\rautoCorrected\x18\a \x01(\bR\rautoCorrected\x12 \n" +
+	"\vtriggeredBy\x18\b \x01(\tR\vtriggeredBy\x12/\n" +
+	"\x06drifts\x18\t \x03(\v2\x17.education.BalanceDriftR\x06drifts\"\xd8\x01\n" +
+	"\fBalanceDrift\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12$\n" +
+	"\rledgerBalance\x18\x03 \x01(\tR\rledgerBalance\x12$\n" +
+	"\ractualBalance\x18\x04 \x01(\tR\ractualBalance\x12\x1e\n" +
+	"\n" +
+	"difference\x18\x05 \x01(\tR\n" +
+	"difference\x12\x1c\n" +
+	"\tcorrected\x18\x06 \x01(\bR\tcorrected\"<\n" +
+	"\x16PlatformMetricsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\x91\x03\n" +
+	"\x0fPlatformMetrics\x12\x10\n" +
+	"\x03mrr\x18\x01 \x01(\tR\x03mrr\x12\x10\n" +
+	"\x03arr\x18\x02 \x01(\tR\x03arr\x12(\n" +
+	"\x0fpayingCompanies\x18\x03 \x01(\x05R\x0fpayingCompanies\x12$\n" +
+	"\rdemoCompanies\x18\x04 \x01(\x05R\rdemoCompanies\x12&\n" +
+	"\x0egraceCompanies\x18\x05 \x01(\x05R\x0egraceCompanies\x12(\n" +
+	"\x0flockedCompanies\x18\x06 \x01(\x05R\x0flockedCompanies\x12&\n" +
+	"\x0etotalCompanies\x18\a \x01(\x05R\x0etotalCompanies\x12&\n" +
+	"\x0eactiveStudents\x18\b \x01(\x05R\x0eactiveStudents\x122\n" +
+	"\brenewals\x18\t \x03(\v2\x16.education.RenewalStatR\brenewals\x124\n" +
+	"\acohorts\x18\n" +
+	" \x03(\v2\x1a.education.CohortRetentionR\acohorts\"\xc3\x01\n" +
+	"\vRenewalStat\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x10\n" +
+	"\x03due\x18\x02 \x01(\x05R\x03due\x12\x18\n" +
+	"\arenewed\x18\x03 \x01(\x05R\arenewed\x12\x18\n" +
+	"\achurned\x18\x04 \x01(\x05R\achurned\x12\x18\n" +
+	"\apending\x18\x05 \x01(\x05R\apending\x12 \n" +
+	"\vrenewalRate\x18\x06 \x01(\x01R\vrenewalRate\x12\x1c\n" +
+	"\tchurnRate\x18\a \x01(\x01R\tchurnRate\"c\n" +
+	"\x0fCohortRetention\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x1c\n" +
+	"\tcompanies\x18\x02 \x01(\x05R\tcompanies\x12\x1c\n" +
+	"\tretention\x18\x03 \x03(\x01R\tretention\"S\n" +
+	"\x13TenantHealthRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"\xee\x03\n" +
+	"\fTenantHealth\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\x05R\tcompanyId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1c\n" +
+	"\tsubdomain\x18\x03 \x01(\tR\tsubdomain\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x16\n" +
+	"\x06isDemo\x18\x05 \x01(\bR\x06isDemo\x12\x1e\n" +
+	"\n" +
+	"tariffName\x18\x06 \x01(\tR\n" +
+	"tariffName\x12\x1c\n" +
+	"\tvalidDate\x18\a \x01(\tR\tvalidDate\x12\x1a\n" +
+	"\bstudents\x18\b \x01(\x05R\bstudents\x12\"\n" +
+	"\fstudentLimit\x18\t \x01(\x05R\fstudentLimit\x12\x16\n" +
+	"\x06groups\x18\n" +
+	" \x01(\x05R\x06groups\x12\x14\n" +
+	"\x05staff\x18\v \x01(\x05R\x05staff\x12\x14\n" +
+	"\x05leads\x18\f \x01(\x05R\x05leads\x12 \n" +
+	"\vrecentLeads\x18\r \x01(\x05R\vrecentLeads\x12\x1a\n" +
+	"\bpayments\x18\x0e \x01(\x05R\bpayments\x12 \n" +
+	"\vpaymentsSum\x18\x0f \x01(\tR\vpaymentsSum\x12\x14\n" +
+	"\x05score\x18\x10 \x01(\x05R\x05score\x12\x14\n" +
+	"\x05level\x18\x11 \x01(\tR\x05level\x12\x10\n" +
+	"\x03day\x18\x12 \x01(\tR\x03day\"w\n" +
+	"\x10TenantHealthList\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.education.TenantHealthR\x05items\x12\x1e\n" +
+	"\n" +
+	"incomplete\x18\x03 \x01(\bR\n" +
+	"incomplete\"R\n" +
+	"\x0eHistoryRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1c\n" +
+	"\tcompanyId\x18\x03 \x01(\x05R\tcompanyId\"\xe0\x02\n" +
+	"\x10PlatformSnapshot\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x10\n" +
+	"\x03mrr\x18\x02 \x01(\tR\x03mrr\x12\x10\n" +
+	"\x03arr\x18\x03 \x01(\tR\x03arr\x12(\n" +
+	"\x0fpayingCompanies\x18\x04 \x01(\x05R\x0fpayingCompanies\x12$\n" +
+	"\rdemoCompanies\x18\x05 \x01(\x05R\rdemoCompanies\x12&\n" +
+	"\x0egraceCompanies\x18\x06 \x01(\x05R\x0egraceCompanies\x12(\n" +
+	"\x0flockedCompanies\x18\a \x01(\x05R\x0flockedCompanies\x12&\n" +
+	"\x0etotalCompanies\x18\b \x01(\x05R\x0etotalCompanies\x12&\n" +
+	"\x0eactiveStudents\x18\t \x01(\x05R\x0eactiveStudents\x12$\n" +
+	"\raverageHealth\x18\n" +
+	" \x01(\x01R\raverageHealth\"D\n" +
+	"\x0fPlatformHistory\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.education.PlatformSnapshotR\x05items2\xe9\x02\n" +
 	"\x15CompanyFinanceService\x12@\n" +
 	"\x06Create\x12\x19.education.CompanyFinance\x1a\x19.education.CompanyFinance\"\x00\x129\n" +
 	"\x06Delete\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\"\x00\x12>\n" +
 	"\x06GetAll\x12\x13.common.PageRequest\x1a\x1d.education.CompanyFinanceList\"\x00\x12H\n" +
 	"\fGetByCompany\x12\x13.common.PageRequest\x1a!.education.CompanyFinanceSelfList\"\x00\x12I\n" +
-	"\x0fUpdateByCompany\x12\x19.education.CompanyFinance\x1a\x19.education.CompanyFinance\"\x002\xff\x02\n" +
+	"\x0fUpdateByCompany\x12\x19.education.CompanyFinance\x1a\x19.education.CompanyFinance\"\x002\xea\x04\n" +
+	"\x0eBillingService\x128\n" +
+	"\x05Quote\x12\x17.education.QuoteRequest\x1a\x16.education.TariffQuote\x12<\n" +
+	"\rCreateInvoice\x12\x17.education.QuoteRequest\x1a\x12.education.Invoice\x12D\n" +
+	"\vGetInvoices\x12\x1d.education.GetInvoicesRequest\x1a\x16.education.InvoiceList\x12<\n" +
+	"\vVoidInvoice\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12=\n" +
+	"\x0fCreatePromoCode\x12\x14.education.PromoCode\x1a\x14.education.PromoCode\x12A\n" +
+	"\rGetPromoCodes\x12\x16.google.protobuf.Empty\x1a\x18.education.PromoCodeList\x12@\n" +
+	"\x13DeactivatePromoCode\x12\x14.education.PromoCode\x1a\x13.common.AbsResponse\x12F\n" +
+	"\x10SetPriceOverride\x12\x18.education.PriceOverride\x1a\x18.education.PriceOverride\x12P\n" +
+	"\x10GetPriceOverride\x12\".education.GetPriceOverrideRequest\x1a\x18.education.PriceOverride2\xa5\x01\n" +
+	"\rSignupService\x12U\n" +
+	"\x0eCheckSubdomain\x12 .education.CheckSubdomainRequest\x1a!.education.CheckSubdomainResponse\x12=\n" +
+	"\x06Signup\x12\x18.education.SignupRequest\x1a\x19.education.SignupResponse2\xd5\x03\n" +
 	"\x0eCompanyService\x12T\n" +
 	"\x15GetCompanyBySubdomain\x12\x1c.education.GetCompanyRequest\x1a\x1d.education.GetCompanyResponse\x12E\n" +
 	"\rCreateCompany\x12\x1f.education.CreateCompanyRequest\x1a\x13.common.AbsResponse\x128\n" +
 	"\x06GetAll\x12\x13.common.PageRequest\x1a\x19.education.GetAllResponse\x12E\n" +
 	"\rUpdateCompany\x12\x1f.education.UpdateCompanyRequest\x1a\x13.common.AbsResponse\x12O\n" +
-	"\fGetStatistic\x12\x1e.education.GetStatisticRequest\x1a\x1f.education.GetStatisticResponse2\xd5\x01\n" +
+	"\fGetStatistic\x12\x1e.education.GetStatisticRequest\x1a\x1f.education.GetStatisticResponse\x12T\n" +
+	"\x0fGetSubscription\x12!.education.GetSubscriptionRequest\x1a\x1e.education.CompanySubscription2\xd5\x01\n" +
 	"\rTariffService\x12.\n" +
 	"\x06Create\x12\x11.education.Tariff\x1a\x11.education.Tariff\x12.\n" +
 	"\x06Update\x12\x11.education.Tariff\x1a\x11.education.Tariff\x12.\n" +
@@ -7154,7 +10952,7 @@ const file_education_proto_rawDesc = "" +
 	"\x11AttendanceService\x12R\n" +
 	"\rGetAttendance\x12\x1f.education.GetAttendanceRequest\x1a .education.GetAttendanceResponse\x12E\n" +
 	"\rSetAttendance\x12\x1f.education.SetAttendanceRequest\x1a\x13.common.AbsResponse\x12y\n" +
-	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2\x99\f\n" +
+	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2\x8b\x0e\n" +
 	"\x0eStudentService\x12R\n" +
 	"\rGetAllStudent\x12\x1f.education.GetAllStudentRequest\x1a .education.GetAllStudentResponse\x12E\n" +
 	"\rCreateStudent\x12\x1f.education.CreateStudentRequest\x1a\x13.common.AbsResponse\x12E\n" +
@@ -7174,7 +10972,28 @@ const file_education_proto_rawDesc = "" +
 	"\x14GetStudentsByGroupId\x12&.education.GetStudentsByGroupIdRequest\x1a'.education.GetStudentsByGroupIdResponse\x12[\n" +
 	"\x18ChangeUserBalanceHistory\x12*.education.ChangeUserBalanceHistoryRequest\x1a\x13.common.AbsResponse\x12i\n" +
 	"\x1fChangeUserBalanceHistoryByDebit\x121.education.ChangeUserBalanceHistoryByDebitRequest\x1a\x13.common.AbsResponse\x12h\n" +
-	"\x16CalculateDiscountSumma\x12(.education.CalculateDiscountSummaRequest\x1a$.education.CalculateDiscountResponseB\n" +
+	"\x16CalculateDiscountSumma\x12(.education.CalculateDiscountSummaRequest\x1a$.education.CalculateDiscountResponse\x12^\n" +
+	"\x13FindStudentsByPhone\x12%.education.FindStudentsByPhoneRequest\x1a .education.SearchStudentResponse\x12E\n" +
+	"\rMergeStudents\x12\x1f.education.MergeStudentsRequest\x1a\x13.common.AbsResponse\x12I\n" +
+	"\x0fDiscardStudents\x12!.education.DiscardStudentsRequest\x1a\x13.common.AbsResponse2\xa9\x02\n" +
+	"\x13NotificationService\x12R\n" +
+	"\x17GetNotificationSettings\x12\x16.google.protobuf.Empty\x1a\x1f.education.NotificationSettings\x12R\n" +
+	"\x1aUpdateNotificationSettings\x12\x1f.education.NotificationSettings\x1a\x13.common.AbsResponse\x12j\n" +
+	"\x15GetNotificationOutbox\x12'.education.GetNotificationOutboxRequest\x1a(.education.GetNotificationOutboxResponse2\xef\x02\n" +
+	"\x13DebtReminderService\x12R\n" +
+	"\x17GetDebtReminderSettings\x12\x16.google.protobuf.Empty\x1a\x1f.education.DebtReminderSettings\x12R\n" +
+	"\x1aUpdateDebtReminderSettings\x12\x1f.education.DebtReminderSettings\x1a\x13.common.AbsResponse\x12[\n" +
+	"\x10GetDebtReminders\x12\".education.GetDebtRemindersRequest\x1a#.education.GetDebtRemindersResponse\x12S\n" +
+	"\x14SetDebtReminderReply\x12&.education.SetDebtReminderReplyRequest\x1a\x13.common.AbsResponse2\xdc\x02\n" +
+	"\x15ReconciliationService\x12g\n" +
+	"\x18RunBalanceReconciliation\x12*.education.RunBalanceReconciliationRequest\x1a\x1f.education.ReconciliationReport\x12s\n" +
+	"\x18GetReconciliationReports\x12*.education.GetReconciliationReportsRequest\x1a+.education.GetReconciliationReportsResponse\x12e\n" +
+	"\x17GetReconciliationReport\x12).education.GetReconciliationReportRequest\x1a\x1f.education.ReconciliationReport2\xd0\x02\n" +
+	"\x10AnalyticsService\x12S\n" +
+	"\x12GetPlatformMetrics\x12!.education.PlatformMetricsRequest\x1a\x1a.education.PlatformMetrics\x12N\n" +
+	"\x0fGetTenantHealth\x12\x1e.education.TenantHealthRequest\x1a\x1b.education.TenantHealthList\x12K\n" +
+	"\x12GetPlatformHistory\x12\x19.education.HistoryRequest\x1a\x1a.education.PlatformHistory\x12J\n" +
+	"\x10GetTenantHistory\x12\x19.education.HistoryRequest\x1a\x1b.education.TenantHealthListB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -7189,255 +11008,373 @@ func file_education_proto_rawDescGZIP() []byte {
 	return file_education_proto_rawDescData
 }
 
-var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 130)
 var file_education_proto_goTypes = []any{
 	(*CompanyFinance)(nil),                         // 0: education.CompanyFinance
 	(*CompanyFinanceSelf)(nil),                     // 1: education.CompanyFinanceSelf
 	(*CompanyFinanceSelfList)(nil),                 // 2: education.CompanyFinanceSelfList
 	(*CompanyFinanceList)(nil),                     // 3: education.CompanyFinanceList
 	(*CompanyFinanceForList)(nil),                  // 4: education.CompanyFinanceForList
-	(*GetStatisticResponse)(nil),                   // 5: education.GetStatisticResponse
-	(*OtherDetails)(nil),                           // 6: education.OtherDetails
-	(*CompanyCommonDetails)(nil),                   // 7: education.CompanyCommonDetails
-	(*GetStatisticRequest)(nil),                    // 8: education.GetStatisticRequest
-	(*UpdateCompanyRequest)(nil),                   // 9: education.UpdateCompanyRequest
-	(*GetAllResponse)(nil),                         // 10: education.GetAllResponse
-	(*CreateCompanyRequest)(nil),                   // 11: education.CreateCompanyRequest
-	(*GetCompanyRequest)(nil),                      // 12: education.GetCompanyRequest
-	(*GetCompanyResponse)(nil),                     // 13: education.GetCompanyResponse
-	(*Tariff)(nil),                                 // 14: education.Tariff
-	(*TariffList)(nil),                             // 15: education.TariffList
-	(*CreateRoomRequest)(nil),                      // 16: education.CreateRoomRequest
-	(*GetUpdateRoomAbs)(nil),                       // 17: education.GetUpdateRoomAbs
-	(*AbsRoom)(nil),                                // 18: education.AbsRoom
-	(*CreateCourseRequest)(nil),                    // 19: education.CreateCourseRequest
-	(*GetUpdateCourseAbs)(nil),                     // 20: education.GetUpdateCourseAbs
-	(*AbsCourse)(nil),                              // 21: education.AbsCourse
-	(*GetCourseByIdResponse)(nil),                  // 22: education.GetCourseByIdResponse
-	(*GetCourseByIdRequest)(nil),                   // 23: education.GetCourseByIdRequest
-	(*GetLeftAfterTrialPeriodRequest)(nil),         // 24: education.GetLeftAfterTrialPeriodRequest
-	(*GetLeftAfterTrialPeriodResponse)(nil),        // 25: education.GetLeftAfterTrialPeriodResponse
-	(*AbsGetLeftAfter)(nil),                        // 26: education.AbsGetLeftAfter
-	(*StudentIdRequest)(nil),                       // 27: education.StudentIdRequest
-	(*GetGroupsByStudentResponse)(nil),             // 28: education.GetGroupsByStudentResponse
-	(*DebtorGroup)(nil),                            // 29: education.DebtorGroup
-	(*DebtorComment)(nil),                          // 30: education.DebtorComment
-	(*GetCommonInformationEducationResponse)(nil),  // 31: education.GetCommonInformationEducationResponse
-	(*GetGroupsByTeacherIdRequest)(nil),            // 32: education.GetGroupsByTeacherIdRequest
-	(*GetGroupsByTeacherResponse)(nil),             // 33: education.GetGroupsByTeacherResponse
-	(*GetGroupByTeacherAbs)(nil),                   // 34: education.GetGroupByTeacherAbs
-	(*CreateGroupRequest)(nil),                     // 35: education.CreateGroupRequest
-	(*GetGroupByIdRequest)(nil),                    // 36: education.GetGroupByIdRequest
-	(*GetUpdateGroupAbs)(nil),                      // 37: education.GetUpdateGroupAbs
-	(*GetGroupsByCourseResponse)(nil),              // 38: education.GetGroupsByCourseResponse
-	(*GetGroupByCourseAbsResponse)(nil),            // 39: education.GetGroupByCourseAbsResponse
-	(*GetGroupAbsResponse)(nil),                    // 40: education.GetGroupAbsResponse
-	(*GetGroupsResponse)(nil),                      // 41: education.GetGroupsResponse
-	(*GetGroupsRequest)(nil),                       // 42: education.GetGroupsRequest
-	(*CalculateTeacherSalaryRequest)(nil),          // 43: education.CalculateTeacherSalaryRequest
-	(*CalculateTeacherSalaryResponse)(nil),         // 44: education.CalculateTeacherSalaryResponse
-	(*AbsCalculateSalary)(nil),                     // 45: education.AbsCalculateSalary
-	(*StudentSalary)(nil),                          // 46: education.StudentSalary
-	(*GetAttendanceRequest)(nil),                   // 47: education.GetAttendanceRequest
-	(*GetAttendanceResponse)(nil),                  // 48: education.GetAttendanceResponse
-	(*Day)(nil),                                    // 49: education.Day
-	(*Student)(nil),                                // 50: education.Student
-	(*Attendance)(nil),                             // 51: education.Attendance
-	(*FreezeDetail)(nil),                           // 52: education.FreezeDetail
-	(*SetAttendanceRequest)(nil),                   // 53: education.SetAttendanceRequest
-	(*CalculateDiscountSummaRequest)(nil),          // 54: education.CalculateDiscountSummaRequest
-	(*CalculateDiscountResponse)(nil),              // 55: education.CalculateDiscountResponse
-	(*ChangeUserBalanceHistoryByDebitRequest)(nil), // 56: education.ChangeUserBalanceHistoryByDebitRequest
-	(*ChangeUserBalanceHistoryRequest)(nil),        // 57: education.ChangeUserBalanceHistoryRequest
-	(*DeleteStudentRequest)(nil),                   // 58: education.DeleteStudentRequest
-	(*GetStudentsByGroupIdResponse)(nil),           // 59: education.GetStudentsByGroupIdResponse
-	(*GetStudentsByGroupIdRequest)(nil),            // 60: education.GetStudentsByGroupIdRequest
-	(*ChangeConditionStudentRequest)(nil),          // 61: education.ChangeConditionStudentRequest
-	(*TransferLessonRequest)(nil),                  // 62: education.TransferLessonRequest
-	(*GetHistoryGroupResponse)(nil),                // 63: education.GetHistoryGroupResponse
-	(*GetHistoryStudentResponse)(nil),              // 64: education.GetHistoryStudentResponse
-	(*AbsStudentHistory)(nil),                      // 65: education.AbsStudentHistory
-	(*AbsGroup)(nil),                               // 66: education.AbsGroup
-	(*AbsHistory)(nil),                             // 67: education.AbsHistory
-	(*SearchStudentRequest)(nil),                   // 68: education.SearchStudentRequest
-	(*SearchStudentResponse)(nil),                  // 69: education.SearchStudentResponse
-	(*AbsStudent)(nil),                             // 70: education.AbsStudent
-	(*GetAllStudentRequest)(nil),                   // 71: education.GetAllStudentRequest
-	(*GetAllStudentResponse)(nil),                  // 72: education.GetAllStudentResponse
-	(*GetGroupsAbsForStudent)(nil),                 // 73: education.GetGroupsAbsForStudent
-	(*GroupGetAllStudentAbs)(nil),                  // 74: education.GroupGetAllStudentAbs
-	(*CreateStudentRequest)(nil),                   // 75: education.CreateStudentRequest
-	(*UpdateStudentRequest)(nil),                   // 76: education.UpdateStudentRequest
-	(*AddToGroupRequest)(nil),                      // 77: education.AddToGroupRequest
-	(*GetStudentByIdResponse)(nil),                 // 78: education.GetStudentByIdResponse
-	(*NoteStudentByAbsRequest)(nil),                // 79: education.NoteStudentByAbsRequest
-	(*GetGroupStudent)(nil),                        // 80: education.GetGroupStudent
-	(*GetNotesByStudent)(nil),                      // 81: education.GetNotesByStudent
-	(*AbsNote)(nil),                                // 82: education.AbsNote
-	(*CreateNoteRequest)(nil),                      // 83: education.CreateNoteRequest
-	nil,                                            // 84: education.OtherDetails.DetailsEntry
-	(*PageRequest)(nil),                            // 85: common.PageRequest
-	(*DeleteAbsRequest)(nil),                       // 86: common.DeleteAbsRequest
-	(*emptypb.Empty)(nil),                          // 87: google.protobuf.Empty
-	(*AbsResponse)(nil),                            // 88: common.AbsResponse
+	(*QuoteRequest)(nil),                           // 5: education.QuoteRequest
+	(*TariffQuote)(nil),                            // 6: education.TariffQuote
+	(*Invoice)(nil),                                // 7: education.Invoice
+	(*GetInvoicesRequest)(nil),                     // 8: education.GetInvoicesRequest
+	(*InvoiceList)(nil),                            // 9: education.InvoiceList
+	(*PromoCode)(nil),                              // 10: education.PromoCode
+	(*PromoCodeList)(nil),                          // 11: education.PromoCodeList
+	(*PriceOverride)(nil),                          // 12: education.PriceOverride
+	(*GetPriceOverrideRequest)(nil),                // 13: education.GetPriceOverrideRequest
+	(*CheckSubdomainRequest)(nil),                  // 14: education.CheckSubdomainRequest
+	(*CheckSubdomainResponse)(nil),                 // 15: education.CheckSubdomainResponse
+	(*SignupRequest)(nil),                          // 16: education.SignupRequest
+	(*SignupResponse)(nil),                         // 17: education.SignupResponse
+	(*GetSubscriptionRequest)(nil),                 // 18: education.GetSubscriptionRequest
+	(*CompanySubscription)(nil),                    // 19: education.CompanySubscription
+	(*GetStatisticResponse)(nil),                   // 20: education.GetStatisticResponse
+	(*OtherDetails)(nil),                           // 21: education.OtherDetails
+	(*CompanyCommonDetails)(nil),                   // 22: education.CompanyCommonDetails
+	(*GetStatisticRequest)(nil),                    // 23: education.GetStatisticRequest
+	(*UpdateCompanyRequest)(nil),                   // 24: education.UpdateCompanyRequest
+	(*GetAllResponse)(nil),                         // 25: education.GetAllResponse
+	(*CreateCompanyRequest)(nil),                   // 26: education.CreateCompanyRequest
+	(*GetCompanyRequest)(nil),                      // 27: education.GetCompanyRequest
+	(*GetCompanyResponse)(nil),                     // 28: education.GetCompanyResponse
+	(*Tariff)(nil),                                 // 29: education.Tariff
+	(*PrepayDiscount)(nil),                         // 30: education.PrepayDiscount
+	(*TariffList)(nil),                             // 31: education.TariffList
+	(*CreateRoomRequest)(nil),                      // 32: education.CreateRoomRequest
+	(*GetUpdateRoomAbs)(nil),                       // 33: education.GetUpdateRoomAbs
+	(*AbsRoom)(nil),                                // 34: education.AbsRoom
+	(*CreateCourseRequest)(nil),                    // 35: education.CreateCourseRequest
+	(*GetUpdateCourseAbs)(nil),                     // 36: education.GetUpdateCourseAbs
+	(*AbsCourse)(nil),                              // 37: education.AbsCourse
+	(*GetCourseByIdResponse)(nil),                  // 38: education.GetCourseByIdResponse
+	(*GetCourseByIdRequest)(nil),                   // 39: education.GetCourseByIdRequest
+	(*GetLeftAfterTrialPeriodRequest)(nil),         // 40: education.GetLeftAfterTrialPeriodRequest
+	(*GetLeftAfterTrialPeriodResponse)(nil),        // 41: education.GetLeftAfterTrialPeriodResponse
+	(*AbsGetLeftAfter)(nil),                        // 42: education.AbsGetLeftAfter
+	(*StudentIdRequest)(nil),                       // 43: education.StudentIdRequest
+	(*GetGroupsByStudentResponse)(nil),             // 44: education.GetGroupsByStudentResponse
+	(*DebtorGroup)(nil),                            // 45: education.DebtorGroup
+	(*DebtorComment)(nil),                          // 46: education.DebtorComment
+	(*GetCommonInformationEducationResponse)(nil),  // 47: education.GetCommonInformationEducationResponse
+	(*GetGroupsByTeacherIdRequest)(nil),            // 48: education.GetGroupsByTeacherIdRequest
+	(*GetGroupsByTeacherResponse)(nil),             // 49: education.GetGroupsByTeacherResponse
+	(*GetGroupByTeacherAbs)(nil),                   // 50: education.GetGroupByTeacherAbs
+	(*CreateGroupRequest)(nil),                     // 51: education.CreateGroupRequest
+	(*GetGroupByIdRequest)(nil),                    // 52: education.GetGroupByIdRequest
+	(*GetUpdateGroupAbs)(nil),                      // 53: education.GetUpdateGroupAbs
+	(*GetGroupsByCourseResponse)(nil),              // 54: education.GetGroupsByCourseResponse
+	(*GetGroupByCourseAbsResponse)(nil),            // 55: education.GetGroupByCourseAbsResponse
+	(*GetGroupAbsResponse)(nil),                    // 56: education.GetGroupAbsResponse
+	(*GetGroupsResponse)(nil),                      // 57: education.GetGroupsResponse
+	(*GetGroupsRequest)(nil),                       // 58: education.GetGroupsRequest
+	(*CalculateTeacherSalaryRequest)(nil),          // 59: education.CalculateTeacherSalaryRequest
+	(*CalculateTeacherSalaryResponse)(nil),         // 60: education.CalculateTeacherSalaryResponse
+	(*AbsCalculateSalary)(nil),                     // 61: education.AbsCalculateSalary
+	(*StudentSalary)(nil),                          // 62: education.StudentSalary
+	(*GetAttendanceRequest)(nil),                   // 63: education.GetAttendanceRequest
+	(*GetAttendanceResponse)(nil),                  // 64: education.GetAttendanceResponse
+	(*Day)(nil),                                    // 65: education.Day
+	(*Student)(nil),                                // 66: education.Student
+	(*Attendance)(nil),                             // 67: education.Attendance
+	(*FreezeDetail)(nil),                           // 68: education.FreezeDetail
+	(*SetAttendanceRequest)(nil),                   // 69: education.SetAttendanceRequest
+	(*DiscardStudentsRequest)(nil),                 // 70: education.DiscardStudentsRequest
+	(*FindStudentsByPhoneRequest)(nil),             // 71: education.FindStudentsByPhoneRequest
+	(*MergeStudentsRequest)(nil),                   // 72: education.MergeStudentsRequest
+	(*CalculateDiscountSummaRequest)(nil),          // 73: education.CalculateDiscountSummaRequest
+	(*CalculateDiscountResponse)(nil),              // 74: education.CalculateDiscountResponse
+	(*ChangeUserBalanceHistoryByDebitRequest)(nil), // 75: education.ChangeUserBalanceHistoryByDebitRequest
+	(*ChangeUserBalanceHistoryRequest)(nil),        // 76: education.ChangeUserBalanceHistoryRequest
+	(*DeleteStudentRequest)(nil),                   // 77: education.DeleteStudentRequest
+	(*GetStudentsByGroupIdResponse)(nil),           // 78: education.GetStudentsByGroupIdResponse
+	(*GetStudentsByGroupIdRequest)(nil),            // 79: education.GetStudentsByGroupIdRequest
+	(*ChangeConditionStudentRequest)(nil),          // 80: education.ChangeConditionStudentRequest
+	(*TransferLessonRequest)(nil),                  // 81: education.TransferLessonRequest
+	(*GetHistoryGroupResponse)(nil),                // 82: education.GetHistoryGroupResponse
+	(*GetHistoryStudentResponse)(nil),              // 83: education.GetHistoryStudentResponse
+	(*AbsStudentHistory)(nil),                      // 84: education.AbsStudentHistory
+	(*AbsGroup)(nil),                               // 85: education.AbsGroup
+	(*AbsHistory)(nil),                             // 86: education.AbsHistory
+	(*SearchStudentRequest)(nil),                   // 87: education.SearchStudentRequest
+	(*SearchStudentResponse)(nil),                  // 88: education.SearchStudentResponse
+	(*AbsStudent)(nil),                             // 89: education.AbsStudent
+	(*GetAllStudentRequest)(nil),                   // 90: education.GetAllStudentRequest
+	(*GetAllStudentResponse)(nil),                  // 91: education.GetAllStudentResponse
+	(*GetGroupsAbsForStudent)(nil),                 // 92: education.GetGroupsAbsForStudent
+	(*GroupGetAllStudentAbs)(nil),                  // 93: education.GroupGetAllStudentAbs
+	(*CreateStudentRequest)(nil),                   // 94: education.CreateStudentRequest
+	(*UpdateStudentRequest)(nil),                   // 95: education.UpdateStudentRequest
+	(*AddToGroupRequest)(nil),                      // 96: education.AddToGroupRequest
+	(*GetStudentByIdResponse)(nil),                 // 97: education.GetStudentByIdResponse
+	(*NoteStudentByAbsRequest)(nil),                // 98: education.NoteStudentByAbsRequest
+	(*GetGroupStudent)(nil),                        // 99: education.GetGroupStudent
+	(*GetNotesByStudent)(nil),                      // 100: education.GetNotesByStudent
+	(*AbsNote)(nil),                                // 101: education.AbsNote
+	(*CreateNoteRequest)(nil),                      // 102: education.CreateNoteRequest
+	(*NotificationSettings)(nil),                   // 103: education.NotificationSettings
+	(*NotificationTemplate)(nil),                   // 104: education.NotificationTemplate
+	(*GetNotificationOutboxRequest)(nil),           // 105: education.GetNotificationOutboxRequest
+	(*GetNotificationOutboxResponse)(nil),          // 106: education.GetNotificationOutboxResponse
+	(*NotificationOutboxItem)(nil),                 // 107: education.NotificationOutboxItem
+	(*DebtReminderSettings)(nil),                   // 108: education.DebtReminderSettings
+	(*GetDebtRemindersRequest)(nil),                // 109: education.GetDebtRemindersRequest
+	(*GetDebtRemindersResponse)(nil),               // 110: education.GetDebtRemindersResponse
+	(*DebtReminderItem)(nil),                       // 111: education.DebtReminderItem
+	(*SetDebtReminderReplyRequest)(nil),            // 112: education.SetDebtReminderReplyRequest
+	(*RunBalanceReconciliationRequest)(nil),        // 113: education.RunBalanceReconciliationRequest
+	(*GetReconciliationReportsRequest)(nil),        // 114: education.GetReconciliationReportsRequest
+	(*GetReconciliationReportsResponse)(nil),       // 115: education.GetReconciliationReportsResponse
+	(*GetReconciliationReportRequest)(nil),         // 116: education.GetReconciliationReportRequest
+	(*ReconciliationReport)(nil),                   // 117: education.ReconciliationReport
+	(*BalanceDrift)(nil),                           // 118: education.BalanceDrift
+	(*PlatformMetricsRequest)(nil),                 // 119: education.PlatformMetricsRequest
+	(*PlatformMetrics)(nil),                        // 120: education.PlatformMetrics
+	(*RenewalStat)(nil),                            // 121: education.RenewalStat
+	(*CohortRetention)(nil),                        // 122: education.CohortRetention
+	(*TenantHealthRequest)(nil),                    // 123: education.TenantHealthRequest
+	(*TenantHealth)(nil),                           // 124: education.TenantHealth
+	(*TenantHealthList)(nil),                       // 125: education.TenantHealthList
+	(*HistoryRequest)(nil),                         // 126: education.HistoryRequest
+	(*PlatformSnapshot)(nil),                       // 127: education.PlatformSnapshot
+	(*PlatformHistory)(nil),                        // 128: education.PlatformHistory
+	nil,                                            // 129: education.OtherDetails.DetailsEntry
+	(*PageRequest)(nil),                            // 130: common.PageRequest
+	(*PaymentPlanStatus)(nil),                      // 131: common.PaymentPlanStatus
+	(*DeleteAbsRequest)(nil),                       // 132: common.DeleteAbsRequest
+	(*emptypb.Empty)(nil),                          // 133: google.protobuf.Empty
+	(*AbsResponse)(nil),                            // 134: common.AbsResponse
 }
 var file_education_proto_depIdxs = []int32{
-	1,  // 0: education.CompanyFinanceSelfList.items:type_name -> education.CompanyFinanceSelf
-	4,  // 1: education.CompanyFinanceList.items:type_name -> education.CompanyFinanceForList
-	7,  // 2: education.GetStatisticResponse.details:type_name -> education.CompanyCommonDetails
-	6,  // 3: education.GetStatisticResponse.registerDetails:type_name -> education.OtherDetails
-	6,  // 4: education.GetStatisticResponse.paymentDetails:type_name -> education.OtherDetails
-	84, // 5: education.OtherDetails.details:type_name -> education.OtherDetails.DetailsEntry
-	13, // 6: education.GetAllResponse.items:type_name -> education.GetCompanyResponse
-	14, // 7: education.GetCompanyResponse.tariff:type_name -> education.Tariff
-	14, // 8: education.TariffList.items:type_name -> education.Tariff
-	18, // 9: education.GetUpdateRoomAbs.rooms:type_name -> education.AbsRoom
-	21, // 10: education.GetUpdateCourseAbs.courses:type_name -> education.AbsCourse
-	26, // 11: education.GetLeftAfterTrialPeriodResponse.items:type_name -> education.AbsGetLeftAfter
-	30, // 12: education.GetGroupsByStudentResponse.comments:type_name -> education.DebtorComment
-	29, // 13: education.GetGroupsByStudentResponse.groups:type_name -> education.DebtorGroup
-	34, // 14: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
-	70, // 15: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
-	39, // 16: education.GetGroupsByCourseResponse.groups:type_name -> education.GetGroupByCourseAbsResponse
-	21, // 17: education.GetGroupAbsResponse.course:type_name -> education.AbsCourse
-	18, // 18: education.GetGroupAbsResponse.room:type_name -> education.AbsRoom
-	40, // 19: education.GetGroupsResponse.groups:type_name -> education.GetGroupAbsResponse
-	85, // 20: education.GetGroupsRequest.page:type_name -> common.PageRequest
-	45, // 21: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
-	46, // 22: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
-	49, // 23: education.GetAttendanceResponse.days:type_name -> education.Day
-	50, // 24: education.GetAttendanceResponse.students:type_name -> education.Student
-	51, // 25: education.Student.attendance:type_name -> education.Attendance
-	52, // 26: education.Student.freezeDetail:type_name -> education.FreezeDetail
-	70, // 27: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
-	67, // 28: education.GetHistoryGroupResponse.groupHistory:type_name -> education.AbsHistory
-	65, // 29: education.GetHistoryGroupResponse.studentsHistory:type_name -> education.AbsStudentHistory
-	67, // 30: education.GetHistoryStudentResponse.studentHistory:type_name -> education.AbsHistory
-	65, // 31: education.GetHistoryStudentResponse.conditionsHistory:type_name -> education.AbsStudentHistory
-	70, // 32: education.AbsStudentHistory.student:type_name -> education.AbsStudent
-	66, // 33: education.AbsStudentHistory.group:type_name -> education.AbsGroup
-	21, // 34: education.AbsGroup.course:type_name -> education.AbsCourse
-	70, // 35: education.SearchStudentResponse.students:type_name -> education.AbsStudent
-	73, // 36: education.GetAllStudentResponse.response:type_name -> education.GetGroupsAbsForStudent
-	74, // 37: education.GetGroupsAbsForStudent.groups:type_name -> education.GroupGetAllStudentAbs
-	21, // 38: education.GroupGetAllStudentAbs.course:type_name -> education.AbsCourse
-	80, // 39: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
-	18, // 40: education.GetGroupStudent.room:type_name -> education.AbsRoom
-	21, // 41: education.GetGroupStudent.course:type_name -> education.AbsCourse
-	82, // 42: education.GetNotesByStudent.notes:type_name -> education.AbsNote
-	0,  // 43: education.CompanyFinanceService.Create:input_type -> education.CompanyFinance
-	86, // 44: education.CompanyFinanceService.Delete:input_type -> common.DeleteAbsRequest
-	85, // 45: education.CompanyFinanceService.GetAll:input_type -> common.PageRequest
-	85, // 46: education.CompanyFinanceService.GetByCompany:input_type -> common.PageRequest
-	0,  // 47: education.CompanyFinanceService.UpdateByCompany:input_type -> education.CompanyFinance
-	12, // 48: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
-	11, // 49: education.CompanyService.CreateCompany:input_type -> education.CreateCompanyRequest
-	85, // 50: education.CompanyService.GetAll:input_type -> common.PageRequest
-	9,  // 51: education.CompanyService.UpdateCompany:input_type -> education.UpdateCompanyRequest
-	8,  // 52: education.CompanyService.GetStatistic:input_type -> education.GetStatisticRequest
-	14, // 53: education.TariffService.Create:input_type -> education.Tariff
-	14, // 54: education.TariffService.Update:input_type -> education.Tariff
-	14, // 55: education.TariffService.Delete:input_type -> education.Tariff
-	87, // 56: education.TariffService.Get:input_type -> google.protobuf.Empty
-	16, // 57: education.RoomService.CreateRoom:input_type -> education.CreateRoomRequest
-	87, // 58: education.RoomService.GetRooms:input_type -> google.protobuf.Empty
-	18, // 59: education.RoomService.UpdateRoom:input_type -> education.AbsRoom
-	86, // 60: education.RoomService.DeleteRoom:input_type -> common.DeleteAbsRequest
-	19, // 61: education.CourseService.CreateCourse:input_type -> education.CreateCourseRequest
-	87, // 62: education.CourseService.GetCourses:input_type -> google.protobuf.Empty
-	23, // 63: education.CourseService.GetCourseById:input_type -> education.GetCourseByIdRequest
-	21, // 64: education.CourseService.UpdateCourse:input_type -> education.AbsCourse
-	86, // 65: education.CourseService.DeleteCourse:input_type -> common.DeleteAbsRequest
-	35, // 66: education.GroupService.CreateGroup:input_type -> education.CreateGroupRequest
-	42, // 67: education.GroupService.GetGroups:input_type -> education.GetGroupsRequest
-	36, // 68: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
-	36, // 69: education.GroupService.GetGroupsByCourseId:input_type -> education.GetGroupByIdRequest
-	27, // 70: education.GroupService.GetGroupsByStudentId:input_type -> education.StudentIdRequest
-	37, // 71: education.GroupService.UpdateGroup:input_type -> education.GetUpdateGroupAbs
-	86, // 72: education.GroupService.DeleteGroup:input_type -> common.DeleteAbsRequest
-	32, // 73: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
-	87, // 74: education.GroupService.GetCommonInformationEducation:input_type -> google.protobuf.Empty
-	24, // 75: education.GroupService.GetLeftAfterTrialPeriod:input_type -> education.GetLeftAfterTrialPeriodRequest
-	47, // 76: education.AttendanceService.GetAttendance:input_type -> education.GetAttendanceRequest
-	53, // 77: education.AttendanceService.SetAttendance:input_type -> education.SetAttendanceRequest
-	43, // 78: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
-	71, // 79: education.StudentService.GetAllStudent:input_type -> education.GetAllStudentRequest
-	75, // 80: education.StudentService.CreateStudent:input_type -> education.CreateStudentRequest
-	76, // 81: education.StudentService.UpdateStudent:input_type -> education.UpdateStudentRequest
-	58, // 82: education.StudentService.DeleteStudent:input_type -> education.DeleteStudentRequest
-	77, // 83: education.StudentService.AddToGroup:input_type -> education.AddToGroupRequest
-	79, // 84: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
-	79, // 85: education.StudentService.GetNoteByStudent:input_type -> education.NoteStudentByAbsRequest
-	83, // 86: education.StudentService.CreateNoteForStudent:input_type -> education.CreateNoteRequest
-	79, // 87: education.StudentService.DeleteStudentNote:input_type -> education.NoteStudentByAbsRequest
-	68, // 88: education.StudentService.SearchStudent:input_type -> education.SearchStudentRequest
-	79, // 89: education.StudentService.GetHistoryGroupById:input_type -> education.NoteStudentByAbsRequest
-	79, // 90: education.StudentService.GetHistoryStudentById:input_type -> education.NoteStudentByAbsRequest
-	62, // 91: education.StudentService.TransferLessonDate:input_type -> education.TransferLessonRequest
-	61, // 92: education.StudentService.ChangeConditionStudent:input_type -> education.ChangeConditionStudentRequest
-	60, // 93: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
-	57, // 94: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
-	56, // 95: education.StudentService.ChangeUserBalanceHistoryByDebit:input_type -> education.ChangeUserBalanceHistoryByDebitRequest
-	54, // 96: education.StudentService.CalculateDiscountSumma:input_type -> education.CalculateDiscountSummaRequest
-	0,  // 97: education.CompanyFinanceService.Create:output_type -> education.CompanyFinance
-	88, // 98: education.CompanyFinanceService.Delete:output_type -> common.AbsResponse
-	3,  // 99: education.CompanyFinanceService.GetAll:output_type -> education.CompanyFinanceList
-	2,  // 100: education.CompanyFinanceService.GetByCompany:output_type -> education.CompanyFinanceSelfList
-	0,  // 101: education.CompanyFinanceService.UpdateByCompany:output_type -> education.CompanyFinance
-	13, // 102: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
-	88, // 103: education.CompanyService.CreateCompany:output_type -> common.AbsResponse
-	10, // 104: education.CompanyService.GetAll:output_type -> education.GetAllResponse
-	88, // 105: education.CompanyService.UpdateCompany:output_type -> common.AbsResponse
-	5,  // 106: education.CompanyService.GetStatistic:output_type -> education.GetStatisticResponse
-	14, // 107: education.TariffService.Create:output_type -> education.Tariff
-	14, // 108: education.TariffService.Update:output_type -> education.Tariff
-	14, // 109: education.TariffService.Delete:output_type -> education.Tariff
-	15, // 110: education.TariffService.Get:output_type -> education.TariffList
-	88, // 111: education.RoomService.CreateRoom:output_type -> common.AbsResponse
-	17, // 112: education.RoomService.GetRooms:output_type -> education.GetUpdateRoomAbs
-	88, // 113: education.RoomService.UpdateRoom:output_type -> common.AbsResponse
-	88, // 114: education.RoomService.DeleteRoom:output_type -> common.AbsResponse
-	88, // 115: education.CourseService.CreateCourse:output_type -> common.AbsResponse
-	20, // 116: education.CourseService.GetCourses:output_type -> education.GetUpdateCourseAbs
-	22, // 117: education.CourseService.GetCourseById:output_type -> education.GetCourseByIdResponse
-	88, // 118: education.CourseService.UpdateCourse:output_type -> common.AbsResponse
-	88, // 119: education.CourseService.DeleteCourse:output_type -> common.AbsResponse
-	88, // 120: education.GroupService.CreateGroup:output_type -> common.AbsResponse
-	41, // 121: education.GroupService.GetGroups:output_type -> education.GetGroupsResponse
-	40, // 122: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
-	38, // 123: education.GroupService.GetGroupsByCourseId:output_type -> education.GetGroupsByCourseResponse
-	28, // 124: education.GroupService.GetGroupsByStudentId:output_type -> education.GetGroupsByStudentResponse
-	88, // 125: education.GroupService.UpdateGroup:output_type -> common.AbsResponse
-	88, // 126: education.GroupService.DeleteGroup:output_type -> common.AbsResponse
-	33, // 127: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
-	31, // 128: education.GroupService.GetCommonInformationEducation:output_type -> education.GetCommonInformationEducationResponse
-	25, // 129: education.GroupService.GetLeftAfterTrialPeriod:output_type -> education.GetLeftAfterTrialPeriodResponse
-	48, // 130: education.AttendanceService.GetAttendance:output_type -> education.GetAttendanceResponse
-	88, // 131: education.AttendanceService.SetAttendance:output_type -> common.AbsResponse
-	44, // 132: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
-	72, // 133: education.StudentService.GetAllStudent:output_type -> education.GetAllStudentResponse
-	88, // 134: education.StudentService.CreateStudent:output_type -> common.AbsResponse
-	88, // 135: education.StudentService.UpdateStudent:output_type -> common.AbsResponse
-	88, // 136: education.StudentService.DeleteStudent:output_type -> common.AbsResponse
-	88, // 137: education.StudentService.AddToGroup:output_type -> common.AbsResponse
-	78, // 138: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
-	81, // 139: education.StudentService.GetNoteByStudent:output_type -> education.GetNotesByStudent
-	88, // 140: education.StudentService.CreateNoteForStudent:output_type -> common.AbsResponse
-	88, // 141: education.StudentService.DeleteStudentNote:output_type -> common.AbsResponse
-	69, // 142: education.StudentService.SearchStudent:output_type -> education.SearchStudentResponse
-	63, // 143: education.StudentService.GetHistoryGroupById:output_type -> education.GetHistoryGroupResponse
-	64, // 144: education.StudentService.GetHistoryStudentById:output_type -> education.GetHistoryStudentResponse
-	88, // 145: education.StudentService.TransferLessonDate:output_type -> common.AbsResponse
-	88, // 146: education.StudentService.ChangeConditionStudent:output_type -> common.AbsResponse
-	59, // 147: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
-	88, // 148: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
-	88, // 149: education.StudentService.ChangeUserBalanceHistoryByDebit:output_type -> common.AbsResponse
-	55, // 150: education.StudentService.CalculateDiscountSumma:output_type -> education.CalculateDiscountResponse
-	97, // [97:151] is the sub-list for method output_type
-	43, // [43:97] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	1,   // 0: education.CompanyFinanceSelfList.items:type_name -> education.CompanyFinanceSelf
+	4,   // 1: education.CompanyFinanceList.items:type_name -> education.CompanyFinanceForList
+	6,   // 2: education.Invoice.quote:type_name -> education.TariffQuote
+	7,   // 3: education.InvoiceList.items:type_name -> education.Invoice
+	10,  // 4: education.PromoCodeList.items:type_name -> education.PromoCode
+	22,  // 5: education.GetStatisticResponse.details:type_name -> education.CompanyCommonDetails
+	21,  // 6: education.GetStatisticResponse.registerDetails:type_name -> education.OtherDetails
+	21,  // 7: education.GetStatisticResponse.paymentDetails:type_name -> education.OtherDetails
+	129, // 8: education.OtherDetails.details:type_name -> education.OtherDetails.DetailsEntry
+	28,  // 9: education.GetAllResponse.items:type_name -> education.GetCompanyResponse
+	29,  // 10: education.GetCompanyResponse.tariff:type_name -> education.Tariff
+	30,  // 11: education.Tariff.prepay_discounts:type_name -> education.PrepayDiscount
+	29,  // 12: education.TariffList.items:type_name -> education.Tariff
+	34,  // 13: education.GetUpdateRoomAbs.rooms:type_name -> education.AbsRoom
+	37,  // 14: education.GetUpdateCourseAbs.courses:type_name -> education.AbsCourse
+	42,  // 15: education.GetLeftAfterTrialPeriodResponse.items:type_name -> education.AbsGetLeftAfter
+	46,  // 16: education.GetGroupsByStudentResponse.comments:type_name -> education.DebtorComment
+	45,  // 17: education.GetGroupsByStudentResponse.groups:type_name -> education.DebtorGroup
+	50,  // 18: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
+	89,  // 19: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
+	55,  // 20: education.GetGroupsByCourseResponse.groups:type_name -> education.GetGroupByCourseAbsResponse
+	37,  // 21: education.GetGroupAbsResponse.course:type_name -> education.AbsCourse
+	34,  // 22: education.GetGroupAbsResponse.room:type_name -> education.AbsRoom
+	56,  // 23: education.GetGroupsResponse.groups:type_name -> education.GetGroupAbsResponse
+	130, // 24: education.GetGroupsRequest.page:type_name -> common.PageRequest
+	61,  // 25: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
+	62,  // 26: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
+	65,  // 27: education.GetAttendanceResponse.days:type_name -> education.Day
+	66,  // 28: education.GetAttendanceResponse.students:type_name -> education.Student
+	67,  // 29: education.Student.attendance:type_name -> education.Attendance
+	68,  // 30: education.Student.freezeDetail:type_name -> education.FreezeDetail
+	89,  // 31: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
+	86,  // 32: education.GetHistoryGroupResponse.groupHistory:type_name -> education.AbsHistory
+	84,  // 33: education.GetHistoryGroupResponse.studentsHistory:type_name -> education.AbsStudentHistory
+	86,  // 34: education.GetHistoryStudentResponse.studentHistory:type_name -> education.AbsHistory
+	84,  // 35: education.GetHistoryStudentResponse.conditionsHistory:type_name -> education.AbsStudentHistory
+	89,  // 36: education.AbsStudentHistory.student:type_name -> education.AbsStudent
+	85,  // 37: education.AbsStudentHistory.group:type_name -> education.AbsGroup
+	37,  // 38: education.AbsGroup.course:type_name -> education.AbsCourse
+	89,  // 39: education.SearchStudentResponse.students:type_name -> education.AbsStudent
+	92,  // 40: education.GetAllStudentResponse.response:type_name -> education.GetGroupsAbsForStudent
+	93,  // 41: education.GetGroupsAbsForStudent.groups:type_name -> education.GroupGetAllStudentAbs
+	37,  // 42: education.GroupGetAllStudentAbs.course:type_name -> education.AbsCourse
+	99,  // 43: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
+	131, // 44: education.GetStudentByIdResponse.paymentPlans:type_name -> common.PaymentPlanStatus
+	34,  // 45: education.GetGroupStudent.room:type_name -> education.AbsRoom
+	37,  // 46: education.GetGroupStudent.course:type_name -> education.AbsCourse
+	101, // 47: education.GetNotesByStudent.notes:type_name -> education.AbsNote
+	104, // 48: education.NotificationSettings.templates:type_name -> education.NotificationTemplate
+	107, // 49: education.GetNotificationOutboxResponse.items:type_name -> education.NotificationOutboxItem
+	111, // 50: education.GetDebtRemindersResponse.items:type_name -> education.DebtReminderItem
+	117, // 51: education.GetReconciliationReportsResponse.items:type_name -> education.ReconciliationReport
+	118, // 52: education.ReconciliationReport.drifts:type_name -> education.BalanceDrift
+	121, // 53: education.PlatformMetrics.renewals:type_name -> education.RenewalStat
+	122, // 54: education.PlatformMetrics.cohorts:type_name -> education.CohortRetention
+	124, // 55: education.TenantHealthList.items:type_name -> education.TenantHealth
+	127, // 56: education.PlatformHistory.items:type_name -> education.PlatformSnapshot
+	0,   // 57: education.CompanyFinanceService.Create:input_type -> education.CompanyFinance
+	132, // 58: education.CompanyFinanceService.Delete:input_type -> common.DeleteAbsRequest
+	130, // 59: education.CompanyFinanceService.GetAll:input_type -> common.PageRequest
+	130, // 60: education.CompanyFinanceService.GetByCompany:input_type -> common.PageRequest
+	0,   // 61: education.CompanyFinanceService.UpdateByCompany:input_type -> education.CompanyFinance
+	5,   // 62: education.BillingService.Quote:input_type -> education.QuoteRequest
+	5,   // 63: education.BillingService.CreateInvoice:input_type -> education.QuoteRequest
+	8,   // 64: education.BillingService.GetInvoices:input_type -> education.GetInvoicesRequest
+	132, // 65: education.BillingService.VoidInvoice:input_type -> common.DeleteAbsRequest
+	10,  // 66: education.BillingService.CreatePromoCode:input_type -> education.PromoCode
+	133, // 67: education.BillingService.GetPromoCodes:input_type -> google.protobuf.Empty
+	10,  // 68: education.BillingService.DeactivatePromoCode:input_type -> education.PromoCode
+	12,  // 69: education.BillingService.SetPriceOverride:input_type -> education.PriceOverride
+	13,  // 70: education.BillingService.GetPriceOverride:input_type -> education.GetPriceOverrideRequest
+	14,  // 71: education.SignupService.CheckSubdomain:input_type -> education.CheckSubdomainRequest
+	16,  // 72: education.SignupService.Signup:input_type -> education.SignupRequest
+	27,  // 73: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
+	26,  // 74: education.CompanyService.CreateCompany:input_type -> education.CreateCompanyRequest
+	130, // 75: education.CompanyService.GetAll:input_type -> common.PageRequest
+	24,  // 76: education.CompanyService.UpdateCompany:input_type -> education.UpdateCompanyRequest
+	23,  // 77: education.CompanyService.GetStatistic:input_type -> education.GetStatisticRequest
+	18,  // 78: education.CompanyService.GetSubscription:input_type -> education.GetSubscriptionRequest
+	29,  // 79: education.TariffService.Create:input_type -> education.Tariff
+	29,  // 80: education.TariffService.Update:input_type -> education.Tariff
+	29,  // 81: education.TariffService.Delete:input_type -> education.Tariff
+	133, // 82: education.TariffService.Get:input_type -> google.protobuf.Empty
+	32,  // 83: education.RoomService.CreateRoom:input_type -> education.CreateRoomRequest
+	133, // 84: education.RoomService.GetRooms:input_type -> google.protobuf.Empty
+	34,  // 85: education.RoomService.UpdateRoom:input_type -> education.AbsRoom
+	132, // 86: education.RoomService.DeleteRoom:input_type -> common.DeleteAbsRequest
+	35,  // 87: education.CourseService.CreateCourse:input_type -> education.CreateCourseRequest
+	133, // 88: education.CourseService.GetCourses:input_type -> google.protobuf.Empty
+	39,  // 89: education.CourseService.GetCourseById:input_type -> education.GetCourseByIdRequest
+	37,  // 90: education.CourseService.UpdateCourse:input_type -> education.AbsCourse
+	132, // 91: education.CourseService.DeleteCourse:input_type -> common.DeleteAbsRequest
+	51,  // 92: education.GroupService.CreateGroup:input_type -> education.CreateGroupRequest
+	58,  // 93: education.GroupService.GetGroups:input_type -> education.GetGroupsRequest
+	52,  // 94: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
+	52,  // 95: education.GroupService.GetGroupsByCourseId:input_type -> education.GetGroupByIdRequest
+	43,  // 96: education.GroupService.GetGroupsByStudentId:input_type -> education.StudentIdRequest
+	53,  // 97: education.GroupService.UpdateGroup:input_type -> education.GetUpdateGroupAbs
+	132, // 98: education.GroupService.DeleteGroup:input_type -> common.DeleteAbsRequest
+	48,  // 99: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
+	133, // 100: education.GroupService.GetCommonInformationEducation:input_type -> google.protobuf.Empty
+	40,  // 101: education.GroupService.GetLeftAfterTrialPeriod:input_type -> education.GetLeftAfterTrialPeriodRequest
+	63,  // 102: education.AttendanceService.GetAttendance:input_type -> education.GetAttendanceRequest
+	69,  // 103: education.AttendanceService.SetAttendance:input_type -> education.SetAttendanceRequest
+	59,  // 104: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
+	90,  // 105: education.StudentService.GetAllStudent:input_type -> education.GetAllStudentRequest
+	94,  // 106: education.StudentService.CreateStudent:input_type -> education.CreateStudentRequest
+	95,  // 107: education.StudentService.UpdateStudent:input_type -> education.UpdateStudentRequest
+	77,  // 108: education.StudentService.DeleteStudent:input_type -> education.DeleteStudentRequest
+	96,  // 109: education.StudentService.AddToGroup:input_type -> education.AddToGroupRequest
+	98,  // 110: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
+	98,  // 111: education.StudentService.GetNoteByStudent:input_type -> education.NoteStudentByAbsRequest
+	102, // 112: education.StudentService.CreateNoteForStudent:input_type -> education.CreateNoteRequest
+	98,  // 113: education.StudentService.DeleteStudentNote:input_type -> education.NoteStudentByAbsRequest
+	87,  // 114: education.StudentService.SearchStudent:input_type -> education.SearchStudentRequest
+	98,  // 115: education.StudentService.GetHistoryGroupById:input_type -> education.NoteStudentByAbsRequest
+	98,  // 116: education.StudentService.GetHistoryStudentById:input_type -> education.NoteStudentByAbsRequest
+	81,  // 117: education.StudentService.TransferLessonDate:input_type -> education.TransferLessonRequest
+	80,  // 118: education.StudentService.ChangeConditionStudent:input_type -> education.ChangeConditionStudentRequest
+	79,  // 119: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
+	76,  // 120: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
+	75,  // 121: education.StudentService.ChangeUserBalanceHistoryByDebit:input_type -> education.ChangeUserBalanceHistoryByDebitRequest
+	73,  // 122: education.StudentService.CalculateDiscountSumma:input_type -> education.CalculateDiscountSummaRequest
+	71,  // 123: education.StudentService.FindStudentsByPhone:input_type -> education.FindStudentsByPhoneRequest
+	72,  // 124: education.StudentService.MergeStudents:input_type -> education.MergeStudentsRequest
+	70,  // 125: education.StudentService.DiscardStudents:input_type -> education.DiscardStudentsRequest
+	133, // 126: education.NotificationService.GetNotificationSettings:input_type -> google.protobuf.Empty
+	103, // 127: education.NotificationService.UpdateNotificationSettings:input_type -> education.NotificationSettings
+	105, // 128: education.NotificationService.GetNotificationOutbox:input_type -> education.GetNotificationOutboxRequest
+	133, // 129: education.DebtReminderService.GetDebtReminderSettings:input_type -> google.protobuf.Empty
+	108, // 130: education.DebtReminderService.UpdateDebtReminderSettings:input_type -> education.DebtReminderSettings
+	109, // 131: education.DebtReminderService.GetDebtReminders:input_type -> education.GetDebtRemindersRequest
+	112, // 132: education.DebtReminderService.SetDebtReminderReply:input_type -> education.SetDebtReminderReplyRequest
+	113, // 133: education.ReconciliationService.RunBalanceReconciliation:input_type -> education.RunBalanceReconciliationRequest
+	114, // 134: education.ReconciliationService.GetReconciliationReports:input_type -> education.GetReconciliationReportsRequest
+	116, // 135: education.ReconciliationService.GetReconciliationReport:input_type -> education.GetReconciliationReportRequest
+	119, // 136: education.AnalyticsService.GetPlatformMetrics:input_type -> education.PlatformMetricsRequest
+	123, // 137: education.AnalyticsService.GetTenantHealth:input_type -> education.TenantHealthRequest
+	126, // 138: education.AnalyticsService.GetPlatformHistory:input_type -> education.HistoryRequest
+	126, // 139: education.AnalyticsService.GetTenantHistory:input_type -> education.HistoryRequest
+	0,   // 140: education.CompanyFinanceService.Create:output_type -> education.CompanyFinance
+	134, // 141: education.CompanyFinanceService.Delete:output_type -> common.AbsResponse
+	3,   // 142: education.CompanyFinanceService.GetAll:output_type -> education.CompanyFinanceList
+	2,   // 143: education.CompanyFinanceService.GetByCompany:output_type -> education.CompanyFinanceSelfList
+	0,   // 144: education.CompanyFinanceService.UpdateByCompany:output_type -> education.CompanyFinance
+	6,   // 145: education.BillingService.Quote:output_type -> education.TariffQuote
+	7,   // 146: education.BillingService.CreateInvoice:output_type -> education.Invoice
+	9,   // 147: education.BillingService.GetInvoices:output_type -> education.InvoiceList
+	134, // 148: education.BillingService.VoidInvoice:output_type -> common.AbsResponse
+	10,  // 149: education.BillingService.CreatePromoCode:output_type -> education.PromoCode
+	11,  // 150: education.BillingService.GetPromoCodes:output_type -> education.PromoCodeList
+	134, // 151: education.BillingService.DeactivatePromoCode:output_type -> common.AbsResponse
+	12,  // 152: education.BillingService.SetPriceOverride:output_type -> education.PriceOverride
+	12,  // 153: education.BillingService.GetPriceOverride:output_type -> education.PriceOverride
+	15,  // 154: education.SignupService.CheckSubdomain:output_type -> education.CheckSubdomainResponse
+	17,  // 155: education.SignupService.Signup:output_type -> education.SignupResponse
+	28,  // 156: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
+	134, // 157: education.CompanyService.CreateCompany:output_type -> common.AbsResponse
+	25,  // 158: education.CompanyService.GetAll:output_type -> education.GetAllResponse
+	134, // 159: education.CompanyService.UpdateCompany:output_type -> common.AbsResponse
+	20,  // 160: education.CompanyService.GetStatistic:output_type -> education.GetStatisticResponse
+	19,  // 161: education.CompanyService.GetSubscription:output_type -> education.CompanySubscription
+	29,  // 162: education.TariffService.Create:output_type -> education.Tariff
+	29,  // 163: education.TariffService.Update:output_type -> education.Tariff
+	29,  // 164: education.TariffService.Delete:output_type -> education.Tariff
+	31,  // 165: education.TariffService.Get:output_type -> education.TariffList
+	134, // 166: education.RoomService.CreateRoom:output_type -> common.AbsResponse
+	33,  // 167: education.RoomService.GetRooms:output_type -> education.GetUpdateRoomAbs
+	134, // 168: education.RoomService.UpdateRoom:output_type -> common.AbsResponse
+	134, // 169: education.RoomService.DeleteRoom:output_type -> common.AbsResponse
+	134, // 170: education.CourseService.CreateCourse:output_type -> common.AbsResponse
+	36,  // 171: education.CourseService.GetCourses:output_type -> education.GetUpdateCourseAbs
+	38,  // 172: education.CourseService.GetCourseById:output_type -> education.GetCourseByIdResponse
+	134, // 173: education.CourseService.UpdateCourse:output_type -> common.AbsResponse
+	134, // 174: education.CourseService.DeleteCourse:output_type -> common.AbsResponse
+	134, // 175: education.GroupService.CreateGroup:output_type -> common.AbsResponse
+	57,  // 176: education.GroupService.GetGroups:output_type -> education.GetGroupsResponse
+	56,  // 177: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
+	54,  // 178: education.GroupService.GetGroupsByCourseId:output_type -> education.GetGroupsByCourseResponse
+	44,  // 179: education.GroupService.GetGroupsByStudentId:output_type -> education.GetGroupsByStudentResponse
+	134, // 180: education.GroupService.UpdateGroup:output_type -> common.AbsResponse
+	134, // 181: education.GroupService.DeleteGroup:output_type -> common.AbsResponse
+	49,  // 182: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
+	47,  // 183: education.GroupService.GetCommonInformationEducation:output_type -> education.GetCommonInformationEducationResponse
+	41,  // 184: education.GroupService.GetLeftAfterTrialPeriod:output_type -> education.GetLeftAfterTrialPeriodResponse
+	64,  // 185: education.AttendanceService.GetAttendance:output_type -> education.GetAttendanceResponse
+	134, // 186: education.AttendanceService.SetAttendance:output_type -> common.AbsResponse
+	60,  // 187: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
+	91,  // 188: education.StudentService.GetAllStudent:output_type -> education.GetAllStudentResponse
+	134, // 189: education.StudentService.CreateStudent:output_type -> common.AbsResponse
+	134, // 190: education.StudentService.UpdateStudent:output_type -> common.AbsResponse
+	134, // 191: education.StudentService.DeleteStudent:output_type -> common.AbsResponse
+	134, // 192: education.StudentService.AddToGroup:output_type -> common.AbsResponse
+	97,  // 193: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
+	100, // 194: education.StudentService.GetNoteByStudent:output_type -> education.GetNotesByStudent
+	134, // 195: education.StudentService.CreateNoteForStudent:output_type -> common.AbsResponse
+	134, // 196: education.StudentService.DeleteStudentNote:output_type -> common.AbsResponse
+	88,  // 197: education.StudentService.SearchStudent:output_type -> education.SearchStudentResponse
+	82,  // 198: education.StudentService.GetHistoryGroupById:output_type -> education.GetHistoryGroupResponse
+	83,  // 199: education.StudentService.GetHistoryStudentById:output_type -> education.GetHistoryStudentResponse
+	134, // 200: education.StudentService.TransferLessonDate:output_type -> common.AbsResponse
+	134, // 201: education.StudentService.ChangeConditionStudent:output_type -> common.AbsResponse
+	78,  // 202: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
+	134, // 203: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
+	134, // 204: education.StudentService.ChangeUserBalanceHistoryByDebit:output_type -> common.AbsResponse
+	74,  // 205: education.StudentService.CalculateDiscountSumma:output_type -> education.CalculateDiscountResponse
+	88,  // 206: education.StudentService.FindStudentsByPhone:output_type -> education.SearchStudentResponse
+	134, // 207: education.StudentService.MergeStudents:output_type -> common.AbsResponse
+	134, // 208: education.StudentService.DiscardStudents:output_type -> common.AbsResponse
+	103, // 209: education.NotificationService.GetNotificationSettings:output_type -> education.NotificationSettings
+	134, // 210: education.NotificationService.UpdateNotificationSettings:output_type -> common.AbsResponse
+	106, // 211: education.NotificationService.GetNotificationOutbox:output_type -> education.GetNotificationOutboxResponse
+	108, // 212: education.DebtReminderService.GetDebtReminderSettings:output_type -> education.DebtReminderSettings
+	134, // 213: education.DebtReminderService.UpdateDebtReminderSettings:output_type -> common.AbsResponse
+	110, // 214: education.DebtReminderService.GetDebtReminders:output_type -> education.GetDebtRemindersResponse
+	134, // 215: education.DebtReminderService.SetDebtReminderReply:output_type -> common.AbsResponse
+	117, // 216: education.ReconciliationService.RunBalanceReconciliation:output_type -> education.ReconciliationReport
+	115, // 217: education.ReconciliationService.GetReconciliationReports:output_type -> education.GetReconciliationReportsResponse
+	117, // 218: education.ReconciliationService.GetReconciliationReport:output_type -> education.ReconciliationReport
+	120, // 219: education.AnalyticsService.GetPlatformMetrics:output_type -> education.PlatformMetrics
+	125, // 220: education.AnalyticsService.GetTenantHealth:output_type -> education.TenantHealthList
+	128, // 221: education.AnalyticsService.GetPlatformHistory:output_type -> education.PlatformHistory
+	125, // 222: education.AnalyticsService.GetTenantHistory:output_type -> education.TenantHealthList
+	140, // [140:223] is the sub-list for method output_type
+	57,  // [57:140] is the sub-list for method input_type
+	57,  // [57:57] is the sub-list for extension type_name
+	57,  // [57:57] is the sub-list for extension extendee
+	0,   // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_education_proto_init() }
@@ -7446,16 +11383,16 @@ func file_education_proto_init() {
 		return
 	}
 	file_common_proto_init()
-	file_education_proto_msgTypes[42].OneofWrappers = []any{}
+	file_education_proto_msgTypes[58].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   85,
+			NumMessages:   130,
 			NumExtensions: 0,
-			NumServices:   8,
+			NumServices:   14,
 		},
 		GoTypes:           file_education_proto_goTypes,
 		DependencyIndexes: file_education_proto_depIdxs,
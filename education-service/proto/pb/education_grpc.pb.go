@@ -274,253 +274,409 @@ var CompanyFinanceService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CompanyService_GetCompanyBySubdomain_FullMethodName = "/education.CompanyService/GetCompanyBySubdomain"
-	CompanyService_CreateCompany_FullMethodName         = "/education.CompanyService/CreateCompany"
-	CompanyService_GetAll_FullMethodName                = "/education.CompanyService/GetAll"
-	CompanyService_UpdateCompany_FullMethodName         = "/education.CompanyService/UpdateCompany"
-	CompanyService_GetStatistic_FullMethodName          = "/education.CompanyService/GetStatistic"
+	BillingService_Quote_FullMethodName               = "/education.BillingService/Quote"
+	BillingService_CreateInvoice_FullMethodName       = "/education.BillingService/CreateInvoice"
+	BillingService_GetInvoices_FullMethodName         = "/education.BillingService/GetInvoices"
+	BillingService_VoidInvoice_FullMethodName         = "/education.BillingService/VoidInvoice"
+	BillingService_CreatePromoCode_FullMethodName     = "/education.BillingService/CreatePromoCode"
+	BillingService_GetPromoCodes_FullMethodName       = "/education.BillingService/GetPromoCodes"
+	BillingService_DeactivatePromoCode_FullMethodName = "/education.BillingService/DeactivatePromoCode"
+	BillingService_SetPriceOverride_FullMethodName    = "/education.BillingService/SetPriceOverride"
+	BillingService_GetPriceOverride_FullMethodName    = "/education.BillingService/GetPriceOverride"
 )
 
-// CompanyServiceClient is the client API for CompanyService service.
+// BillingServiceClient is the client API for BillingService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type CompanyServiceClient interface {
-	GetCompanyBySubdomain(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*GetCompanyResponse, error)
-	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetAll(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
-	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetStatistic(ctx context.Context, in *GetStatisticRequest, opts ...grpc.CallOption) (*GetStatisticResponse, error)
+//
+// billing service start
+type BillingServiceClient interface {
+	Quote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*TariffQuote, error)
+	CreateInvoice(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*Invoice, error)
+	GetInvoices(ctx context.Context, in *GetInvoicesRequest, opts ...grpc.CallOption) (*InvoiceList, error)
+	VoidInvoice(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	CreatePromoCode(ctx context.Context, in *PromoCode, opts ...grpc.CallOption) (*PromoCode, error)
+	GetPromoCodes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PromoCodeList, error)
+	DeactivatePromoCode(ctx context.Context, in *PromoCode, opts ...grpc.CallOption) (*AbsResponse, error)
+	SetPriceOverride(ctx context.Context, in *PriceOverride, opts ...grpc.CallOption) (*PriceOverride, error)
+	GetPriceOverride(ctx context.Context, in *GetPriceOverrideRequest, opts ...grpc.CallOption) (*PriceOverride, error)
+}
+
+type billingServiceClient struct {
+	cc grpc.ClientConnInterface
 }
 
-type companyServiceClient struct {
-	cc grpc.ClientConnInterface
+func NewBillingServiceClient(cc grpc.ClientConnInterface) BillingServiceClient {
+	return &billingServiceClient{cc}
 }
 
-func NewCompanyServiceClient(cc grpc.ClientConnInterface) CompanyServiceClient {
-	return &companyServiceClient{cc}
+func (c *billingServiceClient) Quote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*TariffQuote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffQuote)
+	err := c.cc.Invoke(ctx, BillingService_Quote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *companyServiceClient) GetCompanyBySubdomain(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*GetCompanyResponse, error) {
+func (c *billingServiceClient) CreateInvoice(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*Invoice, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCompanyResponse)
-	err := c.cc.Invoke(ctx, CompanyService_GetCompanyBySubdomain_FullMethodName, in, out, cOpts...)
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, BillingService_CreateInvoice_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *companyServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *billingServiceClient) GetInvoices(ctx context.Context, in *GetInvoicesRequest, opts ...grpc.CallOption) (*InvoiceList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceList)
+	err := c.cc.Invoke(ctx, BillingService_GetInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) VoidInvoice(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, CompanyService_CreateCompany_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BillingService_VoidInvoice_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *companyServiceClient) GetAll(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*GetAllResponse, error) {
+func (c *billingServiceClient) CreatePromoCode(ctx context.Context, in *PromoCode, opts ...grpc.CallOption) (*PromoCode, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllResponse)
-	err := c.cc.Invoke(ctx, CompanyService_GetAll_FullMethodName, in, out, cOpts...)
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, BillingService_CreatePromoCode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *companyServiceClient) UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *billingServiceClient) GetPromoCodes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PromoCodeList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoCodeList)
+	err := c.cc.Invoke(ctx, BillingService_GetPromoCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) DeactivatePromoCode(ctx context.Context, in *PromoCode, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, CompanyService_UpdateCompany_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BillingService_DeactivatePromoCode_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *companyServiceClient) GetStatistic(ctx context.Context, in *GetStatisticRequest, opts ...grpc.CallOption) (*GetStatisticResponse, error) {
+func (c *billingServiceClient) SetPriceOverride(ctx context.Context, in *PriceOverride, opts ...grpc.CallOption) (*PriceOverride, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetStatisticResponse)
-	err := c.cc.Invoke(ctx, CompanyService_GetStatistic_FullMethodName, in, out, cOpts...)
+	out := new(PriceOverride)
+	err := c.cc.Invoke(ctx, BillingService_SetPriceOverride_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CompanyServiceServer is the server API for CompanyService service.
-// All implementations must embed UnimplementedCompanyServiceServer
-// for forward compatibility.
-type CompanyServiceServer interface {
-	GetCompanyBySubdomain(context.Context, *GetCompanyRequest) (*GetCompanyResponse, error)
-	CreateCompany(context.Context, *CreateCompanyRequest) (*AbsResponse, error)
-	GetAll(context.Context, *PageRequest) (*GetAllResponse, error)
-	UpdateCompany(context.Context, *UpdateCompanyRequest) (*AbsResponse, error)
-	GetStatistic(context.Context, *GetStatisticRequest) (*GetStatisticResponse, error)
-	mustEmbedUnimplementedCompanyServiceServer()
+func (c *billingServiceClient) GetPriceOverride(ctx context.Context, in *GetPriceOverrideRequest, opts ...grpc.CallOption) (*PriceOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceOverride)
+	err := c.cc.Invoke(ctx, BillingService_GetPriceOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCompanyServiceServer must be embedded to have
+// BillingServiceServer is the server API for BillingService service.
+// All implementations must embed UnimplementedBillingServiceServer
+// for forward compatibility.
+//
+// billing service start
+type BillingServiceServer interface {
+	Quote(context.Context, *QuoteRequest) (*TariffQuote, error)
+	CreateInvoice(context.Context, *QuoteRequest) (*Invoice, error)
+	GetInvoices(context.Context, *GetInvoicesRequest) (*InvoiceList, error)
+	VoidInvoice(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	CreatePromoCode(context.Context, *PromoCode) (*PromoCode, error)
+	GetPromoCodes(context.Context, *emptypb.Empty) (*PromoCodeList, error)
+	DeactivatePromoCode(context.Context, *PromoCode) (*AbsResponse, error)
+	SetPriceOverride(context.Context, *PriceOverride) (*PriceOverride, error)
+	GetPriceOverride(context.Context, *GetPriceOverrideRequest) (*PriceOverride, error)
+	mustEmbedUnimplementedBillingServiceServer()
+}
+
+// UnimplementedBillingServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedCompanyServiceServer struct{}
+type UnimplementedBillingServiceServer struct{}
 
-func (UnimplementedCompanyServiceServer) GetCompanyBySubdomain(context.Context, *GetCompanyRequest) (*GetCompanyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyBySubdomain not implemented")
+func (UnimplementedBillingServiceServer) Quote(context.Context, *QuoteRequest) (*TariffQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quote not implemented")
 }
-func (UnimplementedCompanyServiceServer) CreateCompany(context.Context, *CreateCompanyRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
+func (UnimplementedBillingServiceServer) CreateInvoice(context.Context, *QuoteRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
 }
-func (UnimplementedCompanyServiceServer) GetAll(context.Context, *PageRequest) (*GetAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+func (UnimplementedBillingServiceServer) GetInvoices(context.Context, *GetInvoicesRequest) (*InvoiceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoices not implemented")
 }
-func (UnimplementedCompanyServiceServer) UpdateCompany(context.Context, *UpdateCompanyRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompany not implemented")
+func (UnimplementedBillingServiceServer) VoidInvoice(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidInvoice not implemented")
 }
-func (UnimplementedCompanyServiceServer) GetStatistic(context.Context, *GetStatisticRequest) (*GetStatisticResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStatistic not implemented")
+func (UnimplementedBillingServiceServer) CreatePromoCode(context.Context, *PromoCode) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
 }
-func (UnimplementedCompanyServiceServer) mustEmbedUnimplementedCompanyServiceServer() {}
-func (UnimplementedCompanyServiceServer) testEmbeddedByValue()                        {}
+func (UnimplementedBillingServiceServer) GetPromoCodes(context.Context, *emptypb.Empty) (*PromoCodeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromoCodes not implemented")
+}
+func (UnimplementedBillingServiceServer) DeactivatePromoCode(context.Context, *PromoCode) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePromoCode not implemented")
+}
+func (UnimplementedBillingServiceServer) SetPriceOverride(context.Context, *PriceOverride) (*PriceOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceOverride not implemented")
+}
+func (UnimplementedBillingServiceServer) GetPriceOverride(context.Context, *GetPriceOverrideRequest) (*PriceOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceOverride not implemented")
+}
+func (UnimplementedBillingServiceServer) mustEmbedUnimplementedBillingServiceServer() {}
+func (UnimplementedBillingServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeCompanyServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CompanyServiceServer will
+// UnsafeBillingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BillingServiceServer will
 // result in compilation errors.
-type UnsafeCompanyServiceServer interface {
-	mustEmbedUnimplementedCompanyServiceServer()
+type UnsafeBillingServiceServer interface {
+	mustEmbedUnimplementedBillingServiceServer()
 }
 
-func RegisterCompanyServiceServer(s grpc.ServiceRegistrar, srv CompanyServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCompanyServiceServer was
+func RegisterBillingServiceServer(s grpc.ServiceRegistrar, srv BillingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBillingServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&CompanyService_ServiceDesc, srv)
+	s.RegisterService(&BillingService_ServiceDesc, srv)
 }
 
-func _CompanyService_GetCompanyBySubdomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompanyRequest)
+func _BillingService_Quote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).GetCompanyBySubdomain(ctx, in)
+		return srv.(BillingServiceServer).Quote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_GetCompanyBySubdomain_FullMethodName,
+		FullMethod: BillingService_Quote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).GetCompanyBySubdomain(ctx, req.(*GetCompanyRequest))
+		return srv.(BillingServiceServer).Quote(ctx, req.(*QuoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCompanyRequest)
+func _BillingService_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).CreateCompany(ctx, in)
+		return srv.(BillingServiceServer).CreateInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_CreateCompany_FullMethodName,
+		FullMethod: BillingService_CreateInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
+		return srv.(BillingServiceServer).CreateInvoice(ctx, req.(*QuoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageRequest)
+func _BillingService_GetInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).GetAll(ctx, in)
+		return srv.(BillingServiceServer).GetInvoices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_GetAll_FullMethodName,
+		FullMethod: BillingService_GetInvoices_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).GetAll(ctx, req.(*PageRequest))
+		return srv.(BillingServiceServer).GetInvoices(ctx, req.(*GetInvoicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_UpdateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCompanyRequest)
+func _BillingService_VoidInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).UpdateCompany(ctx, in)
+		return srv.(BillingServiceServer).VoidInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_UpdateCompany_FullMethodName,
+		FullMethod: BillingService_VoidInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).UpdateCompany(ctx, req.(*UpdateCompanyRequest))
+		return srv.(BillingServiceServer).VoidInvoice(ctx, req.(*DeleteAbsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompanyService_GetStatistic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStatisticRequest)
+func _BillingService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCode)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompanyServiceServer).GetStatistic(ctx, in)
+		return srv.(BillingServiceServer).CreatePromoCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompanyService_GetStatistic_FullMethodName,
+		FullMethod: BillingService_CreatePromoCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompanyServiceServer).GetStatistic(ctx, req.(*GetStatisticRequest))
+		return srv.(BillingServiceServer).CreatePromoCode(ctx, req.(*PromoCode))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// CompanyService_ServiceDesc is the grpc.ServiceDesc for CompanyService service.
+func _BillingService_GetPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetPromoCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetPromoCodes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_DeactivatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).DeactivatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_DeactivatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).DeactivatePromoCode(ctx, req.(*PromoCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_SetPriceOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).SetPriceOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_SetPriceOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).SetPriceOverride(ctx, req.(*PriceOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_GetPriceOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetPriceOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetPriceOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetPriceOverride(ctx, req.(*GetPriceOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BillingService_ServiceDesc is the grpc.ServiceDesc for BillingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var CompanyService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "education.CompanyService",
-	HandlerType: (*CompanyServiceServer)(nil),
+var BillingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.BillingService",
+	HandlerType: (*BillingServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetCompanyBySubdomain",
-			Handler:    _CompanyService_GetCompanyBySubdomain_Handler,
+			MethodName: "Quote",
+			Handler:    _BillingService_Quote_Handler,
 		},
 		{
-			MethodName: "CreateCompany",
-			Handler:    _CompanyService_CreateCompany_Handler,
+			MethodName: "CreateInvoice",
+			Handler:    _BillingService_CreateInvoice_Handler,
 		},
 		{
-			MethodName: "GetAll",
-			Handler:    _CompanyService_GetAll_Handler,
+			MethodName: "GetInvoices",
+			Handler:    _BillingService_GetInvoices_Handler,
 		},
 		{
-			MethodName: "UpdateCompany",
-			Handler:    _CompanyService_UpdateCompany_Handler,
+			MethodName: "VoidInvoice",
+			Handler:    _BillingService_VoidInvoice_Handler,
 		},
 		{
-			MethodName: "GetStatistic",
-			Handler:    _CompanyService_GetStatistic_Handler,
+			MethodName: "CreatePromoCode",
+			Handler:    _BillingService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetPromoCodes",
+			Handler:    _BillingService_GetPromoCodes_Handler,
+		},
+		{
+			MethodName: "DeactivatePromoCode",
+			Handler:    _BillingService_DeactivatePromoCode_Handler,
+		},
+		{
+			MethodName: "SetPriceOverride",
+			Handler:    _BillingService_SetPriceOverride_Handler,
+		},
+		{
+			MethodName: "GetPriceOverride",
+			Handler:    _BillingService_GetPriceOverride_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -528,215 +684,143 @@ var CompanyService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TariffService_Create_FullMethodName = "/education.TariffService/Create"
-	TariffService_Update_FullMethodName = "/education.TariffService/Update"
-	TariffService_Delete_FullMethodName = "/education.TariffService/Delete"
-	TariffService_Get_FullMethodName    = "/education.TariffService/Get"
+	SignupService_CheckSubdomain_FullMethodName = "/education.SignupService/CheckSubdomain"
+	SignupService_Signup_FullMethodName         = "/education.SignupService/Signup"
 )
 
-// TariffServiceClient is the client API for TariffService service.
+// SignupServiceClient is the client API for SignupService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type TariffServiceClient interface {
-	Create(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error)
-	Update(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error)
-	Delete(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error)
-	Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TariffList, error)
+//
+// signup service start
+type SignupServiceClient interface {
+	CheckSubdomain(ctx context.Context, in *CheckSubdomainRequest, opts ...grpc.CallOption) (*CheckSubdomainResponse, error)
+	Signup(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption) (*SignupResponse, error)
 }
 
-type tariffServiceClient struct {
+type signupServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewTariffServiceClient(cc grpc.ClientConnInterface) TariffServiceClient {
-	return &tariffServiceClient{cc}
-}
-
-func (c *tariffServiceClient) Create(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Tariff)
-	err := c.cc.Invoke(ctx, TariffService_Create_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *tariffServiceClient) Update(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Tariff)
-	err := c.cc.Invoke(ctx, TariffService_Update_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewSignupServiceClient(cc grpc.ClientConnInterface) SignupServiceClient {
+	return &signupServiceClient{cc}
 }
 
-func (c *tariffServiceClient) Delete(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error) {
+func (c *signupServiceClient) CheckSubdomain(ctx context.Context, in *CheckSubdomainRequest, opts ...grpc.CallOption) (*CheckSubdomainResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Tariff)
-	err := c.cc.Invoke(ctx, TariffService_Delete_FullMethodName, in, out, cOpts...)
+	out := new(CheckSubdomainResponse)
+	err := c.cc.Invoke(ctx, SignupService_CheckSubdomain_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *tariffServiceClient) Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TariffList, error) {
+func (c *signupServiceClient) Signup(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption) (*SignupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TariffList)
-	err := c.cc.Invoke(ctx, TariffService_Get_FullMethodName, in, out, cOpts...)
+	out := new(SignupResponse)
+	err := c.cc.Invoke(ctx, SignupService_Signup_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// TariffServiceServer is the server API for TariffService service.
-// All implementations must embed UnimplementedTariffServiceServer
+// SignupServiceServer is the server API for SignupService service.
+// All implementations must embed UnimplementedSignupServiceServer
 // for forward compatibility.
-type TariffServiceServer interface {
-	Create(context.Context, *Tariff) (*Tariff, error)
-	Update(context.Context, *Tariff) (*Tariff, error)
-	Delete(context.Context, *Tariff) (*Tariff, error)
-	Get(context.Context, *emptypb.Empty) (*TariffList, error)
-	mustEmbedUnimplementedTariffServiceServer()
+//
+// signup service start
+type SignupServiceServer interface {
+	CheckSubdomain(context.Context, *CheckSubdomainRequest) (*CheckSubdomainResponse, error)
+	Signup(context.Context, *SignupRequest) (*SignupResponse, error)
+	mustEmbedUnimplementedSignupServiceServer()
 }
 
-// UnimplementedTariffServiceServer must be embedded to have
+// UnimplementedSignupServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedTariffServiceServer struct{}
+type UnimplementedSignupServiceServer struct{}
 
-func (UnimplementedTariffServiceServer) Create(context.Context, *Tariff) (*Tariff, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
-}
-func (UnimplementedTariffServiceServer) Update(context.Context, *Tariff) (*Tariff, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
-}
-func (UnimplementedTariffServiceServer) Delete(context.Context, *Tariff) (*Tariff, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (UnimplementedSignupServiceServer) CheckSubdomain(context.Context, *CheckSubdomainRequest) (*CheckSubdomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSubdomain not implemented")
 }
-func (UnimplementedTariffServiceServer) Get(context.Context, *emptypb.Empty) (*TariffList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (UnimplementedSignupServiceServer) Signup(context.Context, *SignupRequest) (*SignupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
 }
-func (UnimplementedTariffServiceServer) mustEmbedUnimplementedTariffServiceServer() {}
-func (UnimplementedTariffServiceServer) testEmbeddedByValue()                       {}
+func (UnimplementedSignupServiceServer) mustEmbedUnimplementedSignupServiceServer() {}
+func (UnimplementedSignupServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeTariffServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TariffServiceServer will
+// UnsafeSignupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SignupServiceServer will
 // result in compilation errors.
-type UnsafeTariffServiceServer interface {
-	mustEmbedUnimplementedTariffServiceServer()
+type UnsafeSignupServiceServer interface {
+	mustEmbedUnimplementedSignupServiceServer()
 }
 
-func RegisterTariffServiceServer(s grpc.ServiceRegistrar, srv TariffServiceServer) {
-	// If the following call pancis, it indicates UnimplementedTariffServiceServer was
+func RegisterSignupServiceServer(s grpc.ServiceRegistrar, srv SignupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSignupServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&TariffService_ServiceDesc, srv)
-}
-
-func _TariffService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tariff)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TariffServiceServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: TariffService_Create_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TariffServiceServer).Create(ctx, req.(*Tariff))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _TariffService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tariff)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TariffServiceServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: TariffService_Update_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TariffServiceServer).Update(ctx, req.(*Tariff))
-	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&SignupService_ServiceDesc, srv)
 }
 
-func _TariffService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tariff)
+func _SignupService_CheckSubdomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSubdomainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TariffServiceServer).Delete(ctx, in)
+		return srv.(SignupServiceServer).CheckSubdomain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TariffService_Delete_FullMethodName,
+		FullMethod: SignupService_CheckSubdomain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TariffServiceServer).Delete(ctx, req.(*Tariff))
+		return srv.(SignupServiceServer).CheckSubdomain(ctx, req.(*CheckSubdomainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TariffService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _SignupService_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TariffServiceServer).Get(ctx, in)
+		return srv.(SignupServiceServer).Signup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TariffService_Get_FullMethodName,
+		FullMethod: SignupService_Signup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TariffServiceServer).Get(ctx, req.(*emptypb.Empty))
+		return srv.(SignupServiceServer).Signup(ctx, req.(*SignupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// TariffService_ServiceDesc is the grpc.ServiceDesc for TariffService service.
+// SignupService_ServiceDesc is the grpc.ServiceDesc for SignupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var TariffService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "education.TariffService",
-	HandlerType: (*TariffServiceServer)(nil),
+var SignupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.SignupService",
+	HandlerType: (*SignupServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Create",
-			Handler:    _TariffService_Create_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _TariffService_Update_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _TariffService_Delete_Handler,
+			MethodName: "CheckSubdomain",
+			Handler:    _SignupService_CheckSubdomain_Handler,
 		},
 		{
-			MethodName: "Get",
-			Handler:    _TariffService_Get_Handler,
+			MethodName: "Signup",
+			Handler:    _SignupService_Signup_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -744,219 +828,291 @@ var TariffService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	RoomService_CreateRoom_FullMethodName = "/education.RoomService/CreateRoom"
-	RoomService_GetRooms_FullMethodName   = "/education.RoomService/GetRooms"
-	RoomService_UpdateRoom_FullMethodName = "/education.RoomService/UpdateRoom"
-	RoomService_DeleteRoom_FullMethodName = "/education.RoomService/DeleteRoom"
+	CompanyService_GetCompanyBySubdomain_FullMethodName = "/education.CompanyService/GetCompanyBySubdomain"
+	CompanyService_CreateCompany_FullMethodName         = "/education.CompanyService/CreateCompany"
+	CompanyService_GetAll_FullMethodName                = "/education.CompanyService/GetAll"
+	CompanyService_UpdateCompany_FullMethodName         = "/education.CompanyService/UpdateCompany"
+	CompanyService_GetStatistic_FullMethodName          = "/education.CompanyService/GetStatistic"
+	CompanyService_GetSubscription_FullMethodName       = "/education.CompanyService/GetSubscription"
 )
 
-// RoomServiceClient is the client API for RoomService service.
+// CompanyServiceClient is the client API for CompanyService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// room service start
-type RoomServiceClient interface {
-	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetRooms(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUpdateRoomAbs, error)
-	UpdateRoom(ctx context.Context, in *AbsRoom, opts ...grpc.CallOption) (*AbsResponse, error)
-	DeleteRoom(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+type CompanyServiceClient interface {
+	GetCompanyBySubdomain(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*GetCompanyResponse, error)
+	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetAll(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
+	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetStatistic(ctx context.Context, in *GetStatisticRequest, opts ...grpc.CallOption) (*GetStatisticResponse, error)
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*CompanySubscription, error)
 }
 
-type roomServiceClient struct {
+type companyServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewRoomServiceClient(cc grpc.ClientConnInterface) RoomServiceClient {
-	return &roomServiceClient{cc}
+func NewCompanyServiceClient(cc grpc.ClientConnInterface) CompanyServiceClient {
+	return &companyServiceClient{cc}
 }
 
-func (c *roomServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *companyServiceClient) GetCompanyBySubdomain(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*GetCompanyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompanyBySubdomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, RoomService_CreateRoom_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, CompanyService_CreateCompany_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roomServiceClient) GetRooms(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUpdateRoomAbs, error) {
+func (c *companyServiceClient) GetAll(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*GetAllResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetUpdateRoomAbs)
-	err := c.cc.Invoke(ctx, RoomService_GetRooms_FullMethodName, in, out, cOpts...)
+	out := new(GetAllResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetAll_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roomServiceClient) UpdateRoom(ctx context.Context, in *AbsRoom, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *companyServiceClient) UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, RoomService_UpdateRoom_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, CompanyService_UpdateCompany_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *roomServiceClient) DeleteRoom(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *companyServiceClient) GetStatistic(ctx context.Context, in *GetStatisticRequest, opts ...grpc.CallOption) (*GetStatisticResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, RoomService_DeleteRoom_FullMethodName, in, out, cOpts...)
+	out := new(GetStatisticResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetStatistic_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RoomServiceServer is the server API for RoomService service.
-// All implementations must embed UnimplementedRoomServiceServer
+func (c *companyServiceClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*CompanySubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanySubscription)
+	err := c.cc.Invoke(ctx, CompanyService_GetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CompanyServiceServer is the server API for CompanyService service.
+// All implementations must embed UnimplementedCompanyServiceServer
 // for forward compatibility.
-//
-// room service start
-type RoomServiceServer interface {
-	CreateRoom(context.Context, *CreateRoomRequest) (*AbsResponse, error)
-	GetRooms(context.Context, *emptypb.Empty) (*GetUpdateRoomAbs, error)
-	UpdateRoom(context.Context, *AbsRoom) (*AbsResponse, error)
-	DeleteRoom(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
-	mustEmbedUnimplementedRoomServiceServer()
+type CompanyServiceServer interface {
+	GetCompanyBySubdomain(context.Context, *GetCompanyRequest) (*GetCompanyResponse, error)
+	CreateCompany(context.Context, *CreateCompanyRequest) (*AbsResponse, error)
+	GetAll(context.Context, *PageRequest) (*GetAllResponse, error)
+	UpdateCompany(context.Context, *UpdateCompanyRequest) (*AbsResponse, error)
+	GetStatistic(context.Context, *GetStatisticRequest) (*GetStatisticResponse, error)
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*CompanySubscription, error)
+	mustEmbedUnimplementedCompanyServiceServer()
 }
 
-// UnimplementedRoomServiceServer must be embedded to have
+// UnimplementedCompanyServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedRoomServiceServer struct{}
+type UnimplementedCompanyServiceServer struct{}
 
-func (UnimplementedRoomServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+func (UnimplementedCompanyServiceServer) GetCompanyBySubdomain(context.Context, *GetCompanyRequest) (*GetCompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyBySubdomain not implemented")
 }
-func (UnimplementedRoomServiceServer) GetRooms(context.Context, *emptypb.Empty) (*GetUpdateRoomAbs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRooms not implemented")
+func (UnimplementedCompanyServiceServer) CreateCompany(context.Context, *CreateCompanyRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
 }
-func (UnimplementedRoomServiceServer) UpdateRoom(context.Context, *AbsRoom) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+func (UnimplementedCompanyServiceServer) GetAll(context.Context, *PageRequest) (*GetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
-func (UnimplementedRoomServiceServer) DeleteRoom(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
+func (UnimplementedCompanyServiceServer) UpdateCompany(context.Context, *UpdateCompanyRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompany not implemented")
 }
-func (UnimplementedRoomServiceServer) mustEmbedUnimplementedRoomServiceServer() {}
-func (UnimplementedRoomServiceServer) testEmbeddedByValue()                     {}
+func (UnimplementedCompanyServiceServer) GetStatistic(context.Context, *GetStatisticRequest) (*GetStatisticResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatistic not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*CompanySubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (UnimplementedCompanyServiceServer) mustEmbedUnimplementedCompanyServiceServer() {}
+func (UnimplementedCompanyServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeRoomServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RoomServiceServer will
+// UnsafeCompanyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompanyServiceServer will
 // result in compilation errors.
-type UnsafeRoomServiceServer interface {
-	mustEmbedUnimplementedRoomServiceServer()
+type UnsafeCompanyServiceServer interface {
+	mustEmbedUnimplementedCompanyServiceServer()
 }
 
-func RegisterRoomServiceServer(s grpc.ServiceRegistrar, srv RoomServiceServer) {
-	// If the following call pancis, it indicates UnimplementedRoomServiceServer was
+func RegisterCompanyServiceServer(s grpc.ServiceRegistrar, srv CompanyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCompanyServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&RoomService_ServiceDesc, srv)
+	s.RegisterService(&CompanyService_ServiceDesc, srv)
 }
 
-func _RoomService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRoomRequest)
+func _CompanyService_GetCompanyBySubdomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoomServiceServer).CreateRoom(ctx, in)
+		return srv.(CompanyServiceServer).GetCompanyBySubdomain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoomService_CreateRoom_FullMethodName,
+		FullMethod: CompanyService_GetCompanyBySubdomain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoomServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+		return srv.(CompanyServiceServer).GetCompanyBySubdomain(ctx, req.(*GetCompanyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoomService_GetRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _CompanyService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoomServiceServer).GetRooms(ctx, in)
+		return srv.(CompanyServiceServer).CreateCompany(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoomService_GetRooms_FullMethodName,
+		FullMethod: CompanyService_CreateCompany_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoomServiceServer).GetRooms(ctx, req.(*emptypb.Empty))
+		return srv.(CompanyServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoomService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AbsRoom)
+func _CompanyService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoomServiceServer).UpdateRoom(ctx, in)
+		return srv.(CompanyServiceServer).GetAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoomService_UpdateRoom_FullMethodName,
+		FullMethod: CompanyService_GetAll_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoomServiceServer).UpdateRoom(ctx, req.(*AbsRoom))
+		return srv.(CompanyServiceServer).GetAll(ctx, req.(*PageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RoomService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAbsRequest)
+func _CompanyService_UpdateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RoomServiceServer).DeleteRoom(ctx, in)
+		return srv.(CompanyServiceServer).UpdateCompany(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RoomService_DeleteRoom_FullMethodName,
+		FullMethod: CompanyService_UpdateCompany_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RoomServiceServer).DeleteRoom(ctx, req.(*DeleteAbsRequest))
+		return srv.(CompanyServiceServer).UpdateCompany(ctx, req.(*UpdateCompanyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// RoomService_ServiceDesc is the grpc.ServiceDesc for RoomService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var RoomService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "education.RoomService",
-	HandlerType: (*RoomServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRoom",
-			Handler:    _RoomService_CreateRoom_Handler,
-		},
-		{
-			MethodName: "GetRooms",
-			Handler:    _RoomService_GetRooms_Handler,
-		},
-		{
-			MethodName: "UpdateRoom",
-			Handler:    _RoomService_UpdateRoom_Handler,
-		},
+func _CompanyService_GetStatistic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatisticRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetStatistic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetStatistic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetStatistic(ctx, req.(*GetStatisticRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CompanyService_ServiceDesc is the grpc.ServiceDesc for CompanyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CompanyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.CompanyService",
+	HandlerType: (*CompanyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "DeleteRoom",
-			Handler:    _RoomService_DeleteRoom_Handler,
+			MethodName: "GetCompanyBySubdomain",
+			Handler:    _CompanyService_GetCompanyBySubdomain_Handler,
+		},
+		{
+			MethodName: "CreateCompany",
+			Handler:    _CompanyService_CreateCompany_Handler,
+		},
+		{
+			MethodName: "GetAll",
+			Handler:    _CompanyService_GetAll_Handler,
+		},
+		{
+			MethodName: "UpdateCompany",
+			Handler:    _CompanyService_UpdateCompany_Handler,
+		},
+		{
+			MethodName: "GetStatistic",
+			Handler:    _CompanyService_GetStatistic_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _CompanyService_GetSubscription_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -964,257 +1120,215 @@ var RoomService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CourseService_CreateCourse_FullMethodName  = "/education.CourseService/CreateCourse"
-	CourseService_GetCourses_FullMethodName    = "/education.CourseService/GetCourses"
-	CourseService_GetCourseById_FullMethodName = "/education.CourseService/GetCourseById"
-	CourseService_UpdateCourse_FullMethodName  = "/education.CourseService/UpdateCourse"
-	CourseService_DeleteCourse_FullMethodName  = "/education.CourseService/DeleteCourse"
+	TariffService_Create_FullMethodName = "/education.TariffService/Create"
+	TariffService_Update_FullMethodName = "/education.TariffService/Update"
+	TariffService_Delete_FullMethodName = "/education.TariffService/Delete"
+	TariffService_Get_FullMethodName    = "/education.TariffService/Get"
 )
 
-// CourseServiceClient is the client API for CourseService service.
+// TariffServiceClient is the client API for TariffService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// course service start
-type CourseServiceClient interface {
-	CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUpdateCourseAbs, error)
-	GetCourseById(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*GetCourseByIdResponse, error)
-	UpdateCourse(ctx context.Context, in *AbsCourse, opts ...grpc.CallOption) (*AbsResponse, error)
-	DeleteCourse(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+type TariffServiceClient interface {
+	Create(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error)
+	Update(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error)
+	Delete(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error)
+	Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TariffList, error)
 }
 
-type courseServiceClient struct {
+type tariffServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewCourseServiceClient(cc grpc.ClientConnInterface) CourseServiceClient {
-	return &courseServiceClient{cc}
-}
-
-func (c *courseServiceClient) CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, CourseService_CreateCourse_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewTariffServiceClient(cc grpc.ClientConnInterface) TariffServiceClient {
+	return &tariffServiceClient{cc}
 }
 
-func (c *courseServiceClient) GetCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUpdateCourseAbs, error) {
+func (c *tariffServiceClient) Create(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetUpdateCourseAbs)
-	err := c.cc.Invoke(ctx, CourseService_GetCourses_FullMethodName, in, out, cOpts...)
+	out := new(Tariff)
+	err := c.cc.Invoke(ctx, TariffService_Create_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *courseServiceClient) GetCourseById(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*GetCourseByIdResponse, error) {
+func (c *tariffServiceClient) Update(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCourseByIdResponse)
-	err := c.cc.Invoke(ctx, CourseService_GetCourseById_FullMethodName, in, out, cOpts...)
+	out := new(Tariff)
+	err := c.cc.Invoke(ctx, TariffService_Update_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *courseServiceClient) UpdateCourse(ctx context.Context, in *AbsCourse, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *tariffServiceClient) Delete(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, CourseService_UpdateCourse_FullMethodName, in, out, cOpts...)
+	out := new(Tariff)
+	err := c.cc.Invoke(ctx, TariffService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *courseServiceClient) DeleteCourse(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *tariffServiceClient) Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TariffList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, CourseService_DeleteCourse_FullMethodName, in, out, cOpts...)
+	out := new(TariffList)
+	err := c.cc.Invoke(ctx, TariffService_Get_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CourseServiceServer is the server API for CourseService service.
-// All implementations must embed UnimplementedCourseServiceServer
+// TariffServiceServer is the server API for TariffService service.
+// All implementations must embed UnimplementedTariffServiceServer
 // for forward compatibility.
-//
-// course service start
-type CourseServiceServer interface {
-	CreateCourse(context.Context, *CreateCourseRequest) (*AbsResponse, error)
-	GetCourses(context.Context, *emptypb.Empty) (*GetUpdateCourseAbs, error)
-	GetCourseById(context.Context, *GetCourseByIdRequest) (*GetCourseByIdResponse, error)
-	UpdateCourse(context.Context, *AbsCourse) (*AbsResponse, error)
-	DeleteCourse(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
-	mustEmbedUnimplementedCourseServiceServer()
+type TariffServiceServer interface {
+	Create(context.Context, *Tariff) (*Tariff, error)
+	Update(context.Context, *Tariff) (*Tariff, error)
+	Delete(context.Context, *Tariff) (*Tariff, error)
+	Get(context.Context, *emptypb.Empty) (*TariffList, error)
+	mustEmbedUnimplementedTariffServiceServer()
 }
 
-// UnimplementedCourseServiceServer must be embedded to have
+// UnimplementedTariffServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedCourseServiceServer struct{}
+type UnimplementedTariffServiceServer struct{}
 
-func (UnimplementedCourseServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
-}
-func (UnimplementedCourseServiceServer) GetCourses(context.Context, *emptypb.Empty) (*GetUpdateCourseAbs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
+func (UnimplementedTariffServiceServer) Create(context.Context, *Tariff) (*Tariff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedCourseServiceServer) GetCourseById(context.Context, *GetCourseByIdRequest) (*GetCourseByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCourseById not implemented")
+func (UnimplementedTariffServiceServer) Update(context.Context, *Tariff) (*Tariff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedCourseServiceServer) UpdateCourse(context.Context, *AbsCourse) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+func (UnimplementedTariffServiceServer) Delete(context.Context, *Tariff) (*Tariff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedCourseServiceServer) DeleteCourse(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+func (UnimplementedTariffServiceServer) Get(context.Context, *emptypb.Empty) (*TariffList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
-func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
-func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
+func (UnimplementedTariffServiceServer) mustEmbedUnimplementedTariffServiceServer() {}
+func (UnimplementedTariffServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeCourseServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CourseServiceServer will
+// UnsafeTariffServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TariffServiceServer will
 // result in compilation errors.
-type UnsafeCourseServiceServer interface {
-	mustEmbedUnimplementedCourseServiceServer()
+type UnsafeTariffServiceServer interface {
+	mustEmbedUnimplementedTariffServiceServer()
 }
 
-func RegisterCourseServiceServer(s grpc.ServiceRegistrar, srv CourseServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCourseServiceServer was
+func RegisterTariffServiceServer(s grpc.ServiceRegistrar, srv TariffServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTariffServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&CourseService_ServiceDesc, srv)
-}
-
-func _CourseService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCourseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CourseServiceServer).CreateCourse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: CourseService_CreateCourse_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&TariffService_ServiceDesc, srv)
 }
 
-func _CourseService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _TariffService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tariff)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).GetCourses(ctx, in)
+		return srv.(TariffServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_GetCourses_FullMethodName,
+		FullMethod: TariffService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).GetCourses(ctx, req.(*emptypb.Empty))
+		return srv.(TariffServiceServer).Create(ctx, req.(*Tariff))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_GetCourseById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCourseByIdRequest)
+func _TariffService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tariff)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).GetCourseById(ctx, in)
+		return srv.(TariffServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_GetCourseById_FullMethodName,
+		FullMethod: TariffService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).GetCourseById(ctx, req.(*GetCourseByIdRequest))
+		return srv.(TariffServiceServer).Update(ctx, req.(*Tariff))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AbsCourse)
+func _TariffService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tariff)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).UpdateCourse(ctx, in)
+		return srv.(TariffServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_UpdateCourse_FullMethodName,
+		FullMethod: TariffService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).UpdateCourse(ctx, req.(*AbsCourse))
+		return srv.(TariffServiceServer).Delete(ctx, req.(*Tariff))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CourseService_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAbsRequest)
+func _TariffService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CourseServiceServer).DeleteCourse(ctx, in)
+		return srv.(TariffServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CourseService_DeleteCourse_FullMethodName,
+		FullMethod: TariffService_Get_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CourseServiceServer).DeleteCourse(ctx, req.(*DeleteAbsRequest))
+		return srv.(TariffServiceServer).Get(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// CourseService_ServiceDesc is the grpc.ServiceDesc for CourseService service.
+// TariffService_ServiceDesc is the grpc.ServiceDesc for TariffService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var CourseService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "education.CourseService",
-	HandlerType: (*CourseServiceServer)(nil),
+var TariffService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.TariffService",
+	HandlerType: (*TariffServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateCourse",
-			Handler:    _CourseService_CreateCourse_Handler,
+			MethodName: "Create",
+			Handler:    _TariffService_Create_Handler,
 		},
 		{
-			MethodName: "GetCourses",
-			Handler:    _CourseService_GetCourses_Handler,
-		},
-		{
-			MethodName: "GetCourseById",
-			Handler:    _CourseService_GetCourseById_Handler,
+			MethodName: "Update",
+			Handler:    _TariffService_Update_Handler,
 		},
 		{
-			MethodName: "UpdateCourse",
-			Handler:    _CourseService_UpdateCourse_Handler,
+			MethodName: "Delete",
+			Handler:    _TariffService_Delete_Handler,
 		},
 		{
-			MethodName: "DeleteCourse",
-			Handler:    _CourseService_DeleteCourse_Handler,
+			MethodName: "Get",
+			Handler:    _TariffService_Get_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1222,629 +1336,1973 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	GroupService_CreateGroup_FullMethodName                   = "/education.GroupService/CreateGroup"
-	GroupService_GetGroups_FullMethodName                     = "/education.GroupService/GetGroups"
-	GroupService_GetGroupById_FullMethodName                  = "/education.GroupService/GetGroupById"
-	GroupService_GetGroupsByCourseId_FullMethodName           = "/education.GroupService/GetGroupsByCourseId"
-	GroupService_GetGroupsByStudentId_FullMethodName          = "/education.GroupService/GetGroupsByStudentId"
-	GroupService_UpdateGroup_FullMethodName                   = "/education.GroupService/UpdateGroup"
-	GroupService_DeleteGroup_FullMethodName                   = "/education.GroupService/DeleteGroup"
-	GroupService_GetGroupsByTeacherId_FullMethodName          = "/education.GroupService/GetGroupsByTeacherId"
-	GroupService_GetCommonInformationEducation_FullMethodName = "/education.GroupService/GetCommonInformationEducation"
-	GroupService_GetLeftAfterTrialPeriod_FullMethodName       = "/education.GroupService/GetLeftAfterTrialPeriod"
+	RoomService_CreateRoom_FullMethodName = "/education.RoomService/CreateRoom"
+	RoomService_GetRooms_FullMethodName   = "/education.RoomService/GetRooms"
+	RoomService_UpdateRoom_FullMethodName = "/education.RoomService/UpdateRoom"
+	RoomService_DeleteRoom_FullMethodName = "/education.RoomService/DeleteRoom"
 )
 
-// GroupServiceClient is the client API for GroupService service.
+// RoomServiceClient is the client API for RoomService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// group service start
-type GroupServiceClient interface {
-	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
-	GetGroupById(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupAbsResponse, error)
-	GetGroupsByCourseId(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupsByCourseResponse, error)
-	GetGroupsByStudentId(ctx context.Context, in *StudentIdRequest, opts ...grpc.CallOption) (*GetGroupsByStudentResponse, error)
-	UpdateGroup(ctx context.Context, in *GetUpdateGroupAbs, opts ...grpc.CallOption) (*AbsResponse, error)
-	DeleteGroup(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetGroupsByTeacherId(ctx context.Context, in *GetGroupsByTeacherIdRequest, opts ...grpc.CallOption) (*GetGroupsByTeacherResponse, error)
-	GetCommonInformationEducation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationEducationResponse, error)
-	GetLeftAfterTrialPeriod(ctx context.Context, in *GetLeftAfterTrialPeriodRequest, opts ...grpc.CallOption) (*GetLeftAfterTrialPeriodResponse, error)
+// room service start
+type RoomServiceClient interface {
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetRooms(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUpdateRoomAbs, error)
+	UpdateRoom(ctx context.Context, in *AbsRoom, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteRoom(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
-type groupServiceClient struct {
+type roomServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewGroupServiceClient(cc grpc.ClientConnInterface) GroupServiceClient {
-	return &groupServiceClient{cc}
+func NewRoomServiceClient(cc grpc.ClientConnInterface) RoomServiceClient {
+	return &roomServiceClient{cc}
 }
 
-func (c *groupServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *roomServiceClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, GroupService_CreateGroup_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *groupServiceClient) GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetGroupsResponse)
-	err := c.cc.Invoke(ctx, GroupService_GetGroups_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *groupServiceClient) GetGroupById(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupAbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetGroupAbsResponse)
-	err := c.cc.Invoke(ctx, GroupService_GetGroupById_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *groupServiceClient) GetGroupsByCourseId(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupsByCourseResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetGroupsByCourseResponse)
-	err := c.cc.Invoke(ctx, GroupService_GetGroupsByCourseId_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, RoomService_CreateRoom_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupServiceClient) GetGroupsByStudentId(ctx context.Context, in *StudentIdRequest, opts ...grpc.CallOption) (*GetGroupsByStudentResponse, error) {
+func (c *roomServiceClient) GetRooms(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUpdateRoomAbs, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetGroupsByStudentResponse)
-	err := c.cc.Invoke(ctx, GroupService_GetGroupsByStudentId_FullMethodName, in, out, cOpts...)
+	out := new(GetUpdateRoomAbs)
+	err := c.cc.Invoke(ctx, RoomService_GetRooms_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupServiceClient) UpdateGroup(ctx context.Context, in *GetUpdateGroupAbs, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *roomServiceClient) UpdateRoom(ctx context.Context, in *AbsRoom, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, GroupService_UpdateGroup_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, RoomService_UpdateRoom_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupServiceClient) DeleteGroup(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *roomServiceClient) DeleteRoom(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, GroupService_DeleteGroup_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *groupServiceClient) GetGroupsByTeacherId(ctx context.Context, in *GetGroupsByTeacherIdRequest, opts ...grpc.CallOption) (*GetGroupsByTeacherResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetGroupsByTeacherResponse)
-	err := c.cc.Invoke(ctx, GroupService_GetGroupsByTeacherId_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *groupServiceClient) GetCommonInformationEducation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationEducationResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCommonInformationEducationResponse)
-	err := c.cc.Invoke(ctx, GroupService_GetCommonInformationEducation_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *groupServiceClient) GetLeftAfterTrialPeriod(ctx context.Context, in *GetLeftAfterTrialPeriodRequest, opts ...grpc.CallOption) (*GetLeftAfterTrialPeriodResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetLeftAfterTrialPeriodResponse)
-	err := c.cc.Invoke(ctx, GroupService_GetLeftAfterTrialPeriod_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, RoomService_DeleteRoom_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// GroupServiceServer is the server API for GroupService service.
-// All implementations must embed UnimplementedGroupServiceServer
+// RoomServiceServer is the server API for RoomService service.
+// All implementations must embed UnimplementedRoomServiceServer
 // for forward compatibility.
 //
-// group service start
-type GroupServiceServer interface {
-	CreateGroup(context.Context, *CreateGroupRequest) (*AbsResponse, error)
-	GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error)
-	GetGroupById(context.Context, *GetGroupByIdRequest) (*GetGroupAbsResponse, error)
-	GetGroupsByCourseId(context.Context, *GetGroupByIdRequest) (*GetGroupsByCourseResponse, error)
-	GetGroupsByStudentId(context.Context, *StudentIdRequest) (*GetGroupsByStudentResponse, error)
-	UpdateGroup(context.Context, *GetUpdateGroupAbs) (*AbsResponse, error)
-	DeleteGroup(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
-	GetGroupsByTeacherId(context.Context, *GetGroupsByTeacherIdRequest) (*GetGroupsByTeacherResponse, error)
-	GetCommonInformationEducation(context.Context, *emptypb.Empty) (*GetCommonInformationEducationResponse, error)
-	GetLeftAfterTrialPeriod(context.Context, *GetLeftAfterTrialPeriodRequest) (*GetLeftAfterTrialPeriodResponse, error)
-	mustEmbedUnimplementedGroupServiceServer()
+// room service start
+type RoomServiceServer interface {
+	CreateRoom(context.Context, *CreateRoomRequest) (*AbsResponse, error)
+	GetRooms(context.Context, *emptypb.Empty) (*GetUpdateRoomAbs, error)
+	UpdateRoom(context.Context, *AbsRoom) (*AbsResponse, error)
+	DeleteRoom(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedRoomServiceServer()
 }
 
-// UnimplementedGroupServiceServer must be embedded to have
+// UnimplementedRoomServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedGroupServiceServer struct{}
+type UnimplementedRoomServiceServer struct{}
 
-func (UnimplementedGroupServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
-}
-func (UnimplementedGroupServiceServer) GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroups not implemented")
-}
-func (UnimplementedGroupServiceServer) GetGroupById(context.Context, *GetGroupByIdRequest) (*GetGroupAbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupById not implemented")
-}
-func (UnimplementedGroupServiceServer) GetGroupsByCourseId(context.Context, *GetGroupByIdRequest) (*GetGroupsByCourseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByCourseId not implemented")
-}
-func (UnimplementedGroupServiceServer) GetGroupsByStudentId(context.Context, *StudentIdRequest) (*GetGroupsByStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByStudentId not implemented")
-}
-func (UnimplementedGroupServiceServer) UpdateGroup(context.Context, *GetUpdateGroupAbs) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
-}
-func (UnimplementedGroupServiceServer) DeleteGroup(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+func (UnimplementedRoomServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
 }
-func (UnimplementedGroupServiceServer) GetGroupsByTeacherId(context.Context, *GetGroupsByTeacherIdRequest) (*GetGroupsByTeacherResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByTeacherId not implemented")
+func (UnimplementedRoomServiceServer) GetRooms(context.Context, *emptypb.Empty) (*GetUpdateRoomAbs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRooms not implemented")
 }
-func (UnimplementedGroupServiceServer) GetCommonInformationEducation(context.Context, *emptypb.Empty) (*GetCommonInformationEducationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommonInformationEducation not implemented")
+func (UnimplementedRoomServiceServer) UpdateRoom(context.Context, *AbsRoom) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
 }
-func (UnimplementedGroupServiceServer) GetLeftAfterTrialPeriod(context.Context, *GetLeftAfterTrialPeriodRequest) (*GetLeftAfterTrialPeriodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLeftAfterTrialPeriod not implemented")
+func (UnimplementedRoomServiceServer) DeleteRoom(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
 }
-func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
-func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
+func (UnimplementedRoomServiceServer) mustEmbedUnimplementedRoomServiceServer() {}
+func (UnimplementedRoomServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeGroupServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GroupServiceServer will
+// UnsafeRoomServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomServiceServer will
 // result in compilation errors.
-type UnsafeGroupServiceServer interface {
-	mustEmbedUnimplementedGroupServiceServer()
+type UnsafeRoomServiceServer interface {
+	mustEmbedUnimplementedRoomServiceServer()
 }
 
-func RegisterGroupServiceServer(s grpc.ServiceRegistrar, srv GroupServiceServer) {
-	// If the following call pancis, it indicates UnimplementedGroupServiceServer was
+func RegisterRoomServiceServer(s grpc.ServiceRegistrar, srv RoomServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRoomServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&GroupService_ServiceDesc, srv)
+	s.RegisterService(&RoomService_ServiceDesc, srv)
 }
 
-func _GroupService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupRequest)
+func _RoomService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).CreateGroup(ctx, in)
+		return srv.(RoomServiceServer).CreateRoom(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_CreateGroup_FullMethodName,
+		FullMethod: RoomService_CreateRoom_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+		return srv.(RoomServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupsRequest)
+func _RoomService_GetRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetGroups(ctx, in)
+		return srv.(RoomServiceServer).GetRooms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetGroups_FullMethodName,
+		FullMethod: RoomService_GetRooms_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetGroups(ctx, req.(*GetGroupsRequest))
+		return srv.(RoomServiceServer).GetRooms(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetGroupById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupByIdRequest)
+func _RoomService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsRoom)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetGroupById(ctx, in)
+		return srv.(RoomServiceServer).UpdateRoom(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetGroupById_FullMethodName,
+		FullMethod: RoomService_UpdateRoom_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetGroupById(ctx, req.(*GetGroupByIdRequest))
+		return srv.(RoomServiceServer).UpdateRoom(ctx, req.(*AbsRoom))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetGroupsByCourseId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupByIdRequest)
+func _RoomService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetGroupsByCourseId(ctx, in)
+		return srv.(RoomServiceServer).DeleteRoom(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetGroupsByCourseId_FullMethodName,
+		FullMethod: RoomService_DeleteRoom_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetGroupsByCourseId(ctx, req.(*GetGroupByIdRequest))
+		return srv.(RoomServiceServer).DeleteRoom(ctx, req.(*DeleteAbsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetGroupsByStudentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StudentIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// RoomService_ServiceDesc is the grpc.ServiceDesc for RoomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.RoomService",
+	HandlerType: (*RoomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoom",
+			Handler:    _RoomService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "GetRooms",
+			Handler:    _RoomService_GetRooms_Handler,
+		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _RoomService_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "DeleteRoom",
+			Handler:    _RoomService_DeleteRoom_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	CourseService_CreateCourse_FullMethodName  = "/education.CourseService/CreateCourse"
+	CourseService_GetCourses_FullMethodName    = "/education.CourseService/GetCourses"
+	CourseService_GetCourseById_FullMethodName = "/education.CourseService/GetCourseById"
+	CourseService_UpdateCourse_FullMethodName  = "/education.CourseService/UpdateCourse"
+	CourseService_DeleteCourse_FullMethodName  = "/education.CourseService/DeleteCourse"
+)
+
+// CourseServiceClient is the client API for CourseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// course service start
+type CourseServiceClient interface {
+	CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUpdateCourseAbs, error)
+	GetCourseById(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*GetCourseByIdResponse, error)
+	UpdateCourse(ctx context.Context, in *AbsCourse, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteCourse(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type courseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourseServiceClient(cc grpc.ClientConnInterface) CourseServiceClient {
+	return &courseServiceClient{cc}
+}
+
+func (c *courseServiceClient) CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCourses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetUpdateCourseAbs, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUpdateCourseAbs)
+	err := c.cc.Invoke(ctx, CourseService_GetCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCourseById(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*GetCourseByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseByIdResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UpdateCourse(ctx context.Context, in *AbsCourse, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CourseService_UpdateCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DeleteCourse(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CourseService_DeleteCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourseServiceServer is the server API for CourseService service.
+// All implementations must embed UnimplementedCourseServiceServer
+// for forward compatibility.
+//
+// course service start
+type CourseServiceServer interface {
+	CreateCourse(context.Context, *CreateCourseRequest) (*AbsResponse, error)
+	GetCourses(context.Context, *emptypb.Empty) (*GetUpdateCourseAbs, error)
+	GetCourseById(context.Context, *GetCourseByIdRequest) (*GetCourseByIdResponse, error)
+	UpdateCourse(context.Context, *AbsCourse) (*AbsResponse, error)
+	DeleteCourse(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedCourseServiceServer()
+}
+
+// UnimplementedCourseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCourseServiceServer struct{}
+
+func (UnimplementedCourseServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourses(context.Context, *emptypb.Empty) (*GetUpdateCourseAbs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourseById(context.Context, *GetCourseByIdRequest) (*GetCourseByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseById not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateCourse(context.Context, *AbsCourse) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) DeleteCourse(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
+func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCourseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourseServiceServer will
+// result in compilation errors.
+type UnsafeCourseServiceServer interface {
+	mustEmbedUnimplementedCourseServiceServer()
+}
+
+func RegisterCourseServiceServer(s grpc.ServiceRegistrar, srv CourseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCourseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CourseService_ServiceDesc, srv)
+}
+
+func _CourseService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCourseById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseById(ctx, req.(*GetCourseByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsCourse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateCourse(ctx, req.(*AbsCourse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DeleteCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DeleteCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DeleteCourse(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CourseService_ServiceDesc is the grpc.ServiceDesc for CourseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.CourseService",
+	HandlerType: (*CourseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCourse",
+			Handler:    _CourseService_CreateCourse_Handler,
+		},
+		{
+			MethodName: "GetCourses",
+			Handler:    _CourseService_GetCourses_Handler,
+		},
+		{
+			MethodName: "GetCourseById",
+			Handler:    _CourseService_GetCourseById_Handler,
+		},
+		{
+			MethodName: "UpdateCourse",
+			Handler:    _CourseService_UpdateCourse_Handler,
+		},
+		{
+			MethodName: "DeleteCourse",
+			Handler:    _CourseService_DeleteCourse_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	GroupService_CreateGroup_FullMethodName                   = "/education.GroupService/CreateGroup"
+	GroupService_GetGroups_FullMethodName                     = "/education.GroupService/GetGroups"
+	GroupService_GetGroupById_FullMethodName                  = "/education.GroupService/GetGroupById"
+	GroupService_GetGroupsByCourseId_FullMethodName           = "/education.GroupService/GetGroupsByCourseId"
+	GroupService_GetGroupsByStudentId_FullMethodName          = "/education.GroupService/GetGroupsByStudentId"
+	GroupService_UpdateGroup_FullMethodName                   = "/education.GroupService/UpdateGroup"
+	GroupService_DeleteGroup_FullMethodName                   = "/education.GroupService/DeleteGroup"
+	GroupService_GetGroupsByTeacherId_FullMethodName          = "/education.GroupService/GetGroupsByTeacherId"
+	GroupService_GetCommonInformationEducation_FullMethodName = "/education.GroupService/GetCommonInformationEducation"
+	GroupService_GetLeftAfterTrialPeriod_FullMethodName       = "/education.GroupService/GetLeftAfterTrialPeriod"
+)
+
+// GroupServiceClient is the client API for GroupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// group service start
+type GroupServiceClient interface {
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error)
+	GetGroupById(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupAbsResponse, error)
+	GetGroupsByCourseId(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupsByCourseResponse, error)
+	GetGroupsByStudentId(ctx context.Context, in *StudentIdRequest, opts ...grpc.CallOption) (*GetGroupsByStudentResponse, error)
+	UpdateGroup(ctx context.Context, in *GetUpdateGroupAbs, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteGroup(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetGroupsByTeacherId(ctx context.Context, in *GetGroupsByTeacherIdRequest, opts ...grpc.CallOption) (*GetGroupsByTeacherResponse, error)
+	GetCommonInformationEducation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationEducationResponse, error)
+	GetLeftAfterTrialPeriod(ctx context.Context, in *GetLeftAfterTrialPeriodRequest, opts ...grpc.CallOption) (*GetLeftAfterTrialPeriodResponse, error)
+}
+
+type groupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupServiceClient(cc grpc.ClientConnInterface) GroupServiceClient {
+	return &groupServiceClient{cc}
+}
+
+func (c *groupServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, GroupService_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroups(ctx context.Context, in *GetGroupsRequest, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroupById(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupAbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupAbsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroupsByCourseId(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupsByCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsByCourseResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupsByCourseId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroupsByStudentId(ctx context.Context, in *StudentIdRequest, opts ...grpc.CallOption) (*GetGroupsByStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsByStudentResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupsByStudentId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateGroup(ctx context.Context, in *GetUpdateGroupAbs, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) DeleteGroup(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, GroupService_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroupsByTeacherId(ctx context.Context, in *GetGroupsByTeacherIdRequest, opts ...grpc.CallOption) (*GetGroupsByTeacherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsByTeacherResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupsByTeacherId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetCommonInformationEducation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationEducationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommonInformationEducationResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetCommonInformationEducation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetLeftAfterTrialPeriod(ctx context.Context, in *GetLeftAfterTrialPeriodRequest, opts ...grpc.CallOption) (*GetLeftAfterTrialPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeftAfterTrialPeriodResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetLeftAfterTrialPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupServiceServer is the server API for GroupService service.
+// All implementations must embed UnimplementedGroupServiceServer
+// for forward compatibility.
+//
+// group service start
+type GroupServiceServer interface {
+	CreateGroup(context.Context, *CreateGroupRequest) (*AbsResponse, error)
+	GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error)
+	GetGroupById(context.Context, *GetGroupByIdRequest) (*GetGroupAbsResponse, error)
+	GetGroupsByCourseId(context.Context, *GetGroupByIdRequest) (*GetGroupsByCourseResponse, error)
+	GetGroupsByStudentId(context.Context, *StudentIdRequest) (*GetGroupsByStudentResponse, error)
+	UpdateGroup(context.Context, *GetUpdateGroupAbs) (*AbsResponse, error)
+	DeleteGroup(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	GetGroupsByTeacherId(context.Context, *GetGroupsByTeacherIdRequest) (*GetGroupsByTeacherResponse, error)
+	GetCommonInformationEducation(context.Context, *emptypb.Empty) (*GetCommonInformationEducationResponse, error)
+	GetLeftAfterTrialPeriod(context.Context, *GetLeftAfterTrialPeriodRequest) (*GetLeftAfterTrialPeriodResponse, error)
+	mustEmbedUnimplementedGroupServiceServer()
+}
+
+// UnimplementedGroupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupServiceServer struct{}
+
+func (UnimplementedGroupServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroups(context.Context, *GetGroupsRequest) (*GetGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroups not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroupById(context.Context, *GetGroupByIdRequest) (*GetGroupAbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupById not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroupsByCourseId(context.Context, *GetGroupByIdRequest) (*GetGroupsByCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByCourseId not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroupsByStudentId(context.Context, *StudentIdRequest) (*GetGroupsByStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByStudentId not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateGroup(context.Context, *GetUpdateGroupAbs) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteGroup(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroupsByTeacherId(context.Context, *GetGroupsByTeacherIdRequest) (*GetGroupsByTeacherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByTeacherId not implemented")
+}
+func (UnimplementedGroupServiceServer) GetCommonInformationEducation(context.Context, *emptypb.Empty) (*GetCommonInformationEducationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommonInformationEducation not implemented")
+}
+func (UnimplementedGroupServiceServer) GetLeftAfterTrialPeriod(context.Context, *GetLeftAfterTrialPeriodRequest) (*GetLeftAfterTrialPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeftAfterTrialPeriod not implemented")
+}
+func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
+func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeGroupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupServiceServer will
+// result in compilation errors.
+type UnsafeGroupServiceServer interface {
+	mustEmbedUnimplementedGroupServiceServer()
+}
+
+func RegisterGroupServiceServer(s grpc.ServiceRegistrar, srv GroupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGroupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroupService_ServiceDesc, srv)
+}
+
+func _GroupService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroups(ctx, req.(*GetGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroupById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupById(ctx, req.(*GetGroupByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroupsByCourseId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupsByCourseId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupsByCourseId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupsByCourseId(ctx, req.(*GetGroupByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroupsByStudentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupsByStudentId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupsByStudentId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupsByStudentId(ctx, req.(*StudentIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpdateGroupAbs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateGroup(ctx, req.(*GetUpdateGroupAbs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteGroup(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroupsByTeacherId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsByTeacherIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupsByTeacherId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupsByTeacherId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupsByTeacherId(ctx, req.(*GetGroupsByTeacherIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetCommonInformationEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetCommonInformationEducation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetCommonInformationEducation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetCommonInformationEducation(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetLeftAfterTrialPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeftAfterTrialPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetLeftAfterTrialPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetLeftAfterTrialPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetLeftAfterTrialPeriod(ctx, req.(*GetLeftAfterTrialPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.GroupService",
+	HandlerType: (*GroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _GroupService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "GetGroups",
+			Handler:    _GroupService_GetGroups_Handler,
+		},
+		{
+			MethodName: "GetGroupById",
+			Handler:    _GroupService_GetGroupById_Handler,
+		},
+		{
+			MethodName: "GetGroupsByCourseId",
+			Handler:    _GroupService_GetGroupsByCourseId_Handler,
+		},
+		{
+			MethodName: "GetGroupsByStudentId",
+			Handler:    _GroupService_GetGroupsByStudentId_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _GroupService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _GroupService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "GetGroupsByTeacherId",
+			Handler:    _GroupService_GetGroupsByTeacherId_Handler,
+		},
+		{
+			MethodName: "GetCommonInformationEducation",
+			Handler:    _GroupService_GetCommonInformationEducation_Handler,
+		},
+		{
+			MethodName: "GetLeftAfterTrialPeriod",
+			Handler:    _GroupService_GetLeftAfterTrialPeriod_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	AttendanceService_GetAttendance_FullMethodName                      = "/education.AttendanceService/GetAttendance"
+	AttendanceService_SetAttendance_FullMethodName                      = "/education.AttendanceService/SetAttendance"
+	AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName = "/education.AttendanceService/CalculateTeacherSalaryByAttendance"
+)
+
+// AttendanceServiceClient is the client API for AttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// attendance service start
+type AttendanceServiceClient interface {
+	GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*GetAttendanceResponse, error)
+	SetAttendance(ctx context.Context, in *SetAttendanceRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error)
+}
+
+type attendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
+	return &attendanceServiceClient{cc}
+}
+
+func (c *attendanceServiceClient) GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*GetAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_GetAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) SetAttendance(ctx context.Context, in *SetAttendanceRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_SetAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateTeacherSalaryResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceServiceServer is the server API for AttendanceService service.
+// All implementations must embed UnimplementedAttendanceServiceServer
+// for forward compatibility.
+//
+// attendance service start
+type AttendanceServiceServer interface {
+	GetAttendance(context.Context, *GetAttendanceRequest) (*GetAttendanceResponse, error)
+	SetAttendance(context.Context, *SetAttendanceRequest) (*AbsResponse, error)
+	CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error)
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+// UnimplementedAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceServiceServer struct{}
+
+func (UnimplementedAttendanceServiceServer) GetAttendance(context.Context, *GetAttendanceRequest) (*GetAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) SetAttendance(context.Context, *SetAttendanceRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateTeacherSalaryByAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
+func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceServiceServer interface {
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+}
+
+func _AttendanceService_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, req.(*GetAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_SetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).SetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_SetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).SetAttendance(ctx, req.(*SetAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_CalculateTeacherSalaryByAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateTeacherSalaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, req.(*CalculateTeacherSalaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.AttendanceService",
+	HandlerType: (*AttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttendance",
+			Handler:    _AttendanceService_GetAttendance_Handler,
+		},
+		{
+			MethodName: "SetAttendance",
+			Handler:    _AttendanceService_SetAttendance_Handler,
+		},
+		{
+			MethodName: "CalculateTeacherSalaryByAttendance",
+			Handler:    _AttendanceService_CalculateTeacherSalaryByAttendance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	StudentService_GetAllStudent_FullMethodName                   = "/education.StudentService/GetAllStudent"
+	StudentService_CreateStudent_FullMethodName                   = "/education.StudentService/CreateStudent"
+	StudentService_UpdateStudent_FullMethodName                   = "/education.StudentService/UpdateStudent"
+	StudentService_DeleteStudent_FullMethodName                   = "/education.StudentService/DeleteStudent"
+	StudentService_AddToGroup_FullMethodName                      = "/education.StudentService/AddToGroup"
+	StudentService_GetStudentById_FullMethodName                  = "/education.StudentService/GetStudentById"
+	StudentService_GetNoteByStudent_FullMethodName                = "/education.StudentService/GetNoteByStudent"
+	StudentService_CreateNoteForStudent_FullMethodName            = "/education.StudentService/CreateNoteForStudent"
+	StudentService_DeleteStudentNote_FullMethodName               = "/education.StudentService/DeleteStudentNote"
+	StudentService_SearchStudent_FullMethodName                   = "/education.StudentService/SearchStudent"
+	StudentService_GetHistoryGroupById_FullMethodName             = "/education.StudentService/GetHistoryGroupById"
+	StudentService_GetHistoryStudentById_FullMethodName           = "/education.StudentService/GetHistoryStudentById"
+	StudentService_TransferLessonDate_FullMethodName              = "/education.StudentService/TransferLessonDate"
+	StudentService_ChangeConditionStudent_FullMethodName          = "/education.StudentService/ChangeConditionStudent"
+	StudentService_GetStudentsByGroupId_FullMethodName            = "/education.StudentService/GetStudentsByGroupId"
+	StudentService_ChangeUserBalanceHistory_FullMethodName        = "/education.StudentService/ChangeUserBalanceHistory"
+	StudentService_ChangeUserBalanceHistoryByDebit_FullMethodName = "/education.StudentService/ChangeUserBalanceHistoryByDebit"
+	StudentService_CalculateDiscountSumma_FullMethodName          = "/education.StudentService/CalculateDiscountSumma"
+	StudentService_FindStudentsByPhone_FullMethodName             = "/education.StudentService/FindStudentsByPhone"
+	StudentService_MergeStudents_FullMethodName                   = "/education.StudentService/MergeStudents"
+	StudentService_DiscardStudents_FullMethodName                 = "/education.StudentService/DiscardStudents"
+)
+
+// StudentServiceClient is the client API for StudentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// student service start
+type StudentServiceClient interface {
+	GetAllStudent(ctx context.Context, in *GetAllStudentRequest, opts ...grpc.CallOption) (*GetAllStudentResponse, error)
+	CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetStudentByIdResponse, error)
+	GetNoteByStudent(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetNotesByStudent, error)
+	CreateNoteForStudent(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteStudentNote(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	SearchStudent(ctx context.Context, in *SearchStudentRequest, opts ...grpc.CallOption) (*SearchStudentResponse, error)
+	GetHistoryGroupById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetHistoryGroupResponse, error)
+	GetHistoryStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetHistoryStudentResponse, error)
+	TransferLessonDate(ctx context.Context, in *TransferLessonRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	ChangeConditionStudent(ctx context.Context, in *ChangeConditionStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetStudentsByGroupId(ctx context.Context, in *GetStudentsByGroupIdRequest, opts ...grpc.CallOption) (*GetStudentsByGroupIdResponse, error)
+	ChangeUserBalanceHistory(ctx context.Context, in *ChangeUserBalanceHistoryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	ChangeUserBalanceHistoryByDebit(ctx context.Context, in *ChangeUserBalanceHistoryByDebitRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	CalculateDiscountSumma(ctx context.Context, in *CalculateDiscountSummaRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error)
+	FindStudentsByPhone(ctx context.Context, in *FindStudentsByPhoneRequest, opts ...grpc.CallOption) (*SearchStudentResponse, error)
+	MergeStudents(ctx context.Context, in *MergeStudentsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	DiscardStudents(ctx context.Context, in *DiscardStudentsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type studentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStudentServiceClient(cc grpc.ClientConnInterface) StudentServiceClient {
+	return &studentServiceClient{cc}
+}
+
+func (c *studentServiceClient) GetAllStudent(ctx context.Context, in *GetAllStudentRequest, opts ...grpc.CallOption) (*GetAllStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllStudentResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetAllStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_CreateStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_UpdateStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_DeleteStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_AddToGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetStudentByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentByIdResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetStudentById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetNoteByStudent(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetNotesByStudent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotesByStudent)
+	err := c.cc.Invoke(ctx, StudentService_GetNoteByStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) CreateNoteForStudent(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_CreateNoteForStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) DeleteStudentNote(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_DeleteStudentNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) SearchStudent(ctx context.Context, in *SearchStudentRequest, opts ...grpc.CallOption) (*SearchStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchStudentResponse)
+	err := c.cc.Invoke(ctx, StudentService_SearchStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetHistoryGroupById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetHistoryGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryGroupResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetHistoryGroupById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetHistoryStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetHistoryStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryStudentResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetHistoryStudentById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) TransferLessonDate(ctx context.Context, in *TransferLessonRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_TransferLessonDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) ChangeConditionStudent(ctx context.Context, in *ChangeConditionStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_ChangeConditionStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetStudentsByGroupId(ctx context.Context, in *GetStudentsByGroupIdRequest, opts ...grpc.CallOption) (*GetStudentsByGroupIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentsByGroupIdResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetStudentsByGroupId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) ChangeUserBalanceHistory(ctx context.Context, in *ChangeUserBalanceHistoryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_ChangeUserBalanceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) ChangeUserBalanceHistoryByDebit(ctx context.Context, in *ChangeUserBalanceHistoryByDebitRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_ChangeUserBalanceHistoryByDebit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) CalculateDiscountSumma(ctx context.Context, in *CalculateDiscountSummaRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateDiscountResponse)
+	err := c.cc.Invoke(ctx, StudentService_CalculateDiscountSumma_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) FindStudentsByPhone(ctx context.Context, in *FindStudentsByPhoneRequest, opts ...grpc.CallOption) (*SearchStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchStudentResponse)
+	err := c.cc.Invoke(ctx, StudentService_FindStudentsByPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) MergeStudents(ctx context.Context, in *MergeStudentsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_MergeStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) DiscardStudents(ctx context.Context, in *DiscardStudentsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_DiscardStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StudentServiceServer is the server API for StudentService service.
+// All implementations must embed UnimplementedStudentServiceServer
+// for forward compatibility.
+//
+// student service start
+type StudentServiceServer interface {
+	GetAllStudent(context.Context, *GetAllStudentRequest) (*GetAllStudentResponse, error)
+	CreateStudent(context.Context, *CreateStudentRequest) (*AbsResponse, error)
+	UpdateStudent(context.Context, *UpdateStudentRequest) (*AbsResponse, error)
+	DeleteStudent(context.Context, *DeleteStudentRequest) (*AbsResponse, error)
+	AddToGroup(context.Context, *AddToGroupRequest) (*AbsResponse, error)
+	GetStudentById(context.Context, *NoteStudentByAbsRequest) (*GetStudentByIdResponse, error)
+	GetNoteByStudent(context.Context, *NoteStudentByAbsRequest) (*GetNotesByStudent, error)
+	CreateNoteForStudent(context.Context, *CreateNoteRequest) (*AbsResponse, error)
+	DeleteStudentNote(context.Context, *NoteStudentByAbsRequest) (*AbsResponse, error)
+	SearchStudent(context.Context, *SearchStudentRequest) (*SearchStudentResponse, error)
+	GetHistoryGroupById(context.Context, *NoteStudentByAbsRequest) (*GetHistoryGroupResponse, error)
+	GetHistoryStudentById(context.Context, *NoteStudentByAbsRequest) (*GetHistoryStudentResponse, error)
+	TransferLessonDate(context.Context, *TransferLessonRequest) (*AbsResponse, error)
+	ChangeConditionStudent(context.Context, *ChangeConditionStudentRequest) (*AbsResponse, error)
+	GetStudentsByGroupId(context.Context, *GetStudentsByGroupIdRequest) (*GetStudentsByGroupIdResponse, error)
+	ChangeUserBalanceHistory(context.Context, *ChangeUserBalanceHistoryRequest) (*AbsResponse, error)
+	ChangeUserBalanceHistoryByDebit(context.Context, *ChangeUserBalanceHistoryByDebitRequest) (*AbsResponse, error)
+	CalculateDiscountSumma(context.Context, *CalculateDiscountSummaRequest) (*CalculateDiscountResponse, error)
+	FindStudentsByPhone(context.Context, *FindStudentsByPhoneRequest) (*SearchStudentResponse, error)
+	MergeStudents(context.Context, *MergeStudentsRequest) (*AbsResponse, error)
+	DiscardStudents(context.Context, *DiscardStudentsRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedStudentServiceServer()
+}
+
+// UnimplementedStudentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStudentServiceServer struct{}
+
+func (UnimplementedStudentServiceServer) GetAllStudent(context.Context, *GetAllStudentRequest) (*GetAllStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllStudent not implemented")
+}
+func (UnimplementedStudentServiceServer) CreateStudent(context.Context, *CreateStudentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStudent not implemented")
+}
+func (UnimplementedStudentServiceServer) UpdateStudent(context.Context, *UpdateStudentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStudent not implemented")
+}
+func (UnimplementedStudentServiceServer) DeleteStudent(context.Context, *DeleteStudentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudent not implemented")
+}
+func (UnimplementedStudentServiceServer) AddToGroup(context.Context, *AddToGroupRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToGroup not implemented")
+}
+func (UnimplementedStudentServiceServer) GetStudentById(context.Context, *NoteStudentByAbsRequest) (*GetStudentByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentById not implemented")
+}
+func (UnimplementedStudentServiceServer) GetNoteByStudent(context.Context, *NoteStudentByAbsRequest) (*GetNotesByStudent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteByStudent not implemented")
+}
+func (UnimplementedStudentServiceServer) CreateNoteForStudent(context.Context, *CreateNoteRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNoteForStudent not implemented")
+}
+func (UnimplementedStudentServiceServer) DeleteStudentNote(context.Context, *NoteStudentByAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudentNote not implemented")
+}
+func (UnimplementedStudentServiceServer) SearchStudent(context.Context, *SearchStudentRequest) (*SearchStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStudent not implemented")
+}
+func (UnimplementedStudentServiceServer) GetHistoryGroupById(context.Context, *NoteStudentByAbsRequest) (*GetHistoryGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryGroupById not implemented")
+}
+func (UnimplementedStudentServiceServer) GetHistoryStudentById(context.Context, *NoteStudentByAbsRequest) (*GetHistoryStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryStudentById not implemented")
+}
+func (UnimplementedStudentServiceServer) TransferLessonDate(context.Context, *TransferLessonRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLessonDate not implemented")
+}
+func (UnimplementedStudentServiceServer) ChangeConditionStudent(context.Context, *ChangeConditionStudentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeConditionStudent not implemented")
+}
+func (UnimplementedStudentServiceServer) GetStudentsByGroupId(context.Context, *GetStudentsByGroupIdRequest) (*GetStudentsByGroupIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByGroupId not implemented")
+}
+func (UnimplementedStudentServiceServer) ChangeUserBalanceHistory(context.Context, *ChangeUserBalanceHistoryRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserBalanceHistory not implemented")
+}
+func (UnimplementedStudentServiceServer) ChangeUserBalanceHistoryByDebit(context.Context, *ChangeUserBalanceHistoryByDebitRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserBalanceHistoryByDebit not implemented")
+}
+func (UnimplementedStudentServiceServer) CalculateDiscountSumma(context.Context, *CalculateDiscountSummaRequest) (*CalculateDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateDiscountSumma not implemented")
+}
+func (UnimplementedStudentServiceServer) FindStudentsByPhone(context.Context, *FindStudentsByPhoneRequest) (*SearchStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindStudentsByPhone not implemented")
+}
+func (UnimplementedStudentServiceServer) MergeStudents(context.Context, *MergeStudentsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeStudents not implemented")
+}
+func (UnimplementedStudentServiceServer) DiscardStudents(context.Context, *DiscardStudentsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardStudents not implemented")
+}
+func (UnimplementedStudentServiceServer) mustEmbedUnimplementedStudentServiceServer() {}
+func (UnimplementedStudentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeStudentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StudentServiceServer will
+// result in compilation errors.
+type UnsafeStudentServiceServer interface {
+	mustEmbedUnimplementedStudentServiceServer()
+}
+
+func RegisterStudentServiceServer(s grpc.ServiceRegistrar, srv StudentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStudentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StudentService_ServiceDesc, srv)
+}
+
+func _StudentService_GetAllStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetAllStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetAllStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetAllStudent(ctx, req.(*GetAllStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_CreateStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).CreateStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_CreateStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).CreateStudent(ctx, req.(*CreateStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_UpdateStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).UpdateStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_UpdateStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).UpdateStudent(ctx, req.(*UpdateStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_DeleteStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).DeleteStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_DeleteStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).DeleteStudent(ctx, req.(*DeleteStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_AddToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).AddToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_AddToGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).AddToGroup(ctx, req.(*AddToGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetStudentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteStudentByAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetStudentById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetStudentById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetStudentById(ctx, req.(*NoteStudentByAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetNoteByStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteStudentByAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetGroupsByStudentId(ctx, in)
+		return srv.(StudentServiceServer).GetNoteByStudent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetGroupsByStudentId_FullMethodName,
+		FullMethod: StudentService_GetNoteByStudent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetGroupsByStudentId(ctx, req.(*StudentIdRequest))
+		return srv.(StudentServiceServer).GetNoteByStudent(ctx, req.(*NoteStudentByAbsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUpdateGroupAbs)
+func _StudentService_CreateNoteForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).UpdateGroup(ctx, in)
+		return srv.(StudentServiceServer).CreateNoteForStudent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_UpdateGroup_FullMethodName,
+		FullMethod: StudentService_CreateNoteForStudent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).UpdateGroup(ctx, req.(*GetUpdateGroupAbs))
+		return srv.(StudentServiceServer).CreateNoteForStudent(ctx, req.(*CreateNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAbsRequest)
+func _StudentService_DeleteStudentNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteStudentByAbsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).DeleteGroup(ctx, in)
+		return srv.(StudentServiceServer).DeleteStudentNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_DeleteGroup_FullMethodName,
+		FullMethod: StudentService_DeleteStudentNote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).DeleteGroup(ctx, req.(*DeleteAbsRequest))
+		return srv.(StudentServiceServer).DeleteStudentNote(ctx, req.(*NoteStudentByAbsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetGroupsByTeacherId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupsByTeacherIdRequest)
+func _StudentService_SearchStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStudentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetGroupsByTeacherId(ctx, in)
+		return srv.(StudentServiceServer).SearchStudent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetGroupsByTeacherId_FullMethodName,
+		FullMethod: StudentService_SearchStudent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetGroupsByTeacherId(ctx, req.(*GetGroupsByTeacherIdRequest))
+		return srv.(StudentServiceServer).SearchStudent(ctx, req.(*SearchStudentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetCommonInformationEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _StudentService_GetHistoryGroupById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteStudentByAbsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetCommonInformationEducation(ctx, in)
+		return srv.(StudentServiceServer).GetHistoryGroupById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetCommonInformationEducation_FullMethodName,
+		FullMethod: StudentService_GetHistoryGroupById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetCommonInformationEducation(ctx, req.(*emptypb.Empty))
+		return srv.(StudentServiceServer).GetHistoryGroupById(ctx, req.(*NoteStudentByAbsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetLeftAfterTrialPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeftAfterTrialPeriodRequest)
+func _StudentService_GetHistoryStudentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteStudentByAbsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetLeftAfterTrialPeriod(ctx, in)
+		return srv.(StudentServiceServer).GetHistoryStudentById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetLeftAfterTrialPeriod_FullMethodName,
+		FullMethod: StudentService_GetHistoryStudentById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetLeftAfterTrialPeriod(ctx, req.(*GetLeftAfterTrialPeriodRequest))
+		return srv.(StudentServiceServer).GetHistoryStudentById(ctx, req.(*NoteStudentByAbsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var GroupService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "education.GroupService",
-	HandlerType: (*GroupServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateGroup",
-			Handler:    _GroupService_CreateGroup_Handler,
-		},
-		{
-			MethodName: "GetGroups",
-			Handler:    _GroupService_GetGroups_Handler,
-		},
-		{
-			MethodName: "GetGroupById",
-			Handler:    _GroupService_GetGroupById_Handler,
-		},
-		{
-			MethodName: "GetGroupsByCourseId",
-			Handler:    _GroupService_GetGroupsByCourseId_Handler,
-		},
-		{
-			MethodName: "GetGroupsByStudentId",
-			Handler:    _GroupService_GetGroupsByStudentId_Handler,
-		},
-		{
-			MethodName: "UpdateGroup",
-			Handler:    _GroupService_UpdateGroup_Handler,
-		},
-		{
-			MethodName: "DeleteGroup",
-			Handler:    _GroupService_DeleteGroup_Handler,
-		},
-		{
-			MethodName: "GetGroupsByTeacherId",
-			Handler:    _GroupService_GetGroupsByTeacherId_Handler,
-		},
-		{
-			MethodName: "GetCommonInformationEducation",
-			Handler:    _GroupService_GetCommonInformationEducation_Handler,
-		},
-		{
-			MethodName: "GetLeftAfterTrialPeriod",
-			Handler:    _GroupService_GetLeftAfterTrialPeriod_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "education.proto",
-}
-
-const (
-	AttendanceService_GetAttendance_FullMethodName                      = "/education.AttendanceService/GetAttendance"
-	AttendanceService_SetAttendance_FullMethodName                      = "/education.AttendanceService/SetAttendance"
-	AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName = "/education.AttendanceService/CalculateTeacherSalaryByAttendance"
-)
-
-// AttendanceServiceClient is the client API for AttendanceService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// attendance service start
-type AttendanceServiceClient interface {
-	GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*GetAttendanceResponse, error)
-	SetAttendance(ctx context.Context, in *SetAttendanceRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error)
-}
-
-type attendanceServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
-	return &attendanceServiceClient{cc}
-}
-
-func (c *attendanceServiceClient) GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*GetAttendanceResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAttendanceResponse)
-	err := c.cc.Invoke(ctx, AttendanceService_GetAttendance_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _StudentService_TransferLessonDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLessonRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StudentServiceServer).TransferLessonDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_TransferLessonDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).TransferLessonDate(ctx, req.(*TransferLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *attendanceServiceClient) SetAttendance(ctx context.Context, in *SetAttendanceRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, AttendanceService_SetAttendance_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _StudentService_ChangeConditionStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeConditionStudentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StudentServiceServer).ChangeConditionStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_ChangeConditionStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).ChangeConditionStudent(ctx, req.(*ChangeConditionStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *attendanceServiceClient) CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CalculateTeacherSalaryResponse)
-	err := c.cc.Invoke(ctx, AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _StudentService_GetStudentsByGroupId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentsByGroupIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// AttendanceServiceServer is the server API for AttendanceService service.
-// All implementations must embed UnimplementedAttendanceServiceServer
-// for forward compatibility.
-//
-// attendance service start
-type AttendanceServiceServer interface {
-	GetAttendance(context.Context, *GetAttendanceRequest) (*GetAttendanceResponse, error)
-	SetAttendance(context.Context, *SetAttendanceRequest) (*AbsResponse, error)
-	CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error)
-	mustEmbedUnimplementedAttendanceServiceServer()
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetStudentsByGroupId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetStudentsByGroupId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetStudentsByGroupId(ctx, req.(*GetStudentsByGroupIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAttendanceServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedAttendanceServiceServer struct{}
-
-func (UnimplementedAttendanceServiceServer) GetAttendance(context.Context, *GetAttendanceRequest) (*GetAttendanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
-}
-func (UnimplementedAttendanceServiceServer) SetAttendance(context.Context, *SetAttendanceRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAttendance not implemented")
-}
-func (UnimplementedAttendanceServiceServer) CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateTeacherSalaryByAttendance not implemented")
+func _StudentService_ChangeUserBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).ChangeUserBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_ChangeUserBalanceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).ChangeUserBalanceHistory(ctx, req.(*ChangeUserBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
-func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
 
-// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
-// result in compilation errors.
-type UnsafeAttendanceServiceServer interface {
-	mustEmbedUnimplementedAttendanceServiceServer()
+func _StudentService_ChangeUserBalanceHistoryByDebit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserBalanceHistoryByDebitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).ChangeUserBalanceHistoryByDebit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_ChangeUserBalanceHistoryByDebit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).ChangeUserBalanceHistoryByDebit(ctx, req.(*ChangeUserBalanceHistoryByDebitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
-	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _StudentService_CalculateDiscountSumma_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateDiscountSummaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(StudentServiceServer).CalculateDiscountSumma(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_CalculateDiscountSumma_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).CalculateDiscountSumma(ctx, req.(*CalculateDiscountSummaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AttendanceService_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAttendanceRequest)
+func _StudentService_FindStudentsByPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindStudentsByPhoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttendanceServiceServer).GetAttendance(ctx, in)
+		return srv.(StudentServiceServer).FindStudentsByPhone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AttendanceService_GetAttendance_FullMethodName,
+		FullMethod: StudentService_FindStudentsByPhone_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttendanceServiceServer).GetAttendance(ctx, req.(*GetAttendanceRequest))
+		return srv.(StudentServiceServer).FindStudentsByPhone(ctx, req.(*FindStudentsByPhoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AttendanceService_SetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAttendanceRequest)
+func _StudentService_MergeStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeStudentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttendanceServiceServer).SetAttendance(ctx, in)
+		return srv.(StudentServiceServer).MergeStudents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AttendanceService_SetAttendance_FullMethodName,
+		FullMethod: StudentService_MergeStudents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttendanceServiceServer).SetAttendance(ctx, req.(*SetAttendanceRequest))
+		return srv.(StudentServiceServer).MergeStudents(ctx, req.(*MergeStudentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AttendanceService_CalculateTeacherSalaryByAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateTeacherSalaryRequest)
+func _StudentService_DiscardStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardStudentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, in)
+		return srv.(StudentServiceServer).DiscardStudents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName,
+		FullMethod: StudentService_DiscardStudents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, req.(*CalculateTeacherSalaryRequest))
+		return srv.(StudentServiceServer).DiscardStudents(ctx, req.(*DiscardStudentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// StudentService_ServiceDesc is the grpc.ServiceDesc for StudentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var AttendanceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "education.AttendanceService",
-	HandlerType: (*AttendanceServiceServer)(nil),
+var StudentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.StudentService",
+	HandlerType: (*StudentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetAttendance",
-			Handler:    _AttendanceService_GetAttendance_Handler,
+			MethodName: "GetAllStudent",
+			Handler:    _StudentService_GetAllStudent_Handler,
 		},
 		{
-			MethodName: "SetAttendance",
-			Handler:    _AttendanceService_SetAttendance_Handler,
+			MethodName: "CreateStudent",
+			Handler:    _StudentService_CreateStudent_Handler,
 		},
 		{
-			MethodName: "CalculateTeacherSalaryByAttendance",
-			Handler:    _AttendanceService_CalculateTeacherSalaryByAttendance_Handler,
+			MethodName: "UpdateStudent",
+			Handler:    _StudentService_UpdateStudent_Handler,
+		},
+		{
+			MethodName: "DeleteStudent",
+			Handler:    _StudentService_DeleteStudent_Handler,
+		},
+		{
+			MethodName: "AddToGroup",
+			Handler:    _StudentService_AddToGroup_Handler,
+		},
+		{
+			MethodName: "GetStudentById",
+			Handler:    _StudentService_GetStudentById_Handler,
+		},
+		{
+			MethodName: "GetNoteByStudent",
+			Handler:    _StudentService_GetNoteByStudent_Handler,
+		},
+		{
+			MethodName: "CreateNoteForStudent",
+			Handler:    _StudentService_CreateNoteForStudent_Handler,
+		},
+		{
+			MethodName: "DeleteStudentNote",
+			Handler:    _StudentService_DeleteStudentNote_Handler,
+		},
+		{
+			MethodName: "SearchStudent",
+			Handler:    _StudentService_SearchStudent_Handler,
+		},
+		{
+			MethodName: "GetHistoryGroupById",
+			Handler:    _StudentService_GetHistoryGroupById_Handler,
+		},
+		{
+			MethodName: "GetHistoryStudentById",
+			Handler:    _StudentService_GetHistoryStudentById_Handler,
+		},
+		{
+			MethodName: "TransferLessonDate",
+			Handler:    _StudentService_TransferLessonDate_Handler,
+		},
+		{
+			MethodName: "ChangeConditionStudent",
+			Handler:    _StudentService_ChangeConditionStudent_Handler,
+		},
+		{
+			MethodName: "GetStudentsByGroupId",
+			Handler:    _StudentService_GetStudentsByGroupId_Handler,
+		},
+		{
+			MethodName: "ChangeUserBalanceHistory",
+			Handler:    _StudentService_ChangeUserBalanceHistory_Handler,
+		},
+		{
+			MethodName: "ChangeUserBalanceHistoryByDebit",
+			Handler:    _StudentService_ChangeUserBalanceHistoryByDebit_Handler,
+		},
+		{
+			MethodName: "CalculateDiscountSumma",
+			Handler:    _StudentService_CalculateDiscountSumma_Handler,
+		},
+		{
+			MethodName: "FindStudentsByPhone",
+			Handler:    _StudentService_FindStudentsByPhone_Handler,
+		},
+		{
+			MethodName: "MergeStudents",
+			Handler:    _StudentService_MergeStudents_Handler,
+		},
+		{
+			MethodName: "DiscardStudents",
+			Handler:    _StudentService_DiscardStudents_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1852,751 +3310,805 @@ var AttendanceService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	StudentService_GetAllStudent_FullMethodName                   = "/education.StudentService/GetAllStudent"
-	StudentService_CreateStudent_FullMethodName                   = "/education.StudentService/CreateStudent"
-	StudentService_UpdateStudent_FullMethodName                   = "/education.StudentService/UpdateStudent"
-	StudentService_DeleteStudent_FullMethodName                   = "/education.StudentService/DeleteStudent"
-	StudentService_AddToGroup_FullMethodName                      = "/education.StudentService/AddToGroup"
-	StudentService_GetStudentById_FullMethodName                  = "/education.StudentService/GetStudentById"
-	StudentService_GetNoteByStudent_FullMethodName                = "/education.StudentService/GetNoteByStudent"
-	StudentService_CreateNoteForStudent_FullMethodName            = "/education.StudentService/CreateNoteForStudent"
-	StudentService_DeleteStudentNote_FullMethodName               = "/education.StudentService/DeleteStudentNote"
-	StudentService_SearchStudent_FullMethodName                   = "/education.StudentService/SearchStudent"
-	StudentService_GetHistoryGroupById_FullMethodName             = "/education.StudentService/GetHistoryGroupById"
-	StudentService_GetHistoryStudentById_FullMethodName           = "/education.StudentService/GetHistoryStudentById"
-	StudentService_TransferLessonDate_FullMethodName              = "/education.StudentService/TransferLessonDate"
-	StudentService_ChangeConditionStudent_FullMethodName          = "/education.StudentService/ChangeConditionStudent"
-	StudentService_GetStudentsByGroupId_FullMethodName            = "/education.StudentService/GetStudentsByGroupId"
-	StudentService_ChangeUserBalanceHistory_FullMethodName        = "/education.StudentService/ChangeUserBalanceHistory"
-	StudentService_ChangeUserBalanceHistoryByDebit_FullMethodName = "/education.StudentService/ChangeUserBalanceHistoryByDebit"
-	StudentService_CalculateDiscountSumma_FullMethodName          = "/education.StudentService/CalculateDiscountSumma"
+	NotificationService_GetNotificationSettings_FullMethodName    = "/education.NotificationService/GetNotificationSettings"
+	NotificationService_UpdateNotificationSettings_FullMethodName = "/education.NotificationService/UpdateNotificationSettings"
+	NotificationService_GetNotificationOutbox_FullMethodName      = "/education.NotificationService/GetNotificationOutbox"
 )
 
-// StudentServiceClient is the client API for StudentService service.
+// NotificationServiceClient is the client API for NotificationService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// student service start
-type StudentServiceClient interface {
-	GetAllStudent(ctx context.Context, in *GetAllStudentRequest, opts ...grpc.CallOption) (*GetAllStudentResponse, error)
-	CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetStudentByIdResponse, error)
-	GetNoteByStudent(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetNotesByStudent, error)
-	CreateNoteForStudent(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	DeleteStudentNote(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	SearchStudent(ctx context.Context, in *SearchStudentRequest, opts ...grpc.CallOption) (*SearchStudentResponse, error)
-	GetHistoryGroupById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetHistoryGroupResponse, error)
-	GetHistoryStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetHistoryStudentResponse, error)
-	TransferLessonDate(ctx context.Context, in *TransferLessonRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	ChangeConditionStudent(ctx context.Context, in *ChangeConditionStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetStudentsByGroupId(ctx context.Context, in *GetStudentsByGroupIdRequest, opts ...grpc.CallOption) (*GetStudentsByGroupIdResponse, error)
-	ChangeUserBalanceHistory(ctx context.Context, in *ChangeUserBalanceHistoryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	ChangeUserBalanceHistoryByDebit(ctx context.Context, in *ChangeUserBalanceHistoryByDebitRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	CalculateDiscountSumma(ctx context.Context, in *CalculateDiscountSummaRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error)
+// notification service start
+type NotificationServiceClient interface {
+	GetNotificationSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NotificationSettings, error)
+	UpdateNotificationSettings(ctx context.Context, in *NotificationSettings, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetNotificationOutbox(ctx context.Context, in *GetNotificationOutboxRequest, opts ...grpc.CallOption) (*GetNotificationOutboxResponse, error)
 }
 
-type studentServiceClient struct {
+type notificationServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewStudentServiceClient(cc grpc.ClientConnInterface) StudentServiceClient {
-	return &studentServiceClient{cc}
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
 }
 
-func (c *studentServiceClient) GetAllStudent(ctx context.Context, in *GetAllStudentRequest, opts ...grpc.CallOption) (*GetAllStudentResponse, error) {
+func (c *notificationServiceClient) GetNotificationSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NotificationSettings, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllStudentResponse)
-	err := c.cc.Invoke(ctx, StudentService_GetAllStudent_FullMethodName, in, out, cOpts...)
+	out := new(NotificationSettings)
+	err := c.cc.Invoke(ctx, NotificationService_GetNotificationSettings_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *studentServiceClient) CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *notificationServiceClient) UpdateNotificationSettings(ctx context.Context, in *NotificationSettings, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_CreateStudent_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateNotificationSettings_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *studentServiceClient) UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *notificationServiceClient) GetNotificationOutbox(ctx context.Context, in *GetNotificationOutboxRequest, opts ...grpc.CallOption) (*GetNotificationOutboxResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_UpdateStudent_FullMethodName, in, out, cOpts...)
+	out := new(GetNotificationOutboxResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetNotificationOutbox_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *studentServiceClient) DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_DeleteStudent_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// notification service start
+type NotificationServiceServer interface {
+	GetNotificationSettings(context.Context, *emptypb.Empty) (*NotificationSettings, error)
+	UpdateNotificationSettings(context.Context, *NotificationSettings) (*AbsResponse, error)
+	GetNotificationOutbox(context.Context, *GetNotificationOutboxRequest) (*GetNotificationOutboxResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
 }
 
-func (c *studentServiceClient) AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_AddToGroup_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) GetNotificationSettings(context.Context, *emptypb.Empty) (*NotificationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSettings not implemented")
 }
+func (UnimplementedNotificationServiceServer) UpdateNotificationSettings(context.Context, *NotificationSettings) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationSettings not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetNotificationOutbox(context.Context, *GetNotificationOutboxRequest) (*GetNotificationOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationOutbox not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
 
-func (c *studentServiceClient) GetStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetStudentByIdResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetStudentByIdResponse)
-	err := c.cc.Invoke(ctx, StudentService_GetStudentById_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
 }
 
-func (c *studentServiceClient) GetNoteByStudent(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetNotesByStudent, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetNotesByStudent)
-	err := c.cc.Invoke(ctx, StudentService_GetNoteByStudent_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return out, nil
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
 }
 
-func (c *studentServiceClient) CreateNoteForStudent(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_CreateNoteForStudent_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _NotificationService_GetNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetNotificationSettings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *studentServiceClient) DeleteStudentNote(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_DeleteStudentNote_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _NotificationService_UpdateNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationSettings)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateNotificationSettings(ctx, req.(*NotificationSettings))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *studentServiceClient) SearchStudent(ctx context.Context, in *SearchStudentRequest, opts ...grpc.CallOption) (*SearchStudentResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SearchStudentResponse)
-	err := c.cc.Invoke(ctx, StudentService_SearchStudent_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _NotificationService_GetNotificationOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationOutboxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetNotificationOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetNotificationOutbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetNotificationOutbox(ctx, req.(*GetNotificationOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *studentServiceClient) GetHistoryGroupById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetHistoryGroupResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetHistoryGroupResponse)
-	err := c.cc.Invoke(ctx, StudentService_GetHistoryGroupById_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNotificationSettings",
+			Handler:    _NotificationService_GetNotificationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationSettings",
+			Handler:    _NotificationService_UpdateNotificationSettings_Handler,
+		},
+		{
+			MethodName: "GetNotificationOutbox",
+			Handler:    _NotificationService_GetNotificationOutbox_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
 }
 
-func (c *studentServiceClient) GetHistoryStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetHistoryStudentResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetHistoryStudentResponse)
-	err := c.cc.Invoke(ctx, StudentService_GetHistoryStudentById_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+const (
+	DebtReminderService_GetDebtReminderSettings_FullMethodName    = "/education.DebtReminderService/GetDebtReminderSettings"
+	DebtReminderService_UpdateDebtReminderSettings_FullMethodName = "/education.DebtReminderService/UpdateDebtReminderSettings"
+	DebtReminderService_GetDebtReminders_FullMethodName           = "/education.DebtReminderService/GetDebtReminders"
+	DebtReminderService_SetDebtReminderReply_FullMethodName       = "/education.DebtReminderService/SetDebtReminderReply"
+)
+
+// DebtReminderServiceClient is the client API for DebtReminderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// debt reminder service start
+type DebtReminderServiceClient interface {
+	GetDebtReminderSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DebtReminderSettings, error)
+	UpdateDebtReminderSettings(ctx context.Context, in *DebtReminderSettings, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetDebtReminders(ctx context.Context, in *GetDebtRemindersRequest, opts ...grpc.CallOption) (*GetDebtRemindersResponse, error)
+	SetDebtReminderReply(ctx context.Context, in *SetDebtReminderReplyRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
-func (c *studentServiceClient) TransferLessonDate(ctx context.Context, in *TransferLessonRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_TransferLessonDate_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type debtReminderServiceClient struct {
+	cc grpc.ClientConnInterface
 }
 
-func (c *studentServiceClient) ChangeConditionStudent(ctx context.Context, in *ChangeConditionStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_ChangeConditionStudent_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewDebtReminderServiceClient(cc grpc.ClientConnInterface) DebtReminderServiceClient {
+	return &debtReminderServiceClient{cc}
 }
 
-func (c *studentServiceClient) GetStudentsByGroupId(ctx context.Context, in *GetStudentsByGroupIdRequest, opts ...grpc.CallOption) (*GetStudentsByGroupIdResponse, error) {
+func (c *debtReminderServiceClient) GetDebtReminderSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DebtReminderSettings, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetStudentsByGroupIdResponse)
-	err := c.cc.Invoke(ctx, StudentService_GetStudentsByGroupId_FullMethodName, in, out, cOpts...)
+	out := new(DebtReminderSettings)
+	err := c.cc.Invoke(ctx, DebtReminderService_GetDebtReminderSettings_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *studentServiceClient) ChangeUserBalanceHistory(ctx context.Context, in *ChangeUserBalanceHistoryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *debtReminderServiceClient) UpdateDebtReminderSettings(ctx context.Context, in *DebtReminderSettings, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_ChangeUserBalanceHistory_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, DebtReminderService_UpdateDebtReminderSettings_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *studentServiceClient) ChangeUserBalanceHistoryByDebit(ctx context.Context, in *ChangeUserBalanceHistoryByDebitRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *debtReminderServiceClient) GetDebtReminders(ctx context.Context, in *GetDebtRemindersRequest, opts ...grpc.CallOption) (*GetDebtRemindersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, StudentService_ChangeUserBalanceHistoryByDebit_FullMethodName, in, out, cOpts...)
+	out := new(GetDebtRemindersResponse)
+	err := c.cc.Invoke(ctx, DebtReminderService_GetDebtReminders_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *studentServiceClient) CalculateDiscountSumma(ctx context.Context, in *CalculateDiscountSummaRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error) {
+func (c *debtReminderServiceClient) SetDebtReminderReply(ctx context.Context, in *SetDebtReminderReplyRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CalculateDiscountResponse)
-	err := c.cc.Invoke(ctx, StudentService_CalculateDiscountSumma_FullMethodName, in, out, cOpts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, DebtReminderService_SetDebtReminderReply_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// StudentServiceServer is the server API for StudentService service.
-// All implementations must embed UnimplementedStudentServiceServer
+// DebtReminderServiceServer is the server API for DebtReminderService service.
+// All implementations must embed UnimplementedDebtReminderServiceServer
 // for forward compatibility.
 //
-// student service start
-type StudentServiceServer interface {
-	GetAllStudent(context.Context, *GetAllStudentRequest) (*GetAllStudentResponse, error)
-	CreateStudent(context.Context, *CreateStudentRequest) (*AbsResponse, error)
-	UpdateStudent(context.Context, *UpdateStudentRequest) (*AbsResponse, error)
-	DeleteStudent(context.Context, *DeleteStudentRequest) (*AbsResponse, error)
-	AddToGroup(context.Context, *AddToGroupRequest) (*AbsResponse, error)
-	GetStudentById(context.Context, *NoteStudentByAbsRequest) (*GetStudentByIdResponse, error)
-	GetNoteByStudent(context.Context, *NoteStudentByAbsRequest) (*GetNotesByStudent, error)
-	CreateNoteForStudent(context.Context, *CreateNoteRequest) (*AbsResponse, error)
-	DeleteStudentNote(context.Context, *NoteStudentByAbsRequest) (*AbsResponse, error)
-	SearchStudent(context.Context, *SearchStudentRequest) (*SearchStudentResponse, error)
-	GetHistoryGroupById(context.Context, *NoteStudentByAbsRequest) (*GetHistoryGroupResponse, error)
-	GetHistoryStudentById(context.Context, *NoteStudentByAbsRequest) (*GetHistoryStudentResponse, error)
-	TransferLessonDate(context.Context, *TransferLessonRequest) (*AbsResponse, error)
-	ChangeConditionStudent(context.Context, *ChangeConditionStudentRequest) (*AbsResponse, error)
-	GetStudentsByGroupId(context.Context, *GetStudentsByGroupIdRequest) (*GetStudentsByGroupIdResponse, error)
-	ChangeUserBalanceHistory(context.Context, *ChangeUserBalanceHistoryRequest) (*AbsResponse, error)
-	ChangeUserBalanceHistoryByDebit(context.Context, *ChangeUserBalanceHistoryByDebitRequest) (*AbsResponse, error)
-	CalculateDiscountSumma(context.Context, *CalculateDiscountSummaRequest) (*CalculateDiscountResponse, error)
-	mustEmbedUnimplementedStudentServiceServer()
+// debt reminder service start
+type DebtReminderServiceServer interface {
+	GetDebtReminderSettings(context.Context, *emptypb.Empty) (*DebtReminderSettings, error)
+	UpdateDebtReminderSettings(context.Context, *DebtReminderSettings) (*AbsResponse, error)
+	GetDebtReminders(context.Context, *GetDebtRemindersRequest) (*GetDebtRemindersResponse, error)
+	SetDebtReminderReply(context.Context, *SetDebtReminderReplyRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedDebtReminderServiceServer()
 }
 
-// UnimplementedStudentServiceServer must be embedded to have
+// UnimplementedDebtReminderServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedStudentServiceServer struct{}
+type UnimplementedDebtReminderServiceServer struct{}
 
-func (UnimplementedStudentServiceServer) GetAllStudent(context.Context, *GetAllStudentRequest) (*GetAllStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllStudent not implemented")
-}
-func (UnimplementedStudentServiceServer) CreateStudent(context.Context, *CreateStudentRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStudent not implemented")
-}
-func (UnimplementedStudentServiceServer) UpdateStudent(context.Context, *UpdateStudentRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateStudent not implemented")
-}
-func (UnimplementedStudentServiceServer) DeleteStudent(context.Context, *DeleteStudentRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudent not implemented")
-}
-func (UnimplementedStudentServiceServer) AddToGroup(context.Context, *AddToGroupRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToGroup not implemented")
-}
-func (UnimplementedStudentServiceServer) GetStudentById(context.Context, *NoteStudentByAbsRequest) (*GetStudentByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentById not implemented")
-}
-func (UnimplementedStudentServiceServer) GetNoteByStudent(context.Context, *NoteStudentByAbsRequest) (*GetNotesByStudent, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNoteByStudent not implemented")
-}
-func (UnimplementedStudentServiceServer) CreateNoteForStudent(context.Context, *CreateNoteRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNoteForStudent not implemented")
+func (UnimplementedDebtReminderServiceServer) GetDebtReminderSettings(context.Context, *emptypb.Empty) (*DebtReminderSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebtReminderSettings not implemented")
 }
-func (UnimplementedStudentServiceServer) DeleteStudentNote(context.Context, *NoteStudentByAbsRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudentNote not implemented")
-}
-func (UnimplementedStudentServiceServer) SearchStudent(context.Context, *SearchStudentRequest) (*SearchStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchStudent not implemented")
-}
-func (UnimplementedStudentServiceServer) GetHistoryGroupById(context.Context, *NoteStudentByAbsRequest) (*GetHistoryGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryGroupById not implemented")
-}
-func (UnimplementedStudentServiceServer) GetHistoryStudentById(context.Context, *NoteStudentByAbsRequest) (*GetHistoryStudentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryStudentById not implemented")
-}
-func (UnimplementedStudentServiceServer) TransferLessonDate(context.Context, *TransferLessonRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferLessonDate not implemented")
-}
-func (UnimplementedStudentServiceServer) ChangeConditionStudent(context.Context, *ChangeConditionStudentRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeConditionStudent not implemented")
-}
-func (UnimplementedStudentServiceServer) GetStudentsByGroupId(context.Context, *GetStudentsByGroupIdRequest) (*GetStudentsByGroupIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByGroupId not implemented")
+func (UnimplementedDebtReminderServiceServer) UpdateDebtReminderSettings(context.Context, *DebtReminderSettings) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDebtReminderSettings not implemented")
 }
-func (UnimplementedStudentServiceServer) ChangeUserBalanceHistory(context.Context, *ChangeUserBalanceHistoryRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserBalanceHistory not implemented")
-}
-func (UnimplementedStudentServiceServer) ChangeUserBalanceHistoryByDebit(context.Context, *ChangeUserBalanceHistoryByDebitRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserBalanceHistoryByDebit not implemented")
+func (UnimplementedDebtReminderServiceServer) GetDebtReminders(context.Context, *GetDebtRemindersRequest) (*GetDebtRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebtReminders not implemented")
 }
-func (UnimplementedStudentServiceServer) CalculateDiscountSumma(context.Context, *CalculateDiscountSummaRequest) (*CalculateDiscountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateDiscountSumma not implemented")
+func (UnimplementedDebtReminderServiceServer) SetDebtReminderReply(context.Context, *SetDebtReminderReplyRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDebtReminderReply not implemented")
 }
-func (UnimplementedStudentServiceServer) mustEmbedUnimplementedStudentServiceServer() {}
-func (UnimplementedStudentServiceServer) testEmbeddedByValue()                        {}
+func (UnimplementedDebtReminderServiceServer) mustEmbedUnimplementedDebtReminderServiceServer() {}
+func (UnimplementedDebtReminderServiceServer) testEmbeddedByValue()                             {}
 
-// UnsafeStudentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to StudentServiceServer will
+// UnsafeDebtReminderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DebtReminderServiceServer will
 // result in compilation errors.
-type UnsafeStudentServiceServer interface {
-	mustEmbedUnimplementedStudentServiceServer()
+type UnsafeDebtReminderServiceServer interface {
+	mustEmbedUnimplementedDebtReminderServiceServer()
 }
 
-func RegisterStudentServiceServer(s grpc.ServiceRegistrar, srv StudentServiceServer) {
-	// If the following call pancis, it indicates UnimplementedStudentServiceServer was
+func RegisterDebtReminderServiceServer(s grpc.ServiceRegistrar, srv DebtReminderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDebtReminderServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&StudentService_ServiceDesc, srv)
+	s.RegisterService(&DebtReminderService_ServiceDesc, srv)
 }
 
-func _StudentService_GetAllStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllStudentRequest)
+func _DebtReminderService_GetDebtReminderSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).GetAllStudent(ctx, in)
+		return srv.(DebtReminderServiceServer).GetDebtReminderSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_GetAllStudent_FullMethodName,
+		FullMethod: DebtReminderService_GetDebtReminderSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).GetAllStudent(ctx, req.(*GetAllStudentRequest))
+		return srv.(DebtReminderServiceServer).GetDebtReminderSettings(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_CreateStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStudentRequest)
+func _DebtReminderService_UpdateDebtReminderSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebtReminderSettings)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).CreateStudent(ctx, in)
+		return srv.(DebtReminderServiceServer).UpdateDebtReminderSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_CreateStudent_FullMethodName,
+		FullMethod: DebtReminderService_UpdateDebtReminderSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).CreateStudent(ctx, req.(*CreateStudentRequest))
+		return srv.(DebtReminderServiceServer).UpdateDebtReminderSettings(ctx, req.(*DebtReminderSettings))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_UpdateStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStudentRequest)
+func _DebtReminderService_GetDebtReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebtRemindersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).UpdateStudent(ctx, in)
+		return srv.(DebtReminderServiceServer).GetDebtReminders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_UpdateStudent_FullMethodName,
+		FullMethod: DebtReminderService_GetDebtReminders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).UpdateStudent(ctx, req.(*UpdateStudentRequest))
+		return srv.(DebtReminderServiceServer).GetDebtReminders(ctx, req.(*GetDebtRemindersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_DeleteStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteStudentRequest)
+func _DebtReminderService_SetDebtReminderReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDebtReminderReplyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).DeleteStudent(ctx, in)
+		return srv.(DebtReminderServiceServer).SetDebtReminderReply(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_DeleteStudent_FullMethodName,
+		FullMethod: DebtReminderService_SetDebtReminderReply_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).DeleteStudent(ctx, req.(*DeleteStudentRequest))
+		return srv.(DebtReminderServiceServer).SetDebtReminderReply(ctx, req.(*SetDebtReminderReplyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_AddToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToGroupRequest)
-	if err := dec(in); err != nil {
+// DebtReminderService_ServiceDesc is the grpc.ServiceDesc for DebtReminderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DebtReminderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.DebtReminderService",
+	HandlerType: (*DebtReminderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDebtReminderSettings",
+			Handler:    _DebtReminderService_GetDebtReminderSettings_Handler,
+		},
+		{
+			MethodName: "UpdateDebtReminderSettings",
+			Handler:    _DebtReminderService_UpdateDebtReminderSettings_Handler,
+		},
+		{
+			MethodName: "GetDebtReminders",
+			Handler:    _DebtReminderService_GetDebtReminders_Handler,
+		},
+		{
+			MethodName: "SetDebtReminderReply",
+			Handler:    _DebtReminderService_SetDebtReminderReply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	ReconciliationService_RunBalanceReconciliation_FullMethodName = "/education.ReconciliationService/RunBalanceReconciliation"
+	ReconciliationService_GetReconciliationReports_FullMethodName = "/education.ReconciliationService/GetReconciliationReports"
+	ReconciliationService_GetReconciliationReport_FullMethodName  = "/education.ReconciliationService/GetReconciliationReport"
+)
+
+// ReconciliationServiceClient is the client API for ReconciliationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// reconciliation service start
+type ReconciliationServiceClient interface {
+	RunBalanceReconciliation(ctx context.Context, in *RunBalanceReconciliationRequest, opts ...grpc.CallOption) (*ReconciliationReport, error)
+	GetReconciliationReports(ctx context.Context, in *GetReconciliationReportsRequest, opts ...grpc.CallOption) (*GetReconciliationReportsResponse, error)
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*ReconciliationReport, error)
+}
+
+type reconciliationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReconciliationServiceClient(cc grpc.ClientConnInterface) ReconciliationServiceClient {
+	return &reconciliationServiceClient{cc}
+}
+
+func (c *reconciliationServiceClient) RunBalanceReconciliation(ctx context.Context, in *RunBalanceReconciliationRequest, opts ...grpc.CallOption) (*ReconciliationReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationReport)
+	err := c.cc.Invoke(ctx, ReconciliationService_RunBalanceReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(StudentServiceServer).AddToGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StudentService_AddToGroup_FullMethodName,
+	return out, nil
+}
+
+func (c *reconciliationServiceClient) GetReconciliationReports(ctx context.Context, in *GetReconciliationReportsRequest, opts ...grpc.CallOption) (*GetReconciliationReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationReportsResponse)
+	err := c.cc.Invoke(ctx, ReconciliationService_GetReconciliationReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).AddToGroup(ctx, req.(*AddToGroupRequest))
+	return out, nil
+}
+
+func (c *reconciliationServiceClient) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*ReconciliationReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationReport)
+	err := c.cc.Invoke(ctx, ReconciliationService_GetReconciliationReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
+}
+
+// ReconciliationServiceServer is the server API for ReconciliationService service.
+// All implementations must embed UnimplementedReconciliationServiceServer
+// for forward compatibility.
+//
+// reconciliation service start
+type ReconciliationServiceServer interface {
+	RunBalanceReconciliation(context.Context, *RunBalanceReconciliationRequest) (*ReconciliationReport, error)
+	GetReconciliationReports(context.Context, *GetReconciliationReportsRequest) (*GetReconciliationReportsResponse, error)
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*ReconciliationReport, error)
+	mustEmbedUnimplementedReconciliationServiceServer()
+}
+
+// UnimplementedReconciliationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReconciliationServiceServer struct{}
+
+func (UnimplementedReconciliationServiceServer) RunBalanceReconciliation(context.Context, *RunBalanceReconciliationRequest) (*ReconciliationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunBalanceReconciliation not implemented")
+}
+func (UnimplementedReconciliationServiceServer) GetReconciliationReports(context.Context, *GetReconciliationReportsRequest) (*GetReconciliationReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationReports not implemented")
+}
+func (UnimplementedReconciliationServiceServer) GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*ReconciliationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationReport not implemented")
+}
+func (UnimplementedReconciliationServiceServer) mustEmbedUnimplementedReconciliationServiceServer() {}
+func (UnimplementedReconciliationServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeReconciliationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReconciliationServiceServer will
+// result in compilation errors.
+type UnsafeReconciliationServiceServer interface {
+	mustEmbedUnimplementedReconciliationServiceServer()
 }
 
-func _StudentService_GetStudentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteStudentByAbsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StudentServiceServer).GetStudentById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StudentService_GetStudentById_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).GetStudentById(ctx, req.(*NoteStudentByAbsRequest))
+func RegisterReconciliationServiceServer(s grpc.ServiceRegistrar, srv ReconciliationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReconciliationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&ReconciliationService_ServiceDesc, srv)
 }
 
-func _StudentService_GetNoteByStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteStudentByAbsRequest)
+func _ReconciliationService_RunBalanceReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunBalanceReconciliationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).GetNoteByStudent(ctx, in)
+		return srv.(ReconciliationServiceServer).RunBalanceReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_GetNoteByStudent_FullMethodName,
+		FullMethod: ReconciliationService_RunBalanceReconciliation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).GetNoteByStudent(ctx, req.(*NoteStudentByAbsRequest))
+		return srv.(ReconciliationServiceServer).RunBalanceReconciliation(ctx, req.(*RunBalanceReconciliationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_CreateNoteForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNoteRequest)
+func _ReconciliationService_GetReconciliationReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).CreateNoteForStudent(ctx, in)
+		return srv.(ReconciliationServiceServer).GetReconciliationReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_CreateNoteForStudent_FullMethodName,
+		FullMethod: ReconciliationService_GetReconciliationReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).CreateNoteForStudent(ctx, req.(*CreateNoteRequest))
+		return srv.(ReconciliationServiceServer).GetReconciliationReports(ctx, req.(*GetReconciliationReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_DeleteStudentNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteStudentByAbsRequest)
+func _ReconciliationService_GetReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).DeleteStudentNote(ctx, in)
+		return srv.(ReconciliationServiceServer).GetReconciliationReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_DeleteStudentNote_FullMethodName,
+		FullMethod: ReconciliationService_GetReconciliationReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).DeleteStudentNote(ctx, req.(*NoteStudentByAbsRequest))
+		return srv.(ReconciliationServiceServer).GetReconciliationReport(ctx, req.(*GetReconciliationReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_SearchStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchStudentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StudentServiceServer).SearchStudent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StudentService_SearchStudent_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).SearchStudent(ctx, req.(*SearchStudentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ReconciliationService_ServiceDesc is the grpc.ServiceDesc for ReconciliationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReconciliationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.ReconciliationService",
+	HandlerType: (*ReconciliationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RunBalanceReconciliation",
+			Handler:    _ReconciliationService_RunBalanceReconciliation_Handler,
+		},
+		{
+			MethodName: "GetReconciliationReports",
+			Handler:    _ReconciliationService_GetReconciliationReports_Handler,
+		},
+		{
+			MethodName: "GetReconciliationReport",
+			Handler:    _ReconciliationService_GetReconciliationReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
 }
 
-func _StudentService_GetHistoryGroupById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteStudentByAbsRequest)
-	if err := dec(in); err != nil {
+const (
+	AnalyticsService_GetPlatformMetrics_FullMethodName = "/education.AnalyticsService/GetPlatformMetrics"
+	AnalyticsService_GetTenantHealth_FullMethodName    = "/education.AnalyticsService/GetTenantHealth"
+	AnalyticsService_GetPlatformHistory_FullMethodName = "/education.AnalyticsService/GetPlatformHistory"
+	AnalyticsService_GetTenantHistory_FullMethodName   = "/education.AnalyticsService/GetTenantHistory"
+)
+
+// AnalyticsServiceClient is the client API for AnalyticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// analytics service start
+// Platform analytics for the platform owner (SUPER_CEO).
+type AnalyticsServiceClient interface {
+	GetPlatformMetrics(ctx context.Context, in *PlatformMetricsRequest, opts ...grpc.CallOption) (*PlatformMetrics, error)
+	GetTenantHealth(ctx context.Context, in *TenantHealthRequest, opts ...grpc.CallOption) (*TenantHealthList, error)
+	GetPlatformHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*PlatformHistory, error)
+	GetTenantHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*TenantHealthList, error)
+}
+
+type analyticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnalyticsServiceClient(cc grpc.ClientConnInterface) AnalyticsServiceClient {
+	return &analyticsServiceClient{cc}
+}
+
+func (c *analyticsServiceClient) GetPlatformMetrics(ctx context.Context, in *PlatformMetricsRequest, opts ...grpc.CallOption) (*PlatformMetrics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlatformMetrics)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetPlatformMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(StudentServiceServer).GetHistoryGroupById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StudentService_GetHistoryGroupById_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).GetHistoryGroupById(ctx, req.(*NoteStudentByAbsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _StudentService_GetHistoryStudentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteStudentByAbsRequest)
-	if err := dec(in); err != nil {
+func (c *analyticsServiceClient) GetTenantHealth(ctx context.Context, in *TenantHealthRequest, opts ...grpc.CallOption) (*TenantHealthList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantHealthList)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetTenantHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(StudentServiceServer).GetHistoryStudentById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StudentService_GetHistoryStudentById_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).GetHistoryStudentById(ctx, req.(*NoteStudentByAbsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _StudentService_TransferLessonDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferLessonRequest)
-	if err := dec(in); err != nil {
+func (c *analyticsServiceClient) GetPlatformHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*PlatformHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlatformHistory)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetPlatformHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(StudentServiceServer).TransferLessonDate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StudentService_TransferLessonDate_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).TransferLessonDate(ctx, req.(*TransferLessonRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _StudentService_ChangeConditionStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeConditionStudentRequest)
-	if err := dec(in); err != nil {
+func (c *analyticsServiceClient) GetTenantHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*TenantHealthList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantHealthList)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetTenantHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(StudentServiceServer).ChangeConditionStudent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StudentService_ChangeConditionStudent_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).ChangeConditionStudent(ctx, req.(*ChangeConditionStudentRequest))
+	return out, nil
+}
+
+// AnalyticsServiceServer is the server API for AnalyticsService service.
+// All implementations must embed UnimplementedAnalyticsServiceServer
+// for forward compatibility.
+//
+// analytics service start
+// Platform analytics for the platform owner (SUPER_CEO).
+type AnalyticsServiceServer interface {
+	GetPlatformMetrics(context.Context, *PlatformMetricsRequest) (*PlatformMetrics, error)
+	GetTenantHealth(context.Context, *TenantHealthRequest) (*TenantHealthList, error)
+	GetPlatformHistory(context.Context, *HistoryRequest) (*PlatformHistory, error)
+	GetTenantHistory(context.Context, *HistoryRequest) (*TenantHealthList, error)
+	mustEmbedUnimplementedAnalyticsServiceServer()
+}
+
+// UnimplementedAnalyticsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnalyticsServiceServer struct{}
+
+func (UnimplementedAnalyticsServiceServer) GetPlatformMetrics(context.Context, *PlatformMetricsRequest) (*PlatformMetrics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformMetrics not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetTenantHealth(context.Context, *TenantHealthRequest) (*TenantHealthList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantHealth not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetPlatformHistory(context.Context, *HistoryRequest) (*PlatformHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformHistory not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetTenantHistory(context.Context, *HistoryRequest) (*TenantHealthList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantHistory not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) mustEmbedUnimplementedAnalyticsServiceServer() {}
+func (UnimplementedAnalyticsServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeAnalyticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnalyticsServiceServer will
+// result in compilation errors.
+type UnsafeAnalyticsServiceServer interface {
+	mustEmbedUnimplementedAnalyticsServiceServer()
+}
+
+func RegisterAnalyticsServiceServer(s grpc.ServiceRegistrar, srv AnalyticsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnalyticsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&AnalyticsService_ServiceDesc, srv)
 }
 
-func _StudentService_GetStudentsByGroupId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStudentsByGroupIdRequest)
+func _AnalyticsService_GetPlatformMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformMetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).GetStudentsByGroupId(ctx, in)
+		return srv.(AnalyticsServiceServer).GetPlatformMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_GetStudentsByGroupId_FullMethodName,
+		FullMethod: AnalyticsService_GetPlatformMetrics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).GetStudentsByGroupId(ctx, req.(*GetStudentsByGroupIdRequest))
+		return srv.(AnalyticsServiceServer).GetPlatformMetrics(ctx, req.(*PlatformMetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_ChangeUserBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeUserBalanceHistoryRequest)
+func _AnalyticsService_GetTenantHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).ChangeUserBalanceHistory(ctx, in)
+		return srv.(AnalyticsServiceServer).GetTenantHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_ChangeUserBalanceHistory_FullMethodName,
+		FullMethod: AnalyticsService_GetTenantHealth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).ChangeUserBalanceHistory(ctx, req.(*ChangeUserBalanceHistoryRequest))
+		return srv.(AnalyticsServiceServer).GetTenantHealth(ctx, req.(*TenantHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_ChangeUserBalanceHistoryByDebit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeUserBalanceHistoryByDebitRequest)
+func _AnalyticsService_GetPlatformHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).ChangeUserBalanceHistoryByDebit(ctx, in)
+		return srv.(AnalyticsServiceServer).GetPlatformHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_ChangeUserBalanceHistoryByDebit_FullMethodName,
+		FullMethod: AnalyticsService_GetPlatformHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).ChangeUserBalanceHistoryByDebit(ctx, req.(*ChangeUserBalanceHistoryByDebitRequest))
+		return srv.(AnalyticsServiceServer).GetPlatformHistory(ctx, req.(*HistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StudentService_CalculateDiscountSumma_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateDiscountSummaRequest)
+func _AnalyticsService_GetTenantHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StudentServiceServer).CalculateDiscountSumma(ctx, in)
+		return srv.(AnalyticsServiceServer).GetTenantHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StudentService_CalculateDiscountSumma_FullMethodName,
+		FullMethod: AnalyticsService_GetTenantHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentServiceServer).CalculateDiscountSumma(ctx, req.(*CalculateDiscountSummaRequest))
+		return srv.(AnalyticsServiceServer).GetTenantHistory(ctx, req.(*HistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// StudentService_ServiceDesc is the grpc.ServiceDesc for StudentService service.
+// AnalyticsService_ServiceDesc is the grpc.ServiceDesc for AnalyticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var StudentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "education.StudentService",
-	HandlerType: (*StudentServiceServer)(nil),
+var AnalyticsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.AnalyticsService",
+	HandlerType: (*AnalyticsServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetAllStudent",
-			Handler:    _StudentService_GetAllStudent_Handler,
-		},
-		{
-			MethodName: "CreateStudent",
-			Handler:    _StudentService_CreateStudent_Handler,
-		},
-		{
-			MethodName: "UpdateStudent",
-			Handler:    _StudentService_UpdateStudent_Handler,
-		},
-		{
-			MethodName: "DeleteStudent",
-			Handler:    _StudentService_DeleteStudent_Handler,
-		},
-		{
-			MethodName: "AddToGroup",
-			Handler:    _StudentService_AddToGroup_Handler,
-		},
-		{
-			MethodName: "GetStudentById",
-			Handler:    _StudentService_GetStudentById_Handler,
-		},
-		{
-			MethodName: "GetNoteByStudent",
-			Handler:    _StudentService_GetNoteByStudent_Handler,
-		},
-		{
-			MethodName: "CreateNoteForStudent",
-			Handler:    _StudentService_CreateNoteForStudent_Handler,
-		},
-		{
-			MethodName: "DeleteStudentNote",
-			Handler:    _StudentService_DeleteStudentNote_Handler,
-		},
-		{
-			MethodName: "SearchStudent",
-			Handler:    _StudentService_SearchStudent_Handler,
-		},
-		{
-			MethodName: "GetHistoryGroupById",
-			Handler:    _StudentService_GetHistoryGroupById_Handler,
-		},
-		{
-			MethodName: "GetHistoryStudentById",
-			Handler:    _StudentService_GetHistoryStudentById_Handler,
-		},
-		{
-			MethodName: "TransferLessonDate",
-			Handler:    _StudentService_TransferLessonDate_Handler,
-		},
-		{
-			MethodName: "ChangeConditionStudent",
-			Handler:    _StudentService_ChangeConditionStudent_Handler,
-		},
-		{
-			MethodName: "GetStudentsByGroupId",
-			Handler:    _StudentService_GetStudentsByGroupId_Handler,
+			MethodName: "GetPlatformMetrics",
+			Handler:    _AnalyticsService_GetPlatformMetrics_Handler,
 		},
 		{
-			MethodName: "ChangeUserBalanceHistory",
-			Handler:    _StudentService_ChangeUserBalanceHistory_Handler,
+			MethodName: "GetTenantHealth",
+			Handler:    _AnalyticsService_GetTenantHealth_Handler,
 		},
 		{
-			MethodName: "ChangeUserBalanceHistoryByDebit",
-			Handler:    _StudentService_ChangeUserBalanceHistoryByDebit_Handler,
+			MethodName: "GetPlatformHistory",
+			Handler:    _AnalyticsService_GetPlatformHistory_Handler,
 		},
 		{
-			MethodName: "CalculateDiscountSumma",
-			Handler:    _StudentService_CalculateDiscountSumma_Handler,
+			MethodName: "GetTenantHistory",
+			Handler:    _AnalyticsService_GetTenantHistory_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -21,18 +21,74 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetDiscountByStudentIdResponse struct {
+type CreateCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Amount        string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	IsHave        bool                   `protobuf:"varint,2,opt,name=isHave,proto3" json:"isHave,omitempty"`
-	DiscountOwner string                 `protobuf:"bytes,3,opt,name=discountOwner,proto3" json:"discountOwner,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Desc          string                 `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_finance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type GetDiscountByStudentIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// taken off the monthly price
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	IsHave bool   `protobuf:"varint,2,opt,name=isHave,proto3" json:"isHave,omitempty"`
+	// TEACHER when part of the amount comes off the teacher's share
+	DiscountOwner string             `protobuf:"bytes,3,opt,name=discountOwner,proto3" json:"discountOwner,omitempty"`
+	TeacherAmount string             `protobuf:"bytes,4,opt,name=teacherAmount,proto3" json:"teacherAmount,omitempty"`
+	Applied       []*AppliedDiscount `protobuf:"bytes,5,rep,name=applied,proto3" json:"applied,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDiscountByStudentIdResponse) Reset() {
 	*x = GetDiscountByStudentIdResponse{}
-	mi := &file_finance_proto_msgTypes[0]
+	mi := &file_finance_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +100,7 @@ func (x *GetDiscountByStudentIdResponse) String() string {
 func (*GetDiscountByStudentIdResponse) ProtoMessage() {}
 
 func (x *GetDiscountByStudentIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[0]
+	mi := &file_finance_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +113,7 @@ func (x *GetDiscountByStudentIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountByStudentIdResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountByStudentIdResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{0}
+	return file_finance_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *GetDiscountByStudentIdResponse) GetAmount() string {
@@ -81,17 +137,41 @@ func (x *GetDiscountByStudentIdResponse) GetDiscountOwner() string {
 	return ""
 }
 
+func (x *GetDiscountByStudentIdResponse) GetTeacherAmount() string {
+	if x != nil {
+		return x.TeacherAmount
+	}
+	return ""
+}
+
+func (x *GetDiscountByStudentIdResponse) GetApplied() []*AppliedDiscount {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
 type GetDiscountByStudentIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// the day priced, today when empty
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// monthly price of the group, percent discounts are taken of it
+	CoursePrice string `protobuf:"bytes,4,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
+	// what the company's rules look at
+	Siblings     int32 `protobuf:"varint,5,opt,name=siblings,proto3" json:"siblings,omitempty"`
+	ActiveGroups int32 `protobuf:"varint,6,opt,name=activeGroups,proto3" json:"activeGroups,omitempty"`
+	Prepaid      bool  `protobuf:"varint,7,opt,name=prepaid,proto3" json:"prepaid,omitempty"`
+	// set by the monthly charge, records the rules applied
+	Apply         bool `protobuf:"varint,8,opt,name=apply,proto3" json:"apply,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDiscountByStudentIdRequest) Reset() {
 	*x = GetDiscountByStudentIdRequest{}
-	mi := &file_finance_proto_msgTypes[1]
+	mi := &file_finance_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -103,7 +183,7 @@ func (x *GetDiscountByStudentIdRequest) String() string {
 func (*GetDiscountByStudentIdRequest) ProtoMessage() {}
 
 func (x *GetDiscountByStudentIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[1]
+	mi := &file_finance_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,7 +196,7 @@ func (x *GetDiscountByStudentIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountByStudentIdRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountByStudentIdRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{1}
+	return file_finance_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetDiscountByStudentIdRequest) GetStudentId() string {
@@ -133,6 +213,333 @@ func (x *GetDiscountByStudentIdRequest) GetGroupId() string {
 	return ""
 }
 
+func (x *GetDiscountByStudentIdRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDiscountByStudentIdRequest) GetCoursePrice() string {
+	if x != nil {
+		return x.CoursePrice
+	}
+	return ""
+}
+
+func (x *GetDiscountByStudentIdRequest) GetSiblings() int32 {
+	if x != nil {
+		return x.Siblings
+	}
+	return 0
+}
+
+func (x *GetDiscountByStudentIdRequest) GetActiveGroups() int32 {
+	if x != nil {
+		return x.ActiveGroups
+	}
+	return 0
+}
+
+func (x *GetDiscountByStudentIdRequest) GetPrepaid() bool {
+	if x != nil {
+		return x.Prepaid
+	}
+	return false
+}
+
+func (x *GetDiscountByStudentIdRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type AppliedDiscount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DISCOUNT for a student's own discount, otherwise the rule type
+	Source        string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	DiscountType  string `protobuf:"bytes,4,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	Value         string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Amount        string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	WithTeacher   bool   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppliedDiscount) Reset() {
+	*x = AppliedDiscount{}
+	mi := &file_finance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedDiscount) ProtoMessage() {}
+
+func (x *AppliedDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedDiscount.ProtoReflect.Descriptor instead.
+func (*AppliedDiscount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AppliedDiscount) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetWithTeacher() bool {
+	if x != nil {
+		return x.WithTeacher
+	}
+	return false
+}
+
+type MergeStudentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceStudentId string                 `protobuf:"bytes,1,opt,name=sourceStudentId,proto3" json:"sourceStudentId,omitempty"`
+	TargetStudentId string                 `protobuf:"bytes,2,opt,name=targetStudentId,proto3" json:"targetStudentId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeStudentRequest) Reset() {
+	*x = MergeStudentRequest{}
+	mi := &file_finance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeStudentRequest) ProtoMessage() {}
+
+func (x *MergeStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeStudentRequest.ProtoReflect.Descriptor instead.
+func (*MergeStudentRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MergeStudentRequest) GetSourceStudentId() string {
+	if x != nil {
+		return x.SourceStudentId
+	}
+	return ""
+}
+
+func (x *MergeStudentRequest) GetTargetStudentId() string {
+	if x != nil {
+		return x.TargetStudentId
+	}
+	return ""
+}
+
+type GetStudentLedgerBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentLedgerBalancesRequest) Reset() {
+	*x = GetStudentLedgerBalancesRequest{}
+	mi := &file_finance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentLedgerBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentLedgerBalancesRequest) ProtoMessage() {}
+
+func (x *GetStudentLedgerBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentLedgerBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentLedgerBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{5}
+}
+
+type StudentLedgerBalance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StudentId        string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Balance          string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	HasPendingEvents bool                   `protobuf:"varint,3,opt,name=hasPendingEvents,proto3" json:"hasPendingEvents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StudentLedgerBalance) Reset() {
+	*x = StudentLedgerBalance{}
+	mi := &file_finance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentLedgerBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentLedgerBalance) ProtoMessage() {}
+
+func (x *StudentLedgerBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentLedgerBalance.ProtoReflect.Descriptor instead.
+func (*StudentLedgerBalance) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StudentLedgerBalance) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentLedgerBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *StudentLedgerBalance) GetHasPendingEvents() bool {
+	if x != nil {
+		return x.HasPendingEvents
+	}
+	return false
+}
+
+type GetStudentLedgerBalancesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Balances      []*StudentLedgerBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentLedgerBalancesResponse) Reset() {
+	*x = GetStudentLedgerBalancesResponse{}
+	mi := &file_finance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentLedgerBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentLedgerBalancesResponse) ProtoMessage() {}
+
+func (x *GetStudentLedgerBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentLedgerBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentLedgerBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetStudentLedgerBalancesResponse) GetBalances() []*StudentLedgerBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
 type PaymentAddRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Comment              string                 `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
@@ -145,13 +552,21 @@ type PaymentAddRequest struct {
 	ActionByName         string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
 	GroupId              string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId,omitempty"`
 	Studentconditiondate string                 `protobuf:"bytes,10,opt,name=studentconditiondate,proto3" json:"studentconditiondate,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// TAKE_OFF and REFUND of group months, split with the student's sponsors
+	Sponsored bool `protobuf:"varint,11,opt,name=sponsored,proto3" json:"sponsored,omitempty"`
+	// cash account an ADD is paid into, the company's default one for method
+	// when empty; sum is in the account's currency
+	AccountId string `protobuf:"bytes,12,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	// discounts a TAKE_OFF was priced with, its rules are recorded as applied
+	// to the month of date together with the charge
+	Discounts     []*AppliedDiscount `protobuf:"bytes,13,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PaymentAddRequest) Reset() {
 	*x = PaymentAddRequest{}
-	mi := &file_finance_proto_msgTypes[2]
+	mi := &file_finance_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -163,7 +578,7 @@ func (x *PaymentAddRequest) String() string {
 func (*PaymentAddRequest) ProtoMessage() {}
 
 func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[2]
+	mi := &file_finance_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +591,7 @@ func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentAddRequest.ProtoReflect.Descriptor instead.
 func (*PaymentAddRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{2}
+	return file_finance_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PaymentAddRequest) GetComment() string {
@@ -249,6 +664,27 @@ func (x *PaymentAddRequest) GetStudentconditiondate() string {
 	return ""
 }
 
+func (x *PaymentAddRequest) GetSponsored() bool {
+	if x != nil {
+		return x.Sponsored
+	}
+	return false
+}
+
+func (x *PaymentAddRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetDiscounts() []*AppliedDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
 type DeleteTeacherSalaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
@@ -258,7 +694,7 @@ type DeleteTeacherSalaryRequest struct {
 
 func (x *DeleteTeacherSalaryRequest) Reset() {
 	*x = DeleteTeacherSalaryRequest{}
-	mi := &file_finance_proto_msgTypes[3]
+	mi := &file_finance_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -270,7 +706,7 @@ func (x *DeleteTeacherSalaryRequest) String() string {
 func (*DeleteTeacherSalaryRequest) ProtoMessage() {}
 
 func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[3]
+	mi := &file_finance_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +719,7 @@ func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeacherSalaryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{3}
+	return file_finance_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteTeacherSalaryRequest) GetTeacherId() string {
@@ -297,7 +733,7 @@ type AbsGetTeachersSalary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
 	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	TeacherName   string                 `protobuf:"bytes,4,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -305,7 +741,7 @@ type AbsGetTeachersSalary struct {
 
 func (x *AbsGetTeachersSalary) Reset() {
 	*x = AbsGetTeachersSalary{}
-	mi := &file_finance_proto_msgTypes[4]
+	mi := &file_finance_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +753,7 @@ func (x *AbsGetTeachersSalary) String() string {
 func (*AbsGetTeachersSalary) ProtoMessage() {}
 
 func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[4]
+	mi := &file_finance_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +766,7 @@ func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsGetTeachersSalary.ProtoReflect.Descriptor instead.
 func (*AbsGetTeachersSalary) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{4}
+	return file_finance_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AbsGetTeachersSalary) GetTeacherId() string {
@@ -347,11 +783,11 @@ func (x *AbsGetTeachersSalary) GetType() string {
 	return ""
 }
 
-func (x *AbsGetTeachersSalary) GetAmount() int32 {
+func (x *AbsGetTeachersSalary) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return ""
 }
 
 func (x *AbsGetTeachersSalary) GetTeacherName() string {
@@ -361,18 +797,91 @@ func (x *AbsGetTeachersSalary) GetTeacherName() string {
 	return ""
 }
 
+type StudentPaymentPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentPaymentPlansRequest) Reset() {
+	*x = StudentPaymentPlansRequest{}
+	mi := &file_finance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentPaymentPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentPaymentPlansRequest) ProtoMessage() {}
+
+func (x *StudentPaymentPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentPaymentPlansRequest.ProtoReflect.Descriptor instead.
+func (*StudentPaymentPlansRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StudentPaymentPlansRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
 var File_finance_proto protoreflect.FileDescriptor
 
 const file_finance_proto_rawDesc = "" +
 	"\n" +
-	"\rfinance.proto\x12\afinance\x1a\fcommon.proto\"v\n" +
+	"\rfinance.proto\x12\afinance\x1a\fcommon.proto\"?\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04desc\x18\x02 \x01(\tR\x04desc\"\xd0\x01\n" +
 	"\x1eGetDiscountByStudentIdResponse\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\tR\x06amount\x12\x16\n" +
 	"\x06isHave\x18\x02 \x01(\bR\x06isHave\x12$\n" +
-	"\rdiscountOwner\x18\x03 \x01(\tR\rdiscountOwner\"W\n" +
+	"\rdiscountOwner\x18\x03 \x01(\tR\rdiscountOwner\x12$\n" +
+	"\rteacherAmount\x18\x04 \x01(\tR\rteacherAmount\x122\n" +
+	"\aapplied\x18\x05 \x03(\v2\x18.finance.AppliedDiscountR\aapplied\"\xfd\x01\n" +
 	"\x1dGetDiscountByStudentIdRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
-	"\agroupId\x18\x02 \x01(\tR\agroupId\"\xa9\x02\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12 \n" +
+	"\vcoursePrice\x18\x04 \x01(\tR\vcoursePrice\x12\x1a\n" +
+	"\bsiblings\x18\x05 \x01(\x05R\bsiblings\x12\"\n" +
+	"\factiveGroups\x18\x06 \x01(\x05R\factiveGroups\x12\x18\n" +
+	"\aprepaid\x18\a \x01(\bR\aprepaid\x12\x14\n" +
+	"\x05apply\x18\b \x01(\bR\x05apply\"\xc1\x01\n" +
+	"\x0fAppliedDiscount\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
+	"\fdiscountType\x18\x04 \x01(\tR\fdiscountType\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12 \n" +
+	"\vwithTeacher\x18\a \x01(\bR\vwithTeacher\"i\n" +
+	"\x13MergeStudentRequest\x12(\n" +
+	"\x0fsourceStudentId\x18\x01 \x01(\tR\x0fsourceStudentId\x12(\n" +
+	"\x0ftargetStudentId\x18\x02 \x01(\tR\x0ftargetStudentId\"!\n" +
+	"\x1fGetStudentLedgerBalancesRequest\"z\n" +
+	"\x14StudentLedgerBalance\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12*\n" +
+	"\x10hasPendingEvents\x18\x03 \x01(\bR\x10hasPendingEvents\"]\n" +
+	" GetStudentLedgerBalancesResponse\x129\n" +
+	"\bbalances\x18\x01 \x03(\v2\x1d.finance.StudentLedgerBalanceR\bbalances\"\x9d\x03\n" +
 	"\x11PaymentAddRequest\x12\x18\n" +
 	"\acomment\x18\x01 \x01(\tR\acomment\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
@@ -386,21 +895,33 @@ const file_finance_proto_rawDesc = "" +
 	"\factionByName\x18\b \x01(\tR\factionByName\x12\x18\n" +
 	"\agroupId\x18\t \x01(\tR\agroupId\x122\n" +
 	"\x14studentconditiondate\x18\n" +
-	" \x01(\tR\x14studentconditiondate\":\n" +
+	" \x01(\tR\x14studentconditiondate\x12\x1c\n" +
+	"\tsponsored\x18\v \x01(\bR\tsponsored\x12\x1c\n" +
+	"\taccountId\x18\f \x01(\tR\taccountId\x126\n" +
+	"\tdiscounts\x18\r \x03(\v2\x18.finance.AppliedDiscountR\tdiscounts\":\n" +
 	"\x1aDeleteTeacherSalaryRequest\x12\x1c\n" +
 	"\tteacherId\x18\x01 \x01(\tR\tteacherId\"\x82\x01\n" +
 	"\x14AbsGetTeachersSalary\x12\x1c\n" +
 	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12 \n" +
-	"\vteacherName\x18\x04 \x01(\tR\vteacherName2|\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12 \n" +
+	"\vteacherName\x18\x04 \x01(\tR\vteacherName\":\n" +
+	"\x1aStudentPaymentPlansRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId2X\n" +
+	"\x0fCategoryService\x12E\n" +
+	"\x0eCreateCategory\x12\x1e.finance.CreateCategoryRequest\x1a\x13.common.AbsResponse2|\n" +
 	"\x0fDiscountService\x12i\n" +
-	"\x16GetDiscountByStudentId\x12&.finance.GetDiscountByStudentIdRequest\x1a'.finance.GetDiscountByStudentIdResponse2O\n" +
+	"\x16GetDiscountByStudentId\x12&.finance.GetDiscountByStudentIdRequest\x1a'.finance.GetDiscountByStudentIdResponse2\xca\x02\n" +
 	"\x0ePaymentService\x12=\n" +
 	"\n" +
-	"PaymentAdd\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse2y\n" +
+	"PaymentAdd\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse\x12A\n" +
+	"\fMergeStudent\x12\x1c.finance.MergeStudentRequest\x1a\x13.common.AbsResponse\x12o\n" +
+	"\x18GetStudentLedgerBalances\x12(.finance.GetStudentLedgerBalancesRequest\x1a).finance.GetStudentLedgerBalancesResponse\x12E\n" +
+	"\x0eGetTenantUsage\x12\x1a.common.TenantUsageRequest\x1a\x17.common.TenantUsageList2y\n" +
 	"\x14TeacherSalaryService\x12a\n" +
-	"\x1bGetTeacherSalaryByTeacherID\x12#.finance.DeleteTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalaryB\n" +
+	"\x1bGetTeacherSalaryByTeacherID\x12#.finance.DeleteTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalary2r\n" +
+	"\x12PaymentPlanService\x12\\\n" +
+	"\x16GetStudentPaymentPlans\x12#.finance.StudentPaymentPlansRequest\x1a\x1d.common.PaymentPlanStatusListB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -415,27 +936,50 @@ func file_finance_proto_rawDescGZIP() []byte {
 	return file_finance_proto_rawDescData
 }
 
-var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_finance_proto_goTypes = []any{
-	(*GetDiscountByStudentIdResponse)(nil), // 0: finance.GetDiscountByStudentIdResponse
-	(*GetDiscountByStudentIdRequest)(nil),  // 1: finance.GetDiscountByStudentIdRequest
-	(*PaymentAddRequest)(nil),              // 2: finance.PaymentAddRequest
-	(*DeleteTeacherSalaryRequest)(nil),     // 3: finance.DeleteTeacherSalaryRequest
-	(*AbsGetTeachersSalary)(nil),           // 4: finance.AbsGetTeachersSalary
-	(*AbsResponse)(nil),                    // 5: common.AbsResponse
+	(*CreateCategoryRequest)(nil),            // 0: finance.CreateCategoryRequest
+	(*GetDiscountByStudentIdResponse)(nil),   // 1: finance.GetDiscountByStudentIdResponse
+	(*GetDiscountByStudentIdRequest)(nil),    // 2: finance.GetDiscountByStudentIdRequest
+	(*AppliedDiscount)(nil),                  // 3: finance.AppliedDiscount
+	(*MergeStudentRequest)(nil),              // 4: finance.MergeStudentRequest
+	(*GetStudentLedgerBalancesRequest)(nil),  // 5: finance.GetStudentLedgerBalancesRequest
+	(*StudentLedgerBalance)(nil),             // 6: finance.StudentLedgerBalance
+	(*GetStudentLedgerBalancesResponse)(nil), // 7: finance.GetStudentLedgerBalancesResponse
+	(*PaymentAddRequest)(nil),                // 8: finance.PaymentAddRequest
+	(*DeleteTeacherSalaryRequest)(nil),       // 9: finance.DeleteTeacherSalaryRequest
+	(*AbsGetTeachersSalary)(nil),             // 10: finance.AbsGetTeachersSalary
+	(*StudentPaymentPlansRequest)(nil),       // 11: finance.StudentPaymentPlansRequest
+	(*TenantUsageRequest)(nil),               // 12: common.TenantUsageRequest
+	(*AbsResponse)(nil),                      // 13: common.AbsResponse
+	(*TenantUsageList)(nil),                  // 14: common.TenantUsageList
+	(*PaymentPlanStatusList)(nil),            // 15: common.PaymentPlanStatusList
 }
 var file_finance_proto_depIdxs = []int32{
-	1, // 0: finance.DiscountService.GetDiscountByStudentId:input_type -> finance.GetDiscountByStudentIdRequest
-	2, // 1: finance.PaymentService.PaymentAdd:input_type -> finance.PaymentAddRequest
-	3, // 2: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:input_type -> finance.DeleteTeacherSalaryRequest
-	0, // 3: finance.DiscountService.GetDiscountByStudentId:output_type -> finance.GetDiscountByStudentIdResponse
-	5, // 4: finance.PaymentService.PaymentAdd:output_type -> common.AbsResponse
-	4, // 5: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:output_type -> finance.AbsGetTeachersSalary
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3,  // 0: finance.GetDiscountByStudentIdResponse.applied:type_name -> finance.AppliedDiscount
+	6,  // 1: finance.GetStudentLedgerBalancesResponse.balances:type_name -> finance.StudentLedgerBalance
+	3,  // 2: finance.PaymentAddRequest.discounts:type_name -> finance.AppliedDiscount
+	0,  // 3: finance.CategoryService.CreateCategory:input_type -> finance.CreateCategoryRequest
+	2,  // 4: finance.DiscountService.GetDiscountByStudentId:input_type -> finance.GetDiscountByStudentIdRequest
+	8,  // 5: finance.PaymentService.PaymentAdd:input_type -> finance.PaymentAddRequest
+	4,  // 6: finance.PaymentService.MergeStudent:input_type -> finance.MergeStudentRequest
+	5,  // 7: finance.PaymentService.GetStudentLedgerBalances:input_type -> finance.GetStudentLedgerBalancesRequest
+	12, // 8: finance.PaymentService.GetTenantUsage:input_type -> common.TenantUsageRequest
+	9,  // 9: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:input_type -> finance.DeleteTeacherSalaryRequest
+	11, // 10: finance.PaymentPlanService.GetStudentPaymentPlans:input_type -> finance.StudentPaymentPlansRequest
+	13, // 11: finance.CategoryService.CreateCategory:output_type -> common.AbsResponse
+	1,  // 12: finance.DiscountService.GetDiscountByStudentId:output_type -> finance.GetDiscountByStudentIdResponse
+	13, // 13: finance.PaymentService.PaymentAdd:output_type -> common.AbsResponse
+	13, // 14: finance.PaymentService.MergeStudent:output_type -> common.AbsResponse
+	7,  // 15: finance.PaymentService.GetStudentLedgerBalances:output_type -> finance.GetStudentLedgerBalancesResponse
+	14, // 16: finance.PaymentService.GetTenantUsage:output_type -> common.TenantUsageList
+	10, // 17: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:output_type -> finance.AbsGetTeachersSalary
+	15, // 18: finance.PaymentPlanService.GetStudentPaymentPlans:output_type -> common.PaymentPlanStatusList
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_finance_proto_init() }
@@ -450,9 +994,9 @@ func file_finance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_finance_proto_rawDesc), len(file_finance_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   5,
 		},
 		GoTypes:           file_finance_proto_goTypes,
 		DependencyIndexes: file_finance_proto_depIdxs,
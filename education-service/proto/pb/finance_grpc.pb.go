@@ -18,6 +18,108 @@ import (
 // Requires gRPC-Go v1.64.0 or later.
 const _ = grpc.SupportPackageIsVersion9
 
+const (
+	CategoryService_CreateCategory_FullMethodName = "/finance.CategoryService/CreateCategory"
+)
+
+// CategoryServiceClient is the client API for CategoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CategoryServiceClient interface {
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type categoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCategoryServiceClient(cc grpc.ClientConnInterface) CategoryServiceClient {
+	return &categoryServiceClient{cc}
+}
+
+func (c *categoryServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CategoryService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CategoryServiceServer is the server API for CategoryService service.
+// All implementations must embed UnimplementedCategoryServiceServer
+// for forward compatibility.
+type CategoryServiceServer interface {
+	CreateCategory(context.Context, *CreateCategoryRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedCategoryServiceServer()
+}
+
+// UnimplementedCategoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCategoryServiceServer struct{}
+
+func (UnimplementedCategoryServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
+func (UnimplementedCategoryServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCategoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CategoryServiceServer will
+// result in compilation errors.
+type UnsafeCategoryServiceServer interface {
+	mustEmbedUnimplementedCategoryServiceServer()
+}
+
+func RegisterCategoryServiceServer(s grpc.ServiceRegistrar, srv CategoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCategoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CategoryService_ServiceDesc, srv)
+}
+
+func _CategoryService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CategoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.CategoryService",
+	HandlerType: (*CategoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCategory",
+			Handler:    _CategoryService_CreateCategory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
 const (
 	DiscountService_GetDiscountByStudentId_FullMethodName = "/finance.DiscountService/GetDiscountByStudentId"
 )
@@ -125,7 +227,10 @@ var DiscountService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	PaymentService_PaymentAdd_FullMethodName = "/finance.PaymentService/PaymentAdd"
+	PaymentService_PaymentAdd_FullMethodName               = "/finance.PaymentService/PaymentAdd"
+	PaymentService_MergeStudent_FullMethodName             = "/finance.PaymentService/MergeStudent"
+	PaymentService_GetStudentLedgerBalances_FullMethodName = "/finance.PaymentService/GetStudentLedgerBalances"
+	PaymentService_GetTenantUsage_FullMethodName           = "/finance.PaymentService/GetTenantUsage"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -135,6 +240,9 @@ const (
 // payment service start
 type PaymentServiceClient interface {
 	PaymentAdd(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	MergeStudent(ctx context.Context, in *MergeStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetStudentLedgerBalances(ctx context.Context, in *GetStudentLedgerBalancesRequest, opts ...grpc.CallOption) (*GetStudentLedgerBalancesResponse, error)
+	GetTenantUsage(ctx context.Context, in *TenantUsageRequest, opts ...grpc.CallOption) (*TenantUsageList, error)
 }
 
 type paymentServiceClient struct {
@@ -155,6 +263,36 @@ func (c *paymentServiceClient) PaymentAdd(ctx context.Context, in *PaymentAddReq
 	return out, nil
 }
 
+func (c *paymentServiceClient) MergeStudent(ctx context.Context, in *MergeStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_MergeStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetStudentLedgerBalances(ctx context.Context, in *GetStudentLedgerBalancesRequest, opts ...grpc.CallOption) (*GetStudentLedgerBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentLedgerBalancesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetStudentLedgerBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetTenantUsage(ctx context.Context, in *TenantUsageRequest, opts ...grpc.CallOption) (*TenantUsageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantUsageList)
+	err := c.cc.Invoke(ctx, PaymentService_GetTenantUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -162,6 +300,9 @@ func (c *paymentServiceClient) PaymentAdd(ctx context.Context, in *PaymentAddReq
 // payment service start
 type PaymentServiceServer interface {
 	PaymentAdd(context.Context, *PaymentAddRequest) (*AbsResponse, error)
+	MergeStudent(context.Context, *MergeStudentRequest) (*AbsResponse, error)
+	GetStudentLedgerBalances(context.Context, *GetStudentLedgerBalancesRequest) (*GetStudentLedgerBalancesResponse, error)
+	GetTenantUsage(context.Context, *TenantUsageRequest) (*TenantUsageList, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -175,6 +316,15 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) PaymentAdd(context.Context, *PaymentAddRequest) (*AbsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PaymentAdd not implemented")
 }
+func (UnimplementedPaymentServiceServer) MergeStudent(context.Context, *MergeStudentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeStudent not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetStudentLedgerBalances(context.Context, *GetStudentLedgerBalancesRequest) (*GetStudentLedgerBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentLedgerBalances not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetTenantUsage(context.Context, *TenantUsageRequest) (*TenantUsageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantUsage not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -214,6 +364,60 @@ func _PaymentService_PaymentAdd_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_MergeStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).MergeStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_MergeStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).MergeStudent(ctx, req.(*MergeStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetStudentLedgerBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentLedgerBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetStudentLedgerBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetStudentLedgerBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetStudentLedgerBalances(ctx, req.(*GetStudentLedgerBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetTenantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetTenantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetTenantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetTenantUsage(ctx, req.(*TenantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +429,18 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PaymentAdd",
 			Handler:    _PaymentService_PaymentAdd_Handler,
 		},
+		{
+			MethodName: "MergeStudent",
+			Handler:    _PaymentService_MergeStudent_Handler,
+		},
+		{
+			MethodName: "GetStudentLedgerBalances",
+			Handler:    _PaymentService_GetStudentLedgerBalances_Handler,
+		},
+		{
+			MethodName: "GetTenantUsage",
+			Handler:    _PaymentService_GetTenantUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
@@ -335,3 +551,109 @@ var TeacherSalaryService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
 }
+
+const (
+	PaymentPlanService_GetStudentPaymentPlans_FullMethodName = "/finance.PaymentPlanService/GetStudentPaymentPlans"
+)
+
+// PaymentPlanServiceClient is the client API for PaymentPlanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// payment plan service start
+type PaymentPlanServiceClient interface {
+	GetStudentPaymentPlans(ctx context.Context, in *StudentPaymentPlansRequest, opts ...grpc.CallOption) (*PaymentPlanStatusList, error)
+}
+
+type paymentPlanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentPlanServiceClient(cc grpc.ClientConnInterface) PaymentPlanServiceClient {
+	return &paymentPlanServiceClient{cc}
+}
+
+func (c *paymentPlanServiceClient) GetStudentPaymentPlans(ctx context.Context, in *StudentPaymentPlansRequest, opts ...grpc.CallOption) (*PaymentPlanStatusList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentPlanStatusList)
+	err := c.cc.Invoke(ctx, PaymentPlanService_GetStudentPaymentPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentPlanServiceServer is the server API for PaymentPlanService service.
+// All implementations must embed UnimplementedPaymentPlanServiceServer
+// for forward compatibility.
+//
+// payment plan service start
+type PaymentPlanServiceServer interface {
+	GetStudentPaymentPlans(context.Context, *StudentPaymentPlansRequest) (*PaymentPlanStatusList, error)
+	mustEmbedUnimplementedPaymentPlanServiceServer()
+}
+
+// UnimplementedPaymentPlanServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentPlanServiceServer struct{}
+
+func (UnimplementedPaymentPlanServiceServer) GetStudentPaymentPlans(context.Context, *StudentPaymentPlansRequest) (*PaymentPlanStatusList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentPaymentPlans not implemented")
+}
+func (UnimplementedPaymentPlanServiceServer) mustEmbedUnimplementedPaymentPlanServiceServer() {}
+func (UnimplementedPaymentPlanServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePaymentPlanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentPlanServiceServer will
+// result in compilation errors.
+type UnsafePaymentPlanServiceServer interface {
+	mustEmbedUnimplementedPaymentPlanServiceServer()
+}
+
+func RegisterPaymentPlanServiceServer(s grpc.ServiceRegistrar, srv PaymentPlanServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentPlanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentPlanService_ServiceDesc, srv)
+}
+
+func _PaymentPlanService_GetStudentPaymentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentPaymentPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentPlanServiceServer).GetStudentPaymentPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentPlanService_GetStudentPaymentPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentPlanServiceServer).GetStudentPaymentPlans(ctx, req.(*StudentPaymentPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentPlanService_ServiceDesc is the grpc.ServiceDesc for PaymentPlanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentPlanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.PaymentPlanService",
+	HandlerType: (*PaymentPlanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStudentPaymentPlans",
+			Handler:    _PaymentPlanService_GetStudentPaymentPlans_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
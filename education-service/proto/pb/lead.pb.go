@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: lead.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateLeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLeadRequest) Reset() {
+	*x = CreateLeadRequest{}
+	mi := &file_lead_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeadRequest) ProtoMessage() {}
+
+func (x *CreateLeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeadRequest.ProtoReflect.Descriptor instead.
+func (*CreateLeadRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateLeadRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type CreateExpectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpectRequest) Reset() {
+	*x = CreateExpectRequest{}
+	mi := &file_lead_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpectRequest) ProtoMessage() {}
+
+func (x *CreateExpectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lead_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpectRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpectRequest) Descriptor() ([]byte, []int) {
+	return file_lead_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateExpectRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+var File_lead_proto protoreflect.FileDescriptor
+
+const file_lead_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"lead.proto\x12\x04lead\x1a\fcommon.proto\")\n" +
+	"\x11CreateLeadRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\"+\n" +
+	"\x13CreateExpectRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title2\x90\x01\n" +
+	"\vLeadService\x12:\n" +
+	"\n" +
+	"CreateLead\x12\x17.lead.CreateLeadRequest\x1a\x13.common.AbsResponse\x12E\n" +
+	"\x0eGetTenantUsage\x12\x1a.common.TenantUsageRequest\x1a\x17.common.TenantUsageList2O\n" +
+	"\rExpectService\x12>\n" +
+	"\fCreateExpect\x12\x19.lead.CreateExpectRequest\x1a\x13.common.AbsResponseB\n" +
+	"Z\bproto/pbb\x06proto3"
+
+var (
+	file_lead_proto_rawDescOnce sync.Once
+	file_lead_proto_rawDescData []byte
+)
+
+func file_lead_proto_rawDescGZIP() []byte {
+	file_lead_proto_rawDescOnce.Do(func() {
+		file_lead_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lead_proto_rawDesc), len(file_lead_proto_rawDesc)))
+	})
+	return file_lead_proto_rawDescData
+}
+
+var file_lead_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lead_proto_goTypes = []any{
+	(*CreateLeadRequest)(nil),   // 0: lead.CreateLeadRequest
+	(*CreateExpectRequest)(nil), // 1: lead.CreateExpectRequest
+	(*TenantUsageRequest)(nil),  // 2: common.TenantUsageRequest
+	(*AbsResponse)(nil),         // 3: common.AbsResponse
+	(*TenantUsageList)(nil),     // 4: common.TenantUsageList
+}
+var file_lead_proto_depIdxs = []int32{
+	0, // 0: lead.LeadService.CreateLead:input_type -> lead.CreateLeadRequest
+	2, // 1: lead.LeadService.GetTenantUsage:input_type -> common.TenantUsageRequest
+	1, // 2: lead.ExpectService.CreateExpect:input_type -> lead.CreateExpectRequest
+	3, // 3: lead.LeadService.CreateLead:output_type -> common.AbsResponse
+	4, // 4: lead.LeadService.GetTenantUsage:output_type -> common.TenantUsageList
+	3, // 5: lead.ExpectService.CreateExpect:output_type -> common.AbsResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_lead_proto_init() }
+func file_lead_proto_init() {
+	if File_lead_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lead_proto_rawDesc), len(file_lead_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_lead_proto_goTypes,
+		DependencyIndexes: file_lead_proto_depIdxs,
+		MessageInfos:      file_lead_proto_msgTypes,
+	}.Build()
+	File_lead_proto = out.File
+	file_lead_proto_goTypes = nil
+	file_lead_proto_depIdxs = nil
+}
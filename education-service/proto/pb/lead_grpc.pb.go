@@ -0,0 +1,261 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: lead.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LeadService_CreateLead_FullMethodName     = "/lead.LeadService/CreateLead"
+	LeadService_GetTenantUsage_FullMethodName = "/lead.LeadService/GetTenantUsage"
+)
+
+// LeadServiceClient is the client API for LeadService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeadServiceClient interface {
+	CreateLead(ctx context.Context, in *CreateLeadRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetTenantUsage(ctx context.Context, in *TenantUsageRequest, opts ...grpc.CallOption) (*TenantUsageList, error)
+}
+
+type leadServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeadServiceClient(cc grpc.ClientConnInterface) LeadServiceClient {
+	return &leadServiceClient{cc}
+}
+
+func (c *leadServiceClient) CreateLead(ctx context.Context, in *CreateLeadRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, LeadService_CreateLead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leadServiceClient) GetTenantUsage(ctx context.Context, in *TenantUsageRequest, opts ...grpc.CallOption) (*TenantUsageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantUsageList)
+	err := c.cc.Invoke(ctx, LeadService_GetTenantUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeadServiceServer is the server API for LeadService service.
+// All implementations must embed UnimplementedLeadServiceServer
+// for forward compatibility.
+type LeadServiceServer interface {
+	CreateLead(context.Context, *CreateLeadRequest) (*AbsResponse, error)
+	GetTenantUsage(context.Context, *TenantUsageRequest) (*TenantUsageList, error)
+	mustEmbedUnimplementedLeadServiceServer()
+}
+
+// UnimplementedLeadServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLeadServiceServer struct{}
+
+func (UnimplementedLeadServiceServer) CreateLead(context.Context, *CreateLeadRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLead not implemented")
+}
+func (UnimplementedLeadServiceServer) GetTenantUsage(context.Context, *TenantUsageRequest) (*TenantUsageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantUsage not implemented")
+}
+func (UnimplementedLeadServiceServer) mustEmbedUnimplementedLeadServiceServer() {}
+func (UnimplementedLeadServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeLeadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeadServiceServer will
+// result in compilation errors.
+type UnsafeLeadServiceServer interface {
+	mustEmbedUnimplementedLeadServiceServer()
+}
+
+func RegisterLeadServiceServer(s grpc.ServiceRegistrar, srv LeadServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLeadServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LeadService_ServiceDesc, srv)
+}
+
+func _LeadService_CreateLead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).CreateLead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_CreateLead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).CreateLead(ctx, req.(*CreateLeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeadService_GetTenantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeadServiceServer).GetTenantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeadService_GetTenantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeadServiceServer).GetTenantUsage(ctx, req.(*TenantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LeadService_ServiceDesc is the grpc.ServiceDesc for LeadService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LeadService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lead.LeadService",
+	HandlerType: (*LeadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLead",
+			Handler:    _LeadService_CreateLead_Handler,
+		},
+		{
+			MethodName: "GetTenantUsage",
+			Handler:    _LeadService_GetTenantUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lead.proto",
+}
+
+const (
+	ExpectService_CreateExpect_FullMethodName = "/lead.ExpectService/CreateExpect"
+)
+
+// ExpectServiceClient is the client API for ExpectService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExpectServiceClient interface {
+	CreateExpect(ctx context.Context, in *CreateExpectRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type expectServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExpectServiceClient(cc grpc.ClientConnInterface) ExpectServiceClient {
+	return &expectServiceClient{cc}
+}
+
+func (c *expectServiceClient) CreateExpect(ctx context.Context, in *CreateExpectRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpectService_CreateExpect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExpectServiceServer is the server API for ExpectService service.
+// All implementations must embed UnimplementedExpectServiceServer
+// for forward compatibility.
+type ExpectServiceServer interface {
+	CreateExpect(context.Context, *CreateExpectRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedExpectServiceServer()
+}
+
+// UnimplementedExpectServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExpectServiceServer struct{}
+
+func (UnimplementedExpectServiceServer) CreateExpect(context.Context, *CreateExpectRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExpect not implemented")
+}
+func (UnimplementedExpectServiceServer) mustEmbedUnimplementedExpectServiceServer() {}
+func (UnimplementedExpectServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeExpectServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExpectServiceServer will
+// result in compilation errors.
+type UnsafeExpectServiceServer interface {
+	mustEmbedUnimplementedExpectServiceServer()
+}
+
+func RegisterExpectServiceServer(s grpc.ServiceRegistrar, srv ExpectServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExpectServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExpectService_ServiceDesc, srv)
+}
+
+func _ExpectService_CreateExpect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExpectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpectServiceServer).CreateExpect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpectService_CreateExpect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpectServiceServer).CreateExpect(ctx, req.(*CreateExpectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExpectService_ServiceDesc is the grpc.ServiceDesc for ExpectService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExpectService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lead.ExpectService",
+	HandlerType: (*ExpectServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateExpect",
+			Handler:    _ExpectService_CreateExpect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lead.proto",
+}
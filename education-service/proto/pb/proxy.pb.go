@@ -21,6 +21,98 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,5,opt,name=birthDate,proto3" json:"birthDate,omitempty"`
+	Gender        bool                   `protobuf:"varint,6,opt,name=gender,proto3" json:"gender,omitempty"`
+	Id            string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_proxy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateUserRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *CreateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type GetUserByCompanyIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
@@ -31,7 +123,7 @@ type GetUserByCompanyIdRequest struct {
 
 func (x *GetUserByCompanyIdRequest) Reset() {
 	*x = GetUserByCompanyIdRequest{}
-	mi := &file_proxy_proto_msgTypes[0]
+	mi := &file_proxy_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +135,7 @@ func (x *GetUserByCompanyIdRequest) String() string {
 func (*GetUserByCompanyIdRequest) ProtoMessage() {}
 
 func (x *GetUserByCompanyIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[0]
+	mi := &file_proxy_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +148,7 @@ func (x *GetUserByCompanyIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByCompanyIdRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByCompanyIdRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{0}
+	return file_proxy_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *GetUserByCompanyIdRequest) GetRole() string {
@@ -82,7 +174,7 @@ type GetUserByCompanyIdResponse struct {
 
 func (x *GetUserByCompanyIdResponse) Reset() {
 	*x = GetUserByCompanyIdResponse{}
-	mi := &file_proxy_proto_msgTypes[1]
+	mi := &file_proxy_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +186,7 @@ func (x *GetUserByCompanyIdResponse) String() string {
 func (*GetUserByCompanyIdResponse) ProtoMessage() {}
 
 func (x *GetUserByCompanyIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[1]
+	mi := &file_proxy_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +199,7 @@ func (x *GetUserByCompanyIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByCompanyIdResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByCompanyIdResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{1}
+	return file_proxy_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetUserByCompanyIdResponse) GetUserId() string {
@@ -126,7 +218,7 @@ type UserAbsRequest struct {
 
 func (x *UserAbsRequest) Reset() {
 	*x = UserAbsRequest{}
-	mi := &file_proxy_proto_msgTypes[2]
+	mi := &file_proxy_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +230,7 @@ func (x *UserAbsRequest) String() string {
 func (*UserAbsRequest) ProtoMessage() {}
 
 func (x *UserAbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[2]
+	mi := &file_proxy_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +243,7 @@ func (x *UserAbsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAbsRequest.ProtoReflect.Descriptor instead.
 func (*UserAbsRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{2}
+	return file_proxy_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UserAbsRequest) GetUserId() string {
@@ -177,7 +269,7 @@ type GetUserByIdResponse struct {
 
 func (x *GetUserByIdResponse) Reset() {
 	*x = GetUserByIdResponse{}
-	mi := &file_proxy_proto_msgTypes[3]
+	mi := &file_proxy_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -189,7 +281,7 @@ func (x *GetUserByIdResponse) String() string {
 func (*GetUserByIdResponse) ProtoMessage() {}
 
 func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[3]
+	mi := &file_proxy_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,7 +294,7 @@ func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{3}
+	return file_proxy_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetUserByIdResponse) GetId() string {
@@ -265,7 +357,15 @@ var File_proxy_proto protoreflect.FileDescriptor
 
 const file_proxy_proto_rawDesc = "" +
 	"\n" +
-	"\vproxy.proto\x12\x04user\"M\n" +
+	"\vproxy.proto\x12\x04user\x1a\fcommon.proto\"\xc7\x01\n" +
+	"\x11CreateUserRequest\x12\x1a\n" +
+	"\bfullName\x18\x01 \x01(\tR\bfullName\x12 \n" +
+	"\vphoneNumber\x18\x02 \x01(\tR\vphoneNumber\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1c\n" +
+	"\tbirthDate\x18\x05 \x01(\tR\tbirthDate\x12\x16\n" +
+	"\x06gender\x18\x06 \x01(\bR\x06gender\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\"M\n" +
 	"\x19GetUserByCompanyIdRequest\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1c\n" +
 	"\tcompanyId\x18\x02 \x01(\tR\tcompanyId\"4\n" +
@@ -282,10 +382,14 @@ const file_proxy_proto_rawDesc = "" +
 	"\x06gender\x18\x06 \x01(\bR\x06gender\x12\x1d\n" +
 	"\n" +
 	"is_deleted\x18\a \x01(\bR\tisDeleted\x12\x1c\n" +
-	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt2\xa6\x01\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt2\xe6\x02\n" +
 	"\vUserService\x12>\n" +
 	"\vGetUserById\x12\x14.user.UserAbsRequest\x1a\x19.user.GetUserByIdResponse\x12W\n" +
-	"\x12GetUserByCompanyId\x12\x1f.user.GetUserByCompanyIdRequest\x1a .user.GetUserByCompanyIdResponseB\n" +
+	"\x12GetUserByCompanyId\x12\x1f.user.GetUserByCompanyIdRequest\x1a .user.GetUserByCompanyIdResponse\x12:\n" +
+	"\n" +
+	"CreateUser\x12\x17.user.CreateUserRequest\x1a\x13.common.AbsResponse\x12;\n" +
+	"\x0eDeleteUserById\x12\x14.user.UserAbsRequest\x1a\x13.common.AbsResponse\x12E\n" +
+	"\x0eGetTenantUsage\x12\x1a.common.TenantUsageRequest\x1a\x17.common.TenantUsageListB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -300,20 +404,30 @@ func file_proxy_proto_rawDescGZIP() []byte {
 	return file_proxy_proto_rawDescData
 }
 
-var file_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_proxy_proto_goTypes = []any{
-	(*GetUserByCompanyIdRequest)(nil),  // 0: user.GetUserByCompanyIdRequest
-	(*GetUserByCompanyIdResponse)(nil), // 1: user.GetUserByCompanyIdResponse
-	(*UserAbsRequest)(nil),             // 2: user.UserAbsRequest
-	(*GetUserByIdResponse)(nil),        // 3: user.GetUserByIdResponse
+	(*CreateUserRequest)(nil),          // 0: user.CreateUserRequest
+	(*GetUserByCompanyIdRequest)(nil),  // 1: user.GetUserByCompanyIdRequest
+	(*GetUserByCompanyIdResponse)(nil), // 2: user.GetUserByCompanyIdResponse
+	(*UserAbsRequest)(nil),             // 3: user.UserAbsRequest
+	(*GetUserByIdResponse)(nil),        // 4: user.GetUserByIdResponse
+	(*TenantUsageRequest)(nil),         // 5: common.TenantUsageRequest
+	(*AbsResponse)(nil),                // 6: common.AbsResponse
+	(*TenantUsageList)(nil),            // 7: common.TenantUsageList
 }
 var file_proxy_proto_depIdxs = []int32{
-	2, // 0: user.UserService.GetUserById:input_type -> user.UserAbsRequest
-	0, // 1: user.UserService.GetUserByCompanyId:input_type -> user.GetUserByCompanyIdRequest
-	3, // 2: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
-	1, // 3: user.UserService.GetUserByCompanyId:output_type -> user.GetUserByCompanyIdResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	3, // 0: user.UserService.GetUserById:input_type -> user.UserAbsRequest
+	1, // 1: user.UserService.GetUserByCompanyId:input_type -> user.GetUserByCompanyIdRequest
+	0, // 2: user.UserService.CreateUser:input_type -> user.CreateUserRequest
+	3, // 3: user.UserService.DeleteUserById:input_type -> user.UserAbsRequest
+	5, // 4: user.UserService.GetTenantUsage:input_type -> common.TenantUsageRequest
+	4, // 5: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
+	2, // 6: user.UserService.GetUserByCompanyId:output_type -> user.GetUserByCompanyIdResponse
+	6, // 7: user.UserService.CreateUser:output_type -> common.AbsResponse
+	6, // 8: user.UserService.DeleteUserById:output_type -> common.AbsResponse
+	7, // 9: user.UserService.GetTenantUsage:output_type -> common.TenantUsageList
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -324,13 +438,14 @@ func file_proxy_proto_init() {
 	if File_proxy_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proxy_proto_rawDesc), len(file_proxy_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	UserService_GetUserById_FullMethodName        = "/user.UserService/GetUserById"
 	UserService_GetUserByCompanyId_FullMethodName = "/user.UserService/GetUserByCompanyId"
+	UserService_CreateUser_FullMethodName         = "/user.UserService/CreateUser"
+	UserService_DeleteUserById_FullMethodName     = "/user.UserService/DeleteUserById"
+	UserService_GetTenantUsage_FullMethodName     = "/user.UserService/GetTenantUsage"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -29,6 +32,9 @@ const (
 type UserServiceClient interface {
 	GetUserById(ctx context.Context, in *UserAbsRequest, opts ...grpc.CallOption) (*GetUserByIdResponse, error)
 	GetUserByCompanyId(ctx context.Context, in *GetUserByCompanyIdRequest, opts ...grpc.CallOption) (*GetUserByCompanyIdResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteUserById(ctx context.Context, in *UserAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetTenantUsage(ctx context.Context, in *TenantUsageRequest, opts ...grpc.CallOption) (*TenantUsageList, error)
 }
 
 type userServiceClient struct {
@@ -59,12 +65,45 @@ func (c *userServiceClient) GetUserByCompanyId(ctx context.Context, in *GetUserB
 	return out, nil
 }
 
+func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserById(ctx context.Context, in *UserAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTenantUsage(ctx context.Context, in *TenantUsageRequest, opts ...grpc.CallOption) (*TenantUsageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantUsageList)
+	err := c.cc.Invoke(ctx, UserService_GetTenantUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	GetUserById(context.Context, *UserAbsRequest) (*GetUserByIdResponse, error)
 	GetUserByCompanyId(context.Context, *GetUserByCompanyIdRequest) (*GetUserByCompanyIdResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*AbsResponse, error)
+	DeleteUserById(context.Context, *UserAbsRequest) (*AbsResponse, error)
+	GetTenantUsage(context.Context, *TenantUsageRequest) (*TenantUsageList, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -81,6 +120,15 @@ func (UnimplementedUserServiceServer) GetUserById(context.Context, *UserAbsReque
 func (UnimplementedUserServiceServer) GetUserByCompanyId(context.Context, *GetUserByCompanyIdRequest) (*GetUserByCompanyIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByCompanyId not implemented")
 }
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserById(context.Context, *UserAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserById not implemented")
+}
+func (UnimplementedUserServiceServer) GetTenantUsage(context.Context, *TenantUsageRequest) (*TenantUsageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantUsage not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -138,6 +186,60 @@ func _UserService_GetUserByCompanyId_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserById(ctx, req.(*UserAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTenantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTenantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTenantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTenantUsage(ctx, req.(*TenantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +255,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByCompanyId",
 			Handler:    _UserService_GetUserByCompanyId_Handler,
 		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUserById",
+			Handler:    _UserService_DeleteUserById_Handler,
+		},
+		{
+			MethodName: "GetTenantUsage",
+			Handler:    _UserService_GetTenantUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
@@ -1,7 +1,15 @@
 package main
 
-import "finance-service/internal/server"
+import (
+	"finance-service/internal/server"
+	"flag"
+)
 
 func main() {
+	flag.Parse()
+	if flag.Arg(0) == "migrate" {
+		server.RunMigrate(flag.Args()[1:])
+		return
+	}
 	server.RunServer()
 }
@@ -1,59 +1,72 @@
 package config
 
-import (
-	"gopkg.in/yaml.v2"
-	"io/ioutil"
-	"os"
-)
-
 type ServerConfig struct {
-	Port int `yaml:"port"`
+	Port int `yaml:"port" env:"SERVER_PORT,required"`
+}
+
+type MetricsConfig struct {
+	Port int `yaml:"port" env:"METRICS_PORT,required"`
+}
+
+// LogConfig sets the lowest level logged: debug, info, warn or error.
+type LogConfig struct {
+	Level string `yaml:"level" env:"LOG_LEVEL"`
+}
+
+// TracingConfig selects where spans are sent: otlp ships them to the
+// collector at Endpoint, stdout prints them for local runs, none disables it.
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER"`
+	Endpoint    string  `yaml:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	SampleRatio float64 `yaml:"sampleRatio" env:"TRACING_SAMPLE_RATIO"`
 }
 
 type DatabaseConfig struct {
-	Host     string `yaml:"host"`
-	Port     int    `yaml:"port"`
-	User     string `yaml:"user"`
-	Password string `yaml:"password"`
-	DBName   string `yaml:"dbname"`
-	SSLMode  string `yaml:"sslmode"`
-	Action   string `yaml:"action"`
+	Host     string `yaml:"host" env:"DB_HOST,required"`
+	Port     int    `yaml:"port" env:"DB_PORT,required"`
+	User     string `yaml:"user" env:"DB_USER,required"`
+	Password string `yaml:"password" env:"DB_PASSWORD,required,secret"`
+	DBName   string `yaml:"dbname" env:"DB_NAME,required"`
+	SSLMode  string `yaml:"sslmode" env:"DB_SSLMODE"`
+	Action   string `yaml:"action" env:"DB_ACTION"`
 }
 
 type GrpcConfig struct {
 	UserService struct {
-		Address string `yaml:"address"`
+		Address string `yaml:"address" env:"USER_SERVICE_ADDRESS,required"`
 	} `yaml:"userService"`
 	EducationService struct {
-		Address string `yaml:"address"`
+		Address string `yaml:"address" env:"EDUCATION_SERVICE_ADDRESS,required"`
 	} `yaml:"educationService"`
 	FinanceService struct {
-		Address string `yaml:"address"`
+		Address string `yaml:"address" env:"FINANCE_SERVICE_ADDRESS"`
 	} `yaml:"financeService"`
 }
 
 type Config struct {
 	Server   ServerConfig   `yaml:"server"`
 	Database DatabaseConfig `yaml:"database"`
+	Metrics  MetricsConfig  `yaml:"metrics"`
+	Tracing  TracingConfig  `yaml:"tracing"`
+	Log      LogConfig      `yaml:"log"`
 	Grpc     GrpcConfig     `yaml:"grpc"`
 }
 
-func LoadConfig() (*Config, error) {
-	file, err := os.Open("config/config.yaml")
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
+// String masks the secrets, the config is safe to log.
+func (c Config) String() string {
+	return redact(c)
+}
 
-	bytes, err := ioutil.ReadAll(file)
-	if err != nil {
-		return nil, err
+func LoadConfig() (*Config, error) {
+	config := Config{
+		Server:   ServerConfig{Port: 8080},
+		Metrics:  MetricsConfig{Port: 2112},
+		Tracing:  TracingConfig{Exporter: "none", SampleRatio: 1},
+		Log:      LogConfig{Level: "info"},
+		Database: DatabaseConfig{Port: 5432, SSLMode: "disable", Action: "no"},
 	}
-
-	var config Config
-	if err := yaml.Unmarshal(bytes, &config); err != nil {
+	if err := load(&config); err != nil {
 		return nil, err
 	}
-
 	return &config, nil
 }
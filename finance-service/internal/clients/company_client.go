@@ -0,0 +1,24 @@
+package clients
+
+import (
+	"context"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/metadata"
+)
+
+type CompanyClient struct {
+	client pb.CompanyServiceClient
+}
+
+func NewCompanyClient(addr string) (*CompanyClient, error) {
+	conn, err := Dial("education-service", addr)
+	if err != nil {
+		return nil, err
+	}
+	return &CompanyClient{client: pb.NewCompanyServiceClient(conn)}, nil
+}
+
+func (cc *CompanyClient) GetSubscription(ctx context.Context, companyId string) (*pb.CompanySubscription, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "company_id", companyId)
+	return cc.client.GetSubscription(ctx, &pb.GetSubscriptionRequest{})
+}
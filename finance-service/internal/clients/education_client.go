@@ -2,8 +2,9 @@ package clients
 
 import (
 	"context"
+	"finance-service/internal/money"
 	"finance-service/proto/pb"
-	"google.golang.org/grpc"
+	"github.com/shopspring/decimal"
 )
 
 type EducationClient struct {
@@ -12,7 +13,7 @@ type EducationClient struct {
 }
 
 func NewEducationClient(addr string) (*EducationClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("education-service", addr)
 	if err != nil {
 		return nil, err
 	}
@@ -22,12 +23,16 @@ func NewEducationClient(addr string) (*EducationClient, error) {
 	return &EducationClient{studentClient: studentClient, groupClient: groupClient}, nil
 }
 
-func (ec *EducationClient) GetStudentById(ctx context.Context, studentId string) (string, string, float64, error) {
+func (ec *EducationClient) GetStudentById(ctx context.Context, studentId string) (string, string, decimal.Decimal, error) {
 	student, err := ec.studentClient.GetStudentById(ctx, &pb.NoteStudentByAbsRequest{Id: studentId})
 	if err != nil {
-		return "", "", 0, err
+		return "", "", money.Zero, err
 	}
-	return student.Name, student.Phone, student.Balance, nil
+	balance, err := money.Parse(student.Balance)
+	if err != nil {
+		return "", "", money.Zero, err
+	}
+	return student.Name, student.Phone, balance, nil
 }
 
 func (ec *EducationClient) GetStudentsByGroupId(ctx context.Context, groupId string) (*pb.GetStudentsByGroupIdResponse, error) {
@@ -73,15 +78,26 @@ func (ec *EducationClient) ChangeUserBalanceHistoryByDebit(ctx context.Context,
 	})
 }
 
+func (ec *EducationClient) ApplyBalanceChange(ctx context.Context, req *pb.ChangeUserBalanceHistoryRequest) error {
+	_, err := ec.studentClient.ChangeUserBalanceHistory(ctx, req)
+	return err
+}
+
+func (ec *EducationClient) ApplyBalanceChangeByDebit(ctx context.Context, req *pb.ChangeUserBalanceHistoryByDebitRequest) error {
+	_, err := ec.studentClient.ChangeUserBalanceHistoryByDebit(ctx, req)
+	return err
+}
+
 func (ec *EducationClient) GetGroupsAndCommentsByStudentId(ctx context.Context, studentId string) (*pb.GetGroupsByStudentResponse, error) {
 	return ec.groupClient.GetGroupsByStudentId(ctx, &pb.StudentIdRequest{StudentId: studentId})
 }
 
-func (ec *EducationClient) CalculateDiscountSumma(ctx context.Context, groupId string, studentId string, discountPrice string, startDate string, endDate, paymentDate, studentActivationDate string) (string, error) {
+func (ec *EducationClient) CalculateDiscountSumma(ctx context.Context, groupId string, studentId string, discountPrice, discountType string, startDate string, endDate, paymentDate, studentActivationDate string) (string, error) {
 	resp, err := ec.studentClient.CalculateDiscountSumma(ctx, &pb.CalculateDiscountSummaRequest{
 		GroupId:       groupId,
 		StudentId:     studentId,
 		DiscountPrice: discountPrice,
+		DiscountType:  discountType,
 		StartDate:     startDate,
 		EndDate:       endDate,
 		PaymentDate:   paymentDate,
@@ -3,7 +3,6 @@ package clients
 import (
 	"context"
 	"finance-service/proto/pb"
-	"google.golang.org/grpc"
 )
 
 type UserClient struct {
@@ -11,7 +10,7 @@ type UserClient struct {
 }
 
 func NewUserClient(addr string) (*UserClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("user-service", addr)
 	if err != nil {
 		return nil, err
 	}
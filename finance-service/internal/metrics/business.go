@@ -0,0 +1,16 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var PaymentsAdded = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "finance_payments_added_total",
+	Help: "Student payments saved, by method and type (ADD, REFUND, TAKE_OFF).",
+}, []string{"method", "type"})
+
+var BalanceEventsFailed = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "finance_balance_events_failed_total",
+	Help: "Balance events education-service rejected, they stay FAILED until retried.",
+})
@@ -0,0 +1,71 @@
+// Package money is the arithmetic on sums of money. Amounts are exact
+// decimals: they travel in protos as strings and are stored in numeric
+// columns.
+//
+// Amounts are kept in tiyin, two decimal places, and rounded half away from
+// zero: 0.125 is 0.13 and -0.125 is -0.13.
+package money
+
+import (
+	"fmt"
+	"github.com/shopspring/decimal"
+	"strings"
+)
+
+// Places is the number of decimal places an amount is kept in.
+const Places = 2
+
+var (
+	Zero    = decimal.Zero
+	hundred = decimal.NewFromInt(100)
+)
+
+// Parse reads an amount sent as a string, like the sum of a payment.
+func Parse(s string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(strings.TrimSpace(s))
+	if err != nil {
+		return Zero, fmt.Errorf("invalid amount %q", s)
+	}
+	return Round(d), nil
+}
+
+// Stored reads an amount that came from Format or from a numeric column, so
+// it cannot be invalid; one that is reads as zero.
+func Stored(s string) decimal.Decimal {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return Zero
+	}
+	return d
+}
+
+// Format writes an amount with exactly two decimal places.
+func Format(d decimal.Decimal) string {
+	return d.StringFixed(Places)
+}
+
+// Round rounds an amount to tiyin.
+func Round(d decimal.Decimal) decimal.Decimal {
+	return d.Round(Places)
+}
+
+// Percent is percent of an amount, rounded to tiyin.
+func Percent(amount, percent decimal.Decimal) decimal.Decimal {
+	return Round(amount.Mul(percent).Div(hundred))
+}
+
+// Split divides a total in n parts of whole tiyin. Every part but the last
+// is rounded down and the last one takes the remainder, so the parts always
+// add up to the total.
+func Split(total decimal.Decimal, n int) []decimal.Decimal {
+	if n < 1 {
+		return nil
+	}
+	each := total.Div(decimal.NewFromInt(int64(n))).RoundFloor(Places)
+	parts := make([]decimal.Decimal, n)
+	for i := range parts[:n-1] {
+		parts[i] = each
+	}
+	parts[n-1] = total.Sub(each.Mul(decimal.NewFromInt(int64(n - 1))))
+	return parts
+}
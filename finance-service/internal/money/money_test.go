@@ -0,0 +1,112 @@
+package money
+
+import (
+	"github.com/shopspring/decimal"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"0.125", "0.13"},
+		{"-0.125", "-0.13"},
+		// half away from zero, not to even: banker's rounding gives 0.12
+		// and 2.64
+		{"0.115", "0.12"},
+		{"0.125000", "0.13"},
+		{"2.645", "2.65"},
+		{"0.124999", "0.12"},
+		{"100", "100.00"},
+		{"0.005", "0.01"},
+		{"-0.005", "-0.01"},
+	}
+	for _, tt := range tests {
+		if got := Format(Round(decimal.RequireFromString(tt.in))); got != tt.want {
+			t.Errorf("Round(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"150000", "150000.00", false},
+		{" 99.995 ", "100.00", false},
+		{"-12.345", "-12.35", false},
+		{"1e3", "1000.00", false},
+		{"", "", true},
+		{"12,5", "", true},
+		{"abc", "", true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && Format(got) != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.in, Format(got), tt.want)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		amount, percent, want string
+	}{
+		{"500000", "10", "50000.00"},
+		{"333333", "15", "49999.95"},
+		// 12500.125, banker's rounding gives 12500.12
+		{"100001", "12.5", "12500.13"},
+		{"0.99", "50", "0.50"},
+		{"0.01", "50", "0.01"},
+		{"77777.77", "33.33", "25923.33"},
+		{"100", "0", "0.00"},
+		{"-0.99", "50", "-0.50"},
+	}
+	for _, tt := range tests {
+		got := Percent(decimal.RequireFromString(tt.amount), decimal.RequireFromString(tt.percent))
+		if Format(got) != tt.want {
+			t.Errorf("Percent(%s, %s) = %s, want %s", tt.amount, tt.percent, Format(got), tt.want)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		total string
+		n     int
+		want  []string
+	}{
+		{"100", 3, []string{"33.33", "33.33", "33.34"}},
+		{"1000000", 12, []string{"83333.33", "83333.33", "83333.33", "83333.33", "83333.33", "83333.33",
+			"83333.33", "83333.33", "83333.33", "83333.33", "83333.33", "83333.37"}},
+		{"0.05", 3, []string{"0.01", "0.01", "0.03"}},
+		{"0.01", 2, []string{"0.00", "0.01"}},
+		{"90", 3, []string{"30.00", "30.00", "30.00"}},
+		{"50.5", 1, []string{"50.50"}},
+		{"100", 0, nil},
+	}
+	for _, tt := range tests {
+		total := decimal.RequireFromString(tt.total)
+		parts := Split(total, tt.n)
+		if len(parts) != len(tt.want) {
+			t.Errorf("Split(%s, %d) has %d parts, want %d", tt.total, tt.n, len(parts), len(tt.want))
+			continue
+		}
+		sum := Zero
+		for i, part := range parts {
+			if Format(part) != tt.want[i] {
+				t.Errorf("Split(%s, %d)[%d] = %s, want %s", tt.total, tt.n, i, Format(part), tt.want[i])
+			}
+			sum = sum.Add(part)
+		}
+		if len(parts) > 0 && !sum.Equal(total) {
+			t.Errorf("Split(%s, %d) adds up to %s", tt.total, tt.n, Format(sum))
+		}
+	}
+}
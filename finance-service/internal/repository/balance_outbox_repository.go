@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"finance-service/internal/clients"
+	"finance-service/internal/metrics"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"log/slog"
+	"time"
+)
+
+const (
+	balanceEventChange        = "CHANGE_BALANCE"
+	balanceEventChangeByDebit = "CHANGE_BALANCE_BY_DEBIT"
+	balanceOutboxBatchSize    = 50
+	balanceOutboxMaxDelay     = 10 * time.Minute
+)
+
+// BalanceOutbox delivers student balance changes to education-service. The
+// events are written in the same transaction as the payment, so a payment and
+// its balance change either both exist or neither does; the outbox id travels
+// as eventId and education-service applies every event only once.
+type BalanceOutbox struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+	wake            chan struct{}
+}
+
+func NewBalanceOutbox(db *sql.DB, educationClient *clients.EducationClient) *BalanceOutbox {
+	return &BalanceOutbox{db: db, educationClient: educationClient, wake: make(chan struct{}, 1)}
+}
+
+func enqueueBalanceChange(tx *sql.Tx, companyId string, req *pb.ChangeUserBalanceHistoryRequest) error {
+	req.EventId = uuid.New().String()
+	return insertBalanceEvent(tx, companyId, req.EventId, req.StudentId, balanceEventChange, req)
+}
+
+func enqueueBalanceChangeByDebit(tx *sql.Tx, companyId string, req *pb.ChangeUserBalanceHistoryByDebitRequest) error {
+	req.EventId = uuid.New().String()
+	return insertBalanceEvent(tx, companyId, req.EventId, req.StudentId, balanceEventChangeByDebit, req)
+}
+
+func insertBalanceEvent(tx *sql.Tx, companyId, eventId, studentId, eventType string, req proto.Message) error {
+	payload, err := protojson.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to encode balance event: %v", err)
+	}
+	_, err = tx.Exec(`INSERT INTO balance_outbox(id, company_id, student_id, event_type, payload) values ($1, $2, $3, $4, $5)`,
+		eventId, companyId, studentId, eventType, payload)
+	if err != nil {
+		return fmt.Errorf("failed to queue balance event: %v", err)
+	}
+	return nil
+}
+
+// Wake asks the relay to deliver right away instead of waiting for the next
+// tick. It never blocks.
+func (o *BalanceOutbox) Wake() {
+	if o == nil {
+		return
+	}
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run delivers pending events every interval, or sooner when woken, until ctx
+// is cancelled.
+func (o *BalanceOutbox) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-o.wake:
+		}
+		// a batch that already started is finished even when stopping
+		if err := o.dispatch(context.WithoutCancel(ctx)); err != nil {
+			slog.Error("balance outbox dispatch failed", "error", err)
+		}
+	}
+}
+
+// dispatch sends one batch. Only the oldest pending event of each student is
+// picked so the balance history in education-service keeps the payment order.
+// Transport errors are retried with a growing delay for as long as it takes;
+// events education-service rejects as invalid are marked FAILED, logged as
+// errors and counted in finance_balance_events_failed_total; they wait for
+// RetryBalanceEvents.
+func (o *BalanceOutbox) dispatch(ctx context.Context) error {
+	tx, err := o.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	rows, err := tx.Query(`
+		SELECT o.id, o.company_id, o.event_type, o.payload, o.attempts FROM balance_outbox o
+		WHERE o.status='PENDING' and o.next_attempt_at <= now()
+		  and not exists(SELECT 1 FROM balance_outbox e where e.student_id = o.student_id and e.status='PENDING' and e.created_at < o.created_at)
+		ORDER BY o.created_at LIMIT $1 FOR UPDATE SKIP LOCKED`, balanceOutboxBatchSize)
+	if err != nil {
+		return err
+	}
+	type outboxRow struct {
+		id, companyId, eventType string
+		payload                  []byte
+		attempts                 int
+	}
+	var batch []outboxRow
+	for rows.Next() {
+		var row outboxRow
+		if err = rows.Scan(&row.id, &row.companyId, &row.eventType, &row.payload, &row.attempts); err != nil {
+			rows.Close()
+			return err
+		}
+		batch = append(batch, row)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, row := range batch {
+		err = o.deliver(ctx, row.companyId, row.eventType, row.payload)
+		if err == nil {
+			_, err = tx.Exec(`UPDATE balance_outbox SET status='SENT', attempts=attempts+1, sent_at=now(), last_error=null where id=$1`, row.id)
+		} else {
+			attempts := row.attempts + 1
+			nextStatus := "PENDING"
+			if code := status.Code(err); code == codes.InvalidArgument || code == codes.NotFound {
+				nextStatus = "FAILED"
+			}
+			delay := time.Duration(attempts*attempts) * 10 * time.Second
+			if delay > balanceOutboxMaxDelay {
+				delay = balanceOutboxMaxDelay
+			}
+			if nextStatus == "FAILED" {
+				metrics.BalanceEventsFailed.Inc()
+				slog.Error("balance event rejected, student balance is out of sync until it is retried", "event_id", row.id, "company_id", row.companyId, "event_type", row.eventType, "error", err)
+			} else {
+				slog.Warn("balance event delivery failed", "event_id", row.id, "attempt", attempts, "status", nextStatus, "error", err)
+			}
+			_, err = tx.Exec(`UPDATE balance_outbox SET status=$1, attempts=$2, last_error=$3, next_attempt_at=$4 where id=$5`,
+				nextStatus, attempts, err.Error(), time.Now().Add(delay), row.id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (o *BalanceOutbox) deliver(ctx context.Context, companyId, eventType string, payload []byte) error {
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	switch eventType {
+	case balanceEventChange:
+		req := pb.ChangeUserBalanceHistoryRequest{}
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return status.Errorf(codes.InvalidArgument, "broken balance event payload: %v", err)
+		}
+		return o.educationClient.ApplyBalanceChange(ctx, &req)
+	case balanceEventChangeByDebit:
+		req := pb.ChangeUserBalanceHistoryByDebitRequest{}
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return status.Errorf(codes.InvalidArgument, "broken balance event payload: %v", err)
+		}
+		return o.educationClient.ApplyBalanceChangeByDebit(ctx, &req)
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown balance event type %s", eventType)
+	}
+}
@@ -0,0 +1,505 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"finance-service/internal/money"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	CurrencyUZS = "UZS"
+	CurrencyUSD = "USD"
+
+	ShiftOpen   = "OPEN"
+	ShiftClosed = "CLOSED"
+)
+
+var (
+	paymentMethods = map[string]bool{"CASH": true, "CLICK": true, "PAYME": true, "CARD": true, "TRANSFER": true}
+	accountKinds   = map[string]bool{"CASH_DESK": true, "BANK": true, "CARD_TERMINAL": true, "ONLINE": true}
+	currencies     = map[string]bool{CurrencyUZS: true, CurrencyUSD: true}
+)
+
+// defaultAccounts are created the first time a company takes money with a
+// method without naming an account.
+var defaultAccounts = map[string]struct{ name, kind string }{
+	"CASH":     {"Kassa", "CASH_DESK"},
+	"CLICK":    {"Click", "ONLINE"},
+	"PAYME":    {"Payme", "ONLINE"},
+	"CARD":     {"Terminal", "CARD_TERMINAL"},
+	"TRANSFER": {"Bank", "BANK"},
+}
+
+// accountMovements is the money that went through accounts, in the account's
+// currency: payments in, returned payments and expenses out.
+const accountMovements = `
+SELECT account_id, coalesce(original_amount, amount) AS amount, created_at FROM student_payments WHERE account_id IS NOT NULL
+UNION ALL
+SELECT account_id, coalesce(original_amount, amount), created_at FROM sponsor_payments WHERE account_id IS NOT NULL
+UNION ALL
+SELECT account_id, -coalesce(original_sum, sum), created_at FROM expense WHERE account_id IS NOT NULL`
+
+type CashRepository struct {
+	db *sql.DB
+}
+
+func NewCashRepository(db *sql.DB) *CashRepository {
+	return &CashRepository{db: db}
+}
+
+// execQuerier is a *sql.DB or a *sql.Tx.
+type execQuerier interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// posting is the account the money of a payment or an expense goes through.
+// The method and currency are the account's; original is what was paid in
+// that currency and amount the same in UZS.
+type posting struct {
+	accountId string
+	method    string
+	currency  string
+	original  decimal.Decimal
+	rate      decimal.Decimal
+	amount    decimal.Decimal
+}
+
+// postTo resolves the account of a payment or an expense: the one given, or
+// the company's default one for the method, created on first use. A sum in
+// USD is converted at the rate recorded on or before the date.
+func postTo(q execQuerier, companyId, accountId, method string, sum decimal.Decimal, date string) (*posting, error) {
+	if accountId == "" {
+		if !paymentMethods[method] {
+			return nil, status.Errorf(codes.InvalidArgument, "method must be CASH, CLICK, PAYME, CARD or TRANSFER, got %q", method)
+		}
+		defaults := defaultAccounts[method]
+		_, err := q.Exec(`INSERT INTO cash_account (id, company_id, name, kind, method, is_default) VALUES ($1, $2, $3, $4, $5, true)
+ON CONFLICT DO NOTHING`, uuid.New(), companyId, defaults.name, defaults.kind, method)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create default cash account: %v", err)
+		}
+		if err = q.QueryRow(`SELECT id FROM cash_account WHERE company_id = $1 AND method = $2 AND is_default`, companyId, method).Scan(&accountId); err != nil {
+			return nil, fmt.Errorf("failed to find default cash account: %v", err)
+		}
+	}
+	p := &posting{accountId: accountId, original: money.Round(sum), rate: decimal.NewFromInt(1)}
+	var active, requiresShift, shiftOpen bool
+	err := q.QueryRow(`SELECT a.method, a.currency, a.is_active, a.requires_shift,
+       exists(SELECT 1 FROM cash_shift s WHERE s.account_id = a.id AND s.status = 'OPEN')
+FROM cash_account a WHERE a.id::text = $1 AND a.company_id = $2`, accountId, companyId).
+		Scan(&p.method, &p.currency, &active, &requiresShift, &shiftOpen)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "cash account %s not found", accountId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cash account: %v", err)
+	}
+	switch {
+	case !active:
+		return nil, status.Error(codes.FailedPrecondition, "the cash account is closed")
+	case requiresShift && !shiftOpen:
+		return nil, status.Error(codes.FailedPrecondition, "open a shift on the cash account first")
+	}
+	if p.currency != CurrencyUZS {
+		p.rate, err = rateOn(q, companyId, p.currency, date)
+		if err != nil {
+			return nil, err
+		}
+	}
+	p.amount = money.Round(p.original.Mul(p.rate))
+	return p, nil
+}
+
+// rateOn is the rate of a currency recorded last on or before a day.
+func rateOn(q execQuerier, companyId, currency, date string) (decimal.Decimal, error) {
+	var rate decimal.Decimal
+	err := q.QueryRow(`SELECT rate FROM exchange_rate WHERE company_id = $1 AND currency = $2 AND rate_date <= $3::date
+ORDER BY rate_date DESC LIMIT 1`, companyId, currency, date).Scan(&rate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return money.Zero, status.Errorf(codes.FailedPrecondition, "no %s exchange rate recorded on or before %s", currency, date)
+	}
+	if err != nil {
+		return money.Zero, fmt.Errorf("failed to load exchange rate: %v", err)
+	}
+	return rate, nil
+}
+
+func validateAccount(req *pb.CashAccount) error {
+	if req.Name == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+	if !accountKinds[req.Kind] {
+		return status.Errorf(codes.InvalidArgument, "kind must be CASH_DESK, BANK, CARD_TERMINAL or ONLINE, got %q", req.Kind)
+	}
+	if req.Currency == "" {
+		req.Currency = CurrencyUZS
+	}
+	if !currencies[req.Currency] {
+		return status.Errorf(codes.InvalidArgument, "currency must be UZS or USD, got %q", req.Currency)
+	}
+	if !paymentMethods[req.Method] {
+		return status.Errorf(codes.InvalidArgument, "method must be CASH, CLICK, PAYME, CARD or TRANSFER, got %q", req.Method)
+	}
+	return nil
+}
+
+// CreateCashAccount adds an account. A default one takes over from the
+// previous default of its method.
+func (r *CashRepository) CreateCashAccount(companyId string, req *pb.CashAccount) (*pb.CashAccount, error) {
+	if err := validateAccount(req); err != nil {
+		return nil, err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	if req.IsDefault {
+		if _, err = tx.Exec(`UPDATE cash_account SET is_default = false WHERE company_id = $1 AND method = $2 AND is_default`, companyId, req.Method); err != nil {
+			return nil, err
+		}
+	}
+	req.Id = uuid.NewString()
+	req.IsActive = true
+	_, err = tx.Exec(`INSERT INTO cash_account (id, company_id, name, kind, currency, method, is_default, requires_shift)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`, req.Id, companyId, req.Name, req.Kind, req.Currency, req.Method, req.IsDefault, req.RequiresShift)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cash account: %v", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit cash account: %v", err)
+	}
+	return r.getAccount(companyId, req.Id)
+}
+
+// UpdateCashAccount changes an account. Its currency is fixed once money
+// went through it, and a closed account is no longer a default.
+func (r *CashRepository) UpdateCashAccount(companyId string, req *pb.CashAccount) (*pb.CashAccount, error) {
+	if err := validateAccount(req); err != nil {
+		return nil, err
+	}
+	current, err := r.getAccount(companyId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if current.Currency != req.Currency {
+		var used bool
+		err = r.db.QueryRow(`SELECT exists(SELECT 1 FROM (`+accountMovements+`) m WHERE m.account_id::text = $1)`, req.Id).Scan(&used)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			return nil, status.Error(codes.FailedPrecondition, "the currency of an account cannot change once money went through it")
+		}
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	isDefault := req.IsDefault && req.IsActive
+	if isDefault {
+		_, err = tx.Exec(`UPDATE cash_account SET is_default = false WHERE company_id = $1 AND method = $2 AND is_default AND id::text <> $3`,
+			companyId, req.Method, req.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, err = tx.Exec(`UPDATE cash_account
+SET name = $3, kind = $4, currency = $5, method = $6, is_default = $7, requires_shift = $8, is_active = $9
+WHERE id::text = $1 AND company_id = $2`, req.Id, companyId, req.Name, req.Kind, req.Currency, req.Method, isDefault, req.RequiresShift, req.IsActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update cash account: %v", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit cash account: %v", err)
+	}
+	return r.getAccount(companyId, req.Id)
+}
+
+func (r *CashRepository) GetCashAccounts(companyId string) (*pb.CashAccountList, error) {
+	accounts, err := r.accounts(companyId, "")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CashAccountList{Items: accounts}, nil
+}
+
+func (r *CashRepository) getAccount(companyId, id string) (*pb.CashAccount, error) {
+	accounts, err := r.accounts(companyId, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, status.Errorf(codes.NotFound, "cash account %s not found", id)
+	}
+	return accounts[0], nil
+}
+
+// accounts lists the accounts of a company, or one of them, with what is on
+// each and its open shift.
+func (r *CashRepository) accounts(companyId, id string) ([]*pb.CashAccount, error) {
+	rows, err := r.db.Query(`SELECT a.id, a.name, a.kind, a.currency, a.method, a.is_default, a.requires_shift, a.is_active, a.created_at,
+       coalesce((SELECT sum(m.amount) FROM (`+accountMovements+`) m WHERE m.account_id = a.id), 0),
+       coalesce((SELECT s.id::text FROM cash_shift s WHERE s.account_id = a.id AND s.status = 'OPEN'), '')
+FROM cash_account a
+WHERE a.company_id = $1
+  AND ($2 = '' OR a.id::text = $2)
+ORDER BY a.is_active DESC, a.created_at`, companyId, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var accounts []*pb.CashAccount
+	for rows.Next() {
+		var account pb.CashAccount
+		var createdAt time.Time
+		var balance decimal.Decimal
+		err = rows.Scan(&account.Id, &account.Name, &account.Kind, &account.Currency, &account.Method, &account.IsDefault, &account.RequiresShift,
+			&account.IsActive, &createdAt, &balance, &account.OpenShiftId)
+		if err != nil {
+			return nil, err
+		}
+		account.Balance = money.Format(balance)
+		account.CreatedAt = createdAt.Format(time.DateTime)
+		accounts = append(accounts, &account)
+	}
+	return accounts, rows.Err()
+}
+
+// OpenCashShift starts a shift on an account with the money counted in it.
+func (r *CashRepository) OpenCashShift(companyId string, req *pb.CashShiftRequest) (*pb.CashShift, error) {
+	counted, err := parseCounted(req.Counted)
+	if err != nil {
+		return nil, err
+	}
+	account, err := r.getAccount(companyId, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if !account.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "the cash account is closed")
+	}
+	id := uuid.NewString()
+	_, err = r.db.Exec(`INSERT INTO cash_shift (id, account_id, company_id, opened_by_id, opened_by_name, opening_counted, comment)
+VALUES ($1, $2, $3, $4, $5, $6, $7)`, id, account.Id, companyId, req.ActionById, req.ActionByName, counted, req.Comment)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return nil, status.Error(codes.AlreadyExists, "a shift is already open on this cash account")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cash shift: %v", err)
+	}
+	return r.getShift(companyId, id)
+}
+
+// CloseCashShift closes the open shift of an account. What should be in it
+// is the opening count plus the money in and less the money out since; the
+// difference to the count is kept with the shift.
+func (r *CashRepository) CloseCashShift(companyId string, req *pb.CashShiftRequest) (*pb.CashShift, error) {
+	counted, err := parseCounted(req.Counted)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	var id string
+	var openingCounted decimal.Decimal
+	var openedAt time.Time
+	err = tx.QueryRow(`SELECT id, opening_counted, opened_at FROM cash_shift
+WHERE account_id::text = $1 AND company_id = $2 AND status = 'OPEN' FOR UPDATE`, req.AccountId, companyId).Scan(&id, &openingCounted, &openedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.FailedPrecondition, "no shift is open on this cash account")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cash shift: %v", err)
+	}
+	inflow, outflow, err := shiftFlows(tx, req.AccountId, openedAt, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(`UPDATE cash_shift
+SET status = 'CLOSED', closed_at = now(), closed_by_id = $2, closed_by_name = $3,
+    inflow = $4, outflow = $5, expected = $6, counted = $7,
+    comment = CASE WHEN $8 = '' THEN comment ELSE $8 END
+WHERE id = $1`, id, req.ActionById, req.ActionByName, inflow, outflow, openingCounted.Add(inflow).Sub(outflow), counted, req.Comment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close cash shift: %v", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit cash shift: %v", err)
+	}
+	return r.getShift(companyId, id)
+}
+
+// parseCounted reads the money counted in a drawer.
+func parseCounted(s string) (decimal.Decimal, error) {
+	counted, err := money.Parse(s)
+	if err != nil {
+		return money.Zero, status.Errorf(codes.InvalidArgument, "counted: %v", err)
+	}
+	if counted.IsNegative() {
+		return money.Zero, status.Error(codes.InvalidArgument, "counted must not be negative")
+	}
+	return counted, nil
+}
+
+// shiftFlows is the money in and out of an account over a period, in the
+// account's currency.
+func shiftFlows(q execQuerier, accountId string, from, to time.Time) (inflow, outflow decimal.Decimal, err error) {
+	err = q.QueryRow(`SELECT coalesce(sum(m.amount) FILTER (WHERE m.amount > 0), 0), coalesce(-sum(m.amount) FILTER (WHERE m.amount < 0), 0)
+FROM (`+accountMovements+`) m
+WHERE m.account_id::text = $1 AND m.created_at >= $2 AND m.created_at <= $3`, accountId, from, to).Scan(&inflow, &outflow)
+	if err != nil {
+		return money.Zero, money.Zero, fmt.Errorf("failed to sum cash account movements: %v", err)
+	}
+	return inflow, outflow, nil
+}
+
+func (r *CashRepository) getShift(companyId, id string) (*pb.CashShift, error) {
+	list, err := r.shifts(companyId, "", id, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 {
+		return nil, status.Errorf(codes.NotFound, "cash shift %s not found", id)
+	}
+	return list.Items[0], nil
+}
+
+func (r *CashRepository) GetCashShifts(companyId string, req *pb.GetCashShiftsRequest) (*pb.CashShiftList, error) {
+	page, size := req.Page, req.Size
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 20
+	}
+	return r.shifts(companyId, req.AccountId, "", page, size)
+}
+
+// shifts lists shifts newest first. An open shift shows where it stands so
+// far.
+func (r *CashRepository) shifts(companyId, accountId, id string, page, size int32) (*pb.CashShiftList, error) {
+	rows, err := r.db.Query(`SELECT s.id, s.account_id, a.name, a.currency, s.status, s.opened_at, s.opened_by_name, s.opening_counted,
+       coalesce(s.inflow, 0), coalesce(s.outflow, 0), coalesce(s.expected, 0), coalesce(s.counted, 0), s.closed_at, coalesce(s.closed_by_name, ''),
+       s.comment, count(*) OVER ()
+FROM cash_shift s
+         JOIN cash_account a ON a.id = s.account_id
+WHERE s.company_id = $1
+  AND ($2 = '' OR s.account_id::text = $2)
+  AND ($3 = '' OR s.id::text = $3)
+ORDER BY s.opened_at DESC
+LIMIT $4 OFFSET $5`, companyId, accountId, id, size, (page-1)*size)
+	if err != nil {
+		return nil, err
+	}
+	var openedAt []time.Time
+	var openings []decimal.Decimal
+	result := &pb.CashShiftList{}
+	for rows.Next() {
+		var shift pb.CashShift
+		var opened time.Time
+		var closed sql.NullTime
+		var openingCounted, inflow, outflow, expected, counted decimal.Decimal
+		err = rows.Scan(&shift.Id, &shift.AccountId, &shift.AccountName, &shift.Currency, &shift.Status, &opened, &shift.OpenedByName, &openingCounted,
+			&inflow, &outflow, &expected, &counted, &closed, &shift.ClosedByName,
+			&shift.Comment, &result.Count)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		shift.OpeningCounted, shift.Inflow, shift.Outflow = money.Format(openingCounted), money.Format(inflow), money.Format(outflow)
+		shift.Expected, shift.Counted = money.Format(expected), money.Format(counted)
+		if shift.Status != ShiftOpen {
+			shift.Difference = money.Format(counted.Sub(expected))
+		}
+		shift.OpenedAt = opened.Format(time.DateTime)
+		if closed.Valid {
+			shift.ClosedAt = closed.Time.Format(time.DateTime)
+		}
+		openedAt = append(openedAt, opened)
+		openings = append(openings, openingCounted)
+		result.Items = append(result.Items, &shift)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	for i, shift := range result.Items {
+		if shift.Status == ShiftOpen {
+			inflow, outflow, err := shiftFlows(r.db, shift.AccountId, openedAt[i], time.Now())
+			if err != nil {
+				return nil, err
+			}
+			shift.Inflow, shift.Outflow = money.Format(inflow), money.Format(outflow)
+			shift.Expected = money.Format(openings[i].Add(inflow).Sub(outflow))
+		}
+	}
+	return result, nil
+}
+
+// SetExchangeRate records the UZS rate of a currency from a day on, today
+// by default. Recording it again for the same day replaces it.
+func (r *CashRepository) SetExchangeRate(companyId string, req *pb.ExchangeRate) (*pb.ExchangeRate, error) {
+	if req.Currency == "" || req.Currency == CurrencyUZS || !currencies[req.Currency] {
+		return nil, status.Errorf(codes.InvalidArgument, "currency must be USD, got %q", req.Currency)
+	}
+	if req.Rate <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "rate must be above 0")
+	}
+	if req.Date == "" {
+		req.Date = time.Now().Format(time.DateOnly)
+	}
+	if _, err := time.Parse(time.DateOnly, req.Date); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "date must be YYYY-MM-DD, got %q", req.Date)
+	}
+	var createdAt time.Time
+	err := r.db.QueryRow(`INSERT INTO exchange_rate (id, company_id, currency, rate, rate_date, created_by_id, created_by_name)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (company_id, currency, rate_date) DO UPDATE
+    SET rate = excluded.rate, created_by_id = excluded.created_by_id, created_by_name = excluded.created_by_name, created_at = now()
+RETURNING id, created_at`, uuid.New(), companyId, req.Currency, req.Rate, req.Date, req.ActionById, req.ActionByName).Scan(&req.Id, &createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record exchange rate: %v", err)
+	}
+	req.CreatedByName = req.ActionByName
+	req.CreatedAt = createdAt.Format(time.DateTime)
+	return req, nil
+}
+
+func (r *CashRepository) GetExchangeRates(companyId string, req *pb.GetExchangeRatesRequest) (*pb.ExchangeRateList, error) {
+	rows, err := r.db.Query(`SELECT id, currency, rate, rate_date, created_by_name, created_at
+FROM exchange_rate
+WHERE company_id = $1
+  AND ($2 = '' OR currency = $2)
+ORDER BY rate_date DESC, currency`, companyId, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.ExchangeRateList{}
+	for rows.Next() {
+		var rate pb.ExchangeRate
+		var date, createdAt time.Time
+		if err = rows.Scan(&rate.Id, &rate.Currency, &rate.Rate, &date, &rate.CreatedByName, &createdAt); err != nil {
+			return nil, err
+		}
+		rate.Date = date.Format(time.DateOnly)
+		rate.CreatedAt = createdAt.Format(time.DateTime)
+		result.Items = append(result.Items, &rate)
+	}
+	return result, rows.Err()
+}
@@ -3,12 +3,13 @@ package repository
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"finance-service/internal/clients"
+	"finance-service/internal/money"
 	"finance-service/internal/utils"
 	"finance-service/proto/pb"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"time"
@@ -18,32 +19,37 @@ type DiscountRepository struct {
 	db            *sql.DB
 	studentClient *clients.EducationClient
 	paymentRepo   *PaymentRepository
+	ruleRepo      *DiscountRuleRepository
 }
 
-func (r *DiscountRepository) CreateDiscount(ctx context.Context, companyId, groupId string, studentId string, discountPrice, comment, startDate, endDate string, withTeacher bool) error {
-	var checker bool
-	tx, err := r.db.Begin()
-	if err != nil {
-		return status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+// CreateDiscount adds a discount to a student in a group. It is combined
+// with the other discounts of the student by the company's policy.
+func (r *DiscountRepository) CreateDiscount(ctx context.Context, companyId, groupId string, studentId string, discountPrice, discountType, comment, startDate, endDate string, withTeacher bool) error {
+	if discountType == "" {
+		discountType = DiscountFixed
 	}
-
-	err = tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM student_discount WHERE group_id=$1 AND student_id=$2 AND company_id=$3)`, groupId, studentId, companyId).Scan(&checker)
+	if err := validateDiscountValue(discountType, discountPrice); err != nil {
+		return err
+	}
+	value, err := money.Parse(discountPrice)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error checking existing discount: %v", err)
+		return err
 	}
-	if checker {
-		return status.Errorf(codes.AlreadyExists, "Discount already exists")
+	tx, err := r.db.Begin()
+	if err != nil {
+		return status.Errorf(codes.Aborted, "error while creating transaction %v", err)
 	}
 
-	_, err = tx.Exec(`INSERT INTO student_discount (student_id, discount, group_id, comment, start_at, end_at, withteacher , company_id) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7 , $8)`, studentId, discountPrice, groupId, comment, startDate, endDate, withTeacher, companyId)
+	discountId := uuid.New()
+	_, err = tx.Exec(`INSERT INTO student_discount (id, student_id, discount, discount_type, group_id, comment, start_at, end_at, withteacher , company_id) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`, discountId, studentId, value, discountType, groupId, comment, startDate, endDate, withTeacher, companyId)
 	if err != nil {
 		tx.Rollback()
 		return status.Errorf(codes.Internal, "Status discount insert error: %v", err)
 	}
 
-	_, err = tx.Exec(`INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action , discount, company_id)
-		VALUES ($1, $2, $3, $4, $5 , $6 , $7, $8, $9, $10)`, uuid.New(), studentId, groupId, startDate, endDate, withTeacher, comment, "CREATE", discountPrice, companyId)
+	_, err = tx.Exec(`INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action , discount, company_id, discount_id, discount_type)
+		VALUES ($1, $2, $3, $4, $5 , $6 , $7, $8, $9, $10, $11, $12)`, uuid.New(), studentId, groupId, startDate, endDate, withTeacher, comment, "CREATE", value, companyId, discountId, discountType)
 	if err != nil {
 		tx.Rollback()
 		return status.Errorf(codes.Internal, "Error inserting into student history: %v", err)
@@ -52,7 +58,8 @@ func (r *DiscountRepository) CreateDiscount(ctx context.Context, companyId, grou
 	rows, err := tx.Query(`
         SELECT id, student_id, method, amount, given_date, comment, created_at, payment_type, created_by_id, created_by_name, group_id , student_activation_date
         FROM student_payments
-        WHERE student_id = $1 AND group_id = $2 AND given_date BETWEEN $3 AND $4 AND payment_type = 'TAKE_OFF' AND company_id=$5 AND student_activation_date is not null`,
+        WHERE student_id = $1 AND group_id = $2 AND given_date BETWEEN $3 AND $4 AND payment_type = 'TAKE_OFF' AND company_id=$5 AND student_activation_date is not null
+          AND reversal_of is null AND not exists(SELECT 1 FROM student_payments rv where rv.reversal_of = student_payments.id)`,
 		studentId, groupId, startDate, endDate, companyId)
 	if err != nil {
 		tx.Rollback()
@@ -98,12 +105,12 @@ func (r *DiscountRepository) CreateDiscount(ctx context.Context, companyId, grou
 	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 	defer cancelFunc()
 	for _, payment := range payments {
-		discountAmount, err := r.studentClient.CalculateDiscountSumma(ctx, groupId, studentId, discountPrice, startDate, endDate, payment.GivenDate, payment.StudentActivationDate)
+		discountAmount, err := r.studentClient.CalculateDiscountSumma(ctx, groupId, studentId, discountPrice, discountType, startDate, endDate, payment.GivenDate, payment.StudentActivationDate)
 		if err != nil {
 			tx.Rollback()
 			return err
 		}
-		err = r.paymentRepo.AddPayment(ctx, companyId, payment.GivenDate, discountAmount, "CASH", "Studentga ushbu tolov amalga oshirilgan kunlar oralig'ida chegirma kiritildi va studentning qolgan puli qaytarib berildi.", studentId, payment.CreatedByName, payment.CreatedByID, groupId, true)
+		err = r.paymentRepo.AddPayment(ctx, companyId, payment.GivenDate, discountAmount, "CASH", "Studentga ushbu tolov amalga oshirilgan kunlar oralig'ida chegirma kiritildi va studentning qolgan puli qaytarib berildi.", studentId, payment.CreatedByName, payment.CreatedByID, groupId, "", true, false)
 		if err != nil {
 			tx.Rollback()
 			return err
@@ -117,33 +124,46 @@ func (r *DiscountRepository) CreateDiscount(ctx context.Context, companyId, grou
 	return nil
 }
 
-func (r *DiscountRepository) DeleteDiscount(companyId, groupId string, studentId string) error {
-	var discount pb.AbsDiscountRequest
-	var createdAt string
-	err := r.db.QueryRow(`SELECT student_id, discount, group_id, comment, start_at, end_at, withteacher, created_at FROM student_discount where student_id=$1 and group_id=$2 and company_id=$3 `, studentId, groupId, companyId).Scan(
-		&discount.StudentId,
-		&discount.DiscountPrice,
-		&discount.GroupId,
-		&discount.Comment,
-		&discount.StartDate,
-		&discount.EndDate,
-		&discount.WithTeacher,
-		&createdAt,
-	)
+// DeleteDiscount deletes one discount of a student in a group, or all of
+// them when id is empty.
+func (r *DiscountRepository) DeleteDiscount(companyId, groupId string, studentId, id string) error {
+	tx, err := r.db.Begin()
 	if err != nil {
-		return status.Errorf(codes.NotFound, err.Error())
+		return status.Errorf(codes.Aborted, "error while creating transaction %v", err)
 	}
-	_, err = r.db.Exec(`DELETE FROM student_discount where group_id=$1 and student_id=$2 and company_id=$3`, groupId, studentId, companyId)
+	defer tx.Rollback()
+	rows, err := tx.Query(`DELETE FROM student_discount
+WHERE group_id = $1 AND student_id = $2 AND company_id = $3 AND ($4 = '' OR id::text = $4)
+RETURNING id, discount, discount_type, comment, start_at, end_at, withteacher`, groupId, studentId, companyId, id)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error while deleting disount %f", err)
+		return status.Errorf(codes.Internal, "Error while deleting disount %v", err)
 	}
-	_, err = r.db.Exec(`INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action , discount , company_id)
-		VALUES ($1, $2, $3, $4, $5 , $6 , $7, $8 , $9 , $10)`, uuid.New(), studentId, groupId, discount.StartDate, discount.EndDate, discount.WithTeacher, discount.Comment, "DELETE", discount.DiscountPrice, companyId)
-	if err != nil {
-		return status.Errorf(codes.Internal, "Error inserting into student history: %v", err)
+	var deleted []*pb.AbsDiscountRequest
+	for rows.Next() {
+		var discount pb.AbsDiscountRequest
+		if err = rows.Scan(&discount.Id, &discount.DiscountPrice, &discount.DiscountType, &discount.Comment, &discount.StartDate, &discount.EndDate, &discount.WithTeacher); err != nil {
+			rows.Close()
+			return err
+		}
+		deleted = append(deleted, &discount)
 	}
-	return nil
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return status.Error(codes.NotFound, "discount not found")
+	}
+	for _, discount := range deleted {
+		_, err = tx.Exec(`INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action , discount , company_id, discount_id, discount_type)
+		VALUES ($1, $2, $3, $4, $5 , $6 , $7, $8 , $9 , $10, $11, $12)`, uuid.New(), studentId, groupId, discount.StartDate, discount.EndDate, discount.WithTeacher, discount.Comment, "DELETE", discount.DiscountPrice, companyId, discount.Id, discount.DiscountType)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Error inserting into student history: %v", err)
+		}
+	}
+	return tx.Commit()
 }
+
 func (r *DiscountRepository) GetAllDiscountByGroup(ctx context.Context, companyId, groupId string) (*pb.GetInformationDiscountResponse, error) {
 	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 	defer cancelFunc()
@@ -155,12 +175,24 @@ func (r *DiscountRepository) GetAllDiscountByGroup(ctx context.Context, companyI
 	var res []*pb.AbsStudentDiscount
 	students := resp.Students
 	for _, el := range students {
-		var element pb.AbsStudentDiscount
-		element.StudentId = el.Id
-		element.StudentName = el.Name
-		element.StudentPhoneNumber = el.PhoneNumber
-		r.db.QueryRow(`SELECT  discount, comment, created_at , start_at , end_at , withteacher FROM student_discount WHERE group_id=$1 and student_id=$2 and company_id=$3`, groupId, el.Id, companyId).Scan(&element.Discount, &element.Cause, &element.CreatedAt, &element.StartAt, &element.EndAt, &element.WithTeacher)
-		res = append(res, &element)
+		rows, err := r.db.Query(`SELECT id, discount, discount_type, comment, created_at, start_at, end_at, withteacher FROM student_discount WHERE group_id=$1 and student_id=$2 and company_id=$3 ORDER BY created_at`, groupId, el.Id, companyId)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for rows.Next() {
+			element := pb.AbsStudentDiscount{StudentId: el.Id, StudentName: el.Name, StudentPhoneNumber: el.PhoneNumber}
+			if err = rows.Scan(&element.Id, &element.Discount, &element.DiscountType, &element.Cause, &element.CreatedAt, &element.StartAt, &element.EndAt, &element.WithTeacher); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			res = append(res, &element)
+			found = true
+		}
+		rows.Close()
+		if !found {
+			res = append(res, &pb.AbsStudentDiscount{StudentId: el.Id, StudentName: el.Name, StudentPhoneNumber: el.PhoneNumber})
+		}
 	}
 	result.Discounts = res
 	return &result, nil
@@ -168,7 +200,7 @@ func (r *DiscountRepository) GetAllDiscountByGroup(ctx context.Context, companyI
 
 func (r *DiscountRepository) GetHistoryDiscount(ctx context.Context, companyId, id string) (*pb.GetHistoryDiscountResponse, error) {
 	query := `
-		SELECT group_id, student_id,discount, comment, start_at, end_at, withTeacher, action, created_at
+		SELECT group_id, student_id,discount, comment, start_at, end_at, withTeacher, action, created_at, discount_type, coalesce(discount_id::text, '')
 		FROM student_discount_history
 		WHERE group_id = $1
 	`
@@ -196,6 +228,8 @@ func (r *DiscountRepository) GetHistoryDiscount(ctx context.Context, companyId,
 			&discount.WithTeacher,
 			&discount.Action,
 			&createdAt,
+			&discount.DiscountType,
+			&discount.DiscountId,
 		); err != nil {
 			return nil, err
 		}
@@ -214,48 +248,155 @@ func (r *DiscountRepository) GetHistoryDiscount(ctx context.Context, companyId,
 	return &pb.GetHistoryDiscountResponse{Discounts: discounts}, nil
 }
 
-func (r *DiscountRepository) GetDiscountByStudentId(companyId, studentId, groupId string) (*pb.GetDiscountByStudentIdResponse, error) {
-	var discount float64
-	var startAt, endAt string
-	var withTeacher bool
+// GetDiscountByStudentId works out what a student gets off the monthly price
+// of a group on a day: their own discounts running that day and the rules of
+// the company they match, combined by the company's policy. The monthly
+// charge sets apply and sends the result back with its TAKE_OFF, which
+// records the rules applied to the month; an early-payment rule counts for
+// the rest of that month only when it was.
+func (r *DiscountRepository) GetDiscountByStudentId(companyId string, req *pb.GetDiscountByStudentIdRequest) (*pb.GetDiscountByStudentIdResponse, error) {
+	day := time.Now()
+	if req.Date != "" {
+		parsed, err := time.Parse(time.DateOnly, req.Date)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "date must be YYYY-MM-DD, got %q", req.Date)
+		}
+		day = parsed
+	}
+	month := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	price, err := money.Parse(req.CoursePrice)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "coursePrice: %v", err)
+	}
 
-	err := r.db.QueryRow(`SELECT discount, start_at, end_at , withteacher FROM student_discount WHERE student_id=$1 AND group_id=$2 and company_id=$3 order by created_at desc limit 1`, studentId, groupId, companyId).Scan(&discount, &startAt, &endAt, &withTeacher)
+	var applied []pricedDiscount
+	rows, err := r.db.Query(`SELECT id, discount, discount_type, withteacher, comment FROM student_discount
+WHERE student_id = $1 AND group_id = $2 AND company_id = $3 AND $4::date BETWEEN start_at AND end_at
+ORDER BY created_at`, req.StudentId, req.GroupId, companyId, day.Format(time.DateOnly))
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("no discount found for the given student and group")
-		}
 		return nil, fmt.Errorf("failed to query database: %v", err)
 	}
+	for rows.Next() {
+		discount := &pb.AppliedDiscount{Source: "DISCOUNT"}
+		var value decimal.Decimal
+		if err = rows.Scan(&discount.Id, &value, &discount.DiscountType, &discount.WithTeacher, &discount.Name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		discount.Value = money.Format(value)
+		applied = append(applied, pricedDiscount{discount, discountAmount(discount.DiscountType, value, price)})
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
 
-	startTime, err := time.Parse("2006-01-02T15:04:05Z", startAt)
+	rules, err := r.ruleRepo.activeRules(companyId)
 	if err != nil {
-		fmt.Println(err.Error())
-		return nil, fmt.Errorf("failed to parse start_at: %v", err)
+		return nil, err
+	}
+	for _, rule := range rules.Items {
+		var matches bool
+		switch rule.RuleType {
+		case RuleSibling:
+			matches = req.Siblings+1 >= rule.MinCount
+		case RuleMultiGroup:
+			matches = req.ActiveGroups >= rule.MinCount
+		case RuleEarlyPayment:
+			matches = req.Prepaid
+			if !req.Apply {
+				if matches, err = r.ruleRepo.applied(rule.Id, req.StudentId, req.GroupId, month); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if matches {
+			value, err := money.Parse(rule.Value)
+			if err != nil {
+				return nil, err
+			}
+			applied = append(applied, pricedDiscount{&pb.AppliedDiscount{
+				Source:       rule.RuleType,
+				Id:           rule.Id,
+				Name:         rule.Name,
+				DiscountType: rule.DiscountType,
+				Value:        rule.Value,
+				WithTeacher:  rule.WithTeacher,
+			}, discountAmount(rule.DiscountType, value, price)})
+		}
 	}
 
-	endTime, err := time.Parse("2006-01-02T15:04:05Z", endAt)
+	policy, err := r.ruleRepo.GetPolicy(companyId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse end_at: %v", err)
+		return nil, err
 	}
+	applied = combineDiscounts(applied, policy, price)
 
-	now := time.Now()
-	if now.After(startTime) && now.Before(endTime) {
-		response := &pb.GetDiscountByStudentIdResponse{}
-		if withTeacher {
-			response.Amount = fmt.Sprintf("%.2f", discount)
-			response.IsHave = true
-			response.DiscountOwner = "TEACHER"
-		} else {
-			response.Amount = fmt.Sprintf("%.2f", discount)
-			response.IsHave = true
-			response.DiscountOwner = "CENTER"
+	response := &pb.GetDiscountByStudentIdResponse{DiscountOwner: "CENTER"}
+	var total, teacherAmount decimal.Decimal
+	for _, discount := range applied {
+		discount.Amount = money.Format(discount.amount)
+		response.Applied = append(response.Applied, discount.AppliedDiscount)
+		total = total.Add(discount.amount)
+		if discount.WithTeacher {
+			teacherAmount = teacherAmount.Add(discount.amount)
 		}
-		return response, nil
 	}
+	response.TeacherAmount = money.Format(teacherAmount)
+	if teacherAmount.IsPositive() {
+		response.DiscountOwner = "TEACHER"
+	}
+	response.IsHave = len(applied) > 0
+	response.Amount = money.Format(total)
+	return response, nil
+}
+
+// pricedDiscount is a discount with what it takes off the price, while the
+// discounts of a month are combined.
+type pricedDiscount struct {
+	*pb.AppliedDiscount
+	amount decimal.Decimal
+}
+
+func discountAmount(discountType string, value, price decimal.Decimal) decimal.Decimal {
+	if discountType == DiscountPercent {
+		return money.Percent(price, value)
+	}
+	return value
+}
 
-	return nil, fmt.Errorf("current time is not within the discount period")
+// combineDiscounts applies the stacking policy: BEST keeps only the largest
+// discount, STACK keeps them all, scaled down together when they add up to
+// more than maxPercent of the price.
+func combineDiscounts(applied []pricedDiscount, policy *pb.DiscountPolicy, price decimal.Decimal) []pricedDiscount {
+	if len(applied) == 0 {
+		return applied
+	}
+	if policy.Stacking == StackingBest {
+		best := applied[0]
+		for _, discount := range applied[1:] {
+			if discount.amount.GreaterThan(best.amount) {
+				best = discount
+			}
+		}
+		applied = []pricedDiscount{best}
+	}
+	if !price.IsPositive() {
+		return applied
+	}
+	var total decimal.Decimal
+	for _, discount := range applied {
+		total = total.Add(discount.amount)
+	}
+	limit := money.Percent(price, decimal.NewFromFloat(policy.MaxPercent))
+	if total.GreaterThan(limit) {
+		for i := range applied {
+			applied[i].amount = money.Round(applied[i].amount.Mul(limit).Div(total))
+		}
+	}
+	return applied
 }
 
-func NewDiscountRepository(db *sql.DB, studentClient *clients.EducationClient, paymentRepo *PaymentRepository) *DiscountRepository {
-	return &DiscountRepository{db: db, studentClient: studentClient, paymentRepo: paymentRepo}
+func NewDiscountRepository(db *sql.DB, studentClient *clients.EducationClient, paymentRepo *PaymentRepository, ruleRepo *DiscountRuleRepository) *DiscountRepository {
+	return &DiscountRepository{db: db, studentClient: studentClient, paymentRepo: paymentRepo, ruleRepo: ruleRepo}
 }
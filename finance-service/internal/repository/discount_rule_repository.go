@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"finance-service/internal/money"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+const (
+	DiscountFixed   = "FIXED"
+	DiscountPercent = "PERCENT"
+
+	RuleSibling      = "SIBLING"
+	RuleMultiGroup   = "MULTI_GROUP"
+	RuleEarlyPayment = "EARLY_PAYMENT"
+
+	StackingStack = "STACK"
+	StackingBest  = "BEST"
+)
+
+type DiscountRuleRepository struct {
+	db *sql.DB
+}
+
+func NewDiscountRuleRepository(db *sql.DB) *DiscountRuleRepository {
+	return &DiscountRuleRepository{db: db}
+}
+
+func validateDiscountValue(discountType, value string) error {
+	parsed, err := money.Parse(value)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "value: %v", err)
+	}
+	switch discountType {
+	case DiscountFixed:
+		if !parsed.IsPositive() {
+			return status.Error(codes.InvalidArgument, "a fixed discount must be above 0")
+		}
+	case DiscountPercent:
+		if !parsed.IsPositive() || parsed.GreaterThan(decimal.NewFromInt(100)) {
+			return status.Error(codes.InvalidArgument, "a percent discount must be above 0 and at most 100")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "discount type must be FIXED or PERCENT, got %q", discountType)
+	}
+	return nil
+}
+
+func validateRule(req *pb.DiscountRule) error {
+	if req.Name == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+	switch req.RuleType {
+	case RuleSibling, RuleMultiGroup:
+		if req.MinCount < 2 {
+			req.MinCount = 2
+		}
+	case RuleEarlyPayment:
+		req.MinCount = 0
+	default:
+		return status.Errorf(codes.InvalidArgument, "rule type must be SIBLING, MULTI_GROUP or EARLY_PAYMENT, got %q", req.RuleType)
+	}
+	return validateDiscountValue(req.DiscountType, req.Value)
+}
+
+func (r *DiscountRuleRepository) CreateRule(companyId string, req *pb.DiscountRule) (*pb.DiscountRule, error) {
+	if err := validateRule(req); err != nil {
+		return nil, err
+	}
+	req.Id = uuid.NewString()
+	req.IsActive = true
+	var createdAt time.Time
+	err := r.db.QueryRow(`INSERT INTO discount_rule (id, company_id, name, rule_type, discount_type, value, min_count, with_teacher)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING created_at`, req.Id, companyId, req.Name, req.RuleType, req.DiscountType, req.Value, req.MinCount, req.WithTeacher).Scan(&createdAt)
+	if err != nil {
+		return nil, err
+	}
+	req.CreatedAt = createdAt.Format(time.DateTime)
+	return req, nil
+}
+
+func (r *DiscountRuleRepository) UpdateRule(companyId string, req *pb.DiscountRule) (*pb.DiscountRule, error) {
+	if err := validateRule(req); err != nil {
+		return nil, err
+	}
+	var createdAt time.Time
+	err := r.db.QueryRow(`UPDATE discount_rule
+SET name = $3, rule_type = $4, discount_type = $5, value = $6, min_count = $7, with_teacher = $8, is_active = $9
+WHERE id = $1 AND company_id = $2
+RETURNING created_at`, req.Id, companyId, req.Name, req.RuleType, req.DiscountType, req.Value, req.MinCount, req.WithTeacher, req.IsActive).Scan(&createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "discount rule %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	req.CreatedAt = createdAt.Format(time.DateTime)
+	return req, nil
+}
+
+// DeleteRule deactivates a rule, its applications stay in the history.
+func (r *DiscountRuleRepository) DeleteRule(companyId, id string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`UPDATE discount_rule SET is_active = false WHERE id = $1 AND company_id = $2`, id, companyId)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Errorf(codes.NotFound, "discount rule %s not found", id)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "discount rule deleted"}, nil
+}
+
+func (r *DiscountRuleRepository) GetRules(companyId string) (*pb.DiscountRuleList, error) {
+	return r.rules(`SELECT id, name, rule_type, discount_type, value, min_count, with_teacher, is_active, created_at
+FROM discount_rule
+WHERE company_id = $1
+ORDER BY is_active DESC, created_at`, companyId)
+}
+
+func (r *DiscountRuleRepository) activeRules(companyId string) (*pb.DiscountRuleList, error) {
+	return r.rules(`SELECT id, name, rule_type, discount_type, value, min_count, with_teacher, is_active, created_at
+FROM discount_rule
+WHERE company_id = $1 AND is_active
+ORDER BY created_at`, companyId)
+}
+
+func (r *DiscountRuleRepository) rules(query, companyId string) (*pb.DiscountRuleList, error) {
+	rows, err := r.db.Query(query, companyId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.DiscountRuleList{}
+	for rows.Next() {
+		var rule pb.DiscountRule
+		var createdAt time.Time
+		if err = rows.Scan(&rule.Id, &rule.Name, &rule.RuleType, &rule.DiscountType, &rule.Value, &rule.MinCount, &rule.WithTeacher, &rule.IsActive, &createdAt); err != nil {
+			return nil, err
+		}
+		rule.CreatedAt = createdAt.Format(time.DateTime)
+		result.Items = append(result.Items, &rule)
+	}
+	return result, rows.Err()
+}
+
+// GetPolicy is STACK up to 100% for companies that did not set one.
+func (r *DiscountRuleRepository) GetPolicy(companyId string) (*pb.DiscountPolicy, error) {
+	policy := &pb.DiscountPolicy{Stacking: StackingStack, MaxPercent: 100}
+	err := r.db.QueryRow(`SELECT stacking, max_percent FROM discount_policy WHERE company_id = $1`, companyId).Scan(&policy.Stacking, &policy.MaxPercent)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (r *DiscountRuleRepository) SetPolicy(companyId string, req *pb.DiscountPolicy) (*pb.DiscountPolicy, error) {
+	if req.Stacking != StackingStack && req.Stacking != StackingBest {
+		return nil, status.Errorf(codes.InvalidArgument, "stacking must be STACK or BEST, got %q", req.Stacking)
+	}
+	if req.MaxPercent <= 0 || req.MaxPercent > 100 {
+		return nil, status.Error(codes.InvalidArgument, "maxPercent must be above 0 and at most 100")
+	}
+	_, err := r.db.Exec(`INSERT INTO discount_policy (company_id, stacking, max_percent) VALUES ($1, $2, $3)
+ON CONFLICT (company_id) DO UPDATE SET stacking = excluded.stacking, max_percent = excluded.max_percent, updated_at = now()`,
+		companyId, req.Stacking, req.MaxPercent)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// applied tells whether a rule was applied to the month of a student in a
+// group by the monthly charge.
+func (r *DiscountRuleRepository) applied(ruleId, studentId, groupId string, month time.Time) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(`SELECT exists(SELECT 1 FROM discount_rule_application WHERE rule_id = $1 AND student_id = $2 AND group_id = $3 AND month = $4)`,
+		ruleId, studentId, groupId, month).Scan(&exists)
+	return exists, err
+}
+
+// recordApplications keeps the first application of each rule a charge was
+// priced with for the month of day; the monthly charge running again does
+// not count it twice. It runs in the charge's transaction, a failed charge
+// leaves no application behind.
+func recordApplications(tx *sql.Tx, companyId, studentId, groupId string, day time.Time, discounts []*pb.AppliedDiscount) error {
+	month := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for _, discount := range discounts {
+		if discount.Source == "DISCOUNT" {
+			continue
+		}
+		amount, err := money.Parse(discount.Amount)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "discount %s: %v", discount.Id, err)
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		_, err = tx.Exec(`INSERT INTO discount_rule_application (id, rule_id, student_id, group_id, month, amount, company_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (rule_id, student_id, group_id, month) DO NOTHING`, uuid.New(), discount.Id, studentId, groupId, month, amount, companyId)
+		if err != nil {
+			return fmt.Errorf("failed to record discount rule application: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *DiscountRuleRepository) GetApplications(companyId string, req *pb.GetRuleApplicationsRequest) (*pb.RuleApplicationList, error) {
+	var month sql.NullTime
+	if req.Month != "" {
+		parsed, err := time.Parse("2006-01", req.Month)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "month must be YYYY-MM, got %q", req.Month)
+		}
+		month = sql.NullTime{Time: parsed, Valid: true}
+	}
+	page, size := req.Page, req.Size
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 20
+	}
+	rows, err := r.db.Query(`SELECT a.id, a.rule_id, r.name, r.rule_type, a.student_id, a.group_id, a.month, a.amount, a.created_at, count(*) OVER ()
+FROM discount_rule_application a
+         JOIN discount_rule r ON r.id = a.rule_id
+WHERE a.company_id = $1
+  AND ($2 = '' OR a.rule_id::text = $2)
+  AND ($3 = '' OR a.student_id::text = $3)
+  AND ($4::date IS NULL OR a.month = $4)
+ORDER BY a.month DESC, a.created_at DESC
+LIMIT $5 OFFSET $6`, companyId, req.RuleId, req.StudentId, month, size, (page-1)*size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.RuleApplicationList{}
+	for rows.Next() {
+		var application pb.RuleApplication
+		var applied, createdAt time.Time
+		err = rows.Scan(&application.Id, &application.RuleId, &application.RuleName, &application.RuleType, &application.StudentId, &application.GroupId,
+			&applied, &application.Amount, &createdAt, &result.Count)
+		if err != nil {
+			return nil, err
+		}
+		application.Month = applied.Format("2006-01")
+		application.CreatedAt = createdAt.Format(time.DateTime)
+		result.Items = append(result.Items, &application)
+	}
+	return result, rows.Err()
+}
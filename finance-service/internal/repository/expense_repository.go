@@ -3,11 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"finance-service/internal/clients"
+	"finance-service/internal/money"
 	"finance-service/internal/utils"
 	"finance-service/proto/pb"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -17,49 +20,90 @@ type ExpenseRepository struct {
 	userClient *clients.UserClient
 }
 
-func (r *ExpenseRepository) CreateExpense(ctx context.Context, companyId, title, givenDate, expenseType, categoryId, userId, sum, createdBy, paymentMethod string) error {
+// CreateExpense pays an expense out of a cash account, sum is in the
+// account's currency and is kept in UZS. An expense on a user is a salary
+// payout and goes to payroll in the ledger.
+func (r *ExpenseRepository) CreateExpense(ctx context.Context, companyId, title, givenDate, expenseType, categoryId, userId, sum, createdBy, paymentMethod, accountId string) error {
+	amount, err := money.Parse(sum)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid sum %q", sum)
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	p, err := postTo(tx, companyId, accountId, paymentMethod, amount, givenDate)
+	if err != nil {
+		return err
+	}
 	query := `
 		INSERT INTO expense (
-			id, title, user_id, category_id, expense_type, sum, created_at , given_date, created_by, payment_method,company_id
+			id, title, user_id, category_id, expense_type, sum, created_at , given_date, created_by, payment_method,company_id,
+			account_id, currency, original_sum, exchange_rate
 		) VALUES (
-		 $1, $2, $3, $4, $5, $6, NOW(), $7, $8 , $9, $10
+		 $1, $2, $3, $4, $5, $6, NOW(), $7, $8 , $9, $10, $11, $12, $13, $14
 		)
 	`
-	var err error
+	id := uuid.NewString()
 	if expenseType == "USER" {
-		_, err = r.db.Exec(query, uuid.New(), title, userId, nil, expenseType, sum, givenDate, createdBy, paymentMethod, companyId)
+		_, err = tx.Exec(query, id, title, userId, nil, expenseType, p.amount, givenDate, createdBy, p.method, companyId, p.accountId, p.currency, p.original, p.rate)
 	} else {
-		_, err = r.db.Exec(query, uuid.New(), title, nil, categoryId, expenseType, sum, givenDate, createdBy, paymentMethod, companyId)
+		_, err = tx.Exec(query, id, title, nil, categoryId, expenseType, p.amount, givenDate, createdBy, p.method, companyId, p.accountId, p.currency, p.original, p.rate)
 	}
 
 	if err != nil {
 		return status.Errorf(codes.Aborted, "error while inserting expense %v", err)
 	}
-	return nil
+	if err = postDocument(tx, SourceExpense, id); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
+
+// DeleteExpense removes an expense. Its journal entry stays and is reversed.
 func (r *ExpenseRepository) DeleteExpense(companyId, id string) error {
-	_, err := r.db.Exec(`DELETE FROM expense where id=$1 and company_id=$2`, id, companyId)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	var entryId string
+	err = tx.QueryRow(`SELECT id FROM journal_entry WHERE source = $1 AND source_id::text = $2 AND company_id = $3 AND reversal_of IS NULL`,
+		SourceExpense, id, companyId).Scan(&entryId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to find the entry of the expense: %v", err)
+	}
+	if entryId != "" {
+		if _, err = reverseEntry(tx, companyId, entryId, "", "expense deleted", "", ""); err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(`DELETE FROM expense where id=$1 and company_id=$2`, id, companyId)
 	if err != nil {
 		return status.Errorf(codes.Aborted, "error while deleting expense %v", err)
 	}
-	return nil
+	return tx.Commit()
 }
 func (r *ExpenseRepository) GetExpenseDiagram(ctx context.Context, companyId, to, from string) (*pb.GetAllExpenseDiagramResponse, error) {
+	// the expense accounts of the ledger, so the total is the one of the
+	// profit and loss report; salaries are grouped by user
 	query := `
 		SELECT 
 			CASE 
-				WHEN expense_type = 'USER' THEN user_id::text
-				WHEN expense_type = 'CATEGORY' THEN c.name
+				WHEN l.user_id IS NOT NULL THEN l.user_id::text
+				ELSE coalesce(c.name, a.name)
 			END AS userOrCategories,
-			SUM(e.sum) AS userOrCategoriesAmount,
-			TO_CHAR(e.given_date, 'YYYY-MM') AS month,
-			SUM(SUM(e.sum)) OVER () AS amountCommonExpense,
-			expense_type,
-			user_id
-		FROM expense e 
-		LEFT JOIN category c ON e.category_id = c.id
-		WHERE e.given_date BETWEEN $1 AND $2 and e.company_id=$3
-		GROUP BY userOrCategories, month, expense_type, user_id
+			SUM(l.debit - l.credit) AS userOrCategoriesAmount,
+			TO_CHAR(e.entry_date, 'YYYY-MM') AS month,
+			SUM(SUM(l.debit - l.credit)) OVER () AS amountCommonExpense,
+			CASE WHEN l.user_id IS NOT NULL THEN 'USER' ELSE 'CATEGORY' END AS expense_type,
+			l.user_id
+		FROM ` + ledgerLines + `
+		LEFT JOIN category c ON l.category_id = c.id
+		WHERE a.type = 'EXPENSE' AND e.entry_date BETWEEN $1 AND $2 and l.company_id=$3
+		GROUP BY userOrCategories, month, expense_type, l.user_id
+		HAVING SUM(l.debit - l.credit) <> 0
 		ORDER BY month;
 		`
 
@@ -70,17 +114,17 @@ func (r *ExpenseRepository) GetExpenseDiagram(ctx context.Context, companyId, to
 	defer rows.Close()
 
 	var response pb.GetAllExpenseDiagramResponse
-	userOrCategoriesMap := make(map[string]float64)
-	monthAmountMap := make(map[string]float64)
-	var commonExpense float64
+	userOrCategoriesMap := make(map[string]decimal.Decimal)
+	monthAmountMap := make(map[string]decimal.Decimal)
+	var commonExpense decimal.Decimal
 	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 	defer cancelFunc()
 	for rows.Next() {
 		var (
 			userOrCategory       string
-			userOrCategoryAmount float64
+			userOrCategoryAmount decimal.Decimal
 			month                string
-			amountCommonExpense  float64
+			amountCommonExpense  decimal.Decimal
 			expenseType          string
 			userID               sql.NullString
 		)
@@ -96,22 +140,22 @@ func (r *ExpenseRepository) GetExpenseDiagram(ctx context.Context, companyId, to
 			userOrCategory = userResp.Name
 		}
 
-		userOrCategoriesMap[userOrCategory] += userOrCategoryAmount
-		monthAmountMap[month] += userOrCategoryAmount
+		userOrCategoriesMap[userOrCategory] = userOrCategoriesMap[userOrCategory].Add(userOrCategoryAmount)
+		monthAmountMap[month] = monthAmountMap[month].Add(userOrCategoryAmount)
 		commonExpense = amountCommonExpense
 	}
 
 	for userOrCategory, amount := range userOrCategoriesMap {
 		response.UserOrCategories = append(response.UserOrCategories, userOrCategory)
-		response.UserOrCategoriesAmount = append(response.UserOrCategoriesAmount, fmt.Sprintf("%.2f", amount))
+		response.UserOrCategoriesAmount = append(response.UserOrCategoriesAmount, money.Format(amount))
 	}
 
 	for month, amount := range monthAmountMap {
 		response.Months = append(response.Months, month)
-		response.MonthAmount = append(response.MonthAmount, fmt.Sprintf("%.2f", amount))
+		response.MonthAmount = append(response.MonthAmount, money.Format(amount))
 	}
 
-	response.AmountCommonExpense = fmt.Sprintf("%.2f", commonExpense)
+	response.AmountCommonExpense = money.Format(commonExpense)
 
 	return &response, nil
 }
@@ -136,9 +180,15 @@ func (r *ExpenseRepository) GetAllExpense(ctx context.Context, companyId string,
             e.sum,
             e.created_by,
             e.payment_method,
-            e.created_at
+            e.created_at,
+            coalesce(e.account_id::text, ''),
+            coalesce(a.name, ''),
+            e.currency,
+            coalesce(e.original_sum, e.sum),
+            e.exchange_rate
         FROM expense e
         LEFT JOIN category c ON e.category_id = c.id
+        LEFT JOIN cash_account a ON a.id = e.account_id
         WHERE e.given_date BETWEEN $1 AND $2 AND e.company_id = $3`
 
 	args := []interface{}{from, to, companyId}
@@ -216,7 +266,7 @@ func (r *ExpenseRepository) scanExpense(ctx context.Context, rows *sql.Rows) (*p
 		categoryID                            int
 		categoryName, categoryDesc            string
 		userID                                sql.NullString
-		sum                                   float64
+		sum                                   decimal.Decimal
 		paymentMethod, expenseType, createdBy string
 	)
 
@@ -233,13 +283,18 @@ func (r *ExpenseRepository) scanExpense(ctx context.Context, rows *sql.Rows) (*p
 		&createdBy,
 		&paymentMethod,
 		&expense.CreatedAt,
+		&expense.AccountId,
+		&expense.AccountName,
+		&expense.Currency,
+		&expense.OriginalSum,
+		&expense.ExchangeRate,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error scanning expense row: %w", err)
 	}
 
 	expense.ExpenseType = expenseType
-	expense.Sum = fmt.Sprintf("%.2f", sum)
+	expense.Sum = money.Format(sum)
 	expense.PaymentType = paymentMethod
 
 	if categoryID > 0 {
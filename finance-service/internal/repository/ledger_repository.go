@@ -0,0 +1,683 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"finance-service/internal/money"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"time"
+)
+
+const (
+	LedgerCash     = "1100"
+	LedgerStudents = "2100"
+	LedgerSponsors = "2200"
+	LedgerEquity   = "3000"
+	LedgerTuition  = "4100"
+	LedgerRefunds  = "4900"
+	LedgerExpenses = "5100"
+	LedgerPayroll  = "5200"
+
+	SourceStudentPayment = "STUDENT_PAYMENT"
+	SourceSponsorPayment = "SPONSOR_PAYMENT"
+	SourceExpense        = "EXPENSE"
+	SourceManual         = "MANUAL"
+)
+
+var ledgerTypes = map[string]bool{"ASSET": true, "LIABILITY": true, "EQUITY": true, "INCOME": true, "EXPENSE": true}
+
+// systemChart is the chart of accounts every company starts with, created
+// the first time it posts to or looks at its ledger.
+var systemChart = []struct {
+	code, name, accountType string
+	isCash                  bool
+}{
+	{LedgerCash, "Kassa va bank", "ASSET", true},
+	{LedgerStudents, "O'quvchilar balansi", "LIABILITY", false},
+	{LedgerSponsors, "Homiylar balansi", "LIABILITY", false},
+	{LedgerEquity, "Kapital", "EQUITY", false},
+	{LedgerTuition, "O'qish to'lovlari", "INCOME", false},
+	{LedgerRefunds, "Qaytarilgan to'lovlar", "INCOME", false},
+	{LedgerExpenses, "Xarajatlar", "EXPENSE", false},
+	{LedgerPayroll, "Ish haqi", "EXPENSE", false},
+}
+
+// controlAccounts hold the balances of students and sponsors. Only their
+// payments move them, education-service keeps the same balances.
+var controlAccounts = map[string]bool{LedgerStudents: true, LedgerSponsors: true}
+
+// postingRules are the accounts a document of a kind debits and credits.
+// Salaries are expenses on a user and are recognized when paid out.
+var postingRules = map[string]map[string][2]string{
+	SourceStudentPayment: {
+		"ADD":      {LedgerCash, LedgerStudents},
+		"TAKE_OFF": {LedgerStudents, LedgerTuition},
+		"REFUND":   {LedgerRefunds, LedgerStudents},
+	},
+	SourceSponsorPayment: {
+		SponsorPaymentAdd:    {LedgerCash, LedgerSponsors},
+		SponsorPaymentCharge: {LedgerSponsors, LedgerTuition},
+		SponsorPaymentRefund: {LedgerRefunds, LedgerSponsors},
+	},
+	SourceExpense: {
+		"CATEGORY": {LedgerExpenses, LedgerCash},
+		"USER":     {LedgerPayroll, LedgerCash},
+	},
+}
+
+// documentQueries load what a document posts: its company, kind, signed
+// amount, date and description, the document it reverses, who made it and
+// what its lines are about.
+var documentQueries = map[string]string{
+	SourceStudentPayment: `SELECT company_id, payment_type, amount, to_char(given_date, 'YYYY-MM-DD'), comment, coalesce(reversal_of::text, ''),
+       created_by_id, created_by_name, student_id::text, '', coalesce(account_id::text, ''), '', NULL::int
+FROM student_payments WHERE id::text = $1`,
+	SourceSponsorPayment: `SELECT company_id, payment_type, amount, to_char(given_date, 'YYYY-MM-DD'), comment, coalesce(reversal_of::text, ''),
+       created_by_id, created_by_name, coalesce(student_id::text, ''), sponsor_id::text, coalesce(account_id::text, ''), '', NULL::int
+FROM sponsor_payments WHERE id::text = $1`,
+	SourceExpense: `SELECT company_id, expense_type, sum, to_char(given_date, 'YYYY-MM-DD'), title, '',
+       created_by, '', '', '', coalesce(account_id::text, ''), coalesce(user_id::text, ''), category_id
+FROM expense WHERE id::text = $1`,
+}
+
+// ledgerLines are the posted lines with their entry and account.
+const ledgerLines = `journal_line l
+         JOIN journal_entry e ON e.id = l.entry_id
+         JOIN ledger_account a ON a.company_id = l.company_id AND a.code = l.account_code`
+
+type journalLine struct {
+	account       string
+	debit         decimal.Decimal
+	credit        decimal.Decimal
+	studentId     string
+	sponsorId     string
+	cashAccountId string
+	userId        string
+	categoryId    sql.NullInt64
+}
+
+type journalEntry struct {
+	companyId     string
+	date          string
+	source        string
+	sourceId      string
+	description   string
+	reversalOf    string
+	createdById   string
+	createdByName string
+	lines         []journalLine
+}
+
+type LedgerRepository struct {
+	db *sql.DB
+}
+
+func NewLedgerRepository(db *sql.DB) *LedgerRepository {
+	return &LedgerRepository{db: db}
+}
+
+// ensureChart creates the system chart of a company unless it has it.
+func ensureChart(q execQuerier, companyId string) error {
+	var accountCodes, names, types []string
+	var cash []bool
+	for _, a := range systemChart {
+		accountCodes, names, types, cash = append(accountCodes, a.code), append(names, a.name), append(types, a.accountType), append(cash, a.isCash)
+	}
+	_, err := q.Exec(`INSERT INTO ledger_account (company_id, code, name, type, is_cash, is_system)
+SELECT $1, a.code, a.name, a.type, a.is_cash, true
+FROM unnest($2::varchar[], $3::varchar[], $4::varchar[], $5::boolean[]) a(code, name, type, is_cash)
+ON CONFLICT DO NOTHING`, companyId, pq.Array(accountCodes), pq.Array(names), pq.Array(types), pq.Array(cash))
+	if err != nil {
+		return fmt.Errorf("failed to create the chart of accounts: %v", err)
+	}
+	return nil
+}
+
+// writeEntry checks that an entry balances and posts it.
+func writeEntry(q execQuerier, e *journalEntry) (string, error) {
+	if len(e.lines) < 2 {
+		return "", status.Error(codes.InvalidArgument, "an entry needs at least two lines")
+	}
+	debit, credit := money.Zero, money.Zero
+	for i := range e.lines {
+		l := &e.lines[i]
+		l.debit, l.credit = money.Round(l.debit), money.Round(l.credit)
+		if l.debit.IsNegative() || l.credit.IsNegative() || l.debit.IsPositive() == l.credit.IsPositive() {
+			return "", status.Errorf(codes.InvalidArgument, "line %d must have either a debit or a credit above 0", i+1)
+		}
+		debit, credit = debit.Add(l.debit), credit.Add(l.credit)
+	}
+	if !debit.Equal(credit) {
+		return "", status.Errorf(codes.InvalidArgument, "debits %s and credits %s do not balance", money.Format(debit), money.Format(credit))
+	}
+	if err := ensureChart(q, e.companyId); err != nil {
+		return "", err
+	}
+	if e.createdById == "" {
+		e.createdById = uuid.Nil.String()
+	}
+	id := uuid.NewString()
+	_, err := q.Exec(`INSERT INTO journal_entry (id, company_id, entry_date, source, source_id, description, reversal_of, created_by_id, created_by_name)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		id, e.companyId, e.date, e.source, nullIfEmpty(e.sourceId), e.description, nullIfEmpty(e.reversalOf), e.createdById, e.createdByName)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return "", status.Error(codes.AlreadyExists, "the document is already posted")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to post journal entry: %v", err)
+	}
+	for _, l := range e.lines {
+		_, err = q.Exec(`INSERT INTO journal_line (entry_id, company_id, account_code, debit, credit, student_id, sponsor_id, cash_account_id, user_id, category_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			id, e.companyId, l.account, l.debit, l.credit, nullIfEmpty(l.studentId), nullIfEmpty(l.sponsorId), nullIfEmpty(l.cashAccountId), nullIfEmpty(l.userId), l.categoryId)
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return "", status.Errorf(codes.InvalidArgument, "account %s or the cash account of its line does not exist", l.account)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to post journal line: %v", err)
+		}
+	}
+	return id, nil
+}
+
+// postDocument posts a payment or an expense to the ledger in the
+// transaction that records it. A reversal has a negative amount, which
+// swaps the sides, and is linked to the entry of the document it reverses.
+func postDocument(q execQuerier, source, id string) error {
+	var e journalEntry
+	var kind, reverses string
+	var amount decimal.Decimal
+	var line journalLine
+	err := q.QueryRow(documentQueries[source], id).Scan(&e.companyId, &kind, &amount, &e.date, &e.description, &reverses,
+		&e.createdById, &e.createdByName, &line.studentId, &line.sponsorId, &line.cashAccountId, &line.userId, &line.categoryId)
+	if err != nil {
+		return fmt.Errorf("failed to load %s %s to post: %v", source, id, err)
+	}
+	rule, ok := postingRules[source][kind]
+	if !ok {
+		return fmt.Errorf("no posting rule for %s %s", source, kind)
+	}
+	if amount.IsZero() {
+		return nil
+	}
+	if reverses != "" {
+		err = q.QueryRow(`SELECT id FROM journal_entry WHERE source = $1 AND source_id::text = $2 AND reversal_of IS NULL`, source, reverses).Scan(&e.reversalOf)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to find the entry to reverse: %v", err)
+		}
+	}
+	e.source, e.sourceId = source, id
+	debitAccount, creditAccount := rule[0], rule[1]
+	if amount.IsNegative() {
+		debitAccount, creditAccount, amount = creditAccount, debitAccount, amount.Neg()
+	}
+	debit, credit := line, line
+	debit.account, debit.debit = debitAccount, amount
+	credit.account, credit.credit = creditAccount, amount
+	e.lines = []journalLine{debit, credit}
+	_, err = writeEntry(q, &e)
+	return err
+}
+
+// reverseEntry posts the lines of an entry again with the sides swapped. The
+// reversal is dated the day it is made, or date when one is given, so the
+// periods already reported on keep their totals.
+func reverseEntry(q execQuerier, companyId, entryId, date, reason, actionById, actionByName string) (string, error) {
+	if date == "" {
+		date = time.Now().Format(time.DateOnly)
+	}
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "date must be YYYY-MM-DD, got %q", date)
+	}
+	var isReversal, isReversed bool
+	err := q.QueryRow(`SELECT reversal_of IS NOT NULL, exists(SELECT 1 FROM journal_entry rv WHERE rv.reversal_of = journal_entry.id)
+FROM journal_entry WHERE id::text = $1 AND company_id = $2 FOR UPDATE`, entryId, companyId).Scan(&isReversal, &isReversed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", status.Error(codes.NotFound, "journal entry not found")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve journal entry: %v", err)
+	}
+	switch {
+	case isReversal:
+		return "", status.Error(codes.FailedPrecondition, "a reversal entry cannot be reversed")
+	case isReversed:
+		return "", status.Error(codes.FailedPrecondition, "journal entry is already reversed")
+	}
+	if actionById == "" {
+		actionById = uuid.Nil.String()
+	}
+	id := uuid.NewString()
+	_, err = q.Exec(`INSERT INTO journal_entry (id, company_id, entry_date, source, source_id, description, reversal_of, created_by_id, created_by_name)
+SELECT $1, company_id, $6, source, source_id, coalesce($2, description), id, $3, $4
+FROM journal_entry WHERE id::text = $5`, id, nullIfEmpty(reason), actionById, actionByName, entryId, date)
+	if err != nil {
+		return "", fmt.Errorf("failed to reverse journal entry: %v", err)
+	}
+	_, err = q.Exec(`INSERT INTO journal_line (entry_id, company_id, account_code, debit, credit, student_id, sponsor_id, cash_account_id, user_id, category_id)
+SELECT $1, company_id, account_code, credit, debit, student_id, sponsor_id, cash_account_id, user_id, category_id
+FROM journal_line WHERE entry_id::text = $2 ORDER BY id`, id, entryId)
+	if err != nil {
+		return "", fmt.Errorf("failed to reverse journal lines: %v", err)
+	}
+	return id, nil
+}
+
+// ledgerPeriod is the period of a report, this month when none is given.
+func ledgerPeriod(from, to string) (string, string, error) {
+	now := time.Now()
+	if from == "" {
+		from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly)
+	}
+	if to == "" {
+		to = now.Format(time.DateOnly)
+	}
+	for name, value := range map[string]string{"from": from, "to": to} {
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return "", "", status.Errorf(codes.InvalidArgument, "%s must be YYYY-MM-DD, got %q", name, value)
+		}
+	}
+	if from > to {
+		return "", "", status.Error(codes.InvalidArgument, "from must not be after to")
+	}
+	return from, to, nil
+}
+
+func (r *LedgerRepository) GetLedgerAccounts(companyId string) (*pb.LedgerAccountList, error) {
+	if err := ensureChart(r.db, companyId); err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`SELECT a.code, a.name, a.type, a.is_cash, a.is_system, coalesce(sum(l.debit - l.credit), 0)
+FROM ledger_account a
+         LEFT JOIN journal_line l ON l.company_id = a.company_id AND l.account_code = a.code
+WHERE a.company_id = $1
+GROUP BY a.code, a.name, a.type, a.is_cash, a.is_system
+ORDER BY a.code`, companyId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.LedgerAccountList{}
+	for rows.Next() {
+		var account pb.LedgerAccount
+		var balance decimal.Decimal
+		if err = rows.Scan(&account.Code, &account.Name, &account.Type, &account.IsCash, &account.IsSystem, &balance); err != nil {
+			return nil, err
+		}
+		account.Balance = money.Format(balance)
+		result.Items = append(result.Items, &account)
+	}
+	return result, rows.Err()
+}
+
+// CreateLedgerAccount adds an account to the chart of a company.
+func (r *LedgerRepository) CreateLedgerAccount(companyId string, req *pb.LedgerAccount) (*pb.LedgerAccount, error) {
+	if _, err := strconv.ParseUint(req.Code, 10, 32); err != nil || len(req.Code) < 3 || len(req.Code) > 10 {
+		return nil, status.Errorf(codes.InvalidArgument, "code must be 3 to 10 digits, got %q", req.Code)
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if !ledgerTypes[req.Type] {
+		return nil, status.Errorf(codes.InvalidArgument, "type must be ASSET, LIABILITY, EQUITY, INCOME or EXPENSE, got %q", req.Type)
+	}
+	if req.IsCash && req.Type != "ASSET" {
+		return nil, status.Error(codes.InvalidArgument, "only an ASSET account can hold cash")
+	}
+	if err := ensureChart(r.db, companyId); err != nil {
+		return nil, err
+	}
+	_, err := r.db.Exec(`INSERT INTO ledger_account (company_id, code, name, type, is_cash) VALUES ($1, $2, $3, $4, $5)`,
+		companyId, req.Code, req.Name, req.Type, req.IsCash)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return nil, status.Errorf(codes.AlreadyExists, "account %s already exists", req.Code)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ledger account: %v", err)
+	}
+	req.IsSystem = false
+	req.Balance = money.Format(money.Zero)
+	return req, nil
+}
+
+// CreateJournalEntry posts an entry by hand, such as money the owners put
+// in. It cannot post to the balances of students and sponsors.
+func (r *LedgerRepository) CreateJournalEntry(companyId string, req *pb.JournalEntry) (*pb.JournalEntry, error) {
+	date := req.Date
+	if date == "" {
+		date = time.Now().Format(time.DateOnly)
+	}
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "date must be YYYY-MM-DD, got %q", date)
+	}
+	entry := journalEntry{companyId: companyId, date: date, source: SourceManual, description: req.Description,
+		createdById: req.ActionById, createdByName: req.ActionByName}
+	for i, l := range req.Lines {
+		if controlAccounts[l.AccountCode] {
+			return nil, status.Errorf(codes.InvalidArgument, "account %s is moved by payments only", l.AccountCode)
+		}
+		for name, value := range map[string]string{"studentId": l.StudentId, "sponsorId": l.SponsorId, "cashAccountId": l.CashAccountId, "userId": l.UserId} {
+			if _, err := uuid.Parse(value); value != "" && err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "line %d: %s must be a UUID, got %q", i+1, name, value)
+			}
+		}
+		line := journalLine{account: l.AccountCode, debit: money.Zero, credit: money.Zero,
+			studentId: l.StudentId, sponsorId: l.SponsorId, cashAccountId: l.CashAccountId, userId: l.UserId}
+		var err error
+		for _, side := range []struct {
+			value  string
+			target *decimal.Decimal
+		}{{l.Debit, &line.debit}, {l.Credit, &line.credit}} {
+			if side.value == "" {
+				continue
+			}
+			if *side.target, err = money.Parse(side.value); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "line %d: invalid amount %q", i+1, side.value)
+			}
+		}
+		if l.CategoryId != "" {
+			if line.categoryId.Int64, err = strconv.ParseInt(l.CategoryId, 10, 32); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "line %d: invalid categoryId %q", i+1, l.CategoryId)
+			}
+			line.categoryId.Valid = true
+		}
+		entry.lines = append(entry.lines, line)
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	id, err := writeEntry(tx, &entry)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit journal entry: %v", err)
+	}
+	return r.getEntry(companyId, id)
+}
+
+// ReverseJournalEntry reverses an entry posted by hand. Entries of payments
+// and expenses are reversed by returning or deleting those.
+func (r *LedgerRepository) ReverseJournalEntry(companyId string, req *pb.ReverseJournalEntryRequest) (*pb.JournalEntry, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	var source string
+	err = tx.QueryRow(`SELECT source FROM journal_entry WHERE id::text = $1 AND company_id = $2`, req.Id, companyId).Scan(&source)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "journal entry not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve journal entry: %v", err)
+	}
+	if source != SourceManual {
+		return nil, status.Error(codes.FailedPrecondition, "an entry of a payment or an expense is reversed by returning or deleting it")
+	}
+	id, err := reverseEntry(tx, companyId, req.Id, req.Date, req.Reason, req.ActionById, req.ActionByName)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit journal entry reversal: %v", err)
+	}
+	return r.getEntry(companyId, id)
+}
+
+func (r *LedgerRepository) GetJournalEntries(companyId string, req *pb.GetJournalEntriesRequest) (*pb.JournalEntryList, error) {
+	for name, value := range map[string]string{"from": req.From, "to": req.To} {
+		if _, err := time.Parse(time.DateOnly, value); value != "" && err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be YYYY-MM-DD, got %q", name, value)
+		}
+	}
+	page, size := req.Page, req.Size
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 20
+	}
+	return r.entries(companyId, req, "", page, size)
+}
+
+func (r *LedgerRepository) getEntry(companyId, id string) (*pb.JournalEntry, error) {
+	list, err := r.entries(companyId, &pb.GetJournalEntriesRequest{}, id, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 {
+		return nil, status.Errorf(codes.NotFound, "journal entry %s not found", id)
+	}
+	return list.Items[0], nil
+}
+
+// entries lists entries newest first, each with its lines.
+func (r *LedgerRepository) entries(companyId string, req *pb.GetJournalEntriesRequest, id string, page, size int32) (*pb.JournalEntryList, error) {
+	rows, err := r.db.Query(`SELECT e.id, e.entry_date, e.source, coalesce(e.source_id::text, ''), e.description, coalesce(e.reversal_of::text, ''),
+       coalesce((SELECT rv.id::text FROM journal_entry rv WHERE rv.reversal_of = e.id), ''), e.created_by_name, e.created_at, count(*) OVER ()
+FROM journal_entry e
+WHERE e.company_id = $1
+  AND ($2 = '' OR e.entry_date >= $2::date)
+  AND ($3 = '' OR e.entry_date <= $3::date)
+  AND ($4 = '' OR e.source = $4)
+  AND ($5 = '' OR exists(SELECT 1 FROM journal_line l WHERE l.entry_id = e.id AND l.account_code = $5))
+  AND ($6 = '' OR exists(SELECT 1 FROM journal_line l WHERE l.entry_id = e.id AND l.student_id::text = $6))
+  AND ($7 = '' OR e.id::text = $7)
+ORDER BY e.entry_date DESC, e.created_at DESC
+LIMIT $8 OFFSET $9`, companyId, req.From, req.To, req.Source, req.AccountCode, req.StudentId, id, size, (page-1)*size)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.JournalEntryList{}
+	byId := map[string]*pb.JournalEntry{}
+	var ids []string
+	for rows.Next() {
+		var entry pb.JournalEntry
+		var date, createdAt time.Time
+		err = rows.Scan(&entry.Id, &date, &entry.Source, &entry.SourceId, &entry.Description, &entry.ReversalOf,
+			&entry.ReversedBy, &entry.CreatedByName, &createdAt, &result.Count)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		entry.Date = date.Format(time.DateOnly)
+		entry.CreatedAt = createdAt.Format(time.DateTime)
+		byId[entry.Id] = &entry
+		ids = append(ids, entry.Id)
+		result.Items = append(result.Items, &entry)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil || len(ids) == 0 {
+		return result, err
+	}
+	rows, err = r.db.Query(`SELECT l.entry_id, l.account_code, a.name, l.debit, l.credit, coalesce(l.student_id::text, ''), coalesce(l.sponsor_id::text, ''),
+       coalesce(l.cash_account_id::text, ''), coalesce(l.user_id::text, ''), coalesce(l.category_id::text, '')
+FROM journal_line l
+         JOIN ledger_account a ON a.company_id = l.company_id AND a.code = l.account_code
+WHERE l.entry_id = ANY ($1::uuid[])
+ORDER BY l.id`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var entryId string
+		var line pb.JournalLine
+		var debit, credit decimal.Decimal
+		err = rows.Scan(&entryId, &line.AccountCode, &line.AccountName, &debit, &credit, &line.StudentId, &line.SponsorId,
+			&line.CashAccountId, &line.UserId, &line.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		line.Debit, line.Credit = money.Format(debit), money.Format(credit)
+		byId[entryId].Lines = append(byId[entryId].Lines, &line)
+	}
+	return result, rows.Err()
+}
+
+// GetTrialBalance sums what was posted to every account up to a day. The
+// debit and credit totals are equal, every entry balances on its own.
+func (r *LedgerRepository) GetTrialBalance(companyId string, req *pb.TrialBalanceRequest) (*pb.TrialBalance, error) {
+	date := req.Date
+	if date == "" {
+		date = time.Now().Format(time.DateOnly)
+	}
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "date must be YYYY-MM-DD, got %q", date)
+	}
+	if err := ensureChart(r.db, companyId); err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`SELECT a.code, a.name, a.type, coalesce(sum(l.debit), 0), coalesce(sum(l.credit), 0)
+FROM ledger_account a
+         LEFT JOIN (journal_line l JOIN journal_entry e ON e.id = l.entry_id AND e.entry_date <= $2)
+                   ON l.company_id = a.company_id AND l.account_code = a.code
+WHERE a.company_id = $1
+GROUP BY a.code, a.name, a.type
+ORDER BY a.code`, companyId, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.TrialBalance{Date: date}
+	totalDebit, totalCredit := money.Zero, money.Zero
+	for rows.Next() {
+		var row pb.TrialBalanceRow
+		var debit, credit decimal.Decimal
+		if err = rows.Scan(&row.Code, &row.Name, &row.Type, &debit, &credit); err != nil {
+			return nil, err
+		}
+		row.Debit, row.Credit, row.Balance = money.Format(debit), money.Format(credit), money.Format(debit.Sub(credit))
+		totalDebit, totalCredit = totalDebit.Add(debit), totalCredit.Add(credit)
+		result.Rows = append(result.Rows, &row)
+	}
+	result.TotalDebit, result.TotalCredit = money.Format(totalDebit), money.Format(totalCredit)
+	return result, rows.Err()
+}
+
+func (r *LedgerRepository) GetProfitAndLoss(companyId string, req *pb.LedgerPeriodRequest) (*pb.ProfitAndLoss, error) {
+	from, to, err := ledgerPeriod(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	if err = ensureChart(r.db, companyId); err != nil {
+		return nil, err
+	}
+	return profitAndLoss(r.db, companyId, from, to)
+}
+
+// profitAndLoss is what every income and expense account took over a
+// period. The dashboards use it too, so they show the same figures.
+func profitAndLoss(db *sql.DB, companyId, from, to string) (*pb.ProfitAndLoss, error) {
+	rows, err := db.Query(`SELECT a.code, a.name, a.type, coalesce(sum(l.credit - l.debit), 0)
+FROM ledger_account a
+         LEFT JOIN (journal_line l JOIN journal_entry e ON e.id = l.entry_id AND e.entry_date BETWEEN $2 AND $3)
+                   ON l.company_id = a.company_id AND l.account_code = a.code
+WHERE a.company_id = $1 AND a.type IN ('INCOME', 'EXPENSE')
+GROUP BY a.code, a.name, a.type
+ORDER BY a.code`, companyId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.ProfitAndLoss{From: from, To: to}
+	income, expense := money.Zero, money.Zero
+	for rows.Next() {
+		var row pb.ProfitAndLossRow
+		var accountType string
+		var amount decimal.Decimal
+		if err = rows.Scan(&row.Code, &row.Name, &accountType, &amount); err != nil {
+			return nil, err
+		}
+		if accountType == "INCOME" {
+			income = income.Add(amount)
+			row.Amount = money.Format(amount)
+			result.Income = append(result.Income, &row)
+			continue
+		}
+		expense = expense.Sub(amount)
+		row.Amount = money.Format(amount.Neg())
+		result.Expenses = append(result.Expenses, &row)
+	}
+	result.TotalIncome, result.TotalExpense = money.Format(income), money.Format(expense)
+	result.NetProfit = money.Format(income.Sub(expense))
+	return result, rows.Err()
+}
+
+// GetCashFlow is the money in and out of every cash account over a period,
+// and the accounts on the other side of it: students, sponsors, expenses,
+// payroll and entries made by hand.
+func (r *LedgerRepository) GetCashFlow(companyId string, req *pb.LedgerPeriodRequest) (*pb.CashFlow, error) {
+	from, to, err := ledgerPeriod(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`SELECT l.account_code, coalesce(l.cash_account_id::text, ''), coalesce(c.name, a.name),
+       coalesce(sum(l.debit - l.credit) FILTER (WHERE e.entry_date < $2), 0),
+       coalesce(sum(l.debit) FILTER (WHERE e.entry_date >= $2), 0),
+       coalesce(sum(l.credit) FILTER (WHERE e.entry_date >= $2), 0)
+FROM `+ledgerLines+`
+         LEFT JOIN cash_account c ON c.id = l.cash_account_id
+WHERE l.company_id = $1 AND a.is_cash AND e.entry_date <= $3
+GROUP BY l.account_code, l.cash_account_id, c.name, a.name
+ORDER BY l.account_code, 3`, companyId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.CashFlow{From: from, To: to}
+	opening, inflow, outflow := money.Zero, money.Zero, money.Zero
+	for rows.Next() {
+		var account pb.CashFlowAccount
+		var accountOpening, in, out decimal.Decimal
+		if err = rows.Scan(&account.LedgerCode, &account.CashAccountId, &account.Name, &accountOpening, &in, &out); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		account.Opening, account.Inflow, account.Outflow = money.Format(accountOpening), money.Format(in), money.Format(out)
+		account.Closing = money.Format(accountOpening.Add(in).Sub(out))
+		opening, inflow, outflow = opening.Add(accountOpening), inflow.Add(in), outflow.Add(out)
+		result.Accounts = append(result.Accounts, &account)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	result.Opening, result.Inflow, result.Outflow = money.Format(opening), money.Format(inflow), money.Format(outflow)
+	result.Closing = money.Format(opening.Add(inflow).Sub(outflow))
+
+	rows, err = r.db.Query(`SELECT a.code, a.name, coalesce(sum(l.credit), 0), coalesce(sum(l.debit), 0)
+FROM `+ledgerLines+`
+WHERE l.company_id = $1 AND NOT a.is_cash AND e.entry_date BETWEEN $2 AND $3
+  AND exists(SELECT 1
+             FROM journal_line cl
+                      JOIN ledger_account ca ON ca.company_id = cl.company_id AND ca.code = cl.account_code
+             WHERE cl.entry_id = l.entry_id AND ca.is_cash)
+GROUP BY a.code, a.name
+ORDER BY a.code`, companyId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var activity pb.CashFlowActivity
+		var in, out decimal.Decimal
+		if err = rows.Scan(&activity.Code, &activity.Name, &in, &out); err != nil {
+			return nil, err
+		}
+		activity.Inflow, activity.Outflow, activity.Net = money.Format(in), money.Format(out), money.Format(in.Sub(out))
+		result.Activities = append(result.Activities, &activity)
+	}
+	return result, rows.Err()
+}
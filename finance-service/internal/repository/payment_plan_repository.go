@@ -0,0 +1,663 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance-service/internal/clients"
+	"finance-service/internal/money"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+const (
+	PlanActive    = "ACTIVE"
+	PlanCompleted = "COMPLETED"
+	PlanCancelled = "CANCELLED"
+
+	InstallmentPending = "PENDING"
+	InstallmentPartial = "PARTIAL"
+	InstallmentPaid    = "PAID"
+	InstallmentOverdue = "OVERDUE"
+
+	maxInstallments = 60
+	lateFeeBatch    = 500
+)
+
+type PaymentPlanRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+	outbox          *BalanceOutbox
+}
+
+func NewPaymentPlanRepository(db *sql.DB, educationClient *clients.EducationClient, outbox *BalanceOutbox) *PaymentPlanRepository {
+	return &PaymentPlanRepository{db: db, educationClient: educationClient, outbox: outbox}
+}
+
+// addMonths keeps the day of the month, or takes the last day of a shorter
+// month, so a plan due on the 31st stays at the end of every month.
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	day := min(t.Day(), first.AddDate(0, 1, -1).Day())
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, t.Location())
+}
+
+// buildSchedule is the explicit schedule of a request, or the total split in
+// even installments a number of months apart. Rounding is left to the last
+// installment, see money.Split.
+func buildSchedule(req *pb.CreatePaymentPlanRequest) ([]*pb.Installment, error) {
+	total, err := money.Parse(req.Total)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "total: %v", err)
+	}
+	if !total.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "total must be above 0")
+	}
+	var installments []*pb.Installment
+	if len(req.Schedule) > 0 {
+		if len(req.Schedule) > maxInstallments {
+			return nil, status.Errorf(codes.InvalidArgument, "a plan has at most %d installments", maxInstallments)
+		}
+		sum := money.Zero
+		for i, scheduled := range req.Schedule {
+			if _, err := time.Parse(time.DateOnly, scheduled.DueDate); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "dueDate must be YYYY-MM-DD, got %q", scheduled.DueDate)
+			}
+			if i > 0 && scheduled.DueDate <= req.Schedule[i-1].DueDate {
+				return nil, status.Error(codes.InvalidArgument, "due dates of the schedule must be in order and different")
+			}
+			amount, err := money.Parse(scheduled.Amount)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "installment %d: %v", i+1, err)
+			}
+			if !amount.IsPositive() {
+				return nil, status.Error(codes.InvalidArgument, "every installment must be above 0")
+			}
+			installments = append(installments, &pb.Installment{Number: int32(i + 1), DueDate: scheduled.DueDate, Amount: money.Format(amount), Status: InstallmentPending})
+			sum = sum.Add(amount)
+		}
+		if !sum.Equal(total) {
+			return nil, status.Errorf(codes.InvalidArgument, "the schedule adds up to %s, not to the total %s", money.Format(sum), money.Format(total))
+		}
+		return installments, nil
+	}
+
+	count := int(req.Installments)
+	if count < 1 || count > maxInstallments {
+		return nil, status.Errorf(codes.InvalidArgument, "installments must be between 1 and %d", maxInstallments)
+	}
+	first, err := time.Parse(time.DateOnly, req.FirstDueDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "firstDueDate must be YYYY-MM-DD, got %q", req.FirstDueDate)
+	}
+	interval := int(req.IntervalMonths)
+	if interval <= 0 {
+		interval = 1
+	}
+	for i, amount := range money.Split(total, count) {
+		installments = append(installments, &pb.Installment{
+			Number:  int32(i + 1),
+			DueDate: addMonths(first, i*interval).Format(time.DateOnly),
+			Amount:  money.Format(amount),
+			Status:  InstallmentPending,
+		})
+	}
+	return installments, nil
+}
+
+func validateLateFee(req *pb.CreatePaymentPlanRequest) error {
+	if req.LateFeeType == "" {
+		req.LateFeeValue = money.Format(money.Zero)
+	}
+	value, err := money.Parse(req.LateFeeValue)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "lateFeeValue: %v", err)
+	}
+	req.LateFeeValue = money.Format(value)
+	switch req.LateFeeType {
+	case "":
+	case CoverFixed:
+		if !value.IsPositive() {
+			return status.Error(codes.InvalidArgument, "a fixed late fee must be above 0")
+		}
+	case CoverPercent:
+		if !value.IsPositive() || value.GreaterThan(decimal.NewFromInt(100)) {
+			return status.Error(codes.InvalidArgument, "a percent late fee must be above 0 and at most 100")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "late fee type must be FIXED or PERCENT, got %q", req.LateFeeType)
+	}
+	if req.GraceDays < 0 {
+		return status.Error(codes.InvalidArgument, "graceDays must not be negative")
+	}
+	return nil
+}
+
+// PreviewPaymentPlan shows the schedule a request would create.
+func (r *PaymentPlanRepository) PreviewPaymentPlan(req *pb.CreatePaymentPlanRequest) (*pb.PaymentPlan, error) {
+	if err := validateLateFee(req); err != nil {
+		return nil, err
+	}
+	installments, err := buildSchedule(req)
+	if err != nil {
+		return nil, err
+	}
+	plan := &pb.PaymentPlan{
+		StudentId:    req.StudentId,
+		GroupId:      req.GroupId,
+		LateFeeType:  req.LateFeeType,
+		LateFeeValue: req.LateFeeValue,
+		GraceDays:    req.GraceDays,
+		Comment:      req.Comment,
+		Installments: installments,
+	}
+	plan.Summary = planSummary(plan, PlanActive)
+	return plan, nil
+}
+
+func (r *PaymentPlanRepository) CreatePaymentPlan(ctx context.Context, companyId string, req *pb.CreatePaymentPlanRequest) (*pb.PaymentPlan, error) {
+	if err := validateLateFee(req); err != nil {
+		return nil, err
+	}
+	if _, err := strconv.ParseInt(req.GroupId, 10, 64); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group id %q", req.GroupId)
+	}
+	installments, err := buildSchedule(req)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
+	defer cancel()
+	if _, _, _, err = r.educationClient.GetStudentById(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	planId := uuid.NewString()
+	_, err = tx.Exec(`INSERT INTO payment_plan (id, company_id, student_id, group_id, late_fee_type, late_fee_value, grace_days, comment, created_by_id, created_by_name)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		planId, companyId, req.StudentId, req.GroupId, nullIfEmpty(req.LateFeeType), req.LateFeeValue, req.GraceDays, req.Comment,
+		req.ActionById, req.ActionByName)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return nil, status.Error(codes.AlreadyExists, "the student already has a running payment plan in this group")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment plan: %v", err)
+	}
+	for _, installment := range installments {
+		_, err = tx.Exec(`INSERT INTO plan_installment (id, plan_id, number, due_date, amount) VALUES ($1, $2, $3, $4, $5)`,
+			uuid.New(), planId, installment.Number, installment.DueDate, installment.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create installment: %v", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit payment plan: %v", err)
+	}
+	return r.GetPaymentPlan(companyId, planId)
+}
+
+// GetPaymentPlan is a plan with its installments and the payments matched
+// to each of them.
+func (r *PaymentPlanRepository) GetPaymentPlan(companyId, id string) (*pb.PaymentPlan, error) {
+	plans, err := loadPaymentPlans(r.db, companyId, "", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(plans) == 0 {
+		return nil, status.Errorf(codes.NotFound, "payment plan %s not found", id)
+	}
+	plan := plans[0]
+	rows, err := r.db.Query(`SELECT a.installment_id, a.payment_id, a.amount, sp.given_date
+FROM plan_allocation a
+         JOIN plan_installment i ON i.id = a.installment_id
+         JOIN student_payments sp ON sp.id = a.payment_id
+WHERE i.plan_id = $1
+ORDER BY a.created_at`, plan.Id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	byId := map[string]*pb.Installment{}
+	for _, installment := range plan.Installments {
+		byId[installment.Id] = installment
+	}
+	for rows.Next() {
+		var installmentId string
+		var payment pb.InstallmentPayment
+		var date time.Time
+		if err = rows.Scan(&installmentId, &payment.PaymentId, &payment.Amount, &date); err != nil {
+			return nil, err
+		}
+		payment.Date = date.Format(time.DateOnly)
+		if installment, ok := byId[installmentId]; ok {
+			installment.Payments = append(installment.Payments, &payment)
+		}
+	}
+	return plan, rows.Err()
+}
+
+func (r *PaymentPlanRepository) GetStudentPaymentPlans(companyId, studentId string) (*pb.PaymentPlanStatusList, error) {
+	plans, err := loadPaymentPlans(r.db, companyId, studentId, "")
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.PaymentPlanStatusList{}
+	for _, plan := range plans {
+		result.Items = append(result.Items, plan.Summary)
+	}
+	return result, nil
+}
+
+// CancelPaymentPlan stops a running plan. Payments already matched to it
+// stay matched.
+func (r *PaymentPlanRepository) CancelPaymentPlan(companyId string, req *pb.PaymentPlanRequest) (*pb.PaymentPlan, error) {
+	result, err := r.db.Exec(`UPDATE payment_plan SET status = 'CANCELLED', cancel_reason = $3 WHERE id::text = $1 AND company_id = $2 AND status = 'ACTIVE'`,
+		req.Id, companyId, nullIfEmpty(req.Reason))
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		if _, err = r.GetPaymentPlan(companyId, req.Id); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.FailedPrecondition, "only a running payment plan can be cancelled")
+	}
+	return r.GetPaymentPlan(companyId, req.Id)
+}
+
+// GetOverdueInstallments lists the unpaid installments of running plans past
+// their due date and grace days, longest overdue first.
+func (r *PaymentPlanRepository) GetOverdueInstallments(ctx context.Context, companyId string, req *pb.GetOverdueInstallmentsRequest) (*pb.OverdueInstallmentList, error) {
+	page, size := req.Page, req.Size
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 20
+	}
+	rows, err := r.db.Query(`SELECT p.id, i.id, p.student_id, p.group_id::text, i.number, i.due_date, current_date - i.due_date,
+       i.amount + i.late_fee - i.paid, i.late_fee, count(*) OVER ()
+FROM plan_installment i
+         JOIN payment_plan p ON p.id = i.plan_id
+WHERE p.company_id = $1
+  AND p.status = 'ACTIVE'
+  AND i.paid < i.amount + i.late_fee
+  AND i.due_date + p.grace_days < current_date
+ORDER BY i.due_date, i.number
+LIMIT $2 OFFSET $3`, companyId, size, (page-1)*size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
+	defer cancel()
+	names := studentNames{client: r.educationClient}
+	result := &pb.OverdueInstallmentList{}
+	for rows.Next() {
+		var item pb.OverdueInstallment
+		var dueDate time.Time
+		err = rows.Scan(&item.PlanId, &item.InstallmentId, &item.StudentId, &item.GroupId, &item.Number, &dueDate, &item.DaysOverdue,
+			&item.Due, &item.LateFee, &result.Count)
+		if err != nil {
+			return nil, err
+		}
+		item.DueDate = dueDate.Format(time.DateOnly)
+		item.StudentName = names.get(ctx, item.StudentId)
+		result.Items = append(result.Items, &item)
+	}
+	return result, rows.Err()
+}
+
+// loadPaymentPlans loads plans of a company, of a student or a single one,
+// newest first, with their installments and where they stand today.
+func loadPaymentPlans(db *sql.DB, companyId, studentId, planId string) ([]*pb.PaymentPlan, error) {
+	rows, err := db.Query(`SELECT p.id, p.student_id, p.group_id::text, p.status, coalesce(p.late_fee_type, ''), p.late_fee_value, p.grace_days,
+       p.comment, p.created_by_name, p.created_at, coalesce(p.cancel_reason, ''),
+       i.id, i.number, i.due_date, i.amount, i.late_fee, i.paid, i.paid_at
+FROM payment_plan p
+         JOIN plan_installment i ON i.plan_id = p.id
+WHERE p.company_id = $1
+  AND ($2 = '' OR p.student_id::text = $2)
+  AND ($3 = '' OR p.id::text = $3)
+ORDER BY p.created_at DESC, p.id, i.number`, companyId, studentId, planId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var plans []*pb.PaymentPlan
+	statuses := map[*pb.PaymentPlan]string{}
+	var plan *pb.PaymentPlan
+	for rows.Next() {
+		var current pb.PaymentPlan
+		var planStatus string
+		var createdAt, dueDate time.Time
+		var paidAt sql.NullTime
+		var installment pb.Installment
+		err = rows.Scan(&current.Id, &current.StudentId, &current.GroupId, &planStatus, &current.LateFeeType, &current.LateFeeValue, &current.GraceDays,
+			&current.Comment, &current.CreatedBy, &createdAt, &current.CancelReason,
+			&installment.Id, &installment.Number, &dueDate, &installment.Amount, &installment.LateFee, &installment.Paid, &paidAt)
+		if err != nil {
+			return nil, err
+		}
+		if plan == nil || plan.Id != current.Id {
+			plan = &current
+			plan.CreatedAt = createdAt.Format(time.DateTime)
+			plans = append(plans, plan)
+			statuses[plan] = planStatus
+		}
+		installment.DueDate = dueDate.Format(time.DateOnly)
+		if paidAt.Valid {
+			installment.PaidAt = paidAt.Time.Format(time.DateOnly)
+		}
+		installment.Status = installmentStatus(&installment, plan.GraceDays, time.Now())
+		plan.Installments = append(plan.Installments, &installment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, plan := range plans {
+		plan.Summary = planSummary(plan, statuses[plan])
+	}
+	return plans, nil
+}
+
+func installmentStatus(installment *pb.Installment, graceDays int32, now time.Time) string {
+	due, _ := time.Parse(time.DateOnly, installment.DueDate)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch {
+	case !money.Stored(installment.Paid).LessThan(money.Stored(installment.Amount).Add(money.Stored(installment.LateFee))):
+		return InstallmentPaid
+	case due.AddDate(0, 0, int(graceDays)).Before(today):
+		return InstallmentOverdue
+	case money.Stored(installment.Paid).IsPositive():
+		return InstallmentPartial
+	default:
+		return InstallmentPending
+	}
+}
+
+// planSummary adds a plan's installments up. A running plan is ON_TRACK or
+// OVERDUE, a finished one keeps its status.
+func planSummary(plan *pb.PaymentPlan, planStatus string) *pb.PaymentPlanStatus {
+	summary := &pb.PaymentPlanStatus{PlanId: plan.Id, GroupId: plan.GroupId, Status: planStatus}
+	var total, paid, overdue decimal.Decimal
+	for _, installment := range plan.Installments {
+		owed := money.Stored(installment.Amount).Add(money.Stored(installment.LateFee))
+		left := owed.Sub(money.Stored(installment.Paid))
+		total = total.Add(owed)
+		paid = paid.Add(money.Stored(installment.Paid))
+		if installment.Status == InstallmentPaid {
+			continue
+		}
+		if installment.Status == InstallmentOverdue {
+			overdue = overdue.Add(left)
+			summary.OverdueInstallments++
+		}
+		if summary.NextDueDate == "" {
+			summary.NextDueDate = installment.DueDate
+			summary.NextDueAmount = money.Format(left)
+		}
+	}
+	summary.Total = money.Format(total)
+	summary.Paid = money.Format(paid)
+	summary.Outstanding = money.Format(total.Sub(paid))
+	summary.Overdue = money.Format(overdue)
+	if planStatus == PlanActive {
+		summary.Status = "ON_TRACK"
+		if summary.OverdueInstallments > 0 {
+			summary.Status = InstallmentOverdue
+		}
+	}
+	if planStatus == PlanCancelled {
+		summary.Overdue, summary.OverdueInstallments, summary.NextDueDate, summary.NextDueAmount = money.Format(money.Zero), 0, "", ""
+	}
+	return summary
+}
+
+// studentPlanDebt is what a student is behind on over their running plans,
+// and the next due date.
+func studentPlanDebt(db *sql.DB, companyId, studentId string) (overdue decimal.Decimal, installments int32, nextDue string, err error) {
+	plans, err := loadPaymentPlans(db, companyId, studentId, "")
+	if err != nil {
+		return money.Zero, 0, "", err
+	}
+	for _, plan := range plans {
+		summary := plan.Summary
+		if summary.Status == PlanCompleted || summary.Status == PlanCancelled {
+			continue
+		}
+		overdue = overdue.Add(money.Stored(summary.Overdue))
+		installments += summary.OverdueInstallments
+		if summary.NextDueDate != "" && (nextDue == "" || summary.NextDueDate < nextDue) {
+			nextDue = summary.NextDueDate
+		}
+	}
+	return overdue, installments, nextDue, nil
+}
+
+// allocatePayment matches a payment of a student to the open installments of
+// their running plans: the plan of the payment's group first, then by due
+// date. What is left over stays on the balance only.
+func allocatePayment(tx *sql.Tx, companyId, studentId, groupId, paymentId string, amount decimal.Decimal, date time.Time) error {
+	rows, err := tx.Query(`SELECT i.id, i.amount + i.late_fee - i.paid
+FROM plan_installment i
+         JOIN payment_plan p ON p.id = i.plan_id
+WHERE p.company_id = $1
+  AND p.student_id = $2
+  AND p.status = 'ACTIVE'
+  AND i.paid < i.amount + i.late_fee
+ORDER BY p.group_id::text = $3 DESC, i.due_date, p.created_at, i.number
+FOR UPDATE OF i`, companyId, studentId, groupId)
+	if err != nil {
+		return fmt.Errorf("failed to load installments: %v", err)
+	}
+	type open struct {
+		id  string
+		due decimal.Decimal
+	}
+	var installments []open
+	for rows.Next() {
+		var installment open
+		if err = rows.Scan(&installment.id, &installment.due); err != nil {
+			rows.Close()
+			return err
+		}
+		installments = append(installments, installment)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	if len(installments) == 0 {
+		return nil
+	}
+
+	left := amount
+	for _, installment := range installments {
+		if !left.IsPositive() {
+			break
+		}
+		share := decimal.Min(installment.due, left)
+		_, err = tx.Exec(`INSERT INTO plan_allocation (id, installment_id, payment_id, amount) VALUES ($1, $2, $3, $4)`,
+			uuid.New(), installment.id, paymentId, share)
+		if err != nil {
+			return fmt.Errorf("failed to match payment to installment: %v", err)
+		}
+		_, err = tx.Exec(`UPDATE plan_installment
+SET paid    = paid + $2,
+    paid_at = CASE WHEN paid + $2 >= amount + late_fee THEN $3::date END
+WHERE id = $1`, installment.id, share, date)
+		if err != nil {
+			return fmt.Errorf("failed to update installment: %v", err)
+		}
+		left = left.Sub(share)
+	}
+	return settlePlans(tx, companyId, studentId)
+}
+
+// unallocatePayment takes a returned or edited payment off the installments
+// it was matched to.
+func unallocatePayment(tx *sql.Tx, companyId, studentId, paymentId string) error {
+	result, err := tx.Exec(`UPDATE plan_installment i
+SET paid    = i.paid - a.amount,
+    paid_at = CASE WHEN i.paid - a.amount >= i.amount + i.late_fee THEN i.paid_at END
+FROM plan_allocation a
+WHERE a.installment_id = i.id
+  AND a.payment_id = $1`, paymentId)
+	if err != nil {
+		return fmt.Errorf("failed to update installments: %v", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil
+	}
+	if _, err = tx.Exec(`DELETE FROM plan_allocation WHERE payment_id = $1`, paymentId); err != nil {
+		return fmt.Errorf("failed to remove payment from installments: %v", err)
+	}
+	return settlePlans(tx, companyId, studentId)
+}
+
+// settlePlans completes the running plans of a student that are paid off,
+// and reopens completed ones that are not any more, unless another plan was
+// started for the group since.
+func settlePlans(tx *sql.Tx, companyId, studentId string) error {
+	_, err := tx.Exec(`UPDATE payment_plan p SET status = 'COMPLETED'
+WHERE p.company_id = $1 AND p.student_id = $2 AND p.status = 'ACTIVE'
+  AND NOT exists(SELECT 1 FROM plan_installment i WHERE i.plan_id = p.id AND i.paid < i.amount + i.late_fee)`, companyId, studentId)
+	if err != nil {
+		return fmt.Errorf("failed to complete payment plans: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE payment_plan p SET status = 'ACTIVE'
+WHERE p.company_id = $1 AND p.student_id = $2 AND p.status = 'COMPLETED'
+  AND exists(SELECT 1 FROM plan_installment i WHERE i.plan_id = p.id AND i.paid < i.amount + i.late_fee)
+  AND NOT exists(SELECT 1 FROM payment_plan o
+                 WHERE o.company_id = p.company_id AND o.student_id = p.student_id AND o.group_id = p.group_id AND o.status = 'ACTIVE')`,
+		companyId, studentId)
+	if err != nil {
+		return fmt.Errorf("failed to reopen payment plans: %v", err)
+	}
+	return nil
+}
+
+// RunLateFees charges the late fees of overdue installments every interval
+// until ctx is cancelled.
+func (r *PaymentPlanRepository) RunLateFees(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.applyLateFees(); err != nil {
+			slog.Error("applying late fees failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// applyLateFees charges the late fee of each overdue installment once: it
+// is added to what the installment is owed and taken off the student's
+// balance.
+func (r *PaymentPlanRepository) applyLateFees() error {
+	rows, err := r.db.Query(`SELECT i.id, p.company_id, p.student_id, p.group_id::text, p.late_fee_type, p.late_fee_value, i.amount, i.number
+FROM plan_installment i
+         JOIN payment_plan p ON p.id = i.plan_id
+WHERE p.status = 'ACTIVE'
+  AND p.late_fee_type IS NOT NULL
+  AND i.late_fee_applied_at IS NULL
+  AND i.paid < i.amount
+  AND i.due_date + p.grace_days < current_date
+ORDER BY i.due_date
+LIMIT $1`, lateFeeBatch)
+	if err != nil {
+		return err
+	}
+	type overdue struct {
+		id, companyId, studentId, groupId, feeType string
+		feeValue, amount                           decimal.Decimal
+		number                                     int
+	}
+	var batch []overdue
+	for rows.Next() {
+		var o overdue
+		if err = rows.Scan(&o.id, &o.companyId, &o.studentId, &o.groupId, &o.feeType, &o.feeValue, &o.amount, &o.number); err != nil {
+			rows.Close()
+			return err
+		}
+		batch = append(batch, o)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	charged := 0
+	for _, o := range batch {
+		fee := o.feeValue
+		if o.feeType == CoverPercent {
+			fee = money.Percent(o.amount, o.feeValue)
+		}
+		if err = r.chargeLateFee(o.id, o.companyId, o.studentId, o.groupId, o.number, fee); err != nil {
+			slog.Error("failed to charge late fee", "company_id", o.companyId, "student_id", o.studentId, "installment_id", o.id, "error", err)
+			continue
+		}
+		charged++
+	}
+	if charged > 0 {
+		r.outbox.Wake()
+		slog.Info("late fees charged", "count", charged)
+	}
+	return nil
+}
+
+func (r *PaymentPlanRepository) chargeLateFee(installmentId, companyId, studentId, groupId string, number int, fee decimal.Decimal) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	result, err := tx.Exec(`UPDATE plan_installment SET late_fee = $2, late_fee_applied_at = now() WHERE id = $1 AND late_fee_applied_at IS NULL`,
+		installmentId, fee)
+	if err != nil {
+		return err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		// charged by another replica in the meantime
+		return nil
+	}
+	today := time.Now().Format(time.DateOnly)
+	comment := fmt.Sprintf("%d-to'lov muddati o'tgani uchun jarima", number)
+	paymentId := uuid.NewString()
+	_, err = tx.Exec(`INSERT INTO student_payments (id, student_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, created_at, group_id, company_id)
+VALUES ($1, $2, 'CASH', $3, $4, $5, 'TAKE_OFF', $6, 'TIZIM', now(), $7, $8)`,
+		paymentId, studentId, fee, today, comment, uuid.Nil, groupId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to take off late fee: %v", err)
+	}
+	if err = postDocument(tx, SourceStudentPayment, paymentId); err != nil {
+		return err
+	}
+	err = enqueueBalanceChange(tx, companyId, &pb.ChangeUserBalanceHistoryRequest{
+		StudentId: studentId, Amount: money.Format(fee), GivenDate: today, Comment: comment, PaymentType: "TAKE_OFF",
+		CreatedBy: uuid.Nil.String(), CreatedByName: "TIZIM", GroupId: groupId,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -5,12 +5,17 @@ import (
 	"database/sql"
 	"errors"
 	"finance-service/internal/clients"
+	"finance-service/internal/metrics"
+	"finance-service/internal/money"
 	"finance-service/internal/utils"
 	"finance-service/proto/pb"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 )
@@ -18,26 +23,23 @@ import (
 type PaymentRepository struct {
 	db              *sql.DB
 	educationClient *clients.EducationClient
+	outbox          *BalanceOutbox
 }
 
-func (r *PaymentRepository) AddPayment(ctx context.Context, companyId string, givenDate, sum, method, comment, studentId, actionByName, actionById, groupId string, isRefund bool) error {
+// AddPayment adds money to a student's balance. A sponsored refund gives
+// the sponsors back their part of it first. A payment goes into a cash
+// account and sum is in its currency; the balance gets it in UZS.
+func (r *PaymentRepository) AddPayment(ctx context.Context, companyId string, givenDate, sum, method, comment, studentId, actionByName, actionById, groupId, accountId string, isRefund, sponsored bool) error {
 
 	tx, err := r.db.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %v", err)
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
-	validMethods := map[string]bool{"CLICK": true, "CASH": true, "PAYME": true}
-	if !validMethods[method] {
+	defer tx.Rollback()
+	if !paymentMethods[method] && (isRefund || accountId == "") {
 		return errors.New("invalid payment method")
 	}
-	amount, err := strconv.ParseFloat(sum, 64)
+	amount, err := money.Parse(sum)
 	if err != nil {
 		return fmt.Errorf("invalid sum amount: %v", err)
 	}
@@ -48,50 +50,81 @@ func (r *PaymentRepository) AddPayment(ctx context.Context, companyId string, gi
 	if err != nil {
 		return fmt.Errorf("invalid date format: %v", err)
 	}
+	p := &posting{currency: CurrencyUZS, original: amount, rate: decimal.NewFromInt(1), amount: amount}
+	if !isRefund {
+		if p, err = postTo(tx, companyId, accountId, method, amount, givenDate); err != nil {
+			return err
+		}
+		method, amount = p.method, p.amount
+		sum = money.Format(amount)
+	}
+	if sponsored {
+		covered, err := splitWithSponsors(tx, companyId, studentId, groupId, parsedDate, amount, isRefund, method, comment, actionById, actionByName)
+		if err != nil {
+			return err
+		}
+		amount = amount.Sub(covered)
+		if !amount.IsPositive() {
+			// the sponsors cover all of it, the student's balance is not touched
+			if err = tx.Commit(); err != nil {
+				return fmt.Errorf("failed to commit payment: %v", err)
+			}
+			return nil
+		}
+		sum = money.Format(amount)
+	}
 	paymentID := uuid.New()
 	query := `INSERT INTO student_payments 
-		(id, student_id, method, amount, given_date, comment, created_by_id, created_by_name , created_at , group_id ,payment_type, company_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9 , $10 , $11 , $12)`
+		(id, student_id, method, amount, given_date, comment, created_by_id, created_by_name , created_at , group_id ,payment_type, company_id,
+		 account_id, currency, original_amount, exchange_rate)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9 , $10 , $11 , $12, $13, $14, $15, $16)`
 	paymentType := "ADD"
 	if isRefund {
 		paymentType = "REFUND"
 	}
-	if groupId == "" {
-		_, err = tx.Exec(query, paymentID, studentId, method, amount, parsedDate, comment, actionById, actionByName, time.Now(), nil, paymentType, companyId)
-	} else {
-		_, err = tx.Exec(query, paymentID, studentId, method, amount, parsedDate, comment, actionById, actionByName, time.Now(), groupId, paymentType, companyId)
-	}
+	_, err = tx.Exec(query, paymentID, studentId, method, amount, parsedDate, comment, actionById, actionByName, time.Now(), nullIfEmpty(groupId), paymentType, companyId,
+		nullIfEmpty(p.accountId), p.currency, p.original, p.rate)
 	if err != nil {
 		return fmt.Errorf("failed to add payment: %v", err)
 	}
-	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
-	defer cancelFunc()
-	err = r.educationClient.ChangeUserBalanceHistory(ctx, studentId, sum, givenDate, comment, "ADD", actionById, actionByName, groupId)
+	if err = postDocument(tx, SourceStudentPayment, paymentID.String()); err != nil {
+		return err
+	}
+	if !isRefund {
+		if err = allocatePayment(tx, companyId, studentId, groupId, paymentID.String(), amount, parsedDate); err != nil {
+			return err
+		}
+	}
+	err = enqueueBalanceChange(tx, companyId, &pb.ChangeUserBalanceHistoryRequest{
+		StudentId: studentId, Amount: sum, GivenDate: givenDate, Comment: comment, PaymentType: "ADD",
+		CreatedBy: actionById, CreatedByName: actionByName, GroupId: groupId,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update user balance history: %v", err)
+		return err
 	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit payment: %v", err)
+	}
+	r.outbox.Wake()
+	metrics.PaymentsAdded.WithLabelValues(method, paymentType).Inc()
 	return nil
 }
 
-func (r *PaymentRepository) TakeOffPayment(ctx context.Context, companyId string, date, sum, method, comment, studentId, actionByName, actionById, groupId, studentConditionDate string) error {
+// TakeOffPayment charges a student's balance. A sponsored charge is split:
+// the sponsors' part is booked to them and the student pays the rest. The
+// discount rules the charge was priced with are recorded with it.
+func (r *PaymentRepository) TakeOffPayment(ctx context.Context, companyId string, date, sum, method, comment, studentId, actionByName, actionById, groupId, studentConditionDate string, sponsored bool, discounts []*pb.AppliedDiscount) error {
 	tx, err := r.db.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %v", err)
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
+	defer tx.Rollback()
 
-	validMethods := map[string]bool{"CLICK": true, "CASH": true, "PAYME": true}
-	if !validMethods[method] {
+	if !paymentMethods[method] {
 		return errors.New("invalid payment method")
 	}
 
-	amount, err := strconv.ParseFloat(sum, 64)
+	amount, err := money.Parse(sum)
 	if err != nil {
 		return fmt.Errorf("invalid sum amount: %v", err)
 	}
@@ -100,8 +133,24 @@ func (r *PaymentRepository) TakeOffPayment(ctx context.Context, companyId string
 	if err != nil {
 		return fmt.Errorf("invalid date format: %v", err)
 	}
-	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
-	defer cancelFunc()
+	if err = recordApplications(tx, companyId, studentId, groupId, parsedDate, discounts); err != nil {
+		return err
+	}
+	if sponsored {
+		covered, err := splitWithSponsors(tx, companyId, studentId, groupId, parsedDate, amount, false, method, comment, actionById, actionByName)
+		if err != nil {
+			return err
+		}
+		amount = amount.Sub(covered)
+		if !amount.IsPositive() {
+			// the sponsors cover all of it, the student's balance is not touched
+			if err = tx.Commit(); err != nil {
+				return fmt.Errorf("failed to commit payment: %v", err)
+			}
+			return nil
+		}
+		sum = money.Format(amount)
+	}
 	paymentID := uuid.New()
 	query := `INSERT INTO student_payments 
 		(id, student_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name , created_at, group_id , company_id , student_activation_date)
@@ -115,78 +164,129 @@ func (r *PaymentRepository) TakeOffPayment(ctx context.Context, companyId string
 	if err != nil {
 		return fmt.Errorf("failed to take off payment: %v", err)
 	}
+	if err = postDocument(tx, SourceStudentPayment, paymentID.String()); err != nil {
+		return err
+	}
 
-	err = r.educationClient.ChangeUserBalanceHistory(ctx, studentId, sum, date, comment, "TAKE_OFF", actionById, actionByName, groupId)
+	err = enqueueBalanceChange(tx, companyId, &pb.ChangeUserBalanceHistoryRequest{
+		StudentId: studentId, Amount: sum, GivenDate: date, Comment: comment, PaymentType: "TAKE_OFF",
+		CreatedBy: actionById, CreatedByName: actionByName, GroupId: groupId,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update user balance history: %v", err)
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit payment: %v", err)
 	}
+	r.outbox.Wake()
+	metrics.PaymentsAdded.WithLabelValues(method, "TAKE_OFF").Inc()
 	return nil
 }
 
-func (r *PaymentRepository) PaymentReturn(ctx context.Context, companyId, paymentId, actionByName, actionById string) (*pb.AbsResponse, error) {
-	var payment struct {
-		ID            string
-		StudentID     string
-		Method        string
-		Amount        float64
-		GivenDate     string
-		Comment       string
-		PaymentType   string
-		CreatedByID   string
-		CreatedByName string
-		CreatedAt     string
-		GroupId       string
+type ledgerPayment struct {
+	ID          string
+	StudentID   string
+	Amount      decimal.Decimal
+	GivenDate   string
+	Comment     string
+	PaymentType string
+	GroupId     string
+}
+
+// lockPayment loads a payment that can still be returned or edited: it must
+// exist, must not be a reversal itself and must not be reversed already.
+func lockPayment(tx *sql.Tx, companyId, paymentId string) (*ledgerPayment, error) {
+	var payment ledgerPayment
+	var isReversal, isReversed bool
+	err := tx.QueryRow(`
+		SELECT id, student_id, amount, to_char(given_date, 'YYYY-MM-DD'), comment, payment_type, coalesce(group_id, 0),
+		       reversal_of is not null, exists(SELECT 1 FROM student_payments rv where rv.reversal_of = student_payments.id)
+		FROM student_payments WHERE id = $1 and company_id = $2 FOR UPDATE`, paymentId, companyId).
+		Scan(&payment.ID, &payment.StudentID, &payment.Amount, &payment.GivenDate, &payment.Comment, &payment.PaymentType, &payment.GroupId, &isReversal, &isReversed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "payment not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve payment: %v", err)
+	}
+	if isReversal {
+		return nil, status.Error(codes.FailedPrecondition, "a reversal entry cannot be changed")
+	}
+	if isReversed {
+		return nil, status.Error(codes.FailedPrecondition, "payment is already returned")
 	}
+	return &payment, nil
+}
+
+// reversePayment appends an entry with the negated amount linked to the
+// original one. It keeps the original given date so period totals net out
+// the same way they did when payments were deleted.
+func reversePayment(tx *sql.Tx, companyId string, payment *ledgerPayment, reason, actionById, actionByName string) error {
+	comment := reason
+	if comment == "" {
+		comment = payment.Comment
+	}
+	id := uuid.NewString()
+	_, err := tx.Exec(`
+		INSERT INTO student_payments (id, student_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, created_at, group_id, company_id, reversal_of, reversal_reason,
+		                              account_id, currency, original_amount, exchange_rate)
+		SELECT $1, student_id, method, -amount, given_date, $2, payment_type, $3, $4, $5, group_id, company_id, id, $6,
+		       account_id, currency, -original_amount, exchange_rate
+		FROM student_payments WHERE id = $7 and company_id = $8`,
+		id, comment, actionById, actionByName, time.Now(), nullIfEmpty(reason), payment.ID, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to reverse payment: %v", err)
+	}
+	return postDocument(tx, SourceStudentPayment, id)
+}
 
+func nullIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// PaymentReturn reverses a payment. The original entry stays in the ledger and
+// a linked reversal entry with the reason and the actor is appended.
+func (r *PaymentRepository) PaymentReturn(ctx context.Context, companyId, paymentId, reason, actionByName, actionById string) (*pb.AbsResponse, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %v", err)
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
-
-	query := `SELECT id, student_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, created_at , coalesce(group_id , 0)
-			  FROM student_payments WHERE id = $1 and company_id=$2`
-	err = tx.QueryRow(query, paymentId, companyId).Scan(
-		&payment.ID,
-		&payment.StudentID,
-		&payment.Method,
-		&payment.Amount,
-		&payment.GivenDate,
-		&payment.Comment,
-		&payment.PaymentType,
-		&payment.CreatedByID,
-		&payment.CreatedByName,
-		&payment.CreatedAt,
-		&payment.GroupId,
-	)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, errors.New("payment not found")
-	} else if err != nil {
-		return nil, fmt.Errorf("failed to retrieve payment: %v", err)
-	}
+	defer tx.Rollback()
 
-	deleteQuery := `DELETE FROM student_payments WHERE id = $1 and company_id=$2`
-	_, err = tx.Exec(deleteQuery, paymentId, companyId)
+	payment, err := lockPayment(tx, companyId, paymentId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete payment: %v", err)
+		return nil, err
+	}
+	if err = reversePayment(tx, companyId, payment, reason, actionById, actionByName); err != nil {
+		return nil, err
+	}
+	if payment.PaymentType == "ADD" {
+		if err = unallocatePayment(tx, companyId, payment.StudentID, payment.ID); err != nil {
+			return nil, err
+		}
 	}
+	balanceType := "TAKE_OFF"
 	if payment.PaymentType == "TAKE_OFF" {
-		payment.PaymentType = "ADD"
-	} else {
-		payment.PaymentType = "TAKE_OFF"
+		balanceType = "ADD"
 	}
-	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
-	defer cancelFunc()
-	err = r.educationClient.ChangeUserBalanceHistory(ctx, payment.StudentID, fmt.Sprintf("%.2f", payment.Amount), payment.GivenDate, payment.Comment, payment.PaymentType, actionById, actionByName, payment.GroupId)
+	comment := payment.Comment
+	if reason != "" {
+		comment = reason
+	}
+	err = enqueueBalanceChange(tx, companyId, &pb.ChangeUserBalanceHistoryRequest{
+		StudentId: payment.StudentID, Amount: money.Format(payment.Amount), GivenDate: payment.GivenDate, Comment: comment,
+		PaymentType: balanceType, CreatedBy: actionById, CreatedByName: actionByName, GroupId: payment.GroupId,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update user balance history: %v", err)
+		return nil, err
 	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit payment return: %v", err)
+	}
+	r.outbox.Wake()
 
 	return &pb.AbsResponse{
 		Status:  http.StatusOK,
@@ -194,45 +294,84 @@ func (r *PaymentRepository) PaymentReturn(ctx context.Context, companyId, paymen
 	}, nil
 }
 
-func (r *PaymentRepository) PaymentUpdate(ctx context.Context, companyId string, paymentId string, date string, method string, userId string, comment string, debit, actionByName, actionById, groupId string) (*pb.AbsResponse, error) {
+// PaymentUpdate never changes a ledger entry in place: the original payment is
+// reversed and a new entry with the edited values replaces it. An edited
+// payment is posted to its cash account anew, debit is in its currency.
+func (r *PaymentRepository) PaymentUpdate(ctx context.Context, companyId string, paymentId string, date string, method string, userId string, comment string, debit, actionByName, actionById, groupId, reason, accountId string) (*pb.AbsResponse, error) {
+	if !paymentMethods[method] && accountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment method")
+	}
+	amount, err := money.Parse(debit)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sum amount: %v", err)
+	}
+	debit = money.Format(amount)
+	if strings.Contains(date, "T") {
+		date = date[:10]
+	}
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+	}
 	tx, err := r.db.Begin()
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %v", err)
 	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
+	defer tx.Rollback()
 
-	var paymentType string
-	var oldDebit string
-	query := `SELECT payment_type , amount FROM student_payments where id=$1 and company_id=$2`
-	err = tx.QueryRow(query, paymentId, companyId).Scan(&paymentType, &oldDebit)
+	payment, err := lockPayment(tx, companyId, paymentId)
 	if err != nil {
-		return nil, fmt.Errorf("error checking payment existence: %v", err)
+		return nil, err
 	}
-	updateQuery := `UPDATE student_payments 
-					SET given_date = $1, method = $2, comment = $3, amount = $4, created_by_id = $5, created_by_name = $6, group_id = $8 
-					WHERE id = $7 and company_id=$9`
-	if groupId == "" {
-		_, err = tx.Exec(updateQuery, date, method, comment, debit, actionById, actionByName, paymentId, nil, companyId)
-	} else {
-		_, err = tx.Exec(updateQuery, date, method, comment, debit, actionById, actionByName, paymentId, groupId, companyId)
+	if reason == "" {
+		reason = "edited"
 	}
-
+	if err = reversePayment(tx, companyId, payment, reason, actionById, actionByName); err != nil {
+		return nil, err
+	}
+	p := &posting{currency: CurrencyUZS, original: amount, rate: decimal.NewFromInt(1), amount: amount, method: method}
+	if payment.PaymentType == "ADD" {
+		if p, err = postTo(tx, companyId, accountId, method, amount, date); err != nil {
+			return nil, err
+		}
+		amount = p.amount
+		debit = money.Format(amount)
+	}
+	newId := uuid.New()
+	_, err = tx.Exec(`
+		INSERT INTO student_payments (id, student_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, created_at, group_id, company_id, student_activation_date, replaces,
+		                              account_id, currency, original_amount, exchange_rate)
+		SELECT $1, student_id, $2, $3, $4, $5, payment_type, $6, $7, $8, $9, company_id, student_activation_date, id, $12, $13, $14, $15
+		FROM student_payments WHERE id = $10 and company_id = $11`,
+		newId, p.method, amount, date, comment, actionById, actionByName, time.Now(), nullIfEmpty(groupId), paymentId, companyId,
+		nullIfEmpty(p.accountId), p.currency, p.original, p.rate)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update payment: %v", err)
 	}
+	if err = postDocument(tx, SourceStudentPayment, newId.String()); err != nil {
+		return nil, err
+	}
+	if payment.PaymentType == "ADD" {
+		// the edited payment is matched to the installments anew
+		if err = unallocatePayment(tx, companyId, payment.StudentID, payment.ID); err != nil {
+			return nil, err
+		}
+		paidOn, _ := time.Parse("2006-01-02", date)
+		if err = allocatePayment(tx, companyId, payment.StudentID, groupId, newId.String(), amount, paidOn); err != nil {
+			return nil, err
+		}
+	}
 
-	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
-	defer cancelFunc()
-	_, err = r.educationClient.ChangeUserBalanceHistoryByDebit(ctx, userId, oldDebit, date, comment, paymentType, actionById, actionByName, groupId, debit)
+	err = enqueueBalanceChangeByDebit(tx, companyId, &pb.ChangeUserBalanceHistoryByDebitRequest{
+		StudentId: payment.StudentID, OldDebit: money.Format(payment.Amount), CurrentDebit: debit, GivenDate: date, Comment: comment,
+		PaymentType: payment.PaymentType, CreatedBy: actionById, CreatedByName: actionByName, GroupId: groupId,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update user balance history: %v", err)
+		return nil, err
 	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit payment update: %v", err)
+	}
+	r.outbox.Wake()
 
 	return &pb.AbsResponse{
 		Status:  http.StatusOK,
@@ -265,17 +404,15 @@ func (r *PaymentRepository) GetMonthlyStatus(ctx context.Context, companyId stri
 
 	for rows.Next() {
 		var month string
-		var totalAdd, totalTakeOff float64
+		var totalAdd, totalTakeOff decimal.Decimal
 
 		if err := rows.Scan(&month, &totalAdd, &totalTakeOff); err != nil {
 			return nil, fmt.Errorf("error scanning row: %v", err)
 		}
 
-		balance := totalAdd - totalTakeOff
-
 		monthlyStatus = append(monthlyStatus, &pb.AbsGetMonthlyStatusResponse{
 			Month:   month,
-			Balance: fmt.Sprintf("%.2f", balance),
+			Balance: money.Format(totalAdd.Sub(totalTakeOff)),
 		})
 	}
 
@@ -300,7 +437,15 @@ func (r *PaymentRepository) GetAllPaymentsByMonth(ctx context.Context, companyId
 			created_by_name, 
 			created_at ,
 			coalesce(group_id , 0),
-			method
+			method,
+			coalesce(reversal_of::text, ''),
+			exists(SELECT 1 FROM student_payments rv where rv.reversal_of = student_payments.id),
+			coalesce(reversal_reason, ''),
+			coalesce(account_id::text, ''),
+			coalesce((SELECT a.name FROM cash_account a WHERE a.id = student_payments.account_id), ''),
+			currency,
+			coalesce(original_amount, amount),
+			exchange_rate
 		FROM 
 			student_payments 
 		WHERE 
@@ -335,6 +480,14 @@ func (r *PaymentRepository) GetAllPaymentsByMonth(ctx context.Context, companyId
 			&payment.CreatedAt,
 			&payment.GroupId,
 			&payment.Method,
+			&payment.ReversalOf,
+			&payment.IsReversed,
+			&payment.ReversalReason,
+			&payment.AccountId,
+			&payment.AccountName,
+			&payment.Currency,
+			&payment.OriginalAmount,
+			&payment.ExchangeRate,
 		); err != nil {
 			return nil, fmt.Errorf("error scanning row: %v", err)
 		}
@@ -471,13 +624,28 @@ func (r *PaymentRepository) GetAllStudentPayments(
 
 	query := `
 SELECT 
+    id,
     student_id,
     method,
     amount,
     given_date,
     comment,
     created_by_id,
-    created_by_name
+    created_by_name,
+    coalesce(reversal_of::text, ''),
+    exists(SELECT 1 FROM student_payments rv where rv.reversal_of = student_payments.id),
+    coalesce(account_id::text, ''),
+    currency,
+    coalesce(original_amount, amount)
+FROM student_payments
+WHERE given_date BETWEEN $1 AND $2
+  AND payment_type = 'ADD'
+  AND company_id = $3
+`
+	totalsQuery := `
+SELECT
+    coalesce(sum(amount) FILTER (WHERE reversal_of is null), 0),
+    coalesce(-sum(amount) FILTER (WHERE reversal_of is not null), 0)
 FROM student_payments
 WHERE given_date BETWEEN $1 AND $2
   AND payment_type = 'ADD'
@@ -505,10 +673,16 @@ WHERE given_date BETWEEN $1 AND $2
 			continue
 		}
 		query += fmt.Sprintf(" AND %s %s $%d", f.Field, operator, argIndex)
+		totalsQuery += fmt.Sprintf(" AND %s %s $%d", f.Field, operator, argIndex)
 		args = append(args, f.Value)
 		argIndex++
 	}
 
+	var gross, reversed decimal.Decimal
+	if err := r.db.QueryRow(totalsQuery, args...).Scan(&gross, &reversed); err != nil {
+		return nil, err
+	}
+
 	// Sorting
 	if len(sorts) > 0 {
 		query += " ORDER BY "
@@ -541,11 +715,16 @@ WHERE given_date BETWEEN $1 AND $2
 	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
 	defer cancel()
 
-	resp := pb.GetAllStudentPaymentsResponse{}
+	resp := pb.GetAllStudentPaymentsResponse{
+		Gross:    money.Format(gross),
+		Reversed: money.Format(reversed),
+		Net:      money.Format(gross.Sub(reversed)),
+	}
 
 	for rows.Next() {
 		el := pb.AbsStudentPayments{}
-		err := rows.Scan(&el.StudentId, &el.Method, &el.Amount, &el.GivenDate, &el.Comment, &el.CreatorId, &el.CreatorName)
+		err := rows.Scan(&el.PaymentId, &el.StudentId, &el.Method, &el.Amount, &el.GivenDate, &el.Comment, &el.CreatorId, &el.CreatorName, &el.ReversalOf, &el.IsReversed,
+			&el.AccountId, &el.Currency, &el.OriginalAmount)
 		if err != nil {
 			return nil, err
 		}
@@ -567,7 +746,7 @@ func (r *PaymentRepository) GetAllStudentPaymentsChart(
 	_ *pb.PageRequest,
 ) (*pb.GetAllStudentPaymentsChartResponse, error) {
 	var (
-		cash, payme, click float64
+		cash, payme, click decimal.Decimal
 	)
 
 	resp := pb.GetAllStudentPaymentsChartResponse{}
@@ -616,10 +795,10 @@ SELECT
 		return nil, fmt.Errorf("error scanning revenue summary: %w", err)
 	}
 
-	resp.TotalRevenue = strconv.FormatFloat(cash+payme+click, 'f', 2, 64)
-	resp.Cash = strconv.FormatFloat(cash, 'f', 2, 64)
-	resp.Click = strconv.FormatFloat(click, 'f', 2, 64)
-	resp.Payme = strconv.FormatFloat(payme, 'f', 2, 64)
+	resp.TotalRevenue = money.Format(cash.Add(payme).Add(click))
+	resp.Cash = money.Format(cash)
+	resp.Click = money.Format(click)
+	resp.Payme = money.Format(payme)
 
 	chartQuery := `
 SELECT 
@@ -772,19 +951,32 @@ func (r *PaymentRepository) GetAllDebtsInformation(ctx context.Context, companyI
 		if err != nil {
 			name = "unknown name"
 			phoneNumber = "unknown phoneNumber"
-			balance = 0
+			balance = money.Zero
 		}
-		if balance >= 0 {
+		overdue, overdueInstallments, nextDue, err := studentPlanDebt(r.db, companyId, debt.DebtorId)
+		if err != nil {
+			return nil, err
+		}
+		// a student ahead on the balance can still be behind on a payment plan
+		if !balance.IsNegative() && !overdue.IsPositive() {
 			continue
 		}
+		debt.OverdueAmount = money.Format(overdue)
+		debt.OverdueInstallments = overdueInstallments
+		debt.NextDueDate = nextDue
 		debt.DebtorName = name
 		debt.PhoneNumber = phoneNumber
 		resp, _ := r.educationClient.GetGroupsAndCommentsByStudentId(ctx, debt.DebtorId)
 		debt.Comments = resp.Comments
 		debt.Groups = resp.Groups
-		debt.Balance = strconv.FormatFloat(balance, 'f', 2, 64)
+		debt.Balance = money.Format(balance)
+		debt.DebtType = "FAMILY"
 		debts = append(debts, &debt)
 	}
+	sponsors, err := sponsorDebts(r.db, companyId, from, to)
+	if err != nil {
+		return nil, err
+	}
 
 	var totalRecords int32
 	var countQuery string
@@ -822,27 +1014,43 @@ func (r *PaymentRepository) GetAllDebtsInformation(ctx context.Context, companyI
 	return &pb.GetAllDebtsInformationResponse{
 		TotalPageCount: totalPageCount,
 		Debts:          debts,
+		SponsorDebts:   sponsors,
 	}, nil
 }
 
+// GetCommonFinanceInformation counts the payments students made this month
+// and takes the month's income, expenses and profit from the ledger.
 func (r *PaymentRepository) GetCommonFinanceInformation(ctx context.Context, companyId string) (*pb.GetCommonInformationResponse, error) {
 	response := new(pb.GetCommonInformationResponse)
 
 	var payInCurrentMonth int32
 
 	err := r.db.QueryRow(`
-        SELECT COUNT(id)
-        FROM student_payments
-        WHERE payment_type = 'ADD' and company_id=$1
-        AND EXTRACT(MONTH FROM given_date) = EXTRACT(MONTH FROM CURRENT_DATE) 
-        AND EXTRACT(YEAR FROM given_date) = EXTRACT(YEAR FROM CURRENT_DATE);
+        SELECT COUNT(e.id)
+        FROM journal_entry e
+        WHERE e.source = 'STUDENT_PAYMENT' and e.company_id=$1
+        AND e.reversal_of is null
+        AND not exists(SELECT 1 FROM journal_entry rv where rv.reversal_of = e.id)
+        AND exists(SELECT 1 FROM journal_line l JOIN ledger_account a ON a.company_id = l.company_id AND a.code = l.account_code
+                   WHERE l.entry_id = e.id AND a.is_cash)
+        AND date_trunc('month', e.entry_date) = date_trunc('month', CURRENT_DATE);
     `, companyId).Scan(&payInCurrentMonth)
 	if err != nil {
 		payInCurrentMonth = 0
 	}
 
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	pnl, err := profitAndLoss(r.db, companyId, from.Format(time.DateOnly), from.AddDate(0, 1, -1).Format(time.DateOnly))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load profit and loss: %v", err)
+	}
+
 	response.DebtorsCount = 0
 	response.PayInCurrentMonth = payInCurrentMonth
+	response.IncomeInCurrentMonth = pnl.TotalIncome
+	response.ExpenseInCurrentMonth = pnl.TotalExpense
+	response.ProfitInCurrentMonth = pnl.NetProfit
 
 	return response, nil
 }
@@ -857,37 +1065,37 @@ func (r *PaymentRepository) GetIncomeChart(ctx context.Context, companyId string
 		return nil, fmt.Errorf("invalid to date format: %v", err)
 	}
 
+	// income is what the income accounts of the ledger took, as on the
+	// profit and loss report; gross leaves reversals out
 	query := `
         SELECT 
-            TO_CHAR(given_date, 'YYYYMM') AS specific_month,
-            SUM(
-                CASE 
-                    WHEN payment_type IN ('ADD', 'TAKE_OFF') THEN amount
-                    WHEN payment_type = 'REFUND' THEN -amount
-                    ELSE 0
-                END
-            ) AS balance
-        FROM student_payments
-        WHERE given_date BETWEEN $1 AND $2 AND company_id=$3
-        GROUP BY TO_CHAR(given_date, 'YYYYMM')
+            TO_CHAR(e.entry_date, 'YYYYMM') AS specific_month,
+            SUM(l.credit - l.debit) AS balance,
+            SUM(l.credit - l.debit) FILTER (WHERE e.reversal_of is null) AS gross
+        FROM ` + ledgerLines + `
+        WHERE a.type = 'INCOME' AND e.entry_date BETWEEN $1 AND $2 AND l.company_id=$3
+        GROUP BY TO_CHAR(e.entry_date, 'YYYYMM')
         ORDER BY specific_month;
     `
 
-	rows, err := r.db.Query(query, startDate, endDate, companyId)
+	rows, err := r.db.Query(query, startDate, endDate.AddDate(0, 1, -1), companyId)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	monthlyBalances := make(map[string]float64)
+	monthlyBalances := make(map[string]decimal.Decimal)
+	monthlyGross := make(map[string]decimal.Decimal)
 
 	for rows.Next() {
 		var month string
-		var balance float64
-		if err := rows.Scan(&month, &balance); err != nil {
+		var balance decimal.Decimal
+		var gross decimal.NullDecimal
+		if err := rows.Scan(&month, &balance, &gross); err != nil {
 			return nil, err
 		}
 		monthlyBalances[month] = balance
+		monthlyGross[month] = gross.Decimal
 	}
 
 	if err := rows.Err(); err != nil {
@@ -901,12 +1109,187 @@ func (r *PaymentRepository) GetIncomeChart(ctx context.Context, companyId string
 
 		response.Response = append(response.Response, &pb.AbsIncomeChart{
 			SpecificMonth: month,
-			Balance:       fmt.Sprintf("%.2f", balance),
+			Balance:       money.Format(balance),
+			Gross:         money.Format(monthlyGross[month]),
+			Reversed:      money.Format(monthlyGross[month].Sub(balance)),
 		})
 	}
 	return &response, nil
 }
 
-func NewPaymentRepository(db *sql.DB, client *clients.EducationClient) *PaymentRepository {
-	return &PaymentRepository{db: db, educationClient: client}
+// MergeStudent moves every payment and discount of the source student to the
+// target one. It does not touch balances, education-service owns them. Running
+// it twice is harmless, the second run finds nothing left to move.
+func (r *PaymentRepository) MergeStudent(companyId, sourceStudentId, targetStudentId string) error {
+	if sourceStudentId == targetStudentId {
+		return status.Error(codes.InvalidArgument, "source and target student should be different")
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`UPDATE student_payments SET student_id=$1 where student_id=$2 and company_id=$3`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move student payments: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE journal_line SET student_id=$1 where student_id=$2 and company_id=$3`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move journal lines: %v", err)
+	}
+	_, err = tx.Exec(`
+		INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action, discount, company_id, discount_id, discount_type)
+		SELECT gen_random_uuid(), $1, sd.group_id, sd.start_at, sd.end_at, sd.withteacher, sd.comment, 'MERGE_DROPPED', sd.discount, sd.company_id, sd.id, sd.discount_type
+		FROM student_discount sd
+		WHERE sd.student_id = $2 and sd.company_id = $3
+		  and exists(SELECT 1 FROM student_discount t where t.student_id = $1 and t.group_id = sd.group_id)`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to write discount history: %v", err)
+	}
+	_, err = tx.Exec(`
+		DELETE FROM student_discount sd
+		WHERE sd.student_id = $2 and sd.company_id = $3
+		  and exists(SELECT 1 FROM student_discount t where t.student_id = $1 and t.group_id = sd.group_id)`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to drop conflicting discounts: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE student_discount SET student_id=$1 where student_id=$2 and company_id=$3`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move student discounts: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE student_discount_history SET student_id=$1 where student_id=$2 and company_id=$3`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move discount history: %v", err)
+	}
+	_, err = tx.Exec(`
+		DELETE FROM discount_rule_application a
+		WHERE a.student_id = $2 and a.company_id = $3
+		  and exists(SELECT 1 FROM discount_rule_application t
+		             where t.student_id = $1 and t.rule_id = a.rule_id and t.group_id = a.group_id and t.month = a.month)`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to drop conflicting discount rule applications: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE discount_rule_application SET student_id=$1 where student_id=$2 and company_id=$3`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move discount rule applications: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE sponsorship SET student_id=$1 where student_id=$2 and company_id=$3`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move sponsorships: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE sponsor_payments SET student_id=$1 where student_id=$2 and company_id=$3`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move sponsor payments: %v", err)
+	}
+	_, err = tx.Exec(`
+		UPDATE payment_plan p SET status = 'CANCELLED', cancel_reason = 'merged'
+		WHERE p.student_id = $2 and p.company_id = $3 and p.status = 'ACTIVE'
+		  and exists(SELECT 1 FROM payment_plan t where t.student_id = $1 and t.group_id = p.group_id and t.status = 'ACTIVE')`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to cancel conflicting payment plans: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE payment_plan SET student_id=$1 where student_id=$2 and company_id=$3`, targetStudentId, sourceStudentId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to move payment plans: %v", err)
+	}
+	return tx.Commit()
+}
+
+// GetStudentLedgerBalances returns the balance every student of the company
+// should have according to student_payments. Students with balance events not
+// yet delivered to education-service are flagged, their balance there is
+// expected to lag behind.
+func (r *PaymentRepository) GetStudentLedgerBalances(companyId string) (*pb.GetStudentLedgerBalancesResponse, error) {
+	rows, err := r.db.Query(`
+		SELECT p.student_id,
+		       sum(CASE WHEN p.payment_type = 'TAKE_OFF' THEN -p.amount ELSE p.amount END),
+		       exists(SELECT 1 FROM balance_outbox o where o.student_id = p.student_id and o.status = 'PENDING')
+		FROM student_payments p
+		WHERE p.company_id = $1
+		GROUP BY p.student_id`, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ledger balances: %v", err)
+	}
+	defer rows.Close()
+	response := pb.GetStudentLedgerBalancesResponse{}
+	for rows.Next() {
+		var balance pb.StudentLedgerBalance
+		if err = rows.Scan(&balance.StudentId, &balance.Balance, &balance.HasPendingEvents); err != nil {
+			return nil, fmt.Errorf("failed to read ledger balance: %v", err)
+		}
+		response.Balances = append(response.Balances, &balance)
+	}
+	return &response, rows.Err()
+}
+
+// GetFailedBalanceEvents lists the balance events of the company that
+// education-service rejected, newest first.
+func (r *PaymentRepository) GetFailedBalanceEvents(companyId string) (*pb.BalanceEventList, error) {
+	rows, err := r.db.Query(`
+		SELECT id, student_id, event_type, attempts, coalesce(last_error, ''), to_char(created_at, 'YYYY-MM-DD HH24:MI:SS')
+		FROM balance_outbox where company_id=$1 and status='FAILED'
+		ORDER BY created_at DESC`, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load failed balance events: %v", err)
+	}
+	defer rows.Close()
+	response := pb.BalanceEventList{}
+	for rows.Next() {
+		var event pb.BalanceEvent
+		if err = rows.Scan(&event.Id, &event.StudentId, &event.EventType, &event.Attempts, &event.LastError, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read balance event: %v", err)
+		}
+		response.Events = append(response.Events, &event)
+	}
+	response.TotalCount = int32(len(response.Events))
+	return &response, rows.Err()
+}
+
+// RetryBalanceEvents puts failed balance events back in the queue, all of the
+// company's when ids is empty, and wakes the relay.
+func (r *PaymentRepository) RetryBalanceEvents(companyId string, ids []string) (int32, error) {
+	result, err := r.db.Exec(`
+		UPDATE balance_outbox SET status='PENDING', next_attempt_at=now()
+		WHERE company_id=$1 and status='FAILED' and (cardinality($2::uuid[]) = 0 or id = ANY($2::uuid[]))`, companyId, pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("failed to retry balance events: %v", err)
+	}
+	retried, _ := result.RowsAffected()
+	r.outbox.Wake()
+	return int32(retried), nil
+}
+
+func NewPaymentRepository(db *sql.DB, client *clients.EducationClient, outbox *BalanceOutbox) *PaymentRepository {
+	return &PaymentRepository{db: db, educationClient: client, outbox: outbox}
+}
+
+// GetTenantUsage counts the student payments of every company, and how many
+// were made and for how much between from and to. Reversed payments are left
+// out.
+func (r *PaymentRepository) GetTenantUsage(from, to string) (*pb.TenantUsageList, error) {
+	rows, err := r.db.Query(`SELECT company_id,
+       count(*),
+       count(*) FILTER (WHERE given_date BETWEEN $1::date AND $2::date),
+       coalesce(sum(amount) FILTER (WHERE given_date BETWEEN $1::date AND $2::date), 0)
+FROM student_payments
+WHERE payment_type = 'ADD' AND company_id IS NOT NULL
+  AND reversal_of is null
+  AND not exists(SELECT 1 FROM student_payments rv where rv.reversal_of = student_payments.id)
+GROUP BY company_id`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	response := &pb.TenantUsageList{}
+	for rows.Next() {
+		var usage pb.TenantUsage
+		var sum decimal.Decimal
+		if err := rows.Scan(&usage.CompanyId, &usage.Count, &usage.Recent, &sum); err != nil {
+			return nil, err
+		}
+		usage.Sum = money.Format(sum)
+		response.Items = append(response.Items, &usage)
+	}
+	return response, rows.Err()
 }
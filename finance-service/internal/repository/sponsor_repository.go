@@ -0,0 +1,649 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance-service/internal/clients"
+	"finance-service/internal/money"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	CoverFixed   = "FIXED"
+	CoverPercent = "PERCENT"
+
+	SponsorPaymentAdd    = "ADD"
+	SponsorPaymentCharge = "CHARGE"
+	SponsorPaymentRefund = "REFUND"
+)
+
+// sponsorEffect is what an entry of sponsor_payments does to the sponsor's
+// balance: payments and refunded charges add to it, charges take from it.
+const sponsorEffect = `CASE WHEN p.payment_type = 'CHARGE' THEN -p.amount ELSE p.amount END`
+
+const sponsorSelect = `SELECT s.id, s.name, s.phone_number, s.contact_person, s.comment, s.is_active, s.created_at,
+       coalesce((SELECT sum(` + sponsorEffect + `) FROM sponsor_payments p WHERE p.sponsor_id = s.id), 0),
+       (SELECT count(DISTINCT sp.student_id) FROM sponsorship sp
+        WHERE sp.sponsor_id = s.id AND sp.start_at <= current_date AND (sp.end_at IS NULL OR sp.end_at >= current_date))
+FROM sponsor s`
+
+const sponsorshipSelect = `SELECT sp.id, sp.sponsor_id, s.name, sp.student_id, coalesce(sp.group_id::text, ''), sp.cover_type, sp.value,
+       sp.start_at, sp.end_at, sp.created_at
+FROM sponsorship sp
+         JOIN sponsor s ON s.id = sp.sponsor_id`
+
+type SponsorRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+}
+
+func NewSponsorRepository(db *sql.DB, educationClient *clients.EducationClient) *SponsorRepository {
+	return &SponsorRepository{db: db, educationClient: educationClient}
+}
+
+func (r *SponsorRepository) CreateSponsor(companyId string, req *pb.Sponsor) (*pb.Sponsor, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	id := uuid.NewString()
+	_, err := r.db.Exec(`INSERT INTO sponsor (id, company_id, name, phone_number, contact_person, comment) VALUES ($1, $2, $3, $4, $5, $6)`,
+		id, companyId, strings.TrimSpace(req.Name), req.PhoneNumber, req.ContactPerson, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSponsor(companyId, id)
+}
+
+func (r *SponsorRepository) UpdateSponsor(companyId string, req *pb.Sponsor) (*pb.Sponsor, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	result, err := r.db.Exec(`UPDATE sponsor SET name = $3, phone_number = $4, contact_person = $5, comment = $6, is_active = $7
+WHERE id = $1 AND company_id = $2`, req.Id, companyId, strings.TrimSpace(req.Name), req.PhoneNumber, req.ContactPerson, req.Comment, req.IsActive)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Errorf(codes.NotFound, "sponsor %s not found", req.Id)
+	}
+	return r.getSponsor(companyId, req.Id)
+}
+
+// DeleteSponsor deactivates a sponsor. Its ledger stays, and charges of its
+// students are no longer split with it.
+func (r *SponsorRepository) DeleteSponsor(companyId, id string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`UPDATE sponsor SET is_active = false WHERE id = $1 AND company_id = $2`, id, companyId)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, status.Errorf(codes.NotFound, "sponsor %s not found", id)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "sponsor deleted"}, nil
+}
+
+func (r *SponsorRepository) GetSponsors(companyId string) (*pb.SponsorList, error) {
+	rows, err := r.db.Query(sponsorSelect+` WHERE s.company_id = $1 ORDER BY s.is_active DESC, s.name`, companyId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result := &pb.SponsorList{}
+	for rows.Next() {
+		sponsor, err := scanSponsor(rows)
+		if err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, sponsor)
+	}
+	return result, rows.Err()
+}
+
+func (r *SponsorRepository) getSponsor(companyId, id string) (*pb.Sponsor, error) {
+	sponsor, err := scanSponsor(r.db.QueryRow(sponsorSelect+` WHERE s.id::text = $1 AND s.company_id = $2`, id, companyId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "sponsor %s not found", id)
+	}
+	return sponsor, err
+}
+
+func scanSponsor(row interface{ Scan(...any) error }) (*pb.Sponsor, error) {
+	var sponsor pb.Sponsor
+	var createdAt time.Time
+	var balance decimal.Decimal
+	err := row.Scan(&sponsor.Id, &sponsor.Name, &sponsor.PhoneNumber, &sponsor.ContactPerson, &sponsor.Comment, &sponsor.IsActive, &createdAt,
+		&balance, &sponsor.StudentCount)
+	if err != nil {
+		return nil, err
+	}
+	sponsor.Balance = money.Format(balance)
+	sponsor.CreatedAt = createdAt.Format(time.DateTime)
+	return &sponsor, nil
+}
+
+func (r *SponsorRepository) AddSponsorship(ctx context.Context, companyId string, req *pb.Sponsorship) (*pb.Sponsorship, error) {
+	value, err := money.Parse(req.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "value: %v", err)
+	}
+	req.Value = money.Format(value)
+	switch req.CoverType {
+	case CoverFixed:
+		if !value.IsPositive() {
+			return nil, status.Error(codes.InvalidArgument, "a fixed cover must be above 0")
+		}
+	case CoverPercent:
+		if !value.IsPositive() || value.GreaterThan(decimal.NewFromInt(100)) {
+			return nil, status.Error(codes.InvalidArgument, "a percent cover must be above 0 and at most 100")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "cover type must be FIXED or PERCENT, got %q", req.CoverType)
+	}
+	startAt := time.Now().Format(time.DateOnly)
+	if req.StartAt != "" {
+		if _, err := time.Parse(time.DateOnly, req.StartAt); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "startAt must be YYYY-MM-DD, got %q", req.StartAt)
+		}
+		startAt = req.StartAt
+	}
+	if req.EndAt != "" {
+		if _, err := time.Parse(time.DateOnly, req.EndAt); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "endAt must be YYYY-MM-DD, got %q", req.EndAt)
+		}
+		if req.EndAt < startAt {
+			return nil, status.Error(codes.InvalidArgument, "endAt must not be before startAt")
+		}
+	}
+	if req.GroupId != "" {
+		if _, err := strconv.ParseInt(req.GroupId, 10, 64); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid group id %q", req.GroupId)
+		}
+	}
+	sponsor, err := r.getSponsor(companyId, req.SponsorId)
+	if err != nil {
+		return nil, err
+	}
+	if !sponsor.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "the sponsor is deleted")
+	}
+	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
+	defer cancel()
+	if _, _, _, err = r.educationClient.GetStudentById(ctx, req.StudentId); err != nil {
+		return nil, err
+	}
+
+	id := uuid.NewString()
+	_, err = r.db.Exec(`INSERT INTO sponsorship (id, sponsor_id, student_id, group_id, cover_type, value, start_at, end_at, company_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		id, req.SponsorId, req.StudentId, nullIfEmpty(req.GroupId), req.CoverType, req.Value, startAt, nullIfEmpty(req.EndAt), companyId)
+	if err != nil {
+		return nil, err
+	}
+	return r.getSponsorship(ctx, companyId, id)
+}
+
+// EndSponsorship stops a sponsorship after a day. Charges made until then
+// stay split.
+func (r *SponsorRepository) EndSponsorship(ctx context.Context, companyId string, req *pb.EndSponsorshipRequest) (*pb.Sponsorship, error) {
+	endAt := time.Now().Format(time.DateOnly)
+	if req.EndAt != "" {
+		if _, err := time.Parse(time.DateOnly, req.EndAt); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "endAt must be YYYY-MM-DD, got %q", req.EndAt)
+		}
+		endAt = req.EndAt
+	}
+	var startAt time.Time
+	err := r.db.QueryRow(`SELECT start_at FROM sponsorship WHERE id::text = $1 AND company_id = $2`, req.Id, companyId).Scan(&startAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "sponsorship %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if endAt < startAt.Format(time.DateOnly) {
+		return nil, status.Error(codes.InvalidArgument, "endAt must not be before the start of the sponsorship")
+	}
+	if _, err = r.db.Exec(`UPDATE sponsorship SET end_at = $3 WHERE id = $1 AND company_id = $2`, req.Id, companyId, endAt); err != nil {
+		return nil, err
+	}
+	return r.getSponsorship(ctx, companyId, req.Id)
+}
+
+func (r *SponsorRepository) GetSponsorships(ctx context.Context, companyId string, req *pb.GetSponsorshipsRequest) (*pb.SponsorshipList, error) {
+	rows, err := r.db.Query(sponsorshipSelect+`
+WHERE sp.company_id = $1
+  AND ($2 = '' OR sp.sponsor_id::text = $2)
+  AND ($3 = '' OR sp.student_id::text = $3)
+ORDER BY sp.created_at DESC`, companyId, req.SponsorId, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.SponsorshipList{}
+	for rows.Next() {
+		sponsorship, err := scanSponsorship(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		result.Items = append(result.Items, sponsorship)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
+	defer cancel()
+	names := studentNames{client: r.educationClient}
+	for _, sponsorship := range result.Items {
+		sponsorship.StudentName = names.get(ctx, sponsorship.StudentId)
+	}
+	return result, nil
+}
+
+func (r *SponsorRepository) getSponsorship(ctx context.Context, companyId, id string) (*pb.Sponsorship, error) {
+	sponsorship, err := scanSponsorship(r.db.QueryRow(sponsorshipSelect+` WHERE sp.id::text = $1 AND sp.company_id = $2`, id, companyId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "sponsorship %s not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	names := studentNames{client: r.educationClient}
+	sponsorship.StudentName = names.get(ctx, sponsorship.StudentId)
+	return sponsorship, nil
+}
+
+func scanSponsorship(row interface{ Scan(...any) error }) (*pb.Sponsorship, error) {
+	var sponsorship pb.Sponsorship
+	var startAt, createdAt time.Time
+	var endAt sql.NullTime
+	err := row.Scan(&sponsorship.Id, &sponsorship.SponsorId, &sponsorship.SponsorName, &sponsorship.StudentId, &sponsorship.GroupId,
+		&sponsorship.CoverType, &sponsorship.Value, &startAt, &endAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	today := time.Now().Format(time.DateOnly)
+	sponsorship.StartAt = startAt.Format(time.DateOnly)
+	if endAt.Valid {
+		sponsorship.EndAt = endAt.Time.Format(time.DateOnly)
+	}
+	sponsorship.IsActive = sponsorship.StartAt <= today && (sponsorship.EndAt == "" || sponsorship.EndAt >= today)
+	sponsorship.CreatedAt = createdAt.Format(time.DateTime)
+	return &sponsorship, nil
+}
+
+// SponsorPaymentAdd records money a sponsor paid in.
+func (r *SponsorRepository) SponsorPaymentAdd(companyId string, req *pb.SponsorPaymentRequest) (*pb.AbsResponse, error) {
+	amount, err := money.Parse(req.Amount)
+	if err != nil || !amount.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount %q", req.Amount)
+	}
+	date := time.Now().Format(time.DateOnly)
+	if req.Date != "" {
+		if _, err = time.Parse(time.DateOnly, req.Date); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "date must be YYYY-MM-DD, got %q", req.Date)
+		}
+		date = req.Date
+	}
+	if _, err = r.getSponsor(companyId, req.SponsorId); err != nil {
+		return nil, err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	p, err := postTo(tx, companyId, req.AccountId, req.Method, amount, date)
+	if err != nil {
+		return nil, err
+	}
+	id := uuid.NewString()
+	_, err = tx.Exec(`INSERT INTO sponsor_payments (id, sponsor_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, company_id,
+                              account_id, currency, original_amount, exchange_rate)
+VALUES ($1, $2, $3, $4, $5, $6, 'ADD', $7, $8, $9, $10, $11, $12, $13)`,
+		id, req.SponsorId, p.method, p.amount, date, req.Comment, req.ActionById, req.ActionByName, companyId,
+		p.accountId, p.currency, p.original, p.rate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add sponsor payment: %v", err)
+	}
+	if err = postDocument(tx, SourceSponsorPayment, id); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit sponsor payment: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusCreated, Message: "sponsor payment added"}, nil
+}
+
+// SponsorPaymentReturn reverses a payment of a sponsor the way PaymentReturn
+// does for students. Covered charges are not returned here, they go back
+// with the refund of the student's month.
+func (r *SponsorRepository) SponsorPaymentReturn(companyId string, req *pb.SponsorPaymentReturnRequest) (*pb.AbsResponse, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+	var paymentType string
+	var isReversal, isReversed bool
+	err = tx.QueryRow(`SELECT payment_type, reversal_of IS NOT NULL,
+       exists(SELECT 1 FROM sponsor_payments rv WHERE rv.reversal_of = sponsor_payments.id)
+FROM sponsor_payments WHERE id::text = $1 AND company_id = $2 FOR UPDATE`, req.Id, companyId).Scan(&paymentType, &isReversal, &isReversed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "sponsor payment not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sponsor payment: %v", err)
+	}
+	switch {
+	case paymentType != SponsorPaymentAdd:
+		return nil, status.Error(codes.FailedPrecondition, "a covered charge goes back with the refund of the student's month")
+	case isReversal:
+		return nil, status.Error(codes.FailedPrecondition, "a reversal entry cannot be changed")
+	case isReversed:
+		return nil, status.Error(codes.FailedPrecondition, "payment is already returned")
+	}
+	id := uuid.NewString()
+	_, err = tx.Exec(`INSERT INTO sponsor_payments (id, sponsor_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, company_id, reversal_of, reversal_reason,
+                              account_id, currency, original_amount, exchange_rate)
+SELECT $1, sponsor_id, method, -amount, given_date, coalesce($2, comment), payment_type, $3, $4, company_id, id, $2,
+       account_id, currency, -original_amount, exchange_rate
+FROM sponsor_payments WHERE id = $5`, id, nullIfEmpty(req.Reason), req.ActionById, req.ActionByName, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reverse sponsor payment: %v", err)
+	}
+	if err = postDocument(tx, SourceSponsorPayment, id); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit sponsor payment return: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "sponsor payment returned successfully"}, nil
+}
+
+// GetSponsorStatement lists what a sponsor paid and covered over a period,
+// this month when no period is given, with the balance before and after it.
+func (r *SponsorRepository) GetSponsorStatement(ctx context.Context, companyId string, req *pb.SponsorStatementRequest) (*pb.SponsorStatement, error) {
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly)
+	to := now.Format(time.DateOnly)
+	for _, value := range []struct {
+		name   string
+		given  string
+		target *string
+	}{{"from", req.From, &from}, {"to", req.To, &to}} {
+		if value.given == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, value.given); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be YYYY-MM-DD, got %q", value.name, value.given)
+		}
+		*value.target = value.given
+	}
+	if to < from {
+		return nil, status.Error(codes.InvalidArgument, "to must not be before from")
+	}
+	sponsor, err := r.getSponsor(companyId, req.SponsorId)
+	if err != nil {
+		return nil, err
+	}
+	statement := &pb.SponsorStatement{Sponsor: sponsor, From: from, To: to}
+	var opening decimal.Decimal
+	err = r.db.QueryRow(`SELECT coalesce(sum(`+sponsorEffect+`), 0) FROM sponsor_payments p WHERE p.sponsor_id = $1 AND p.given_date < $2`,
+		sponsor.Id, from).Scan(&opening)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`SELECT p.id, p.given_date, p.payment_type, coalesce(p.student_id::text, ''), coalesce(p.group_id::text, ''), p.method,
+       `+sponsorEffect+`, p.comment, coalesce(p.reversal_of::text, '')
+FROM sponsor_payments p
+WHERE p.sponsor_id = $1 AND p.given_date BETWEEN $2 AND $3
+ORDER BY p.given_date, p.created_at`, sponsor.Id, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
+	defer cancel()
+	names := studentNames{client: r.educationClient}
+	covered := map[string]*pb.SponsorStatementStudent{}
+	studentCovered := map[string]decimal.Decimal{}
+	balance := opening
+	var paid, coveredTotal decimal.Decimal
+	for rows.Next() {
+		var entry pb.SponsorStatementEntry
+		var date time.Time
+		var amount decimal.Decimal
+		err = rows.Scan(&entry.Id, &date, &entry.Type, &entry.StudentId, &entry.GroupId, &entry.Method, &amount, &entry.Comment, &entry.ReversalOf)
+		if err != nil {
+			return nil, err
+		}
+		entry.Date = date.Format(time.DateOnly)
+		entry.Amount = money.Format(amount)
+		balance = balance.Add(amount)
+		if entry.Type == SponsorPaymentAdd {
+			paid = paid.Add(amount)
+		} else {
+			coveredTotal = coveredTotal.Sub(amount)
+		}
+		if entry.StudentId != "" {
+			entry.StudentName = names.get(ctx, entry.StudentId)
+			student, ok := covered[entry.StudentId]
+			if !ok {
+				student = &pb.SponsorStatementStudent{StudentId: entry.StudentId, StudentName: entry.StudentName}
+				covered[entry.StudentId] = student
+				statement.Students = append(statement.Students, student)
+			}
+			studentCovered[entry.StudentId] = studentCovered[entry.StudentId].Sub(amount)
+			student.Covered = money.Format(studentCovered[entry.StudentId])
+		}
+		statement.Entries = append(statement.Entries, &entry)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	statement.OpeningBalance = money.Format(opening)
+	statement.Paid = money.Format(paid)
+	statement.Covered = money.Format(coveredTotal)
+	statement.ClosingBalance = money.Format(balance)
+	return statement, nil
+}
+
+// studentNames looks names of students up once each.
+type studentNames struct {
+	client *clients.EducationClient
+	names  map[string]string
+}
+
+func (n *studentNames) get(ctx context.Context, studentId string) string {
+	if name, ok := n.names[studentId]; ok {
+		return name
+	}
+	if n.names == nil {
+		n.names = map[string]string{}
+	}
+	name, _, _, err := n.client.GetStudentById(ctx, studentId)
+	if err != nil {
+		name = "unknown name"
+	}
+	n.names[studentId] = name
+	return name
+}
+
+type coverage struct {
+	id        string
+	sponsorId string
+	coverType string
+	value     decimal.Decimal
+}
+
+// splitWithSponsors books the part of a student's charge, or of the refund
+// of one, that the student's sponsors cover, and returns it. A charge is
+// split by the sponsorships running that day: oldest first, each taking a
+// percent of the charge or a fixed amount, up to what is left of it. A
+// refund reverses the split of the charges of its month.
+func splitWithSponsors(tx *sql.Tx, companyId, studentId, groupId string, date time.Time, amount decimal.Decimal, refund bool, method, comment, actionById, actionByName string) (decimal.Decimal, error) {
+	if !amount.IsPositive() {
+		return money.Zero, nil
+	}
+	if refund {
+		return refundSponsors(tx, companyId, studentId, groupId, date, amount, method, comment, actionById, actionByName)
+	}
+	rows, err := tx.Query(`SELECT sp.id, sp.sponsor_id, sp.cover_type, sp.value
+FROM sponsorship sp
+         JOIN sponsor s ON s.id = sp.sponsor_id AND s.is_active
+WHERE sp.company_id = $1
+  AND sp.student_id = $2
+  AND (sp.group_id IS NULL OR sp.group_id::text = $3)
+  AND sp.start_at <= $4
+  AND (sp.end_at IS NULL OR sp.end_at >= $4)
+ORDER BY sp.created_at`, companyId, studentId, groupId, date)
+	if err != nil {
+		return money.Zero, fmt.Errorf("failed to load sponsorships: %v", err)
+	}
+	var coverages []coverage
+	for rows.Next() {
+		var c coverage
+		if err = rows.Scan(&c.id, &c.sponsorId, &c.coverType, &c.value); err != nil {
+			rows.Close()
+			return money.Zero, err
+		}
+		coverages = append(coverages, c)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return money.Zero, err
+	}
+
+	left := amount
+	for _, c := range coverages {
+		share := c.value
+		if c.coverType == CoverPercent {
+			share = money.Percent(amount, c.value)
+		}
+		share = decimal.Min(share, left)
+		if !share.IsPositive() {
+			continue
+		}
+		id := uuid.NewString()
+		_, err = tx.Exec(`INSERT INTO sponsor_payments (id, sponsor_id, sponsorship_id, student_id, group_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, company_id, charge_amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+			id, c.sponsorId, c.id, studentId, nullIfEmpty(groupId), method, share, date, comment, SponsorPaymentCharge, actionById, actionByName, companyId, amount)
+		if err != nil {
+			return money.Zero, fmt.Errorf("failed to book the sponsor's part: %v", err)
+		}
+		if err = postDocument(tx, SourceSponsorPayment, id); err != nil {
+			return money.Zero, err
+		}
+		left = left.Sub(share)
+	}
+	return amount.Sub(left), nil
+}
+
+type sponsorCharge struct {
+	id            string
+	sponsorId     string
+	sponsorshipId sql.NullString
+	amount        decimal.Decimal
+	chargeAmount  decimal.Decimal
+	refundable    decimal.Decimal
+}
+
+// refundSponsors gives the sponsors back their part of a refunded month:
+// each sponsor charge of the student's group in that month returns the same
+// share of the refund it had of its charge, up to what was not refunded of
+// it yet. Sponsorships changed since the charge do not matter.
+func refundSponsors(tx *sql.Tx, companyId, studentId, groupId string, date time.Time, amount decimal.Decimal, method, comment, actionById, actionByName string) (decimal.Decimal, error) {
+	rows, err := tx.Query(`SELECT c.id, c.sponsor_id, c.sponsorship_id, c.amount, coalesce(c.charge_amount, c.amount),
+       c.amount - coalesce((SELECT sum(r.amount) FROM sponsor_payments r WHERE r.refund_of = c.id), 0)
+FROM sponsor_payments c
+WHERE c.company_id = $1
+  AND c.student_id = $2
+  AND coalesce(c.group_id::text, '') = $3
+  AND c.payment_type = 'CHARGE'
+  AND date_trunc('month', c.given_date) = date_trunc('month', $4::date)
+  AND NOT EXISTS(SELECT 1 FROM sponsor_payments rv WHERE rv.reversal_of = c.id)
+ORDER BY c.created_at
+FOR UPDATE OF c`, companyId, studentId, groupId, date)
+	if err != nil {
+		return money.Zero, fmt.Errorf("failed to load sponsor charges: %v", err)
+	}
+	var charges []sponsorCharge
+	for rows.Next() {
+		var c sponsorCharge
+		if err = rows.Scan(&c.id, &c.sponsorId, &c.sponsorshipId, &c.amount, &c.chargeAmount, &c.refundable); err != nil {
+			rows.Close()
+			return money.Zero, err
+		}
+		charges = append(charges, c)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return money.Zero, err
+	}
+
+	left := amount
+	for _, c := range charges {
+		share := c.amount
+		if c.chargeAmount.IsPositive() {
+			share = money.Round(amount.Mul(c.amount).Div(c.chargeAmount))
+		}
+		share = decimal.Min(share, c.refundable, left)
+		if !share.IsPositive() {
+			continue
+		}
+		id := uuid.NewString()
+		_, err = tx.Exec(`INSERT INTO sponsor_payments (id, sponsor_id, sponsorship_id, student_id, group_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, company_id, refund_of)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+			id, c.sponsorId, c.sponsorshipId, studentId, nullIfEmpty(groupId), method, share, date, comment, SponsorPaymentRefund, actionById, actionByName, companyId, c.id)
+		if err != nil {
+			return money.Zero, fmt.Errorf("failed to refund the sponsor's part: %v", err)
+		}
+		if err = postDocument(tx, SourceSponsorPayment, id); err != nil {
+			return money.Zero, err
+		}
+		left = left.Sub(share)
+	}
+	return amount.Sub(left), nil
+}
+
+// sponsorDebts are the sponsors owing money, with what their balance moved
+// by over a period.
+func sponsorDebts(db *sql.DB, companyId, from, to string) ([]*pb.AbsDebtsInformation, error) {
+	rows, err := db.Query(`SELECT s.id, s.name, s.phone_number,
+       sum(`+sponsorEffect+`) AS balance,
+       coalesce(sum(`+sponsorEffect+`) FILTER (WHERE p.given_date BETWEEN $2 AND $3), 0)
+FROM sponsor s
+         JOIN sponsor_payments p ON p.sponsor_id = s.id
+WHERE s.company_id = $1
+GROUP BY s.id
+HAVING sum(`+sponsorEffect+`) < 0
+ORDER BY balance`, companyId, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var debts []*pb.AbsDebtsInformation
+	for rows.Next() {
+		var balance, onPeriod decimal.Decimal
+		debt := pb.AbsDebtsInformation{DebtType: "SPONSOR"}
+		if err = rows.Scan(&debt.DebtorId, &debt.DebtorName, &debt.PhoneNumber, &balance, &onPeriod); err != nil {
+			return nil, err
+		}
+		debt.Balance = money.Format(balance)
+		debt.TotalOnPeriod = money.Format(onPeriod)
+		debts = append(debts, &debt)
+	}
+	return debts, rows.Err()
+}
@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"finance-service/internal/clients"
+	"finance-service/internal/money"
 	"finance-service/internal/utils"
 	"finance-service/proto/pb"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"net/http"
@@ -17,14 +19,18 @@ type TeacherSalaryRepository struct {
 	userClient *clients.UserClient
 }
 
-func (r *TeacherSalaryRepository) CreateTeacherSalary(ctx context.Context, companyId string, amount int32, teacherId string, amountType string) (*pb.AbsResponse, error) {
-	if amountType == "PERCENT" && (amount > 100 || amount < 0) {
+func (r *TeacherSalaryRepository) CreateTeacherSalary(ctx context.Context, companyId string, amountText string, teacherId string, amountType string) (*pb.AbsResponse, error) {
+	amount, err := money.Parse(amountText)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "amount: %v", err)
+	}
+	if amountType == "PERCENT" && (amount.GreaterThan(decimal.NewFromInt(100)) || amount.IsNegative()) {
 		return nil, status.Errorf(codes.Aborted, "invalid amount for PERCENT: must be between 0 and 100")
 	}
-	if amountType != "PERCENT" && amount < 10000 {
+	if amountType != "PERCENT" && amount.LessThan(decimal.NewFromInt(10000)) {
 		return nil, status.Errorf(codes.Aborted, "invalid amount: must be non-negative")
 	}
-	_, err := r.db.Exec("INSERT INTO teacher_salary (teacher_id, salary_type, salary_type_count , company_id) VALUES ($1, $2, $3 , $4)", teacherId, amountType, amount, companyId)
+	_, err = r.db.Exec("INSERT INTO teacher_salary (teacher_id, salary_type, salary_type_count , company_id) VALUES ($1, $2, $3 , $4)", teacherId, amountType, amount, companyId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to insert data: %v", err)
 	}
@@ -62,7 +68,7 @@ func (r *TeacherSalaryRepository) GetTeacherSalary(ctx context.Context, companyI
 	defer cancelFunc()
 	for rows.Next() {
 		var teacherId, salaryType, teacherName string
-		var amount int32
+		var amount decimal.Decimal
 
 		if err := rows.Scan(&teacherId, &salaryType, &amount); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
@@ -75,7 +81,7 @@ func (r *TeacherSalaryRepository) GetTeacherSalary(ctx context.Context, companyI
 		salaries = append(salaries, &pb.AbsGetTeachersSalary{
 			TeacherId:   teacherId,
 			Type:        salaryType,
-			Amount:      amount,
+			Amount:      money.Format(amount),
 			TeacherName: teacherName,
 		})
 	}
@@ -89,8 +95,9 @@ func (r *TeacherSalaryRepository) GetTeacherSalary(ctx context.Context, companyI
 
 func (r *TeacherSalaryRepository) GetTeacherSalaryByTeacherID(ctx context.Context, companyId string, teacherId string) (*pb.AbsGetTeachersSalary, error) {
 	var salary pb.AbsGetTeachersSalary
+	var amount decimal.Decimal
 	err := r.db.QueryRow("SELECT teacher_id, salary_type, salary_type_count FROM teacher_salary WHERE teacher_id = $1 and company_id=$2", teacherId, companyId).
-		Scan(&salary.TeacherId, &salary.Type, &salary.Amount)
+		Scan(&salary.TeacherId, &salary.Type, &amount)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -99,6 +106,7 @@ func (r *TeacherSalaryRepository) GetTeacherSalaryByTeacherID(ctx context.Contex
 		return nil, status.Errorf(codes.Internal, "failed to retrieve salary: %v", err)
 	}
 
+	salary.Amount = money.Format(amount)
 	return &salary, nil
 }
 
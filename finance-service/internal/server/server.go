@@ -1,62 +1,122 @@
 package server
 
 import (
+	"context"
 	"finance-service/config"
 	"finance-service/internal/clients"
+	"finance-service/internal/logging"
+	"finance-service/internal/metrics"
 	"finance-service/internal/repository"
 	"finance-service/internal/service"
+	"finance-service/internal/subscription"
+	"finance-service/internal/tracing"
 	"finance-service/internal/utils"
+	"finance-service/migrations"
 	"finance-service/proto/pb"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 	"log"
+	"log/slog"
 	"net"
+	"os"
+	"os/signal"
 	"strconv"
+	"sync"
+	"syscall"
+	"time"
 )
 
 func RunServer() {
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		log.Fatalf(err.Error())
+		log.Fatalf("Failed to load config: %v", err)
 	}
+	if err = logging.Init("finance-service", cfg.Log.Level); err != nil {
+		log.Fatalf("Failed to set up logging: %v", err)
+	}
+	slog.Info("loaded config", "config", cfg.String())
 	db, err := repository.NewFinanceDB(&cfg.Database)
 	if err != nil {
-		log.Fatalf(err.Error())
+		logging.Fatal("failed to initialize database", err)
+	}
+	defer db.Close()
+	migrations.SetUpMigrating(cfg.Database.Action, db)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	flushTraces, err := tracing.Init(ctx, "finance-service", cfg.Tracing.Exporter, cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+	if err != nil {
+		logging.Fatal("failed to set up tracing", err)
 	}
+	defer flushTraces()
+	metrics.RegisterDB(db, "finance")
+	go metrics.Serve(ctx, cfg.Metrics.Port)
+	var workers sync.WaitGroup
 	educationClient, err := clients.NewEducationClient(cfg.Grpc.EducationService.Address)
 	if err != nil {
-		log.Fatalf(err.Error())
+		logging.Fatal("failed to create education client", err)
 	}
 	userClient, err := clients.NewUserClient(cfg.Grpc.UserService.Address)
 	if err != nil {
-		log.Fatalf(err.Error())
+		logging.Fatal("failed to create user client", err)
 	}
+	companyClient, err := clients.NewCompanyClient(cfg.Grpc.EducationService.Address)
+	if err != nil {
+		logging.Fatal("failed to create company client", err)
+	}
+	enforcer := subscription.NewEnforcer(companyClient.GetSubscription)
 	categoryRepo := repository.NewCategoryRepository(db)
 	categoryService := service.NewCategoryService(categoryRepo)
 	expenseRepo := repository.NewExpenseRepository(db, userClient)
 	expenseService := service.NewExpenseService(expenseRepo)
-	paymentRepo := repository.NewPaymentRepository(db, educationClient)
+	balanceOutbox := repository.NewBalanceOutbox(db, educationClient)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		balanceOutbox.Run(ctx, 5*time.Second)
+	}()
+	paymentRepo := repository.NewPaymentRepository(db, educationClient, balanceOutbox)
 	paymentService := service.NewPaymentService(paymentRepo)
-	discountRepo := repository.NewDiscountRepository(db, educationClient, paymentRepo)
-	discountService := service.NewDiscountService(discountRepo)
+	discountRuleRepo := repository.NewDiscountRuleRepository(db)
+	discountRepo := repository.NewDiscountRepository(db, educationClient, paymentRepo, discountRuleRepo)
+	discountService := service.NewDiscountService(discountRepo, discountRuleRepo)
+	sponsorRepo := repository.NewSponsorRepository(db, educationClient)
+	sponsorService := service.NewSponsorService(sponsorRepo)
+	cashRepo := repository.NewCashRepository(db)
+	cashService := service.NewCashService(cashRepo)
+	ledgerRepo := repository.NewLedgerRepository(db)
+	ledgerService := service.NewLedgerService(ledgerRepo)
+	paymentPlanRepo := repository.NewPaymentPlanRepository(db, educationClient, balanceOutbox)
+	paymentPlanService := service.NewPaymentPlanService(paymentPlanRepo)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		paymentPlanRepo.RunLateFees(ctx, time.Hour)
+	}()
 	salaryRepo := repository.NewTeacherSalaryRepository(db, userClient)
 	salaryService := service.NewTeacherSalaryService(salaryRepo)
 	list, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.Server.Port))
 	if err != nil {
-		log.Fatalf(err.Error())
+		logging.Fatal("failed to listen", err)
 	}
 
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(utils.RecoveryInterceptor),
+		grpc.StatsHandler(tracing.ServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor, logging.UnaryServerInterceptor, utils.RecoveryInterceptor, enforcer.UnaryServerInterceptor),
 	)
 
+	healthServer := registerHealth(ctx, grpcServer, db)
 	pb.RegisterDiscountServiceServer(grpcServer, discountService)
 	pb.RegisterCategoryServiceServer(grpcServer, categoryService)
 	pb.RegisterExpenseServiceServer(grpcServer, expenseService)
 	pb.RegisterPaymentServiceServer(grpcServer, paymentService)
 	pb.RegisterTeacherSalaryServiceServer(grpcServer, salaryService)
-	log.Printf("Server listening on port %v", cfg.Server.Port)
-	if err := grpcServer.Serve(list); err != nil {
-		log.Fatalf("Failed to serve  %v", err)
-	}
+	pb.RegisterSponsorServiceServer(grpcServer, sponsorService)
+	pb.RegisterPaymentPlanServiceServer(grpcServer, paymentPlanService)
+	pb.RegisterCashServiceServer(grpcServer, cashService)
+	pb.RegisterLedgerServiceServer(grpcServer, ledgerService)
+	slog.Info("server listening", "port", cfg.Server.Port)
+	serve(ctx, grpcServer, healthServer, list)
+	workers.Wait()
+	slog.Info("server stopped")
 }
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CashService struct {
+	pb.UnimplementedCashServiceServer
+	repo *repository.CashRepository
+}
+
+func NewCashService(repo *repository.CashRepository) *CashService {
+	return &CashService{repo: repo}
+}
+
+func (cs *CashService) CreateCashAccount(ctx context.Context, req *pb.CashAccount) (*pb.CashAccount, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.CreateCashAccount(companyId, req)
+}
+
+func (cs *CashService) UpdateCashAccount(ctx context.Context, req *pb.CashAccount) (*pb.CashAccount, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.UpdateCashAccount(companyId, req)
+}
+
+func (cs *CashService) GetCashAccounts(ctx context.Context, req *emptypb.Empty) (*pb.CashAccountList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.GetCashAccounts(companyId)
+}
+
+func (cs *CashService) OpenCashShift(ctx context.Context, req *pb.CashShiftRequest) (*pb.CashShift, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.OpenCashShift(companyId, req)
+}
+
+func (cs *CashService) CloseCashShift(ctx context.Context, req *pb.CashShiftRequest) (*pb.CashShift, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.CloseCashShift(companyId, req)
+}
+
+func (cs *CashService) GetCashShifts(ctx context.Context, req *pb.GetCashShiftsRequest) (*pb.CashShiftList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.GetCashShifts(companyId, req)
+}
+
+func (cs *CashService) SetExchangeRate(ctx context.Context, req *pb.ExchangeRate) (*pb.ExchangeRate, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.SetExchangeRate(companyId, req)
+}
+
+func (cs *CashService) GetExchangeRates(ctx context.Context, req *pb.GetExchangeRatesRequest) (*pb.ExchangeRateList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.GetExchangeRates(companyId, req)
+}
@@ -7,16 +7,18 @@ import (
 	"finance-service/proto/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"net/http"
 )
 
 type DiscountService struct {
 	pb.UnimplementedDiscountServiceServer
-	repo *repository.DiscountRepository
+	repo     *repository.DiscountRepository
+	ruleRepo *repository.DiscountRuleRepository
 }
 
-func NewDiscountService(repo *repository.DiscountRepository) *DiscountService {
-	return &DiscountService{repo: repo}
+func NewDiscountService(repo *repository.DiscountRepository, ruleRepo *repository.DiscountRuleRepository) *DiscountService {
+	return &DiscountService{repo: repo, ruleRepo: ruleRepo}
 }
 
 func (ds *DiscountService) CreateDiscount(ctx context.Context, req *pb.AbsDiscountRequest) (*pb.AbsResponse, error) {
@@ -24,7 +26,7 @@ func (ds *DiscountService) CreateDiscount(ctx context.Context, req *pb.AbsDiscou
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	if err := ds.repo.CreateDiscount(ctx, companyId, req.GroupId, req.StudentId, req.DiscountPrice, req.Comment, req.StartDate, req.EndDate, req.WithTeacher); err != nil {
+	if err := ds.repo.CreateDiscount(ctx, companyId, req.GroupId, req.StudentId, req.DiscountPrice, req.DiscountType, req.Comment, req.StartDate, req.EndDate, req.WithTeacher); err != nil {
 		return nil, err
 	}
 	return &pb.AbsResponse{
@@ -38,7 +40,7 @@ func (ds *DiscountService) DeleteDiscount(ctx context.Context, req *pb.AbsDiscou
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	if err := ds.repo.DeleteDiscount(companyId, req.GroupId, req.StudentId); err != nil {
+	if err := ds.repo.DeleteDiscount(companyId, req.GroupId, req.StudentId, req.Id); err != nil {
 		return nil, err
 	}
 	return &pb.AbsResponse{
@@ -68,5 +70,61 @@ func (ds *DiscountService) GetDiscountByStudentId(ctx context.Context, req *pb.G
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return ds.repo.GetDiscountByStudentId(companyId, req.StudentId, req.GroupId)
+	return ds.repo.GetDiscountByStudentId(companyId, req)
+}
+
+func (ds *DiscountService) CreateDiscountRule(ctx context.Context, req *pb.DiscountRule) (*pb.DiscountRule, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.ruleRepo.CreateRule(companyId, req)
+}
+
+func (ds *DiscountService) UpdateDiscountRule(ctx context.Context, req *pb.DiscountRule) (*pb.DiscountRule, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.ruleRepo.UpdateRule(companyId, req)
+}
+
+func (ds *DiscountService) DeleteDiscountRule(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.ruleRepo.DeleteRule(companyId, req.Id)
+}
+
+func (ds *DiscountService) GetDiscountRules(ctx context.Context, req *emptypb.Empty) (*pb.DiscountRuleList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.ruleRepo.GetRules(companyId)
+}
+
+func (ds *DiscountService) GetDiscountPolicy(ctx context.Context, req *emptypb.Empty) (*pb.DiscountPolicy, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.ruleRepo.GetPolicy(companyId)
+}
+
+func (ds *DiscountService) SetDiscountPolicy(ctx context.Context, req *pb.DiscountPolicy) (*pb.DiscountPolicy, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.ruleRepo.SetPolicy(companyId, req)
+}
+
+func (ds *DiscountService) GetRuleApplications(ctx context.Context, req *pb.GetRuleApplicationsRequest) (*pb.RuleApplicationList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.ruleRepo.GetApplications(companyId, req)
 }
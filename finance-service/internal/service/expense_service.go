@@ -20,7 +20,7 @@ func (e *ExpenseService) CreateExpense(ctx context.Context, req *pb.CreateExpens
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	if err := e.repo.CreateExpense(ctx, companyId, req.Title, req.GivenDate, req.ExpenseType, req.CategoryId, req.UserId, req.Sum, req.CreatedById, req.PaymentMethod); err != nil {
+	if err := e.repo.CreateExpense(ctx, companyId, req.Title, req.GivenDate, req.ExpenseType, req.CategoryId, req.UserId, req.Sum, req.CreatedById, req.PaymentMethod, req.AccountId); err != nil {
 		return nil, err
 	}
 	return &pb.AbsResponse{
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type LedgerService struct {
+	pb.UnimplementedLedgerServiceServer
+	repo *repository.LedgerRepository
+}
+
+func NewLedgerService(repo *repository.LedgerRepository) *LedgerService {
+	return &LedgerService{repo: repo}
+}
+
+func (cs *LedgerService) GetLedgerAccounts(ctx context.Context, req *emptypb.Empty) (*pb.LedgerAccountList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.GetLedgerAccounts(companyId)
+}
+
+func (cs *LedgerService) CreateLedgerAccount(ctx context.Context, req *pb.LedgerAccount) (*pb.LedgerAccount, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.CreateLedgerAccount(companyId, req)
+}
+
+func (cs *LedgerService) CreateJournalEntry(ctx context.Context, req *pb.JournalEntry) (*pb.JournalEntry, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.CreateJournalEntry(companyId, req)
+}
+
+func (cs *LedgerService) ReverseJournalEntry(ctx context.Context, req *pb.ReverseJournalEntryRequest) (*pb.JournalEntry, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.ReverseJournalEntry(companyId, req)
+}
+
+func (cs *LedgerService) GetJournalEntries(ctx context.Context, req *pb.GetJournalEntriesRequest) (*pb.JournalEntryList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.GetJournalEntries(companyId, req)
+}
+
+func (cs *LedgerService) GetTrialBalance(ctx context.Context, req *pb.TrialBalanceRequest) (*pb.TrialBalance, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.GetTrialBalance(companyId, req)
+}
+
+func (cs *LedgerService) GetProfitAndLoss(ctx context.Context, req *pb.LedgerPeriodRequest) (*pb.ProfitAndLoss, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.GetProfitAndLoss(companyId, req)
+}
+
+func (cs *LedgerService) GetCashFlow(ctx context.Context, req *pb.LedgerPeriodRequest) (*pb.CashFlow, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return cs.repo.GetCashFlow(companyId, req)
+}
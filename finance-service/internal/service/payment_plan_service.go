@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PaymentPlanService struct {
+	pb.UnimplementedPaymentPlanServiceServer
+	repo *repository.PaymentPlanRepository
+}
+
+func NewPaymentPlanService(repo *repository.PaymentPlanRepository) *PaymentPlanService {
+	return &PaymentPlanService{repo: repo}
+}
+
+func (ps *PaymentPlanService) PreviewPaymentPlan(ctx context.Context, req *pb.CreatePaymentPlanRequest) (*pb.PaymentPlan, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.PreviewPaymentPlan(req)
+}
+
+func (ps *PaymentPlanService) CreatePaymentPlan(ctx context.Context, req *pb.CreatePaymentPlanRequest) (*pb.PaymentPlan, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.CreatePaymentPlan(ctx, companyId, req)
+}
+
+func (ps *PaymentPlanService) GetPaymentPlan(ctx context.Context, req *pb.PaymentPlanRequest) (*pb.PaymentPlan, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.GetPaymentPlan(companyId, req.Id)
+}
+
+func (ps *PaymentPlanService) CancelPaymentPlan(ctx context.Context, req *pb.PaymentPlanRequest) (*pb.PaymentPlan, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.CancelPaymentPlan(companyId, req)
+}
+
+func (ps *PaymentPlanService) GetStudentPaymentPlans(ctx context.Context, req *pb.StudentPaymentPlansRequest) (*pb.PaymentPlanStatusList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.GetStudentPaymentPlans(companyId, req.StudentId)
+}
+
+func (ps *PaymentPlanService) GetOverdueInstallments(ctx context.Context, req *pb.GetOverdueInstallmentsRequest) (*pb.OverdueInstallmentList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.GetOverdueInstallments(ctx, companyId, req)
+}
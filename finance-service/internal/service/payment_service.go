@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"finance-service/internal/repository"
+	"finance-service/internal/subscription"
 	"finance-service/internal/utils"
 	"finance-service/proto/pb"
 	"google.golang.org/grpc/codes"
@@ -22,7 +23,7 @@ func (ps *PaymentService) PaymentAdd(ctx context.Context, req *pb.PaymentAddRequ
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
 	if req.Type == "ADD" {
-		if err := ps.repo.AddPayment(ctx, companyId, req.Date, req.Sum, req.Method, req.Comment, req.UserId, req.ActionByName, req.ActionById, req.GroupId, false); err != nil {
+		if err := ps.repo.AddPayment(ctx, companyId, req.Date, req.Sum, req.Method, req.Comment, req.UserId, req.ActionByName, req.ActionById, req.GroupId, req.AccountId, false, false); err != nil {
 			return nil, status.Errorf(codes.Canceled, err.Error())
 		}
 		return &pb.AbsResponse{
@@ -30,7 +31,7 @@ func (ps *PaymentService) PaymentAdd(ctx context.Context, req *pb.PaymentAddRequ
 			Message: "payment added",
 		}, nil
 	} else if req.Type == "TAKE_OFF" {
-		if err := ps.repo.TakeOffPayment(ctx, companyId, req.Date, req.Sum, req.Method, req.Comment, req.UserId, req.ActionByName, req.ActionById, req.GroupId, req.Studentconditiondate); err != nil {
+		if err := ps.repo.TakeOffPayment(ctx, companyId, req.Date, req.Sum, req.Method, req.Comment, req.UserId, req.ActionByName, req.ActionById, req.GroupId, req.Studentconditiondate, req.Sponsored, req.Discounts); err != nil {
 			return nil, status.Errorf(codes.Canceled, err.Error())
 		}
 		return &pb.AbsResponse{
@@ -38,7 +39,7 @@ func (ps *PaymentService) PaymentAdd(ctx context.Context, req *pb.PaymentAddRequ
 			Message: "payment take_off successfully",
 		}, nil
 	} else if req.Type == "REFUND" {
-		if err := ps.repo.AddPayment(ctx, companyId, req.Date, req.Sum, req.Method, req.Comment, req.UserId, req.ActionByName, req.ActionById, req.GroupId, true); err != nil {
+		if err := ps.repo.AddPayment(ctx, companyId, req.Date, req.Sum, req.Method, req.Comment, req.UserId, req.ActionByName, req.ActionById, req.GroupId, "", true, req.Sponsored); err != nil {
 			return nil, status.Errorf(codes.Canceled, err.Error())
 		}
 		return &pb.AbsResponse{
@@ -48,19 +49,57 @@ func (ps *PaymentService) PaymentAdd(ctx context.Context, req *pb.PaymentAddRequ
 	}
 	return nil, status.Errorf(codes.Aborted, "invalid request type")
 }
+func (ps *PaymentService) MergeStudent(ctx context.Context, req *pb.MergeStudentRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if err := ps.repo.MergeStudent(companyId, req.SourceStudentId, req.TargetStudentId); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "student payments merged",
+	}, nil
+}
+func (ps *PaymentService) GetStudentLedgerBalances(ctx context.Context, req *pb.GetStudentLedgerBalancesRequest) (*pb.GetStudentLedgerBalancesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.GetStudentLedgerBalances(companyId)
+}
+func (ps *PaymentService) GetFailedBalanceEvents(ctx context.Context, _ *emptypb.Empty) (*pb.BalanceEventList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.GetFailedBalanceEvents(companyId)
+}
+func (ps *PaymentService) RetryBalanceEvents(ctx context.Context, req *pb.RetryBalanceEventsRequest) (*pb.RetryBalanceEventsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	retried, err := ps.repo.RetryBalanceEvents(companyId, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RetryBalanceEventsResponse{Retried: retried}, nil
+}
 func (ps *PaymentService) PaymentReturn(ctx context.Context, req *pb.PaymentReturnRequest) (*pb.AbsResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return ps.repo.PaymentReturn(ctx, companyId, req.PaymentId, req.ActionByName, req.ActionById)
+	return ps.repo.PaymentReturn(ctx, companyId, req.PaymentId, req.Reason, req.ActionByName, req.ActionById)
 }
 func (ps *PaymentService) PaymentUpdate(ctx context.Context, req *pb.PaymentUpdateRequest) (*pb.AbsResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return ps.repo.PaymentUpdate(ctx, companyId, req.PaymentId, req.Date, req.Method, req.UserId, req.Comment, req.Debit, req.ActionByName, req.ActionById, req.GroupId)
+	return ps.repo.PaymentUpdate(ctx, companyId, req.PaymentId, req.Date, req.Method, req.UserId, req.Comment, req.Debit, req.ActionByName, req.ActionById, req.GroupId, req.Reason, req.AccountId)
 }
 func (ps *PaymentService) GetMonthlyStatus(ctx context.Context, req *pb.GetMonthlyStatusRequest) (*pb.GetMonthlyStatusResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
@@ -131,6 +170,14 @@ func (ps *PaymentService) GetIncomeChart(ctx context.Context, req *pb.GetIncomeC
 	return ps.repo.GetIncomeChart(ctx, companyId, req.From, req.To)
 }
 
+// GetTenantUsage is for the platform analytics of education-service only.
+func (ps *PaymentService) GetTenantUsage(ctx context.Context, req *pb.TenantUsageRequest) (*pb.TenantUsageList, error) {
+	if !subscription.FromPeer(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "tenant usage is only available to the platform services")
+	}
+	return ps.repo.GetTenantUsage(req.From, req.To)
+}
+
 func NewPaymentService(repo *repository.PaymentRepository) *PaymentService {
 	return &PaymentService{repo: repo}
 }
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type SponsorService struct {
+	pb.UnimplementedSponsorServiceServer
+	repo *repository.SponsorRepository
+}
+
+func NewSponsorService(repo *repository.SponsorRepository) *SponsorService {
+	return &SponsorService{repo: repo}
+}
+
+func (ss *SponsorService) CreateSponsor(ctx context.Context, req *pb.Sponsor) (*pb.Sponsor, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.CreateSponsor(companyId, req)
+}
+
+func (ss *SponsorService) UpdateSponsor(ctx context.Context, req *pb.Sponsor) (*pb.Sponsor, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.UpdateSponsor(companyId, req)
+}
+
+func (ss *SponsorService) DeleteSponsor(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.DeleteSponsor(companyId, req.Id)
+}
+
+func (ss *SponsorService) GetSponsors(ctx context.Context, req *emptypb.Empty) (*pb.SponsorList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.GetSponsors(companyId)
+}
+
+func (ss *SponsorService) AddSponsorship(ctx context.Context, req *pb.Sponsorship) (*pb.Sponsorship, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.AddSponsorship(ctx, companyId, req)
+}
+
+func (ss *SponsorService) EndSponsorship(ctx context.Context, req *pb.EndSponsorshipRequest) (*pb.Sponsorship, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.EndSponsorship(ctx, companyId, req)
+}
+
+func (ss *SponsorService) GetSponsorships(ctx context.Context, req *pb.GetSponsorshipsRequest) (*pb.SponsorshipList, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.GetSponsorships(ctx, companyId, req)
+}
+
+func (ss *SponsorService) SponsorPaymentAdd(ctx context.Context, req *pb.SponsorPaymentRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.SponsorPaymentAdd(companyId, req)
+}
+
+func (ss *SponsorService) SponsorPaymentReturn(ctx context.Context, req *pb.SponsorPaymentReturnRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.SponsorPaymentReturn(companyId, req)
+}
+
+func (ss *SponsorService) GetSponsorStatement(ctx context.Context, req *pb.SponsorStatementRequest) (*pb.SponsorStatement, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ss.repo.GetSponsorStatement(ctx, companyId, req)
+}
@@ -3,11 +3,12 @@ package utils
 import (
 	"context"
 	"errors"
-	"fmt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"log/slog"
+	"runtime/debug"
 	"strconv"
 	"time"
 )
@@ -20,7 +21,7 @@ func RecoveryInterceptor(
 ) (resp interface{}, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Recovered from panic in gRPC call: %v\n", r)
+			slog.ErrorContext(ctx, "recovered from panic in gRPC call", "panic", r, "stack", string(debug.Stack()))
 			err = status.Errorf(codes.Internal, "Internal server error")
 		}
 	}()
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS teacher_salary;
+DROP TABLE IF EXISTS student_payments;
+DROP TABLE IF EXISTS expense;
+DROP TABLE IF EXISTS category;
+DROP TABLE IF EXISTS student_discount_history;
+DROP TABLE IF EXISTS student_discount;
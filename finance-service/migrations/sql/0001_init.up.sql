@@ -12,7 +12,7 @@ CREATE TABLE IF NOT EXISTS student_discount
     PRIMARY KEY (student_id, group_id)
 );
 
-CREATE TABLE student_discount_history
+CREATE TABLE IF NOT EXISTS student_discount_history
 (
     id          uuid PRIMARY KEY,
     student_id  uuid             NOT NULL,
@@ -79,4 +79,3 @@ CREATE TABLE IF NOT EXISTS teacher_salary
     created_at        timestamp DEFAULT NOW(),
     company_id        int
 );
-
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS balance_outbox
+(
+    id              uuid PRIMARY KEY,
+    company_id      int       NOT NULL,
+    student_id      uuid      NOT NULL,
+    event_type      varchar   NOT NULL CHECK (event_type IN ('CHANGE_BALANCE', 'CHANGE_BALANCE_BY_DEBIT')),
+    payload         jsonb     NOT NULL,
+    status          varchar   NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'SENT', 'FAILED')),
+    attempts        int       NOT NULL DEFAULT 0,
+    last_error      varchar,
+    next_attempt_at timestamp NOT NULL DEFAULT now(),
+    created_at      timestamp NOT NULL DEFAULT now(),
+    sent_at         timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_balance_outbox_pending ON balance_outbox (next_attempt_at) WHERE status = 'PENDING';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS uq_student_payments_reversal;
+ALTER TABLE student_payments
+    DROP COLUMN IF EXISTS replaces,
+    DROP COLUMN IF EXISTS reversal_reason,
+    DROP COLUMN IF EXISTS reversal_of;
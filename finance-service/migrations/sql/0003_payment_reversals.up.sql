@@ -0,0 +1,8 @@
+ALTER TABLE student_payments
+    ADD COLUMN IF NOT EXISTS reversal_of uuid references student_payments (id);
+ALTER TABLE student_payments
+    ADD COLUMN IF NOT EXISTS reversal_reason varchar;
+ALTER TABLE student_payments
+    ADD COLUMN IF NOT EXISTS replaces uuid references student_payments (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_student_payments_reversal ON student_payments (reversal_of) WHERE reversal_of IS NOT NULL;
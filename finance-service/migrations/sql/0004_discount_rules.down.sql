@@ -0,0 +1,26 @@
+DROP TABLE IF EXISTS discount_rule_application;
+DROP TABLE IF EXISTS discount_policy;
+DROP TABLE IF EXISTS discount_rule;
+
+ALTER TABLE student_discount_history
+    DROP COLUMN IF EXISTS discount_type;
+ALTER TABLE student_discount_history
+    DROP COLUMN IF EXISTS discount_id;
+
+-- keeps the latest discount of each student and group, the old key allows one
+DELETE FROM student_discount d
+    USING student_discount newer
+WHERE newer.student_id = d.student_id
+  AND newer.group_id = d.group_id
+  AND (newer.created_at, newer.id) > (d.created_at, d.id);
+DROP INDEX IF EXISTS idx_student_discount_student;
+ALTER TABLE student_discount
+    DROP CONSTRAINT IF EXISTS student_discount_percent;
+ALTER TABLE student_discount
+    DROP COLUMN IF EXISTS discount_type;
+ALTER TABLE student_discount
+    DROP CONSTRAINT IF EXISTS student_discount_pkey;
+ALTER TABLE student_discount
+    DROP COLUMN IF EXISTS id;
+ALTER TABLE student_discount
+    ADD PRIMARY KEY (student_id, group_id);
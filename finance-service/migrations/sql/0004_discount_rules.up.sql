@@ -0,0 +1,55 @@
+-- a student can have several discounts in a group, also overlapping ones
+ALTER TABLE student_discount
+    ADD COLUMN IF NOT EXISTS id uuid NOT NULL DEFAULT gen_random_uuid();
+ALTER TABLE student_discount
+    DROP CONSTRAINT IF EXISTS student_discount_pkey;
+ALTER TABLE student_discount
+    ADD PRIMARY KEY (id);
+ALTER TABLE student_discount
+    ADD COLUMN IF NOT EXISTS discount_type varchar NOT NULL DEFAULT 'FIXED' CHECK (discount_type IN ('FIXED', 'PERCENT'));
+ALTER TABLE student_discount
+    ADD CONSTRAINT student_discount_percent CHECK (discount_type <> 'PERCENT' OR discount BETWEEN 0 AND 100);
+CREATE INDEX IF NOT EXISTS idx_student_discount_student ON student_discount (company_id, student_id, group_id);
+
+ALTER TABLE student_discount_history
+    ADD COLUMN IF NOT EXISTS discount_id uuid;
+ALTER TABLE student_discount_history
+    ADD COLUMN IF NOT EXISTS discount_type varchar NOT NULL DEFAULT 'FIXED';
+
+CREATE TABLE IF NOT EXISTS discount_rule
+(
+    id            uuid PRIMARY KEY,
+    company_id    int              NOT NULL,
+    name          varchar          NOT NULL,
+    rule_type     varchar          NOT NULL CHECK (rule_type IN ('SIBLING', 'MULTI_GROUP', 'EARLY_PAYMENT')),
+    discount_type varchar          NOT NULL CHECK (discount_type IN ('FIXED', 'PERCENT')),
+    value         double precision NOT NULL CHECK (value > 0),
+    min_count     int              NOT NULL DEFAULT 2,
+    with_teacher  boolean          NOT NULL DEFAULT false,
+    is_active     boolean          NOT NULL DEFAULT true,
+    created_at    timestamp                 DEFAULT now(),
+    CHECK (discount_type <> 'PERCENT' OR value <= 100)
+);
+CREATE INDEX IF NOT EXISTS idx_discount_rule_company ON discount_rule (company_id) WHERE is_active;
+
+CREATE TABLE IF NOT EXISTS discount_policy
+(
+    company_id  int PRIMARY KEY,
+    stacking    varchar          NOT NULL DEFAULT 'STACK' CHECK (stacking IN ('STACK', 'BEST')),
+    max_percent double precision NOT NULL DEFAULT 100 CHECK (max_percent BETWEEN 0 AND 100),
+    updated_at  timestamp                 DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS discount_rule_application
+(
+    id         uuid PRIMARY KEY,
+    rule_id    uuid             NOT NULL REFERENCES discount_rule (id),
+    student_id uuid             NOT NULL,
+    group_id   bigint           NOT NULL,
+    month      date             NOT NULL,
+    amount     double precision NOT NULL,
+    company_id int              NOT NULL,
+    created_at timestamp DEFAULT now(),
+    UNIQUE (rule_id, student_id, group_id, month)
+);
+CREATE INDEX IF NOT EXISTS idx_discount_rule_application_student ON discount_rule_application (company_id, student_id, month);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS sponsor_payments;
+DROP TABLE IF EXISTS sponsorship;
+DROP TABLE IF EXISTS sponsor;
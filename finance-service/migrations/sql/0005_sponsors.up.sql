@@ -0,0 +1,54 @@
+CREATE TABLE IF NOT EXISTS sponsor
+(
+    id             uuid PRIMARY KEY,
+    company_id     int     NOT NULL,
+    name           varchar NOT NULL,
+    phone_number   varchar NOT NULL DEFAULT '',
+    contact_person varchar NOT NULL DEFAULT '',
+    comment        varchar NOT NULL DEFAULT '',
+    is_active      boolean NOT NULL DEFAULT true,
+    created_at     timestamp        DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_sponsor_company ON sponsor (company_id);
+
+CREATE TABLE IF NOT EXISTS sponsorship
+(
+    id         uuid PRIMARY KEY,
+    sponsor_id uuid             NOT NULL REFERENCES sponsor (id),
+    student_id uuid             NOT NULL,
+    -- every group of the student when null
+    group_id   bigint,
+    cover_type varchar          NOT NULL CHECK (cover_type IN ('FIXED', 'PERCENT')),
+    value      double precision NOT NULL CHECK (value > 0),
+    start_at   date             NOT NULL,
+    end_at     date,
+    company_id int              NOT NULL,
+    created_at timestamp DEFAULT now(),
+    CHECK (cover_type <> 'PERCENT' OR value <= 100),
+    CHECK (end_at IS NULL OR end_at >= start_at)
+);
+CREATE INDEX IF NOT EXISTS idx_sponsorship_student ON sponsorship (company_id, student_id);
+
+-- The sponsor's ledger. Like student_payments, entries are never changed: a
+-- return appends one with the negated amount linked by reversal_of.
+CREATE TABLE IF NOT EXISTS sponsor_payments
+(
+    id              uuid PRIMARY KEY,
+    sponsor_id      uuid             NOT NULL REFERENCES sponsor (id),
+    sponsorship_id  uuid REFERENCES sponsorship (id),
+    student_id      uuid,
+    group_id        bigint,
+    method          varchar          NOT NULL CHECK (method IN ('CASH', 'CLICK', 'PAYME', 'TRANSFER')),
+    amount          double precision NOT NULL,
+    given_date      date             NOT NULL,
+    comment         varchar          NOT NULL DEFAULT '',
+    payment_type    varchar          NOT NULL CHECK (payment_type IN ('ADD', 'CHARGE', 'REFUND')),
+    created_by_id   uuid             NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    created_by_name varchar          NOT NULL DEFAULT '',
+    created_at      timestamp                 DEFAULT now(),
+    company_id      int              NOT NULL,
+    reversal_of     uuid REFERENCES sponsor_payments (id),
+    reversal_reason varchar
+);
+CREATE INDEX IF NOT EXISTS idx_sponsor_payments_sponsor ON sponsor_payments (company_id, sponsor_id, given_date);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_sponsor_payments_reversal ON sponsor_payments (reversal_of) WHERE reversal_of IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS plan_allocation;
+DROP TABLE IF EXISTS plan_installment;
+DROP TABLE IF EXISTS payment_plan;
@@ -0,0 +1,46 @@
+CREATE TABLE IF NOT EXISTS payment_plan
+(
+    id              uuid PRIMARY KEY,
+    company_id      int              NOT NULL,
+    student_id      uuid             NOT NULL,
+    group_id        bigint           NOT NULL,
+    status          varchar          NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'COMPLETED', 'CANCELLED')),
+    late_fee_type   varchar CHECK (late_fee_type IN ('FIXED', 'PERCENT')),
+    late_fee_value  double precision NOT NULL DEFAULT 0,
+    grace_days      int              NOT NULL DEFAULT 0 CHECK (grace_days >= 0),
+    comment         varchar          NOT NULL DEFAULT '',
+    cancel_reason   varchar,
+    created_by_id   uuid             NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    created_by_name varchar          NOT NULL DEFAULT '',
+    created_at      timestamp                 DEFAULT now(),
+    CHECK (late_fee_type <> 'PERCENT' OR late_fee_value <= 100)
+);
+-- one running plan per enrollment
+CREATE UNIQUE INDEX IF NOT EXISTS uq_payment_plan_active ON payment_plan (company_id, student_id, group_id) WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS plan_installment
+(
+    id                  uuid PRIMARY KEY,
+    plan_id             uuid             NOT NULL REFERENCES payment_plan (id),
+    number              int              NOT NULL,
+    due_date            date             NOT NULL,
+    amount              double precision NOT NULL CHECK (amount > 0),
+    late_fee            double precision NOT NULL DEFAULT 0,
+    late_fee_applied_at timestamp,
+    paid                double precision NOT NULL DEFAULT 0,
+    paid_at             date,
+    UNIQUE (plan_id, number)
+);
+CREATE INDEX IF NOT EXISTS idx_plan_installment_due ON plan_installment (due_date) WHERE paid_at IS NULL;
+
+-- which payment paid what of an installment
+CREATE TABLE IF NOT EXISTS plan_allocation
+(
+    id             uuid PRIMARY KEY,
+    installment_id uuid             NOT NULL REFERENCES plan_installment (id),
+    payment_id     uuid             NOT NULL REFERENCES student_payments (id),
+    amount         double precision NOT NULL,
+    created_at     timestamp DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_plan_allocation_payment ON plan_allocation (payment_id);
+CREATE INDEX IF NOT EXISTS idx_plan_allocation_installment ON plan_allocation (installment_id);
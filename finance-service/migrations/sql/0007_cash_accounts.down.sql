@@ -0,0 +1,30 @@
+ALTER TABLE sponsor_payments
+    DROP COLUMN IF EXISTS account_id,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS original_amount,
+    DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE sponsor_payments DROP CONSTRAINT IF EXISTS sponsor_payments_method_check;
+ALTER TABLE sponsor_payments
+    ADD CONSTRAINT sponsor_payments_method_check CHECK (method IN ('CASH', 'CLICK', 'PAYME', 'TRANSFER'));
+
+ALTER TABLE expense
+    DROP COLUMN IF EXISTS account_id,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS original_sum,
+    DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE expense DROP CONSTRAINT IF EXISTS expense_payment_method_check;
+ALTER TABLE expense
+    ADD CONSTRAINT expense_payment_method_check CHECK (payment_method IN ('CASH', 'CLICK', 'PAYME'));
+
+ALTER TABLE student_payments
+    DROP COLUMN IF EXISTS account_id,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS original_amount,
+    DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE student_payments DROP CONSTRAINT IF EXISTS student_payments_method_check;
+ALTER TABLE student_payments
+    ADD CONSTRAINT student_payments_method_check CHECK (method IN ('CLICK', 'CASH', 'PAYME'));
+
+DROP TABLE IF EXISTS exchange_rate;
+DROP TABLE IF EXISTS cash_shift;
+DROP TABLE IF EXISTS cash_account;
@@ -0,0 +1,119 @@
+CREATE TABLE IF NOT EXISTS cash_account
+(
+    id             uuid PRIMARY KEY,
+    company_id     int       NOT NULL,
+    name           varchar   NOT NULL,
+    kind           varchar   NOT NULL CHECK (kind IN ('CASH_DESK', 'BANK', 'CARD_TERMINAL', 'ONLINE')),
+    currency       varchar   NOT NULL DEFAULT 'UZS' CHECK (currency IN ('UZS', 'USD')),
+    method         varchar   NOT NULL CHECK (method IN ('CASH', 'CLICK', 'PAYME', 'CARD', 'TRANSFER')),
+    is_default     boolean   NOT NULL DEFAULT false,
+    requires_shift boolean   NOT NULL DEFAULT false,
+    is_active      boolean   NOT NULL DEFAULT true,
+    created_at     timestamp NOT NULL DEFAULT now()
+);
+-- where payments of a method go when no account is given
+CREATE UNIQUE INDEX IF NOT EXISTS uq_cash_account_default ON cash_account (company_id, method) WHERE is_default;
+
+CREATE TABLE IF NOT EXISTS cash_shift
+(
+    id              uuid PRIMARY KEY,
+    account_id      uuid             NOT NULL REFERENCES cash_account (id),
+    company_id      int              NOT NULL,
+    status          varchar          NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'CLOSED')),
+    opened_at       timestamp        NOT NULL DEFAULT now(),
+    opened_by_id    uuid             NOT NULL,
+    opened_by_name  varchar          NOT NULL DEFAULT '',
+    opening_counted double precision NOT NULL DEFAULT 0,
+    closed_at       timestamp,
+    closed_by_id    uuid,
+    closed_by_name  varchar,
+    inflow          double precision,
+    outflow         double precision,
+    expected        double precision,
+    counted         double precision,
+    comment         varchar          NOT NULL DEFAULT ''
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_cash_shift_open ON cash_shift (account_id) WHERE status = 'OPEN';
+CREATE INDEX IF NOT EXISTS idx_cash_shift_account ON cash_shift (company_id, account_id, opened_at);
+
+CREATE TABLE IF NOT EXISTS exchange_rate
+(
+    id              uuid PRIMARY KEY,
+    company_id      int              NOT NULL,
+    currency        varchar          NOT NULL CHECK (currency IN ('USD')),
+    rate            double precision NOT NULL CHECK (rate > 0),
+    rate_date       date             NOT NULL,
+    created_by_id   uuid             NOT NULL,
+    created_by_name varchar          NOT NULL DEFAULT '',
+    created_at      timestamp        NOT NULL DEFAULT now(),
+    UNIQUE (company_id, currency, rate_date)
+);
+
+-- amount and sum stay in UZS; original_amount is what was paid in the
+-- account's currency at exchange_rate
+ALTER TABLE student_payments DROP CONSTRAINT IF EXISTS student_payments_method_check;
+ALTER TABLE student_payments
+    ADD CONSTRAINT student_payments_method_check CHECK (method IN ('CASH', 'CLICK', 'PAYME', 'CARD', 'TRANSFER'));
+ALTER TABLE student_payments
+    ADD COLUMN IF NOT EXISTS account_id uuid REFERENCES cash_account (id),
+    ADD COLUMN IF NOT EXISTS currency varchar NOT NULL DEFAULT 'UZS',
+    ADD COLUMN IF NOT EXISTS original_amount double precision,
+    ADD COLUMN IF NOT EXISTS exchange_rate double precision NOT NULL DEFAULT 1;
+
+ALTER TABLE expense DROP CONSTRAINT IF EXISTS expense_payment_method_check;
+ALTER TABLE expense
+    ADD CONSTRAINT expense_payment_method_check CHECK (payment_method IN ('CASH', 'CLICK', 'PAYME', 'CARD', 'TRANSFER'));
+ALTER TABLE expense
+    ADD COLUMN IF NOT EXISTS account_id uuid REFERENCES cash_account (id),
+    ADD COLUMN IF NOT EXISTS currency varchar NOT NULL DEFAULT 'UZS',
+    ADD COLUMN IF NOT EXISTS original_sum double precision,
+    ADD COLUMN IF NOT EXISTS exchange_rate double precision NOT NULL DEFAULT 1;
+
+ALTER TABLE sponsor_payments DROP CONSTRAINT IF EXISTS sponsor_payments_method_check;
+ALTER TABLE sponsor_payments
+    ADD CONSTRAINT sponsor_payments_method_check CHECK (method IN ('CASH', 'CLICK', 'PAYME', 'CARD', 'TRANSFER'));
+ALTER TABLE sponsor_payments
+    ADD COLUMN IF NOT EXISTS account_id uuid REFERENCES cash_account (id),
+    ADD COLUMN IF NOT EXISTS currency varchar NOT NULL DEFAULT 'UZS',
+    ADD COLUMN IF NOT EXISTS original_amount double precision,
+    ADD COLUMN IF NOT EXISTS exchange_rate double precision NOT NULL DEFAULT 1;
+
+CREATE INDEX IF NOT EXISTS idx_student_payments_account ON student_payments (account_id, created_at) WHERE account_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_expense_account ON expense (account_id, created_at) WHERE account_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_sponsor_payments_account ON sponsor_payments (account_id, created_at) WHERE account_id IS NOT NULL;
+
+-- every company gets a default UZS account for each method it already took
+-- money with, and the money taken so far is posted to it
+INSERT INTO cash_account (id, company_id, name, kind, method, is_default)
+SELECT gen_random_uuid(),
+       m.company_id,
+       CASE m.method WHEN 'CASH' THEN 'Kassa' WHEN 'CLICK' THEN 'Click' WHEN 'PAYME' THEN 'Payme' ELSE 'Bank' END,
+       CASE m.method WHEN 'CASH' THEN 'CASH_DESK' WHEN 'TRANSFER' THEN 'BANK' ELSE 'ONLINE' END,
+       m.method,
+       true
+FROM (SELECT company_id, method FROM student_payments WHERE payment_type = 'ADD' AND company_id IS NOT NULL
+      UNION
+      SELECT company_id, payment_method FROM expense WHERE company_id IS NOT NULL
+      UNION
+      SELECT company_id, method FROM sponsor_payments WHERE payment_type = 'ADD') m
+ON CONFLICT DO NOTHING;
+
+UPDATE student_payments sp
+SET account_id = a.id
+FROM cash_account a
+WHERE a.company_id = sp.company_id AND a.method = sp.method AND a.is_default
+  AND sp.payment_type = 'ADD' AND sp.account_id IS NULL;
+UPDATE expense e
+SET account_id = a.id
+FROM cash_account a
+WHERE a.company_id = e.company_id AND a.method = e.payment_method AND a.is_default
+  AND e.account_id IS NULL;
+UPDATE sponsor_payments sp
+SET account_id = a.id
+FROM cash_account a
+WHERE a.company_id = sp.company_id AND a.method = sp.method AND a.is_default
+  AND sp.payment_type = 'ADD' AND sp.account_id IS NULL;
+
+UPDATE student_payments SET original_amount = amount WHERE original_amount IS NULL;
+UPDATE expense SET original_sum = sum WHERE original_sum IS NULL;
+UPDATE sponsor_payments SET original_amount = amount WHERE original_amount IS NULL;
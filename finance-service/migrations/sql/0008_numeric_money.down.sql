@@ -0,0 +1,46 @@
+ALTER TABLE student_discount
+    ALTER COLUMN discount TYPE double precision USING discount::double precision;
+ALTER TABLE student_discount_history
+    ALTER COLUMN discount TYPE double precision USING discount::double precision;
+
+ALTER TABLE expense
+    ALTER COLUMN sum TYPE double precision USING sum::double precision,
+    ALTER COLUMN original_sum TYPE double precision USING original_sum::double precision,
+    ALTER COLUMN exchange_rate TYPE double precision USING exchange_rate::double precision;
+
+ALTER TABLE student_payments
+    ALTER COLUMN amount TYPE double precision USING amount::double precision,
+    ALTER COLUMN original_amount TYPE double precision USING original_amount::double precision,
+    ALTER COLUMN exchange_rate TYPE double precision USING exchange_rate::double precision;
+
+ALTER TABLE discount_rule
+    ALTER COLUMN value TYPE double precision USING value::double precision;
+ALTER TABLE discount_policy
+    ALTER COLUMN max_percent TYPE double precision USING max_percent::double precision;
+ALTER TABLE discount_rule_application
+    ALTER COLUMN amount TYPE double precision USING amount::double precision;
+
+ALTER TABLE sponsorship
+    ALTER COLUMN value TYPE double precision USING value::double precision;
+ALTER TABLE sponsor_payments
+    ALTER COLUMN amount TYPE double precision USING amount::double precision,
+    ALTER COLUMN original_amount TYPE double precision USING original_amount::double precision,
+    ALTER COLUMN exchange_rate TYPE double precision USING exchange_rate::double precision;
+
+ALTER TABLE payment_plan
+    ALTER COLUMN late_fee_value TYPE double precision USING late_fee_value::double precision;
+ALTER TABLE plan_installment
+    ALTER COLUMN amount TYPE double precision USING amount::double precision,
+    ALTER COLUMN late_fee TYPE double precision USING late_fee::double precision,
+    ALTER COLUMN paid TYPE double precision USING paid::double precision;
+ALTER TABLE plan_allocation
+    ALTER COLUMN amount TYPE double precision USING amount::double precision;
+
+ALTER TABLE cash_shift
+    ALTER COLUMN opening_counted TYPE double precision USING opening_counted::double precision,
+    ALTER COLUMN inflow TYPE double precision USING inflow::double precision,
+    ALTER COLUMN outflow TYPE double precision USING outflow::double precision,
+    ALTER COLUMN expected TYPE double precision USING expected::double precision,
+    ALTER COLUMN counted TYPE double precision USING counted::double precision;
+ALTER TABLE exchange_rate
+    ALTER COLUMN rate TYPE double precision USING rate::double precision;
@@ -0,0 +1,49 @@
+-- Money is stored as exact numeric with two decimal places and exchange
+-- rates with four. Existing values are rounded half away from zero, which is
+-- the rule internal/money applies in code.
+ALTER TABLE student_discount
+    ALTER COLUMN discount TYPE numeric(16, 2) USING round(discount::numeric, 2);
+ALTER TABLE student_discount_history
+    ALTER COLUMN discount TYPE numeric(16, 2) USING round(discount::numeric, 2);
+
+ALTER TABLE expense
+    ALTER COLUMN sum TYPE numeric(16, 2) USING round(sum::numeric, 2),
+    ALTER COLUMN original_sum TYPE numeric(16, 2) USING round(original_sum::numeric, 2),
+    ALTER COLUMN exchange_rate TYPE numeric(16, 4) USING round(exchange_rate::numeric, 4);
+
+ALTER TABLE student_payments
+    ALTER COLUMN amount TYPE numeric(16, 2) USING round(amount::numeric, 2),
+    ALTER COLUMN original_amount TYPE numeric(16, 2) USING round(original_amount::numeric, 2),
+    ALTER COLUMN exchange_rate TYPE numeric(16, 4) USING round(exchange_rate::numeric, 4);
+
+ALTER TABLE discount_rule
+    ALTER COLUMN value TYPE numeric(16, 2) USING round(value::numeric, 2);
+ALTER TABLE discount_policy
+    ALTER COLUMN max_percent TYPE numeric(5, 2) USING round(max_percent::numeric, 2);
+ALTER TABLE discount_rule_application
+    ALTER COLUMN amount TYPE numeric(16, 2) USING round(amount::numeric, 2);
+
+ALTER TABLE sponsorship
+    ALTER COLUMN value TYPE numeric(16, 2) USING round(value::numeric, 2);
+ALTER TABLE sponsor_payments
+    ALTER COLUMN amount TYPE numeric(16, 2) USING round(amount::numeric, 2),
+    ALTER COLUMN original_amount TYPE numeric(16, 2) USING round(original_amount::numeric, 2),
+    ALTER COLUMN exchange_rate TYPE numeric(16, 4) USING round(exchange_rate::numeric, 4);
+
+ALTER TABLE payment_plan
+    ALTER COLUMN late_fee_value TYPE numeric(16, 2) USING round(late_fee_value::numeric, 2);
+ALTER TABLE plan_installment
+    ALTER COLUMN amount TYPE numeric(16, 2) USING round(amount::numeric, 2),
+    ALTER COLUMN late_fee TYPE numeric(16, 2) USING round(late_fee::numeric, 2),
+    ALTER COLUMN paid TYPE numeric(16, 2) USING round(paid::numeric, 2);
+ALTER TABLE plan_allocation
+    ALTER COLUMN amount TYPE numeric(16, 2) USING round(amount::numeric, 2);
+
+ALTER TABLE cash_shift
+    ALTER COLUMN opening_counted TYPE numeric(16, 2) USING round(opening_counted::numeric, 2),
+    ALTER COLUMN inflow TYPE numeric(16, 2) USING round(inflow::numeric, 2),
+    ALTER COLUMN outflow TYPE numeric(16, 2) USING round(outflow::numeric, 2),
+    ALTER COLUMN expected TYPE numeric(16, 2) USING round(expected::numeric, 2),
+    ALTER COLUMN counted TYPE numeric(16, 2) USING round(counted::numeric, 2);
+ALTER TABLE exchange_rate
+    ALTER COLUMN rate TYPE numeric(16, 4) USING round(rate::numeric, 4);
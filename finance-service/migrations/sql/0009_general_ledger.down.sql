@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS journal_line;
+DROP TABLE IF EXISTS journal_entry;
+DROP TABLE IF EXISTS ledger_account;
@@ -0,0 +1,120 @@
+CREATE TABLE IF NOT EXISTS ledger_account
+(
+    company_id int     NOT NULL,
+    code       varchar NOT NULL,
+    name       varchar NOT NULL,
+    type       varchar NOT NULL CHECK (type IN ('ASSET', 'LIABILITY', 'EQUITY', 'INCOME', 'EXPENSE')),
+    -- money on hand, what cash flow is about
+    is_cash    boolean NOT NULL DEFAULT false,
+    is_system  boolean NOT NULL DEFAULT false,
+    created_at timestamp        DEFAULT now(),
+    PRIMARY KEY (company_id, code),
+    CHECK (NOT is_cash OR type = 'ASSET')
+);
+
+CREATE TABLE IF NOT EXISTS journal_entry
+(
+    id              uuid PRIMARY KEY,
+    company_id      int     NOT NULL,
+    entry_date      date    NOT NULL,
+    -- STUDENT_PAYMENT, SPONSOR_PAYMENT, EXPENSE or MANUAL
+    source          varchar NOT NULL CHECK (source IN ('STUDENT_PAYMENT', 'SPONSOR_PAYMENT', 'EXPENSE', 'MANUAL')),
+    source_id       uuid,
+    description     varchar NOT NULL DEFAULT '',
+    reversal_of     uuid REFERENCES journal_entry (id),
+    created_by_id   uuid    NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    created_by_name varchar NOT NULL DEFAULT '',
+    created_at      timestamp        DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_journal_entry_date ON journal_entry (company_id, entry_date);
+-- a document is posted once, and reversed once
+CREATE UNIQUE INDEX IF NOT EXISTS uq_journal_entry_source ON journal_entry (source, source_id) WHERE source_id IS NOT NULL AND reversal_of IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_journal_entry_reversal ON journal_entry (reversal_of) WHERE reversal_of IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS journal_line
+(
+    id              bigserial PRIMARY KEY,
+    entry_id        uuid           NOT NULL REFERENCES journal_entry (id),
+    company_id      int            NOT NULL,
+    account_code    varchar        NOT NULL,
+    debit           numeric(16, 2) NOT NULL DEFAULT 0,
+    credit          numeric(16, 2) NOT NULL DEFAULT 0,
+    student_id      uuid,
+    sponsor_id      uuid,
+    cash_account_id uuid REFERENCES cash_account (id),
+    user_id         uuid,
+    category_id     int,
+    FOREIGN KEY (company_id, account_code) REFERENCES ledger_account (company_id, code),
+    CHECK ((debit > 0 AND credit = 0) OR (credit > 0 AND debit = 0))
+);
+CREATE INDEX IF NOT EXISTS idx_journal_line_entry ON journal_line (entry_id);
+CREATE INDEX IF NOT EXISTS idx_journal_line_account ON journal_line (company_id, account_code);
+CREATE INDEX IF NOT EXISTS idx_journal_line_student ON journal_line (student_id) WHERE student_id IS NOT NULL;
+
+-- every company that has money records gets the system chart, and what it
+-- recorded so far is posted the way the service posts it from now on
+INSERT INTO ledger_account (company_id, code, name, type, is_cash, is_system)
+SELECT c.company_id, a.code, a.name, a.type, a.is_cash, true
+FROM (SELECT company_id FROM student_payments
+      UNION
+      SELECT company_id FROM sponsor_payments
+      UNION
+      SELECT company_id FROM expense) c
+         CROSS JOIN (VALUES ('1100', 'Kassa va bank', 'ASSET', true),
+                            ('2100', 'O''quvchilar balansi', 'LIABILITY', false),
+                            ('2200', 'Homiylar balansi', 'LIABILITY', false),
+                            ('3000', 'Kapital', 'EQUITY', false),
+                            ('4100', 'O''qish to''lovlari', 'INCOME', false),
+                            ('4900', 'Qaytarilgan to''lovlar', 'INCOME', false),
+                            ('5100', 'Xarajatlar', 'EXPENSE', false),
+                            ('5200', 'Ish haqi', 'EXPENSE', false)) a(code, name, type, is_cash)
+WHERE c.company_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+INSERT INTO journal_entry (id, company_id, entry_date, source, source_id, description, created_by_id, created_by_name, created_at)
+SELECT gen_random_uuid(), company_id, given_date, 'STUDENT_PAYMENT', id, comment, created_by_id, created_by_name, coalesce(created_at, now())
+FROM student_payments
+WHERE company_id IS NOT NULL AND amount <> 0 AND reversal_of IS NULL;
+INSERT INTO journal_entry (id, company_id, entry_date, source, source_id, description, reversal_of, created_by_id, created_by_name, created_at)
+SELECT gen_random_uuid(), p.company_id, p.given_date, 'STUDENT_PAYMENT', p.id, p.comment, o.id, p.created_by_id, p.created_by_name, coalesce(p.created_at, now())
+FROM student_payments p
+         LEFT JOIN journal_entry o ON o.source = 'STUDENT_PAYMENT' AND o.source_id = p.reversal_of
+WHERE p.company_id IS NOT NULL AND p.amount <> 0 AND p.reversal_of IS NOT NULL;
+
+INSERT INTO journal_entry (id, company_id, entry_date, source, source_id, description, created_by_id, created_by_name, created_at)
+SELECT gen_random_uuid(), company_id, given_date, 'SPONSOR_PAYMENT', id, comment, created_by_id, created_by_name, coalesce(created_at, now())
+FROM sponsor_payments
+WHERE amount <> 0 AND reversal_of IS NULL;
+INSERT INTO journal_entry (id, company_id, entry_date, source, source_id, description, reversal_of, created_by_id, created_by_name, created_at)
+SELECT gen_random_uuid(), p.company_id, p.given_date, 'SPONSOR_PAYMENT', p.id, p.comment, o.id, p.created_by_id, p.created_by_name, coalesce(p.created_at, now())
+FROM sponsor_payments p
+         LEFT JOIN journal_entry o ON o.source = 'SPONSOR_PAYMENT' AND o.source_id = p.reversal_of
+WHERE p.amount <> 0 AND p.reversal_of IS NOT NULL;
+
+INSERT INTO journal_entry (id, company_id, entry_date, source, source_id, description, created_by_id, created_at)
+SELECT gen_random_uuid(), company_id, given_date, 'EXPENSE', id, title, created_by, coalesce(created_at, now())
+FROM expense
+WHERE company_id IS NOT NULL AND sum <> 0;
+
+-- each document is a debit and a credit of its amount; a negative amount,
+-- as on a reversal, swaps the sides
+INSERT INTO journal_line (entry_id, company_id, account_code, debit, credit, student_id, cash_account_id)
+SELECT e.id, p.company_id, s.code, greatest(s.sign * p.amount, 0), greatest(-s.sign * p.amount, 0), p.student_id, p.account_id
+FROM student_payments p
+         JOIN journal_entry e ON e.source = 'STUDENT_PAYMENT' AND e.source_id = p.id
+         CROSS JOIN LATERAL (VALUES (CASE p.payment_type WHEN 'ADD' THEN '1100' WHEN 'TAKE_OFF' THEN '2100' ELSE '4900' END, 1),
+                                    (CASE p.payment_type WHEN 'ADD' THEN '2100' WHEN 'TAKE_OFF' THEN '4100' ELSE '2100' END, -1)) s(code, sign);
+
+INSERT INTO journal_line (entry_id, company_id, account_code, debit, credit, student_id, sponsor_id, cash_account_id)
+SELECT e.id, p.company_id, s.code, greatest(s.sign * p.amount, 0), greatest(-s.sign * p.amount, 0), p.student_id, p.sponsor_id, p.account_id
+FROM sponsor_payments p
+         JOIN journal_entry e ON e.source = 'SPONSOR_PAYMENT' AND e.source_id = p.id
+         CROSS JOIN LATERAL (VALUES (CASE p.payment_type WHEN 'ADD' THEN '1100' WHEN 'CHARGE' THEN '2200' ELSE '4900' END, 1),
+                                    (CASE p.payment_type WHEN 'ADD' THEN '2200' WHEN 'CHARGE' THEN '4100' ELSE '2200' END, -1)) s(code, sign);
+
+INSERT INTO journal_line (entry_id, company_id, account_code, debit, credit, cash_account_id, user_id, category_id)
+SELECT e.id, x.company_id, s.code, greatest(s.sign * x.sum, 0), greatest(-s.sign * x.sum, 0), x.account_id, x.user_id, x.category_id
+FROM expense x
+         JOIN journal_entry e ON e.source = 'EXPENSE' AND e.source_id = x.id
+         CROSS JOIN LATERAL (VALUES (CASE x.expense_type WHEN 'USER' THEN '5200' ELSE '5100' END, 1),
+                                    ('1100', -1)) s(code, sign);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_sponsor_payments_refund_of;
+ALTER TABLE sponsor_payments
+    DROP COLUMN IF EXISTS refund_of,
+    DROP COLUMN IF EXISTS charge_amount;
@@ -0,0 +1,8 @@
+-- a sponsor's part of a charge keeps the whole charge it was taken from, so
+-- a refund of the month gives each sponsor back the part they paid; the
+-- refund points at the charge it reverses. Charges booked before this have
+-- no charge_amount and are refunded up to their whole amount.
+ALTER TABLE sponsor_payments
+    ADD COLUMN IF NOT EXISTS charge_amount numeric(16, 2),
+    ADD COLUMN IF NOT EXISTS refund_of     uuid REFERENCES sponsor_payments (id);
+CREATE INDEX IF NOT EXISTS idx_sponsor_payments_refund_of ON sponsor_payments (refund_of) WHERE refund_of IS NOT NULL;
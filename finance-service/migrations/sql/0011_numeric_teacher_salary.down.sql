@@ -0,0 +1,2 @@
+ALTER TABLE teacher_salary
+    ALTER COLUMN salary_type_count TYPE double precision USING salary_type_count::double precision;
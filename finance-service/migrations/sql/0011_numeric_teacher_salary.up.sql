@@ -0,0 +1,2 @@
+ALTER TABLE teacher_salary
+    ALTER COLUMN salary_type_count TYPE numeric(16, 2) USING round(salary_type_count::numeric, 2);
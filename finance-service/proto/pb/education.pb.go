@@ -87,7 +87,7 @@ type AbsCourse struct {
 	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	LessonDuration int32                  `protobuf:"varint,2,opt,name=lessonDuration,proto3" json:"lessonDuration,omitempty"`
 	CourseDuration int32                  `protobuf:"varint,3,opt,name=courseDuration,proto3" json:"courseDuration,omitempty"`
-	Price          float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Price          string                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
 	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
@@ -151,11 +151,11 @@ func (x *AbsCourse) GetCourseDuration() int32 {
 	return 0
 }
 
-func (x *AbsCourse) GetPrice() float64 {
+func (x *AbsCourse) GetPrice() string {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return ""
 }
 
 func (x *AbsCourse) GetDescription() string {
@@ -165,6 +165,159 @@ func (x *AbsCourse) GetDescription() string {
 	return ""
 }
 
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_education_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{2}
+}
+
+type CompanySubscription struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// ACTIVE, GRACE (read-only after valid_date) or LOCKED
+	State          string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ValidDate      string `protobuf:"bytes,3,opt,name=validDate,proto3" json:"validDate,omitempty"`
+	GraceUntil     string `protobuf:"bytes,4,opt,name=graceUntil,proto3" json:"graceUntil,omitempty"`
+	DaysLeft       int32  `protobuf:"varint,5,opt,name=daysLeft,proto3" json:"daysLeft,omitempty"`
+	IsDemo         bool   `protobuf:"varint,6,opt,name=isDemo,proto3" json:"isDemo,omitempty"`
+	TariffId       int32  `protobuf:"varint,7,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	TariffName     string `protobuf:"bytes,8,opt,name=tariffName,proto3" json:"tariffName,omitempty"`
+	StudentLimit   int32  `protobuf:"varint,9,opt,name=studentLimit,proto3" json:"studentLimit,omitempty"`
+	ActiveStudents int32  `protobuf:"varint,10,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompanySubscription) Reset() {
+	*x = CompanySubscription{}
+	mi := &file_education_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanySubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanySubscription) ProtoMessage() {}
+
+func (x *CompanySubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanySubscription.ProtoReflect.Descriptor instead.
+func (*CompanySubscription) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompanySubscription) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetValidDate() string {
+	if x != nil {
+		return x.ValidDate
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetGraceUntil() string {
+	if x != nil {
+		return x.GraceUntil
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetDaysLeft() int32 {
+	if x != nil {
+		return x.DaysLeft
+	}
+	return 0
+}
+
+func (x *CompanySubscription) GetIsDemo() bool {
+	if x != nil {
+		return x.IsDemo
+	}
+	return false
+}
+
+func (x *CompanySubscription) GetTariffId() int32 {
+	if x != nil {
+		return x.TariffId
+	}
+	return 0
+}
+
+func (x *CompanySubscription) GetTariffName() string {
+	if x != nil {
+		return x.TariffName
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetStudentLimit() int32 {
+	if x != nil {
+		return x.StudentLimit
+	}
+	return 0
+}
+
+func (x *CompanySubscription) GetActiveStudents() int32 {
+	if x != nil {
+		return x.ActiveStudents
+	}
+	return 0
+}
+
 type CalculateDiscountSummaRequest struct {
 	state                                        protoimpl.MessageState `protogen:"open.v1"`
 	GroupId                                      string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
@@ -174,13 +327,15 @@ type CalculateDiscountSummaRequest struct {
 	EndDate                                      string                 `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
 	PaymentDate                                  string                 `protobuf:"bytes,6,opt,name=paymentDate,proto3" json:"paymentDate,omitempty"`
 	StudentActivationDateInThisGroupWhilePayment string                 `protobuf:"bytes,7,opt,name=studentActivationDateInThisGroupWhilePayment,proto3" json:"studentActivationDateInThisGroupWhilePayment,omitempty"`
-	unknownFields                                protoimpl.UnknownFields
-	sizeCache                                    protoimpl.SizeCache
+	// FIXED (default) or PERCENT, a percent is taken of the course price
+	DiscountType  string `protobuf:"bytes,8,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CalculateDiscountSummaRequest) Reset() {
 	*x = CalculateDiscountSummaRequest{}
-	mi := &file_education_proto_msgTypes[2]
+	mi := &file_education_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -192,7 +347,7 @@ func (x *CalculateDiscountSummaRequest) String() string {
 func (*CalculateDiscountSummaRequest) ProtoMessage() {}
 
 func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[2]
+	mi := &file_education_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +360,7 @@ func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateDiscountSummaRequest.ProtoReflect.Descriptor instead.
 func (*CalculateDiscountSummaRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{2}
+	return file_education_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CalculateDiscountSummaRequest) GetGroupId() string {
@@ -257,6 +412,13 @@ func (x *CalculateDiscountSummaRequest) GetStudentActivationDateInThisGroupWhile
 	return ""
 }
 
+func (x *CalculateDiscountSummaRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
 type CalculateDiscountResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	CalculatedPrice string                 `protobuf:"bytes,1,opt,name=calculatedPrice,proto3" json:"calculatedPrice,omitempty"`
@@ -266,7 +428,7 @@ type CalculateDiscountResponse struct {
 
 func (x *CalculateDiscountResponse) Reset() {
 	*x = CalculateDiscountResponse{}
-	mi := &file_education_proto_msgTypes[3]
+	mi := &file_education_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +440,7 @@ func (x *CalculateDiscountResponse) String() string {
 func (*CalculateDiscountResponse) ProtoMessage() {}
 
 func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[3]
+	mi := &file_education_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +453,7 @@ func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateDiscountResponse.ProtoReflect.Descriptor instead.
 func (*CalculateDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{3}
+	return file_education_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CalculateDiscountResponse) GetCalculatedPrice() string {
@@ -312,13 +474,14 @@ type ChangeUserBalanceHistoryByDebitRequest struct {
 	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
 	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
 	CurrentDebit  string                 `protobuf:"bytes,9,opt,name=currentDebit,proto3" json:"currentDebit,omitempty"`
+	EventId       string                 `protobuf:"bytes,10,opt,name=eventId,proto3" json:"eventId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChangeUserBalanceHistoryByDebitRequest) Reset() {
 	*x = ChangeUserBalanceHistoryByDebitRequest{}
-	mi := &file_education_proto_msgTypes[4]
+	mi := &file_education_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +493,7 @@ func (x *ChangeUserBalanceHistoryByDebitRequest) String() string {
 func (*ChangeUserBalanceHistoryByDebitRequest) ProtoMessage() {}
 
 func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[4]
+	mi := &file_education_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +506,7 @@ func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use ChangeUserBalanceHistoryByDebitRequest.ProtoReflect.Descriptor instead.
 func (*ChangeUserBalanceHistoryByDebitRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{4}
+	return file_education_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ChangeUserBalanceHistoryByDebitRequest) GetStudentId() string {
@@ -409,6 +572,13 @@ func (x *ChangeUserBalanceHistoryByDebitRequest) GetCurrentDebit() string {
 	return ""
 }
 
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
 type ChangeUserBalanceHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
@@ -419,13 +589,14 @@ type ChangeUserBalanceHistoryRequest struct {
 	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
 	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
 	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	EventId       string                 `protobuf:"bytes,9,opt,name=eventId,proto3" json:"eventId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChangeUserBalanceHistoryRequest) Reset() {
 	*x = ChangeUserBalanceHistoryRequest{}
-	mi := &file_education_proto_msgTypes[5]
+	mi := &file_education_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +608,7 @@ func (x *ChangeUserBalanceHistoryRequest) String() string {
 func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
 
 func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[5]
+	mi := &file_education_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +621,7 @@ func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
 func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{5}
+	return file_education_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
@@ -509,6 +680,13 @@ func (x *ChangeUserBalanceHistoryRequest) GetGroupId() string {
 	return ""
 }
 
+func (x *ChangeUserBalanceHistoryRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
 type GetStudentsByGroupIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
@@ -518,7 +696,7 @@ type GetStudentsByGroupIdResponse struct {
 
 func (x *GetStudentsByGroupIdResponse) Reset() {
 	*x = GetStudentsByGroupIdResponse{}
-	mi := &file_education_proto_msgTypes[6]
+	mi := &file_education_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -530,7 +708,7 @@ func (x *GetStudentsByGroupIdResponse) String() string {
 func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
 
 func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[6]
+	mi := &file_education_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +721,7 @@ func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{6}
+	return file_education_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
@@ -563,7 +741,7 @@ type GetStudentsByGroupIdRequest struct {
 
 func (x *GetStudentsByGroupIdRequest) Reset() {
 	*x = GetStudentsByGroupIdRequest{}
-	mi := &file_education_proto_msgTypes[7]
+	mi := &file_education_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -575,7 +753,7 @@ func (x *GetStudentsByGroupIdRequest) String() string {
 func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
 
 func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[7]
+	mi := &file_education_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,7 +766,7 @@ func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{7}
+	return file_education_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
@@ -616,7 +794,7 @@ type AbsStudent struct {
 
 func (x *AbsStudent) Reset() {
 	*x = AbsStudent{}
-	mi := &file_education_proto_msgTypes[8]
+	mi := &file_education_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -628,7 +806,7 @@ func (x *AbsStudent) String() string {
 func (*AbsStudent) ProtoMessage() {}
 
 func (x *AbsStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[8]
+	mi := &file_education_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +819,7 @@ func (x *AbsStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
 func (*AbsStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{8}
+	return file_education_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AbsStudent) GetId() string {
@@ -672,7 +850,7 @@ type GetStudentByIdResponse struct {
 	Gender        bool                   `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
 	DateOfBirth   string                 `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
 	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Balance       float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	Balance       string                 `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	Groups        []*GetGroupStudent     `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
 	Condition     string                 `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
@@ -682,7 +860,7 @@ type GetStudentByIdResponse struct {
 
 func (x *GetStudentByIdResponse) Reset() {
 	*x = GetStudentByIdResponse{}
-	mi := &file_education_proto_msgTypes[9]
+	mi := &file_education_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -694,7 +872,7 @@ func (x *GetStudentByIdResponse) String() string {
 func (*GetStudentByIdResponse) ProtoMessage() {}
 
 func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[9]
+	mi := &file_education_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +885,7 @@ func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{9}
+	return file_education_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetStudentByIdResponse) GetId() string {
@@ -745,11 +923,11 @@ func (x *GetStudentByIdResponse) GetPhone() string {
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetBalance() float64 {
+func (x *GetStudentByIdResponse) GetBalance() string {
 	if x != nil {
 		return x.Balance
 	}
-	return 0
+	return ""
 }
 
 func (x *GetStudentByIdResponse) GetCreatedAt() string {
@@ -782,7 +960,7 @@ type NoteStudentByAbsRequest struct {
 
 func (x *NoteStudentByAbsRequest) Reset() {
 	*x = NoteStudentByAbsRequest{}
-	mi := &file_education_proto_msgTypes[10]
+	mi := &file_education_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -794,7 +972,7 @@ func (x *NoteStudentByAbsRequest) String() string {
 func (*NoteStudentByAbsRequest) ProtoMessage() {}
 
 func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[10]
+	mi := &file_education_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,7 +985,7 @@ func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
 func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{10}
+	return file_education_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *NoteStudentByAbsRequest) GetId() string {
@@ -832,14 +1010,14 @@ type GetGroupStudent struct {
 	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
 	StudentAddedAt     string                 `protobuf:"bytes,14,opt,name=studentAddedAt,proto3" json:"studentAddedAt,omitempty"`
 	TeacherName        string                 `protobuf:"bytes,15,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	PriceForStudent    float64                `protobuf:"fixed64,13,opt,name=priceForStudent,proto3" json:"priceForStudent,omitempty"`
+	PriceForStudent    string                 `protobuf:"bytes,13,opt,name=priceForStudent,proto3" json:"priceForStudent,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetGroupStudent) Reset() {
 	*x = GetGroupStudent{}
-	mi := &file_education_proto_msgTypes[11]
+	mi := &file_education_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -851,7 +1029,7 @@ func (x *GetGroupStudent) String() string {
 func (*GetGroupStudent) ProtoMessage() {}
 
 func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[11]
+	mi := &file_education_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +1042,7 @@ func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
 func (*GetGroupStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{11}
+	return file_education_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetGroupStudent) GetId() string {
@@ -958,11 +1136,11 @@ func (x *GetGroupStudent) GetTeacherName() string {
 	return ""
 }
 
-func (x *GetGroupStudent) GetPriceForStudent() float64 {
+func (x *GetGroupStudent) GetPriceForStudent() string {
 	if x != nil {
 		return x.PriceForStudent
 	}
-	return 0
+	return ""
 }
 
 type StudentIdRequest struct {
@@ -974,7 +1152,7 @@ type StudentIdRequest struct {
 
 func (x *StudentIdRequest) Reset() {
 	*x = StudentIdRequest{}
-	mi := &file_education_proto_msgTypes[12]
+	mi := &file_education_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -986,7 +1164,7 @@ func (x *StudentIdRequest) String() string {
 func (*StudentIdRequest) ProtoMessage() {}
 
 func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[12]
+	mi := &file_education_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,7 +1177,7 @@ func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudentIdRequest.ProtoReflect.Descriptor instead.
 func (*StudentIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{12}
+	return file_education_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StudentIdRequest) GetStudentId() string {
@@ -1019,7 +1197,7 @@ type GetGroupsByStudentResponse struct {
 
 func (x *GetGroupsByStudentResponse) Reset() {
 	*x = GetGroupsByStudentResponse{}
-	mi := &file_education_proto_msgTypes[13]
+	mi := &file_education_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1031,7 +1209,7 @@ func (x *GetGroupsByStudentResponse) String() string {
 func (*GetGroupsByStudentResponse) ProtoMessage() {}
 
 func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[13]
+	mi := &file_education_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +1222,7 @@ func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsByStudentResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupsByStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{13}
+	return file_education_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetGroupsByStudentResponse) GetComments() []*DebtorComment {
@@ -1070,7 +1248,7 @@ type GetGroupByIdRequest struct {
 
 func (x *GetGroupByIdRequest) Reset() {
 	*x = GetGroupByIdRequest{}
-	mi := &file_education_proto_msgTypes[14]
+	mi := &file_education_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1082,7 +1260,7 @@ func (x *GetGroupByIdRequest) String() string {
 func (*GetGroupByIdRequest) ProtoMessage() {}
 
 func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[14]
+	mi := &file_education_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,7 +1273,7 @@ func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{14}
+	return file_education_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetGroupByIdRequest) GetId() string {
@@ -1125,7 +1303,7 @@ type GetGroupAbsResponse struct {
 
 func (x *GetGroupAbsResponse) Reset() {
 	*x = GetGroupAbsResponse{}
-	mi := &file_education_proto_msgTypes[15]
+	mi := &file_education_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1137,7 +1315,7 @@ func (x *GetGroupAbsResponse) String() string {
 func (*GetGroupAbsResponse) ProtoMessage() {}
 
 func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[15]
+	mi := &file_education_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +1328,7 @@ func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{15}
+	return file_education_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetGroupAbsResponse) GetId() string {
@@ -1251,8 +1429,25 @@ const file_education_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
 	"\x0elessonDuration\x18\x02 \x01(\x05R\x0elessonDuration\x12&\n" +
 	"\x0ecourseDuration\x18\x03 \x01(\x05R\x0ecourseDuration\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xbb\x02\n" +
+	"\x05price\x18\x04 \x01(\tR\x05price\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x18\n" +
+	"\x16GetSubscriptionRequest\"\xc3\x02\n" +
+	"\x13CompanySubscription\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1c\n" +
+	"\tvalidDate\x18\x03 \x01(\tR\tvalidDate\x12\x1e\n" +
+	"\n" +
+	"graceUntil\x18\x04 \x01(\tR\n" +
+	"graceUntil\x12\x1a\n" +
+	"\bdaysLeft\x18\x05 \x01(\x05R\bdaysLeft\x12\x16\n" +
+	"\x06isDemo\x18\x06 \x01(\bR\x06isDemo\x12\x1a\n" +
+	"\btariffId\x18\a \x01(\x05R\btariffId\x12\x1e\n" +
+	"\n" +
+	"tariffName\x18\b \x01(\tR\n" +
+	"tariffName\x12\"\n" +
+	"\fstudentLimit\x18\t \x01(\x05R\fstudentLimit\x12&\n" +
+	"\x0eactiveStudents\x18\n" +
+	" \x01(\x05R\x0eactiveStudents\"\xdf\x02\n" +
 	"\x1dCalculateDiscountSummaRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12$\n" +
@@ -1260,9 +1455,10 @@ const file_education_proto_rawDesc = "" +
 	"\tstartDate\x18\x04 \x01(\tR\tstartDate\x12\x18\n" +
 	"\aendDate\x18\x05 \x01(\tR\aendDate\x12 \n" +
 	"\vpaymentDate\x18\x06 \x01(\tR\vpaymentDate\x12b\n" +
-	",studentActivationDateInThisGroupWhilePayment\x18\a \x01(\tR,studentActivationDateInThisGroupWhilePayment\"E\n" +
+	",studentActivationDateInThisGroupWhilePayment\x18\a \x01(\tR,studentActivationDateInThisGroupWhilePayment\x12\"\n" +
+	"\fdiscountType\x18\b \x01(\tR\fdiscountType\"E\n" +
 	"\x19CalculateDiscountResponse\x12(\n" +
-	"\x0fcalculatedPrice\x18\x01 \x01(\tR\x0fcalculatedPrice\"\xbf\x02\n" +
+	"\x0fcalculatedPrice\x18\x01 \x01(\tR\x0fcalculatedPrice\"\xd9\x02\n" +
 	"&ChangeUserBalanceHistoryByDebitRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x1a\n" +
 	"\boldDebit\x18\x02 \x01(\tR\boldDebit\x12\x1c\n" +
@@ -1272,7 +1468,9 @@ const file_education_proto_rawDesc = "" +
 	"\tcreatedBy\x18\x06 \x01(\tR\tcreatedBy\x12$\n" +
 	"\rcreatedByName\x18\a \x01(\tR\rcreatedByName\x12\x18\n" +
 	"\agroupId\x18\b \x01(\tR\agroupId\x12\"\n" +
-	"\fcurrentDebit\x18\t \x01(\tR\fcurrentDebit\"\x90\x02\n" +
+	"\fcurrentDebit\x18\t \x01(\tR\fcurrentDebit\x12\x18\n" +
+	"\aeventId\x18\n" +
+	" \x01(\tR\aeventId\"\xaa\x02\n" +
 	"\x1fChangeUserBalanceHistoryRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
@@ -1281,7 +1479,8 @@ const file_education_proto_rawDesc = "" +
 	"\fpayment_type\x18\x05 \x01(\tR\vpaymentType\x12\x1c\n" +
 	"\tcreatedBy\x18\x06 \x01(\tR\tcreatedBy\x12$\n" +
 	"\rcreatedByName\x18\a \x01(\tR\rcreatedByName\x12\x18\n" +
-	"\agroupId\x18\b \x01(\tR\agroupId\"Q\n" +
+	"\agroupId\x18\b \x01(\tR\agroupId\x12\x18\n" +
+	"\aeventId\x18\t \x01(\tR\aeventId\"Q\n" +
 	"\x1cGetStudentsByGroupIdResponse\x121\n" +
 	"\bstudents\x18\x01 \x03(\v2\x15.education.AbsStudentR\bstudents\"[\n" +
 	"\x1bGetStudentsByGroupIdRequest\x12\x18\n" +
@@ -1298,7 +1497,7 @@ const file_education_proto_rawDesc = "" +
 	"\x06gender\x18\x03 \x01(\bR\x06gender\x12 \n" +
 	"\vdateOfBirth\x18\x04 \x01(\tR\vdateOfBirth\x12\x14\n" +
 	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x18\n" +
-	"\abalance\x18\x06 \x01(\x01R\abalance\x12\x1c\n" +
+	"\abalance\x18\x06 \x01(\tR\abalance\x12\x1c\n" +
 	"\tcreatedAt\x18\a \x01(\tR\tcreatedAt\x122\n" +
 	"\x06groups\x18\b \x03(\v2\x1a.education.GetGroupStudentR\x06groups\x12\x1c\n" +
 	"\tcondition\x18\t \x01(\tR\tcondition\")\n" +
@@ -1318,7 +1517,7 @@ const file_education_proto_rawDesc = "" +
 	"\x12studentActivatedAt\x18\f \x01(\tR\x12studentActivatedAt\x12&\n" +
 	"\x0estudentAddedAt\x18\x0e \x01(\tR\x0estudentAddedAt\x12 \n" +
 	"\vteacherName\x18\x0f \x01(\tR\vteacherName\x12(\n" +
-	"\x0fpriceForStudent\x18\r \x01(\x01R\x0fpriceForStudent\"0\n" +
+	"\x0fpriceForStudent\x18\r \x01(\tR\x0fpriceForStudent\"0\n" +
 	"\x10StudentIdRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\"|\n" +
 	"\x1aGetGroupsByStudentResponse\x121\n" +
@@ -1341,7 +1540,9 @@ const file_education_proto_rawDesc = "" +
 	" \x01(\tR\tstartDate\x12\x18\n" +
 	"\aendDate\x18\v \x01(\tR\aendDate\x12\x12\n" +
 	"\x04days\x18\f \x03(\tR\x04days\x12\x1a\n" +
-	"\bdateType\x18\r \x01(\tR\bdateType2\x84\x04\n" +
+	"\bdateType\x18\r \x01(\tR\bdateType2f\n" +
+	"\x0eCompanyService\x12T\n" +
+	"\x0fGetSubscription\x12!.education.GetSubscriptionRequest\x1a\x1e.education.CompanySubscription2\x84\x04\n" +
 	"\x0eStudentService\x12W\n" +
 	"\x0eGetStudentById\x12\".education.NoteStudentByAbsRequest\x1a!.education.GetStudentByIdResponse\x12g\n" +
 	"\x14GetStudentsByGroupId\x12&.education.GetStudentsByGroupIdRequest\x1a'.education.GetStudentsByGroupIdResponse\x12[\n" +
@@ -1365,51 +1566,55 @@ func file_education_proto_rawDescGZIP() []byte {
 	return file_education_proto_rawDescData
 }
 
-var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_education_proto_goTypes = []any{
 	(*AbsRoom)(nil),                                // 0: education.AbsRoom
 	(*AbsCourse)(nil),                              // 1: education.AbsCourse
-	(*CalculateDiscountSummaRequest)(nil),          // 2: education.CalculateDiscountSummaRequest
-	(*CalculateDiscountResponse)(nil),              // 3: education.CalculateDiscountResponse
-	(*ChangeUserBalanceHistoryByDebitRequest)(nil), // 4: education.ChangeUserBalanceHistoryByDebitRequest
-	(*ChangeUserBalanceHistoryRequest)(nil),        // 5: education.ChangeUserBalanceHistoryRequest
-	(*GetStudentsByGroupIdResponse)(nil),           // 6: education.GetStudentsByGroupIdResponse
-	(*GetStudentsByGroupIdRequest)(nil),            // 7: education.GetStudentsByGroupIdRequest
-	(*AbsStudent)(nil),                             // 8: education.AbsStudent
-	(*GetStudentByIdResponse)(nil),                 // 9: education.GetStudentByIdResponse
-	(*NoteStudentByAbsRequest)(nil),                // 10: education.NoteStudentByAbsRequest
-	(*GetGroupStudent)(nil),                        // 11: education.GetGroupStudent
-	(*StudentIdRequest)(nil),                       // 12: education.StudentIdRequest
-	(*GetGroupsByStudentResponse)(nil),             // 13: education.GetGroupsByStudentResponse
-	(*GetGroupByIdRequest)(nil),                    // 14: education.GetGroupByIdRequest
-	(*GetGroupAbsResponse)(nil),                    // 15: education.GetGroupAbsResponse
-	(*DebtorComment)(nil),                          // 16: common.DebtorComment
-	(*DebtorGroup)(nil),                            // 17: common.DebtorGroup
-	(*AbsResponse)(nil),                            // 18: common.AbsResponse
+	(*GetSubscriptionRequest)(nil),                 // 2: education.GetSubscriptionRequest
+	(*CompanySubscription)(nil),                    // 3: education.CompanySubscription
+	(*CalculateDiscountSummaRequest)(nil),          // 4: education.CalculateDiscountSummaRequest
+	(*CalculateDiscountResponse)(nil),              // 5: education.CalculateDiscountResponse
+	(*ChangeUserBalanceHistoryByDebitRequest)(nil), // 6: education.ChangeUserBalanceHistoryByDebitRequest
+	(*ChangeUserBalanceHistoryRequest)(nil),        // 7: education.ChangeUserBalanceHistoryRequest
+	(*GetStudentsByGroupIdResponse)(nil),           // 8: education.GetStudentsByGroupIdResponse
+	(*GetStudentsByGroupIdRequest)(nil),            // 9: education.GetStudentsByGroupIdRequest
+	(*AbsStudent)(nil),                             // 10: education.AbsStudent
+	(*GetStudentByIdResponse)(nil),                 // 11: education.GetStudentByIdResponse
+	(*NoteStudentByAbsRequest)(nil),                // 12: education.NoteStudentByAbsRequest
+	(*GetGroupStudent)(nil),                        // 13: education.GetGroupStudent
+	(*StudentIdRequest)(nil),                       // 14: education.StudentIdRequest
+	(*GetGroupsByStudentResponse)(nil),             // 15: education.GetGroupsByStudentResponse
+	(*GetGroupByIdRequest)(nil),                    // 16: education.GetGroupByIdRequest
+	(*GetGroupAbsResponse)(nil),                    // 17: education.GetGroupAbsResponse
+	(*DebtorComment)(nil),                          // 18: common.DebtorComment
+	(*DebtorGroup)(nil),                            // 19: common.DebtorGroup
+	(*AbsResponse)(nil),                            // 20: common.AbsResponse
 }
 var file_education_proto_depIdxs = []int32{
-	8,  // 0: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
-	11, // 1: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
+	10, // 0: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
+	13, // 1: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
 	0,  // 2: education.GetGroupStudent.room:type_name -> education.AbsRoom
 	1,  // 3: education.GetGroupStudent.course:type_name -> education.AbsCourse
-	16, // 4: education.GetGroupsByStudentResponse.comments:type_name -> common.DebtorComment
-	17, // 5: education.GetGroupsByStudentResponse.groups:type_name -> common.DebtorGroup
-	10, // 6: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
-	7,  // 7: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
-	5,  // 8: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
-	4,  // 9: education.StudentService.ChangeUserBalanceHistoryByDebit:input_type -> education.ChangeUserBalanceHistoryByDebitRequest
-	2,  // 10: education.StudentService.CalculateDiscountSumma:input_type -> education.CalculateDiscountSummaRequest
-	14, // 11: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
-	12, // 12: education.GroupService.GetGroupsByStudentId:input_type -> education.StudentIdRequest
-	9,  // 13: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
-	6,  // 14: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
-	18, // 15: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
-	18, // 16: education.StudentService.ChangeUserBalanceHistoryByDebit:output_type -> common.AbsResponse
-	3,  // 17: education.StudentService.CalculateDiscountSumma:output_type -> education.CalculateDiscountResponse
-	15, // 18: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
-	13, // 19: education.GroupService.GetGroupsByStudentId:output_type -> education.GetGroupsByStudentResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
+	18, // 4: education.GetGroupsByStudentResponse.comments:type_name -> common.DebtorComment
+	19, // 5: education.GetGroupsByStudentResponse.groups:type_name -> common.DebtorGroup
+	2,  // 6: education.CompanyService.GetSubscription:input_type -> education.GetSubscriptionRequest
+	12, // 7: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
+	9,  // 8: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
+	7,  // 9: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
+	6,  // 10: education.StudentService.ChangeUserBalanceHistoryByDebit:input_type -> education.ChangeUserBalanceHistoryByDebitRequest
+	4,  // 11: education.StudentService.CalculateDiscountSumma:input_type -> education.CalculateDiscountSummaRequest
+	16, // 12: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
+	14, // 13: education.GroupService.GetGroupsByStudentId:input_type -> education.StudentIdRequest
+	3,  // 14: education.CompanyService.GetSubscription:output_type -> education.CompanySubscription
+	11, // 15: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
+	8,  // 16: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
+	20, // 17: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
+	20, // 18: education.StudentService.ChangeUserBalanceHistoryByDebit:output_type -> common.AbsResponse
+	5,  // 19: education.StudentService.CalculateDiscountSumma:output_type -> education.CalculateDiscountResponse
+	17, // 20: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
+	15, // 21: education.GroupService.GetGroupsByStudentId:output_type -> education.GetGroupsByStudentResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
 	6,  // [6:6] is the sub-list for extension type_name
 	6,  // [6:6] is the sub-list for extension extendee
 	0,  // [0:6] is the sub-list for field type_name
@@ -1427,9 +1632,9 @@ func file_education_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   18,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_education_proto_goTypes,
 		DependencyIndexes: file_education_proto_depIdxs,
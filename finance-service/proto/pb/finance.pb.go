@@ -23,10 +23,14 @@ const (
 )
 
 type GetDiscountByStudentIdResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Amount        string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	IsHave        bool                   `protobuf:"varint,2,opt,name=isHave,proto3" json:"isHave,omitempty"`
-	DiscountOwner string                 `protobuf:"bytes,3,opt,name=discountOwner,proto3" json:"discountOwner,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// taken off the monthly price
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	IsHave bool   `protobuf:"varint,2,opt,name=isHave,proto3" json:"isHave,omitempty"`
+	// TEACHER when part of the amount comes off the teacher's share
+	DiscountOwner string             `protobuf:"bytes,3,opt,name=discountOwner,proto3" json:"discountOwner,omitempty"`
+	TeacherAmount string             `protobuf:"bytes,4,opt,name=teacherAmount,proto3" json:"teacherAmount,omitempty"`
+	Applied       []*AppliedDiscount `protobuf:"bytes,5,rep,name=applied,proto3" json:"applied,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,10 +86,34 @@ func (x *GetDiscountByStudentIdResponse) GetDiscountOwner() string {
 	return ""
 }
 
+func (x *GetDiscountByStudentIdResponse) GetTeacherAmount() string {
+	if x != nil {
+		return x.TeacherAmount
+	}
+	return ""
+}
+
+func (x *GetDiscountByStudentIdResponse) GetApplied() []*AppliedDiscount {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
 type GetDiscountByStudentIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// the day priced, today when empty
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// monthly price of the group, percent discounts are taken of it
+	CoursePrice string `protobuf:"bytes,4,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
+	// what the company's rules look at
+	Siblings     int32 `protobuf:"varint,5,opt,name=siblings,proto3" json:"siblings,omitempty"`
+	ActiveGroups int32 `protobuf:"varint,6,opt,name=activeGroups,proto3" json:"activeGroups,omitempty"`
+	Prepaid      bool  `protobuf:"varint,7,opt,name=prepaid,proto3" json:"prepaid,omitempty"`
+	// set by the monthly charge, records the rules applied
+	Apply         bool `protobuf:"varint,8,opt,name=apply,proto3" json:"apply,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,27 +162,76 @@ func (x *GetDiscountByStudentIdRequest) GetGroupId() string {
 	return ""
 }
 
-type GetHistoryDiscountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+func (x *GetDiscountByStudentIdRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDiscountByStudentIdRequest) GetCoursePrice() string {
+	if x != nil {
+		return x.CoursePrice
+	}
+	return ""
+}
+
+func (x *GetDiscountByStudentIdRequest) GetSiblings() int32 {
+	if x != nil {
+		return x.Siblings
+	}
+	return 0
+}
+
+func (x *GetDiscountByStudentIdRequest) GetActiveGroups() int32 {
+	if x != nil {
+		return x.ActiveGroups
+	}
+	return 0
+}
+
+func (x *GetDiscountByStudentIdRequest) GetPrepaid() bool {
+	if x != nil {
+		return x.Prepaid
+	}
+	return false
+}
+
+func (x *GetDiscountByStudentIdRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+type AppliedDiscount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DISCOUNT for a student's own discount, otherwise the rule type
+	Source        string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	DiscountType  string `protobuf:"bytes,4,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	Value         string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Amount        string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	WithTeacher   bool   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryDiscountRequest) Reset() {
-	*x = GetHistoryDiscountRequest{}
+func (x *AppliedDiscount) Reset() {
+	*x = AppliedDiscount{}
 	mi := &file_finance_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryDiscountRequest) String() string {
+func (x *AppliedDiscount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryDiscountRequest) ProtoMessage() {}
+func (*AppliedDiscount) ProtoMessage() {}
 
-func (x *GetHistoryDiscountRequest) ProtoReflect() protoreflect.Message {
+func (x *AppliedDiscount) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -166,94 +243,95 @@ func (x *GetHistoryDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryDiscountRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoryDiscountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppliedDiscount.ProtoReflect.Descriptor instead.
+func (*AppliedDiscount) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetHistoryDiscountRequest) GetStudentId() string {
+func (x *AppliedDiscount) GetSource() string {
 	if x != nil {
-		return x.StudentId
+		return x.Source
 	}
 	return ""
 }
 
-type GetHistoryDiscountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Discounts     []*AbsHistoryDiscount  `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AppliedDiscount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *GetHistoryDiscountResponse) Reset() {
-	*x = GetHistoryDiscountResponse{}
-	mi := &file_finance_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AppliedDiscount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetHistoryDiscountResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AppliedDiscount) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
 }
 
-func (*GetHistoryDiscountResponse) ProtoMessage() {}
-
-func (x *GetHistoryDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[3]
+func (x *AppliedDiscount) GetValue() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Value
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetHistoryDiscountResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoryDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{3}
+func (x *AppliedDiscount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
 }
 
-func (x *GetHistoryDiscountResponse) GetDiscounts() []*AbsHistoryDiscount {
+func (x *AppliedDiscount) GetWithTeacher() bool {
 	if x != nil {
-		return x.Discounts
+		return x.WithTeacher
 	}
-	return nil
+	return false
 }
 
-type AbsHistoryDiscount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	GroupName     string                 `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	StudentName   string                 `protobuf:"bytes,11,opt,name=studentName,proto3" json:"studentName,omitempty"`
-	DiscountPrice string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	StartDate     string                 `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate       string                 `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	WithTeacher   bool                   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
-	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+// DiscountRule is a discount every student of the company gets who matches
+// it: SIBLING when minCount students of a family study, MULTI_GROUP when the
+// student is in minCount groups, EARLY_PAYMENT when the balance covered the
+// month before it was charged.
+type DiscountRule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RuleType string                 `protobuf:"bytes,3,opt,name=ruleType,proto3" json:"ruleType,omitempty"`
+	// FIXED or PERCENT
+	DiscountType  string `protobuf:"bytes,4,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	Value         string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	MinCount      int32  `protobuf:"varint,6,opt,name=minCount,proto3" json:"minCount,omitempty"`
+	WithTeacher   bool   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
+	IsActive      bool   `protobuf:"varint,8,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsHistoryDiscount) Reset() {
-	*x = AbsHistoryDiscount{}
-	mi := &file_finance_proto_msgTypes[4]
+func (x *DiscountRule) Reset() {
+	*x = DiscountRule{}
+	mi := &file_finance_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsHistoryDiscount) String() string {
+func (x *DiscountRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsHistoryDiscount) ProtoMessage() {}
+func (*DiscountRule) ProtoMessage() {}
 
-func (x *AbsHistoryDiscount) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[4]
+func (x *DiscountRule) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,116 +342,96 @@ func (x *AbsHistoryDiscount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsHistoryDiscount.ProtoReflect.Descriptor instead.
-func (*AbsHistoryDiscount) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *AbsHistoryDiscount) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *AbsHistoryDiscount) GetGroupName() string {
-	if x != nil {
-		return x.GroupName
-	}
-	return ""
+// Deprecated: Use DiscountRule.ProtoReflect.Descriptor instead.
+func (*DiscountRule) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *AbsHistoryDiscount) GetStudentId() string {
+func (x *DiscountRule) GetId() string {
 	if x != nil {
-		return x.StudentId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetStudentName() string {
+func (x *DiscountRule) GetName() string {
 	if x != nil {
-		return x.StudentName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetDiscountPrice() string {
+func (x *DiscountRule) GetRuleType() string {
 	if x != nil {
-		return x.DiscountPrice
+		return x.RuleType
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetComment() string {
+func (x *DiscountRule) GetDiscountType() string {
 	if x != nil {
-		return x.Comment
+		return x.DiscountType
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetStartDate() string {
+func (x *DiscountRule) GetValue() string {
 	if x != nil {
-		return x.StartDate
+		return x.Value
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetEndDate() string {
+func (x *DiscountRule) GetMinCount() int32 {
 	if x != nil {
-		return x.EndDate
+		return x.MinCount
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsHistoryDiscount) GetWithTeacher() bool {
+func (x *DiscountRule) GetWithTeacher() bool {
 	if x != nil {
 		return x.WithTeacher
 	}
 	return false
 }
 
-func (x *AbsHistoryDiscount) GetAction() string {
+func (x *DiscountRule) GetIsActive() bool {
 	if x != nil {
-		return x.Action
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *AbsHistoryDiscount) GetCreatedAt() string {
+func (x *DiscountRule) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-type AbsDiscountRequest struct {
+type DiscountRuleList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	DiscountPrice string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	StartDate     string                 `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate       string                 `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	WithTeacher   bool                   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
+	Items         []*DiscountRule        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsDiscountRequest) Reset() {
-	*x = AbsDiscountRequest{}
-	mi := &file_finance_proto_msgTypes[5]
+func (x *DiscountRuleList) Reset() {
+	*x = DiscountRuleList{}
+	mi := &file_finance_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsDiscountRequest) String() string {
+func (x *DiscountRuleList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsDiscountRequest) ProtoMessage() {}
+func (*DiscountRuleList) ProtoMessage() {}
 
-func (x *AbsDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[5]
+func (x *DiscountRuleList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,81 +442,98 @@ func (x *AbsDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsDiscountRequest.ProtoReflect.Descriptor instead.
-func (*AbsDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DiscountRuleList.ProtoReflect.Descriptor instead.
+func (*DiscountRuleList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AbsDiscountRequest) GetGroupId() string {
+func (x *DiscountRuleList) GetItems() []*DiscountRule {
 	if x != nil {
-		return x.GroupId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *AbsDiscountRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
+// DiscountPolicy tells how the discounts of a student combine: STACK adds
+// them up to maxPercent of the price, BEST only gives the largest.
+type DiscountPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stacking      string                 `protobuf:"bytes,1,opt,name=stacking,proto3" json:"stacking,omitempty"`
+	MaxPercent    float64                `protobuf:"fixed64,2,opt,name=maxPercent,proto3" json:"maxPercent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsDiscountRequest) GetDiscountPrice() string {
-	if x != nil {
-		return x.DiscountPrice
-	}
-	return ""
+func (x *DiscountPolicy) Reset() {
+	*x = DiscountPolicy{}
+	mi := &file_finance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsDiscountRequest) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
+func (x *DiscountPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AbsDiscountRequest) GetStartDate() string {
+func (*DiscountPolicy) ProtoMessage() {}
+
+func (x *DiscountPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[5]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AbsDiscountRequest) GetEndDate() string {
+// Deprecated: Use DiscountPolicy.ProtoReflect.Descriptor instead.
+func (*DiscountPolicy) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DiscountPolicy) GetStacking() string {
 	if x != nil {
-		return x.EndDate
+		return x.Stacking
 	}
 	return ""
 }
 
-func (x *AbsDiscountRequest) GetWithTeacher() bool {
+func (x *DiscountPolicy) GetMaxPercent() float64 {
 	if x != nil {
-		return x.WithTeacher
+		return x.MaxPercent
 	}
-	return false
+	return 0
 }
 
-type GetInformationDiscountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+type GetRuleApplicationsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RuleId    string                 `protobuf:"bytes,1,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+	StudentId string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// YYYY-MM
+	Month         string `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"`
+	Page          int32  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInformationDiscountRequest) Reset() {
-	*x = GetInformationDiscountRequest{}
+func (x *GetRuleApplicationsRequest) Reset() {
+	*x = GetRuleApplicationsRequest{}
 	mi := &file_finance_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInformationDiscountRequest) String() string {
+func (x *GetRuleApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInformationDiscountRequest) ProtoMessage() {}
+func (*GetRuleApplicationsRequest) ProtoMessage() {}
 
-func (x *GetInformationDiscountRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRuleApplicationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,40 +545,4674 @@ func (x *GetInformationDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInformationDiscountRequest.ProtoReflect.Descriptor instead.
-func (*GetInformationDiscountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRuleApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRuleApplicationsRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetInformationDiscountRequest) GetGroupId() string {
+func (x *GetRuleApplicationsRequest) GetRuleId() string {
 	if x != nil {
-		return x.GroupId
+		return x.RuleId
 	}
 	return ""
 }
 
-type GetInformationDiscountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Discounts     []*AbsStudentDiscount  `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetRuleApplicationsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
 }
 
-func (x *GetInformationDiscountResponse) Reset() {
-	*x = GetInformationDiscountResponse{}
+func (x *GetRuleApplicationsRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GetRuleApplicationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRuleApplicationsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type RuleApplication struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	RuleType      string                 `protobuf:"bytes,4,opt,name=ruleType,proto3" json:"ruleType,omitempty"`
+	StudentId     string                 `protobuf:"bytes,5,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,6,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Month         string                 `protobuf:"bytes,7,opt,name=month,proto3" json:"month,omitempty"`
+	Amount        string                 `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleApplication) Reset() {
+	*x = RuleApplication{}
+	mi := &file_finance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleApplication) ProtoMessage() {}
+
+func (x *RuleApplication) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleApplication.ProtoReflect.Descriptor instead.
+func (*RuleApplication) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RuleApplication) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RuleApplication) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type RuleApplicationList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*RuleApplication     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleApplicationList) Reset() {
+	*x = RuleApplicationList{}
+	mi := &file_finance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleApplicationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleApplicationList) ProtoMessage() {}
+
+func (x *RuleApplicationList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleApplicationList.ProtoReflect.Descriptor instead.
+func (*RuleApplicationList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RuleApplicationList) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RuleApplicationList) GetItems() []*RuleApplication {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetHistoryDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryDiscountRequest) Reset() {
+	*x = GetHistoryDiscountRequest{}
+	mi := &file_finance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryDiscountRequest) ProtoMessage() {}
+
+func (x *GetHistoryDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryDiscountRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetHistoryDiscountRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type GetHistoryDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discounts     []*AbsHistoryDiscount  `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryDiscountResponse) Reset() {
+	*x = GetHistoryDiscountResponse{}
+	mi := &file_finance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryDiscountResponse) ProtoMessage() {}
+
+func (x *GetHistoryDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryDiscountResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetHistoryDiscountResponse) GetDiscounts() []*AbsHistoryDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type AbsHistoryDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName     string                 `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName   string                 `protobuf:"bytes,11,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	DiscountPrice string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	StartDate     string                 `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate       string                 `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	WithTeacher   bool                   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
+	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,12,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	DiscountId    string                 `protobuf:"bytes,13,opt,name=discountId,proto3" json:"discountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsHistoryDiscount) Reset() {
+	*x = AbsHistoryDiscount{}
+	mi := &file_finance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsHistoryDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsHistoryDiscount) ProtoMessage() {}
+
+func (x *AbsHistoryDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsHistoryDiscount.ProtoReflect.Descriptor instead.
+func (*AbsHistoryDiscount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AbsHistoryDiscount) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetDiscountPrice() string {
+	if x != nil {
+		return x.DiscountPrice
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetWithTeacher() bool {
+	if x != nil {
+		return x.WithTeacher
+	}
+	return false
+}
+
+func (x *AbsHistoryDiscount) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+type AbsDiscountRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	StudentId string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// the amount, or the percent for PERCENT discounts
+	DiscountPrice string `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice,omitempty"`
+	Comment       string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	StartDate     string `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate       string `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	WithTeacher   bool   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
+	// deletes just this discount, otherwise all of the student in the group
+	Id string `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	// FIXED (default) or PERCENT
+	DiscountType  string `protobuf:"bytes,9,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsDiscountRequest) Reset() {
+	*x = AbsDiscountRequest{}
+	mi := &file_finance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsDiscountRequest) ProtoMessage() {}
+
+func (x *AbsDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsDiscountRequest.ProtoReflect.Descriptor instead.
+func (*AbsDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AbsDiscountRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetDiscountPrice() string {
+	if x != nil {
+		return x.DiscountPrice
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetWithTeacher() bool {
+	if x != nil {
+		return x.WithTeacher
+	}
+	return false
+}
+
+func (x *AbsDiscountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+type GetInformationDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInformationDiscountRequest) Reset() {
+	*x = GetInformationDiscountRequest{}
+	mi := &file_finance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInformationDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInformationDiscountRequest) ProtoMessage() {}
+
+func (x *GetInformationDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInformationDiscountRequest.ProtoReflect.Descriptor instead.
+func (*GetInformationDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetInformationDiscountRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetInformationDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discounts     []*AbsStudentDiscount  `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInformationDiscountResponse) Reset() {
+	*x = GetInformationDiscountResponse{}
+	mi := &file_finance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInformationDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInformationDiscountResponse) ProtoMessage() {}
+
+func (x *GetInformationDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInformationDiscountResponse.ProtoReflect.Descriptor instead.
+func (*GetInformationDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetInformationDiscountResponse) GetDiscounts() []*AbsStudentDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type AbsStudentDiscount struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StudentId          string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName        string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	StudentPhoneNumber string                 `protobuf:"bytes,3,opt,name=studentPhoneNumber,proto3" json:"studentPhoneNumber,omitempty"`
+	Discount           string                 `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	Cause              string                 `protobuf:"bytes,5,opt,name=cause,proto3" json:"cause,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	StartAt            string                 `protobuf:"bytes,7,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt              string                 `protobuf:"bytes,8,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	WithTeacher        bool                   `protobuf:"varint,9,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
+	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	DiscountType       string                 `protobuf:"bytes,11,opt,name=discountType,proto3" json:"discountType,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AbsStudentDiscount) Reset() {
+	*x = AbsStudentDiscount{}
+	mi := &file_finance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudentDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudentDiscount) ProtoMessage() {}
+
+func (x *AbsStudentDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudentDiscount.ProtoReflect.Descriptor instead.
+func (*AbsStudentDiscount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AbsStudentDiscount) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetStudentPhoneNumber() string {
+	if x != nil {
+		return x.StudentPhoneNumber
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetDiscount() string {
+	if x != nil {
+		return x.Discount
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetWithTeacher() bool {
+	if x != nil {
+		return x.WithTeacher
+	}
+	return false
+}
+
+func (x *AbsStudentDiscount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Desc          string                 `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_finance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type GetAllCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*AbsCategory         `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllCategoryRequest) Reset() {
+	*x = GetAllCategoryRequest{}
+	mi := &file_finance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCategoryRequest) ProtoMessage() {}
+
+func (x *GetAllCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAllCategoryRequest) GetCategories() []*AbsCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type AbsCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Desc          string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsCategory) Reset() {
+	*x = AbsCategory{}
+	mi := &file_finance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsCategory) ProtoMessage() {}
+
+func (x *AbsCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsCategory.ProtoReflect.Descriptor instead.
+func (*AbsCategory) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AbsCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsCategory) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type GetAllExpenseDiagramResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserOrCategories       []string               `protobuf:"bytes,1,rep,name=userOrCategories,proto3" json:"userOrCategories,omitempty"`
+	UserOrCategoriesAmount []string               `protobuf:"bytes,2,rep,name=userOrCategoriesAmount,proto3" json:"userOrCategoriesAmount,omitempty"`
+	MonthAmount            []string               `protobuf:"bytes,3,rep,name=monthAmount,proto3" json:"monthAmount,omitempty"`
+	Months                 []string               `protobuf:"bytes,4,rep,name=months,proto3" json:"months,omitempty"`
+	AmountCommonExpense    string                 `protobuf:"bytes,5,opt,name=amountCommonExpense,proto3" json:"amountCommonExpense,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseDiagramResponse) Reset() {
+	*x = GetAllExpenseDiagramResponse{}
+	mi := &file_finance_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseDiagramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseDiagramResponse) ProtoMessage() {}
+
+func (x *GetAllExpenseDiagramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseDiagramResponse.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseDiagramResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAllExpenseDiagramResponse) GetUserOrCategories() []string {
+	if x != nil {
+		return x.UserOrCategories
+	}
+	return nil
+}
+
+func (x *GetAllExpenseDiagramResponse) GetUserOrCategoriesAmount() []string {
+	if x != nil {
+		return x.UserOrCategoriesAmount
+	}
+	return nil
+}
+
+func (x *GetAllExpenseDiagramResponse) GetMonthAmount() []string {
+	if x != nil {
+		return x.MonthAmount
+	}
+	return nil
+}
+
+func (x *GetAllExpenseDiagramResponse) GetMonths() []string {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *GetAllExpenseDiagramResponse) GetAmountCommonExpense() string {
+	if x != nil {
+		return x.AmountCommonExpense
+	}
+	return ""
+}
+
+type GetAllExpenseDiagramRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseDiagramRequest) Reset() {
+	*x = GetAllExpenseDiagramRequest{}
+	mi := &file_finance_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseDiagramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseDiagramRequest) ProtoMessage() {}
+
+func (x *GetAllExpenseDiagramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseDiagramRequest.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseDiagramRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAllExpenseDiagramRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllExpenseDiagramRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetAllExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	PageReq       *PageRequest           `protobuf:"bytes,4,opt,name=pageReq,proto3" json:"pageReq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseRequest) Reset() {
+	*x = GetAllExpenseRequest{}
+	mi := &file_finance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseRequest) ProtoMessage() {}
+
+func (x *GetAllExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseRequest.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAllExpenseRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllExpenseRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetAllExpenseRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetAllExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAllExpenseRequest) GetPageReq() *PageRequest {
+	if x != nil {
+		return x.PageReq
+	}
+	return nil
+}
+
+type GetAllExpenseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalPageCount int32                  `protobuf:"varint,1,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
+	Expenses       []*GetAllExpenseAbs    `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseResponse) Reset() {
+	*x = GetAllExpenseResponse{}
+	mi := &file_finance_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseResponse) ProtoMessage() {}
+
+func (x *GetAllExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseResponse.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAllExpenseResponse) GetTotalPageCount() int32 {
+	if x != nil {
+		return x.TotalPageCount
+	}
+	return 0
+}
+
+func (x *GetAllExpenseResponse) GetExpenses() []*GetAllExpenseAbs {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type GetAllExpenseAbs struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GivenDate   string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	Category    *AbsCategory           `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	User        *GetUserByIdResponse   `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	ExpenseType string                 `protobuf:"bytes,5,opt,name=expenseType,proto3" json:"expenseType,omitempty"`
+	Sum         string                 `protobuf:"bytes,6,opt,name=sum,proto3" json:"sum,omitempty"`
+	Creator     *GetUserByIdResponse   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	PaymentType string                 `protobuf:"bytes,8,opt,name=paymentType,proto3" json:"paymentType,omitempty"`
+	CreatedAt   string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Title       string                 `protobuf:"bytes,10,opt,name=title,proto3" json:"title,omitempty"`
+	AccountId   string                 `protobuf:"bytes,11,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	AccountName string                 `protobuf:"bytes,12,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Currency    string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
+	// in the account's currency, sum is in UZS
+	OriginalSum   string  `protobuf:"bytes,14,opt,name=originalSum,proto3" json:"originalSum,omitempty"`
+	ExchangeRate  float64 `protobuf:"fixed64,15,opt,name=exchangeRate,proto3" json:"exchangeRate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseAbs) Reset() {
+	*x = GetAllExpenseAbs{}
+	mi := &file_finance_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseAbs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseAbs) ProtoMessage() {}
+
+func (x *GetAllExpenseAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseAbs.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseAbs) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAllExpenseAbs) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetCategory() *AbsCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *GetAllExpenseAbs) GetUser() *GetUserByIdResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetAllExpenseAbs) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetCreator() *GetUserByIdResponse {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *GetAllExpenseAbs) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetOriginalSum() string {
+	if x != nil {
+		return x.OriginalSum
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+type CreateExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	ExpenseType   string                 `protobuf:"bytes,3,opt,name=expenseType,proto3" json:"expenseType,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,4,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
+	Sum           string                 `protobuf:"bytes,6,opt,name=sum,proto3" json:"sum,omitempty"`
+	CreatedById   string                 `protobuf:"bytes,7,opt,name=createdById,proto3" json:"createdById,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,8,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
+	// cash account the money is paid from, the company's default one for
+	// paymentMethod when empty; sum is in the account's currency
+	AccountId     string `protobuf:"bytes,9,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpenseRequest) Reset() {
+	*x = CreateExpenseRequest{}
+	mi := &file_finance_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseRequest) ProtoMessage() {}
+
+func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateExpenseRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type GetIncomeChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncomeChartRequest) Reset() {
+	*x = GetIncomeChartRequest{}
+	mi := &file_finance_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncomeChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomeChartRequest) ProtoMessage() {}
+
+func (x *GetIncomeChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomeChartRequest.ProtoReflect.Descriptor instead.
+func (*GetIncomeChartRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetIncomeChartRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetIncomeChartRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetIncomeChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      []*AbsIncomeChart      `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncomeChartResponse) Reset() {
+	*x = GetIncomeChartResponse{}
+	mi := &file_finance_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncomeChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomeChartResponse) ProtoMessage() {}
+
+func (x *GetIncomeChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomeChartResponse.ProtoReflect.Descriptor instead.
+func (*GetIncomeChartResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetIncomeChartResponse) GetResponse() []*AbsIncomeChart {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type AbsIncomeChart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpecificMonth string                 `protobuf:"bytes,1,opt,name=specificMonth,proto3" json:"specificMonth,omitempty"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Gross         string                 `protobuf:"bytes,3,opt,name=gross,proto3" json:"gross,omitempty"`
+	Reversed      string                 `protobuf:"bytes,4,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsIncomeChart) Reset() {
+	*x = AbsIncomeChart{}
+	mi := &file_finance_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsIncomeChart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsIncomeChart) ProtoMessage() {}
+
+func (x *AbsIncomeChart) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsIncomeChart.ProtoReflect.Descriptor instead.
+func (*AbsIncomeChart) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AbsIncomeChart) GetSpecificMonth() string {
+	if x != nil {
+		return x.SpecificMonth
+	}
+	return ""
+}
+
+func (x *AbsIncomeChart) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AbsIncomeChart) GetGross() string {
+	if x != nil {
+		return x.Gross
+	}
+	return ""
+}
+
+func (x *AbsIncomeChart) GetReversed() string {
+	if x != nil {
+		return x.Reversed
+	}
+	return ""
+}
+
+type GetCommonInformationResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DebtorsCount      int32                  `protobuf:"varint,1,opt,name=debtorsCount,proto3" json:"debtorsCount,omitempty"`
+	PayInCurrentMonth int32                  `protobuf:"varint,2,opt,name=payInCurrentMonth,proto3" json:"payInCurrentMonth,omitempty"`
+	// from the ledger, as on the profit and loss report of this month
+	IncomeInCurrentMonth  string `protobuf:"bytes,3,opt,name=incomeInCurrentMonth,proto3" json:"incomeInCurrentMonth,omitempty"`
+	ExpenseInCurrentMonth string `protobuf:"bytes,4,opt,name=expenseInCurrentMonth,proto3" json:"expenseInCurrentMonth,omitempty"`
+	ProfitInCurrentMonth  string `protobuf:"bytes,5,opt,name=profitInCurrentMonth,proto3" json:"profitInCurrentMonth,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetCommonInformationResponse) Reset() {
+	*x = GetCommonInformationResponse{}
+	mi := &file_finance_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommonInformationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommonInformationResponse) ProtoMessage() {}
+
+func (x *GetCommonInformationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommonInformationResponse.ProtoReflect.Descriptor instead.
+func (*GetCommonInformationResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCommonInformationResponse) GetDebtorsCount() int32 {
+	if x != nil {
+		return x.DebtorsCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationResponse) GetPayInCurrentMonth() int32 {
+	if x != nil {
+		return x.PayInCurrentMonth
+	}
+	return 0
+}
+
+func (x *GetCommonInformationResponse) GetIncomeInCurrentMonth() string {
+	if x != nil {
+		return x.IncomeInCurrentMonth
+	}
+	return ""
+}
+
+func (x *GetCommonInformationResponse) GetExpenseInCurrentMonth() string {
+	if x != nil {
+		return x.ExpenseInCurrentMonth
+	}
+	return ""
+}
+
+func (x *GetCommonInformationResponse) GetProfitInCurrentMonth() string {
+	if x != nil {
+		return x.ProfitInCurrentMonth
+	}
+	return ""
+}
+
+type GetAllDebtsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageParam     *PageRequest           `protobuf:"bytes,1,opt,name=pageParam,proto3" json:"pageParam,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	AmountFrom    int64                  `protobuf:"varint,4,opt,name=amountFrom,proto3" json:"amountFrom,omitempty"`
+	AmountTo      int64                  `protobuf:"varint,5,opt,name=amountTo,proto3" json:"amountTo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllDebtsRequest) Reset() {
+	*x = GetAllDebtsRequest{}
+	mi := &file_finance_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllDebtsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllDebtsRequest) ProtoMessage() {}
+
+func (x *GetAllDebtsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllDebtsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllDebtsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAllDebtsRequest) GetPageParam() *PageRequest {
+	if x != nil {
+		return x.PageParam
+	}
+	return nil
+}
+
+func (x *GetAllDebtsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllDebtsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetAllDebtsRequest) GetAmountFrom() int64 {
+	if x != nil {
+		return x.AmountFrom
+	}
+	return 0
+}
+
+func (x *GetAllDebtsRequest) GetAmountTo() int64 {
+	if x != nil {
+		return x.AmountTo
+	}
+	return 0
+}
+
+type GetAllDebtsInformationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
+	// owed by the students' families
+	Debts []*AbsDebtsInformation `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts,omitempty"`
+	// owed by sponsors, all of them on every page
+	SponsorDebts  []*AbsDebtsInformation `protobuf:"bytes,3,rep,name=sponsorDebts,proto3" json:"sponsorDebts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllDebtsInformationResponse) Reset() {
+	*x = GetAllDebtsInformationResponse{}
+	mi := &file_finance_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllDebtsInformationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllDebtsInformationResponse) ProtoMessage() {}
+
+func (x *GetAllDebtsInformationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllDebtsInformationResponse.ProtoReflect.Descriptor instead.
+func (*GetAllDebtsInformationResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAllDebtsInformationResponse) GetTotalPageCount() int32 {
+	if x != nil {
+		return x.TotalPageCount
+	}
+	return 0
+}
+
+func (x *GetAllDebtsInformationResponse) GetDebts() []*AbsDebtsInformation {
+	if x != nil {
+		return x.Debts
+	}
+	return nil
+}
+
+func (x *GetAllDebtsInformationResponse) GetSponsorDebts() []*AbsDebtsInformation {
+	if x != nil {
+		return x.SponsorDebts
+	}
+	return nil
+}
+
+type AbsDebtsInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DebtorId      string                 `protobuf:"bytes,1,opt,name=debtorId,proto3" json:"debtorId,omitempty"`
+	DebtorName    string                 `protobuf:"bytes,2,opt,name=debtorName,proto3" json:"debtorName,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Balance       string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	TotalOnPeriod string                 `protobuf:"bytes,5,opt,name=totalOnPeriod,proto3" json:"totalOnPeriod,omitempty"`
+	Groups        []*DebtorGroup         `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	Comments      []*DebtorComment       `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
+	// FAMILY or SPONSOR
+	DebtType string `protobuf:"bytes,8,opt,name=debtType,proto3" json:"debtType,omitempty"`
+	// installment plans of the student
+	OverdueAmount       string `protobuf:"bytes,9,opt,name=overdueAmount,proto3" json:"overdueAmount,omitempty"`
+	OverdueInstallments int32  `protobuf:"varint,10,opt,name=overdueInstallments,proto3" json:"overdueInstallments,omitempty"`
+	NextDueDate         string `protobuf:"bytes,11,opt,name=nextDueDate,proto3" json:"nextDueDate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AbsDebtsInformation) Reset() {
+	*x = AbsDebtsInformation{}
+	mi := &file_finance_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsDebtsInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsDebtsInformation) ProtoMessage() {}
+
+func (x *AbsDebtsInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsDebtsInformation.ProtoReflect.Descriptor instead.
+func (*AbsDebtsInformation) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AbsDebtsInformation) GetDebtorId() string {
+	if x != nil {
+		return x.DebtorId
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetDebtorName() string {
+	if x != nil {
+		return x.DebtorName
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetTotalOnPeriod() string {
+	if x != nil {
+		return x.TotalOnPeriod
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetGroups() []*DebtorGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AbsDebtsInformation) GetComments() []*DebtorComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *AbsDebtsInformation) GetDebtType() string {
+	if x != nil {
+		return x.DebtType
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetOverdueAmount() string {
+	if x != nil {
+		return x.OverdueAmount
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetOverdueInstallments() int32 {
+	if x != nil {
+		return x.OverdueInstallments
+	}
+	return 0
+}
+
+func (x *AbsDebtsInformation) GetNextDueDate() string {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return ""
+}
+
+type GetAllStudentPaymentsChartResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Cash          string                    `protobuf:"bytes,1,opt,name=cash,proto3" json:"cash,omitempty"`
+	Payme         string                    `protobuf:"bytes,2,opt,name=payme,proto3" json:"payme,omitempty"`
+	Click         string                    `protobuf:"bytes,3,opt,name=click,proto3" json:"click,omitempty"`
+	TotalRevenue  string                    `protobuf:"bytes,4,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`
+	PaymentsChart []*AbsTakeOfChartResponse `protobuf:"bytes,5,rep,name=paymentsChart,proto3" json:"paymentsChart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStudentPaymentsChartResponse) Reset() {
+	*x = GetAllStudentPaymentsChartResponse{}
+	mi := &file_finance_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentPaymentsChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentPaymentsChartResponse) ProtoMessage() {}
+
+func (x *GetAllStudentPaymentsChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentPaymentsChartResponse.ProtoReflect.Descriptor instead.
+func (*GetAllStudentPaymentsChartResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetCash() string {
+	if x != nil {
+		return x.Cash
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetPayme() string {
+	if x != nil {
+		return x.Payme
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetClick() string {
+	if x != nil {
+		return x.Click
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetTotalRevenue() string {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetPaymentsChart() []*AbsTakeOfChartResponse {
+	if x != nil {
+		return x.PaymentsChart
+	}
+	return nil
+}
+
+type GetAllStudentPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,6,opt,name=page,proto3" json:"page,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Filters       []*Filters             `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+	Sorts         []*SortBy              `protobuf:"bytes,5,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStudentPaymentsRequest) Reset() {
+	*x = GetAllStudentPaymentsRequest{}
+	mi := &file_finance_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentPaymentsRequest) ProtoMessage() {}
+
+func (x *GetAllStudentPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllStudentPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAllStudentPaymentsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAllStudentPaymentsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsRequest) GetFilters() []*Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetAllStudentPaymentsRequest) GetSorts() []*SortBy {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+type Filters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filters) Reset() {
+	*x = Filters{}
+	mi := &file_finance_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filters) ProtoMessage() {}
+
+func (x *Filters) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filters.ProtoReflect.Descriptor instead.
+func (*Filters) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Filters) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Filters) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Filters) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SortBy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortBy) Reset() {
+	*x = SortBy{}
+	mi := &file_finance_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortBy) ProtoMessage() {}
+
+func (x *SortBy) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortBy.ProtoReflect.Descriptor instead.
+func (*SortBy) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SortBy) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortBy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GetAllStudentPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*AbsStudentPayments  `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	Gross         string                 `protobuf:"bytes,2,opt,name=gross,proto3" json:"gross,omitempty"`
+	Reversed      string                 `protobuf:"bytes,3,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	Net           string                 `protobuf:"bytes,4,opt,name=net,proto3" json:"net,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStudentPaymentsResponse) Reset() {
+	*x = GetAllStudentPaymentsResponse{}
+	mi := &file_finance_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentPaymentsResponse) ProtoMessage() {}
+
+func (x *GetAllStudentPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllStudentPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetAllStudentPaymentsResponse) GetPayments() []*AbsStudentPayments {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *GetAllStudentPaymentsResponse) GetGross() string {
+	if x != nil {
+		return x.Gross
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsResponse) GetReversed() string {
+	if x != nil {
+		return x.Reversed
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsResponse) GetNet() string {
+	if x != nil {
+		return x.Net
+	}
+	return ""
+}
+
+type AbsStudentPayments struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GivenDate      string                 `protobuf:"bytes,1,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	StudentId      string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName    string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	Amount         string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method         string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Comment        string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatorName    string                 `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName,omitempty"`
+	CreatorId      string                 `protobuf:"bytes,8,opt,name=creatorId,proto3" json:"creatorId,omitempty"`
+	PaymentId      string                 `protobuf:"bytes,9,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	ReversalOf     string                 `protobuf:"bytes,10,opt,name=reversalOf,proto3" json:"reversalOf,omitempty"`
+	IsReversed     bool                   `protobuf:"varint,11,opt,name=isReversed,proto3" json:"isReversed,omitempty"`
+	AccountId      string                 `protobuf:"bytes,12,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Currency       string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
+	OriginalAmount string                 `protobuf:"bytes,14,opt,name=originalAmount,proto3" json:"originalAmount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsStudentPayments) Reset() {
+	*x = AbsStudentPayments{}
+	mi := &file_finance_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudentPayments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudentPayments) ProtoMessage() {}
+
+func (x *AbsStudentPayments) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudentPayments.ProtoReflect.Descriptor instead.
+func (*AbsStudentPayments) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AbsStudentPayments) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetCreatorName() string {
+	if x != nil {
+		return x.CreatorName
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetReversalOf() string {
+	if x != nil {
+		return x.ReversalOf
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetIsReversed() bool {
+	if x != nil {
+		return x.IsReversed
+	}
+	return false
+}
+
+func (x *AbsStudentPayments) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetOriginalAmount() string {
+	if x != nil {
+		return x.OriginalAmount
+	}
+	return ""
+}
+
+type GetAllPaymentTakeOffChartResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ChartResponse []*AbsTakeOfChartResponse `protobuf:"bytes,1,rep,name=chartResponse,proto3" json:"chartResponse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentTakeOffChartResponse) Reset() {
+	*x = GetAllPaymentTakeOffChartResponse{}
+	mi := &file_finance_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentTakeOffChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentTakeOffChartResponse) ProtoMessage() {}
+
+func (x *GetAllPaymentTakeOffChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentTakeOffChartResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentTakeOffChartResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAllPaymentTakeOffChartResponse) GetChartResponse() []*AbsTakeOfChartResponse {
+	if x != nil {
+		return x.ChartResponse
+	}
+	return nil
+}
+
+type AbsTakeOfChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	YearMonth     string                 `protobuf:"bytes,1,opt,name=yearMonth,proto3" json:"yearMonth,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsTakeOfChartResponse) Reset() {
+	*x = AbsTakeOfChartResponse{}
+	mi := &file_finance_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsTakeOfChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsTakeOfChartResponse) ProtoMessage() {}
+
+func (x *AbsTakeOfChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsTakeOfChartResponse.ProtoReflect.Descriptor instead.
+func (*AbsTakeOfChartResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AbsTakeOfChartResponse) GetYearMonth() string {
+	if x != nil {
+		return x.YearMonth
+	}
+	return ""
+}
+
+func (x *AbsTakeOfChartResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetAllPaymentTakeOffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentTakeOffRequest) Reset() {
+	*x = GetAllPaymentTakeOffRequest{}
+	mi := &file_finance_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentTakeOffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentTakeOffRequest) ProtoMessage() {}
+
+func (x *GetAllPaymentTakeOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentTakeOffRequest.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentTakeOffRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetAllPaymentTakeOffRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllPaymentTakeOffRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetAllPaymentTakeOffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pennies       []*AbsPaymentTakeOff   `protobuf:"bytes,1,rep,name=pennies,proto3" json:"pennies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentTakeOffResponse) Reset() {
+	*x = GetAllPaymentTakeOffResponse{}
+	mi := &file_finance_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentTakeOffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentTakeOffResponse) ProtoMessage() {}
+
+func (x *GetAllPaymentTakeOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentTakeOffResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentTakeOffResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetAllPaymentTakeOffResponse) GetPennies() []*AbsPaymentTakeOff {
+	if x != nil {
+		return x.Pennies
+	}
+	return nil
+}
+
+type AbsPaymentTakeOff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	StudentName   string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,6,opt,name=creatorId,proto3" json:"creatorId,omitempty"`
+	CreatorName   string                 `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName,omitempty"`
+	Sum           string                 `protobuf:"bytes,8,opt,name=sum,proto3" json:"sum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsPaymentTakeOff) Reset() {
+	*x = AbsPaymentTakeOff{}
+	mi := &file_finance_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsPaymentTakeOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsPaymentTakeOff) ProtoMessage() {}
+
+func (x *AbsPaymentTakeOff) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsPaymentTakeOff.ProtoReflect.Descriptor instead.
+func (*AbsPaymentTakeOff) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AbsPaymentTakeOff) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetCreatorName() string {
+	if x != nil {
+		return x.CreatorName
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+type GetAllPaymentsByMonthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentsByMonthRequest) Reset() {
+	*x = GetAllPaymentsByMonthRequest{}
+	mi := &file_finance_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentsByMonthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentsByMonthRequest) ProtoMessage() {}
+
+func (x *GetAllPaymentsByMonthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentsByMonthRequest.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentsByMonthRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetAllPaymentsByMonthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAllPaymentsByMonthRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+type GetAllPaymentsByMonthResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Payments      []*AbsGetAllPaymentsByMonthResponse `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentsByMonthResponse) Reset() {
+	*x = GetAllPaymentsByMonthResponse{}
+	mi := &file_finance_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentsByMonthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentsByMonthResponse) ProtoMessage() {}
+
+func (x *GetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentsByMonthResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentsByMonthResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetAllPaymentsByMonthResponse) GetPayments() []*AbsGetAllPaymentsByMonthResponse {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type AbsGetAllPaymentsByMonthResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GivenDate      string                 `protobuf:"bytes,1,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	PaymentType    string                 `protobuf:"bytes,2,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	Amount         string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Comment        string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedById    string                 `protobuf:"bytes,5,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
+	CreatedByName  string                 `protobuf:"bytes,6,opt,name=created_by_name,json=createdByName,proto3" json:"created_by_name,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaymentId      string                 `protobuf:"bytes,8,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	GroupId        string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName      string                 `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	Method         string                 `protobuf:"bytes,11,opt,name=method,proto3" json:"method,omitempty"`
+	ReversalOf     string                 `protobuf:"bytes,12,opt,name=reversalOf,proto3" json:"reversalOf,omitempty"`
+	IsReversed     bool                   `protobuf:"varint,13,opt,name=isReversed,proto3" json:"isReversed,omitempty"`
+	ReversalReason string                 `protobuf:"bytes,14,opt,name=reversalReason,proto3" json:"reversalReason,omitempty"`
+	AccountId      string                 `protobuf:"bytes,15,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	AccountName    string                 `protobuf:"bytes,16,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Currency       string                 `protobuf:"bytes,17,opt,name=currency,proto3" json:"currency,omitempty"`
+	// in the account's currency, amount is in UZS
+	OriginalAmount string  `protobuf:"bytes,18,opt,name=originalAmount,proto3" json:"originalAmount,omitempty"`
+	ExchangeRate   float64 `protobuf:"fixed64,19,opt,name=exchangeRate,proto3" json:"exchangeRate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) Reset() {
+	*x = AbsGetAllPaymentsByMonthResponse{}
+	mi := &file_finance_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGetAllPaymentsByMonthResponse) ProtoMessage() {}
+
+func (x *AbsGetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGetAllPaymentsByMonthResponse.ProtoReflect.Descriptor instead.
+func (*AbsGetAllPaymentsByMonthResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetReversalOf() string {
+	if x != nil {
+		return x.ReversalOf
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetIsReversed() bool {
+	if x != nil {
+		return x.IsReversed
+	}
+	return false
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetReversalReason() string {
+	if x != nil {
+		return x.ReversalReason
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetOriginalAmount() string {
+	if x != nil {
+		return x.OriginalAmount
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+type GetMonthlyStatusResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	MonthStatus   []*AbsGetMonthlyStatusResponse `protobuf:"bytes,1,rep,name=monthStatus,proto3" json:"monthStatus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthlyStatusResponse) Reset() {
+	*x = GetMonthlyStatusResponse{}
+	mi := &file_finance_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlyStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlyStatusResponse) ProtoMessage() {}
+
+func (x *GetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlyStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetMonthlyStatusResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetMonthlyStatusResponse) GetMonthStatus() []*AbsGetMonthlyStatusResponse {
+	if x != nil {
+		return x.MonthStatus
+	}
+	return nil
+}
+
+type AbsGetMonthlyStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsGetMonthlyStatusResponse) Reset() {
+	*x = AbsGetMonthlyStatusResponse{}
+	mi := &file_finance_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGetMonthlyStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGetMonthlyStatusResponse) ProtoMessage() {}
+
+func (x *AbsGetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGetMonthlyStatusResponse.ProtoReflect.Descriptor instead.
+func (*AbsGetMonthlyStatusResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AbsGetMonthlyStatusResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *AbsGetMonthlyStatusResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type GetMonthlyStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthlyStatusRequest) Reset() {
+	*x = GetMonthlyStatusRequest{}
+	mi := &file_finance_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlyStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlyStatusRequest) ProtoMessage() {}
+
+func (x *GetMonthlyStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlyStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMonthlyStatusRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetMonthlyStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MergeStudentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceStudentId string                 `protobuf:"bytes,1,opt,name=sourceStudentId,proto3" json:"sourceStudentId,omitempty"`
+	TargetStudentId string                 `protobuf:"bytes,2,opt,name=targetStudentId,proto3" json:"targetStudentId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeStudentRequest) Reset() {
+	*x = MergeStudentRequest{}
+	mi := &file_finance_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeStudentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeStudentRequest) ProtoMessage() {}
+
+func (x *MergeStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeStudentRequest.ProtoReflect.Descriptor instead.
+func (*MergeStudentRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MergeStudentRequest) GetSourceStudentId() string {
+	if x != nil {
+		return x.SourceStudentId
+	}
+	return ""
+}
+
+func (x *MergeStudentRequest) GetTargetStudentId() string {
+	if x != nil {
+		return x.TargetStudentId
+	}
+	return ""
+}
+
+type BalanceEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	Attempts      int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceEvent) Reset() {
+	*x = BalanceEvent{}
+	mi := &file_finance_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceEvent) ProtoMessage() {}
+
+func (x *BalanceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceEvent.ProtoReflect.Descriptor instead.
+func (*BalanceEvent) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BalanceEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BalanceEvent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *BalanceEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *BalanceEvent) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *BalanceEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *BalanceEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type BalanceEventList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*BalanceEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceEventList) Reset() {
+	*x = BalanceEventList{}
+	mi := &file_finance_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceEventList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceEventList) ProtoMessage() {}
+
+func (x *BalanceEventList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceEventList.ProtoReflect.Descriptor instead.
+func (*BalanceEventList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BalanceEventList) GetEvents() []*BalanceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *BalanceEventList) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RetryBalanceEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryBalanceEventsRequest) Reset() {
+	*x = RetryBalanceEventsRequest{}
+	mi := &file_finance_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryBalanceEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryBalanceEventsRequest) ProtoMessage() {}
+
+func (x *RetryBalanceEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryBalanceEventsRequest.ProtoReflect.Descriptor instead.
+func (*RetryBalanceEventsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RetryBalanceEventsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type RetryBalanceEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retried       int32                  `protobuf:"varint,1,opt,name=retried,proto3" json:"retried,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryBalanceEventsResponse) Reset() {
+	*x = RetryBalanceEventsResponse{}
+	mi := &file_finance_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryBalanceEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryBalanceEventsResponse) ProtoMessage() {}
+
+func (x *RetryBalanceEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryBalanceEventsResponse.ProtoReflect.Descriptor instead.
+func (*RetryBalanceEventsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RetryBalanceEventsResponse) GetRetried() int32 {
+	if x != nil {
+		return x.Retried
+	}
+	return 0
+}
+
+type GetStudentLedgerBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentLedgerBalancesRequest) Reset() {
+	*x = GetStudentLedgerBalancesRequest{}
+	mi := &file_finance_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentLedgerBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentLedgerBalancesRequest) ProtoMessage() {}
+
+func (x *GetStudentLedgerBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentLedgerBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentLedgerBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{54}
+}
+
+type StudentLedgerBalance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StudentId        string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Balance          string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	HasPendingEvents bool                   `protobuf:"varint,3,opt,name=hasPendingEvents,proto3" json:"hasPendingEvents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StudentLedgerBalance) Reset() {
+	*x = StudentLedgerBalance{}
+	mi := &file_finance_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentLedgerBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentLedgerBalance) ProtoMessage() {}
+
+func (x *StudentLedgerBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentLedgerBalance.ProtoReflect.Descriptor instead.
+func (*StudentLedgerBalance) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *StudentLedgerBalance) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentLedgerBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *StudentLedgerBalance) GetHasPendingEvents() bool {
+	if x != nil {
+		return x.HasPendingEvents
+	}
+	return false
+}
+
+type GetStudentLedgerBalancesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Balances      []*StudentLedgerBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentLedgerBalancesResponse) Reset() {
+	*x = GetStudentLedgerBalancesResponse{}
+	mi := &file_finance_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentLedgerBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentLedgerBalancesResponse) ProtoMessage() {}
+
+func (x *GetStudentLedgerBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentLedgerBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentLedgerBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetStudentLedgerBalancesResponse) GetBalances() []*StudentLedgerBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type PaymentAddRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Comment              string                 `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Date                 string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Sum                  string                 `protobuf:"bytes,4,opt,name=sum,proto3" json:"sum,omitempty"`
+	UserId               string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
+	Type                 string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	ActionById           string                 `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName         string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	GroupId              string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Studentconditiondate string                 `protobuf:"bytes,10,opt,name=studentconditiondate,proto3" json:"studentconditiondate,omitempty"`
+	// TAKE_OFF and REFUND of group months, split with the student's sponsors
+	Sponsored bool `protobuf:"varint,11,opt,name=sponsored,proto3" json:"sponsored,omitempty"`
+	// cash account an ADD is paid into, the company's default one for method
+	// when empty; sum is in the account's currency
+	AccountId string `protobuf:"bytes,12,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	// discounts a TAKE_OFF was priced with, its rules are recorded as applied
+	// to the month of date together with the charge
+	Discounts     []*AppliedDiscount `protobuf:"bytes,13,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentAddRequest) Reset() {
+	*x = PaymentAddRequest{}
+	mi := &file_finance_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentAddRequest) ProtoMessage() {}
+
+func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentAddRequest.ProtoReflect.Descriptor instead.
+func (*PaymentAddRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PaymentAddRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetStudentconditiondate() string {
+	if x != nil {
+		return x.Studentconditiondate
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetSponsored() bool {
+	if x != nil {
+		return x.Sponsored
+	}
+	return false
+}
+
+func (x *PaymentAddRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetDiscounts() []*AppliedDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type PaymentUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debit         string                 `protobuf:"bytes,1,opt,name=debit,proto3" json:"debit,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,6,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	ActionById    string                 `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	AccountId     string                 `protobuf:"bytes,11,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentUpdateRequest) Reset() {
+	*x = PaymentUpdateRequest{}
+	mi := &file_finance_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentUpdateRequest) ProtoMessage() {}
+
+func (x *PaymentUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentUpdateRequest.ProtoReflect.Descriptor instead.
+func (*PaymentUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PaymentUpdateRequest) GetDebit() string {
+	if x != nil {
+		return x.Debit
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type PaymentReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentReturnRequest) Reset() {
+	*x = PaymentReturnRequest{}
+	mi := &file_finance_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentReturnRequest) ProtoMessage() {}
+
+func (x *PaymentReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentReturnRequest.ProtoReflect.Descriptor instead.
+func (*PaymentReturnRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PaymentReturnRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PaymentReturnRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PaymentReturnRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *PaymentReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetTeachersSalaryRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Salaries      []*AbsGetTeachersSalary `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeachersSalaryRequest) Reset() {
+	*x = GetTeachersSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeachersSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeachersSalaryRequest) ProtoMessage() {}
+
+func (x *GetTeachersSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeachersSalaryRequest.ProtoReflect.Descriptor instead.
+func (*GetTeachersSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTeachersSalaryRequest) GetSalaries() []*AbsGetTeachersSalary {
+	if x != nil {
+		return x.Salaries
+	}
+	return nil
+}
+
+type AbsGetTeachersSalary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TeacherName   string                 `protobuf:"bytes,4,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsGetTeachersSalary) Reset() {
+	*x = AbsGetTeachersSalary{}
+	mi := &file_finance_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGetTeachersSalary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGetTeachersSalary) ProtoMessage() {}
+
+func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGetTeachersSalary.ProtoReflect.Descriptor instead.
+func (*AbsGetTeachersSalary) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AbsGetTeachersSalary) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+type DeleteTeacherSalaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeacherSalaryRequest) Reset() {
+	*x = DeleteTeacherSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type CreateTeacherSalaryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Type      string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// a sum for FIXED, a percent for PERCENT
+	Amount        string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeacherSalaryRequest) Reset() {
+	*x = CreateTeacherSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *CreateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CreateTeacherSalaryRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateTeacherSalaryRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type Sponsor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	ContactPerson string                 `protobuf:"bytes,4,opt,name=contactPerson,proto3" json:"contactPerson,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	IsActive      bool                   `protobuf:"varint,6,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	// paid in less charged, negative when the sponsor owes
+	Balance       string `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	StudentCount  int32  `protobuf:"varint,8,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sponsor) Reset() {
+	*x = Sponsor{}
+	mi := &file_finance_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sponsor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sponsor) ProtoMessage() {}
+
+func (x *Sponsor) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sponsor.ProtoReflect.Descriptor instead.
+func (*Sponsor) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Sponsor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sponsor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sponsor) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Sponsor) GetContactPerson() string {
+	if x != nil {
+		return x.ContactPerson
+	}
+	return ""
+}
+
+func (x *Sponsor) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Sponsor) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Sponsor) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *Sponsor) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *Sponsor) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SponsorList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Sponsor             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SponsorList) Reset() {
+	*x = SponsorList{}
+	mi := &file_finance_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SponsorList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SponsorList) ProtoMessage() {}
+
+func (x *SponsorList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SponsorList.ProtoReflect.Descriptor instead.
+func (*SponsorList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SponsorList) GetItems() []*Sponsor {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Sponsorship is the part of a student's monthly charges a sponsor covers:
+// a percent of each charge, or a fixed amount of it a month. Without a group
+// it covers every group of the student.
+type Sponsorship struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SponsorId   string                 `protobuf:"bytes,2,opt,name=sponsorId,proto3" json:"sponsorId,omitempty"`
+	SponsorName string                 `protobuf:"bytes,3,opt,name=sponsorName,proto3" json:"sponsorName,omitempty"`
+	StudentId   string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName string                 `protobuf:"bytes,5,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	GroupId     string                 `protobuf:"bytes,6,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// FIXED or PERCENT
+	CoverType string `protobuf:"bytes,7,opt,name=coverType,proto3" json:"coverType,omitempty"`
+	Value     string `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+	StartAt   string `protobuf:"bytes,9,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	// open ended when empty
+	EndAt         string `protobuf:"bytes,10,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	IsActive      bool   `protobuf:"varint,11,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	CreatedAt     string `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sponsorship) Reset() {
+	*x = Sponsorship{}
+	mi := &file_finance_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sponsorship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sponsorship) ProtoMessage() {}
+
+func (x *Sponsorship) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sponsorship.ProtoReflect.Descriptor instead.
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Sponsorship) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetSponsorId() string {
+	if x != nil {
+		return x.SponsorId
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetSponsorName() string {
+	if x != nil {
+		return x.SponsorName
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetCoverType() string {
+	if x != nil {
+		return x.CoverType
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *Sponsorship) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Sponsorship) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type EndSponsorshipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// today when empty
+	EndAt         string `protobuf:"bytes,2,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndSponsorshipRequest) Reset() {
+	*x = EndSponsorshipRequest{}
+	mi := &file_finance_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSponsorshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSponsorshipRequest) ProtoMessage() {}
+
+func (x *EndSponsorshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSponsorshipRequest.ProtoReflect.Descriptor instead.
+func (*EndSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EndSponsorshipRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EndSponsorshipRequest) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+type GetSponsorshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SponsorId     string                 `protobuf:"bytes,1,opt,name=sponsorId,proto3" json:"sponsorId,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSponsorshipsRequest) Reset() {
+	*x = GetSponsorshipsRequest{}
+	mi := &file_finance_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSponsorshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSponsorshipsRequest) ProtoMessage() {}
+
+func (x *GetSponsorshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSponsorshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetSponsorshipsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetSponsorshipsRequest) GetSponsorId() string {
+	if x != nil {
+		return x.SponsorId
+	}
+	return ""
+}
+
+func (x *GetSponsorshipsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type SponsorshipList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Sponsorship         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SponsorshipList) Reset() {
+	*x = SponsorshipList{}
+	mi := &file_finance_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SponsorshipList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SponsorshipList) ProtoMessage() {}
+
+func (x *SponsorshipList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SponsorshipList.ProtoReflect.Descriptor instead.
+func (*SponsorshipList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SponsorshipList) GetItems() []*Sponsorship {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SponsorPaymentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SponsorId string                 `protobuf:"bytes,1,opt,name=sponsorId,proto3" json:"sponsorId,omitempty"`
+	Amount    string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// CASH, CLICK, PAYME, CARD or TRANSFER, or the method of accountId
+	// when given
+	Method        string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Date          string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Comment       string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	ActionById    string `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	AccountId     string `protobuf:"bytes,8,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SponsorPaymentRequest) Reset() {
+	*x = SponsorPaymentRequest{}
+	mi := &file_finance_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SponsorPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SponsorPaymentRequest) ProtoMessage() {}
+
+func (x *SponsorPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SponsorPaymentRequest.ProtoReflect.Descriptor instead.
+func (*SponsorPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SponsorPaymentRequest) GetSponsorId() string {
+	if x != nil {
+		return x.SponsorId
+	}
+	return ""
+}
+
+func (x *SponsorPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SponsorPaymentRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SponsorPaymentRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SponsorPaymentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SponsorPaymentRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SponsorPaymentRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *SponsorPaymentRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type SponsorPaymentReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActionById    string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SponsorPaymentReturnRequest) Reset() {
+	*x = SponsorPaymentReturnRequest{}
+	mi := &file_finance_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInformationDiscountResponse) String() string {
+func (x *SponsorPaymentReturnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInformationDiscountResponse) ProtoMessage() {}
+func (*SponsorPaymentReturnRequest) ProtoMessage() {}
 
-func (x *GetInformationDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[7]
+func (x *SponsorPaymentReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,48 +5223,63 @@ func (x *GetInformationDiscountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInformationDiscountResponse.ProtoReflect.Descriptor instead.
-func (*GetInformationDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SponsorPaymentReturnRequest.ProtoReflect.Descriptor instead.
+func (*SponsorPaymentReturnRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetInformationDiscountResponse) GetDiscounts() []*AbsStudentDiscount {
+func (x *SponsorPaymentReturnRequest) GetId() string {
 	if x != nil {
-		return x.Discounts
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type AbsStudentDiscount struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	StudentId          string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	StudentName        string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
-	StudentPhoneNumber string                 `protobuf:"bytes,3,opt,name=studentPhoneNumber,proto3" json:"studentPhoneNumber,omitempty"`
-	Discount           string                 `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount,omitempty"`
-	Cause              string                 `protobuf:"bytes,5,opt,name=cause,proto3" json:"cause,omitempty"`
-	CreatedAt          string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	StartAt            string                 `protobuf:"bytes,7,opt,name=startAt,proto3" json:"startAt,omitempty"`
-	EndAt              string                 `protobuf:"bytes,8,opt,name=endAt,proto3" json:"endAt,omitempty"`
-	WithTeacher        bool                   `protobuf:"varint,9,opt,name=withTeacher,proto3" json:"withTeacher,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *SponsorPaymentReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *AbsStudentDiscount) Reset() {
-	*x = AbsStudentDiscount{}
-	mi := &file_finance_proto_msgTypes[8]
+func (x *SponsorPaymentReturnRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SponsorPaymentReturnRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type SponsorStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SponsorId     string                 `protobuf:"bytes,1,opt,name=sponsorId,proto3" json:"sponsorId,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SponsorStatementRequest) Reset() {
+	*x = SponsorStatementRequest{}
+	mi := &file_finance_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsStudentDiscount) String() string {
+func (x *SponsorStatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsStudentDiscount) ProtoMessage() {}
+func (*SponsorStatementRequest) ProtoMessage() {}
 
-func (x *AbsStudentDiscount) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[8]
+func (x *SponsorStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,97 +5290,174 @@ func (x *AbsStudentDiscount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsStudentDiscount.ProtoReflect.Descriptor instead.
-func (*AbsStudentDiscount) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SponsorStatementRequest.ProtoReflect.Descriptor instead.
+func (*SponsorStatementRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *AbsStudentDiscount) GetStudentId() string {
+func (x *SponsorStatementRequest) GetSponsorId() string {
 	if x != nil {
-		return x.StudentId
+		return x.SponsorId
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetStudentName() string {
+func (x *SponsorStatementRequest) GetFrom() string {
 	if x != nil {
-		return x.StudentName
+		return x.From
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetStudentPhoneNumber() string {
+func (x *SponsorStatementRequest) GetTo() string {
 	if x != nil {
-		return x.StudentPhoneNumber
+		return x.To
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetDiscount() string {
+type SponsorStatementEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date  string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// ADD for a payment, CHARGE for a covered charge, REFUND for a covered refund
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	StudentId   string `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName string `protobuf:"bytes,5,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	GroupId     string `protobuf:"bytes,6,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Method      string `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	// signed by its effect on the balance
+	Amount        string `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Comment       string `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	ReversalOf    string `protobuf:"bytes,10,opt,name=reversalOf,proto3" json:"reversalOf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SponsorStatementEntry) Reset() {
+	*x = SponsorStatementEntry{}
+	mi := &file_finance_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SponsorStatementEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SponsorStatementEntry) ProtoMessage() {}
+
+func (x *SponsorStatementEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[73]
 	if x != nil {
-		return x.Discount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SponsorStatementEntry.ProtoReflect.Descriptor instead.
+func (*SponsorStatementEntry) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SponsorStatementEntry) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetCause() string {
+func (x *SponsorStatementEntry) GetDate() string {
 	if x != nil {
-		return x.Cause
+		return x.Date
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetCreatedAt() string {
+func (x *SponsorStatementEntry) GetType() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Type
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetStartAt() string {
+func (x *SponsorStatementEntry) GetStudentId() string {
 	if x != nil {
-		return x.StartAt
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetEndAt() string {
+func (x *SponsorStatementEntry) GetStudentName() string {
 	if x != nil {
-		return x.EndAt
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetWithTeacher() bool {
+func (x *SponsorStatementEntry) GetGroupId() string {
 	if x != nil {
-		return x.WithTeacher
+		return x.GroupId
 	}
-	return false
+	return ""
 }
 
-type CreateCategoryRequest struct {
+func (x *SponsorStatementEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SponsorStatementEntry) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SponsorStatementEntry) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SponsorStatementEntry) GetReversalOf() string {
+	if x != nil {
+		return x.ReversalOf
+	}
+	return ""
+}
+
+type SponsorStatementStudent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Desc          string                 `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	Covered       string                 `protobuf:"bytes,3,opt,name=covered,proto3" json:"covered,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_finance_proto_msgTypes[9]
+func (x *SponsorStatementStudent) Reset() {
+	*x = SponsorStatementStudent{}
+	mi := &file_finance_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *SponsorStatementStudent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*SponsorStatementStudent) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[9]
+func (x *SponsorStatementStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,47 +5468,62 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SponsorStatementStudent.ProtoReflect.Descriptor instead.
+func (*SponsorStatementStudent) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *SponsorStatementStudent) GetStudentId() string {
 	if x != nil {
-		return x.Name
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *CreateCategoryRequest) GetDesc() string {
+func (x *SponsorStatementStudent) GetStudentName() string {
 	if x != nil {
-		return x.Desc
+		return x.StudentName
 	}
 	return ""
 }
 
-type GetAllCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*AbsCategory         `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SponsorStatementStudent) GetCovered() string {
+	if x != nil {
+		return x.Covered
+	}
+	return ""
 }
 
-func (x *GetAllCategoryRequest) Reset() {
-	*x = GetAllCategoryRequest{}
-	mi := &file_finance_proto_msgTypes[10]
+type SponsorStatement struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	Sponsor        *Sponsor                   `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	From           string                     `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                     `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	OpeningBalance string                     `protobuf:"bytes,4,opt,name=openingBalance,proto3" json:"openingBalance,omitempty"`
+	Paid           string                     `protobuf:"bytes,5,opt,name=paid,proto3" json:"paid,omitempty"`
+	Covered        string                     `protobuf:"bytes,6,opt,name=covered,proto3" json:"covered,omitempty"`
+	ClosingBalance string                     `protobuf:"bytes,7,opt,name=closingBalance,proto3" json:"closingBalance,omitempty"`
+	Entries        []*SponsorStatementEntry   `protobuf:"bytes,8,rep,name=entries,proto3" json:"entries,omitempty"`
+	Students       []*SponsorStatementStudent `protobuf:"bytes,9,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SponsorStatement) Reset() {
+	*x = SponsorStatement{}
+	mi := &file_finance_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllCategoryRequest) String() string {
+func (x *SponsorStatement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllCategoryRequest) ProtoMessage() {}
+func (*SponsorStatement) ProtoMessage() {}
 
-func (x *GetAllCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[10]
+func (x *SponsorStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,45 +5531,116 @@ func (x *GetAllCategoryRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SponsorStatement.ProtoReflect.Descriptor instead.
+func (*SponsorStatement) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SponsorStatement) GetSponsor() *Sponsor {
+	if x != nil {
+		return x.Sponsor
+	}
+	return nil
+}
+
+func (x *SponsorStatement) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SponsorStatement) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SponsorStatement) GetOpeningBalance() string {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return ""
+}
+
+func (x *SponsorStatement) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *SponsorStatement) GetCovered() string {
+	if x != nil {
+		return x.Covered
+	}
+	return ""
+}
+
+func (x *SponsorStatement) GetClosingBalance() string {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return ""
 }
 
-// Deprecated: Use GetAllCategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetAllCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{10}
+func (x *SponsorStatement) GetEntries() []*SponsorStatementEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
 }
 
-func (x *GetAllCategoryRequest) GetCategories() []*AbsCategory {
+func (x *SponsorStatement) GetStudents() []*SponsorStatementStudent {
 	if x != nil {
-		return x.Categories
+		return x.Students
 	}
 	return nil
 }
 
-type AbsCategory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Desc          string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+type CreatePaymentPlanRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Total     string                 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	// split evenly when schedule is empty
+	Installments int32  `protobuf:"varint,4,opt,name=installments,proto3" json:"installments,omitempty"`
+	FirstDueDate string `protobuf:"bytes,5,opt,name=firstDueDate,proto3" json:"firstDueDate,omitempty"`
+	// months between due dates, 1 when 0
+	IntervalMonths int32 `protobuf:"varint,6,opt,name=intervalMonths,proto3" json:"intervalMonths,omitempty"`
+	// an explicit schedule instead, adding up to total
+	Schedule []*ScheduledInstallment `protobuf:"bytes,7,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	// FIXED or PERCENT of the installment, none when empty
+	LateFeeType  string `protobuf:"bytes,8,opt,name=lateFeeType,proto3" json:"lateFeeType,omitempty"`
+	LateFeeValue string `protobuf:"bytes,9,opt,name=lateFeeValue,proto3" json:"lateFeeValue,omitempty"`
+	// days after the due date before an installment is overdue
+	GraceDays     int32  `protobuf:"varint,10,opt,name=graceDays,proto3" json:"graceDays,omitempty"`
+	Comment       string `protobuf:"bytes,11,opt,name=comment,proto3" json:"comment,omitempty"`
+	ActionById    string `protobuf:"bytes,12,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,13,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsCategory) Reset() {
-	*x = AbsCategory{}
-	mi := &file_finance_proto_msgTypes[11]
+func (x *CreatePaymentPlanRequest) Reset() {
+	*x = CreatePaymentPlanRequest{}
+	mi := &file_finance_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsCategory) String() string {
+func (x *CreatePaymentPlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsCategory) ProtoMessage() {}
+func (*CreatePaymentPlanRequest) ProtoMessage() {}
 
-func (x *AbsCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[11]
+func (x *CreatePaymentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,131 +5651,125 @@ func (x *AbsCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsCategory.ProtoReflect.Descriptor instead.
-func (*AbsCategory) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreatePaymentPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AbsCategory) GetId() string {
+func (x *CreatePaymentPlanRequest) GetStudentId() string {
 	if x != nil {
-		return x.Id
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsCategory) GetName() string {
+func (x *CreatePaymentPlanRequest) GetGroupId() string {
 	if x != nil {
-		return x.Name
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *AbsCategory) GetDesc() string {
+func (x *CreatePaymentPlanRequest) GetTotal() string {
 	if x != nil {
-		return x.Desc
+		return x.Total
 	}
 	return ""
 }
 
-type GetAllExpenseDiagramResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	UserOrCategories       []string               `protobuf:"bytes,1,rep,name=userOrCategories,proto3" json:"userOrCategories,omitempty"`
-	UserOrCategoriesAmount []string               `protobuf:"bytes,2,rep,name=userOrCategoriesAmount,proto3" json:"userOrCategoriesAmount,omitempty"`
-	MonthAmount            []string               `protobuf:"bytes,3,rep,name=monthAmount,proto3" json:"monthAmount,omitempty"`
-	Months                 []string               `protobuf:"bytes,4,rep,name=months,proto3" json:"months,omitempty"`
-	AmountCommonExpense    string                 `protobuf:"bytes,5,opt,name=amountCommonExpense,proto3" json:"amountCommonExpense,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *CreatePaymentPlanRequest) GetInstallments() int32 {
+	if x != nil {
+		return x.Installments
+	}
+	return 0
 }
 
-func (x *GetAllExpenseDiagramResponse) Reset() {
-	*x = GetAllExpenseDiagramResponse{}
-	mi := &file_finance_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreatePaymentPlanRequest) GetFirstDueDate() string {
+	if x != nil {
+		return x.FirstDueDate
+	}
+	return ""
 }
 
-func (x *GetAllExpenseDiagramResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreatePaymentPlanRequest) GetIntervalMonths() int32 {
+	if x != nil {
+		return x.IntervalMonths
+	}
+	return 0
 }
 
-func (*GetAllExpenseDiagramResponse) ProtoMessage() {}
-
-func (x *GetAllExpenseDiagramResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[12]
+func (x *CreatePaymentPlanRequest) GetSchedule() []*ScheduledInstallment {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Schedule
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetAllExpenseDiagramResponse.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseDiagramResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{12}
+func (x *CreatePaymentPlanRequest) GetLateFeeType() string {
+	if x != nil {
+		return x.LateFeeType
+	}
+	return ""
 }
 
-func (x *GetAllExpenseDiagramResponse) GetUserOrCategories() []string {
+func (x *CreatePaymentPlanRequest) GetLateFeeValue() string {
 	if x != nil {
-		return x.UserOrCategories
+		return x.LateFeeValue
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllExpenseDiagramResponse) GetUserOrCategoriesAmount() []string {
+func (x *CreatePaymentPlanRequest) GetGraceDays() int32 {
 	if x != nil {
-		return x.UserOrCategoriesAmount
+		return x.GraceDays
 	}
-	return nil
+	return 0
 }
 
-func (x *GetAllExpenseDiagramResponse) GetMonthAmount() []string {
+func (x *CreatePaymentPlanRequest) GetComment() string {
 	if x != nil {
-		return x.MonthAmount
+		return x.Comment
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllExpenseDiagramResponse) GetMonths() []string {
+func (x *CreatePaymentPlanRequest) GetActionById() string {
 	if x != nil {
-		return x.Months
+		return x.ActionById
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllExpenseDiagramResponse) GetAmountCommonExpense() string {
+func (x *CreatePaymentPlanRequest) GetActionByName() string {
 	if x != nil {
-		return x.AmountCommonExpense
+		return x.ActionByName
 	}
 	return ""
 }
 
-type GetAllExpenseDiagramRequest struct {
+type ScheduledInstallment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	DueDate       string                 `protobuf:"bytes,1,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllExpenseDiagramRequest) Reset() {
-	*x = GetAllExpenseDiagramRequest{}
-	mi := &file_finance_proto_msgTypes[13]
+func (x *ScheduledInstallment) Reset() {
+	*x = ScheduledInstallment{}
+	mi := &file_finance_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllExpenseDiagramRequest) String() string {
+func (x *ScheduledInstallment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllExpenseDiagramRequest) ProtoMessage() {}
+func (*ScheduledInstallment) ProtoMessage() {}
 
-func (x *GetAllExpenseDiagramRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[13]
+func (x *ScheduledInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,51 +5780,48 @@ func (x *GetAllExpenseDiagramRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllExpenseDiagramRequest.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseDiagramRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ScheduledInstallment.ProtoReflect.Descriptor instead.
+func (*ScheduledInstallment) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetAllExpenseDiagramRequest) GetFrom() string {
+func (x *ScheduledInstallment) GetDueDate() string {
 	if x != nil {
-		return x.From
+		return x.DueDate
 	}
 	return ""
 }
 
-func (x *GetAllExpenseDiagramRequest) GetTo() string {
+func (x *ScheduledInstallment) GetAmount() string {
 	if x != nil {
-		return x.To
+		return x.Amount
 	}
 	return ""
 }
 
-type GetAllExpenseRequest struct {
+type PaymentPlanRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	PageReq       *PageRequest           `protobuf:"bytes,4,opt,name=pageReq,proto3" json:"pageReq,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllExpenseRequest) Reset() {
-	*x = GetAllExpenseRequest{}
-	mi := &file_finance_proto_msgTypes[14]
+func (x *PaymentPlanRequest) Reset() {
+	*x = PaymentPlanRequest{}
+	mi := &file_finance_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllExpenseRequest) String() string {
+func (x *PaymentPlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllExpenseRequest) ProtoMessage() {}
+func (*PaymentPlanRequest) ProtoMessage() {}
 
-func (x *GetAllExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[14]
+func (x *PaymentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,69 +5832,47 @@ func (x *GetAllExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllExpenseRequest.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetAllExpenseRequest) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
-}
-
-func (x *GetAllExpenseRequest) GetTo() string {
-	if x != nil {
-		return x.To
-	}
-	return ""
-}
-
-func (x *GetAllExpenseRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use PaymentPlanRequest.ProtoReflect.Descriptor instead.
+func (*PaymentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetAllExpenseRequest) GetId() string {
+func (x *PaymentPlanRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetAllExpenseRequest) GetPageReq() *PageRequest {
+func (x *PaymentPlanRequest) GetReason() string {
 	if x != nil {
-		return x.PageReq
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type GetAllExpenseResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TotalPageCount int32                  `protobuf:"varint,1,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
-	Expenses       []*GetAllExpenseAbs    `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type StudentPaymentPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllExpenseResponse) Reset() {
-	*x = GetAllExpenseResponse{}
-	mi := &file_finance_proto_msgTypes[15]
+func (x *StudentPaymentPlansRequest) Reset() {
+	*x = StudentPaymentPlansRequest{}
+	mi := &file_finance_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllExpenseResponse) String() string {
+func (x *StudentPaymentPlansRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllExpenseResponse) ProtoMessage() {}
+func (*StudentPaymentPlansRequest) ProtoMessage() {}
 
-func (x *GetAllExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[15]
+func (x *StudentPaymentPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,56 +5883,51 @@ func (x *GetAllExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllExpenseResponse.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetAllExpenseResponse) GetTotalPageCount() int32 {
-	if x != nil {
-		return x.TotalPageCount
-	}
-	return 0
+// Deprecated: Use StudentPaymentPlansRequest.ProtoReflect.Descriptor instead.
+func (*StudentPaymentPlansRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetAllExpenseResponse) GetExpenses() []*GetAllExpenseAbs {
+func (x *StudentPaymentPlansRequest) GetStudentId() string {
 	if x != nil {
-		return x.Expenses
+		return x.StudentId
 	}
-	return nil
+	return ""
 }
 
-type GetAllExpenseAbs struct {
+type PaymentPlan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	Category      *AbsCategory           `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	User          *GetUserByIdResponse   `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
-	ExpenseType   string                 `protobuf:"bytes,5,opt,name=expenseType,proto3" json:"expenseType,omitempty"`
-	Sum           string                 `protobuf:"bytes,6,opt,name=sum,proto3" json:"sum,omitempty"`
-	Creator       *GetUserByIdResponse   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
-	PaymentType   string                 `protobuf:"bytes,8,opt,name=paymentType,proto3" json:"paymentType,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	LateFeeType   string                 `protobuf:"bytes,4,opt,name=lateFeeType,proto3" json:"lateFeeType,omitempty"`
+	LateFeeValue  string                 `protobuf:"bytes,5,opt,name=lateFeeValue,proto3" json:"lateFeeValue,omitempty"`
+	GraceDays     int32                  `protobuf:"varint,6,opt,name=graceDays,proto3" json:"graceDays,omitempty"`
+	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	Title         string                 `protobuf:"bytes,10,opt,name=title,proto3" json:"title,omitempty"`
+	CancelReason  string                 `protobuf:"bytes,10,opt,name=cancelReason,proto3" json:"cancelReason,omitempty"`
+	Summary       *PaymentPlanStatus     `protobuf:"bytes,11,opt,name=summary,proto3" json:"summary,omitempty"`
+	Installments  []*Installment         `protobuf:"bytes,12,rep,name=installments,proto3" json:"installments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllExpenseAbs) Reset() {
-	*x = GetAllExpenseAbs{}
-	mi := &file_finance_proto_msgTypes[16]
+func (x *PaymentPlan) Reset() {
+	*x = PaymentPlan{}
+	mi := &file_finance_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllExpenseAbs) String() string {
+func (x *PaymentPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllExpenseAbs) ProtoMessage() {}
+func (*PaymentPlan) ProtoMessage() {}
 
-func (x *GetAllExpenseAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[16]
+func (x *PaymentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1087,110 +5938,126 @@ func (x *GetAllExpenseAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllExpenseAbs.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseAbs) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PaymentPlan.ProtoReflect.Descriptor instead.
+func (*PaymentPlan) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetAllExpenseAbs) GetId() string {
+func (x *PaymentPlan) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetGivenDate() string {
+func (x *PaymentPlan) GetStudentId() string {
 	if x != nil {
-		return x.GivenDate
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetCategory() *AbsCategory {
+func (x *PaymentPlan) GetGroupId() string {
 	if x != nil {
-		return x.Category
+		return x.GroupId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllExpenseAbs) GetUser() *GetUserByIdResponse {
+func (x *PaymentPlan) GetLateFeeType() string {
 	if x != nil {
-		return x.User
+		return x.LateFeeType
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllExpenseAbs) GetExpenseType() string {
+func (x *PaymentPlan) GetLateFeeValue() string {
 	if x != nil {
-		return x.ExpenseType
+		return x.LateFeeValue
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetSum() string {
+func (x *PaymentPlan) GetGraceDays() int32 {
 	if x != nil {
-		return x.Sum
+		return x.GraceDays
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAllExpenseAbs) GetCreator() *GetUserByIdResponse {
+func (x *PaymentPlan) GetComment() string {
 	if x != nil {
-		return x.Creator
+		return x.Comment
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllExpenseAbs) GetPaymentType() string {
+func (x *PaymentPlan) GetCreatedBy() string {
 	if x != nil {
-		return x.PaymentType
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetCreatedAt() string {
+func (x *PaymentPlan) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetTitle() string {
+func (x *PaymentPlan) GetCancelReason() string {
 	if x != nil {
-		return x.Title
+		return x.CancelReason
 	}
 	return ""
 }
 
-type CreateExpenseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	ExpenseType   string                 `protobuf:"bytes,3,opt,name=expenseType,proto3" json:"expenseType,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,4,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
-	Sum           string                 `protobuf:"bytes,6,opt,name=sum,proto3" json:"sum,omitempty"`
-	CreatedById   string                 `protobuf:"bytes,7,opt,name=createdById,proto3" json:"createdById,omitempty"`
-	PaymentMethod string                 `protobuf:"bytes,8,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"`
+func (x *PaymentPlan) GetSummary() *PaymentPlanStatus {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *PaymentPlan) GetInstallments() []*Installment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+type Installment struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number  int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	DueDate string                 `protobuf:"bytes,3,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	Amount  string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	LateFee string                 `protobuf:"bytes,5,opt,name=lateFee,proto3" json:"lateFee,omitempty"`
+	Paid    string                 `protobuf:"bytes,6,opt,name=paid,proto3" json:"paid,omitempty"`
+	// PENDING, PARTIAL, PAID or OVERDUE
+	Status        string                `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	PaidAt        string                `protobuf:"bytes,8,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
+	Payments      []*InstallmentPayment `protobuf:"bytes,9,rep,name=payments,proto3" json:"payments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExpenseRequest) Reset() {
-	*x = CreateExpenseRequest{}
-	mi := &file_finance_proto_msgTypes[17]
+func (x *Installment) Reset() {
+	*x = Installment{}
+	mi := &file_finance_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExpenseRequest) String() string {
+func (x *Installment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExpenseRequest) ProtoMessage() {}
+func (*Installment) ProtoMessage() {}
 
-func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[17]
+func (x *Installment) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1201,90 +6068,98 @@ func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
-func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Installment.ProtoReflect.Descriptor instead.
+func (*Installment) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CreateExpenseRequest) GetTitle() string {
+func (x *Installment) GetId() string {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetGivenDate() string {
+func (x *Installment) GetNumber() int32 {
 	if x != nil {
-		return x.GivenDate
+		return x.Number
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateExpenseRequest) GetExpenseType() string {
+func (x *Installment) GetDueDate() string {
 	if x != nil {
-		return x.ExpenseType
+		return x.DueDate
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetCategoryId() string {
+func (x *Installment) GetAmount() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetUserId() string {
+func (x *Installment) GetLateFee() string {
 	if x != nil {
-		return x.UserId
+		return x.LateFee
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetSum() string {
+func (x *Installment) GetPaid() string {
 	if x != nil {
-		return x.Sum
+		return x.Paid
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetCreatedById() string {
+func (x *Installment) GetStatus() string {
 	if x != nil {
-		return x.CreatedById
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetPaymentMethod() string {
+func (x *Installment) GetPaidAt() string {
 	if x != nil {
-		return x.PaymentMethod
+		return x.PaidAt
 	}
 	return ""
 }
 
-type GetIncomeChartRequest struct {
+func (x *Installment) GetPayments() []*InstallmentPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type InstallmentPayment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIncomeChartRequest) Reset() {
-	*x = GetIncomeChartRequest{}
-	mi := &file_finance_proto_msgTypes[18]
+func (x *InstallmentPayment) Reset() {
+	*x = InstallmentPayment{}
+	mi := &file_finance_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIncomeChartRequest) String() string {
+func (x *InstallmentPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIncomeChartRequest) ProtoMessage() {}
+func (*InstallmentPayment) ProtoMessage() {}
 
-func (x *GetIncomeChartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[18]
+func (x *InstallmentPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,47 +6170,55 @@ func (x *GetIncomeChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIncomeChartRequest.ProtoReflect.Descriptor instead.
-func (*GetIncomeChartRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use InstallmentPayment.ProtoReflect.Descriptor instead.
+func (*InstallmentPayment) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetIncomeChartRequest) GetFrom() string {
+func (x *InstallmentPayment) GetPaymentId() string {
 	if x != nil {
-		return x.From
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *GetIncomeChartRequest) GetTo() string {
+func (x *InstallmentPayment) GetAmount() string {
 	if x != nil {
-		return x.To
+		return x.Amount
 	}
 	return ""
 }
 
-type GetIncomeChartResponse struct {
+func (x *InstallmentPayment) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetOverdueInstallmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      []*AbsIncomeChart      `protobuf:"bytes,1,rep,name=response,proto3" json:"response,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIncomeChartResponse) Reset() {
-	*x = GetIncomeChartResponse{}
-	mi := &file_finance_proto_msgTypes[19]
+func (x *GetOverdueInstallmentsRequest) Reset() {
+	*x = GetOverdueInstallmentsRequest{}
+	mi := &file_finance_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIncomeChartResponse) String() string {
+func (x *GetOverdueInstallmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIncomeChartResponse) ProtoMessage() {}
+func (*GetOverdueInstallmentsRequest) ProtoMessage() {}
 
-func (x *GetIncomeChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[19]
+func (x *GetOverdueInstallmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,41 +6229,56 @@ func (x *GetIncomeChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIncomeChartResponse.ProtoReflect.Descriptor instead.
-func (*GetIncomeChartResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetOverdueInstallmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetOverdueInstallmentsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetIncomeChartResponse) GetResponse() []*AbsIncomeChart {
+func (x *GetOverdueInstallmentsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Response
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type AbsIncomeChart struct {
+func (x *GetOverdueInstallmentsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type OverdueInstallment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SpecificMonth string                 `protobuf:"bytes,1,opt,name=specificMonth,proto3" json:"specificMonth,omitempty"`
-	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId,omitempty"`
+	InstallmentId string                 `protobuf:"bytes,2,opt,name=installmentId,proto3" json:"installmentId,omitempty"`
+	StudentId     string                 `protobuf:"bytes,3,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName   string                 `protobuf:"bytes,4,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	GroupId       string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Number        int32                  `protobuf:"varint,6,opt,name=number,proto3" json:"number,omitempty"`
+	DueDate       string                 `protobuf:"bytes,7,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	DaysOverdue   int32                  `protobuf:"varint,8,opt,name=daysOverdue,proto3" json:"daysOverdue,omitempty"`
+	Due           string                 `protobuf:"bytes,9,opt,name=due,proto3" json:"due,omitempty"`
+	LateFee       string                 `protobuf:"bytes,10,opt,name=lateFee,proto3" json:"lateFee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsIncomeChart) Reset() {
-	*x = AbsIncomeChart{}
-	mi := &file_finance_proto_msgTypes[20]
+func (x *OverdueInstallment) Reset() {
+	*x = OverdueInstallment{}
+	mi := &file_finance_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsIncomeChart) String() string {
+func (x *OverdueInstallment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsIncomeChart) ProtoMessage() {}
+func (*OverdueInstallment) ProtoMessage() {}
 
-func (x *AbsIncomeChart) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[20]
+func (x *OverdueInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,103 +6289,104 @@ func (x *AbsIncomeChart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsIncomeChart.ProtoReflect.Descriptor instead.
-func (*AbsIncomeChart) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use OverdueInstallment.ProtoReflect.Descriptor instead.
+func (*OverdueInstallment) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *AbsIncomeChart) GetSpecificMonth() string {
+func (x *OverdueInstallment) GetPlanId() string {
 	if x != nil {
-		return x.SpecificMonth
+		return x.PlanId
 	}
 	return ""
 }
 
-func (x *AbsIncomeChart) GetBalance() string {
+func (x *OverdueInstallment) GetInstallmentId() string {
 	if x != nil {
-		return x.Balance
+		return x.InstallmentId
 	}
 	return ""
 }
 
-type GetCommonInformationResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	DebtorsCount      int32                  `protobuf:"varint,1,opt,name=debtorsCount,proto3" json:"debtorsCount,omitempty"`
-	PayInCurrentMonth int32                  `protobuf:"varint,2,opt,name=payInCurrentMonth,proto3" json:"payInCurrentMonth,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *OverdueInstallment) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
 }
 
-func (x *GetCommonInformationResponse) Reset() {
-	*x = GetCommonInformationResponse{}
-	mi := &file_finance_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OverdueInstallment) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
 }
 
-func (x *GetCommonInformationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OverdueInstallment) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
 }
 
-func (*GetCommonInformationResponse) ProtoMessage() {}
-
-func (x *GetCommonInformationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[21]
+func (x *OverdueInstallment) GetNumber() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Number
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCommonInformationResponse.ProtoReflect.Descriptor instead.
-func (*GetCommonInformationResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{21}
+func (x *OverdueInstallment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
 }
 
-func (x *GetCommonInformationResponse) GetDebtorsCount() int32 {
+func (x *OverdueInstallment) GetDaysOverdue() int32 {
 	if x != nil {
-		return x.DebtorsCount
+		return x.DaysOverdue
 	}
 	return 0
 }
 
-func (x *GetCommonInformationResponse) GetPayInCurrentMonth() int32 {
+func (x *OverdueInstallment) GetDue() string {
 	if x != nil {
-		return x.PayInCurrentMonth
+		return x.Due
 	}
-	return 0
+	return ""
 }
 
-type GetAllDebtsRequest struct {
+func (x *OverdueInstallment) GetLateFee() string {
+	if x != nil {
+		return x.LateFee
+	}
+	return ""
+}
+
+type OverdueInstallmentList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageParam     *PageRequest           `protobuf:"bytes,1,opt,name=pageParam,proto3" json:"pageParam,omitempty"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	AmountFrom    int64                  `protobuf:"varint,4,opt,name=amountFrom,proto3" json:"amountFrom,omitempty"`
-	AmountTo      int64                  `protobuf:"varint,5,opt,name=amountTo,proto3" json:"amountTo,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*OverdueInstallment  `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllDebtsRequest) Reset() {
-	*x = GetAllDebtsRequest{}
-	mi := &file_finance_proto_msgTypes[22]
+func (x *OverdueInstallmentList) Reset() {
+	*x = OverdueInstallmentList{}
+	mi := &file_finance_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllDebtsRequest) String() string {
+func (x *OverdueInstallmentList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllDebtsRequest) ProtoMessage() {}
+func (*OverdueInstallmentList) ProtoMessage() {}
 
-func (x *GetAllDebtsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[22]
+func (x *OverdueInstallmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1498,69 +6397,63 @@ func (x *GetAllDebtsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllDebtsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllDebtsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *GetAllDebtsRequest) GetPageParam() *PageRequest {
-	if x != nil {
-		return x.PageParam
-	}
-	return nil
-}
-
-func (x *GetAllDebtsRequest) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
-}
-
-func (x *GetAllDebtsRequest) GetTo() string {
-	if x != nil {
-		return x.To
-	}
-	return ""
+// Deprecated: Use OverdueInstallmentList.ProtoReflect.Descriptor instead.
+func (*OverdueInstallmentList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetAllDebtsRequest) GetAmountFrom() int64 {
+func (x *OverdueInstallmentList) GetCount() int32 {
 	if x != nil {
-		return x.AmountFrom
+		return x.Count
 	}
 	return 0
 }
 
-func (x *GetAllDebtsRequest) GetAmountTo() int64 {
+func (x *OverdueInstallmentList) GetItems() []*OverdueInstallment {
 	if x != nil {
-		return x.AmountTo
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-type GetAllDebtsInformationResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
-	Debts          []*AbsDebtsInformation `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CashAccount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// CASH_DESK, BANK, CARD_TERMINAL or ONLINE
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// UZS or USD
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// CASH, CLICK, PAYME, CARD or TRANSFER
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// where payments of its method go when no account is given
+	IsDefault bool `protobuf:"varint,6,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	// payments and expenses need an open shift
+	RequiresShift bool `protobuf:"varint,7,opt,name=requiresShift,proto3" json:"requiresShift,omitempty"`
+	IsActive      bool `protobuf:"varint,8,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	// in the account's currency
+	Balance       string `protobuf:"bytes,9,opt,name=balance,proto3" json:"balance,omitempty"`
+	OpenShiftId   string `protobuf:"bytes,10,opt,name=openShiftId,proto3" json:"openShiftId,omitempty"`
+	CreatedAt     string `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllDebtsInformationResponse) Reset() {
-	*x = GetAllDebtsInformationResponse{}
-	mi := &file_finance_proto_msgTypes[23]
+func (x *CashAccount) Reset() {
+	*x = CashAccount{}
+	mi := &file_finance_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllDebtsInformationResponse) String() string {
+func (x *CashAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllDebtsInformationResponse) ProtoMessage() {}
+func (*CashAccount) ProtoMessage() {}
 
-func (x *GetAllDebtsInformationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[23]
+func (x *CashAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,143 +6464,159 @@ func (x *GetAllDebtsInformationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllDebtsInformationResponse.ProtoReflect.Descriptor instead.
-func (*GetAllDebtsInformationResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CashAccount.ProtoReflect.Descriptor instead.
+func (*CashAccount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetAllDebtsInformationResponse) GetTotalPageCount() int32 {
+func (x *CashAccount) GetId() string {
 	if x != nil {
-		return x.TotalPageCount
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAllDebtsInformationResponse) GetDebts() []*AbsDebtsInformation {
+func (x *CashAccount) GetName() string {
 	if x != nil {
-		return x.Debts
+		return x.Name
 	}
-	return nil
-}
-
-type AbsDebtsInformation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DebtorId      string                 `protobuf:"bytes,1,opt,name=debtorId,proto3" json:"debtorId,omitempty"`
-	DebtorName    string                 `protobuf:"bytes,2,opt,name=debtorName,proto3" json:"debtorName,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	Balance       string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
-	TotalOnPeriod string                 `protobuf:"bytes,5,opt,name=totalOnPeriod,proto3" json:"totalOnPeriod,omitempty"`
-	Groups        []*DebtorGroup         `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
-	Comments      []*DebtorComment       `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *AbsDebtsInformation) Reset() {
-	*x = AbsDebtsInformation{}
-	mi := &file_finance_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CashAccount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (x *AbsDebtsInformation) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CashAccount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (*AbsDebtsInformation) ProtoMessage() {}
-
-func (x *AbsDebtsInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[24]
+func (x *CashAccount) GetMethod() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Method
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AbsDebtsInformation.ProtoReflect.Descriptor instead.
-func (*AbsDebtsInformation) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{24}
+func (x *CashAccount) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
 }
 
-func (x *AbsDebtsInformation) GetDebtorId() string {
+func (x *CashAccount) GetRequiresShift() bool {
 	if x != nil {
-		return x.DebtorId
+		return x.RequiresShift
 	}
-	return ""
+	return false
 }
 
-func (x *AbsDebtsInformation) GetDebtorName() string {
+func (x *CashAccount) GetIsActive() bool {
 	if x != nil {
-		return x.DebtorName
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *AbsDebtsInformation) GetPhoneNumber() string {
+func (x *CashAccount) GetBalance() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Balance
 	}
 	return ""
 }
 
-func (x *AbsDebtsInformation) GetBalance() string {
+func (x *CashAccount) GetOpenShiftId() string {
 	if x != nil {
-		return x.Balance
+		return x.OpenShiftId
 	}
 	return ""
 }
 
-func (x *AbsDebtsInformation) GetTotalOnPeriod() string {
+func (x *CashAccount) GetCreatedAt() string {
 	if x != nil {
-		return x.TotalOnPeriod
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *AbsDebtsInformation) GetGroups() []*DebtorGroup {
+type CashAccountList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CashAccount         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashAccountList) Reset() {
+	*x = CashAccountList{}
+	mi := &file_finance_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashAccountList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashAccountList) ProtoMessage() {}
+
+func (x *CashAccountList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[87]
 	if x != nil {
-		return x.Groups
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AbsDebtsInformation) GetComments() []*DebtorComment {
+// Deprecated: Use CashAccountList.ProtoReflect.Descriptor instead.
+func (*CashAccountList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CashAccountList) GetItems() []*CashAccount {
 	if x != nil {
-		return x.Comments
+		return x.Items
 	}
 	return nil
 }
 
-type GetAllStudentPaymentsChartResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Cash          string                    `protobuf:"bytes,1,opt,name=cash,proto3" json:"cash,omitempty"`
-	Payme         string                    `protobuf:"bytes,2,opt,name=payme,proto3" json:"payme,omitempty"`
-	Click         string                    `protobuf:"bytes,3,opt,name=click,proto3" json:"click,omitempty"`
-	TotalRevenue  string                    `protobuf:"bytes,4,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`
-	PaymentsChart []*AbsTakeOfChartResponse `protobuf:"bytes,5,rep,name=paymentsChart,proto3" json:"paymentsChart,omitempty"`
+type CashShiftRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	// money counted in the drawer, in the account's currency
+	Counted       string `protobuf:"bytes,2,opt,name=counted,proto3" json:"counted,omitempty"`
+	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,5,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllStudentPaymentsChartResponse) Reset() {
-	*x = GetAllStudentPaymentsChartResponse{}
-	mi := &file_finance_proto_msgTypes[25]
+func (x *CashShiftRequest) Reset() {
+	*x = CashShiftRequest{}
+	mi := &file_finance_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentPaymentsChartResponse) String() string {
+func (x *CashShiftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentPaymentsChartResponse) ProtoMessage() {}
+func (*CashShiftRequest) ProtoMessage() {}
 
-func (x *GetAllStudentPaymentsChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[25]
+func (x *CashShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,72 +6627,86 @@ func (x *GetAllStudentPaymentsChartResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentPaymentsChartResponse.ProtoReflect.Descriptor instead.
-func (*GetAllStudentPaymentsChartResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CashShiftRequest.ProtoReflect.Descriptor instead.
+func (*CashShiftRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetAllStudentPaymentsChartResponse) GetCash() string {
+func (x *CashShiftRequest) GetAccountId() string {
 	if x != nil {
-		return x.Cash
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsChartResponse) GetPayme() string {
+func (x *CashShiftRequest) GetCounted() string {
 	if x != nil {
-		return x.Payme
+		return x.Counted
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsChartResponse) GetClick() string {
+func (x *CashShiftRequest) GetComment() string {
 	if x != nil {
-		return x.Click
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsChartResponse) GetTotalRevenue() string {
+func (x *CashShiftRequest) GetActionById() string {
 	if x != nil {
-		return x.TotalRevenue
+		return x.ActionById
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsChartResponse) GetPaymentsChart() []*AbsTakeOfChartResponse {
+func (x *CashShiftRequest) GetActionByName() string {
 	if x != nil {
-		return x.PaymentsChart
+		return x.ActionByName
 	}
-	return nil
+	return ""
 }
 
-type GetAllStudentPaymentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,6,opt,name=page,proto3" json:"page,omitempty"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Filters       []*Filters             `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
-	Sorts         []*SortBy              `protobuf:"bytes,5,rep,name=sorts,proto3" json:"sorts,omitempty"`
+type CashShift struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId   string                 `protobuf:"bytes,2,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	AccountName string                 `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Currency    string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// OPEN or CLOSED
+	Status         string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	OpenedAt       string `protobuf:"bytes,6,opt,name=openedAt,proto3" json:"openedAt,omitempty"`
+	OpenedByName   string `protobuf:"bytes,7,opt,name=openedByName,proto3" json:"openedByName,omitempty"`
+	OpeningCounted string `protobuf:"bytes,8,opt,name=openingCounted,proto3" json:"openingCounted,omitempty"`
+	Inflow         string `protobuf:"bytes,9,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Outflow        string `protobuf:"bytes,10,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	// openingCounted + inflow - outflow
+	Expected string `protobuf:"bytes,11,opt,name=expected,proto3" json:"expected,omitempty"`
+	Counted  string `protobuf:"bytes,12,opt,name=counted,proto3" json:"counted,omitempty"`
+	// counted - expected, negative when money is missing
+	Difference    string `protobuf:"bytes,13,opt,name=difference,proto3" json:"difference,omitempty"`
+	ClosedAt      string `protobuf:"bytes,14,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
+	ClosedByName  string `protobuf:"bytes,15,opt,name=closedByName,proto3" json:"closedByName,omitempty"`
+	Comment       string `protobuf:"bytes,16,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllStudentPaymentsRequest) Reset() {
-	*x = GetAllStudentPaymentsRequest{}
-	mi := &file_finance_proto_msgTypes[26]
+func (x *CashShift) Reset() {
+	*x = CashShift{}
+	mi := &file_finance_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentPaymentsRequest) String() string {
+func (x *CashShift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentPaymentsRequest) ProtoMessage() {}
+func (*CashShift) ProtoMessage() {}
 
-func (x *GetAllStudentPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[26]
+func (x *CashShift) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1794,129 +6717,147 @@ func (x *GetAllStudentPaymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentPaymentsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllStudentPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CashShift.ProtoReflect.Descriptor instead.
+func (*CashShift) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetAllStudentPaymentsRequest) GetPage() *PageRequest {
+func (x *CashShift) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllStudentPaymentsRequest) GetFrom() string {
+func (x *CashShift) GetAccountId() string {
 	if x != nil {
-		return x.From
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsRequest) GetTo() string {
+func (x *CashShift) GetAccountName() string {
 	if x != nil {
-		return x.To
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsRequest) GetFilters() []*Filters {
+func (x *CashShift) GetCurrency() string {
 	if x != nil {
-		return x.Filters
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllStudentPaymentsRequest) GetSorts() []*SortBy {
+func (x *CashShift) GetStatus() string {
 	if x != nil {
-		return x.Sorts
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type Filters struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CashShift) GetOpenedAt() string {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return ""
 }
 
-func (x *Filters) Reset() {
-	*x = Filters{}
-	mi := &file_finance_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CashShift) GetOpenedByName() string {
+	if x != nil {
+		return x.OpenedByName
+	}
+	return ""
 }
 
-func (x *Filters) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CashShift) GetOpeningCounted() string {
+	if x != nil {
+		return x.OpeningCounted
+	}
+	return ""
 }
 
-func (*Filters) ProtoMessage() {}
+func (x *CashShift) GetInflow() string {
+	if x != nil {
+		return x.Inflow
+	}
+	return ""
+}
 
-func (x *Filters) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[27]
+func (x *CashShift) GetOutflow() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Outflow
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Filters.ProtoReflect.Descriptor instead.
-func (*Filters) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{27}
+func (x *CashShift) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
 }
 
-func (x *Filters) GetField() string {
+func (x *CashShift) GetCounted() string {
 	if x != nil {
-		return x.Field
+		return x.Counted
 	}
 	return ""
 }
 
-func (x *Filters) GetType() string {
+func (x *CashShift) GetDifference() string {
 	if x != nil {
-		return x.Type
+		return x.Difference
 	}
 	return ""
 }
 
-func (x *Filters) GetValue() string {
+func (x *CashShift) GetClosedAt() string {
 	if x != nil {
-		return x.Value
+		return x.ClosedAt
 	}
 	return ""
 }
 
-type SortBy struct {
+func (x *CashShift) GetClosedByName() string {
+	if x != nil {
+		return x.ClosedByName
+	}
+	return ""
+}
+
+func (x *CashShift) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type GetCashShiftsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=accountId,proto3" json:"accountId,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SortBy) Reset() {
-	*x = SortBy{}
-	mi := &file_finance_proto_msgTypes[28]
+func (x *GetCashShiftsRequest) Reset() {
+	*x = GetCashShiftsRequest{}
+	mi := &file_finance_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SortBy) String() string {
+func (x *GetCashShiftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SortBy) ProtoMessage() {}
+func (*GetCashShiftsRequest) ProtoMessage() {}
 
-func (x *SortBy) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[28]
+func (x *GetCashShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1927,47 +6868,55 @@ func (x *SortBy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SortBy.ProtoReflect.Descriptor instead.
-func (*SortBy) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetCashShiftsRequest.ProtoReflect.Descriptor instead.
+func (*GetCashShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *SortBy) GetField() string {
+func (x *GetCashShiftsRequest) GetAccountId() string {
 	if x != nil {
-		return x.Field
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *SortBy) GetType() string {
+func (x *GetCashShiftsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Type
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-type GetAllStudentPaymentsResponse struct {
+func (x *GetCashShiftsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CashShiftList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payments      []*AbsStudentPayments  `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*CashShift           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllStudentPaymentsResponse) Reset() {
-	*x = GetAllStudentPaymentsResponse{}
-	mi := &file_finance_proto_msgTypes[29]
+func (x *CashShiftList) Reset() {
+	*x = CashShiftList{}
+	mi := &file_finance_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentPaymentsResponse) String() string {
+func (x *CashShiftList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentPaymentsResponse) ProtoMessage() {}
+func (*CashShiftList) ProtoMessage() {}
 
-func (x *GetAllStudentPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[29]
+func (x *CashShiftList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,47 +6927,56 @@ func (x *GetAllStudentPaymentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentPaymentsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllStudentPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CashShiftList.ProtoReflect.Descriptor instead.
+func (*CashShiftList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetAllStudentPaymentsResponse) GetPayments() []*AbsStudentPayments {
+func (x *CashShiftList) GetCount() int32 {
 	if x != nil {
-		return x.Payments
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CashShiftList) GetItems() []*CashShift {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-type AbsStudentPayments struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GivenDate     string                 `protobuf:"bytes,1,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	StudentName   string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName,omitempty"`
-	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
-	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
-	CreatorName   string                 `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName,omitempty"`
-	CreatorId     string                 `protobuf:"bytes,8,opt,name=creatorId,proto3" json:"creatorId,omitempty"`
+type ExchangeRate struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// UZS for one unit of currency
+	Rate float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// the rate holds from this day until the next one recorded
+	Date          string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	CreatedByName string `protobuf:"bytes,5,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	CreatedAt     string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ActionById    string `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsStudentPayments) Reset() {
-	*x = AbsStudentPayments{}
-	mi := &file_finance_proto_msgTypes[30]
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_finance_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsStudentPayments) String() string {
+func (x *ExchangeRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsStudentPayments) ProtoMessage() {}
+func (*ExchangeRate) ProtoMessage() {}
 
-func (x *AbsStudentPayments) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[30]
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,89 +6987,89 @@ func (x *AbsStudentPayments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsStudentPayments.ProtoReflect.Descriptor instead.
-func (*AbsStudentPayments) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *AbsStudentPayments) GetGivenDate() string {
+func (x *ExchangeRate) GetId() string {
 	if x != nil {
-		return x.GivenDate
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetStudentId() string {
+func (x *ExchangeRate) GetCurrency() string {
 	if x != nil {
-		return x.StudentId
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetStudentName() string {
+func (x *ExchangeRate) GetRate() float64 {
 	if x != nil {
-		return x.StudentName
+		return x.Rate
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsStudentPayments) GetAmount() string {
+func (x *ExchangeRate) GetDate() string {
 	if x != nil {
-		return x.Amount
+		return x.Date
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetMethod() string {
+func (x *ExchangeRate) GetCreatedByName() string {
 	if x != nil {
-		return x.Method
+		return x.CreatedByName
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetComment() string {
+func (x *ExchangeRate) GetCreatedAt() string {
 	if x != nil {
-		return x.Comment
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetCreatorName() string {
+func (x *ExchangeRate) GetActionById() string {
 	if x != nil {
-		return x.CreatorName
+		return x.ActionById
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetCreatorId() string {
+func (x *ExchangeRate) GetActionByName() string {
 	if x != nil {
-		return x.CreatorId
+		return x.ActionByName
 	}
 	return ""
 }
 
-type GetAllPaymentTakeOffChartResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	ChartResponse []*AbsTakeOfChartResponse `protobuf:"bytes,1,rep,name=chartResponse,proto3" json:"chartResponse,omitempty"`
+type GetExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentTakeOffChartResponse) Reset() {
-	*x = GetAllPaymentTakeOffChartResponse{}
-	mi := &file_finance_proto_msgTypes[31]
+func (x *GetExchangeRatesRequest) Reset() {
+	*x = GetExchangeRatesRequest{}
+	mi := &file_finance_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentTakeOffChartResponse) String() string {
+func (x *GetExchangeRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentTakeOffChartResponse) ProtoMessage() {}
+func (*GetExchangeRatesRequest) ProtoMessage() {}
 
-func (x *GetAllPaymentTakeOffChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[31]
+func (x *GetExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,41 +7080,40 @@ func (x *GetAllPaymentTakeOffChartResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentTakeOffChartResponse.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentTakeOffChartResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetAllPaymentTakeOffChartResponse) GetChartResponse() []*AbsTakeOfChartResponse {
+func (x *GetExchangeRatesRequest) GetCurrency() string {
 	if x != nil {
-		return x.ChartResponse
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-type AbsTakeOfChartResponse struct {
+type ExchangeRateList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	YearMonth     string                 `protobuf:"bytes,1,opt,name=yearMonth,proto3" json:"yearMonth,omitempty"`
-	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Items         []*ExchangeRate        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsTakeOfChartResponse) Reset() {
-	*x = AbsTakeOfChartResponse{}
-	mi := &file_finance_proto_msgTypes[32]
+func (x *ExchangeRateList) Reset() {
+	*x = ExchangeRateList{}
+	mi := &file_finance_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsTakeOfChartResponse) String() string {
+func (x *ExchangeRateList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsTakeOfChartResponse) ProtoMessage() {}
+func (*ExchangeRateList) ProtoMessage() {}
 
-func (x *AbsTakeOfChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[32]
+func (x *ExchangeRateList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,48 +7124,49 @@ func (x *AbsTakeOfChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsTakeOfChartResponse.ProtoReflect.Descriptor instead.
-func (*AbsTakeOfChartResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *AbsTakeOfChartResponse) GetYearMonth() string {
-	if x != nil {
-		return x.YearMonth
-	}
-	return ""
+// Deprecated: Use ExchangeRateList.ProtoReflect.Descriptor instead.
+func (*ExchangeRateList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *AbsTakeOfChartResponse) GetAmount() string {
+func (x *ExchangeRateList) GetItems() []*ExchangeRate {
 	if x != nil {
-		return x.Amount
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type GetAllPaymentTakeOffRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+type LedgerAccount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ASSET, LIABILITY, EQUITY, INCOME or EXPENSE
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// money on hand, only an ASSET can be
+	IsCash bool `protobuf:"varint,4,opt,name=isCash,proto3" json:"isCash,omitempty"`
+	// part of the chart every company starts with
+	IsSystem bool `protobuf:"varint,5,opt,name=isSystem,proto3" json:"isSystem,omitempty"`
+	// debit less credit of everything posted to it
+	Balance       string `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentTakeOffRequest) Reset() {
-	*x = GetAllPaymentTakeOffRequest{}
-	mi := &file_finance_proto_msgTypes[33]
+func (x *LedgerAccount) Reset() {
+	*x = LedgerAccount{}
+	mi := &file_finance_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentTakeOffRequest) String() string {
+func (x *LedgerAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentTakeOffRequest) ProtoMessage() {}
+func (*LedgerAccount) ProtoMessage() {}
 
-func (x *GetAllPaymentTakeOffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[33]
+func (x *LedgerAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2219,47 +7177,75 @@ func (x *GetAllPaymentTakeOffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentTakeOffRequest.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentTakeOffRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use LedgerAccount.ProtoReflect.Descriptor instead.
+func (*LedgerAccount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetAllPaymentTakeOffRequest) GetFrom() string {
+func (x *LedgerAccount) GetCode() string {
 	if x != nil {
-		return x.From
+		return x.Code
 	}
 	return ""
 }
 
-func (x *GetAllPaymentTakeOffRequest) GetTo() string {
+func (x *LedgerAccount) GetName() string {
 	if x != nil {
-		return x.To
+		return x.Name
 	}
 	return ""
 }
 
-type GetAllPaymentTakeOffResponse struct {
+func (x *LedgerAccount) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LedgerAccount) GetIsCash() bool {
+	if x != nil {
+		return x.IsCash
+	}
+	return false
+}
+
+func (x *LedgerAccount) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *LedgerAccount) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type LedgerAccountList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pennies       []*AbsPaymentTakeOff   `protobuf:"bytes,1,rep,name=pennies,proto3" json:"pennies,omitempty"`
+	Items         []*LedgerAccount       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentTakeOffResponse) Reset() {
-	*x = GetAllPaymentTakeOffResponse{}
-	mi := &file_finance_proto_msgTypes[34]
+func (x *LedgerAccountList) Reset() {
+	*x = LedgerAccountList{}
+	mi := &file_finance_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentTakeOffResponse) String() string {
+func (x *LedgerAccountList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentTakeOffResponse) ProtoMessage() {}
+func (*LedgerAccountList) ProtoMessage() {}
 
-func (x *GetAllPaymentTakeOffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[34]
+func (x *LedgerAccountList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,47 +7256,49 @@ func (x *GetAllPaymentTakeOffResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentTakeOffResponse.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentTakeOffResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use LedgerAccountList.ProtoReflect.Descriptor instead.
+func (*LedgerAccountList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetAllPaymentTakeOffResponse) GetPennies() []*AbsPaymentTakeOff {
+func (x *LedgerAccountList) GetItems() []*LedgerAccount {
 	if x != nil {
-		return x.Pennies
+		return x.Items
 	}
 	return nil
 }
 
-type AbsPaymentTakeOff struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
-	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	StudentName   string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName,omitempty"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
-	CreatorId     string                 `protobuf:"bytes,6,opt,name=creatorId,proto3" json:"creatorId,omitempty"`
-	CreatorName   string                 `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName,omitempty"`
-	Sum           string                 `protobuf:"bytes,8,opt,name=sum,proto3" json:"sum,omitempty"`
+type JournalLine struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountCode string                 `protobuf:"bytes,1,opt,name=accountCode,proto3" json:"accountCode,omitempty"`
+	AccountName string                 `protobuf:"bytes,2,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	// one of debit and credit is above 0, the other is 0
+	Debit         string `protobuf:"bytes,3,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit        string `protobuf:"bytes,4,opt,name=credit,proto3" json:"credit,omitempty"`
+	StudentId     string `protobuf:"bytes,5,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	SponsorId     string `protobuf:"bytes,6,opt,name=sponsorId,proto3" json:"sponsorId,omitempty"`
+	CashAccountId string `protobuf:"bytes,7,opt,name=cashAccountId,proto3" json:"cashAccountId,omitempty"`
+	UserId        string `protobuf:"bytes,8,opt,name=userId,proto3" json:"userId,omitempty"`
+	CategoryId    string `protobuf:"bytes,9,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsPaymentTakeOff) Reset() {
-	*x = AbsPaymentTakeOff{}
-	mi := &file_finance_proto_msgTypes[35]
+func (x *JournalLine) Reset() {
+	*x = JournalLine{}
+	mi := &file_finance_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsPaymentTakeOff) String() string {
+func (x *JournalLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsPaymentTakeOff) ProtoMessage() {}
+func (*JournalLine) ProtoMessage() {}
 
-func (x *AbsPaymentTakeOff) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[35]
+func (x *JournalLine) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2321,90 +7309,108 @@ func (x *AbsPaymentTakeOff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsPaymentTakeOff.ProtoReflect.Descriptor instead.
-func (*AbsPaymentTakeOff) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use JournalLine.ProtoReflect.Descriptor instead.
+func (*JournalLine) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *AbsPaymentTakeOff) GetPaymentId() string {
+func (x *JournalLine) GetAccountCode() string {
 	if x != nil {
-		return x.PaymentId
+		return x.AccountCode
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetGivenDate() string {
+func (x *JournalLine) GetAccountName() string {
 	if x != nil {
-		return x.GivenDate
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetStudentName() string {
+func (x *JournalLine) GetDebit() string {
 	if x != nil {
-		return x.StudentName
+		return x.Debit
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetStudentId() string {
+func (x *JournalLine) GetCredit() string {
+	if x != nil {
+		return x.Credit
+	}
+	return ""
+}
+
+func (x *JournalLine) GetStudentId() string {
 	if x != nil {
 		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetComment() string {
+func (x *JournalLine) GetSponsorId() string {
 	if x != nil {
-		return x.Comment
+		return x.SponsorId
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetCreatorId() string {
+func (x *JournalLine) GetCashAccountId() string {
 	if x != nil {
-		return x.CreatorId
+		return x.CashAccountId
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetCreatorName() string {
+func (x *JournalLine) GetUserId() string {
 	if x != nil {
-		return x.CreatorName
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetSum() string {
+func (x *JournalLine) GetCategoryId() string {
 	if x != nil {
-		return x.Sum
+		return x.CategoryId
 	}
 	return ""
 }
 
-type GetAllPaymentsByMonthRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+type JournalEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date  string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// STUDENT_PAYMENT, SPONSOR_PAYMENT, EXPENSE or MANUAL
+	Source        string         `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	SourceId      string         `protobuf:"bytes,4,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	Description   string         `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ReversalOf    string         `protobuf:"bytes,6,opt,name=reversalOf,proto3" json:"reversalOf,omitempty"`
+	ReversedBy    string         `protobuf:"bytes,7,opt,name=reversedBy,proto3" json:"reversedBy,omitempty"`
+	Lines         []*JournalLine `protobuf:"bytes,8,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedByName string         `protobuf:"bytes,9,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	CreatedAt     string         `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ActionById    string         `protobuf:"bytes,11,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string         `protobuf:"bytes,12,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentsByMonthRequest) Reset() {
-	*x = GetAllPaymentsByMonthRequest{}
-	mi := &file_finance_proto_msgTypes[36]
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	mi := &file_finance_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentsByMonthRequest) String() string {
+func (x *JournalEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentsByMonthRequest) ProtoMessage() {}
+func (*JournalEntry) ProtoMessage() {}
 
-func (x *GetAllPaymentsByMonthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[36]
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,47 +7421,122 @@ func (x *GetAllPaymentsByMonthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentsByMonthRequest.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentsByMonthRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetAllPaymentsByMonthRequest) GetUserId() string {
+func (x *JournalEntry) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetAllPaymentsByMonthRequest) GetMonth() string {
+func (x *JournalEntry) GetDate() string {
 	if x != nil {
-		return x.Month
+		return x.Date
 	}
 	return ""
 }
 
-type GetAllPaymentsByMonthResponse struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	Payments      []*AbsGetAllPaymentsByMonthResponse `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+func (x *JournalEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetReversalOf() string {
+	if x != nil {
+		return x.ReversalOf
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetReversedBy() string {
+	if x != nil {
+		return x.ReversedBy
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetLines() []*JournalLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type ReverseJournalEntryRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason       string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActionById   string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	// YYYY-MM-DD, today by default
+	Date          string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentsByMonthResponse) Reset() {
-	*x = GetAllPaymentsByMonthResponse{}
-	mi := &file_finance_proto_msgTypes[37]
+func (x *ReverseJournalEntryRequest) Reset() {
+	*x = ReverseJournalEntryRequest{}
+	mi := &file_finance_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentsByMonthResponse) String() string {
+func (x *ReverseJournalEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentsByMonthResponse) ProtoMessage() {}
+func (*ReverseJournalEntryRequest) ProtoMessage() {}
 
-func (x *GetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[37]
+func (x *ReverseJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,50 +7547,74 @@ func (x *GetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentsByMonthResponse.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentsByMonthResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ReverseJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*ReverseJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetAllPaymentsByMonthResponse) GetPayments() []*AbsGetAllPaymentsByMonthResponse {
+func (x *ReverseJournalEntryRequest) GetId() string {
 	if x != nil {
-		return x.Payments
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReverseJournalEntryRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReverseJournalEntryRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ReverseJournalEntryRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *ReverseJournalEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-type AbsGetAllPaymentsByMonthResponse struct {
+type GetJournalEntriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GivenDate     string                 `protobuf:"bytes,1,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	PaymentType   string                 `protobuf:"bytes,2,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
-	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	CreatedById   string                 `protobuf:"bytes,5,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
-	CreatedByName string                 `protobuf:"bytes,6,opt,name=created_by_name,json=createdByName,proto3" json:"created_by_name,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PaymentId     string                 `protobuf:"bytes,8,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
-	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	GroupName     string                 `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	Method        string                 `protobuf:"bytes,11,opt,name=method,proto3" json:"method,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	AccountCode   string                 `protobuf:"bytes,4,opt,name=accountCode,proto3" json:"accountCode,omitempty"`
+	StudentId     string                 `protobuf:"bytes,5,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) Reset() {
-	*x = AbsGetAllPaymentsByMonthResponse{}
-	mi := &file_finance_proto_msgTypes[38]
+func (x *GetJournalEntriesRequest) Reset() {
+	*x = GetJournalEntriesRequest{}
+	mi := &file_finance_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) String() string {
+func (x *GetJournalEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetAllPaymentsByMonthResponse) ProtoMessage() {}
+func (*GetJournalEntriesRequest) ProtoMessage() {}
 
-func (x *AbsGetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[38]
+func (x *GetJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,110 +7625,135 @@ func (x *AbsGetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetAllPaymentsByMonthResponse.ProtoReflect.Descriptor instead.
-func (*AbsGetAllPaymentsByMonthResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetGivenDate() string {
+func (x *GetJournalEntriesRequest) GetFrom() string {
 	if x != nil {
-		return x.GivenDate
+		return x.From
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetPaymentType() string {
+func (x *GetJournalEntriesRequest) GetTo() string {
 	if x != nil {
-		return x.PaymentType
+		return x.To
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetAmount() string {
+func (x *GetJournalEntriesRequest) GetSource() string {
 	if x != nil {
-		return x.Amount
+		return x.Source
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetComment() string {
+func (x *GetJournalEntriesRequest) GetAccountCode() string {
 	if x != nil {
-		return x.Comment
+		return x.AccountCode
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedById() string {
+func (x *GetJournalEntriesRequest) GetStudentId() string {
 	if x != nil {
-		return x.CreatedById
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedByName() string {
+func (x *GetJournalEntriesRequest) GetPage() int32 {
 	if x != nil {
-		return x.CreatedByName
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedAt() string {
+func (x *GetJournalEntriesRequest) GetSize() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetPaymentId() string {
-	if x != nil {
-		return x.PaymentId
-	}
-	return ""
+type JournalEntryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items         []*JournalEntry        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetGroupId() string {
+func (x *JournalEntryList) Reset() {
+	*x = JournalEntryList{}
+	mi := &file_finance_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEntryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntryList) ProtoMessage() {}
+
+func (x *JournalEntryList) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[101]
 	if x != nil {
-		return x.GroupId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetGroupName() string {
+// Deprecated: Use JournalEntryList.ProtoReflect.Descriptor instead.
+func (*JournalEntryList) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *JournalEntryList) GetCount() int32 {
 	if x != nil {
-		return x.GroupName
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetMethod() string {
+func (x *JournalEntryList) GetItems() []*JournalEntry {
 	if x != nil {
-		return x.Method
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type GetMonthlyStatusResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	MonthStatus   []*AbsGetMonthlyStatusResponse `protobuf:"bytes,1,rep,name=monthStatus,proto3" json:"monthStatus,omitempty"`
+type TrialBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD, today by default
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonthlyStatusResponse) Reset() {
-	*x = GetMonthlyStatusResponse{}
-	mi := &file_finance_proto_msgTypes[39]
+func (x *TrialBalanceRequest) Reset() {
+	*x = TrialBalanceRequest{}
+	mi := &file_finance_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonthlyStatusResponse) String() string {
+func (x *TrialBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonthlyStatusResponse) ProtoMessage() {}
+func (*TrialBalanceRequest) ProtoMessage() {}
 
-func (x *GetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[39]
+func (x *TrialBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2634,41 +7764,46 @@ func (x *GetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonthlyStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetMonthlyStatusResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use TrialBalanceRequest.ProtoReflect.Descriptor instead.
+func (*TrialBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetMonthlyStatusResponse) GetMonthStatus() []*AbsGetMonthlyStatusResponse {
+func (x *TrialBalanceRequest) GetDate() string {
 	if x != nil {
-		return x.MonthStatus
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-type AbsGetMonthlyStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
-	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+type TrialBalanceRow struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Code   string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type   string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Debit  string                 `protobuf:"bytes,4,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit string                 `protobuf:"bytes,5,opt,name=credit,proto3" json:"credit,omitempty"`
+	// debit less credit
+	Balance       string `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGetMonthlyStatusResponse) Reset() {
-	*x = AbsGetMonthlyStatusResponse{}
-	mi := &file_finance_proto_msgTypes[40]
+func (x *TrialBalanceRow) Reset() {
+	*x = TrialBalanceRow{}
+	mi := &file_finance_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetMonthlyStatusResponse) String() string {
+func (x *TrialBalanceRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetMonthlyStatusResponse) ProtoMessage() {}
+func (*TrialBalanceRow) ProtoMessage() {}
 
-func (x *AbsGetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[40]
+func (x *TrialBalanceRow) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2679,47 +7814,79 @@ func (x *AbsGetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetMonthlyStatusResponse.ProtoReflect.Descriptor instead.
-func (*AbsGetMonthlyStatusResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use TrialBalanceRow.ProtoReflect.Descriptor instead.
+func (*TrialBalanceRow) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *AbsGetMonthlyStatusResponse) GetMonth() string {
+func (x *TrialBalanceRow) GetCode() string {
 	if x != nil {
-		return x.Month
+		return x.Code
 	}
 	return ""
 }
 
-func (x *AbsGetMonthlyStatusResponse) GetBalance() string {
+func (x *TrialBalanceRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrialBalanceRow) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TrialBalanceRow) GetDebit() string {
+	if x != nil {
+		return x.Debit
+	}
+	return ""
+}
+
+func (x *TrialBalanceRow) GetCredit() string {
+	if x != nil {
+		return x.Credit
+	}
+	return ""
+}
+
+func (x *TrialBalanceRow) GetBalance() string {
 	if x != nil {
 		return x.Balance
 	}
 	return ""
 }
 
-type GetMonthlyStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+type TrialBalance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Date  string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Rows  []*TrialBalanceRow     `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	// equal when the ledger is balanced
+	TotalDebit    string `protobuf:"bytes,3,opt,name=totalDebit,proto3" json:"totalDebit,omitempty"`
+	TotalCredit   string `protobuf:"bytes,4,opt,name=totalCredit,proto3" json:"totalCredit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonthlyStatusRequest) Reset() {
-	*x = GetMonthlyStatusRequest{}
-	mi := &file_finance_proto_msgTypes[41]
+func (x *TrialBalance) Reset() {
+	*x = TrialBalance{}
+	mi := &file_finance_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonthlyStatusRequest) String() string {
+func (x *TrialBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonthlyStatusRequest) ProtoMessage() {}
+func (*TrialBalance) ProtoMessage() {}
 
-func (x *GetMonthlyStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[41]
+func (x *TrialBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2730,49 +7897,63 @@ func (x *GetMonthlyStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonthlyStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetMonthlyStatusRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use TrialBalance.ProtoReflect.Descriptor instead.
+func (*TrialBalance) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetMonthlyStatusRequest) GetUserId() string {
+func (x *TrialBalance) GetDate() string {
 	if x != nil {
-		return x.UserId
+		return x.Date
 	}
 	return ""
 }
 
-type PaymentAddRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Comment              string                 `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
-	Date                 string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Method               string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
-	Sum                  string                 `protobuf:"bytes,4,opt,name=sum,proto3" json:"sum,omitempty"`
-	UserId               string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
-	Type                 string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
-	ActionById           string                 `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById,omitempty"`
-	ActionByName         string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
-	GroupId              string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	Studentconditiondate string                 `protobuf:"bytes,10,opt,name=studentconditiondate,proto3" json:"studentconditiondate,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *TrialBalance) GetRows() []*TrialBalanceRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
 }
 
-func (x *PaymentAddRequest) Reset() {
-	*x = PaymentAddRequest{}
-	mi := &file_finance_proto_msgTypes[42]
+func (x *TrialBalance) GetTotalDebit() string {
+	if x != nil {
+		return x.TotalDebit
+	}
+	return ""
+}
+
+func (x *TrialBalance) GetTotalCredit() string {
+	if x != nil {
+		return x.TotalCredit
+	}
+	return ""
+}
+
+type LedgerPeriodRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD, this month by default
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerPeriodRequest) Reset() {
+	*x = LedgerPeriodRequest{}
+	mi := &file_finance_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentAddRequest) String() string {
+func (x *LedgerPeriodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentAddRequest) ProtoMessage() {}
+func (*LedgerPeriodRequest) ProtoMessage() {}
 
-func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[42]
+func (x *LedgerPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2783,111 +7964,114 @@ func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentAddRequest.ProtoReflect.Descriptor instead.
-func (*PaymentAddRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use LedgerPeriodRequest.ProtoReflect.Descriptor instead.
+func (*LedgerPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *PaymentAddRequest) GetComment() string {
+func (x *LedgerPeriodRequest) GetFrom() string {
 	if x != nil {
-		return x.Comment
+		return x.From
 	}
 	return ""
 }
 
-func (x *PaymentAddRequest) GetDate() string {
+func (x *LedgerPeriodRequest) GetTo() string {
 	if x != nil {
-		return x.Date
+		return x.To
 	}
 	return ""
 }
 
-func (x *PaymentAddRequest) GetMethod() string {
-	if x != nil {
-		return x.Method
-	}
-	return ""
+type ProfitAndLossRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentAddRequest) GetSum() string {
-	if x != nil {
-		return x.Sum
-	}
-	return ""
+func (x *ProfitAndLossRow) Reset() {
+	*x = ProfitAndLossRow{}
+	mi := &file_finance_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentAddRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+func (x *ProfitAndLossRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PaymentAddRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
+func (*ProfitAndLossRow) ProtoMessage() {}
 
-func (x *PaymentAddRequest) GetActionById() string {
+func (x *ProfitAndLossRow) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[106]
 	if x != nil {
-		return x.ActionById
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PaymentAddRequest) GetActionByName() string {
-	if x != nil {
-		return x.ActionByName
-	}
-	return ""
+// Deprecated: Use ProfitAndLossRow.ProtoReflect.Descriptor instead.
+func (*ProfitAndLossRow) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *PaymentAddRequest) GetGroupId() string {
+func (x *ProfitAndLossRow) GetCode() string {
 	if x != nil {
-		return x.GroupId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *PaymentAddRequest) GetStudentconditiondate() string {
+func (x *ProfitAndLossRow) GetName() string {
 	if x != nil {
-		return x.Studentconditiondate
+		return x.Name
 	}
 	return ""
 }
 
-type PaymentUpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Debit         string                 `protobuf:"bytes,1,opt,name=debit,proto3" json:"debit,omitempty"`
-	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId,omitempty"`
-	PaymentId     string                 `protobuf:"bytes,6,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
-	ActionById    string                 `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById,omitempty"`
-	ActionByName  string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
-	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId,omitempty"`
+func (x *ProfitAndLossRow) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type ProfitAndLoss struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	From         string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To           string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Income       []*ProfitAndLossRow    `protobuf:"bytes,3,rep,name=income,proto3" json:"income,omitempty"`
+	Expenses     []*ProfitAndLossRow    `protobuf:"bytes,4,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	TotalIncome  string                 `protobuf:"bytes,5,opt,name=totalIncome,proto3" json:"totalIncome,omitempty"`
+	TotalExpense string                 `protobuf:"bytes,6,opt,name=totalExpense,proto3" json:"totalExpense,omitempty"`
+	// totalIncome - totalExpense
+	NetProfit     string `protobuf:"bytes,7,opt,name=netProfit,proto3" json:"netProfit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentUpdateRequest) Reset() {
-	*x = PaymentUpdateRequest{}
-	mi := &file_finance_proto_msgTypes[43]
+func (x *ProfitAndLoss) Reset() {
+	*x = ProfitAndLoss{}
+	mi := &file_finance_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentUpdateRequest) String() string {
+func (x *ProfitAndLoss) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentUpdateRequest) ProtoMessage() {}
+func (*ProfitAndLoss) ProtoMessage() {}
 
-func (x *PaymentUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[43]
+func (x *ProfitAndLoss) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2898,98 +8082,88 @@ func (x *PaymentUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentUpdateRequest.ProtoReflect.Descriptor instead.
-func (*PaymentUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *PaymentUpdateRequest) GetDebit() string {
-	if x != nil {
-		return x.Debit
-	}
-	return ""
-}
-
-func (x *PaymentUpdateRequest) GetMethod() string {
-	if x != nil {
-		return x.Method
-	}
-	return ""
+// Deprecated: Use ProfitAndLoss.ProtoReflect.Descriptor instead.
+func (*ProfitAndLoss) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *PaymentUpdateRequest) GetDate() string {
+func (x *ProfitAndLoss) GetFrom() string {
 	if x != nil {
-		return x.Date
+		return x.From
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetComment() string {
+func (x *ProfitAndLoss) GetTo() string {
 	if x != nil {
-		return x.Comment
+		return x.To
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetUserId() string {
+func (x *ProfitAndLoss) GetIncome() []*ProfitAndLossRow {
 	if x != nil {
-		return x.UserId
+		return x.Income
 	}
-	return ""
+	return nil
 }
 
-func (x *PaymentUpdateRequest) GetPaymentId() string {
+func (x *ProfitAndLoss) GetExpenses() []*ProfitAndLossRow {
 	if x != nil {
-		return x.PaymentId
+		return x.Expenses
 	}
-	return ""
+	return nil
 }
 
-func (x *PaymentUpdateRequest) GetActionById() string {
+func (x *ProfitAndLoss) GetTotalIncome() string {
 	if x != nil {
-		return x.ActionById
+		return x.TotalIncome
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetActionByName() string {
+func (x *ProfitAndLoss) GetTotalExpense() string {
 	if x != nil {
-		return x.ActionByName
+		return x.TotalExpense
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetGroupId() string {
+func (x *ProfitAndLoss) GetNetProfit() string {
 	if x != nil {
-		return x.GroupId
+		return x.NetProfit
 	}
 	return ""
 }
 
-type PaymentReturnRequest struct {
+type CashFlowAccount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
-	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
-	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	LedgerCode    string                 `protobuf:"bytes,1,opt,name=ledgerCode,proto3" json:"ledgerCode,omitempty"`
+	CashAccountId string                 `protobuf:"bytes,2,opt,name=cashAccountId,proto3" json:"cashAccountId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Opening       string                 `protobuf:"bytes,4,opt,name=opening,proto3" json:"opening,omitempty"`
+	Inflow        string                 `protobuf:"bytes,5,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Outflow       string                 `protobuf:"bytes,6,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	Closing       string                 `protobuf:"bytes,7,opt,name=closing,proto3" json:"closing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentReturnRequest) Reset() {
-	*x = PaymentReturnRequest{}
-	mi := &file_finance_proto_msgTypes[44]
+func (x *CashFlowAccount) Reset() {
+	*x = CashFlowAccount{}
+	mi := &file_finance_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentReturnRequest) String() string {
+func (x *CashFlowAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentReturnRequest) ProtoMessage() {}
+func (*CashFlowAccount) ProtoMessage() {}
 
-func (x *PaymentReturnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[44]
+func (x *CashFlowAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3000,101 +8174,87 @@ func (x *PaymentReturnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentReturnRequest.ProtoReflect.Descriptor instead.
-func (*PaymentReturnRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use CashFlowAccount.ProtoReflect.Descriptor instead.
+func (*CashFlowAccount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *PaymentReturnRequest) GetPaymentId() string {
+func (x *CashFlowAccount) GetLedgerCode() string {
 	if x != nil {
-		return x.PaymentId
+		return x.LedgerCode
 	}
 	return ""
 }
 
-func (x *PaymentReturnRequest) GetActionById() string {
+func (x *CashFlowAccount) GetCashAccountId() string {
 	if x != nil {
-		return x.ActionById
+		return x.CashAccountId
 	}
 	return ""
 }
 
-func (x *PaymentReturnRequest) GetActionByName() string {
+func (x *CashFlowAccount) GetName() string {
 	if x != nil {
-		return x.ActionByName
+		return x.Name
 	}
 	return ""
 }
 
-type GetTeachersSalaryRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Salaries      []*AbsGetTeachersSalary `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetTeachersSalaryRequest) Reset() {
-	*x = GetTeachersSalaryRequest{}
-	mi := &file_finance_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetTeachersSalaryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CashFlowAccount) GetOpening() string {
+	if x != nil {
+		return x.Opening
+	}
+	return ""
 }
 
-func (*GetTeachersSalaryRequest) ProtoMessage() {}
-
-func (x *GetTeachersSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[45]
+func (x *CashFlowAccount) GetInflow() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Inflow
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetTeachersSalaryRequest.ProtoReflect.Descriptor instead.
-func (*GetTeachersSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{45}
+func (x *CashFlowAccount) GetOutflow() string {
+	if x != nil {
+		return x.Outflow
+	}
+	return ""
 }
 
-func (x *GetTeachersSalaryRequest) GetSalaries() []*AbsGetTeachersSalary {
+func (x *CashFlowAccount) GetClosing() string {
 	if x != nil {
-		return x.Salaries
+		return x.Closing
 	}
-	return nil
+	return ""
 }
 
-type AbsGetTeachersSalary struct {
+// money that came from or went to an account other than cash
+type CashFlowActivity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	TeacherName   string                 `protobuf:"bytes,4,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Inflow        string                 `protobuf:"bytes,3,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Outflow       string                 `protobuf:"bytes,4,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	Net           string                 `protobuf:"bytes,5,opt,name=net,proto3" json:"net,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGetTeachersSalary) Reset() {
-	*x = AbsGetTeachersSalary{}
-	mi := &file_finance_proto_msgTypes[46]
+func (x *CashFlowActivity) Reset() {
+	*x = CashFlowActivity{}
+	mi := &file_finance_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetTeachersSalary) String() string {
+func (x *CashFlowActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetTeachersSalary) ProtoMessage() {}
+func (*CashFlowActivity) ProtoMessage() {}
 
-func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[46]
+func (x *CashFlowActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3105,61 +8265,75 @@ func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetTeachersSalary.ProtoReflect.Descriptor instead.
-func (*AbsGetTeachersSalary) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use CashFlowActivity.ProtoReflect.Descriptor instead.
+func (*CashFlowActivity) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *AbsGetTeachersSalary) GetTeacherId() string {
+func (x *CashFlowActivity) GetCode() string {
 	if x != nil {
-		return x.TeacherId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *AbsGetTeachersSalary) GetType() string {
+func (x *CashFlowActivity) GetName() string {
 	if x != nil {
-		return x.Type
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AbsGetTeachersSalary) GetAmount() int32 {
+func (x *CashFlowActivity) GetInflow() string {
 	if x != nil {
-		return x.Amount
+		return x.Inflow
 	}
-	return 0
+	return ""
 }
 
-func (x *AbsGetTeachersSalary) GetTeacherName() string {
+func (x *CashFlowActivity) GetOutflow() string {
 	if x != nil {
-		return x.TeacherName
+		return x.Outflow
 	}
 	return ""
 }
 
-type DeleteTeacherSalaryRequest struct {
+func (x *CashFlowActivity) GetNet() string {
+	if x != nil {
+		return x.Net
+	}
+	return ""
+}
+
+type CashFlow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Accounts      []*CashFlowAccount     `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Activities    []*CashFlowActivity    `protobuf:"bytes,4,rep,name=activities,proto3" json:"activities,omitempty"`
+	Opening       string                 `protobuf:"bytes,5,opt,name=opening,proto3" json:"opening,omitempty"`
+	Inflow        string                 `protobuf:"bytes,6,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Outflow       string                 `protobuf:"bytes,7,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	Closing       string                 `protobuf:"bytes,8,opt,name=closing,proto3" json:"closing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeacherSalaryRequest) Reset() {
-	*x = DeleteTeacherSalaryRequest{}
-	mi := &file_finance_proto_msgTypes[47]
+func (x *CashFlow) Reset() {
+	*x = CashFlow{}
+	mi := &file_finance_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeacherSalaryRequest) String() string {
+func (x *CashFlow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeacherSalaryRequest) ProtoMessage() {}
+func (*CashFlow) ProtoMessage() {}
 
-func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[47]
+func (x *CashFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3170,76 +8344,65 @@ func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use CashFlow.ProtoReflect.Descriptor instead.
+func (*CashFlow) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *DeleteTeacherSalaryRequest) GetTeacherId() string {
+func (x *CashFlow) GetFrom() string {
 	if x != nil {
-		return x.TeacherId
+		return x.From
 	}
 	return ""
 }
 
-type CreateTeacherSalaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateTeacherSalaryRequest) Reset() {
-	*x = CreateTeacherSalaryRequest{}
-	mi := &file_finance_proto_msgTypes[48]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CashFlow) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
 }
 
-func (x *CreateTeacherSalaryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CashFlow) GetAccounts() []*CashFlowAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
 }
 
-func (*CreateTeacherSalaryRequest) ProtoMessage() {}
-
-func (x *CreateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[48]
+func (x *CashFlow) GetActivities() []*CashFlowActivity {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Activities
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*CreateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{48}
+func (x *CashFlow) GetOpening() string {
+	if x != nil {
+		return x.Opening
+	}
+	return ""
 }
 
-func (x *CreateTeacherSalaryRequest) GetTeacherId() string {
+func (x *CashFlow) GetInflow() string {
 	if x != nil {
-		return x.TeacherId
+		return x.Inflow
 	}
 	return ""
 }
 
-func (x *CreateTeacherSalaryRequest) GetType() string {
+func (x *CashFlow) GetOutflow() string {
 	if x != nil {
-		return x.Type
+		return x.Outflow
 	}
 	return ""
 }
 
-func (x *CreateTeacherSalaryRequest) GetAmount() int32 {
+func (x *CashFlow) GetClosing() string {
 	if x != nil {
-		return x.Amount
+		return x.Closing
 	}
-	return 0
+	return ""
 }
 
 var File_finance_proto protoreflect.FileDescriptor
@@ -3247,18 +8410,70 @@ var File_finance_proto protoreflect.FileDescriptor
 const file_finance_proto_rawDesc = "" +
 	"\n" +
 	"\rfinance.proto\x12\afinance\x1a\fcommon.proto\x1a\n" +
-	"user.proto\x1a\x1bgoogle/protobuf/empty.proto\"v\n" +
+	"user.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd0\x01\n" +
 	"\x1eGetDiscountByStudentIdResponse\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\tR\x06amount\x12\x16\n" +
 	"\x06isHave\x18\x02 \x01(\bR\x06isHave\x12$\n" +
-	"\rdiscountOwner\x18\x03 \x01(\tR\rdiscountOwner\"W\n" +
+	"\rdiscountOwner\x18\x03 \x01(\tR\rdiscountOwner\x12$\n" +
+	"\rteacherAmount\x18\x04 \x01(\tR\rteacherAmount\x122\n" +
+	"\aapplied\x18\x05 \x03(\v2\x18.finance.AppliedDiscountR\aapplied\"\xfd\x01\n" +
 	"\x1dGetDiscountByStudentIdRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
-	"\agroupId\x18\x02 \x01(\tR\agroupId\"9\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12 \n" +
+	"\vcoursePrice\x18\x04 \x01(\tR\vcoursePrice\x12\x1a\n" +
+	"\bsiblings\x18\x05 \x01(\x05R\bsiblings\x12\"\n" +
+	"\factiveGroups\x18\x06 \x01(\x05R\factiveGroups\x12\x18\n" +
+	"\aprepaid\x18\a \x01(\bR\aprepaid\x12\x14\n" +
+	"\x05apply\x18\b \x01(\bR\x05apply\"\xc1\x01\n" +
+	"\x0fAppliedDiscount\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
+	"\fdiscountType\x18\x04 \x01(\tR\fdiscountType\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12 \n" +
+	"\vwithTeacher\x18\a \x01(\bR\vwithTeacher\"\x80\x02\n" +
+	"\fDiscountRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bruleType\x18\x03 \x01(\tR\bruleType\x12\"\n" +
+	"\fdiscountType\x18\x04 \x01(\tR\fdiscountType\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x1a\n" +
+	"\bminCount\x18\x06 \x01(\x05R\bminCount\x12 \n" +
+	"\vwithTeacher\x18\a \x01(\bR\vwithTeacher\x12\x1a\n" +
+	"\bisActive\x18\b \x01(\bR\bisActive\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\"?\n" +
+	"\x10DiscountRuleList\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.finance.DiscountRuleR\x05items\"L\n" +
+	"\x0eDiscountPolicy\x12\x1a\n" +
+	"\bstacking\x18\x01 \x01(\tR\bstacking\x12\x1e\n" +
+	"\n" +
+	"maxPercent\x18\x02 \x01(\x01R\n" +
+	"maxPercent\"\x90\x01\n" +
+	"\x1aGetRuleApplicationsRequest\x12\x16\n" +
+	"\x06ruleId\x18\x01 \x01(\tR\x06ruleId\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\tR\x05month\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x05R\x04size\"\xf5\x01\n" +
+	"\x0fRuleApplication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06ruleId\x18\x02 \x01(\tR\x06ruleId\x12\x1a\n" +
+	"\bruleName\x18\x03 \x01(\tR\bruleName\x12\x1a\n" +
+	"\bruleType\x18\x04 \x01(\tR\bruleType\x12\x1c\n" +
+	"\tstudentId\x18\x05 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x06 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05month\x18\a \x01(\tR\x05month\x12\x16\n" +
+	"\x06amount\x18\b \x01(\tR\x06amount\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\"[\n" +
+	"\x13RuleApplicationList\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12.\n" +
+	"\x05items\x18\x02 \x03(\v2\x18.finance.RuleApplicationR\x05items\"9\n" +
 	"\x19GetHistoryDiscountRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\"W\n" +
 	"\x1aGetHistoryDiscountResponse\x129\n" +
-	"\tdiscounts\x18\x01 \x03(\v2\x1b.finance.AbsHistoryDiscountR\tdiscounts\"\xdc\x02\n" +
+	"\tdiscounts\x18\x01 \x03(\v2\x1b.finance.AbsHistoryDiscountR\tdiscounts\"\xa0\x03\n" +
 	"\x12AbsHistoryDiscount\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tgroupName\x18\n" +
@@ -3271,7 +8486,11 @@ const file_finance_proto_rawDesc = "" +
 	"\aendDate\x18\x06 \x01(\tR\aendDate\x12 \n" +
 	"\vwithTeacher\x18\a \x01(\bR\vwithTeacher\x12\x16\n" +
 	"\x06action\x18\b \x01(\tR\x06action\x12\x1c\n" +
-	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\"\xe6\x01\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\"\n" +
+	"\fdiscountType\x18\f \x01(\tR\fdiscountType\x12\x1e\n" +
+	"\n" +
+	"discountId\x18\r \x01(\tR\n" +
+	"discountId\"\x9a\x02\n" +
 	"\x12AbsDiscountRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12$\n" +
@@ -3279,11 +8498,13 @@ const file_finance_proto_rawDesc = "" +
 	"\acomment\x18\x04 \x01(\tR\acomment\x12\x1c\n" +
 	"\tstartDate\x18\x05 \x01(\tR\tstartDate\x12\x18\n" +
 	"\aendDate\x18\x06 \x01(\tR\aendDate\x12 \n" +
-	"\vwithTeacher\x18\a \x01(\bR\vwithTeacher\"9\n" +
+	"\vwithTeacher\x18\a \x01(\bR\vwithTeacher\x12\x0e\n" +
+	"\x02id\x18\b \x01(\tR\x02id\x12\"\n" +
+	"\fdiscountType\x18\t \x01(\tR\fdiscountType\"9\n" +
 	"\x1dGetInformationDiscountRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\"[\n" +
 	"\x1eGetInformationDiscountResponse\x129\n" +
-	"\tdiscounts\x18\x01 \x03(\v2\x1b.finance.AbsStudentDiscountR\tdiscounts\"\xa6\x02\n" +
+	"\tdiscounts\x18\x01 \x03(\v2\x1b.finance.AbsStudentDiscountR\tdiscounts\"\xda\x02\n" +
 	"\x12AbsStudentDiscount\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
 	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12.\n" +
@@ -3293,7 +8514,10 @@ const file_finance_proto_rawDesc = "" +
 	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\x12\x18\n" +
 	"\astartAt\x18\a \x01(\tR\astartAt\x12\x14\n" +
 	"\x05endAt\x18\b \x01(\tR\x05endAt\x12 \n" +
-	"\vwithTeacher\x18\t \x01(\bR\vwithTeacher\"?\n" +
+	"\vwithTeacher\x18\t \x01(\bR\vwithTeacher\x12\x0e\n" +
+	"\x02id\x18\n" +
+	" \x01(\tR\x02id\x12\"\n" +
+	"\fdiscountType\x18\v \x01(\tR\fdiscountType\"?\n" +
 	"\x15CreateCategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04desc\x18\x02 \x01(\tR\x04desc\"M\n" +
@@ -3322,7 +8546,7 @@ const file_finance_proto_rawDesc = "" +
 	"\apageReq\x18\x04 \x01(\v2\x13.common.PageRequestR\apageReq\"v\n" +
 	"\x15GetAllExpenseResponse\x12&\n" +
 	"\x0etotalPageCount\x18\x01 \x01(\x05R\x0etotalPageCount\x125\n" +
-	"\bexpenses\x18\x02 \x03(\v2\x19.finance.GetAllExpenseAbsR\bexpenses\"\xe0\x02\n" +
+	"\bexpenses\x18\x02 \x03(\v2\x19.finance.GetAllExpenseAbsR\bexpenses\"\x82\x04\n" +
 	"\x10GetAllExpenseAbs\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tgivenDate\x18\x02 \x01(\tR\tgivenDate\x120\n" +
@@ -3334,7 +8558,12 @@ const file_finance_proto_rawDesc = "" +
 	"\vpaymentType\x18\b \x01(\tR\vpaymentType\x12\x1c\n" +
 	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\x14\n" +
 	"\x05title\x18\n" +
-	" \x01(\tR\x05title\"\xfe\x01\n" +
+	" \x01(\tR\x05title\x12\x1c\n" +
+	"\taccountId\x18\v \x01(\tR\taccountId\x12 \n" +
+	"\vaccountName\x18\f \x01(\tR\vaccountName\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrency\x12 \n" +
+	"\voriginalSum\x18\x0e \x01(\tR\voriginalSum\x12\"\n" +
+	"\fexchangeRate\x18\x0f \x01(\x01R\fexchangeRate\"\x9c\x02\n" +
 	"\x14CreateExpenseRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
 	"\tgivenDate\x18\x02 \x01(\tR\tgivenDate\x12 \n" +
@@ -3345,18 +8574,24 @@ const file_finance_proto_rawDesc = "" +
 	"\x06userId\x18\x05 \x01(\tR\x06userId\x12\x10\n" +
 	"\x03sum\x18\x06 \x01(\tR\x03sum\x12 \n" +
 	"\vcreatedById\x18\a \x01(\tR\vcreatedById\x12$\n" +
-	"\rpaymentMethod\x18\b \x01(\tR\rpaymentMethod\";\n" +
+	"\rpaymentMethod\x18\b \x01(\tR\rpaymentMethod\x12\x1c\n" +
+	"\taccountId\x18\t \x01(\tR\taccountId\";\n" +
 	"\x15GetIncomeChartRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\"M\n" +
 	"\x16GetIncomeChartResponse\x123\n" +
-	"\bresponse\x18\x01 \x03(\v2\x17.finance.AbsIncomeChartR\bresponse\"P\n" +
+	"\bresponse\x18\x01 \x03(\v2\x17.finance.AbsIncomeChartR\bresponse\"\x82\x01\n" +
 	"\x0eAbsIncomeChart\x12$\n" +
 	"\rspecificMonth\x18\x01 \x01(\tR\rspecificMonth\x12\x18\n" +
-	"\abalance\x18\x02 \x01(\tR\abalance\"p\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12\x14\n" +
+	"\x05gross\x18\x03 \x01(\tR\x05gross\x12\x1a\n" +
+	"\breversed\x18\x04 \x01(\tR\breversed\"\x8e\x02\n" +
 	"\x1cGetCommonInformationResponse\x12\"\n" +
 	"\fdebtorsCount\x18\x01 \x01(\x05R\fdebtorsCount\x12,\n" +
-	"\x11payInCurrentMonth\x18\x02 \x01(\x05R\x11payInCurrentMonth\"\xa7\x01\n" +
+	"\x11payInCurrentMonth\x18\x02 \x01(\x05R\x11payInCurrentMonth\x122\n" +
+	"\x14incomeInCurrentMonth\x18\x03 \x01(\tR\x14incomeInCurrentMonth\x124\n" +
+	"\x15expenseInCurrentMonth\x18\x04 \x01(\tR\x15expenseInCurrentMonth\x122\n" +
+	"\x14profitInCurrentMonth\x18\x05 \x01(\tR\x14profitInCurrentMonth\"\xa7\x01\n" +
 	"\x12GetAllDebtsRequest\x121\n" +
 	"\tpageParam\x18\x01 \x01(\v2\x13.common.PageRequestR\tpageParam\x12\x12\n" +
 	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
@@ -3364,10 +8599,11 @@ const file_finance_proto_rawDesc = "" +
 	"\n" +
 	"amountFrom\x18\x04 \x01(\x03R\n" +
 	"amountFrom\x12\x1a\n" +
-	"\bamountTo\x18\x05 \x01(\x03R\bamountTo\"|\n" +
+	"\bamountTo\x18\x05 \x01(\x03R\bamountTo\"\xbe\x01\n" +
 	"\x1eGetAllDebtsInformationResponse\x12&\n" +
 	"\x0etotalPageCount\x18\x02 \x01(\x05R\x0etotalPageCount\x122\n" +
-	"\x05debts\x18\x01 \x03(\v2\x1c.finance.AbsDebtsInformationR\x05debts\"\x93\x02\n" +
+	"\x05debts\x18\x01 \x03(\v2\x1c.finance.AbsDebtsInformationR\x05debts\x12@\n" +
+	"\fsponsorDebts\x18\x03 \x03(\v2\x1c.finance.AbsDebtsInformationR\fsponsorDebts\"\xa9\x03\n" +
 	"\x13AbsDebtsInformation\x12\x1a\n" +
 	"\bdebtorId\x18\x01 \x01(\tR\bdebtorId\x12\x1e\n" +
 	"\n" +
@@ -3377,7 +8613,12 @@ const file_finance_proto_rawDesc = "" +
 	"\abalance\x18\x04 \x01(\tR\abalance\x12$\n" +
 	"\rtotalOnPeriod\x18\x05 \x01(\tR\rtotalOnPeriod\x12+\n" +
 	"\x06groups\x18\x06 \x03(\v2\x13.common.DebtorGroupR\x06groups\x121\n" +
-	"\bcomments\x18\a \x03(\v2\x15.common.DebtorCommentR\bcomments\"\xcf\x01\n" +
+	"\bcomments\x18\a \x03(\v2\x15.common.DebtorCommentR\bcomments\x12\x1a\n" +
+	"\bdebtType\x18\b \x01(\tR\bdebtType\x12$\n" +
+	"\roverdueAmount\x18\t \x01(\tR\roverdueAmount\x120\n" +
+	"\x13overdueInstallments\x18\n" +
+	" \x01(\x05R\x13overdueInstallments\x12 \n" +
+	"\vnextDueDate\x18\v \x01(\tR\vnextDueDate\"\xcf\x01\n" +
 	"\"GetAllStudentPaymentsChartResponse\x12\x12\n" +
 	"\x04cash\x18\x01 \x01(\tR\x04cash\x12\x14\n" +
 	"\x05payme\x18\x02 \x01(\tR\x05payme\x12\x14\n" +
@@ -3396,9 +8637,12 @@ const file_finance_proto_rawDesc = "" +
 	"\x05value\x18\x03 \x01(\tR\x05value\"2\n" +
 	"\x06SortBy\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\"X\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"\x9c\x01\n" +
 	"\x1dGetAllStudentPaymentsResponse\x127\n" +
-	"\bpayments\x18\x01 \x03(\v2\x1b.finance.AbsStudentPaymentsR\bpayments\"\xfc\x01\n" +
+	"\bpayments\x18\x01 \x03(\v2\x1b.finance.AbsStudentPaymentsR\bpayments\x12\x14\n" +
+	"\x05gross\x18\x02 \x01(\tR\x05gross\x12\x1a\n" +
+	"\breversed\x18\x03 \x01(\tR\breversed\x12\x10\n" +
+	"\x03net\x18\x04 \x01(\tR\x03net\"\xbc\x03\n" +
 	"\x12AbsStudentPayments\x12\x1c\n" +
 	"\tgivenDate\x18\x01 \x01(\tR\tgivenDate\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12 \n" +
@@ -3407,7 +8651,18 @@ const file_finance_proto_rawDesc = "" +
 	"\x06method\x18\x05 \x01(\tR\x06method\x12\x18\n" +
 	"\acomment\x18\x06 \x01(\tR\acomment\x12 \n" +
 	"\vcreatorName\x18\a \x01(\tR\vcreatorName\x12\x1c\n" +
-	"\tcreatorId\x18\b \x01(\tR\tcreatorId\"j\n" +
+	"\tcreatorId\x18\b \x01(\tR\tcreatorId\x12\x1c\n" +
+	"\tpaymentId\x18\t \x01(\tR\tpaymentId\x12\x1e\n" +
+	"\n" +
+	"reversalOf\x18\n" +
+	" \x01(\tR\n" +
+	"reversalOf\x12\x1e\n" +
+	"\n" +
+	"isReversed\x18\v \x01(\bR\n" +
+	"isReversed\x12\x1c\n" +
+	"\taccountId\x18\f \x01(\tR\taccountId\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrency\x12&\n" +
+	"\x0eoriginalAmount\x18\x0e \x01(\tR\x0eoriginalAmount\"j\n" +
 	"!GetAllPaymentTakeOffChartResponse\x12E\n" +
 	"\rchartResponse\x18\x01 \x03(\v2\x1f.finance.AbsTakeOfChartResponseR\rchartResponse\"N\n" +
 	"\x16AbsTakeOfChartResponse\x12\x1c\n" +
@@ -3431,7 +8686,7 @@ const file_finance_proto_rawDesc = "" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\tR\x05month\"f\n" +
 	"\x1dGetAllPaymentsByMonthResponse\x12E\n" +
-	"\bpayments\x18\x01 \x03(\v2).finance.AbsGetAllPaymentsByMonthResponseR\bpayments\"\xee\x02\n" +
+	"\bpayments\x18\x01 \x03(\v2).finance.AbsGetAllPaymentsByMonthResponseR\bpayments\"\xfe\x04\n" +
 	" AbsGetAllPaymentsByMonthResponse\x12\x1c\n" +
 	"\tgivenDate\x18\x01 \x01(\tR\tgivenDate\x12!\n" +
 	"\fpayment_type\x18\x02 \x01(\tR\vpaymentType\x12\x16\n" +
@@ -3445,14 +8700,52 @@ const file_finance_proto_rawDesc = "" +
 	"\agroupId\x18\t \x01(\tR\agroupId\x12\x1c\n" +
 	"\tgroupName\x18\n" +
 	" \x01(\tR\tgroupName\x12\x16\n" +
-	"\x06method\x18\v \x01(\tR\x06method\"b\n" +
+	"\x06method\x18\v \x01(\tR\x06method\x12\x1e\n" +
+	"\n" +
+	"reversalOf\x18\f \x01(\tR\n" +
+	"reversalOf\x12\x1e\n" +
+	"\n" +
+	"isReversed\x18\r \x01(\bR\n" +
+	"isReversed\x12&\n" +
+	"\x0ereversalReason\x18\x0e \x01(\tR\x0ereversalReason\x12\x1c\n" +
+	"\taccountId\x18\x0f \x01(\tR\taccountId\x12 \n" +
+	"\vaccountName\x18\x10 \x01(\tR\vaccountName\x12\x1a\n" +
+	"\bcurrency\x18\x11 \x01(\tR\bcurrency\x12&\n" +
+	"\x0eoriginalAmount\x18\x12 \x01(\tR\x0eoriginalAmount\x12\"\n" +
+	"\fexchangeRate\x18\x13 \x01(\x01R\fexchangeRate\"b\n" +
 	"\x18GetMonthlyStatusResponse\x12F\n" +
 	"\vmonthStatus\x18\x01 \x03(\v2$.finance.AbsGetMonthlyStatusResponseR\vmonthStatus\"M\n" +
 	"\x1bAbsGetMonthlyStatusResponse\x12\x14\n" +
 	"\x05month\x18\x01 \x01(\tR\x05month\x12\x18\n" +
 	"\abalance\x18\x02 \x01(\tR\abalance\"1\n" +
 	"\x17GetMonthlyStatusRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xa9\x02\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"i\n" +
+	"\x13MergeStudentRequest\x12(\n" +
+	"\x0fsourceStudentId\x18\x01 \x01(\tR\x0fsourceStudentId\x12(\n" +
+	"\x0ftargetStudentId\x18\x02 \x01(\tR\x0ftargetStudentId\"\xb2\x01\n" +
+	"\fBalanceEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x1c\n" +
+	"\teventType\x18\x03 \x01(\tR\teventType\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12\x1c\n" +
+	"\tlastError\x18\x05 \x01(\tR\tlastError\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\"a\n" +
+	"\x10BalanceEventList\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.finance.BalanceEventR\x06events\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\"-\n" +
+	"\x19RetryBalanceEventsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"6\n" +
+	"\x1aRetryBalanceEventsResponse\x12\x18\n" +
+	"\aretried\x18\x01 \x01(\x05R\aretried\"!\n" +
+	"\x1fGetStudentLedgerBalancesRequest\"z\n" +
+	"\x14StudentLedgerBalance\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12*\n" +
+	"\x10hasPendingEvents\x18\x03 \x01(\bR\x10hasPendingEvents\"]\n" +
+	" GetStudentLedgerBalancesResponse\x129\n" +
+	"\bbalances\x18\x01 \x03(\v2\x1d.finance.StudentLedgerBalanceR\bbalances\"\x9d\x03\n" +
 	"\x11PaymentAddRequest\x12\x18\n" +
 	"\acomment\x18\x01 \x01(\tR\acomment\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
@@ -3466,7 +8759,10 @@ const file_finance_proto_rawDesc = "" +
 	"\factionByName\x18\b \x01(\tR\factionByName\x12\x18\n" +
 	"\agroupId\x18\t \x01(\tR\agroupId\x122\n" +
 	"\x14studentconditiondate\x18\n" +
-	" \x01(\tR\x14studentconditiondate\"\x86\x02\n" +
+	" \x01(\tR\x14studentconditiondate\x12\x1c\n" +
+	"\tsponsored\x18\v \x01(\bR\tsponsored\x12\x1c\n" +
+	"\taccountId\x18\f \x01(\tR\taccountId\x126\n" +
+	"\tdiscounts\x18\r \x03(\v2\x18.finance.AppliedDiscountR\tdiscounts\"\xbc\x02\n" +
 	"\x14PaymentUpdateRequest\x12\x14\n" +
 	"\x05debit\x18\x01 \x01(\tR\x05debit\x12\x16\n" +
 	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
@@ -3478,32 +8774,381 @@ const file_finance_proto_rawDesc = "" +
 	"actionById\x18\a \x01(\tR\n" +
 	"actionById\x12\"\n" +
 	"\factionByName\x18\b \x01(\tR\factionByName\x12\x18\n" +
-	"\agroupId\x18\t \x01(\tR\agroupId\"x\n" +
+	"\agroupId\x18\t \x01(\tR\agroupId\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x1c\n" +
+	"\taccountId\x18\v \x01(\tR\taccountId\"\x90\x01\n" +
 	"\x14PaymentReturnRequest\x12\x1c\n" +
 	"\tpaymentId\x18\x01 \x01(\tR\tpaymentId\x12\x1e\n" +
 	"\n" +
 	"actionById\x18\x02 \x01(\tR\n" +
 	"actionById\x12\"\n" +
-	"\factionByName\x18\x03 \x01(\tR\factionByName\"U\n" +
+	"\factionByName\x18\x03 \x01(\tR\factionByName\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"U\n" +
 	"\x18GetTeachersSalaryRequest\x129\n" +
 	"\bsalaries\x18\x01 \x03(\v2\x1d.finance.AbsGetTeachersSalaryR\bsalaries\"\x82\x01\n" +
 	"\x14AbsGetTeachersSalary\x12\x1c\n" +
 	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12 \n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12 \n" +
 	"\vteacherName\x18\x04 \x01(\tR\vteacherName\":\n" +
 	"\x1aDeleteTeacherSalaryRequest\x12\x1c\n" +
 	"\tteacherId\x18\x01 \x01(\tR\tteacherId\"f\n" +
 	"\x1aCreateTeacherSalaryRequest\x12\x1c\n" +
 	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x05R\x06amount2\xd1\x03\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\x87\x02\n" +
+	"\aSponsor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vphoneNumber\x18\x03 \x01(\tR\vphoneNumber\x12$\n" +
+	"\rcontactPerson\x18\x04 \x01(\tR\rcontactPerson\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1a\n" +
+	"\bisActive\x18\x06 \x01(\bR\bisActive\x12\x18\n" +
+	"\abalance\x18\a \x01(\tR\abalance\x12\"\n" +
+	"\fstudentCount\x18\b \x01(\x05R\fstudentCount\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\"5\n" +
+	"\vSponsorList\x12&\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.finance.SponsorR\x05items\"\xd5\x02\n" +
+	"\vSponsorship\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tsponsorId\x18\x02 \x01(\tR\tsponsorId\x12 \n" +
+	"\vsponsorName\x18\x03 \x01(\tR\vsponsorName\x12\x1c\n" +
+	"\tstudentId\x18\x04 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x05 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x06 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tcoverType\x18\a \x01(\tR\tcoverType\x12\x14\n" +
+	"\x05value\x18\b \x01(\tR\x05value\x12\x18\n" +
+	"\astartAt\x18\t \x01(\tR\astartAt\x12\x14\n" +
+	"\x05endAt\x18\n" +
+	" \x01(\tR\x05endAt\x12\x1a\n" +
+	"\bisActive\x18\v \x01(\bR\bisActive\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\tR\tcreatedAt\"=\n" +
+	"\x15EndSponsorshipRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05endAt\x18\x02 \x01(\tR\x05endAt\"T\n" +
+	"\x16GetSponsorshipsRequest\x12\x1c\n" +
+	"\tsponsorId\x18\x01 \x01(\tR\tsponsorId\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"=\n" +
+	"\x0fSponsorshipList\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.finance.SponsorshipR\x05items\"\xf5\x01\n" +
+	"\x15SponsorPaymentRequest\x12\x1c\n" +
+	"\tsponsorId\x18\x01 \x01(\tR\tsponsorId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x06 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\a \x01(\tR\factionByName\x12\x1c\n" +
+	"\taccountId\x18\b \x01(\tR\taccountId\"\x89\x01\n" +
+	"\x1bSponsorPaymentReturnRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x04 \x01(\tR\factionByName\"[\n" +
+	"\x17SponsorStatementRequest\x12\x1c\n" +
+	"\tsponsorId\x18\x01 \x01(\tR\tsponsorId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\x93\x02\n" +
+	"\x15SponsorStatementEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tstudentId\x18\x04 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x05 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x06 \x01(\tR\agroupId\x12\x16\n" +
+	"\x06method\x18\a \x01(\tR\x06method\x12\x16\n" +
+	"\x06amount\x18\b \x01(\tR\x06amount\x12\x18\n" +
+	"\acomment\x18\t \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"reversalOf\x18\n" +
+	" \x01(\tR\n" +
+	"reversalOf\"s\n" +
+	"\x17SponsorStatementStudent\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12\x18\n" +
+	"\acovered\x18\x03 \x01(\tR\acovered\"\xd8\x02\n" +
+	"\x10SponsorStatement\x12*\n" +
+	"\asponsor\x18\x01 \x01(\v2\x10.finance.SponsorR\asponsor\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12&\n" +
+	"\x0eopeningBalance\x18\x04 \x01(\tR\x0eopeningBalance\x12\x12\n" +
+	"\x04paid\x18\x05 \x01(\tR\x04paid\x12\x18\n" +
+	"\acovered\x18\x06 \x01(\tR\acovered\x12&\n" +
+	"\x0eclosingBalance\x18\a \x01(\tR\x0eclosingBalance\x128\n" +
+	"\aentries\x18\b \x03(\v2\x1e.finance.SponsorStatementEntryR\aentries\x12<\n" +
+	"\bstudents\x18\t \x03(\v2 .finance.SponsorStatementStudentR\bstudents\"\xd5\x03\n" +
+	"\x18CreatePaymentPlanRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\tR\x05total\x12\"\n" +
+	"\finstallments\x18\x04 \x01(\x05R\finstallments\x12\"\n" +
+	"\ffirstDueDate\x18\x05 \x01(\tR\ffirstDueDate\x12&\n" +
+	"\x0eintervalMonths\x18\x06 \x01(\x05R\x0eintervalMonths\x129\n" +
+	"\bschedule\x18\a \x03(\v2\x1d.finance.ScheduledInstallmentR\bschedule\x12 \n" +
+	"\vlateFeeType\x18\b \x01(\tR\vlateFeeType\x12\"\n" +
+	"\flateFeeValue\x18\t \x01(\tR\flateFeeValue\x12\x1c\n" +
+	"\tgraceDays\x18\n" +
+	" \x01(\x05R\tgraceDays\x12\x18\n" +
+	"\acomment\x18\v \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\f \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\r \x01(\tR\factionByName\"H\n" +
+	"\x14ScheduledInstallment\x12\x18\n" +
+	"\adueDate\x18\x01 \x01(\tR\adueDate\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"<\n" +
+	"\x12PaymentPlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\":\n" +
+	"\x1aStudentPaymentPlansRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\"\xa2\x03\n" +
+	"\vPaymentPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12 \n" +
+	"\vlateFeeType\x18\x04 \x01(\tR\vlateFeeType\x12\"\n" +
+	"\flateFeeValue\x18\x05 \x01(\tR\flateFeeValue\x12\x1c\n" +
+	"\tgraceDays\x18\x06 \x01(\x05R\tgraceDays\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\x12\x1c\n" +
+	"\tcreatedBy\x18\b \x01(\tR\tcreatedBy\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\"\n" +
+	"\fcancelReason\x18\n" +
+	" \x01(\tR\fcancelReason\x123\n" +
+	"\asummary\x18\v \x01(\v2\x19.common.PaymentPlanStatusR\asummary\x128\n" +
+	"\finstallments\x18\f \x03(\v2\x14.finance.InstallmentR\finstallments\"\xfe\x01\n" +
+	"\vInstallment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x18\n" +
+	"\adueDate\x18\x03 \x01(\tR\adueDate\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x18\n" +
+	"\alateFee\x18\x05 \x01(\tR\alateFee\x12\x12\n" +
+	"\x04paid\x18\x06 \x01(\tR\x04paid\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x16\n" +
+	"\x06paidAt\x18\b \x01(\tR\x06paidAt\x127\n" +
+	"\bpayments\x18\t \x03(\v2\x1b.finance.InstallmentPaymentR\bpayments\"^\n" +
+	"\x12InstallmentPayment\x12\x1c\n" +
+	"\tpaymentId\x18\x01 \x01(\tR\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"G\n" +
+	"\x1dGetOverdueInstallmentsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"\xac\x02\n" +
+	"\x12OverdueInstallment\x12\x16\n" +
+	"\x06planId\x18\x01 \x01(\tR\x06planId\x12$\n" +
+	"\rinstallmentId\x18\x02 \x01(\tR\rinstallmentId\x12\x1c\n" +
+	"\tstudentId\x18\x03 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x04 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x05 \x01(\tR\agroupId\x12\x16\n" +
+	"\x06number\x18\x06 \x01(\x05R\x06number\x12\x18\n" +
+	"\adueDate\x18\a \x01(\tR\adueDate\x12 \n" +
+	"\vdaysOverdue\x18\b \x01(\x05R\vdaysOverdue\x12\x10\n" +
+	"\x03due\x18\t \x01(\tR\x03due\x12\x18\n" +
+	"\alateFee\x18\n" +
+	" \x01(\tR\alateFee\"a\n" +
+	"\x16OverdueInstallmentList\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x121\n" +
+	"\x05items\x18\x02 \x03(\v2\x1b.finance.OverdueInstallmentR\x05items\"\xb3\x02\n" +
+	"\vCashAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x1c\n" +
+	"\tisDefault\x18\x06 \x01(\bR\tisDefault\x12$\n" +
+	"\rrequiresShift\x18\a \x01(\bR\rrequiresShift\x12\x1a\n" +
+	"\bisActive\x18\b \x01(\bR\bisActive\x12\x18\n" +
+	"\abalance\x18\t \x01(\tR\abalance\x12 \n" +
+	"\vopenShiftId\x18\n" +
+	" \x01(\tR\vopenShiftId\x12\x1c\n" +
+	"\tcreatedAt\x18\v \x01(\tR\tcreatedAt\"=\n" +
+	"\x0fCashAccountList\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.finance.CashAccountR\x05items\"\xa8\x01\n" +
+	"\x10CashShiftRequest\x12\x1c\n" +
+	"\taccountId\x18\x01 \x01(\tR\taccountId\x12\x18\n" +
+	"\acounted\x18\x02 \x01(\tR\acounted\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x05 \x01(\tR\factionByName\"\xd9\x03\n" +
+	"\tCashShift\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\tR\taccountId\x12 \n" +
+	"\vaccountName\x18\x03 \x01(\tR\vaccountName\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\bopenedAt\x18\x06 \x01(\tR\bopenedAt\x12\"\n" +
+	"\fopenedByName\x18\a \x01(\tR\fopenedByName\x12&\n" +
+	"\x0eopeningCounted\x18\b \x01(\tR\x0eopeningCounted\x12\x16\n" +
+	"\x06inflow\x18\t \x01(\tR\x06inflow\x12\x18\n" +
+	"\aoutflow\x18\n" +
+	" \x01(\tR\aoutflow\x12\x1a\n" +
+	"\bexpected\x18\v \x01(\tR\bexpected\x12\x18\n" +
+	"\acounted\x18\f \x01(\tR\acounted\x12\x1e\n" +
+	"\n" +
+	"difference\x18\r \x01(\tR\n" +
+	"difference\x12\x1a\n" +
+	"\bclosedAt\x18\x0e \x01(\tR\bclosedAt\x12\"\n" +
+	"\fclosedByName\x18\x0f \x01(\tR\fclosedByName\x12\x18\n" +
+	"\acomment\x18\x10 \x01(\tR\acomment\"\\\n" +
+	"\x14GetCashShiftsRequest\x12\x1c\n" +
+	"\taccountId\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"O\n" +
+	"\rCashShiftList\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.finance.CashShiftR\x05items\"\xea\x01\n" +
+	"\fExchangeRate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12$\n" +
+	"\rcreatedByName\x18\x05 \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\a \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\b \x01(\tR\factionByName\"5\n" +
+	"\x17GetExchangeRatesRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"?\n" +
+	"\x10ExchangeRateList\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.finance.ExchangeRateR\x05items\"\x99\x01\n" +
+	"\rLedgerAccount\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06isCash\x18\x04 \x01(\bR\x06isCash\x12\x1a\n" +
+	"\bisSystem\x18\x05 \x01(\bR\bisSystem\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\tR\abalance\"A\n" +
+	"\x11LedgerAccountList\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.finance.LedgerAccountR\x05items\"\x99\x02\n" +
+	"\vJournalLine\x12 \n" +
+	"\vaccountCode\x18\x01 \x01(\tR\vaccountCode\x12 \n" +
+	"\vaccountName\x18\x02 \x01(\tR\vaccountName\x12\x14\n" +
+	"\x05debit\x18\x03 \x01(\tR\x05debit\x12\x16\n" +
+	"\x06credit\x18\x04 \x01(\tR\x06credit\x12\x1c\n" +
+	"\tstudentId\x18\x05 \x01(\tR\tstudentId\x12\x1c\n" +
+	"\tsponsorId\x18\x06 \x01(\tR\tsponsorId\x12$\n" +
+	"\rcashAccountId\x18\a \x01(\tR\rcashAccountId\x12\x16\n" +
+	"\x06userId\x18\b \x01(\tR\x06userId\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\t \x01(\tR\n" +
+	"categoryId\"\xfc\x02\n" +
+	"\fJournalEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1a\n" +
+	"\bsourceId\x18\x04 \x01(\tR\bsourceId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1e\n" +
+	"\n" +
+	"reversalOf\x18\x06 \x01(\tR\n" +
+	"reversalOf\x12\x1e\n" +
+	"\n" +
+	"reversedBy\x18\a \x01(\tR\n" +
+	"reversedBy\x12*\n" +
+	"\x05lines\x18\b \x03(\v2\x14.finance.JournalLineR\x05lines\x12$\n" +
+	"\rcreatedByName\x18\t \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\v \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\f \x01(\tR\factionByName\"\x9c\x01\n" +
+	"\x1aReverseJournalEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x04 \x01(\tR\factionByName\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\"\xbe\x01\n" +
+	"\x18GetJournalEntriesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12 \n" +
+	"\vaccountCode\x18\x04 \x01(\tR\vaccountCode\x12\x1c\n" +
+	"\tstudentId\x18\x05 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\a \x01(\x05R\x04size\"U\n" +
+	"\x10JournalEntryList\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12+\n" +
+	"\x05items\x18\x02 \x03(\v2\x15.finance.JournalEntryR\x05items\")\n" +
+	"\x13TrialBalanceRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\x95\x01\n" +
+	"\x0fTrialBalanceRow\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05debit\x18\x04 \x01(\tR\x05debit\x12\x16\n" +
+	"\x06credit\x18\x05 \x01(\tR\x06credit\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\tR\abalance\"\x92\x01\n" +
+	"\fTrialBalance\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12,\n" +
+	"\x04rows\x18\x02 \x03(\v2\x18.finance.TrialBalanceRowR\x04rows\x12\x1e\n" +
+	"\n" +
+	"totalDebit\x18\x03 \x01(\tR\n" +
+	"totalDebit\x12 \n" +
+	"\vtotalCredit\x18\x04 \x01(\tR\vtotalCredit\"9\n" +
+	"\x13LedgerPeriodRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"R\n" +
+	"\x10ProfitAndLossRow\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\x81\x02\n" +
+	"\rProfitAndLoss\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x121\n" +
+	"\x06income\x18\x03 \x03(\v2\x19.finance.ProfitAndLossRowR\x06income\x125\n" +
+	"\bexpenses\x18\x04 \x03(\v2\x19.finance.ProfitAndLossRowR\bexpenses\x12 \n" +
+	"\vtotalIncome\x18\x05 \x01(\tR\vtotalIncome\x12\"\n" +
+	"\ftotalExpense\x18\x06 \x01(\tR\ftotalExpense\x12\x1c\n" +
+	"\tnetProfit\x18\a \x01(\tR\tnetProfit\"\xd1\x01\n" +
+	"\x0fCashFlowAccount\x12\x1e\n" +
+	"\n" +
+	"ledgerCode\x18\x01 \x01(\tR\n" +
+	"ledgerCode\x12$\n" +
+	"\rcashAccountId\x18\x02 \x01(\tR\rcashAccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aopening\x18\x04 \x01(\tR\aopening\x12\x16\n" +
+	"\x06inflow\x18\x05 \x01(\tR\x06inflow\x12\x18\n" +
+	"\aoutflow\x18\x06 \x01(\tR\aoutflow\x12\x18\n" +
+	"\aclosing\x18\a \x01(\tR\aclosing\"~\n" +
+	"\x10CashFlowActivity\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06inflow\x18\x03 \x01(\tR\x06inflow\x12\x18\n" +
+	"\aoutflow\x18\x04 \x01(\tR\aoutflow\x12\x10\n" +
+	"\x03net\x18\x05 \x01(\tR\x03net\"\x85\x02\n" +
+	"\bCashFlow\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x124\n" +
+	"\baccounts\x18\x03 \x03(\v2\x18.finance.CashFlowAccountR\baccounts\x129\n" +
+	"\n" +
+	"activities\x18\x04 \x03(\v2\x19.finance.CashFlowActivityR\n" +
+	"activities\x12\x18\n" +
+	"\aopening\x18\x05 \x01(\tR\aopening\x12\x16\n" +
+	"\x06inflow\x18\x06 \x01(\tR\x06inflow\x12\x18\n" +
+	"\aoutflow\x18\a \x01(\tR\aoutflow\x12\x18\n" +
+	"\aclosing\x18\b \x01(\tR\aclosing2\xcc\a\n" +
 	"\x0fDiscountService\x12l\n" +
 	"\x19GetAllInformationDiscount\x12&.finance.GetInformationDiscountRequest\x1a'.finance.GetInformationDiscountResponse\x12B\n" +
 	"\x0eCreateDiscount\x12\x1b.finance.AbsDiscountRequest\x1a\x13.common.AbsResponse\x12B\n" +
 	"\x0eDeleteDiscount\x12\x1b.finance.AbsDiscountRequest\x1a\x13.common.AbsResponse\x12]\n" +
 	"\x12GetHistoryDiscount\x12\".finance.GetHistoryDiscountRequest\x1a#.finance.GetHistoryDiscountResponse\x12i\n" +
-	"\x16GetDiscountByStudentId\x12&.finance.GetDiscountByStudentIdRequest\x1a'.finance.GetDiscountByStudentIdResponse2\xe3\x01\n" +
+	"\x16GetDiscountByStudentId\x12&.finance.GetDiscountByStudentIdRequest\x1a'.finance.GetDiscountByStudentIdResponse\x12B\n" +
+	"\x12CreateDiscountRule\x12\x15.finance.DiscountRule\x1a\x15.finance.DiscountRule\x12B\n" +
+	"\x12UpdateDiscountRule\x12\x15.finance.DiscountRule\x1a\x15.finance.DiscountRule\x12C\n" +
+	"\x12DeleteDiscountRule\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12E\n" +
+	"\x10GetDiscountRules\x12\x16.google.protobuf.Empty\x1a\x19.finance.DiscountRuleList\x12D\n" +
+	"\x11GetDiscountPolicy\x12\x16.google.protobuf.Empty\x1a\x17.finance.DiscountPolicy\x12E\n" +
+	"\x11SetDiscountPolicy\x12\x17.finance.DiscountPolicy\x1a\x17.finance.DiscountPolicy\x12X\n" +
+	"\x13GetRuleApplications\x12#.finance.GetRuleApplicationsRequest\x1a\x1c.finance.RuleApplicationList2\xe3\x01\n" +
 	"\x0fCategoryService\x12E\n" +
 	"\x0eCreateCategory\x12\x1e.finance.CreateCategoryRequest\x1a\x13.common.AbsResponse\x12?\n" +
 	"\x0eDeleteCategory\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12H\n" +
@@ -3512,10 +9157,12 @@ const file_finance_proto_rawDesc = "" +
 	"\rCreateExpense\x12\x1d.finance.CreateExpenseRequest\x1a\x13.common.AbsResponse\x12>\n" +
 	"\rDeleteExpense\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12N\n" +
 	"\rGetAllExpense\x12\x1d.finance.GetAllExpenseRequest\x1a\x1e.finance.GetAllExpenseResponse\x12c\n" +
-	"\x14GetAllExpenseDiagram\x12$.finance.GetAllExpenseDiagramRequest\x1a%.finance.GetAllExpenseDiagramResponse2\xd9\b\n" +
+	"\x14GetAllExpenseDiagram\x12$.finance.GetAllExpenseDiagramRequest\x1a%.finance.GetAllExpenseDiagramResponse2\x80\f\n" +
 	"\x0ePaymentService\x12=\n" +
 	"\n" +
-	"PaymentAdd\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse\x12C\n" +
+	"PaymentAdd\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse\x12A\n" +
+	"\fMergeStudent\x12\x1c.finance.MergeStudentRequest\x1a\x13.common.AbsResponse\x12o\n" +
+	"\x18GetStudentLedgerBalances\x12(.finance.GetStudentLedgerBalancesRequest\x1a).finance.GetStudentLedgerBalancesResponse\x12C\n" +
 	"\rPaymentReturn\x12\x1d.finance.PaymentReturnRequest\x1a\x13.common.AbsResponse\x12C\n" +
 	"\rPaymentUpdate\x12\x1d.finance.PaymentUpdateRequest\x1a\x13.common.AbsResponse\x12W\n" +
 	"\x10GetMonthlyStatus\x12 .finance.GetMonthlyStatusRequest\x1a!.finance.GetMonthlyStatusResponse\x12f\n" +
@@ -3523,15 +9170,54 @@ const file_finance_proto_rawDesc = "" +
 	"\x14GetAllPaymentTakeOff\x12$.finance.GetAllPaymentTakeOffRequest\x1a%.finance.GetAllPaymentTakeOffResponse\x12m\n" +
 	"\x19GetAllPaymentTakeOffChart\x12$.finance.GetAllPaymentTakeOffRequest\x1a*.finance.GetAllPaymentTakeOffChartResponse\x12f\n" +
 	"\x15GetAllStudentPayments\x12%.finance.GetAllStudentPaymentsRequest\x1a&.finance.GetAllStudentPaymentsResponse\x12p\n" +
-	"\x1aGetAllStudentPaymentsChart\x12%.finance.GetAllStudentPaymentsRequest\x1a+.finance.GetAllStudentPaymentsChartResponse\x12^\n" +
+	"\x1aGetAllStudentPaymentsChart\x12%.finance.GetAllStudentPaymentsRequest\x1a+.finance.GetAllStudentPaymentsChartResponse\x12E\n" +
+	"\x0eGetTenantUsage\x12\x1a.common.TenantUsageRequest\x1a\x17.common.TenantUsageList\x12^\n" +
 	"\x16GetAllDebtsInformation\x12\x1b.finance.GetAllDebtsRequest\x1a'.finance.GetAllDebtsInformationResponse\x12\\\n" +
 	"\x1bGetCommonFinanceInformation\x12\x16.google.protobuf.Empty\x1a%.finance.GetCommonInformationResponse\x12Q\n" +
-	"\x0eGetIncomeChart\x12\x1e.finance.GetIncomeChartRequest\x1a\x1f.finance.GetIncomeChartResponse2\xea\x02\n" +
+	"\x0eGetIncomeChart\x12\x1e.finance.GetIncomeChartRequest\x1a\x1f.finance.GetIncomeChartResponse\x12K\n" +
+	"\x16GetFailedBalanceEvents\x12\x16.google.protobuf.Empty\x1a\x19.finance.BalanceEventList\x12]\n" +
+	"\x12RetryBalanceEvents\x12\".finance.RetryBalanceEventsRequest\x1a#.finance.RetryBalanceEventsResponse2\xea\x02\n" +
 	"\x14TeacherSalaryService\x12O\n" +
 	"\x13CreateTeacherSalary\x12#.finance.CreateTeacherSalaryRequest\x1a\x13.common.AbsResponse\x12O\n" +
 	"\x13DeleteTeacherSalary\x12#.finance.DeleteTeacherSalaryRequest\x1a\x13.common.AbsResponse\x12M\n" +
 	"\x10GetTeacherSalary\x12\x16.google.protobuf.Empty\x1a!.finance.GetTeachersSalaryRequest\x12a\n" +
-	"\x1bGetTeacherSalaryByTeacherID\x12#.finance.DeleteTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalaryB\n" +
+	"\x1bGetTeacherSalaryByTeacherID\x12#.finance.DeleteTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalary2\xbc\x05\n" +
+	"\x0eSponsorService\x123\n" +
+	"\rCreateSponsor\x12\x10.finance.Sponsor\x1a\x10.finance.Sponsor\x123\n" +
+	"\rUpdateSponsor\x12\x10.finance.Sponsor\x1a\x10.finance.Sponsor\x12>\n" +
+	"\rDeleteSponsor\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12;\n" +
+	"\vGetSponsors\x12\x16.google.protobuf.Empty\x1a\x14.finance.SponsorList\x12<\n" +
+	"\x0eAddSponsorship\x12\x14.finance.Sponsorship\x1a\x14.finance.Sponsorship\x12F\n" +
+	"\x0eEndSponsorship\x12\x1e.finance.EndSponsorshipRequest\x1a\x14.finance.Sponsorship\x12L\n" +
+	"\x0fGetSponsorships\x12\x1f.finance.GetSponsorshipsRequest\x1a\x18.finance.SponsorshipList\x12H\n" +
+	"\x11SponsorPaymentAdd\x12\x1e.finance.SponsorPaymentRequest\x1a\x13.common.AbsResponse\x12Q\n" +
+	"\x14SponsorPaymentReturn\x12$.finance.SponsorPaymentReturnRequest\x1a\x13.common.AbsResponse\x12R\n" +
+	"\x13GetSponsorStatement\x12 .finance.SponsorStatementRequest\x1a\x19.finance.SponsorStatement2\xff\x03\n" +
+	"\x12PaymentPlanService\x12M\n" +
+	"\x12PreviewPaymentPlan\x12!.finance.CreatePaymentPlanRequest\x1a\x14.finance.PaymentPlan\x12L\n" +
+	"\x11CreatePaymentPlan\x12!.finance.CreatePaymentPlanRequest\x1a\x14.finance.PaymentPlan\x12C\n" +
+	"\x0eGetPaymentPlan\x12\x1b.finance.PaymentPlanRequest\x1a\x14.finance.PaymentPlan\x12F\n" +
+	"\x11CancelPaymentPlan\x12\x1b.finance.PaymentPlanRequest\x1a\x14.finance.PaymentPlan\x12\\\n" +
+	"\x16GetStudentPaymentPlans\x12#.finance.StudentPaymentPlansRequest\x1a\x1d.common.PaymentPlanStatusList\x12a\n" +
+	"\x16GetOverdueInstallments\x12&.finance.GetOverdueInstallmentsRequest\x1a\x1f.finance.OverdueInstallmentList2\xaf\x04\n" +
+	"\vCashService\x12?\n" +
+	"\x11CreateCashAccount\x12\x14.finance.CashAccount\x1a\x14.finance.CashAccount\x12?\n" +
+	"\x11UpdateCashAccount\x12\x14.finance.CashAccount\x1a\x14.finance.CashAccount\x12C\n" +
+	"\x0fGetCashAccounts\x12\x16.google.protobuf.Empty\x1a\x18.finance.CashAccountList\x12>\n" +
+	"\rOpenCashShift\x12\x19.finance.CashShiftRequest\x1a\x12.finance.CashShift\x12?\n" +
+	"\x0eCloseCashShift\x12\x19.finance.CashShiftRequest\x1a\x12.finance.CashShift\x12F\n" +
+	"\rGetCashShifts\x12\x1d.finance.GetCashShiftsRequest\x1a\x16.finance.CashShiftList\x12?\n" +
+	"\x0fSetExchangeRate\x12\x15.finance.ExchangeRate\x1a\x15.finance.ExchangeRate\x12O\n" +
+	"\x10GetExchangeRates\x12 .finance.GetExchangeRatesRequest\x1a\x19.finance.ExchangeRateList2\xdb\x04\n" +
+	"\rLedgerService\x12G\n" +
+	"\x11GetLedgerAccounts\x12\x16.google.protobuf.Empty\x1a\x1a.finance.LedgerAccountList\x12E\n" +
+	"\x13CreateLedgerAccount\x12\x16.finance.LedgerAccount\x1a\x16.finance.LedgerAccount\x12B\n" +
+	"\x12CreateJournalEntry\x12\x15.finance.JournalEntry\x1a\x15.finance.JournalEntry\x12Q\n" +
+	"\x13ReverseJournalEntry\x12#.finance.ReverseJournalEntryRequest\x1a\x15.finance.JournalEntry\x12Q\n" +
+	"\x11GetJournalEntries\x12!.finance.GetJournalEntriesRequest\x1a\x19.finance.JournalEntryList\x12F\n" +
+	"\x0fGetTrialBalance\x12\x1c.finance.TrialBalanceRequest\x1a\x15.finance.TrialBalance\x12H\n" +
+	"\x10GetProfitAndLoss\x12\x1c.finance.LedgerPeriodRequest\x1a\x16.finance.ProfitAndLoss\x12>\n" +
+	"\vGetCashFlow\x12\x1c.finance.LedgerPeriodRequest\x1a\x11.finance.CashFlowB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -3546,150 +9232,332 @@ func file_finance_proto_rawDescGZIP() []byte {
 	return file_finance_proto_rawDescData
 }
 
-var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
 var file_finance_proto_goTypes = []any{
 	(*GetDiscountByStudentIdResponse)(nil),     // 0: finance.GetDiscountByStudentIdResponse
 	(*GetDiscountByStudentIdRequest)(nil),      // 1: finance.GetDiscountByStudentIdRequest
-	(*GetHistoryDiscountRequest)(nil),          // 2: finance.GetHistoryDiscountRequest
-	(*GetHistoryDiscountResponse)(nil),         // 3: finance.GetHistoryDiscountResponse
-	(*AbsHistoryDiscount)(nil),                 // 4: finance.AbsHistoryDiscount
-	(*AbsDiscountRequest)(nil),                 // 5: finance.AbsDiscountRequest
-	(*GetInformationDiscountRequest)(nil),      // 6: finance.GetInformationDiscountRequest
-	(*GetInformationDiscountResponse)(nil),     // 7: finance.GetInformationDiscountResponse
-	(*AbsStudentDiscount)(nil),                 // 8: finance.AbsStudentDiscount
-	(*CreateCategoryRequest)(nil),              // 9: finance.CreateCategoryRequest
-	(*GetAllCategoryRequest)(nil),              // 10: finance.GetAllCategoryRequest
-	(*AbsCategory)(nil),                        // 11: finance.AbsCategory
-	(*GetAllExpenseDiagramResponse)(nil),       // 12: finance.GetAllExpenseDiagramResponse
-	(*GetAllExpenseDiagramRequest)(nil),        // 13: finance.GetAllExpenseDiagramRequest
-	(*GetAllExpenseRequest)(nil),               // 14: finance.GetAllExpenseRequest
-	(*GetAllExpenseResponse)(nil),              // 15: finance.GetAllExpenseResponse
-	(*GetAllExpenseAbs)(nil),                   // 16: finance.GetAllExpenseAbs
-	(*CreateExpenseRequest)(nil),               // 17: finance.CreateExpenseRequest
-	(*GetIncomeChartRequest)(nil),              // 18: finance.GetIncomeChartRequest
-	(*GetIncomeChartResponse)(nil),             // 19: finance.GetIncomeChartResponse
-	(*AbsIncomeChart)(nil),                     // 20: finance.AbsIncomeChart
-	(*GetCommonInformationResponse)(nil),       // 21: finance.GetCommonInformationResponse
-	(*GetAllDebtsRequest)(nil),                 // 22: finance.GetAllDebtsRequest
-	(*GetAllDebtsInformationResponse)(nil),     // 23: finance.GetAllDebtsInformationResponse
-	(*AbsDebtsInformation)(nil),                // 24: finance.AbsDebtsInformation
-	(*GetAllStudentPaymentsChartResponse)(nil), // 25: finance.GetAllStudentPaymentsChartResponse
-	(*GetAllStudentPaymentsRequest)(nil),       // 26: finance.GetAllStudentPaymentsRequest
-	(*Filters)(nil),                            // 27: finance.Filters
-	(*SortBy)(nil),                             // 28: finance.SortBy
-	(*GetAllStudentPaymentsResponse)(nil),      // 29: finance.GetAllStudentPaymentsResponse
-	(*AbsStudentPayments)(nil),                 // 30: finance.AbsStudentPayments
-	(*GetAllPaymentTakeOffChartResponse)(nil),  // 31: finance.GetAllPaymentTakeOffChartResponse
-	(*AbsTakeOfChartResponse)(nil),             // 32: finance.AbsTakeOfChartResponse
-	(*GetAllPaymentTakeOffRequest)(nil),        // 33: finance.GetAllPaymentTakeOffRequest
-	(*GetAllPaymentTakeOffResponse)(nil),       // 34: finance.GetAllPaymentTakeOffResponse
-	(*AbsPaymentTakeOff)(nil),                  // 35: finance.AbsPaymentTakeOff
-	(*GetAllPaymentsByMonthRequest)(nil),       // 36: finance.GetAllPaymentsByMonthRequest
-	(*GetAllPaymentsByMonthResponse)(nil),      // 37: finance.GetAllPaymentsByMonthResponse
-	(*AbsGetAllPaymentsByMonthResponse)(nil),   // 38: finance.AbsGetAllPaymentsByMonthResponse
-	(*GetMonthlyStatusResponse)(nil),           // 39: finance.GetMonthlyStatusResponse
-	(*AbsGetMonthlyStatusResponse)(nil),        // 40: finance.AbsGetMonthlyStatusResponse
-	(*GetMonthlyStatusRequest)(nil),            // 41: finance.GetMonthlyStatusRequest
-	(*PaymentAddRequest)(nil),                  // 42: finance.PaymentAddRequest
-	(*PaymentUpdateRequest)(nil),               // 43: finance.PaymentUpdateRequest
-	(*PaymentReturnRequest)(nil),               // 44: finance.PaymentReturnRequest
-	(*GetTeachersSalaryRequest)(nil),           // 45: finance.GetTeachersSalaryRequest
-	(*AbsGetTeachersSalary)(nil),               // 46: finance.AbsGetTeachersSalary
-	(*DeleteTeacherSalaryRequest)(nil),         // 47: finance.DeleteTeacherSalaryRequest
-	(*CreateTeacherSalaryRequest)(nil),         // 48: finance.CreateTeacherSalaryRequest
-	(*PageRequest)(nil),                        // 49: common.PageRequest
-	(*GetUserByIdResponse)(nil),                // 50: user.GetUserByIdResponse
-	(*DebtorGroup)(nil),                        // 51: common.DebtorGroup
-	(*DebtorComment)(nil),                      // 52: common.DebtorComment
-	(*DeleteAbsRequest)(nil),                   // 53: common.DeleteAbsRequest
-	(*emptypb.Empty)(nil),                      // 54: google.protobuf.Empty
-	(*AbsResponse)(nil),                        // 55: common.AbsResponse
+	(*AppliedDiscount)(nil),                    // 2: finance.AppliedDiscount
+	(*DiscountRule)(nil),                       // 3: finance.DiscountRule
+	(*DiscountRuleList)(nil),                   // 4: finance.DiscountRuleList
+	(*DiscountPolicy)(nil),                     // 5: finance.DiscountPolicy
+	(*GetRuleApplicationsRequest)(nil),         // 6: finance.GetRuleApplicationsRequest
+	(*RuleApplication)(nil),                    // 7: finance.RuleApplication
+	(*RuleApplicationList)(nil),                // 8: finance.RuleApplicationList
+	(*GetHistoryDiscountRequest)(nil),          // 9: finance.GetHistoryDiscountRequest
+	(*GetHistoryDiscountResponse)(nil),         // 10: finance.GetHistoryDiscountResponse
+	(*AbsHistoryDiscount)(nil),                 // 11: finance.AbsHistoryDiscount
+	(*AbsDiscountRequest)(nil),                 // 12: finance.AbsDiscountRequest
+	(*GetInformationDiscountRequest)(nil),      // 13: finance.GetInformationDiscountRequest
+	(*GetInformationDiscountResponse)(nil),     // 14: finance.GetInformationDiscountResponse
+	(*AbsStudentDiscount)(nil),                 // 15: finance.AbsStudentDiscount
+	(*CreateCategoryRequest)(nil),              // 16: finance.CreateCategoryRequest
+	(*GetAllCategoryRequest)(nil),              // 17: finance.GetAllCategoryRequest
+	(*AbsCategory)(nil),                        // 18: finance.AbsCategory
+	(*GetAllExpenseDiagramResponse)(nil),       // 19: finance.GetAllExpenseDiagramResponse
+	(*GetAllExpenseDiagramRequest)(nil),        // 20: finance.GetAllExpenseDiagramRequest
+	(*GetAllExpenseRequest)(nil),               // 21: finance.GetAllExpenseRequest
+	(*GetAllExpenseResponse)(nil),              // 22: finance.GetAllExpenseResponse
+	(*GetAllExpenseAbs)(nil),                   // 23: finance.GetAllExpenseAbs
+	(*CreateExpenseRequest)(nil),               // 24: finance.CreateExpenseRequest
+	(*GetIncomeChartRequest)(nil),              // 25: finance.GetIncomeChartRequest
+	(*GetIncomeChartResponse)(nil),             // 26: finance.GetIncomeChartResponse
+	(*AbsIncomeChart)(nil),                     // 27: finance.AbsIncomeChart
+	(*GetCommonInformationResponse)(nil),       // 28: finance.GetCommonInformationResponse
+	(*GetAllDebtsRequest)(nil),                 // 29: finance.GetAllDebtsRequest
+	(*GetAllDebtsInformationResponse)(nil),     // 30: finance.GetAllDebtsInformationResponse
+	(*AbsDebtsInformation)(nil),                // 31: finance.AbsDebtsInformation
+	(*GetAllStudentPaymentsChartResponse)(nil), // 32: finance.GetAllStudentPaymentsChartResponse
+	(*GetAllStudentPaymentsRequest)(nil),       // 33: finance.GetAllStudentPaymentsRequest
+	(*Filters)(nil),                            // 34: finance.Filters
+	(*SortBy)(nil),                             // 35: finance.SortBy
+	(*GetAllStudentPaymentsResponse)(nil),      // 36: finance.GetAllStudentPaymentsResponse
+	(*AbsStudentPayments)(nil),                 // 37: finance.AbsStudentPayments
+	(*GetAllPaymentTakeOffChartResponse)(nil),  // 38: finance.GetAllPaymentTakeOffChartResponse
+	(*AbsTakeOfChartResponse)(nil),             // 39: finance.AbsTakeOfChartResponse
+	(*GetAllPaymentTakeOffRequest)(nil),        // 40: finance.GetAllPaymentTakeOffRequest
+	(*GetAllPaymentTakeOffResponse)(nil),       // 41: finance.GetAllPaymentTakeOffResponse
+	(*AbsPaymentTakeOff)(nil),                  // 42: finance.AbsPaymentTakeOff
+	(*GetAllPaymentsByMonthRequest)(nil),       // 43: finance.GetAllPaymentsByMonthRequest
+	(*GetAllPaymentsByMonthResponse)(nil),      // 44: finance.GetAllPaymentsByMonthResponse
+	(*AbsGetAllPaymentsByMonthResponse)(nil),   // 45: finance.AbsGetAllPaymentsByMonthResponse
+	(*GetMonthlyStatusResponse)(nil),           // 46: finance.GetMonthlyStatusResponse
+	(*AbsGetMonthlyStatusResponse)(nil),        // 47: finance.AbsGetMonthlyStatusResponse
+	(*GetMonthlyStatusRequest)(nil),            // 48: finance.GetMonthlyStatusRequest
+	(*MergeStudentRequest)(nil),                // 49: finance.MergeStudentRequest
+	(*BalanceEvent)(nil),                       // 50: finance.BalanceEvent
+	(*BalanceEventList)(nil),                   // 51: finance.BalanceEventList
+	(*RetryBalanceEventsRequest)(nil),          // 52: finance.RetryBalanceEventsRequest
+	(*RetryBalanceEventsResponse)(nil),         // 53: finance.RetryBalanceEventsResponse
+	(*GetStudentLedgerBalancesRequest)(nil),    // 54: finance.GetStudentLedgerBalancesRequest
+	(*StudentLedgerBalance)(nil),               // 55: finance.StudentLedgerBalance
+	(*GetStudentLedgerBalancesResponse)(nil),   // 56: finance.GetStudentLedgerBalancesResponse
+	(*PaymentAddRequest)(nil),                  // 57: finance.PaymentAddRequest
+	(*PaymentUpdateRequest)(nil),               // 58: finance.PaymentUpdateRequest
+	(*PaymentReturnRequest)(nil),               // 59: finance.PaymentReturnRequest
+	(*GetTeachersSalaryRequest)(nil),           // 60: finance.GetTeachersSalaryRequest
+	(*AbsGetTeachersSalary)(nil),               // 61: finance.AbsGetTeachersSalary
+	(*DeleteTeacherSalaryRequest)(nil),         // 62: finance.DeleteTeacherSalaryRequest
+	(*CreateTeacherSalaryRequest)(nil),         // 63: finance.CreateTeacherSalaryRequest
+	(*Sponsor)(nil),                            // 64: finance.Sponsor
+	(*SponsorList)(nil),                        // 65: finance.SponsorList
+	(*Sponsorship)(nil),                        // 66: finance.Sponsorship
+	(*EndSponsorshipRequest)(nil),              // 67: finance.EndSponsorshipRequest
+	(*GetSponsorshipsRequest)(nil),             // 68: finance.GetSponsorshipsRequest
+	(*SponsorshipList)(nil),                    // 69: finance.SponsorshipList
+	(*SponsorPaymentRequest)(nil),              // 70: finance.SponsorPaymentRequest
+	(*SponsorPaymentReturnRequest)(nil),        // 71: finance.SponsorPaymentReturnRequest
+	(*SponsorStatementRequest)(nil),            // 72: finance.SponsorStatementRequest
+	(*SponsorStatementEntry)(nil),              // 73: finance.SponsorStatementEntry
+	(*SponsorStatementStudent)(nil),            // 74: finance.SponsorStatementStudent
+	(*SponsorStatement)(nil),                   // 75: finance.SponsorStatement
+	(*CreatePaymentPlanRequest)(nil),           // 76: finance.CreatePaymentPlanRequest
+	(*ScheduledInstallment)(nil),               // 77: finance.ScheduledInstallment
+	(*PaymentPlanRequest)(nil),                 // 78: finance.PaymentPlanRequest
+	(*StudentPaymentPlansRequest)(nil),         // 79: finance.StudentPaymentPlansRequest
+	(*PaymentPlan)(nil),                        // 80: finance.PaymentPlan
+	(*Installment)(nil),                        // 81: finance.Installment
+	(*InstallmentPayment)(nil),                 // 82: finance.InstallmentPayment
+	(*GetOverdueInstallmentsRequest)(nil),      // 83: finance.GetOverdueInstallmentsRequest
+	(*OverdueInstallment)(nil),                 // 84: finance.OverdueInstallment
+	(*OverdueInstallmentList)(nil),             // 85: finance.OverdueInstallmentList
+	(*CashAccount)(nil),                        // 86: finance.CashAccount
+	(*CashAccountList)(nil),                    // 87: finance.CashAccountList
+	(*CashShiftRequest)(nil),                   // 88: finance.CashShiftRequest
+	(*CashShift)(nil),                          // 89: finance.CashShift
+	(*GetCashShiftsRequest)(nil),               // 90: finance.GetCashShiftsRequest
+	(*CashShiftList)(nil),                      // 91: finance.CashShiftList
+	(*ExchangeRate)(nil),                       // 92: finance.ExchangeRate
+	(*GetExchangeRatesRequest)(nil),            // 93: finance.GetExchangeRatesRequest
+	(*ExchangeRateList)(nil),                   // 94: finance.ExchangeRateList
+	(*LedgerAccount)(nil),                      // 95: finance.LedgerAccount
+	(*LedgerAccountList)(nil),                  // 96: finance.LedgerAccountList
+	(*JournalLine)(nil),                        // 97: finance.JournalLine
+	(*JournalEntry)(nil),                       // 98: finance.JournalEntry
+	(*ReverseJournalEntryRequest)(nil),         // 99: finance.ReverseJournalEntryRequest
+	(*GetJournalEntriesRequest)(nil),           // 100: finance.GetJournalEntriesRequest
+	(*JournalEntryList)(nil),                   // 101: finance.JournalEntryList
+	(*TrialBalanceRequest)(nil),                // 102: finance.TrialBalanceRequest
+	(*TrialBalanceRow)(nil),                    // 103: finance.TrialBalanceRow
+	(*TrialBalance)(nil),                       // 104: finance.TrialBalance
+	(*LedgerPeriodRequest)(nil),                // 105: finance.LedgerPeriodRequest
+	(*ProfitAndLossRow)(nil),                   // 106: finance.ProfitAndLossRow
+	(*ProfitAndLoss)(nil),                      // 107: finance.ProfitAndLoss
+	(*CashFlowAccount)(nil),                    // 108: finance.CashFlowAccount
+	(*CashFlowActivity)(nil),                   // 109: finance.CashFlowActivity
+	(*CashFlow)(nil),                           // 110: finance.CashFlow
+	(*PageRequest)(nil),                        // 111: common.PageRequest
+	(*GetUserByIdResponse)(nil),                // 112: user.GetUserByIdResponse
+	(*DebtorGroup)(nil),                        // 113: common.DebtorGroup
+	(*DebtorComment)(nil),                      // 114: common.DebtorComment
+	(*PaymentPlanStatus)(nil),                  // 115: common.PaymentPlanStatus
+	(*DeleteAbsRequest)(nil),                   // 116: common.DeleteAbsRequest
+	(*emptypb.Empty)(nil),                      // 117: google.protobuf.Empty
+	(*TenantUsageRequest)(nil),                 // 118: common.TenantUsageRequest
+	(*AbsResponse)(nil),                        // 119: common.AbsResponse
+	(*TenantUsageList)(nil),                    // 120: common.TenantUsageList
+	(*PaymentPlanStatusList)(nil),              // 121: common.PaymentPlanStatusList
 }
 var file_finance_proto_depIdxs = []int32{
-	4,  // 0: finance.GetHistoryDiscountResponse.discounts:type_name -> finance.AbsHistoryDiscount
-	8,  // 1: finance.GetInformationDiscountResponse.discounts:type_name -> finance.AbsStudentDiscount
-	11, // 2: finance.GetAllCategoryRequest.categories:type_name -> finance.AbsCategory
-	49, // 3: finance.GetAllExpenseRequest.pageReq:type_name -> common.PageRequest
-	16, // 4: finance.GetAllExpenseResponse.expenses:type_name -> finance.GetAllExpenseAbs
-	11, // 5: finance.GetAllExpenseAbs.category:type_name -> finance.AbsCategory
-	50, // 6: finance.GetAllExpenseAbs.user:type_name -> user.GetUserByIdResponse
-	50, // 7: finance.GetAllExpenseAbs.creator:type_name -> user.GetUserByIdResponse
-	20, // 8: finance.GetIncomeChartResponse.response:type_name -> finance.AbsIncomeChart
-	49, // 9: finance.GetAllDebtsRequest.pageParam:type_name -> common.PageRequest
-	24, // 10: finance.GetAllDebtsInformationResponse.debts:type_name -> finance.AbsDebtsInformation
-	51, // 11: finance.AbsDebtsInformation.groups:type_name -> common.DebtorGroup
-	52, // 12: finance.AbsDebtsInformation.comments:type_name -> common.DebtorComment
-	32, // 13: finance.GetAllStudentPaymentsChartResponse.paymentsChart:type_name -> finance.AbsTakeOfChartResponse
-	49, // 14: finance.GetAllStudentPaymentsRequest.page:type_name -> common.PageRequest
-	27, // 15: finance.GetAllStudentPaymentsRequest.filters:type_name -> finance.Filters
-	28, // 16: finance.GetAllStudentPaymentsRequest.sorts:type_name -> finance.SortBy
-	30, // 17: finance.GetAllStudentPaymentsResponse.payments:type_name -> finance.AbsStudentPayments
-	32, // 18: finance.GetAllPaymentTakeOffChartResponse.chartResponse:type_name -> finance.AbsTakeOfChartResponse
-	35, // 19: finance.GetAllPaymentTakeOffResponse.pennies:type_name -> finance.AbsPaymentTakeOff
-	38, // 20: finance.GetAllPaymentsByMonthResponse.payments:type_name -> finance.AbsGetAllPaymentsByMonthResponse
-	40, // 21: finance.GetMonthlyStatusResponse.monthStatus:type_name -> finance.AbsGetMonthlyStatusResponse
-	46, // 22: finance.GetTeachersSalaryRequest.salaries:type_name -> finance.AbsGetTeachersSalary
-	6,  // 23: finance.DiscountService.GetAllInformationDiscount:input_type -> finance.GetInformationDiscountRequest
-	5,  // 24: finance.DiscountService.CreateDiscount:input_type -> finance.AbsDiscountRequest
-	5,  // 25: finance.DiscountService.DeleteDiscount:input_type -> finance.AbsDiscountRequest
-	2,  // 26: finance.DiscountService.GetHistoryDiscount:input_type -> finance.GetHistoryDiscountRequest
-	1,  // 27: finance.DiscountService.GetDiscountByStudentId:input_type -> finance.GetDiscountByStudentIdRequest
-	9,  // 28: finance.CategoryService.CreateCategory:input_type -> finance.CreateCategoryRequest
-	53, // 29: finance.CategoryService.DeleteCategory:input_type -> common.DeleteAbsRequest
-	54, // 30: finance.CategoryService.GetAllCategory:input_type -> google.protobuf.Empty
-	17, // 31: finance.ExpenseService.CreateExpense:input_type -> finance.CreateExpenseRequest
-	53, // 32: finance.ExpenseService.DeleteExpense:input_type -> common.DeleteAbsRequest
-	14, // 33: finance.ExpenseService.GetAllExpense:input_type -> finance.GetAllExpenseRequest
-	13, // 34: finance.ExpenseService.GetAllExpenseDiagram:input_type -> finance.GetAllExpenseDiagramRequest
-	42, // 35: finance.PaymentService.PaymentAdd:input_type -> finance.PaymentAddRequest
-	44, // 36: finance.PaymentService.PaymentReturn:input_type -> finance.PaymentReturnRequest
-	43, // 37: finance.PaymentService.PaymentUpdate:input_type -> finance.PaymentUpdateRequest
-	41, // 38: finance.PaymentService.GetMonthlyStatus:input_type -> finance.GetMonthlyStatusRequest
-	36, // 39: finance.PaymentService.GetAllPaymentsByMonth:input_type -> finance.GetAllPaymentsByMonthRequest
-	33, // 40: finance.PaymentService.GetAllPaymentTakeOff:input_type -> finance.GetAllPaymentTakeOffRequest
-	33, // 41: finance.PaymentService.GetAllPaymentTakeOffChart:input_type -> finance.GetAllPaymentTakeOffRequest
-	26, // 42: finance.PaymentService.GetAllStudentPayments:input_type -> finance.GetAllStudentPaymentsRequest
-	26, // 43: finance.PaymentService.GetAllStudentPaymentsChart:input_type -> finance.GetAllStudentPaymentsRequest
-	22, // 44: finance.PaymentService.GetAllDebtsInformation:input_type -> finance.GetAllDebtsRequest
-	54, // 45: finance.PaymentService.GetCommonFinanceInformation:input_type -> google.protobuf.Empty
-	18, // 46: finance.PaymentService.GetIncomeChart:input_type -> finance.GetIncomeChartRequest
-	48, // 47: finance.TeacherSalaryService.CreateTeacherSalary:input_type -> finance.CreateTeacherSalaryRequest
-	47, // 48: finance.TeacherSalaryService.DeleteTeacherSalary:input_type -> finance.DeleteTeacherSalaryRequest
-	54, // 49: finance.TeacherSalaryService.GetTeacherSalary:input_type -> google.protobuf.Empty
-	47, // 50: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:input_type -> finance.DeleteTeacherSalaryRequest
-	7,  // 51: finance.DiscountService.GetAllInformationDiscount:output_type -> finance.GetInformationDiscountResponse
-	55, // 52: finance.DiscountService.CreateDiscount:output_type -> common.AbsResponse
-	55, // 53: finance.DiscountService.DeleteDiscount:output_type -> common.AbsResponse
-	3,  // 54: finance.DiscountService.GetHistoryDiscount:output_type -> finance.GetHistoryDiscountResponse
-	0,  // 55: finance.DiscountService.GetDiscountByStudentId:output_type -> finance.GetDiscountByStudentIdResponse
-	55, // 56: finance.CategoryService.CreateCategory:output_type -> common.AbsResponse
-	55, // 57: finance.CategoryService.DeleteCategory:output_type -> common.AbsResponse
-	10, // 58: finance.CategoryService.GetAllCategory:output_type -> finance.GetAllCategoryRequest
-	55, // 59: finance.ExpenseService.CreateExpense:output_type -> common.AbsResponse
-	55, // 60: finance.ExpenseService.DeleteExpense:output_type -> common.AbsResponse
-	15, // 61: finance.ExpenseService.GetAllExpense:output_type -> finance.GetAllExpenseResponse
-	12, // 62: finance.ExpenseService.GetAllExpenseDiagram:output_type -> finance.GetAllExpenseDiagramResponse
-	55, // 63: finance.PaymentService.PaymentAdd:output_type -> common.AbsResponse
-	55, // 64: finance.PaymentService.PaymentReturn:output_type -> common.AbsResponse
-	55, // 65: finance.PaymentService.PaymentUpdate:output_type -> common.AbsResponse
-	39, // 66: finance.PaymentService.GetMonthlyStatus:output_type -> finance.GetMonthlyStatusResponse
-	37, // 67: finance.PaymentService.GetAllPaymentsByMonth:output_type -> finance.GetAllPaymentsByMonthResponse
-	34, // 68: finance.PaymentService.GetAllPaymentTakeOff:output_type -> finance.GetAllPaymentTakeOffResponse
-	31, // 69: finance.PaymentService.GetAllPaymentTakeOffChart:output_type -> finance.GetAllPaymentTakeOffChartResponse
-	29, // 70: finance.PaymentService.GetAllStudentPayments:output_type -> finance.GetAllStudentPaymentsResponse
-	25, // 71: finance.PaymentService.GetAllStudentPaymentsChart:output_type -> finance.GetAllStudentPaymentsChartResponse
-	23, // 72: finance.PaymentService.GetAllDebtsInformation:output_type -> finance.GetAllDebtsInformationResponse
-	21, // 73: finance.PaymentService.GetCommonFinanceInformation:output_type -> finance.GetCommonInformationResponse
-	19, // 74: finance.PaymentService.GetIncomeChart:output_type -> finance.GetIncomeChartResponse
-	55, // 75: finance.TeacherSalaryService.CreateTeacherSalary:output_type -> common.AbsResponse
-	55, // 76: finance.TeacherSalaryService.DeleteTeacherSalary:output_type -> common.AbsResponse
-	45, // 77: finance.TeacherSalaryService.GetTeacherSalary:output_type -> finance.GetTeachersSalaryRequest
-	46, // 78: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:output_type -> finance.AbsGetTeachersSalary
-	51, // [51:79] is the sub-list for method output_type
-	23, // [23:51] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	2,   // 0: finance.GetDiscountByStudentIdResponse.applied:type_name -> finance.AppliedDiscount
+	3,   // 1: finance.DiscountRuleList.items:type_name -> finance.DiscountRule
+	7,   // 2: finance.RuleApplicationList.items:type_name -> finance.RuleApplication
+	11,  // 3: finance.GetHistoryDiscountResponse.discounts:type_name -> finance.AbsHistoryDiscount
+	15,  // 4: finance.GetInformationDiscountResponse.discounts:type_name -> finance.AbsStudentDiscount
+	18,  // 5: finance.GetAllCategoryRequest.categories:type_name -> finance.AbsCategory
+	111, // 6: finance.GetAllExpenseRequest.pageReq:type_name -> common.PageRequest
+	23,  // 7: finance.GetAllExpenseResponse.expenses:type_name -> finance.GetAllExpenseAbs
+	18,  // 8: finance.GetAllExpenseAbs.category:type_name -> finance.AbsCategory
+	112, // 9: finance.GetAllExpenseAbs.user:type_name -> user.GetUserByIdResponse
+	112, // 10: finance.GetAllExpenseAbs.creator:type_name -> user.GetUserByIdResponse
+	27,  // 11: finance.GetIncomeChartResponse.response:type_name -> finance.AbsIncomeChart
+	111, // 12: finance.GetAllDebtsRequest.pageParam:type_name -> common.PageRequest
+	31,  // 13: finance.GetAllDebtsInformationResponse.debts:type_name -> finance.AbsDebtsInformation
+	31,  // 14: finance.GetAllDebtsInformationResponse.sponsorDebts:type_name -> finance.AbsDebtsInformation
+	113, // 15: finance.AbsDebtsInformation.groups:type_name -> common.DebtorGroup
+	114, // 16: finance.AbsDebtsInformation.comments:type_name -> common.DebtorComment
+	39,  // 17: finance.GetAllStudentPaymentsChartResponse.paymentsChart:type_name -> finance.AbsTakeOfChartResponse
+	111, // 18: finance.GetAllStudentPaymentsRequest.page:type_name -> common.PageRequest
+	34,  // 19: finance.GetAllStudentPaymentsRequest.filters:type_name -> finance.Filters
+	35,  // 20: finance.GetAllStudentPaymentsRequest.sorts:type_name -> finance.SortBy
+	37,  // 21: finance.GetAllStudentPaymentsResponse.payments:type_name -> finance.AbsStudentPayments
+	39,  // 22: finance.GetAllPaymentTakeOffChartResponse.chartResponse:type_name -> finance.AbsTakeOfChartResponse
+	42,  // 23: finance.GetAllPaymentTakeOffResponse.pennies:type_name -> finance.AbsPaymentTakeOff
+	45,  // 24: finance.GetAllPaymentsByMonthResponse.payments:type_name -> finance.AbsGetAllPaymentsByMonthResponse
+	47,  // 25: finance.GetMonthlyStatusResponse.monthStatus:type_name -> finance.AbsGetMonthlyStatusResponse
+	50,  // 26: finance.BalanceEventList.events:type_name -> finance.BalanceEvent
+	55,  // 27: finance.GetStudentLedgerBalancesResponse.balances:type_name -> finance.StudentLedgerBalance
+	2,   // 28: finance.PaymentAddRequest.discounts:type_name -> finance.AppliedDiscount
+	61,  // 29: finance.GetTeachersSalaryRequest.salaries:type_name -> finance.AbsGetTeachersSalary
+	64,  // 30: finance.SponsorList.items:type_name -> finance.Sponsor
+	66,  // 31: finance.SponsorshipList.items:type_name -> finance.Sponsorship
+	64,  // 32: finance.SponsorStatement.sponsor:type_name -> finance.Sponsor
+	73,  // 33: finance.SponsorStatement.entries:type_name -> finance.SponsorStatementEntry
+	74,  // 34: finance.SponsorStatement.students:type_name -> finance.SponsorStatementStudent
+	77,  // 35: finance.CreatePaymentPlanRequest.schedule:type_name -> finance.ScheduledInstallment
+	115, // 36: finance.PaymentPlan.summary:type_name -> common.PaymentPlanStatus
+	81,  // 37: finance.PaymentPlan.installments:type_name -> finance.Installment
+	82,  // 38: finance.Installment.payments:type_name -> finance.InstallmentPayment
+	84,  // 39: finance.OverdueInstallmentList.items:type_name -> finance.OverdueInstallment
+	86,  // 40: finance.CashAccountList.items:type_name -> finance.CashAccount
+	89,  // 41: finance.CashShiftList.items:type_name -> finance.CashShift
+	92,  // 42: finance.ExchangeRateList.items:type_name -> finance.ExchangeRate
+	95,  // 43: finance.LedgerAccountList.items:type_name -> finance.LedgerAccount
+	97,  // 44: finance.JournalEntry.lines:type_name -> finance.JournalLine
+	98,  // 45: finance.JournalEntryList.items:type_name -> finance.JournalEntry
+	103, // 46: finance.TrialBalance.rows:type_name -> finance.TrialBalanceRow
+	106, // 47: finance.ProfitAndLoss.income:type_name -> finance.ProfitAndLossRow
+	106, // 48: finance.ProfitAndLoss.expenses:type_name -> finance.ProfitAndLossRow
+	108, // 49: finance.CashFlow.accounts:type_name -> finance.CashFlowAccount
+	109, // 50: finance.CashFlow.activities:type_name -> finance.CashFlowActivity
+	13,  // 51: finance.DiscountService.GetAllInformationDiscount:input_type -> finance.GetInformationDiscountRequest
+	12,  // 52: finance.DiscountService.CreateDiscount:input_type -> finance.AbsDiscountRequest
+	12,  // 53: finance.DiscountService.DeleteDiscount:input_type -> finance.AbsDiscountRequest
+	9,   // 54: finance.DiscountService.GetHistoryDiscount:input_type -> finance.GetHistoryDiscountRequest
+	1,   // 55: finance.DiscountService.GetDiscountByStudentId:input_type -> finance.GetDiscountByStudentIdRequest
+	3,   // 56: finance.DiscountService.CreateDiscountRule:input_type -> finance.DiscountRule
+	3,   // 57: finance.DiscountService.UpdateDiscountRule:input_type -> finance.DiscountRule
+	116, // 58: finance.DiscountService.DeleteDiscountRule:input_type -> common.DeleteAbsRequest
+	117, // 59: finance.DiscountService.GetDiscountRules:input_type -> google.protobuf.Empty
+	117, // 60: finance.DiscountService.GetDiscountPolicy:input_type -> google.protobuf.Empty
+	5,   // 61: finance.DiscountService.SetDiscountPolicy:input_type -> finance.DiscountPolicy
+	6,   // 62: finance.DiscountService.GetRuleApplications:input_type -> finance.GetRuleApplicationsRequest
+	16,  // 63: finance.CategoryService.CreateCategory:input_type -> finance.CreateCategoryRequest
+	116, // 64: finance.CategoryService.DeleteCategory:input_type -> common.DeleteAbsRequest
+	117, // 65: finance.CategoryService.GetAllCategory:input_type -> google.protobuf.Empty
+	24,  // 66: finance.ExpenseService.CreateExpense:input_type -> finance.CreateExpenseRequest
+	116, // 67: finance.ExpenseService.DeleteExpense:input_type -> common.DeleteAbsRequest
+	21,  // 68: finance.ExpenseService.GetAllExpense:input_type -> finance.GetAllExpenseRequest
+	20,  // 69: finance.ExpenseService.GetAllExpenseDiagram:input_type -> finance.GetAllExpenseDiagramRequest
+	57,  // 70: finance.PaymentService.PaymentAdd:input_type -> finance.PaymentAddRequest
+	49,  // 71: finance.PaymentService.MergeStudent:input_type -> finance.MergeStudentRequest
+	54,  // 72: finance.PaymentService.GetStudentLedgerBalances:input_type -> finance.GetStudentLedgerBalancesRequest
+	59,  // 73: finance.PaymentService.PaymentReturn:input_type -> finance.PaymentReturnRequest
+	58,  // 74: finance.PaymentService.PaymentUpdate:input_type -> finance.PaymentUpdateRequest
+	48,  // 75: finance.PaymentService.GetMonthlyStatus:input_type -> finance.GetMonthlyStatusRequest
+	43,  // 76: finance.PaymentService.GetAllPaymentsByMonth:input_type -> finance.GetAllPaymentsByMonthRequest
+	40,  // 77: finance.PaymentService.GetAllPaymentTakeOff:input_type -> finance.GetAllPaymentTakeOffRequest
+	40,  // 78: finance.PaymentService.GetAllPaymentTakeOffChart:input_type -> finance.GetAllPaymentTakeOffRequest
+	33,  // 79: finance.PaymentService.GetAllStudentPayments:input_type -> finance.GetAllStudentPaymentsRequest
+	33,  // 80: finance.PaymentService.GetAllStudentPaymentsChart:input_type -> finance.GetAllStudentPaymentsRequest
+	118, // 81: finance.PaymentService.GetTenantUsage:input_type -> common.TenantUsageRequest
+	29,  // 82: finance.PaymentService.GetAllDebtsInformation:input_type -> finance.GetAllDebtsRequest
+	117, // 83: finance.PaymentService.GetCommonFinanceInformation:input_type -> google.protobuf.Empty
+	25,  // 84: finance.PaymentService.GetIncomeChart:input_type -> finance.GetIncomeChartRequest
+	117, // 85: finance.PaymentService.GetFailedBalanceEvents:input_type -> google.protobuf.Empty
+	52,  // 86: finance.PaymentService.RetryBalanceEvents:input_type -> finance.RetryBalanceEventsRequest
+	63,  // 87: finance.TeacherSalaryService.CreateTeacherSalary:input_type -> finance.CreateTeacherSalaryRequest
+	62,  // 88: finance.TeacherSalaryService.DeleteTeacherSalary:input_type -> finance.DeleteTeacherSalaryRequest
+	117, // 89: finance.TeacherSalaryService.GetTeacherSalary:input_type -> google.protobuf.Empty
+	62,  // 90: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:input_type -> finance.DeleteTeacherSalaryRequest
+	64,  // 91: finance.SponsorService.CreateSponsor:input_type -> finance.Sponsor
+	64,  // 92: finance.SponsorService.UpdateSponsor:input_type -> finance.Sponsor
+	116, // 93: finance.SponsorService.DeleteSponsor:input_type -> common.DeleteAbsRequest
+	117, // 94: finance.SponsorService.GetSponsors:input_type -> google.protobuf.Empty
+	66,  // 95: finance.SponsorService.AddSponsorship:input_type -> finance.Sponsorship
+	67,  // 96: finance.SponsorService.EndSponsorship:input_type -> finance.EndSponsorshipRequest
+	68,  // 97: finance.SponsorService.GetSponsorships:input_type -> finance.GetSponsorshipsRequest
+	70,  // 98: finance.SponsorService.SponsorPaymentAdd:input_type -> finance.SponsorPaymentRequest
+	71,  // 99: finance.SponsorService.SponsorPaymentReturn:input_type -> finance.SponsorPaymentReturnRequest
+	72,  // 100: finance.SponsorService.GetSponsorStatement:input_type -> finance.SponsorStatementRequest
+	76,  // 101: finance.PaymentPlanService.PreviewPaymentPlan:input_type -> finance.CreatePaymentPlanRequest
+	76,  // 102: finance.PaymentPlanService.CreatePaymentPlan:input_type -> finance.CreatePaymentPlanRequest
+	78,  // 103: finance.PaymentPlanService.GetPaymentPlan:input_type -> finance.PaymentPlanRequest
+	78,  // 104: finance.PaymentPlanService.CancelPaymentPlan:input_type -> finance.PaymentPlanRequest
+	79,  // 105: finance.PaymentPlanService.GetStudentPaymentPlans:input_type -> finance.StudentPaymentPlansRequest
+	83,  // 106: finance.PaymentPlanService.GetOverdueInstallments:input_type -> finance.GetOverdueInstallmentsRequest
+	86,  // 107: finance.CashService.CreateCashAccount:input_type -> finance.CashAccount
+	86,  // 108: finance.CashService.UpdateCashAccount:input_type -> finance.CashAccount
+	117, // 109: finance.CashService.GetCashAccounts:input_type -> google.protobuf.Empty
+	88,  // 110: finance.CashService.OpenCashShift:input_type -> finance.CashShiftRequest
+	88,  // 111: finance.CashService.CloseCashShift:input_type -> finance.CashShiftRequest
+	90,  // 112: finance.CashService.GetCashShifts:input_type -> finance.GetCashShiftsRequest
+	92,  // 113: finance.CashService.SetExchangeRate:input_type -> finance.ExchangeRate
+	93,  // 114: finance.CashService.GetExchangeRates:input_type -> finance.GetExchangeRatesRequest
+	117, // 115: finance.LedgerService.GetLedgerAccounts:input_type -> google.protobuf.Empty
+	95,  // 116: finance.LedgerService.CreateLedgerAccount:input_type -> finance.LedgerAccount
+	98,  // 117: finance.LedgerService.CreateJournalEntry:input_type -> finance.JournalEntry
+	99,  // 118: finance.LedgerService.ReverseJournalEntry:input_type -> finance.ReverseJournalEntryRequest
+	100, // 119: finance.LedgerService.GetJournalEntries:input_type -> finance.GetJournalEntriesRequest
+	102, // 120: finance.LedgerService.GetTrialBalance:input_type -> finance.TrialBalanceRequest
+	105, // 121: finance.LedgerService.GetProfitAndLoss:input_type -> finance.LedgerPeriodRequest
+	105, // 122: finance.LedgerService.GetCashFlow:input_type -> finance.LedgerPeriodRequest
+	14,  // 123: finance.DiscountService.GetAllInformationDiscount:output_type -> finance.GetInformationDiscountResponse
+	119, // 124: finance.DiscountService.CreateDiscount:output_type -> common.AbsResponse
+	119, // 125: finance.DiscountService.DeleteDiscount:output_type -> common.AbsResponse
+	10,  // 126: finance.DiscountService.GetHistoryDiscount:output_type -> finance.GetHistoryDiscountResponse
+	0,   // 127: finance.DiscountService.GetDiscountByStudentId:output_type -> finance.GetDiscountByStudentIdResponse
+	3,   // 128: finance.DiscountService.CreateDiscountRule:output_type -> finance.DiscountRule
+	3,   // 129: finance.DiscountService.UpdateDiscountRule:output_type -> finance.DiscountRule
+	119, // 130: finance.DiscountService.DeleteDiscountRule:output_type -> common.AbsResponse
+	4,   // 131: finance.DiscountService.GetDiscountRules:output_type -> finance.DiscountRuleList
+	5,   // 132: finance.DiscountService.GetDiscountPolicy:output_type -> finance.DiscountPolicy
+	5,   // 133: finance.DiscountService.SetDiscountPolicy:output_type -> finance.DiscountPolicy
+	8,   // 134: finance.DiscountService.GetRuleApplications:output_type -> finance.RuleApplicationList
+	119, // 135: finance.CategoryService.CreateCategory:output_type -> common.AbsResponse
+	119, // 136: finance.CategoryService.DeleteCategory:output_type -> common.AbsResponse
+	17,  // 137: finance.CategoryService.GetAllCategory:output_type -> finance.GetAllCategoryRequest
+	119, // 138: finance.ExpenseService.CreateExpense:output_type -> common.AbsResponse
+	119, // 139: finance.ExpenseService.DeleteExpense:output_type -> common.AbsResponse
+	22,  // 140: finance.ExpenseService.GetAllExpense:output_type -> finance.GetAllExpenseResponse
+	19,  // 141: finance.ExpenseService.GetAllExpenseDiagram:output_type -> finance.GetAllExpenseDiagramResponse
+	119, // 142: finance.PaymentService.PaymentAdd:output_type -> common.AbsResponse
+	119, // 143: finance.PaymentService.MergeStudent:output_type -> common.AbsResponse
+	56,  // 144: finance.PaymentService.GetStudentLedgerBalances:output_type -> finance.GetStudentLedgerBalancesResponse
+	119, // 145: finance.PaymentService.PaymentReturn:output_type -> common.AbsResponse
+	119, // 146: finance.PaymentService.PaymentUpdate:output_type -> common.AbsResponse
+	46,  // 147: finance.PaymentService.GetMonthlyStatus:output_type -> finance.GetMonthlyStatusResponse
+	44,  // 148: finance.PaymentService.GetAllPaymentsByMonth:output_type -> finance.GetAllPaymentsByMonthResponse
+	41,  // 149: finance.PaymentService.GetAllPaymentTakeOff:output_type -> finance.GetAllPaymentTakeOffResponse
+	38,  // 150: finance.PaymentService.GetAllPaymentTakeOffChart:output_type -> finance.GetAllPaymentTakeOffChartResponse
+	36,  // 151: finance.PaymentService.GetAllStudentPayments:output_type -> finance.GetAllStudentPaymentsResponse
+	32,  // 152: finance.PaymentService.GetAllStudentPaymentsChart:output_type -> finance.GetAllStudentPaymentsChartResponse
+	120, // 153: finance.PaymentService.GetTenantUsage:output_type -> common.TenantUsageList
+	30,  // 154: finance.PaymentService.GetAllDebtsInformation:output_type -> finance.GetAllDebtsInformationResponse
+	28,  // 155: finance.PaymentService.GetCommonFinanceInformation:output_type -> finance.GetCommonInformationResponse
+	26,  // 156: finance.PaymentService.GetIncomeChart:output_type -> finance.GetIncomeChartResponse
+	51,  // 157: finance.PaymentService.GetFailedBalanceEvents:output_type -> finance.BalanceEventList
+	53,  // 158: finance.PaymentService.RetryBalanceEvents:output_type -> finance.RetryBalanceEventsResponse
+	119, // 159: finance.TeacherSalaryService.CreateTeacherSalary:output_type -> common.AbsResponse
+	119, // 160: finance.TeacherSalaryService.DeleteTeacherSalary:output_type -> common.AbsResponse
+	60,  // 161: finance.TeacherSalaryService.GetTeacherSalary:output_type -> finance.GetTeachersSalaryRequest
+	61,  // 162: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:output_type -> finance.AbsGetTeachersSalary
+	64,  // 163: finance.SponsorService.CreateSponsor:output_type -> finance.Sponsor
+	64,  // 164: finance.SponsorService.UpdateSponsor:output_type -> finance.Sponsor
+	119, // 165: finance.SponsorService.DeleteSponsor:output_type -> common.AbsResponse
+	65,  // 166: finance.SponsorService.GetSponsors:output_type -> finance.SponsorList
+	66,  // 167: finance.SponsorService.AddSponsorship:output_type -> finance.Sponsorship
+	66,  // 168: finance.SponsorService.EndSponsorship:output_type -> finance.Sponsorship
+	69,  // 169: finance.SponsorService.GetSponsorships:output_type -> finance.SponsorshipList
+	119, // 170: finance.SponsorService.SponsorPaymentAdd:output_type -> common.AbsResponse
+	119, // 171: finance.SponsorService.SponsorPaymentReturn:output_type -> common.AbsResponse
+	75,  // 172: finance.SponsorService.GetSponsorStatement:output_type -> finance.SponsorStatement
+	80,  // 173: finance.PaymentPlanService.PreviewPaymentPlan:output_type -> finance.PaymentPlan
+	80,  // 174: finance.PaymentPlanService.CreatePaymentPlan:output_type -> finance.PaymentPlan
+	80,  // 175: finance.PaymentPlanService.GetPaymentPlan:output_type -> finance.PaymentPlan
+	80,  // 176: finance.PaymentPlanService.CancelPaymentPlan:output_type -> finance.PaymentPlan
+	121, // 177: finance.PaymentPlanService.GetStudentPaymentPlans:output_type -> common.PaymentPlanStatusList
+	85,  // 178: finance.PaymentPlanService.GetOverdueInstallments:output_type -> finance.OverdueInstallmentList
+	86,  // 179: finance.CashService.CreateCashAccount:output_type -> finance.CashAccount
+	86,  // 180: finance.CashService.UpdateCashAccount:output_type -> finance.CashAccount
+	87,  // 181: finance.CashService.GetCashAccounts:output_type -> finance.CashAccountList
+	89,  // 182: finance.CashService.OpenCashShift:output_type -> finance.CashShift
+	89,  // 183: finance.CashService.CloseCashShift:output_type -> finance.CashShift
+	91,  // 184: finance.CashService.GetCashShifts:output_type -> finance.CashShiftList
+	92,  // 185: finance.CashService.SetExchangeRate:output_type -> finance.ExchangeRate
+	94,  // 186: finance.CashService.GetExchangeRates:output_type -> finance.ExchangeRateList
+	96,  // 187: finance.LedgerService.GetLedgerAccounts:output_type -> finance.LedgerAccountList
+	95,  // 188: finance.LedgerService.CreateLedgerAccount:output_type -> finance.LedgerAccount
+	98,  // 189: finance.LedgerService.CreateJournalEntry:output_type -> finance.JournalEntry
+	98,  // 190: finance.LedgerService.ReverseJournalEntry:output_type -> finance.JournalEntry
+	101, // 191: finance.LedgerService.GetJournalEntries:output_type -> finance.JournalEntryList
+	104, // 192: finance.LedgerService.GetTrialBalance:output_type -> finance.TrialBalance
+	107, // 193: finance.LedgerService.GetProfitAndLoss:output_type -> finance.ProfitAndLoss
+	110, // 194: finance.LedgerService.GetCashFlow:output_type -> finance.CashFlow
+	123, // [123:195] is the sub-list for method output_type
+	51,  // [51:123] is the sub-list for method input_type
+	51,  // [51:51] is the sub-list for extension type_name
+	51,  // [51:51] is the sub-list for extension extendee
+	0,   // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_finance_proto_init() }
@@ -3705,9 +9573,9 @@ func file_finance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_finance_proto_rawDesc), len(file_finance_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   49,
+			NumMessages:   111,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   9,
 		},
 		GoTypes:           file_finance_proto_goTypes,
 		DependencyIndexes: file_finance_proto_depIdxs,
@@ -1,7 +1,15 @@
 package main
 
-import "lid-service/internal/server"
+import (
+	"flag"
+	"lid-service/internal/server"
+)
 
 func main() {
+	flag.Parse()
+	if flag.Arg(0) == "migrate" {
+		server.RunMigrate(flag.Args()[1:])
+		return
+	}
 	server.RunServer()
 }
@@ -0,0 +1,26 @@
+package clients
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+	"lid-service/proto/pb"
+	"log/slog"
+)
+
+type CompanyClient struct {
+	client pb.CompanyServiceClient
+}
+
+func NewCompanyClient(addr string) *CompanyClient {
+	conn, err := Dial("education-service", addr)
+	if err != nil {
+		slog.Error("failed to create company client", "error", err)
+		return nil
+	}
+	return &CompanyClient{client: pb.NewCompanyServiceClient(conn)}
+}
+
+func (cc *CompanyClient) GetSubscription(ctx context.Context, companyId string) (*pb.CompanySubscription, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "company_id", companyId)
+	return cc.client.GetSubscription(ctx, &pb.GetSubscriptionRequest{})
+}
@@ -2,9 +2,8 @@ package clients
 
 import (
 	"context"
-	"fmt"
-	"google.golang.org/grpc"
 	"lid-service/proto/pb"
+	"log/slog"
 )
 
 type GroupClient struct {
@@ -13,9 +12,9 @@ type GroupClient struct {
 }
 
 func NewGroupClient(addr string) *GroupClient {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("education-service", addr)
 	if err != nil {
-		fmt.Println(err)
+		slog.Error("failed to create group client", "error", err)
 		return nil
 	}
 	client := pb.NewGroupServiceClient(conn)
@@ -2,9 +2,8 @@ package clients
 
 import (
 	"context"
-	"fmt"
-	"google.golang.org/grpc"
 	"lid-service/proto/pb"
+	"log/slog"
 )
 
 type StudentClient struct {
@@ -12,18 +11,18 @@ type StudentClient struct {
 }
 
 func NewStudentClient(addr string) *StudentClient {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
-	fmt.Println(addr)
+	conn, err := Dial("education-service", addr)
 	if err != nil {
-		fmt.Println(err)
+		slog.Error("failed to create student client", "error", err)
 		return nil
 	}
 	client := pb.NewStudentServiceClient(conn)
 	return &StudentClient{client: client}
 }
 
-func (gc *StudentClient) CreateStudent(ctx context.Context, phoneNumber, name, dateBirth, groupId, dateFrom, createdBy string, gender bool) (*pb.AbsResponse, error) {
+func (gc *StudentClient) CreateStudent(ctx context.Context, studentId, phoneNumber, name, dateBirth, groupId, dateFrom, createdBy, comment, source string, gender bool) (*pb.AbsResponse, error) {
 	req := pb.CreateStudentRequest{
+		Id:                studentId,
 		PhoneNumber:       phoneNumber,
 		Name:              name,
 		DateOfBirth:       dateBirth,
@@ -35,6 +34,8 @@ func (gc *StudentClient) CreateStudent(ctx context.Context, phoneNumber, name, d
 		GroupId:           groupId,
 		DateFrom:          dateFrom,
 		CreatedBy:         createdBy,
+		Comment:           comment,
+		Source:            source,
 	}
 	resp, err := gc.client.CreateStudent(ctx, &req)
 	if err != nil {
@@ -42,3 +43,9 @@ func (gc *StudentClient) CreateStudent(ctx context.Context, phoneNumber, name, d
 	}
 	return resp, nil
 }
+
+// DiscardStudents removes the students and the group of a failed conversion.
+// groupRequestKey finds the group when its id is not known.
+func (gc *StudentClient) DiscardStudents(ctx context.Context, studentIds []string, groupId, groupRequestKey string) (*pb.AbsResponse, error) {
+	return gc.client.DiscardStudents(ctx, &pb.DiscardStudentsRequest{StudentIds: studentIds, GroupId: groupId, GroupRequestKey: groupRequestKey})
+}
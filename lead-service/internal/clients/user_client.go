@@ -2,7 +2,6 @@ package clients
 
 import (
 	"context"
-	"google.golang.org/grpc"
 	"lid-service/proto/pb"
 )
 
@@ -11,7 +10,7 @@ type UserClient struct {
 }
 
 func NewUserClient(addr string) *UserClient {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("user-service", addr)
 	if err != nil {
 		return nil
 	}
@@ -0,0 +1,11 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var LeadsCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "lead_leads_created_total",
+	Help: "Leads created, by channel (manual or form).",
+}, []string{"channel"})
@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"lid-service/internal/metrics"
+	"lid-service/internal/utils"
 	"time"
 )
 
@@ -19,43 +21,77 @@ func NewLeadDataRepository(db *sql.DB) *LeadDataRepository {
 	return &LeadDataRepository{db: db}
 }
 
-func (r *LeadDataRepository) CreateLeadData(companyId string, phoneNumber, leadID, expectID, setID, comment, name *string) error {
+func (r *LeadDataRepository) CreateLeadData(companyId string, phoneNumber, leadID, expectID, setID, comment, name *string) ([]string, error) {
+	normalized, err := utils.NormalizePhone(*phoneNumber)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	duplicates, err := r.findLeadNamesByPhone(companyId, normalized)
+	if err != nil {
+		return nil, err
+	}
 	query := `
 		INSERT INTO lead_user (phone_number, lead_id, expect_id, set_id, comment , full_name , company_id) 
 		VALUES ($1, $2, $3, $4, $5, $6 , $7)`
-	_, err := r.db.Exec(query, phoneNumber, leadID, expectID, setID, comment, name, companyId)
+	_, err = r.db.Exec(query, normalized, leadID, expectID, setID, comment, name, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lead data: %w", err)
+	}
+	metrics.LeadsCreated.WithLabelValues("manual").Inc()
+	return duplicates, incrementLeadReports(r.db, companyId, leadID)
+}
+
+func (r *LeadDataRepository) findLeadNamesByPhone(companyId string, phoneNumber string) ([]string, error) {
+	rows, err := r.db.Query(`SELECT full_name FROM lead_user where company_id=$1 and phone_number=$2`, companyId, phoneNumber)
 	if err != nil {
-		return fmt.Errorf("failed to create lead data: %w", err)
+		return nil, err
 	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+func incrementLeadReports(db *sql.DB, companyId string, leadID *string) error {
 	var title string
-	err = r.db.QueryRow(`SELECT title from lead_section where id=$1 and company_id=$2`, leadID, companyId).Scan(&title)
+	err := db.QueryRow(`SELECT title from lead_section where id=$1 and company_id=$2`, leadID, companyId).Scan(&title)
 	if err != nil {
 		return status.Errorf(codes.Aborted, err.Error())
 	}
 	var checker bool
-	_ = r.db.QueryRow(`SELECT exists(SELECT 1 FROM lead_source_reports where source=$1 and company_id=$2)`, title, companyId).Scan(&checker)
+	_ = db.QueryRow(`SELECT exists(SELECT 1 FROM lead_source_reports where source=$1 and company_id=$2)`, title, companyId).Scan(&checker)
 	if checker {
-		_, _ = r.db.Exec(`UPDATE lead_source_reports SET lead_count=lead_count+1 where source=$1 and company_id=$2`, title, companyId)
+		_, _ = db.Exec(`UPDATE lead_source_reports SET lead_count=lead_count+1 where source=$1 and company_id=$2`, title, companyId)
 	} else {
-		_, _ = r.db.Exec(`INSERT INTO lead_source_reports(id, lead_count, source , created_at , company_id) values ($1 , $2 , $3 , $4 , $5)`, uuid.New(), 1, title, time.Now(), companyId)
+		_, _ = db.Exec(`INSERT INTO lead_source_reports(id, lead_count, source , created_at , company_id) values ($1 , $2 , $3 , $4 , $5)`, uuid.New(), 1, title, time.Now(), companyId)
 	}
 	format := time.Now().Format("2006-01")
 
-	_ = r.db.QueryRow(`SELECT exists(SELECT 1 FROM lead_conversion_reports where conversion_date=$1 and company_id=$2)`, format, companyId).Scan(&checker)
+	_ = db.QueryRow(`SELECT exists(SELECT 1 FROM lead_conversion_reports where conversion_date=$1 and company_id=$2)`, format, companyId).Scan(&checker)
 	if checker {
-		_, _ = r.db.Exec(`UPDATE lead_conversion_reports SET lead_count=lead_count+1 where conversion_date=$1 and company_id=$2`, format, companyId)
+		_, _ = db.Exec(`UPDATE lead_conversion_reports SET lead_count=lead_count+1 where conversion_date=$1 and company_id=$2`, format, companyId)
 	} else {
-		_, _ = r.db.Exec(`INSERT INTO lead_conversion_reports(id, lead_count, conversion_date, created_at , company_id) values ($1 , $2 , $3 , $4,  $5)`, uuid.New(), 1, format, time.Now(), companyId)
+		_, _ = db.Exec(`INSERT INTO lead_conversion_reports(id, lead_count, conversion_date, created_at , company_id) values ($1 , $2 , $3 , $4,  $5)`, uuid.New(), 1, format, time.Now(), companyId)
 	}
 	return nil
 }
 
 func (r *LeadDataRepository) UpdateLeadData(companyId string, id, phoneNumber, comment, name string) error {
+	phoneNumber, err := utils.NormalizePhone(phoneNumber)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 	query := `
 		UPDATE lead_user 
 		SET phone_number = $1, comment = $2 , full_name= $3 WHERE id = $4 and company_id=$5`
 
-	_, err := r.db.Exec(query, phoneNumber, comment, name, id, companyId)
+	_, err = r.db.Exec(query, phoneNumber, comment, name, id, companyId)
 	if err != nil {
 		return fmt.Errorf("failed to update lead data: %w", err)
 	}
@@ -91,3 +127,51 @@ func (r *LeadDataRepository) ChangeLeadPlace(companyId string, sectionID, sectio
 	}
 	return nil
 }
+
+// MergeLeadData folds the source lead into the target one: the comment is
+// appended, form submissions are re-pointed and the source lead is removed.
+func (r *LeadDataRepository) MergeLeadData(companyId string, sourceId, targetId string) error {
+	if sourceId == targetId {
+		return status.Error(codes.InvalidArgument, "source and target leads should be different")
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var sourceName, sourcePhone, sourceComment string
+	err = tx.QueryRow(`SELECT full_name, phone_number, coalesce(comment, '') FROM lead_user where id=$1 and company_id=$2 FOR UPDATE`, sourceId, companyId).
+		Scan(&sourceName, &sourcePhone, &sourceComment)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "source lead not found")
+	}
+	if err != nil {
+		return err
+	}
+	note := fmt.Sprintf("%s (%s)", sourceName, sourcePhone)
+	if sourceComment != "" {
+		note += ": " + sourceComment
+	}
+	result, err := tx.Exec(`
+		UPDATE lead_user
+		SET comment = CASE WHEN coalesce(comment, '') = '' THEN $1 ELSE comment || E'\n' || $1 END,
+		    source = coalesce(source, (SELECT source FROM lead_user where id = $3)),
+		    utm_source = coalesce(utm_source, (SELECT utm_source FROM lead_user where id = $3)),
+		    utm_medium = coalesce(utm_medium, (SELECT utm_medium FROM lead_user where id = $3)),
+		    utm_campaign = coalesce(utm_campaign, (SELECT utm_campaign FROM lead_user where id = $3))
+		WHERE id = $2 and company_id = $4`, note, targetId, sourceId, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to merge lead data: %w", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return status.Error(codes.NotFound, "target lead not found")
+	}
+	if _, err = tx.Exec(`UPDATE lead_form_submission SET lead_user_id = $1 WHERE lead_user_id = $2`, targetId, sourceId); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(`DELETE FROM lead_user WHERE id = $1 and company_id = $2`, sourceId, companyId); err != nil {
+		return fmt.Errorf("failed to delete merged lead data: %w", err)
+	}
+	return tx.Commit()
+}
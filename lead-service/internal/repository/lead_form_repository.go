@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"lid-service/internal/metrics"
+	"lid-service/internal/utils"
+	"lid-service/proto/pb"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type LeadFormRepository struct {
+	db *sql.DB
+}
+
+// NewLeadFormRepository initializes a new LeadFormRepository
+func NewLeadFormRepository(db *sql.DB) *LeadFormRepository {
+	return &LeadFormRepository{db: db}
+}
+
+func defaultLeadForm() *pb.LeadForm {
+	return &pb.LeadForm{
+		Title: "Ro'yxatdan o'tish",
+		Fields: []*pb.LeadFormField{
+			{Name: "name", Label: "Ism", Required: true},
+			{Name: "phoneNumber", Label: "Telefon raqam", Required: true},
+		},
+		RateLimitPerHour: 5,
+		IsActive:         false,
+		SuccessMessage:   "Arizangiz qabul qilindi",
+	}
+}
+
+func (r *LeadFormRepository) GetLeadForm(companyId string) (*pb.LeadForm, error) {
+	var (
+		form          pb.LeadForm
+		fieldsJSON    []byte
+		defaultLeadId sql.NullString
+	)
+	err := r.db.QueryRow(`SELECT title, fields, default_lead_id, rate_limit_per_hour, is_active, coalesce(success_message, '') FROM lead_form where company_id=$1`, companyId).
+		Scan(&form.Title, &fieldsJSON, &defaultLeadId, &form.RateLimitPerHour, &form.IsActive, &form.SuccessMessage)
+	if errors.Is(err, sql.ErrNoRows) {
+		return defaultLeadForm(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lead form: %w", err)
+	}
+	if err = json.Unmarshal(fieldsJSON, &form.Fields); err != nil {
+		return nil, fmt.Errorf("failed to parse lead form fields: %w", err)
+	}
+	form.DefaultLeadId = defaultLeadId.String
+	return &form, nil
+}
+
+func (r *LeadFormRepository) UpsertLeadForm(companyId string, form *pb.LeadForm) error {
+	if err := validateLeadFormFields(form.Fields); err != nil {
+		return err
+	}
+	var defaultLeadId *string
+	if form.DefaultLeadId != "" {
+		var exists bool
+		err := r.db.QueryRow(`SELECT exists(SELECT 1 FROM lead_section where id=$1 and company_id=$2)`, form.DefaultLeadId, companyId).Scan(&exists)
+		if err != nil || !exists {
+			return status.Error(codes.InvalidArgument, "default lead section not found")
+		}
+		defaultLeadId = &form.DefaultLeadId
+	}
+	rateLimit := form.RateLimitPerHour
+	if rateLimit <= 0 {
+		rateLimit = 5
+	}
+	fieldsJSON, err := json.Marshal(form.Fields)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(`
+		INSERT INTO lead_form (company_id, title, fields, default_lead_id, rate_limit_per_hour, is_active, success_message)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (company_id) DO UPDATE
+		SET title = $2, fields = $3, default_lead_id = $4, rate_limit_per_hour = $5, is_active = $6, success_message = $7`,
+		companyId, form.Title, fieldsJSON, defaultLeadId, rateLimit, form.IsActive, form.SuccessMessage)
+	if err != nil {
+		return fmt.Errorf("failed to save lead form: %w", err)
+	}
+	return nil
+}
+
+func validateLeadFormFields(fields []*pb.LeadFormField) error {
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		if strings.TrimSpace(field.Name) == "" {
+			return status.Error(codes.InvalidArgument, "lead form field name is required")
+		}
+		if seen[field.Name] {
+			return status.Errorf(codes.InvalidArgument, "duplicate lead form field %s", field.Name)
+		}
+		seen[field.Name] = true
+	}
+	if !seen["phoneNumber"] {
+		return status.Error(codes.InvalidArgument, "lead form should contain phoneNumber field")
+	}
+	return nil
+}
+
+// SubmitPublicLead stores a lead coming from the public form. Every attempt,
+// including rejected ones, is recorded in lead_form_submission and counts
+// towards the hourly limit. Honeypot hits and duplicates get the same answer as
+// a new lead so the submitter cannot probe which phone numbers are known.
+func (r *LeadFormRepository) SubmitPublicLead(companyId string, req *pb.PublicLeadRequest) (*pb.PublicLeadResponse, error) {
+	form, err := r.GetLeadForm(companyId)
+	if err != nil {
+		return nil, err
+	}
+	if !form.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "lead form is disabled")
+	}
+	successMessage := form.SuccessMessage
+	if successMessage == "" {
+		successMessage = defaultLeadForm().SuccessMessage
+	}
+
+	var submissions int32
+	err = r.db.QueryRow(`SELECT count(*) FROM lead_form_submission where company_id=$1 and client_ip=$2 and created_at > now() - interval '1 hour'`, companyId, req.ClientIp).Scan(&submissions)
+	if err != nil {
+		return nil, err
+	}
+	if submissions >= form.RateLimitPerHour {
+		return nil, status.Error(codes.ResourceExhausted, "too many submissions, try again later")
+	}
+
+	if strings.TrimSpace(req.Website) != "" {
+		_, err = r.db.Exec(`INSERT INTO lead_form_submission(company_id, client_ip, phone_number, is_spam) values ($1, $2, $3, true)`, companyId, req.ClientIp, req.PhoneNumber)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.PublicLeadResponse{Status: http.StatusOK, Message: successMessage}, nil
+	}
+
+	values := map[string]string{"name": req.Name, "phoneNumber": req.PhoneNumber, "comment": req.Comment}
+	for key, value := range req.Extra {
+		if _, ok := values[key]; !ok {
+			values[key] = value
+		}
+	}
+	for _, field := range form.Fields {
+		if field.Required && strings.TrimSpace(values[field.Name]) == "" {
+			return nil, r.rejectSubmission(companyId, req, status.Errorf(codes.InvalidArgument, "%s is required", field.Name))
+		}
+	}
+	phoneNumber, err := utils.NormalizePhone(req.PhoneNumber)
+	if err != nil {
+		return nil, r.rejectSubmission(companyId, req, status.Error(codes.InvalidArgument, "invalid phone number"))
+	}
+
+	var existingId int
+	err = r.db.QueryRow(`SELECT id FROM lead_user where company_id=$1 and phone_number=$2 limit 1`, companyId, phoneNumber).Scan(&existingId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if err == nil {
+		_, err = r.db.Exec(`INSERT INTO lead_form_submission(company_id, client_ip, phone_number, lead_user_id, is_duplicate) values ($1, $2, $3, $4, true)`, companyId, req.ClientIp, req.PhoneNumber, existingId)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.PublicLeadResponse{Status: http.StatusOK, Message: successMessage}, nil
+	}
+
+	source := req.UtmSource
+	if source == "" {
+		source = req.Source
+	}
+	leadId, err := r.resolveLeadSection(companyId, source, form.DefaultLeadId)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = phoneNumber
+	}
+	comment := buildLeadComment(form, values)
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	var leadUserId int
+	err = tx.QueryRow(`
+		INSERT INTO lead_user (phone_number, full_name, lead_id, comment, source, utm_source, utm_medium, utm_campaign, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`,
+		phoneNumber, name, leadId, comment, req.Source, req.UtmSource, req.UtmMedium, req.UtmCampaign, companyId).Scan(&leadUserId)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to create lead data: %w", err)
+	}
+	_, err = tx.Exec(`INSERT INTO lead_form_submission(company_id, client_ip, phone_number, lead_user_id) values ($1, $2, $3, $4)`, companyId, req.ClientIp, req.PhoneNumber, leadUserId)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	metrics.LeadsCreated.WithLabelValues("form").Inc()
+	if err = incrementLeadReports(r.db, companyId, &leadId); err != nil {
+		return nil, err
+	}
+	return &pb.PublicLeadResponse{Status: http.StatusOK, Message: successMessage}, nil
+}
+
+// rejectSubmission records a rejected attempt so that invalid posts are rate
+// limited like accepted ones, and returns the rejection error.
+func (r *LeadFormRepository) rejectSubmission(companyId string, req *pb.PublicLeadRequest, rejection error) error {
+	_, err := r.db.Exec(`INSERT INTO lead_form_submission(company_id, client_ip, phone_number, is_rejected) values ($1, $2, $3, true)`, companyId, req.ClientIp, req.PhoneNumber)
+	if err != nil {
+		return err
+	}
+	return rejection
+}
+
+// resolveLeadSection maps the utm/source value onto a lead section with the same
+// title, falling back to the form default and then to the oldest section.
+func (r *LeadFormRepository) resolveLeadSection(companyId, source, defaultLeadId string) (string, error) {
+	var id int
+	if source != "" {
+		err := r.db.QueryRow(`SELECT id FROM lead_section where company_id=$1 and lower(title)=lower($2)`, companyId, strings.TrimSpace(source)).Scan(&id)
+		if err == nil {
+			return strconv.Itoa(id), nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return "", err
+		}
+	}
+	if defaultLeadId != "" {
+		return defaultLeadId, nil
+	}
+	err := r.db.QueryRow(`SELECT id FROM lead_section where company_id=$1 order by id limit 1`, companyId).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", status.Error(codes.FailedPrecondition, "company has no lead section to accept leads")
+	}
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(id), nil
+}
+
+func buildLeadComment(form *pb.LeadForm, values map[string]string) string {
+	lines := []string{}
+	if comment := strings.TrimSpace(values["comment"]); comment != "" {
+		lines = append(lines, comment)
+	}
+	labels := make(map[string]string)
+	for _, field := range form.Fields {
+		labels[field.Name] = field.Label
+	}
+	var keys []string
+	for key := range values {
+		if key == "name" || key == "phoneNumber" || key == "comment" || strings.TrimSpace(values[key]) == "" {
+			continue
+		}
+		if _, ok := labels[key]; !ok {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		label := labels[key]
+		if label == "" {
+			label = key
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", label, strings.TrimSpace(values[key])))
+	}
+	return strings.Join(lines, "\n")
+}
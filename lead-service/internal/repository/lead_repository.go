@@ -4,7 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"lid-service/proto/pb"
-	"log"
+	"log/slog"
 )
 
 type LeadRepository struct {
@@ -48,7 +48,7 @@ func calculateSet(companyId string, p *pb.GetLeadCommonResponse, db *sql.DB, req
     `
 	rows, err := db.Query(query, companyId)
 	if err != nil {
-		log.Printf("Error fetching sets: %v", err)
+		slog.Error("failed to fetch sets", "company_id", companyId, "error", err)
 		return
 	}
 	defer rows.Close()
@@ -60,7 +60,7 @@ func calculateSet(companyId string, p *pb.GetLeadCommonResponse, db *sql.DB, req
 	for rows.Next() {
 		section := &pb.Section{}
 		if err := rows.Scan(&section.Id, &section.Name); err != nil {
-			log.Printf("Error scanning set section row: %v", err)
+			slog.Error("failed to scan set", "company_id", companyId, "error", err)
 			return
 		}
 		section.Type = "set"
@@ -82,7 +82,7 @@ func calculateExpectations(companyId string, p *pb.GetLeadCommonResponse, db *sq
     `
 	rows, err := db.Query(query, companyId)
 	if err != nil {
-		log.Printf("Error fetching expectations: %v", err)
+		slog.Error("failed to fetch expectations", "company_id", companyId, "error", err)
 		return
 	}
 	defer rows.Close()
@@ -95,7 +95,7 @@ func calculateExpectations(companyId string, p *pb.GetLeadCommonResponse, db *sq
 	for rows.Next() {
 		section := &pb.Section{}
 		if err := rows.Scan(&section.Id, &section.Name); err != nil {
-			log.Printf("Error scanning expectation section row: %v", err)
+			slog.Error("failed to scan expectation", "company_id", companyId, "error", err)
 			return
 		}
 		section.Type = "expectation"
@@ -117,7 +117,7 @@ func calculateLeadsWithDetails(companyId string, p *pb.GetLeadCommonResponse, db
     `
 	rows, err := db.Query(query, companyId)
 	if err != nil {
-		log.Printf("Error fetching lead sections: %v", err)
+		slog.Error("failed to fetch lead sections", "company_id", companyId, "error", err)
 		return
 	}
 	defer rows.Close()
@@ -129,7 +129,7 @@ func calculateLeadsWithDetails(companyId string, p *pb.GetLeadCommonResponse, db
 	for rows.Next() {
 		section := &pb.Section{}
 		if err := rows.Scan(&section.Id, &section.Name); err != nil {
-			log.Printf("Error scanning lead section row: %v", err)
+			slog.Error("failed to scan lead section", "company_id", companyId, "error", err)
 			return
 		}
 		section.Type = "lead"
@@ -159,7 +159,7 @@ func fetchLeadsForSection(companyId string, db *sql.DB, sectionId, sectionType s
 
 	rows, err := db.Query(query, sectionId, companyId)
 	if err != nil {
-		log.Printf("Error fetching leads for section: %v", err)
+		slog.Error("failed to fetch section leads", "company_id", companyId, "section_id", sectionId, "error", err)
 		return nil
 	}
 	defer rows.Close()
@@ -168,7 +168,7 @@ func fetchLeadsForSection(companyId string, db *sql.DB, sectionId, sectionType s
 	for rows.Next() {
 		lead := &pb.Lead{}
 		if err := rows.Scan(&lead.Id, &lead.Name, &lead.Comment, &lead.CreatedAt, &lead.PhoneNumber); err != nil {
-			log.Printf("Error scanning lead row: %v", err)
+			slog.Error("failed to scan lead", "company_id", companyId, "section_id", sectionId, "error", err)
 			return nil
 		}
 		leads = append(leads, lead)
@@ -305,3 +305,25 @@ func (r *LeadRepository) GetActiveLeadCount(companyId string) (*pb.GetActiveLead
 	r.db.QueryRow(`SELECT COUNT(*) FROM lead_user where company_id=$1`, companyId).Scan(&activeLeadCount)
 	return &pb.GetActiveLeadCountResponse{ActiveLeadCount: int32(activeLeadCount)}, nil
 }
+
+// GetTenantUsage counts the leads of every company, and how many of them
+// came in between from and to.
+func (r *LeadRepository) GetTenantUsage(from, to string) (*pb.TenantUsageList, error) {
+	rows, err := r.db.Query(`SELECT company_id, count(*), count(*) FILTER (WHERE created_at >= $1::date AND created_at < $2::date + 1)
+FROM lead_user
+WHERE company_id IS NOT NULL
+GROUP BY company_id`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	response := &pb.TenantUsageList{}
+	for rows.Next() {
+		var usage pb.TenantUsage
+		if err := rows.Scan(&usage.CompanyId, &usage.Count, &usage.Recent); err != nil {
+			return nil, err
+		}
+		response.Items = append(response.Items, &usage)
+	}
+	return response, rows.Err()
+}
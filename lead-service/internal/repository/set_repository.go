@@ -2,9 +2,13 @@ package repository
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"lid-service/proto/pb"
+	"time"
 )
 
 type SetRepository struct {
@@ -48,25 +52,182 @@ func (r *SetRepository) DeleteSet(companyId, id string) error {
 	return nil
 }
 
-func (r *SetRepository) GetLeadDataBySetId(companyId, setId string) ([]string, []string, error) {
-	queryLeadData := `SELECT full_name , phone_number FROM lead_user where set_id=$1 and company_id=$2`
-	rows, err := r.db.Query(queryLeadData, setId, companyId)
+// SetConversionLead is a lead of a set being converted into a group together
+// with the student id reserved for it.
+type SetConversionLead struct {
+	Id          int
+	StudentId   string
+	Name        string
+	PhoneNumber string
+	Comment     string
+	Source      string
+}
+
+// StartSetConversion records a pending conversion of the set and reserves a
+// student id for every lead in it. Only one conversion per set may be pending.
+func (r *SetRepository) StartSetConversion(companyId, setId, actionById, actionByName string) (int, []SetConversionLead, error) {
+	tx, err := r.db.Begin()
 	if err != nil {
-		return nil, nil, err
+		return 0, nil, err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRow(`SELECT exists(SELECT 1 FROM set_section where id=$1 and company_id=$2)`, setId, companyId).Scan(&exists)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !exists {
+		return 0, nil, status.Error(codes.NotFound, "set not found")
+	}
+	var createdBy *string
+	if actionById != "" {
+		createdBy = &actionById
+	}
+	var conversionId int
+	err = tx.QueryRow(`INSERT INTO set_conversion(set_id, created_by, created_by_name, company_id) values ($1, $2, $3, $4) ON CONFLICT DO NOTHING RETURNING id`, setId, createdBy, actionByName, companyId).Scan(&conversionId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil, status.Error(codes.FailedPrecondition, "set is already being converted to a group")
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to start set conversion: %w", err)
+	}
+	rows, err := tx.Query(`
+		INSERT INTO set_conversion_lead(conversion_id, lead_user_id, student_id, full_name, phone_number, comment, source, company_id)
+		SELECT $1, id, gen_random_uuid(), full_name, phone_number, comment, coalesce(utm_source, source), company_id
+		FROM lead_user where set_id=$2 and company_id=$3
+		RETURNING id, student_id, full_name, phone_number, coalesce(comment, ''), coalesce(source, '')`, conversionId, setId, companyId)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reserve students: %w", err)
 	}
 	defer rows.Close()
-	var names, phoneNumbers []string
+	var leads []SetConversionLead
 	for rows.Next() {
-		var name, phoneNumber string
-		err = rows.Scan(&name, &phoneNumber)
-		if err != nil {
-			return nil, nil, err
+		var lead SetConversionLead
+		if err = rows.Scan(&lead.Id, &lead.StudentId, &lead.Name, &lead.PhoneNumber, &lead.Comment, &lead.Source); err != nil {
+			return 0, nil, err
 		}
+		leads = append(leads, lead)
+	}
+	if err = rows.Err(); err != nil {
+		return 0, nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, nil, err
+	}
+	return conversionId, leads, nil
+}
 
-		names = append(names, name)
-		phoneNumbers = append(phoneNumbers, phoneNumber)
+// StaleSetConversion is a conversion taken over by the recovery job.
+type StaleSetConversion struct {
+	Id        int
+	GroupId   string
+	CompanyId string
+	Error     string
+}
+
+// ExpireSetConversions moves the conversions pending for longer than lease to
+// COMPENSATING and returns them, together with those whose compensation has
+// not succeeded yet, for compensation. Moving them frees their sets right away
+// and keeps a late CompleteSetConversion of the original request from
+// succeeding.
+func (r *SetRepository) ExpireSetConversions(lease time.Duration) ([]StaleSetConversion, error) {
+	rows, err := r.db.Query(`
+		WITH expired AS (
+			UPDATE set_conversion SET status='COMPENSATING', error='abandoned while pending'
+			WHERE status='PENDING' and created_at < now() - make_interval(secs => $1)
+			RETURNING id
+		)
+		SELECT id, coalesce(group_id, ''), company_id, coalesce(error, 'abandoned while pending')
+		FROM set_conversion
+		WHERE id IN (SELECT id FROM expired)
+		   OR (status='COMPENSATING' and created_at < now() - make_interval(secs => $1))`, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var conversions []StaleSetConversion
+	for rows.Next() {
+		var conversion StaleSetConversion
+		if err = rows.Scan(&conversion.Id, &conversion.GroupId, &conversion.CompanyId, &conversion.Error); err != nil {
+			return nil, err
+		}
+		conversions = append(conversions, conversion)
+	}
+	return conversions, rows.Err()
+}
+
+// GetConversionLeads returns the leads of a conversion that were not discarded
+// yet, together with their reserved student ids.
+func (r *SetRepository) GetConversionLeads(conversionId int) ([]SetConversionLead, error) {
+	rows, err := r.db.Query(`
+		SELECT id, student_id, full_name, phone_number, coalesce(comment, ''), coalesce(source, '')
+		FROM set_conversion_lead where conversion_id=$1 and status <> 'DISCARDED'`, conversionId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var leads []SetConversionLead
+	for rows.Next() {
+		var lead SetConversionLead
+		if err = rows.Scan(&lead.Id, &lead.StudentId, &lead.Name, &lead.PhoneNumber, &lead.Comment, &lead.Source); err != nil {
+			return nil, err
+		}
+		leads = append(leads, lead)
+	}
+	return leads, rows.Err()
+}
+
+func (r *SetRepository) SetConversionGroup(conversionId int, groupId string) error {
+	_, err := r.db.Exec(`UPDATE set_conversion SET group_id=$1 where id=$2`, groupId, conversionId)
+	return err
+}
+
+func (r *SetRepository) MarkConversionLead(id int, leadStatus string) error {
+	_, err := r.db.Exec(`UPDATE set_conversion_lead SET status=$1 where id=$2`, leadStatus, id)
+	return err
+}
+
+// StartCompensation marks a pending conversion COMPENSATING: what it created
+// is being undone, and the recovery job keeps trying until that succeeds.
+func (r *SetRepository) StartCompensation(conversionId int, cause error) error {
+	_, err := r.db.Exec(`UPDATE set_conversion SET status='COMPENSATING', error=$1 where id=$2 and status='PENDING'`, cause.Error(), conversionId)
+	return err
+}
+
+// FinishSetConversion closes a conversion that did not complete. FAILED means
+// nothing was created, COMPENSATED means every created record was removed
+// again.
+func (r *SetRepository) FinishSetConversion(conversionId int, conversionStatus string, cause error) error {
+	var message *string
+	if cause != nil {
+		text := cause.Error()
+		message = &text
+	}
+	_, err := r.db.Exec(`UPDATE set_conversion SET status=$1, error=$2, finished_at=now() where id=$3`, conversionStatus, message, conversionId)
+	return err
+}
+
+// CompleteSetConversion marks the conversion completed and removes the set,
+// its leads stay recorded in set_conversion_lead. A conversion the recovery job
+// already expired is not completed.
+func (r *SetRepository) CompleteSetConversion(companyId, setId string, conversionId int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	result, err := tx.Exec(`UPDATE set_conversion SET status='COMPLETED', finished_at=now() where id=$1 and status='PENDING'`, conversionId)
+	if err != nil {
+		return err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return status.Error(codes.Aborted, "set conversion was abandoned")
+	}
+	if _, err = tx.Exec(`DELETE FROM set_section WHERE id = $1 and company_id=$2`, setId, companyId); err != nil {
+		return fmt.Errorf("failed to delete set: %w", err)
 	}
-	return names, phoneNumbers, nil
+	return tx.Commit()
 }
 
 func (r *SetRepository) GetById(companyId string, setId string) (*pb.SetDataResponse, error) {
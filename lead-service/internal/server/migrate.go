@@ -0,0 +1,25 @@
+package server
+
+import (
+	_ "github.com/lib/pq"
+	"lid-service/config"
+	"lid-service/internal/repository"
+	"lid-service/migrations"
+	"log"
+)
+
+// RunMigrate serves `lead-service migrate up|down|status`.
+func RunMigrate(args []string) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	db, err := repository.NewPostgresDB(&cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer db.Close()
+	if err = migrations.Command(db, args); err != nil {
+		log.Fatalf("Migration failed: %v", err)
+	}
+}
@@ -1,18 +1,28 @@
 package server
 
 import (
+	"context"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 	"lid-service/config"
 	"lid-service/internal/clients"
+	"lid-service/internal/logging"
+	"lid-service/internal/metrics"
 	"lid-service/internal/repository"
 	"lid-service/internal/service"
+	"lid-service/internal/subscription"
+	"lid-service/internal/tracing"
 	"lid-service/internal/utils"
 	"lid-service/migrations"
 	"lid-service/proto/pb"
 	"log"
+	"log/slog"
 	"net"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 )
 
 func RunServer() {
@@ -20,45 +30,66 @@ func RunServer() {
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	if err = logging.Init("lead-service", cfg.Log.Level); err != nil {
+		log.Fatalf("Failed to set up logging: %v", err)
+	}
+	slog.Info("loaded config", "config", cfg.String())
 	db, err := repository.NewPostgresDB(&cfg.Database)
 	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+		logging.Fatal("failed to initialize database", err)
 	}
 	defer db.Close()
 	migrations.SetUpMigrating(cfg.Database.Action, db)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	flushTraces, err := tracing.Init(ctx, "lead-service", cfg.Tracing.Exporter, cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+	if err != nil {
+		logging.Fatal("failed to set up tracing", err)
+	}
+	defer flushTraces()
+	metrics.RegisterDB(db, "lead")
+	go metrics.Serve(ctx, cfg.Metrics.Port)
+
 	// lead_service_clients_start
 	groupClient := clients.NewGroupClient(cfg.Grpc.EducationService.Address)
 	studentClient := clients.NewStudentClient(cfg.Grpc.EducationService.Address)
 	userClient := clients.NewUserClient(cfg.Grpc.UserService.Address)
+	companyClient := clients.NewCompanyClient(cfg.Grpc.EducationService.Address)
+	enforcer := subscription.NewEnforcer(companyClient.GetSubscription)
 
 	// lead_service_services_start
 	expectRepo := repository.NewExpectRepository(db)
 	setRepo := repository.NewSetRepository(db)
 	leadRepo := repository.NewLeadRepository(db)
 	leadDataRepo := repository.NewLeadDataRepository(db)
+	leadFormRepo := repository.NewLeadFormRepository(db)
 
 	leadService := service.NewLeadService(leadRepo)
 	expectService := service.NewExpectService(expectRepo)
 	setService := service.NewSetService(setRepo, groupClient, studentClient, userClient)
 	leadDataService := service.NewLeadDataService(leadDataRepo)
+	leadFormService := service.NewLeadFormService(leadFormRepo)
 	// lead_service_services_end
+	go setService.RunConversionRecovery(ctx, time.Minute)
 
 	lis, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.Server.Port))
 	if err != nil {
-		log.Fatalf("Failed to listen on port %v: %v", cfg.Server.Port, err)
+		logging.Fatal("failed to listen", err)
 	}
 
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(utils.RecoveryInterceptor),
+		grpc.StatsHandler(tracing.ServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor, logging.UnaryServerInterceptor, utils.RecoveryInterceptor, enforcer.UnaryServerInterceptor),
 	)
+	healthServer := registerHealth(ctx, grpcServer, db)
 	pb.RegisterLeadServiceServer(grpcServer, leadService)
 	pb.RegisterLeadDataServiceServer(grpcServer, leadDataService)
 	pb.RegisterExpectServiceServer(grpcServer, expectService)
 	pb.RegisterSetServiceServer(grpcServer, setService)
+	pb.RegisterLeadFormServiceServer(grpcServer, leadFormService)
 
-	log.Printf("Server listening on port %v", cfg.Server.Port)
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
-	}
+	slog.Info("server listening", "port", cfg.Server.Port)
+	serve(ctx, grpcServer, healthServer, lis)
+	slog.Info("server stopped")
 }
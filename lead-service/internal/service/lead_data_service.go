@@ -7,6 +7,7 @@ import (
 	"lid-service/internal/repository"
 	"lid-service/internal/utils"
 	"lid-service/proto/pb"
+	"strings"
 )
 
 type LeadDataService struct {
@@ -24,10 +25,13 @@ func (s *LeadDataService) CreateLeadData(ctx context.Context, req *pb.CreateLead
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	err := s.repo.CreateLeadData(companyId, &req.PhoneNumber, &req.LeadId, nil, nil, &req.Comment, &req.Name)
+	duplicates, err := s.repo.CreateLeadData(companyId, &req.PhoneNumber, &req.LeadId, nil, nil, &req.Comment, &req.Name)
 	if err != nil {
 		return &pb.AbsResponse{Status: 500, Message: "Failed to create lead data: " + err.Error()}, err
 	}
+	if len(duplicates) > 0 {
+		return &pb.AbsResponse{Status: 200, Message: "Lead data created successfully; warning: phone number already used by " + strings.Join(duplicates, ", ")}, nil
+	}
 	return &pb.AbsResponse{Status: 200, Message: "Lead data created successfully"}, nil
 }
 
@@ -68,3 +72,16 @@ func (s *LeadDataService) ChangeLeadPlace(ctx context.Context, req *pb.ChangeLea
 	}
 	return &pb.AbsResponse{Status: 200, Message: "Lead data changed successfully"}, nil
 }
+
+// MergeLeadData folds a duplicated lead into another one
+func (s *LeadDataService) MergeLeadData(ctx context.Context, req *pb.MergeLeadDataRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	err := s.repo.MergeLeadData(companyId, req.SourceId, req.TargetId)
+	if err != nil {
+		return &pb.AbsResponse{Status: 500, Message: "Failed to merge lead data: " + err.Error()}, err
+	}
+	return &pb.AbsResponse{Status: 200, Message: "Lead data merged successfully"}, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"lid-service/internal/repository"
+	"lid-service/internal/utils"
+	"lid-service/proto/pb"
+)
+
+type LeadFormService struct {
+	pb.UnimplementedLeadFormServiceServer
+	repo *repository.LeadFormRepository
+}
+
+func NewLeadFormService(repo *repository.LeadFormRepository) *LeadFormService {
+	return &LeadFormService{repo: repo}
+}
+
+func (s *LeadFormService) GetLeadForm(ctx context.Context, req *emptypb.Empty) (*pb.LeadForm, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetLeadForm(companyId)
+}
+
+func (s *LeadFormService) UpsertLeadForm(ctx context.Context, req *pb.LeadForm) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	err := s.repo.UpsertLeadForm(companyId, req)
+	if err != nil {
+		return &pb.AbsResponse{Status: 500, Message: "Failed to save lead form: " + err.Error()}, err
+	}
+	return &pb.AbsResponse{Status: 200, Message: "Lead form saved successfully"}, nil
+}
+
+// SubmitPublicLead is called by the gateway for unauthenticated submissions,
+// the company is resolved from the subdomain before reaching this service.
+func (s *LeadFormService) SubmitPublicLead(ctx context.Context, req *pb.PublicLeadRequest) (*pb.PublicLeadResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if req.ClientIp == "" {
+		return nil, status.Error(codes.InvalidArgument, "client ip is required")
+	}
+	return s.repo.SubmitPublicLead(companyId, req)
+}
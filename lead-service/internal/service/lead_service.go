@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"lid-service/internal/repository"
+	"lid-service/internal/subscription"
 	"lid-service/internal/utils"
 	"lid-service/proto/pb"
 )
@@ -79,6 +80,14 @@ func (s *LeadService) GetLeadReports(ctx context.Context, req *pb.GetLeadReports
 	return s.repo.GetLeadReports(companyId, req.EndYear, req.StartYear)
 }
 
+// GetTenantUsage is for the platform analytics of education-service only.
+func (s *LeadService) GetTenantUsage(ctx context.Context, req *pb.TenantUsageRequest) (*pb.TenantUsageList, error) {
+	if !subscription.FromPeer(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "tenant usage is only available to the platform services")
+	}
+	return s.repo.GetTenantUsage(req.From, req.To)
+}
+
 func (s *LeadService) GetActiveLeadCount(ctx context.Context, req *emptypb.Empty) (*pb.GetActiveLeadCountResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -9,10 +10,35 @@ import (
 	"lid-service/internal/repository"
 	"lid-service/internal/utils"
 	"lid-service/proto/pb"
+	"log/slog"
 	"strconv"
 	"time"
 )
 
+// setConversionLease is how long a conversion may stay PENDING. The calls of a
+// conversion share a much shorter timeout, so one still pending afterwards
+// belongs to a request that died half way, and none of its calls can still be
+// running in education-service.
+const setConversionLease = 5 * time.Minute
+
+// conversionGroupKey is the request key the group of a conversion is created
+// with, so it can be found when the reply of CreateGroup was lost.
+func conversionGroupKey(conversionId int) string {
+	return "set-conversion-" + strconv.Itoa(conversionId)
+}
+
+// rejected tells whether education-service refused a call outright, so it
+// cannot have created anything. After a timeout or a lost connection the call
+// may have gone through all the same.
+func rejected(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied, codes.FailedPrecondition,
+		codes.OutOfRange, codes.Unimplemented, codes.Unauthenticated:
+		return true
+	}
+	return false
+}
+
 type SetService struct {
 	pb.UnimplementedSetServiceServer
 	repo          *repository.SetRepository
@@ -95,30 +121,118 @@ func (s *SetService) ChangeToSet(ctx context.Context, req *pb.ChangeToSetRequest
 		GroupStartDate:  req.StartDate,
 		GroupEndDate:    req.EndDate,
 	}
+	if req.ActionById == "" {
+		return nil, status.Error(codes.InvalidArgument, "acting user is required")
+	}
+	conversionId, leads, err := s.repo.StartSetConversion(companyId, req.SetId, req.ActionById, req.ActionByName)
+	if err != nil {
+		return nil, err
+	}
 	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 	defer cancelFunc()
+	createGroupReq.RequestKey = conversionGroupKey(conversionId)
 	err, groupId := s.groupClient.CreateGroup(ctx, &createGroupReq)
 	if err != nil {
+		if rejected(err) {
+			_ = s.repo.FinishSetConversion(conversionId, "FAILED", err)
+			return nil, err
+		}
+		// the group may exist and its creation may even still be running, so
+		// the recovery job removes it by its request key once the lease is over
+		_ = s.repo.StartCompensation(conversionId, err)
 		return nil, err
 	}
-	currentDate := time.Now().Format("2006-01-02")
-	names, phoneNumbers, err := s.repo.GetLeadDataBySetId(companyId, req.SetId)
-	if err != nil {
-		return nil, err
+	if err = s.repo.SetConversionGroup(conversionId, groupId); err != nil {
+		return nil, s.compensateSetConversion(companyId, conversionId, groupId, nil, err)
 	}
-	length := min(len(names), len(phoneNumbers))
-	for i := 0; i < length; i++ {
-		_, err = s.studentClient.CreateStudent(ctx, phoneNumbers[i], names[i], "2006-12-14", groupId, currentDate, "1b39d121-7840-4411-bcfe-87c1beb9422b", true)
+	currentDate := time.Now().Format("2006-01-02")
+	var created []repository.SetConversionLead
+	for _, lead := range leads {
+		_, err = s.studentClient.CreateStudent(ctx, lead.StudentId, lead.PhoneNumber, lead.Name, "", groupId, currentDate, req.ActionById, lead.Comment, lead.Source, true)
+		// the student may exist even when the call failed, e.g. on a timeout,
+		// so its reserved id is discarded as well
+		created = append(created, lead)
 		if err != nil {
-			return nil, err
+			return nil, s.compensateSetConversion(companyId, conversionId, groupId, created, fmt.Errorf("failed to create student %s: %w", lead.Name, err))
+		}
+		if err = s.repo.MarkConversionLead(lead.Id, "CREATED"); err != nil {
+			return nil, s.compensateSetConversion(companyId, conversionId, groupId, created, err)
 		}
 	}
-	err = s.repo.DeleteSet(companyId, req.SetId)
-	if err != nil {
-		return nil, err
+	if err = s.repo.CompleteSetConversion(companyId, req.SetId, conversionId); err != nil {
+		return nil, s.compensateSetConversion(companyId, conversionId, groupId, created, err)
 	}
 	return &pb.AbsResponse{Status: 200, Message: "Set changed to group successfully"}, nil
 }
+
+// compensateSetConversion undoes a partially applied conversion and returns
+// the original cause. A conversion that could not be undone stays
+// COMPENSATING for the recovery job to try again.
+func (s *SetService) compensateSetConversion(companyId string, conversionId int, groupId string, created []repository.SetConversionLead, cause error) error {
+	_ = s.repo.StartCompensation(conversionId, cause)
+	if err := s.undoSetConversion(companyId, conversionId, groupId, created, cause); err != nil {
+		slog.Error("failed to compensate set conversion, the recovery job retries it", "conversion_id", conversionId, "company_id", companyId, "error", err)
+	}
+	return cause
+}
+
+// undoSetConversion discards the students created so far and the group in
+// education-service, the set with its leads is left untouched. The group is
+// found by its request key when its id is not known. It runs on its own
+// context so that a conversion that failed on a timeout can still be undone.
+func (s *SetService) undoSetConversion(companyId string, conversionId int, groupId string, created []repository.SetConversionLead, cause error) error {
+	ctx, cancelFunc := utils.NewTimoutContext(context.Background(), companyId)
+	defer cancelFunc()
+	studentIds := make([]string, 0, len(created))
+	for _, lead := range created {
+		studentIds = append(studentIds, lead.StudentId)
+	}
+	if _, err := s.studentClient.DiscardStudents(ctx, studentIds, groupId, conversionGroupKey(conversionId)); err != nil {
+		return err
+	}
+	for _, lead := range created {
+		_ = s.repo.MarkConversionLead(lead.Id, "DISCARDED")
+	}
+	return s.repo.FinishSetConversion(conversionId, "COMPENSATED", cause)
+}
+
+// RunConversionRecovery compensates, every interval until ctx is done, the
+// conversions left PENDING longer than setConversionLease by a request that
+// never finished, so their sets can be converted again, and retries the
+// compensations that did not succeed.
+func (s *SetService) RunConversionRecovery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.recoverStaleConversions()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SetService) recoverStaleConversions() {
+	conversions, err := s.repo.ExpireSetConversions(setConversionLease)
+	if err != nil {
+		slog.Error("failed to expire stale set conversions", "error", err)
+		return
+	}
+	for _, conversion := range conversions {
+		leads, err := s.repo.GetConversionLeads(conversion.Id)
+		if err != nil {
+			slog.Error("failed to load leads of a stale set conversion", "conversion_id", conversion.Id, "error", err)
+			continue
+		}
+		if err = s.undoSetConversion(conversion.CompanyId, conversion.Id, conversion.GroupId, leads, errors.New(conversion.Error)); err != nil {
+			slog.Error("failed to compensate stale set conversion", "conversion_id", conversion.Id, "company_id", conversion.CompanyId, "error", err)
+			continue
+		}
+		slog.Warn("compensated stale set conversion", "conversion_id", conversion.Id, "company_id", conversion.CompanyId)
+	}
+}
+
 func (s *SetService) GetById(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.SetDataResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
@@ -0,0 +1,141 @@
+package subscription
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"lid-service/proto/pb"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	StateActive = "ACTIVE"
+	StateGrace  = "GRACE"
+	StateLocked = "LOCKED"
+
+	// CallerKey is set by the services on calls to each other. Such calls
+	// are made for a request that was already let through, or by a job.
+	CallerKey = "caller"
+
+	cacheTTL = time.Minute
+)
+
+// exemptServices stay reachable for locked companies: the company's own
+// status and billing, the platform owner's management of companies and
+// login, which checks the subscription itself.
+var exemptServices = map[string]bool{
+	"education.CompanyService":        true,
+	"education.CompanyFinanceService": true,
+	"education.TariffService":         true,
+	"education.BillingService":        true,
+	"user.AuthService":                true,
+	"grpc.health.v1.Health":           true,
+}
+
+var readPrefixes = []string{"Get", "Search", "Find", "Calculate"}
+
+// Source looks the subscription of a company up.
+type Source func(ctx context.Context, companyId string) (*pb.CompanySubscription, error)
+
+type cached struct {
+	state   string
+	expires time.Time
+}
+
+// Enforcer rejects calls of companies whose subscription ran out: while in
+// the grace period only reads are allowed, once locked nothing is. States
+// are cached for a minute, a payment takes effect within that.
+type Enforcer struct {
+	source Source
+	mu     sync.Mutex
+	states map[string]cached
+}
+
+func NewEnforcer(source Source) *Enforcer {
+	return &Enforcer{source: source, states: map[string]cached{}}
+}
+
+func (e *Enforcer) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := e.check(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (e *Enforcer) check(ctx context.Context, fullMethod string) error {
+	service, method := splitMethod(fullMethod)
+	if exemptServices[service] {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	companyId := first(md, "company_id")
+	if companyId == "" || first(md, CallerKey) != "" || first(md, "role") == "SUPER_CEO" {
+		return nil
+	}
+	state, err := e.state(ctx, companyId)
+	if err != nil {
+		// an unknown state must not take every tenant down with it
+		slog.WarnContext(ctx, "failed to check subscription, letting the call through", "error", err)
+		return nil
+	}
+	switch {
+	case state == StateLocked:
+		return status.Error(codes.PermissionDenied, "subscription expired, the company is locked until it is renewed")
+	case state == StateGrace && !isRead(method):
+		return status.Error(codes.FailedPrecondition, "subscription expired, the company is read-only until it is renewed")
+	}
+	return nil
+}
+
+func (e *Enforcer) state(ctx context.Context, companyId string) (string, error) {
+	e.mu.Lock()
+	entry, ok := e.states[companyId]
+	e.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.state, nil
+	}
+	sub, err := e.source(ctx, companyId)
+	if err != nil {
+		return "", err
+	}
+	e.mu.Lock()
+	e.states[companyId] = cached{state: sub.State, expires: time.Now().Add(cacheTTL)}
+	e.mu.Unlock()
+	return sub.State, nil
+}
+
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "", fullMethod
+}
+
+func isRead(method string) bool {
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// FromPeer tells whether a call was made by one of the platform services,
+// not on behalf of a company's user.
+func FromPeer(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return first(md, CallerKey) != ""
+}
@@ -7,6 +7,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"log/slog"
+	"regexp"
+	"runtime/debug"
+	"strings"
 	"time"
 )
 
@@ -18,7 +22,7 @@ func RecoveryInterceptor(
 ) (resp interface{}, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Recovered from panic in gRPC call: %v\n", r)
+			slog.ErrorContext(ctx, "recovered from panic in gRPC call", "panic", r, "stack", string(debug.Stack()))
 			err = status.Errorf(codes.Internal, "Internal server error")
 		}
 	}()
@@ -41,3 +45,25 @@ func NewTimoutContext(ctx context.Context, companyId string) (context.Context, c
 	res, cancelFunc := context.WithTimeout(ctx, time.Second*15)
 	return res, cancelFunc
 }
+
+var phoneDigitsRegex = regexp.MustCompile(`\D`)
+
+// NormalizePhone converts a phone number into E.164 form. Numbers written
+// without a country code are treated as Uzbek ones.
+func NormalizePhone(phone string) (string, error) {
+	trimmed := strings.TrimSpace(phone)
+	digits := phoneDigitsRegex.ReplaceAllString(trimmed, "")
+	switch {
+	case strings.HasPrefix(trimmed, "+"):
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	case len(digits) == 9:
+		digits = "998" + digits
+	case len(digits) == 10 && strings.HasPrefix(digits, "8"):
+		digits = "998" + digits[1:]
+	}
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", fmt.Errorf("invalid phone number: %s", phone)
+	}
+	return "+" + digits, nil
+}
@@ -1,4 +0,0 @@
-DROP TABLE IF EXISTS lid_user;
-DROP TABLE IF EXISTS set;
-DROP TABLE IF EXISTS expect;
-DROP TABLE IF EXISTS lid;
\ No newline at end of file
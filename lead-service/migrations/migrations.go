@@ -1,43 +1,361 @@
 package migrations
 
 import (
+	"context"
+	"crypto/sha256"
 	"database/sql"
+	"embed"
+	"encoding/hex"
 	"fmt"
-	"io/ioutil"
-	"log"
+	"lid-service/internal/logging"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
-func readSQLFile(filepath string) (string, error) {
-	bytes, err := ioutil.ReadFile(filepath)
-	if err != nil {
-		return "", err
-	}
-	return string(bytes), nil
+// Migrations live in sql/ as NNNN_name.up.sql and NNNN_name.down.sql and are
+// compiled into the binary, so the service does not depend on its working
+// directory to find them.
+//
+//go:embed sql/*.sql
+var files embed.FS
+
+// lockKey is the postgres advisory lock held while migrating. Replicas that
+// start together wait for each other instead of racing on the schema.
+const lockKey int64 = 7340003
+
+const createTableQuery = `
+	CREATE TABLE IF NOT EXISTS schema_migrations
+	(
+	    version    int PRIMARY KEY,
+	    name       varchar   NOT NULL,
+	    checksum   varchar   NOT NULL,
+	    applied_at timestamp NOT NULL DEFAULT now()
+	)`
+
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
 }
 
+type Status struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+	Modified  bool
+}
+
+// SetUpMigrating is called on start up. With action "up" every pending
+// migration is applied; rolling back is only done through `migrate down`.
 func SetUpMigrating(action string, db *sql.DB) {
-	var sqlFile string
-	var err error
+	switch action {
+	case "up":
+		applied, err := Up(db, 0)
+		if err != nil {
+			logging.Fatal("failed to execute migrations", err)
+		}
+		slog.Info("migrations executed", "applied", applied)
+	case "down":
+		slog.Warn("down migrations are not run on start up, use the `migrate down` command")
+	default:
+		slog.Info("migrations skipped", "action", action)
+	}
+}
+
+// Load reads the embedded migrations ordered by version.
+func Load() ([]Migration, error) {
+	entries, err := files.ReadDir("sql")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		fileName := entry.Name()
+		base, direction, ok := strings.Cut(strings.TrimSuffix(fileName, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("unexpected migration file %s", fileName)
+		}
+		prefix, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("unexpected migration file %s", fileName)
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("bad version in migration file %s", fileName)
+		}
+		body, err := files.ReadFile("sql/" + fileName)
+		if err != nil {
+			return nil, err
+		}
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		} else if migration.Name != name {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, name)
+		}
+		if direction == "up" {
+			migration.Up = string(body)
+			sum := sha256.Sum256(body)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(body)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %04d_%s has no up file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
 
-	if action == "up" {
-		sqlFile, err = readSQLFile("migrations/lid_service_up.sql")
+// Up applies at most limit pending migrations, all of them when limit is 0.
+// It refuses to run when an applied migration was edited afterwards.
+func Up(db *sql.DB, limit int) (int, error) {
+	migrations, err := Load()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	err = withLock(db, func(conn *sql.Conn) error {
+		applied, err := appliedChecksums(conn)
 		if err != nil {
-			log.Fatalf("Failed to read up migration file: %v", err)
+			return err
+		}
+		if err = verifyChecksums(migrations, applied); err != nil {
+			return err
 		}
-	} else if action == "down" {
-		sqlFile, err = readSQLFile("migrations/lid_service_down.sql")
+		for _, migration := range migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			if limit > 0 && count == limit {
+				break
+			}
+			err = inTx(conn, func(tx *sql.Tx) error {
+				if _, err := tx.Exec(migration.Up); err != nil {
+					return err
+				}
+				_, err := tx.Exec(`INSERT INTO schema_migrations(version, name, checksum) values ($1, $2, $3)`,
+					migration.Version, migration.Name, migration.Checksum)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migration %04d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			slog.Info("applied migration", "version", migration.Version, "name", migration.Name)
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Down rolls back the last steps applied migrations.
+func Down(db *sql.DB, steps int) (int, error) {
+	migrations, err := Load()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	err = withLock(db, func(conn *sql.Conn) error {
+		applied, err := appliedChecksums(conn)
 		if err != nil {
-			log.Fatalf("Failed to read down migration file: %v", err)
+			return err
+		}
+		if err = verifyChecksums(migrations, applied); err != nil {
+			return err
+		}
+		for i := len(migrations) - 1; i >= 0 && count < steps; i-- {
+			migration := migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("migration %04d_%s has no down file", migration.Version, migration.Name)
+			}
+			err = inTx(conn, func(tx *sql.Tx) error {
+				if _, err := tx.Exec(migration.Down); err != nil {
+					return err
+				}
+				_, err := tx.Exec(`DELETE FROM schema_migrations where version=$1`, migration.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("rollback of %04d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			slog.Info("rolled back migration", "version", migration.Version, "name", migration.Name)
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// GetStatus lists every known migration with the time it was applied.
+func GetStatus(db *sql.DB) ([]Status, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(createTableQuery); err != nil {
+		return nil, err
+	}
+	rows, err := db.Query(`SELECT version, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	type appliedRow struct {
+		checksum  string
+		appliedAt time.Time
+	}
+	applied := map[int]appliedRow{}
+	for rows.Next() {
+		var version int
+		var row appliedRow
+		if err = rows.Scan(&version, &row.checksum, &row.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = row
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, 0, len(migrations))
+	for _, migration := range migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if row, ok := applied[migration.Version]; ok {
+			appliedAt := row.appliedAt
+			status.AppliedAt = &appliedAt
+			status.Modified = row.checksum != migration.Checksum
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func appliedChecksums(conn *sql.Conn) (map[int]string, error) {
+	rows, err := conn.QueryContext(context.Background(), `SELECT version, checksum FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	applied := map[int]string{}
+	for rows.Next() {
+		var version int
+		var checksum string
+		if err = rows.Scan(&version, &checksum); err != nil {
+			return nil, err
+		}
+		applied[version] = checksum
+	}
+	return applied, rows.Err()
+}
+
+func verifyChecksums(migrations []Migration, applied map[int]string) error {
+	known := make(map[int]bool, len(migrations))
+	for _, migration := range migrations {
+		known[migration.Version] = true
+		if checksum, ok := applied[migration.Version]; ok && checksum != migration.Checksum {
+			return fmt.Errorf("migration %04d_%s was changed after it was applied, add a new migration instead", migration.Version, migration.Name)
 		}
-	} else {
-		log.Println("no action")
-		return
 	}
+	for version := range applied {
+		if !known[version] {
+			return fmt.Errorf("database has migration %04d that this build does not know about", version)
+		}
+	}
+	return nil
+}
+
+// withLock runs fn on a single connection holding the advisory lock, the
+// session lock is tied to that connection and released with it.
+func withLock(db *sql.DB, fn func(conn *sql.Conn) error) error {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, lockKey); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, lockKey)
+	if _, err = conn.ExecContext(ctx, createTableQuery); err != nil {
+		return err
+	}
+	return fn(conn)
+}
 
-	_, err = db.Exec(sqlFile)
+func inTx(conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(context.Background(), nil)
 	if err != nil {
-		log.Fatalf("Failed to execute migration: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+	if err = fn(tx); err != nil {
+		return err
 	}
+	return tx.Commit()
+}
 
-	fmt.Printf("Migration '%s' executed successfully\n", action)
+// Command runs the `migrate` subcommand: up [n], down [n] or status. Down
+// rolls back one migration unless a count is given.
+func Command(db *sql.DB, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up [n] | down [n] | status")
+	}
+	count := 0
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n <= 0 {
+			return fmt.Errorf("migration count must be a positive number, got %q", args[1])
+		}
+		count = n
+	}
+	switch args[0] {
+	case "up":
+		applied, err := Up(db, count)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d migration(s) applied\n", applied)
+	case "down":
+		if count == 0 {
+			count = 1
+		}
+		rolledBack, err := Down(db, count)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d migration(s) rolled back\n", rolledBack)
+	case "status":
+		statuses, err := GetStatus(db)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if status.Modified {
+				state += " (modified after apply)"
+			}
+			fmt.Printf("%04d_%-40s %s\n", status.Version, status.Name, state)
+		}
+	default:
+		return fmt.Errorf("unknown migrate command %q, use up, down or status", args[0])
+	}
+	return nil
 }
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS lead_conversion_reports;
+DROP TABLE IF EXISTS lead_source_reports;
+DROP TABLE IF EXISTS lead_user;
+DROP TABLE IF EXISTS set_section;
+DROP TABLE IF EXISTS expect_section;
+DROP TABLE IF EXISTS lead_section;
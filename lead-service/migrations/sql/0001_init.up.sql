@@ -58,4 +58,4 @@ CREATE TABLE IF NOT EXISTS lead_conversion_reports
     conversion_date varchar,
     created_at      timestamp DEFAULT NOW(),
     company_id      int
-);
\ No newline at end of file
+);
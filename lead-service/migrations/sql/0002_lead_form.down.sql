@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS lead_form_submission;
+DROP TABLE IF EXISTS lead_form;
+ALTER TABLE lead_user
+    DROP COLUMN IF EXISTS utm_campaign,
+    DROP COLUMN IF EXISTS utm_medium,
+    DROP COLUMN IF EXISTS utm_source,
+    DROP COLUMN IF EXISTS source;
@@ -0,0 +1,32 @@
+ALTER TABLE lead_user
+    ADD COLUMN IF NOT EXISTS source       varchar,
+    ADD COLUMN IF NOT EXISTS utm_source   varchar,
+    ADD COLUMN IF NOT EXISTS utm_medium   varchar,
+    ADD COLUMN IF NOT EXISTS utm_campaign varchar;
+
+CREATE TABLE IF NOT EXISTS lead_form
+(
+    id                  serial PRIMARY KEY,
+    company_id          int     NOT NULL UNIQUE,
+    title               varchar NOT NULL,
+    fields              jsonb   NOT NULL DEFAULT '[]',
+    default_lead_id     int REFERENCES lead_section (id) ON DELETE SET NULL,
+    rate_limit_per_hour int     NOT NULL DEFAULT 5 CHECK (rate_limit_per_hour > 0),
+    is_active           boolean NOT NULL DEFAULT true,
+    success_message     varchar,
+    created_at          timestamp        DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS lead_form_submission
+(
+    id           serial PRIMARY KEY,
+    company_id   int     NOT NULL,
+    client_ip    varchar NOT NULL,
+    phone_number varchar,
+    lead_user_id int REFERENCES lead_user (id) ON DELETE SET NULL,
+    is_spam      boolean NOT NULL DEFAULT false,
+    is_duplicate boolean NOT NULL DEFAULT false,
+    created_at   timestamp        DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_lead_form_submission_rate ON lead_form_submission (company_id, client_ip, created_at);
@@ -0,0 +1,2 @@
+-- normalized phone numbers are kept, the original formatting is not recoverable
+DROP INDEX IF EXISTS idx_lead_user_company_phone;
@@ -0,0 +1,10 @@
+UPDATE lead_user
+SET phone_number = '+998' || regexp_replace(phone_number, '\D', '', 'g')
+WHERE length(regexp_replace(phone_number, '\D', '', 'g')) = 9;
+
+UPDATE lead_user
+SET phone_number = '+' || regexp_replace(phone_number, '\D', '', 'g')
+WHERE phone_number !~ '^\+[1-9][0-9]{7,14}$'
+  AND regexp_replace(phone_number, '\D', '', 'g') ~ '^[1-9][0-9]{9,14}$';
+
+CREATE INDEX IF NOT EXISTS idx_lead_user_company_phone ON lead_user (company_id, phone_number);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS set_conversion_lead;
+DROP TABLE IF EXISTS set_conversion;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS set_conversion
+(
+    id              serial PRIMARY KEY,
+    set_id          int     NOT NULL,
+    group_id        varchar,
+    status          varchar NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'COMPLETED', 'COMPENSATED', 'FAILED')),
+    error           text,
+    created_by      uuid,
+    created_by_name varchar,
+    created_at      timestamp        DEFAULT now(),
+    finished_at     timestamp,
+    company_id      int
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_set_conversion_pending ON set_conversion (set_id) WHERE status = 'PENDING';
+
+CREATE TABLE IF NOT EXISTS set_conversion_lead
+(
+    id            serial PRIMARY KEY,
+    conversion_id int REFERENCES set_conversion (id) NOT NULL,
+    lead_user_id  int                                NOT NULL,
+    student_id    uuid                               NOT NULL,
+    full_name     varchar                            NOT NULL,
+    phone_number  varchar                            NOT NULL,
+    comment       varchar,
+    source        varchar,
+    status        varchar                            NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'CREATED', 'DISCARDED')),
+    company_id    int
+);
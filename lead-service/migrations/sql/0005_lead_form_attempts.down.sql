@@ -0,0 +1,3 @@
+ALTER TABLE lead_form_submission DROP COLUMN IF EXISTS is_rejected;
+
+ALTER TABLE lead_form ALTER COLUMN is_active SET DEFAULT true;
@@ -0,0 +1,3 @@
+ALTER TABLE lead_form ALTER COLUMN is_active SET DEFAULT false;
+
+ALTER TABLE lead_form_submission ADD COLUMN IF NOT EXISTS is_rejected boolean NOT NULL DEFAULT false;
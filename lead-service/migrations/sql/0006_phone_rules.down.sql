@@ -0,0 +1 @@
+-- normalized phone numbers are kept, the original formatting is not recoverable
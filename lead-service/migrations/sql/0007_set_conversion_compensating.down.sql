@@ -0,0 +1,9 @@
+UPDATE set_conversion
+SET status      = 'FAILED',
+    finished_at = now()
+WHERE status = 'COMPENSATING';
+
+ALTER TABLE set_conversion
+    DROP CONSTRAINT IF EXISTS set_conversion_status_check;
+ALTER TABLE set_conversion
+    ADD CONSTRAINT set_conversion_status_check CHECK (status IN ('PENDING', 'COMPLETED', 'COMPENSATED', 'FAILED'));
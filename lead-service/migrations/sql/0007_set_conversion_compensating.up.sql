@@ -0,0 +1,11 @@
+ALTER TABLE set_conversion
+    DROP CONSTRAINT IF EXISTS set_conversion_status_check;
+ALTER TABLE set_conversion
+    ADD CONSTRAINT set_conversion_status_check CHECK (status IN ('PENDING', 'COMPENSATING', 'COMPLETED', 'COMPENSATED', 'FAILED'));
+
+-- conversions whose compensation failed are undone again by the recovery job
+UPDATE set_conversion
+SET status      = 'COMPENSATING',
+    finished_at = NULL
+WHERE status = 'FAILED'
+  AND error LIKE '%compensation failed%';
@@ -19,14 +19,118 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GroupService_CreateGroup_FullMethodName = "/education.GroupService/CreateGroup"
+	CompanyService_GetSubscription_FullMethodName = "/education.CompanyService/GetSubscription"
 )
 
-// GroupServiceClient is the client API for GroupService service.
+// CompanyServiceClient is the client API for CompanyService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
 // group service start
+type CompanyServiceClient interface {
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*CompanySubscription, error)
+}
+
+type companyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCompanyServiceClient(cc grpc.ClientConnInterface) CompanyServiceClient {
+	return &companyServiceClient{cc}
+}
+
+func (c *companyServiceClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*CompanySubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanySubscription)
+	err := c.cc.Invoke(ctx, CompanyService_GetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CompanyServiceServer is the server API for CompanyService service.
+// All implementations must embed UnimplementedCompanyServiceServer
+// for forward compatibility.
+//
+// group service start
+type CompanyServiceServer interface {
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*CompanySubscription, error)
+	mustEmbedUnimplementedCompanyServiceServer()
+}
+
+// UnimplementedCompanyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCompanyServiceServer struct{}
+
+func (UnimplementedCompanyServiceServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*CompanySubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (UnimplementedCompanyServiceServer) mustEmbedUnimplementedCompanyServiceServer() {}
+func (UnimplementedCompanyServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCompanyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompanyServiceServer will
+// result in compilation errors.
+type UnsafeCompanyServiceServer interface {
+	mustEmbedUnimplementedCompanyServiceServer()
+}
+
+func RegisterCompanyServiceServer(s grpc.ServiceRegistrar, srv CompanyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCompanyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CompanyService_ServiceDesc, srv)
+}
+
+func _CompanyService_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CompanyService_ServiceDesc is the grpc.ServiceDesc for CompanyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CompanyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.CompanyService",
+	HandlerType: (*CompanyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSubscription",
+			Handler:    _CompanyService_GetSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	GroupService_CreateGroup_FullMethodName = "/education.GroupService/CreateGroup"
+)
+
+// GroupServiceClient is the client API for GroupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GroupServiceClient interface {
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
@@ -52,8 +156,6 @@ func (c *groupServiceClient) CreateGroup(ctx context.Context, in *CreateGroupReq
 // GroupServiceServer is the server API for GroupService service.
 // All implementations must embed UnimplementedGroupServiceServer
 // for forward compatibility.
-//
-// group service start
 type GroupServiceServer interface {
 	CreateGroup(context.Context, *CreateGroupRequest) (*AbsResponse, error)
 	mustEmbedUnimplementedGroupServiceServer()
@@ -125,7 +227,8 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	StudentService_CreateStudent_FullMethodName = "/education.StudentService/CreateStudent"
+	StudentService_CreateStudent_FullMethodName   = "/education.StudentService/CreateStudent"
+	StudentService_DiscardStudents_FullMethodName = "/education.StudentService/DiscardStudents"
 )
 
 // StudentServiceClient is the client API for StudentService service.
@@ -135,6 +238,7 @@ const (
 // student service start
 type StudentServiceClient interface {
 	CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	DiscardStudents(ctx context.Context, in *DiscardStudentsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
 type studentServiceClient struct {
@@ -155,6 +259,16 @@ func (c *studentServiceClient) CreateStudent(ctx context.Context, in *CreateStud
 	return out, nil
 }
 
+func (c *studentServiceClient) DiscardStudents(ctx context.Context, in *DiscardStudentsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_DiscardStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StudentServiceServer is the server API for StudentService service.
 // All implementations must embed UnimplementedStudentServiceServer
 // for forward compatibility.
@@ -162,6 +276,7 @@ func (c *studentServiceClient) CreateStudent(ctx context.Context, in *CreateStud
 // student service start
 type StudentServiceServer interface {
 	CreateStudent(context.Context, *CreateStudentRequest) (*AbsResponse, error)
+	DiscardStudents(context.Context, *DiscardStudentsRequest) (*AbsResponse, error)
 	mustEmbedUnimplementedStudentServiceServer()
 }
 
@@ -175,6 +290,9 @@ type UnimplementedStudentServiceServer struct{}
 func (UnimplementedStudentServiceServer) CreateStudent(context.Context, *CreateStudentRequest) (*AbsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateStudent not implemented")
 }
+func (UnimplementedStudentServiceServer) DiscardStudents(context.Context, *DiscardStudentsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardStudents not implemented")
+}
 func (UnimplementedStudentServiceServer) mustEmbedUnimplementedStudentServiceServer() {}
 func (UnimplementedStudentServiceServer) testEmbeddedByValue()                        {}
 
@@ -214,6 +332,24 @@ func _StudentService_CreateStudent_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudentService_DiscardStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).DiscardStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_DiscardStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).DiscardStudents(ctx, req.(*DiscardStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StudentService_ServiceDesc is the grpc.ServiceDesc for StudentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +361,10 @@ var StudentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStudent",
 			Handler:    _StudentService_CreateStudent_Handler,
 		},
+		{
+			MethodName: "DiscardStudents",
+			Handler:    _StudentService_DiscardStudents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "education.proto",
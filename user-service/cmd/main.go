@@ -1,7 +1,15 @@
 package main
 
-import "user-service/internal/server"
+import (
+	"flag"
+	"user-service/internal/server"
+)
 
 func main() {
+	flag.Parse()
+	if flag.Arg(0) == "migrate" {
+		server.RunMigrate(flag.Args()[1:])
+		return
+	}
 	server.RunServer()
 }
@@ -1,43 +1,56 @@
 package config
 
-import (
-	"gopkg.in/yaml.v3"
-	"os"
-)
-
 type Config struct {
 	Server struct {
-		Port int `yaml:"port"`
+		Port int `yaml:"port" env:"SERVER_PORT,required"`
 	} `yaml:"server"`
 	Database struct {
-		Host     string `yaml:"host"`
-		Port     int    `yaml:"port"`
-		User     string `yaml:"user"`
-		Password string `yaml:"password"`
-		Name     string `yaml:"name"`
-		Sslmode  string `yaml:"sslmode"`
-		Action   string `yaml:"action"`
+		Host     string `yaml:"host" env:"DB_HOST,required"`
+		Port     int    `yaml:"port" env:"DB_PORT,required"`
+		User     string `yaml:"user" env:"DB_USER,required"`
+		Password string `yaml:"password" env:"DB_PASSWORD,required,secret"`
+		Name     string `yaml:"name" env:"DB_NAME,required"`
+		Sslmode  string `yaml:"sslmode" env:"DB_SSLMODE"`
+		Action   string `yaml:"action" env:"DB_ACTION"`
 	} `yaml:"database"`
+	Metrics struct {
+		Port int `yaml:"port" env:"METRICS_PORT,required"`
+	} `yaml:"metrics"`
+	// Tracing selects where spans are sent: otlp ships them to the collector
+	// at Endpoint, stdout prints them for local runs, none disables it.
+	Tracing struct {
+		Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER"`
+		Endpoint    string  `yaml:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+		SampleRatio float64 `yaml:"sampleRatio" env:"TRACING_SAMPLE_RATIO"`
+	} `yaml:"tracing"`
+	// Log sets the lowest level logged: debug, info, warn or error.
+	Log struct {
+		Level string `yaml:"level" env:"LOG_LEVEL"`
+	} `yaml:"log"`
 	Grpc struct {
 		EducationService struct {
-			Address string `yaml:"address"`
+			Address string `yaml:"address" env:"EDUCATION_SERVICE_ADDRESS,required"`
 		} `yaml:"educationService"`
 	} `yaml:"grpc"`
 }
 
-func LoadConfig() (*Config, error) {
-	file, err := os.Open("config/config.yaml")
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
+// String masks the secrets, the config is safe to log.
+func (c Config) String() string {
+	return redact(c)
+}
 
+func LoadConfig() (*Config, error) {
 	var config Config
-	decoder := yaml.NewDecoder(file)
-	err = decoder.Decode(&config)
-	if err != nil {
+	config.Server.Port = 8080
+	config.Metrics.Port = 2112
+	config.Tracing.Exporter = "none"
+	config.Tracing.SampleRatio = 1
+	config.Log.Level = "info"
+	config.Database.Port = 5432
+	config.Database.Sslmode = "disable"
+	config.Database.Action = "no"
+	if err := load(&config); err != nil {
 		return nil, err
 	}
-
 	return &config, nil
 }
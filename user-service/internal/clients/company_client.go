@@ -0,0 +1,25 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/metadata"
+	"user-service/proto/pb"
+)
+
+type CompanyClient struct {
+	client pb.CompanyServiceClient
+}
+
+func NewCompanyClient(addr string) (*CompanyClient, error) {
+	conn, err := Dial("education-service", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to CompanyService: %w", err)
+	}
+	return &CompanyClient{client: pb.NewCompanyServiceClient(conn)}, nil
+}
+
+func (cc *CompanyClient) GetSubscription(ctx context.Context, companyId string) (*pb.CompanySubscription, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "company_id", companyId)
+	return cc.client.GetSubscription(ctx, &pb.GetSubscriptionRequest{})
+}
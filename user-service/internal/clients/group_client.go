@@ -3,7 +3,6 @@ package clients
 import (
 	"context"
 	"fmt"
-	"google.golang.org/grpc"
 	"user-service/proto/pb"
 )
 
@@ -12,7 +11,7 @@ type GroupClient struct {
 }
 
 func NewGroupClient(addr string) (*GroupClient, error) {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := Dial("education-service", addr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to GroupService: %w", err)
 	}
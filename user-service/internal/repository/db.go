@@ -3,8 +3,9 @@ package repository
 import (
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 	"user-service/config"
+	"user-service/internal/tracing"
 )
 
 func NewPostgresRepository(cfg *config.Config) (*sql.DB, error) {
@@ -12,7 +13,7 @@ func NewPostgresRepository(cfg *config.Config) (*sql.DB, error) {
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Database.Password, cfg.Database.Name, cfg.Database.Sslmode,
 	)
-	db, err := sql.Open("postgres", connStr)
+	db, err := tracing.OpenDB(connStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
@@ -21,6 +22,6 @@ func NewPostgresRepository(cfg *config.Config) (*sql.DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	log.Println("Database connection established")
+	slog.Info("database connection established")
 	return db, nil
 }
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -14,27 +15,20 @@ import (
 )
 
 type UserRepository struct {
-	db              *sql.DB
-	groupClient     *clients.GroupClient
-	groupClientChan chan *clients.GroupClient
+	db          *sql.DB
+	groupClient *clients.GroupClient
 }
 
-func NewUserRepository(db *sql.DB, clientChan chan *clients.GroupClient) *UserRepository {
-	return &UserRepository{db: db, groupClientChan: clientChan}
+func NewUserRepository(db *sql.DB, groupClient *clients.GroupClient) *UserRepository {
+	return &UserRepository{db: db, groupClient: groupClient}
 }
 
-func (r *UserRepository) ensureGroupClient() error {
-	if r.groupClient == nil {
-		select {
-		case client := <-r.groupClientChan:
-			r.groupClient = client
-		case <-time.After(5 * time.Second):
-			return fmt.Errorf("failed to initialize GroupClient within timeout")
-		}
+func (r *UserRepository) CreateUser(companyId string, id string, gender bool, number string, birthDate string, name string, password string, role string) (*pb.AbsResponse, error) {
+	if id == "" {
+		id = uuid.New().String()
+	} else if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
 	}
-	return nil
-}
-func (r *UserRepository) CreateUser(companyId string, gender bool, number string, birthDate string, name string, password string, role string) (*pb.AbsResponse, error) {
 	var exists bool
 	if err := r.db.QueryRow(`SELECT exists(SELECT 1 FROM users where phone_number=$1 and company_id=$2 and is_deleted=false)`, number, companyId).Scan(&exists); err != nil {
 		return nil, status.Error(codes.PermissionDenied, "this phone number already exists")
@@ -43,7 +37,7 @@ func (r *UserRepository) CreateUser(companyId string, gender bool, number string
 	if err != nil {
 		return nil, err
 	}
-	_, err = r.db.Exec(`INSERT INTO users(id, full_name, phone_number, password, role, birth_date, gender,  company_id) values ($1 , $2 , $3 , $4 , $5 , $6, $7 , $8)`, uuid.New(), name, number, encodedPassword, role, birthDate, gender, companyId)
+	_, err = r.db.Exec(`INSERT INTO users(id, full_name, phone_number, password, role, birth_date, gender,  company_id) values ($1 , $2 , $3 , $4 , $5 , nullif($6, '')::date, $7 , $8)`, id, name, number, encodedPassword, role, birthDate, gender, companyId)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +47,6 @@ func (r *UserRepository) CreateUser(companyId string, gender bool, number string
 	}, nil
 }
 func (r *UserRepository) GetTeachers(ctx context.Context, companyId string, isDeleted bool) (*pb.GetTeachersResponse, error) {
-	if err := r.ensureGroupClient(); err != nil {
-		return nil, err
-	}
-
 	rows, err := r.db.Query(`SELECT id, full_name, phone_number FROM users WHERE is_deleted=$1 AND role='TEACHER' and company_id=$2`, isDeleted, companyId)
 	if err != nil {
 		return nil, err
@@ -93,6 +83,7 @@ func (r *UserRepository) GetTeachers(ctx context.Context, companyId string, isDe
 }
 func (r *UserRepository) GetUserById(companyId string, userId string) (*pb.GetUserByIdResponse, error) {
 	var response pb.GetUserByIdResponse
+	var birthDate sql.NullString
 	err := r.db.QueryRow(`SELECT id,
        full_name,
        phone_number,
@@ -100,19 +91,19 @@ func (r *UserRepository) GetUserById(companyId string, userId string) (*pb.GetUs
        birth_date,
        gender,
        is_deleted,
-       created_at FROM users where id=$1 and company_id=$2`, userId, companyId).Scan(&response.Id, &response.Name, &response.PhoneNumber, &response.Role, &response.BirthDate, &response.Gender, &response.IsDeleted, &response.CreatedAt)
+       created_at FROM users where id=$1 and company_id=$2`, userId, companyId).Scan(&response.Id, &response.Name, &response.PhoneNumber, &response.Role, &birthDate, &response.Gender, &response.IsDeleted, &response.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
+	response.BirthDate = birthDate.String
 	return &response, nil
 }
 func (r *UserRepository) UpdateUser(companyId string, userId string, name string, gender bool, role string, birthDate string, phoneNumber, password string, accessFinance bool) (*pb.AbsResponse, error) {
 	var err error
-	fmt.Printf("heree access to finance %v\n", accessFinance)
 	if password != "" {
 		query := `
         UPDATE users 
-        SET full_name = $1, phone_number = $2, gender = $3, role = $4, birth_date = $5, password=$8
+        SET full_name = $1, phone_number = $2, gender = $3, role = $4, birth_date = nullif($5, '')::date, password=$8
         WHERE id = $6 and company_id=$7
     `
 		password, err = utils.EncodePassword(password)
@@ -126,7 +117,7 @@ func (r *UserRepository) UpdateUser(companyId string, userId string, name string
 	} else {
 		query := `
         UPDATE users 
-        SET full_name = $1, phone_number = $2, gender = $3, role = $4, birth_date = $5 , has_access_finance = $8
+        SET full_name = $1, phone_number = $2, gender = $3, role = $4, birth_date = nullif($5, '')::date , has_access_finance = $8
         WHERE id = $6 and company_id=$7
     `
 		_, err = r.db.Exec(query, name, phoneNumber, gender, role, birthDate, userId, companyId, accessFinance)
@@ -140,6 +131,9 @@ func (r *UserRepository) UpdateUser(companyId string, userId string, name string
 func (r *UserRepository) DeleteUser(ctx context.Context, companyId string, id string) (*pb.AbsResponse, error) {
 	var role string
 	err := r.db.QueryRow(`SELECT role FROM users where id=$1 and company_id=$2`, id, companyId).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Aborted, err.Error())
 	}
@@ -192,10 +186,12 @@ func (r *UserRepository) GetAllEmployee(companyId string, isArchived bool) (*pb.
 	for rows.Next() {
 		var emp pb.GetUserByIdResponse
 		var createdAt time.Time
-		err := rows.Scan(&emp.Id, &emp.Name, &emp.PhoneNumber, &emp.Role, &emp.BirthDate, &emp.Gender, &emp.IsDeleted, &createdAt, &emp.HasAccessFinance)
+		var birthDate sql.NullString
+		err := rows.Scan(&emp.Id, &emp.Name, &emp.PhoneNumber, &emp.Role, &birthDate, &emp.Gender, &emp.IsDeleted, &createdAt, &emp.HasAccessFinance)
 		if err != nil {
 			return nil, err
 		}
+		emp.BirthDate = birthDate.String
 		emp.CreatedAt = createdAt.Format(time.RFC3339)
 		employees = append(employees, &emp)
 	}
@@ -209,6 +205,7 @@ func (r *UserRepository) GetAllEmployee(companyId string, isArchived bool) (*pb.
 func (r *UserRepository) GetUserByPhoneNumber(companyId string, phoneNumber string) (*pb.GetUserByIdResponse, string, error) {
 	res := pb.GetUserByIdResponse{}
 	var password string
+	var birthDate sql.NullString
 	query := ""
 	if companyId != "" {
 		query = `SELECT id,
@@ -223,7 +220,7 @@ func (r *UserRepository) GetUserByPhoneNumber(companyId string, phoneNumber stri
        coalesce(company_id ,0),
        has_access_finance
        FROM users where phone_number=$1 and company_id=$2 and is_deleted=false`
-		err := r.db.QueryRow(query, phoneNumber, companyId).Scan(&res.Id, &res.Name, &res.PhoneNumber, &password, &res.Role, &res.BirthDate, &res.Gender, &res.IsDeleted, &res.CreatedAt, &res.CompanyId, &res.HasAccessFinance)
+		err := r.db.QueryRow(query, phoneNumber, companyId).Scan(&res.Id, &res.Name, &res.PhoneNumber, &password, &res.Role, &birthDate, &res.Gender, &res.IsDeleted, &res.CreatedAt, &res.CompanyId, &res.HasAccessFinance)
 		if err != nil {
 			return nil, "", err
 		}
@@ -240,12 +237,13 @@ func (r *UserRepository) GetUserByPhoneNumber(companyId string, phoneNumber stri
        coalesce(company_id ,0),
        has_access_finance
        FROM users where phone_number=$1 and is_deleted=false`
-		err := r.db.QueryRow(query, phoneNumber).Scan(&res.Id, &res.Name, &res.PhoneNumber, &password, &res.Role, &res.BirthDate, &res.Gender, &res.IsDeleted, &res.CreatedAt, &res.CompanyId, &res.HasAccessFinance)
+		err := r.db.QueryRow(query, phoneNumber).Scan(&res.Id, &res.Name, &res.PhoneNumber, &password, &res.Role, &birthDate, &res.Gender, &res.IsDeleted, &res.CreatedAt, &res.CompanyId, &res.HasAccessFinance)
 		if err != nil {
 			return nil, "", err
 		}
 	}
 
+	res.BirthDate = birthDate.String
 	return &res, password, nil
 }
 func (r *UserRepository) GetAllStuff(companyId string, isArchived bool) (*pb.GetAllStuffResponse, error) {
@@ -259,10 +257,12 @@ func (r *UserRepository) GetAllStuff(companyId string, isArchived bool) (*pb.Get
 	var response pb.GetAllStuffResponse
 	for rows.Next() {
 		var user pb.GetUserByIdResponse
-		err = rows.Scan(&user.Id, &user.PhoneNumber, &user.Role, &user.Name, &user.BirthDate, &user.Gender, &user.IsDeleted, &user.CreatedAt)
+		var birthDate sql.NullString
+		err = rows.Scan(&user.Id, &user.PhoneNumber, &user.Role, &user.Name, &birthDate, &user.Gender, &user.IsDeleted, &user.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
+		user.BirthDate = birthDate.String
 		response.Stuff = append(response.Stuff, &user)
 	}
 	if err = rows.Err(); err != nil {
@@ -365,6 +365,7 @@ func (r *UserRepository) GetUserCompanyId(companyId string, role string) (*pb.Ge
 func (r *UserRepository) GetUserByIdFilter(id string) (*pb.GetUserByIdResponse, string, error) {
 	res := pb.GetUserByIdResponse{}
 	var password string
+	var birthDate sql.NullString
 	query := ""
 	if id != "" {
 		query = `SELECT id,
@@ -379,7 +380,7 @@ func (r *UserRepository) GetUserByIdFilter(id string) (*pb.GetUserByIdResponse,
        coalesce(company_id ,0),
        has_access_finance
        FROM users where id=$1`
-		err := r.db.QueryRow(query, id).Scan(&res.Id, &res.Name, &res.PhoneNumber, &password, &res.Role, &res.BirthDate, &res.Gender, &res.IsDeleted, &res.CreatedAt, &res.CompanyId, &res.HasAccessFinance)
+		err := r.db.QueryRow(query, id).Scan(&res.Id, &res.Name, &res.PhoneNumber, &password, &res.Role, &birthDate, &res.Gender, &res.IsDeleted, &res.CreatedAt, &res.CompanyId, &res.HasAccessFinance)
 		if err != nil {
 			return nil, "", err
 		}
@@ -387,5 +388,28 @@ func (r *UserRepository) GetUserByIdFilter(id string) (*pb.GetUserByIdResponse,
 		return nil, "", status.Error(codes.Unauthenticated, "user id not found")
 	}
 
+	res.BirthDate = birthDate.String
 	return &res, password, nil
 }
+
+// GetTenantUsage counts the staff of every company, and how many of them
+// were added between from and to.
+func (r *UserRepository) GetTenantUsage(from, to string) (*pb.TenantUsageList, error) {
+	rows, err := r.db.Query(`SELECT company_id, count(*), count(*) FILTER (WHERE created_at >= $1::date AND created_at < $2::date + 1)
+FROM users
+WHERE is_deleted = false AND role <> 'SUPER_CEO' AND company_id IS NOT NULL
+GROUP BY company_id`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+	response := &pb.TenantUsageList{}
+	for rows.Next() {
+		var usage pb.TenantUsage
+		if err := rows.Scan(&usage.CompanyId, &usage.Count, &usage.Recent); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		response.Items = append(response.Items, &usage)
+	}
+	return response, rows.Err()
+}
@@ -0,0 +1,25 @@
+package server
+
+import (
+	_ "github.com/lib/pq"
+	"log"
+	"user-service/config"
+	"user-service/internal/repository"
+	"user-service/migrations"
+)
+
+// RunMigrate serves `user-service migrate up|down|status`.
+func RunMigrate(args []string) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	db, err := repository.NewPostgresRepository(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer db.Close()
+	if err = migrations.Command(db, args); err != nil {
+		log.Fatalf("Migration failed: %v", err)
+	}
+}
@@ -1,17 +1,26 @@
 package server
 
 import (
+	"context"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 	"log"
+	"log/slog"
 	"net"
+	"os"
+	"os/signal"
 	"strconv"
-	"time"
+	"syscall"
 	"user-service/config"
 	"user-service/internal/clients"
+	"user-service/internal/logging"
+	"user-service/internal/metrics"
 	"user-service/internal/repository"
 	"user-service/internal/service"
+	"user-service/internal/subscription"
+	"user-service/internal/tracing"
 	"user-service/internal/utils"
+	"user-service/migrations"
 	"user-service/proto/pb"
 )
 
@@ -20,48 +29,56 @@ func RunServer() {
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	if err = logging.Init("user-service", cfg.Log.Level); err != nil {
+		log.Fatalf("Failed to set up logging: %v", err)
+	}
+	slog.Info("loaded config", "config", cfg.String())
 
 	db, err := repository.NewPostgresRepository(cfg)
 	if err != nil {
-		log.Fatalf("Failed to load database: %v", err)
+		logging.Fatal("failed to initialize database", err)
 	}
 	defer db.Close()
+	migrations.SetUpMigrating(cfg.Database.Action, db)
 
-	groupClientChan := make(chan *clients.GroupClient)
-	go func() {
-		time.Sleep(2 * time.Second)
-		var client *clients.GroupClient
-		for {
-			client, err = clients.NewGroupClient(cfg.Grpc.EducationService.Address)
-			if err == nil {
-				log.Println("Connected to Education Service successfully.")
-				groupClientChan <- client
-				close(groupClientChan)
-				break
-			}
-			log.Printf("Waiting for Education Service...")
-			time.Sleep(2 * time.Second)
-		}
-	}()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	flushTraces, err := tracing.Init(ctx, "user-service", cfg.Tracing.Exporter, cfg.Tracing.Endpoint, cfg.Tracing.SampleRatio)
+	if err != nil {
+		logging.Fatal("failed to set up tracing", err)
+	}
+	defer flushTraces()
+	metrics.RegisterDB(db, "user")
+	go metrics.Serve(ctx, cfg.Metrics.Port)
+
+	groupClient, err := clients.NewGroupClient(cfg.Grpc.EducationService.Address)
+	if err != nil {
+		logging.Fatal("failed to create group client", err)
+	}
+	companyClient, err := clients.NewCompanyClient(cfg.Grpc.EducationService.Address)
+	if err != nil {
+		logging.Fatal("failed to create company client", err)
+	}
+	enforcer := subscription.NewEnforcer(companyClient.GetSubscription)
 
-	userRepo := repository.NewUserRepository(db, groupClientChan)
+	userRepo := repository.NewUserRepository(db, groupClient)
 	userService := service.NewUserService(userRepo)
-	authService := service.NewAuthService(userRepo)
+	authService := service.NewAuthService(userRepo, companyClient)
 
 	listen, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.Server.Port))
 	if err != nil {
-		log.Fatalf("Error while listening: %v", err)
+		logging.Fatal("failed to listen", err)
 	}
 
 	server := grpc.NewServer(
-		grpc.UnaryInterceptor(utils.RecoveryInterceptor),
+		grpc.StatsHandler(tracing.ServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor, logging.UnaryServerInterceptor, utils.RecoveryInterceptor, enforcer.UnaryServerInterceptor),
 	)
+	healthServer := registerHealth(ctx, server, db)
 	pb.RegisterUserServiceServer(server, userService)
 	pb.RegisterAuthServiceServer(server, authService)
 
-	log.Printf("Server listening on port %v", cfg.Server.Port)
-
-	if err := server.Serve(listen); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
-	}
+	slog.Info("server listening", "port", cfg.Server.Port)
+	serve(ctx, server, healthServer, listen)
+	slog.Info("server stopped")
 }
@@ -5,20 +5,25 @@ import (
 	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"log/slog"
+	"user-service/internal/clients"
 	"user-service/internal/repository"
 	"user-service/internal/security"
+	"user-service/internal/subscription"
 	"user-service/internal/utils"
 	"user-service/proto/pb"
 )
 
 type AuthService struct {
 	pb.UnimplementedAuthServiceServer
-	userRepo *repository.UserRepository
+	userRepo      *repository.UserRepository
+	companyClient *clients.CompanyClient
 }
 
-func NewAuthService(repo *repository.UserRepository) *AuthService {
+func NewAuthService(repo *repository.UserRepository, companyClient *clients.CompanyClient) *AuthService {
 	return &AuthService{
-		userRepo: repo,
+		userRepo:      repo,
+		companyClient: companyClient,
 	}
 }
 
@@ -34,6 +39,9 @@ func (as *AuthService) Login(ctx context.Context, request *pb.LoginRequest) (*pb
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "notog'ri login yoki parol")
 	}
+	if err = as.checkSubscription(ctx, request.CompanyId, user.Role); err != nil {
+		return nil, err
+	}
 	token, err := security.GenerateToken(user)
 	if err != nil {
 		return nil, err
@@ -45,6 +53,23 @@ func (as *AuthService) Login(ctx context.Context, request *pb.LoginRequest) (*pb
 	}, nil
 }
 
+// checkSubscription keeps users of locked companies out. The platform owner
+// is let in regardless, and so is everyone when the state is unknown.
+func (as *AuthService) checkSubscription(ctx context.Context, companyId, role string) error {
+	if role == "SUPER_CEO" {
+		return nil
+	}
+	sub, err := as.companyClient.GetSubscription(ctx, companyId)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to check subscription on login", "company_id", companyId, "error", err)
+		return nil
+	}
+	if sub.State == subscription.StateLocked {
+		return status.Error(codes.PermissionDenied, "subscription expired, the company is locked until it is renewed")
+	}
+	return nil
+}
+
 func (as *AuthService) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.GetUserByIdResponse, error) {
 	claims, err := security.ValidateToken(req.Token)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"user-service/internal/repository"
+	"user-service/internal/subscription"
 	"user-service/internal/utils"
 	"user-service/proto/pb"
 )
@@ -26,7 +27,7 @@ func (u *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "company id required")
 	}
-	return u.userRepo.CreateUser(companyId, req.Gender, req.PhoneNumber, req.BirthDate, req.FullName, req.Password, req.Role)
+	return u.userRepo.CreateUser(companyId, req.Id, req.Gender, req.PhoneNumber, req.BirthDate, req.FullName, req.Password, req.Role)
 }
 
 func (u *UserService) GetTeachers(ctx context.Context, req *pb.GetTeachersRequest) (*pb.GetTeachersResponse, error) {
@@ -98,6 +99,14 @@ func (u *UserService) UpdateUserPassword(ctx context.Context, req *pb.UpdateUser
 	return u.userRepo.UpdateUserPassword(companyId, req.UserId, req.NewPassword)
 }
 
+// GetTenantUsage is for the platform analytics of education-service only.
+func (u *UserService) GetTenantUsage(ctx context.Context, req *pb.TenantUsageRequest) (*pb.TenantUsageList, error) {
+	if !subscription.FromPeer(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "tenant usage is only available to the platform services")
+	}
+	return u.userRepo.GetTenantUsage(req.From, req.To)
+}
+
 func (u *UserService) GetUserByCompanyId(ctx context.Context, req *pb.GetUserByCompanyIdRequest) (*pb.GetUserByCompanyIdResponse, error) {
 	return u.userRepo.GetUserCompanyId(req.CompanyId, req.Role)
 }
@@ -2,12 +2,13 @@ package utils
 
 import (
 	"context"
-	"fmt"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"log/slog"
+	"runtime/debug"
 	"time"
 )
 
@@ -31,7 +32,7 @@ func RecoveryInterceptor(
 ) (resp interface{}, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Recovered from panic in gRPC call: %v\n", r)
+			slog.ErrorContext(ctx, "recovered from panic in gRPC call", "panic", r, "stack", string(debug.Stack()))
 			err = status.Errorf(codes.Internal, "Internal server error")
 		}
 	}()
@@ -42,7 +43,6 @@ func GetCompanyDetails(ctx context.Context) string {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if ok {
 		if _, ok := md["company_id"]; ok {
-			fmt.Println("here the value ", md["company_id"][0])
 			return md["company_id"][0]
 		}
 	}
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS user_update_trigger ON users;
+DROP FUNCTION IF EXISTS log_user_updates();
+DROP TABLE IF EXISTS users_history;
+DROP TABLE IF EXISTS users;
@@ -15,7 +15,8 @@ CREATE TABLE IF NOT EXISTS users
 
 INSERT INTO users(id, full_name, phone_number, password, role)
 values (gen_random_uuid(), 'Shohruh', '+998950960153', '$2a$10$1gDxC.3v73V45QXt0R3cCurQE5YL5jB5HTRKrh8L1maJx68nySEtW',
-        'SUPER_CEO');
+        'SUPER_CEO')
+ON CONFLICT (phone_number) DO NOTHING;
 
 CREATE TABLE IF NOT EXISTS users_history
 (
@@ -66,6 +67,7 @@ BEGIN
 END;
 $$ LANGUAGE plpgsql;
 
+DROP TRIGGER IF EXISTS user_update_trigger ON users;
 CREATE TRIGGER user_update_trigger
     AFTER UPDATE
     ON users
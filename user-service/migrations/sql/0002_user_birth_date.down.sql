@@ -0,0 +1 @@
+ALTER TABLE users ALTER COLUMN birth_date SET DEFAULT '2000-12-12';
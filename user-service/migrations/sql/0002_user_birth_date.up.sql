@@ -0,0 +1 @@
+ALTER TABLE users ALTER COLUMN birth_date DROP DEFAULT;
@@ -1 +0,0 @@
-drop table users;
\ No newline at end of file
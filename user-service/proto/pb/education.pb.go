@@ -21,6 +21,159 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_education_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{0}
+}
+
+type CompanySubscription struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// ACTIVE, GRACE (read-only after valid_date) or LOCKED
+	State          string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ValidDate      string `protobuf:"bytes,3,opt,name=validDate,proto3" json:"validDate,omitempty"`
+	GraceUntil     string `protobuf:"bytes,4,opt,name=graceUntil,proto3" json:"graceUntil,omitempty"`
+	DaysLeft       int32  `protobuf:"varint,5,opt,name=daysLeft,proto3" json:"daysLeft,omitempty"`
+	IsDemo         bool   `protobuf:"varint,6,opt,name=isDemo,proto3" json:"isDemo,omitempty"`
+	TariffId       int32  `protobuf:"varint,7,opt,name=tariffId,proto3" json:"tariffId,omitempty"`
+	TariffName     string `protobuf:"bytes,8,opt,name=tariffName,proto3" json:"tariffName,omitempty"`
+	StudentLimit   int32  `protobuf:"varint,9,opt,name=studentLimit,proto3" json:"studentLimit,omitempty"`
+	ActiveStudents int32  `protobuf:"varint,10,opt,name=activeStudents,proto3" json:"activeStudents,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompanySubscription) Reset() {
+	*x = CompanySubscription{}
+	mi := &file_education_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanySubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanySubscription) ProtoMessage() {}
+
+func (x *CompanySubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanySubscription.ProtoReflect.Descriptor instead.
+func (*CompanySubscription) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CompanySubscription) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetValidDate() string {
+	if x != nil {
+		return x.ValidDate
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetGraceUntil() string {
+	if x != nil {
+		return x.GraceUntil
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetDaysLeft() int32 {
+	if x != nil {
+		return x.DaysLeft
+	}
+	return 0
+}
+
+func (x *CompanySubscription) GetIsDemo() bool {
+	if x != nil {
+		return x.IsDemo
+	}
+	return false
+}
+
+func (x *CompanySubscription) GetTariffId() int32 {
+	if x != nil {
+		return x.TariffId
+	}
+	return 0
+}
+
+func (x *CompanySubscription) GetTariffName() string {
+	if x != nil {
+		return x.TariffName
+	}
+	return ""
+}
+
+func (x *CompanySubscription) GetStudentLimit() int32 {
+	if x != nil {
+		return x.StudentLimit
+	}
+	return 0
+}
+
+func (x *CompanySubscription) GetActiveStudents() int32 {
+	if x != nil {
+		return x.ActiveStudents
+	}
+	return 0
+}
+
 type GetCompanyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -31,7 +184,7 @@ type GetCompanyRequest struct {
 
 func (x *GetCompanyRequest) Reset() {
 	*x = GetCompanyRequest{}
-	mi := &file_education_proto_msgTypes[0]
+	mi := &file_education_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +196,7 @@ func (x *GetCompanyRequest) String() string {
 func (*GetCompanyRequest) ProtoMessage() {}
 
 func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[0]
+	mi := &file_education_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +209,7 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
 func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{0}
+	return file_education_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetCompanyRequest) GetDomain() string {
@@ -95,7 +248,7 @@ type GetCompanyResponse struct {
 
 func (x *GetCompanyResponse) Reset() {
 	*x = GetCompanyResponse{}
-	mi := &file_education_proto_msgTypes[1]
+	mi := &file_education_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -107,7 +260,7 @@ func (x *GetCompanyResponse) String() string {
 func (*GetCompanyResponse) ProtoMessage() {}
 
 func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[1]
+	mi := &file_education_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -120,7 +273,7 @@ func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
 func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{1}
+	return file_education_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetCompanyResponse) GetId() string {
@@ -226,7 +379,7 @@ type Tariff struct {
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	StudentCount  int32                  `protobuf:"varint,3,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
-	Sum           float32                `protobuf:"fixed32,4,opt,name=sum,proto3" json:"sum,omitempty"`
+	Sum           string                 `protobuf:"bytes,4,opt,name=sum,proto3" json:"sum,omitempty"`
 	Discounts     string                 `protobuf:"bytes,5,opt,name=discounts,proto3" json:"discounts,omitempty"`
 	IsDeleted     bool                   `protobuf:"varint,6,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -236,7 +389,7 @@ type Tariff struct {
 
 func (x *Tariff) Reset() {
 	*x = Tariff{}
-	mi := &file_education_proto_msgTypes[2]
+	mi := &file_education_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +401,7 @@ func (x *Tariff) String() string {
 func (*Tariff) ProtoMessage() {}
 
 func (x *Tariff) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[2]
+	mi := &file_education_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +414,7 @@ func (x *Tariff) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
 func (*Tariff) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{2}
+	return file_education_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Tariff) GetId() int32 {
@@ -285,11 +438,11 @@ func (x *Tariff) GetStudentCount() int32 {
 	return 0
 }
 
-func (x *Tariff) GetSum() float32 {
+func (x *Tariff) GetSum() string {
 	if x != nil {
 		return x.Sum
 	}
-	return 0
+	return ""
 }
 
 func (x *Tariff) GetDiscounts() string {
@@ -323,7 +476,7 @@ type GetGroupsByTeacherIdRequest struct {
 
 func (x *GetGroupsByTeacherIdRequest) Reset() {
 	*x = GetGroupsByTeacherIdRequest{}
-	mi := &file_education_proto_msgTypes[3]
+	mi := &file_education_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -335,7 +488,7 @@ func (x *GetGroupsByTeacherIdRequest) String() string {
 func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
 
 func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[3]
+	mi := &file_education_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +501,7 @@ func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
 func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{3}
+	return file_education_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
@@ -374,7 +527,7 @@ type GetGroupsByTeacherResponse struct {
 
 func (x *GetGroupsByTeacherResponse) Reset() {
 	*x = GetGroupsByTeacherResponse{}
-	mi := &file_education_proto_msgTypes[4]
+	mi := &file_education_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +539,7 @@ func (x *GetGroupsByTeacherResponse) String() string {
 func (*GetGroupsByTeacherResponse) ProtoMessage() {}
 
 func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[4]
+	mi := &file_education_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,7 +552,7 @@ func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
 func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{4}
+	return file_education_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
@@ -427,7 +580,7 @@ type GetGroupByTeacherAbs struct {
 
 func (x *GetGroupByTeacherAbs) Reset() {
 	*x = GetGroupByTeacherAbs{}
-	mi := &file_education_proto_msgTypes[5]
+	mi := &file_education_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +592,7 @@ func (x *GetGroupByTeacherAbs) String() string {
 func (*GetGroupByTeacherAbs) ProtoMessage() {}
 
 func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[5]
+	mi := &file_education_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +605,7 @@ func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
 func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{5}
+	return file_education_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetGroupByTeacherAbs) GetId() string {
@@ -536,7 +689,7 @@ type AbsStudent struct {
 
 func (x *AbsStudent) Reset() {
 	*x = AbsStudent{}
-	mi := &file_education_proto_msgTypes[6]
+	mi := &file_education_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -548,7 +701,7 @@ func (x *AbsStudent) String() string {
 func (*AbsStudent) ProtoMessage() {}
 
 func (x *AbsStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[6]
+	mi := &file_education_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +714,7 @@ func (x *AbsStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
 func (*AbsStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{6}
+	return file_education_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AbsStudent) GetId() string {
@@ -589,7 +742,24 @@ var File_education_proto protoreflect.FileDescriptor
 
 const file_education_proto_rawDesc = "" +
 	"\n" +
-	"\x0feducation.proto\x12\teducation\";\n" +
+	"\x0feducation.proto\x12\teducation\"\x18\n" +
+	"\x16GetSubscriptionRequest\"\xc3\x02\n" +
+	"\x13CompanySubscription\x12\x1c\n" +
+	"\tcompanyId\x18\x01 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1c\n" +
+	"\tvalidDate\x18\x03 \x01(\tR\tvalidDate\x12\x1e\n" +
+	"\n" +
+	"graceUntil\x18\x04 \x01(\tR\n" +
+	"graceUntil\x12\x1a\n" +
+	"\bdaysLeft\x18\x05 \x01(\x05R\bdaysLeft\x12\x16\n" +
+	"\x06isDemo\x18\x06 \x01(\bR\x06isDemo\x12\x1a\n" +
+	"\btariffId\x18\a \x01(\x05R\btariffId\x12\x1e\n" +
+	"\n" +
+	"tariffName\x18\b \x01(\tR\n" +
+	"tariffName\x12\"\n" +
+	"\fstudentLimit\x18\t \x01(\x05R\fstudentLimit\x12&\n" +
+	"\x0eactiveStudents\x18\n" +
+	" \x01(\x05R\x0eactiveStudents\";\n" +
 	"\x11GetCompanyRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"\xbe\x03\n" +
@@ -616,7 +786,7 @@ const file_education_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
 	"\rstudent_count\x18\x03 \x01(\x05R\fstudentCount\x12\x10\n" +
-	"\x03sum\x18\x04 \x01(\x02R\x03sum\x12\x1c\n" +
+	"\x03sum\x18\x04 \x01(\tR\x03sum\x12\x1c\n" +
 	"\tdiscounts\x18\x05 \x01(\tR\tdiscounts\x12\x1d\n" +
 	"\n" +
 	"is_deleted\x18\x06 \x01(\bR\tisDeleted\x12\x1d\n" +
@@ -651,9 +821,10 @@ const file_education_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vphoneNumber\x18\x03 \x01(\tR\vphoneNumber2u\n" +
 	"\fGroupService\x12e\n" +
-	"\x14GetGroupsByTeacherId\x12&.education.GetGroupsByTeacherIdRequest\x1a%.education.GetGroupsByTeacherResponse2f\n" +
+	"\x14GetGroupsByTeacherId\x12&.education.GetGroupsByTeacherIdRequest\x1a%.education.GetGroupsByTeacherResponse2\xbc\x01\n" +
 	"\x0eCompanyService\x12T\n" +
-	"\x15GetCompanyBySubdomain\x12\x1c.education.GetCompanyRequest\x1a\x1d.education.GetCompanyResponseB\n" +
+	"\x15GetCompanyBySubdomain\x12\x1c.education.GetCompanyRequest\x1a\x1d.education.GetCompanyResponse\x12T\n" +
+	"\x0fGetSubscription\x12!.education.GetSubscriptionRequest\x1a\x1e.education.CompanySubscriptionB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -668,26 +839,30 @@ func file_education_proto_rawDescGZIP() []byte {
 	return file_education_proto_rawDescData
 }
 
-var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_education_proto_goTypes = []any{
-	(*GetCompanyRequest)(nil),           // 0: education.GetCompanyRequest
-	(*GetCompanyResponse)(nil),          // 1: education.GetCompanyResponse
-	(*Tariff)(nil),                      // 2: education.Tariff
-	(*GetGroupsByTeacherIdRequest)(nil), // 3: education.GetGroupsByTeacherIdRequest
-	(*GetGroupsByTeacherResponse)(nil),  // 4: education.GetGroupsByTeacherResponse
-	(*GetGroupByTeacherAbs)(nil),        // 5: education.GetGroupByTeacherAbs
-	(*AbsStudent)(nil),                  // 6: education.AbsStudent
+	(*GetSubscriptionRequest)(nil),      // 0: education.GetSubscriptionRequest
+	(*CompanySubscription)(nil),         // 1: education.CompanySubscription
+	(*GetCompanyRequest)(nil),           // 2: education.GetCompanyRequest
+	(*GetCompanyResponse)(nil),          // 3: education.GetCompanyResponse
+	(*Tariff)(nil),                      // 4: education.Tariff
+	(*GetGroupsByTeacherIdRequest)(nil), // 5: education.GetGroupsByTeacherIdRequest
+	(*GetGroupsByTeacherResponse)(nil),  // 6: education.GetGroupsByTeacherResponse
+	(*GetGroupByTeacherAbs)(nil),        // 7: education.GetGroupByTeacherAbs
+	(*AbsStudent)(nil),                  // 8: education.AbsStudent
 }
 var file_education_proto_depIdxs = []int32{
-	2, // 0: education.GetCompanyResponse.tariff:type_name -> education.Tariff
-	5, // 1: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
-	6, // 2: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
-	3, // 3: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
-	0, // 4: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
-	4, // 5: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
-	1, // 6: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
+	4, // 0: education.GetCompanyResponse.tariff:type_name -> education.Tariff
+	7, // 1: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
+	8, // 2: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
+	5, // 3: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
+	2, // 4: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
+	0, // 5: education.CompanyService.GetSubscription:input_type -> education.GetSubscriptionRequest
+	6, // 6: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
+	3, // 7: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
+	1, // 8: education.CompanyService.GetSubscription:output_type -> education.CompanySubscription
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
 	3, // [3:3] is the sub-list for extension extendee
 	0, // [0:3] is the sub-list for field type_name
@@ -704,7 +879,7 @@ func file_education_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
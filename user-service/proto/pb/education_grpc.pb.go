@@ -122,6 +122,7 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	CompanyService_GetCompanyBySubdomain_FullMethodName = "/education.CompanyService/GetCompanyBySubdomain"
+	CompanyService_GetSubscription_FullMethodName       = "/education.CompanyService/GetSubscription"
 )
 
 // CompanyServiceClient is the client API for CompanyService service.
@@ -129,6 +130,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CompanyServiceClient interface {
 	GetCompanyBySubdomain(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*GetCompanyResponse, error)
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*CompanySubscription, error)
 }
 
 type companyServiceClient struct {
@@ -149,11 +151,22 @@ func (c *companyServiceClient) GetCompanyBySubdomain(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *companyServiceClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*CompanySubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanySubscription)
+	err := c.cc.Invoke(ctx, CompanyService_GetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CompanyServiceServer is the server API for CompanyService service.
 // All implementations must embed UnimplementedCompanyServiceServer
 // for forward compatibility.
 type CompanyServiceServer interface {
 	GetCompanyBySubdomain(context.Context, *GetCompanyRequest) (*GetCompanyResponse, error)
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*CompanySubscription, error)
 	mustEmbedUnimplementedCompanyServiceServer()
 }
 
@@ -167,6 +180,9 @@ type UnimplementedCompanyServiceServer struct{}
 func (UnimplementedCompanyServiceServer) GetCompanyBySubdomain(context.Context, *GetCompanyRequest) (*GetCompanyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyBySubdomain not implemented")
 }
+func (UnimplementedCompanyServiceServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*CompanySubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
 func (UnimplementedCompanyServiceServer) mustEmbedUnimplementedCompanyServiceServer() {}
 func (UnimplementedCompanyServiceServer) testEmbeddedByValue()                        {}
 
@@ -206,6 +222,24 @@ func _CompanyService_GetCompanyBySubdomain_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CompanyService_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CompanyService_ServiceDesc is the grpc.ServiceDesc for CompanyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -217,6 +251,10 @@ var CompanyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompanyBySubdomain",
 			Handler:    _CompanyService_GetCompanyBySubdomain_Handler,
 		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _CompanyService_GetSubscription_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "education.proto",
@@ -674,13 +674,15 @@ func (x *GetUserByIdResponse) GetHasAccessFinance() bool {
 }
 
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FullName      string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	BirthDate     string                 `protobuf:"bytes,5,opt,name=birthDate,proto3" json:"birthDate,omitempty"`
-	Gender        bool                   `protobuf:"varint,6,opt,name=gender,proto3" json:"gender,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FullName    string                 `protobuf:"bytes,1,opt,name=fullName,proto3" json:"fullName,omitempty"`
+	PhoneNumber string                 `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Password    string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Role        string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	BirthDate   string                 `protobuf:"bytes,5,opt,name=birthDate,proto3" json:"birthDate,omitempty"`
+	Gender      bool                   `protobuf:"varint,6,opt,name=gender,proto3" json:"gender,omitempty"`
+	// optional, generated when empty; lets a caller retry or undo the create
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -757,6 +759,13 @@ func (x *CreateUserRequest) GetGender() bool {
 	return false
 }
 
+func (x *CreateUserRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type GetTeachersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsDeleted     bool                   `protobuf:"varint,1,opt,name=isDeleted,proto3" json:"isDeleted,omitempty"`
@@ -1138,14 +1147,15 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"company_id\x18\t \x01(\x05R\tcompanyId\x12,\n" +
 	"\x12has_access_finance\x18\n" +
-	" \x01(\bR\x10hasAccessFinance\"\xb7\x01\n" +
+	" \x01(\bR\x10hasAccessFinance\"\xc7\x01\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\bfullName\x18\x01 \x01(\tR\bfullName\x12 \n" +
 	"\vphoneNumber\x18\x02 \x01(\tR\vphoneNumber\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1c\n" +
 	"\tbirthDate\x18\x05 \x01(\tR\tbirthDate\x12\x16\n" +
-	"\x06gender\x18\x06 \x01(\bR\x06gender\"2\n" +
+	"\x06gender\x18\x06 \x01(\bR\x06gender\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\"2\n" +
 	"\x12GetTeachersRequest\x12\x1c\n" +
 	"\tisDeleted\x18\x01 \x01(\bR\tisDeleted\"C\n" +
 	"\x13GetTeachersResponse\x12,\n" +
@@ -1166,7 +1176,7 @@ const file_user_proto_rawDesc = "" +
 	"\rLoginResponse\x12-\n" +
 	"\x04user\x18\x01 \x01(\v2\x19.user.GetUserByIdResponseR\x04user\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
-	"\x04isOk\x18\x03 \x01(\bR\x04isOk2\xd1\x05\n" +
+	"\x04isOk\x18\x03 \x01(\bR\x04isOk2\x98\x06\n" +
 	"\vUserService\x12:\n" +
 	"\n" +
 	"CreateUser\x12\x17.user.CreateUserRequest\x1a\x13.common.AbsResponse\x12B\n" +
@@ -1178,7 +1188,8 @@ const file_user_proto_rawDesc = "" +
 	"\vGetAllStuff\x12\x1b.user.GetAllEmployeeRequest\x1a\x19.user.GetAllStuffResponse\x12L\n" +
 	"\x12GetHistoryByUserId\x12\x14.user.UserAbsRequest\x1a .user.GetHistoryByUserIdResponse\x12J\n" +
 	"\x12UpdateUserPassword\x12\x1f.user.UpdateUserPasswordRequest\x1a\x13.common.AbsResponse\x12W\n" +
-	"\x12GetUserByCompanyId\x12\x1f.user.GetUserByCompanyIdRequest\x1a .user.GetUserByCompanyIdResponse2\x87\x01\n" +
+	"\x12GetUserByCompanyId\x12\x1f.user.GetUserByCompanyIdRequest\x1a .user.GetUserByCompanyIdResponse\x12E\n" +
+	"\x0eGetTenantUsage\x12\x1a.common.TenantUsageRequest\x1a\x17.common.TenantUsageList2\x87\x01\n" +
 	"\vAuthService\x120\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x13.user.LoginResponse\x12F\n" +
 	"\rValidateToken\x12\x1a.user.ValidateTokenRequest\x1a\x19.user.GetUserByIdResponseB\n" +
@@ -1216,7 +1227,9 @@ var file_user_proto_goTypes = []any{
 	(*ValidateTokenRequest)(nil),          // 15: user.ValidateTokenRequest
 	(*LoginRequest)(nil),                  // 16: user.LoginRequest
 	(*LoginResponse)(nil),                 // 17: user.LoginResponse
-	(*AbsResponse)(nil),                   // 18: common.AbsResponse
+	(*TenantUsageRequest)(nil),            // 18: common.TenantUsageRequest
+	(*AbsResponse)(nil),                   // 19: common.AbsResponse
+	(*TenantUsageList)(nil),               // 20: common.TenantUsageList
 }
 var file_user_proto_depIdxs = []int32{
 	10, // 0: user.GetAllStuffResponse.stuff:type_name -> user.GetUserByIdResponse
@@ -1234,22 +1247,24 @@ var file_user_proto_depIdxs = []int32{
 	9,  // 12: user.UserService.GetHistoryByUserId:input_type -> user.UserAbsRequest
 	2,  // 13: user.UserService.UpdateUserPassword:input_type -> user.UpdateUserPasswordRequest
 	0,  // 14: user.UserService.GetUserByCompanyId:input_type -> user.GetUserByCompanyIdRequest
-	16, // 15: user.AuthService.Login:input_type -> user.LoginRequest
-	15, // 16: user.AuthService.ValidateToken:input_type -> user.ValidateTokenRequest
-	18, // 17: user.UserService.CreateUser:output_type -> common.AbsResponse
-	13, // 18: user.UserService.GetTeachers:output_type -> user.GetTeachersResponse
-	10, // 19: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
-	18, // 20: user.UserService.UpdateUserById:output_type -> common.AbsResponse
-	18, // 21: user.UserService.DeleteUserById:output_type -> common.AbsResponse
-	8,  // 22: user.UserService.GetAllEmployee:output_type -> user.GetAllEmployeeResponse
-	3,  // 23: user.UserService.GetAllStuff:output_type -> user.GetAllStuffResponse
-	4,  // 24: user.UserService.GetHistoryByUserId:output_type -> user.GetHistoryByUserIdResponse
-	18, // 25: user.UserService.UpdateUserPassword:output_type -> common.AbsResponse
-	1,  // 26: user.UserService.GetUserByCompanyId:output_type -> user.GetUserByCompanyIdResponse
-	17, // 27: user.AuthService.Login:output_type -> user.LoginResponse
-	10, // 28: user.AuthService.ValidateToken:output_type -> user.GetUserByIdResponse
-	17, // [17:29] is the sub-list for method output_type
-	5,  // [5:17] is the sub-list for method input_type
+	18, // 15: user.UserService.GetTenantUsage:input_type -> common.TenantUsageRequest
+	16, // 16: user.AuthService.Login:input_type -> user.LoginRequest
+	15, // 17: user.AuthService.ValidateToken:input_type -> user.ValidateTokenRequest
+	19, // 18: user.UserService.CreateUser:output_type -> common.AbsResponse
+	13, // 19: user.UserService.GetTeachers:output_type -> user.GetTeachersResponse
+	10, // 20: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
+	19, // 21: user.UserService.UpdateUserById:output_type -> common.AbsResponse
+	19, // 22: user.UserService.DeleteUserById:output_type -> common.AbsResponse
+	8,  // 23: user.UserService.GetAllEmployee:output_type -> user.GetAllEmployeeResponse
+	3,  // 24: user.UserService.GetAllStuff:output_type -> user.GetAllStuffResponse
+	4,  // 25: user.UserService.GetHistoryByUserId:output_type -> user.GetHistoryByUserIdResponse
+	19, // 26: user.UserService.UpdateUserPassword:output_type -> common.AbsResponse
+	1,  // 27: user.UserService.GetUserByCompanyId:output_type -> user.GetUserByCompanyIdResponse
+	20, // 28: user.UserService.GetTenantUsage:output_type -> common.TenantUsageList
+	17, // 29: user.AuthService.Login:output_type -> user.LoginResponse
+	10, // 30: user.AuthService.ValidateToken:output_type -> user.GetUserByIdResponse
+	18, // [18:31] is the sub-list for method output_type
+	5,  // [5:18] is the sub-list for method input_type
 	5,  // [5:5] is the sub-list for extension type_name
 	5,  // [5:5] is the sub-list for extension extendee
 	0,  // [0:5] is the sub-list for field type_name
@@ -29,6 +29,7 @@ const (
 	UserService_GetHistoryByUserId_FullMethodName = "/user.UserService/GetHistoryByUserId"
 	UserService_UpdateUserPassword_FullMethodName = "/user.UserService/UpdateUserPassword"
 	UserService_GetUserByCompanyId_FullMethodName = "/user.UserService/GetUserByCompanyId"
+	UserService_GetTenantUsage_FullMethodName     = "/user.UserService/GetTenantUsage"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -45,6 +46,7 @@ type UserServiceClient interface {
 	GetHistoryByUserId(ctx context.Context, in *UserAbsRequest, opts ...grpc.CallOption) (*GetHistoryByUserIdResponse, error)
 	UpdateUserPassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	GetUserByCompanyId(ctx context.Context, in *GetUserByCompanyIdRequest, opts ...grpc.CallOption) (*GetUserByCompanyIdResponse, error)
+	GetTenantUsage(ctx context.Context, in *TenantUsageRequest, opts ...grpc.CallOption) (*TenantUsageList, error)
 }
 
 type userServiceClient struct {
@@ -155,6 +157,16 @@ func (c *userServiceClient) GetUserByCompanyId(ctx context.Context, in *GetUserB
 	return out, nil
 }
 
+func (c *userServiceClient) GetTenantUsage(ctx context.Context, in *TenantUsageRequest, opts ...grpc.CallOption) (*TenantUsageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantUsageList)
+	err := c.cc.Invoke(ctx, UserService_GetTenantUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -169,6 +181,7 @@ type UserServiceServer interface {
 	GetHistoryByUserId(context.Context, *UserAbsRequest) (*GetHistoryByUserIdResponse, error)
 	UpdateUserPassword(context.Context, *UpdateUserPasswordRequest) (*AbsResponse, error)
 	GetUserByCompanyId(context.Context, *GetUserByCompanyIdRequest) (*GetUserByCompanyIdResponse, error)
+	GetTenantUsage(context.Context, *TenantUsageRequest) (*TenantUsageList, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -209,6 +222,9 @@ func (UnimplementedUserServiceServer) UpdateUserPassword(context.Context, *Updat
 func (UnimplementedUserServiceServer) GetUserByCompanyId(context.Context, *GetUserByCompanyIdRequest) (*GetUserByCompanyIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByCompanyId not implemented")
 }
+func (UnimplementedUserServiceServer) GetTenantUsage(context.Context, *TenantUsageRequest) (*TenantUsageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantUsage not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -410,6 +426,24 @@ func _UserService_GetUserByCompanyId_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetTenantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTenantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTenantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTenantUsage(ctx, req.(*TenantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +491,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByCompanyId",
 			Handler:    _UserService_GetUserByCompanyId_Handler,
 		},
+		{
+			MethodName: "GetTenantUsage",
+			Handler:    _UserService_GetTenantUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",